@@ -29,6 +29,8 @@ const (
 	Query_DelegatorValidators_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
 	Query_DelegatorWithdrawAddress_FullMethodName    = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
 	Query_CommunityPool_FullMethodName               = "/cosmos.distribution.v1beta1.Query/CommunityPool"
+	Query_AutoCompoundSettings_FullMethodName        = "/cosmos.distribution.v1beta1.Query/AutoCompoundSettings"
+	Query_AutoCompoundRounds_FullMethodName          = "/cosmos.distribution.v1beta1.Query/AutoCompoundRounds"
 )
 
 // QueryClient is the client API for Query service.
@@ -56,11 +58,14 @@ type QueryClient interface {
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
-	// Deprecated: Do not use.
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// AutoCompoundSettings queries the auto-compounding settings of a delegator.
+	AutoCompoundSettings(ctx context.Context, in *QueryAutoCompoundSettingsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundSettingsResponse, error)
+	// AutoCompoundRounds queries the auto-compounding rounds in progress.
+	AutoCompoundRounds(ctx context.Context, in *QueryAutoCompoundRoundsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundRoundsResponse, error)
 }
 
 type queryClient struct {
@@ -161,7 +166,6 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
-// Deprecated: Do not use.
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryCommunityPoolResponse)
@@ -172,6 +176,26 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) AutoCompoundSettings(ctx context.Context, in *QueryAutoCompoundSettingsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAutoCompoundSettingsResponse)
+	err := c.cc.Invoke(ctx, Query_AutoCompoundSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AutoCompoundRounds(ctx context.Context, in *QueryAutoCompoundRoundsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundRoundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAutoCompoundRoundsResponse)
+	err := c.cc.Invoke(ctx, Query_AutoCompoundRounds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -197,11 +221,14 @@ type QueryServer interface {
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
-	// Deprecated: Do not use.
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// AutoCompoundSettings queries the auto-compounding settings of a delegator.
+	AutoCompoundSettings(context.Context, *QueryAutoCompoundSettingsRequest) (*QueryAutoCompoundSettingsResponse, error)
+	// AutoCompoundRounds queries the auto-compounding rounds in progress.
+	AutoCompoundRounds(context.Context, *QueryAutoCompoundRoundsRequest) (*QueryAutoCompoundRoundsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -242,6 +269,12 @@ func (UnimplementedQueryServer) DelegatorWithdrawAddress(context.Context, *Query
 func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (UnimplementedQueryServer) AutoCompoundSettings(context.Context, *QueryAutoCompoundSettingsRequest) (*QueryAutoCompoundSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompoundSettings not implemented")
+}
+func (UnimplementedQueryServer) AutoCompoundRounds(context.Context, *QueryAutoCompoundRoundsRequest) (*QueryAutoCompoundRoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompoundRounds not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -443,6 +476,42 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutoCompoundSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AutoCompoundSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundSettings(ctx, req.(*QueryAutoCompoundSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoCompoundRounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundRoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundRounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AutoCompoundRounds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundRounds(ctx, req.(*QueryAutoCompoundRoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -490,6 +559,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "AutoCompoundSettings",
+			Handler:    _Query_AutoCompoundSettings_Handler,
+		},
+		{
+			MethodName: "AutoCompoundRounds",
+			Handler:    _Query_AutoCompoundRounds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -1,5 +1,3 @@
-// Since: cosmos-sdk 0.43
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.5.1
@@ -24,6 +22,10 @@ const (
 	Query_Allowance_FullMethodName           = "/cosmos.feegrant.v1beta1.Query/Allowance"
 	Query_Allowances_FullMethodName          = "/cosmos.feegrant.v1beta1.Query/Allowances"
 	Query_AllowancesByGranter_FullMethodName = "/cosmos.feegrant.v1beta1.Query/AllowancesByGranter"
+	Query_FeePool_FullMethodName             = "/cosmos.feegrant.v1beta1.Query/FeePool"
+	Query_FeePoolsByGranter_FullMethodName   = "/cosmos.feegrant.v1beta1.Query/FeePoolsByGranter"
+	Query_FeePoolGrantee_FullMethodName      = "/cosmos.feegrant.v1beta1.Query/FeePoolGrantee"
+	Query_FeePoolGrantees_FullMethodName     = "/cosmos.feegrant.v1beta1.Query/FeePoolGrantees"
 )
 
 // QueryClient is the client API for Query service.
@@ -38,6 +40,14 @@ type QueryClient interface {
 	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// FeePool returns a fee pool and its usage.
+	FeePool(ctx context.Context, in *QueryFeePoolRequest, opts ...grpc.CallOption) (*QueryFeePoolResponse, error)
+	// FeePoolsByGranter returns the fee pools created by a granter.
+	FeePoolsByGranter(ctx context.Context, in *QueryFeePoolsByGranterRequest, opts ...grpc.CallOption) (*QueryFeePoolsByGranterResponse, error)
+	// FeePoolGrantee returns the quota and usage of a grantee of a fee pool.
+	FeePoolGrantee(ctx context.Context, in *QueryFeePoolGranteeRequest, opts ...grpc.CallOption) (*QueryFeePoolGranteeResponse, error)
+	// FeePoolGrantees returns the grantees of a fee pool and their usage.
+	FeePoolGrantees(ctx context.Context, in *QueryFeePoolGranteesRequest, opts ...grpc.CallOption) (*QueryFeePoolGranteesResponse, error)
 }
 
 type queryClient struct {
@@ -78,6 +88,46 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) FeePool(ctx context.Context, in *QueryFeePoolRequest, opts ...grpc.CallOption) (*QueryFeePoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeePoolResponse)
+	err := c.cc.Invoke(ctx, Query_FeePool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePoolsByGranter(ctx context.Context, in *QueryFeePoolsByGranterRequest, opts ...grpc.CallOption) (*QueryFeePoolsByGranterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeePoolsByGranterResponse)
+	err := c.cc.Invoke(ctx, Query_FeePoolsByGranter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePoolGrantee(ctx context.Context, in *QueryFeePoolGranteeRequest, opts ...grpc.CallOption) (*QueryFeePoolGranteeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeePoolGranteeResponse)
+	err := c.cc.Invoke(ctx, Query_FeePoolGrantee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePoolGrantees(ctx context.Context, in *QueryFeePoolGranteesRequest, opts ...grpc.CallOption) (*QueryFeePoolGranteesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeePoolGranteesResponse)
+	err := c.cc.Invoke(ctx, Query_FeePoolGrantees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -90,6 +140,14 @@ type QueryServer interface {
 	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// FeePool returns a fee pool and its usage.
+	FeePool(context.Context, *QueryFeePoolRequest) (*QueryFeePoolResponse, error)
+	// FeePoolsByGranter returns the fee pools created by a granter.
+	FeePoolsByGranter(context.Context, *QueryFeePoolsByGranterRequest) (*QueryFeePoolsByGranterResponse, error)
+	// FeePoolGrantee returns the quota and usage of a grantee of a fee pool.
+	FeePoolGrantee(context.Context, *QueryFeePoolGranteeRequest) (*QueryFeePoolGranteeResponse, error)
+	// FeePoolGrantees returns the grantees of a fee pool and their usage.
+	FeePoolGrantees(context.Context, *QueryFeePoolGranteesRequest) (*QueryFeePoolGranteesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -109,6 +167,18 @@ func (UnimplementedQueryServer) Allowances(context.Context, *QueryAllowancesRequ
 func (UnimplementedQueryServer) AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (UnimplementedQueryServer) FeePool(context.Context, *QueryFeePoolRequest) (*QueryFeePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePool not implemented")
+}
+func (UnimplementedQueryServer) FeePoolsByGranter(context.Context, *QueryFeePoolsByGranterRequest) (*QueryFeePoolsByGranterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePoolsByGranter not implemented")
+}
+func (UnimplementedQueryServer) FeePoolGrantee(context.Context, *QueryFeePoolGranteeRequest) (*QueryFeePoolGranteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePoolGrantee not implemented")
+}
+func (UnimplementedQueryServer) FeePoolGrantees(context.Context, *QueryFeePoolGranteesRequest) (*QueryFeePoolGranteesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePoolGrantees not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -184,6 +254,78 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePool(ctx, req.(*QueryFeePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePoolsByGranter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePoolsByGranterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePoolsByGranter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeePoolsByGranter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePoolsByGranter(ctx, req.(*QueryFeePoolsByGranterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePoolGrantee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePoolGranteeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePoolGrantee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeePoolGrantee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePoolGrantee(ctx, req.(*QueryFeePoolGranteeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePoolGrantees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePoolGranteesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePoolGrantees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeePoolGrantees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePoolGrantees(ctx, req.(*QueryFeePoolGranteesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,6 +345,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "FeePool",
+			Handler:    _Query_FeePool_Handler,
+		},
+		{
+			MethodName: "FeePoolsByGranter",
+			Handler:    _Query_FeePoolsByGranter_Handler,
+		},
+		{
+			MethodName: "FeePoolGrantee",
+			Handler:    _Query_FeePoolGrantee_Handler,
+		},
+		{
+			MethodName: "FeePoolGrantees",
+			Handler:    _Query_FeePoolGrantees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -1,5 +1,3 @@
-// Since: cosmos-sdk 0.43
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.5.1
@@ -21,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_GrantAllowance_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
-	Msg_RevokeAllowance_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
-	Msg_PruneAllowances_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_GrantAllowance_FullMethodName        = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
+	Msg_RevokeAllowance_FullMethodName       = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
+	Msg_PruneAllowances_FullMethodName       = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_CreateFeePool_FullMethodName         = "/cosmos.feegrant.v1beta1.Msg/CreateFeePool"
+	Msg_UpdateFeePoolGrantees_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/UpdateFeePoolGrantees"
+	Msg_DeleteFeePool_FullMethodName         = "/cosmos.feegrant.v1beta1.Msg/DeleteFeePool"
 )
 
 // MsgClient is the client API for Msg service.
@@ -40,6 +41,13 @@ type MsgClient interface {
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error)
+	// CreateFeePool creates a fee pool funded by the granter. The pool pays the
+	// fees of its grantees within the pool-wide cap and the per-grantee quotas.
+	CreateFeePool(ctx context.Context, in *MsgCreateFeePool, opts ...grpc.CallOption) (*MsgCreateFeePoolResponse, error)
+	// UpdateFeePoolGrantees adds and removes grantees of a fee pool in bulk.
+	UpdateFeePoolGrantees(ctx context.Context, in *MsgUpdateFeePoolGrantees, opts ...grpc.CallOption) (*MsgUpdateFeePoolGranteesResponse, error)
+	// DeleteFeePool deletes a fee pool and refunds its remaining balance to the granter.
+	DeleteFeePool(ctx context.Context, in *MsgDeleteFeePool, opts ...grpc.CallOption) (*MsgDeleteFeePoolResponse, error)
 }
 
 type msgClient struct {
@@ -80,6 +88,36 @@ func (c *msgClient) PruneAllowances(ctx context.Context, in *MsgPruneAllowances,
 	return out, nil
 }
 
+func (c *msgClient) CreateFeePool(ctx context.Context, in *MsgCreateFeePool, opts ...grpc.CallOption) (*MsgCreateFeePoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCreateFeePoolResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateFeePool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeePoolGrantees(ctx context.Context, in *MsgUpdateFeePoolGrantees, opts ...grpc.CallOption) (*MsgUpdateFeePoolGranteesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateFeePoolGranteesResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateFeePoolGrantees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteFeePool(ctx context.Context, in *MsgDeleteFeePool, opts ...grpc.CallOption) (*MsgDeleteFeePoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDeleteFeePoolResponse)
+	err := c.cc.Invoke(ctx, Msg_DeleteFeePool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -94,6 +132,13 @@ type MsgServer interface {
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
 	// PruneAllowances prunes expired fee allowances, currently up to 75 at a time.
 	PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error)
+	// CreateFeePool creates a fee pool funded by the granter. The pool pays the
+	// fees of its grantees within the pool-wide cap and the per-grantee quotas.
+	CreateFeePool(context.Context, *MsgCreateFeePool) (*MsgCreateFeePoolResponse, error)
+	// UpdateFeePoolGrantees adds and removes grantees of a fee pool in bulk.
+	UpdateFeePoolGrantees(context.Context, *MsgUpdateFeePoolGrantees) (*MsgUpdateFeePoolGranteesResponse, error)
+	// DeleteFeePool deletes a fee pool and refunds its remaining balance to the granter.
+	DeleteFeePool(context.Context, *MsgDeleteFeePool) (*MsgDeleteFeePoolResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -113,6 +158,15 @@ func (UnimplementedMsgServer) RevokeAllowance(context.Context, *MsgRevokeAllowan
 func (UnimplementedMsgServer) PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAllowances not implemented")
 }
+func (UnimplementedMsgServer) CreateFeePool(context.Context, *MsgCreateFeePool) (*MsgCreateFeePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFeePool not implemented")
+}
+func (UnimplementedMsgServer) UpdateFeePoolGrantees(context.Context, *MsgUpdateFeePoolGrantees) (*MsgUpdateFeePoolGranteesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeePoolGrantees not implemented")
+}
+func (UnimplementedMsgServer) DeleteFeePool(context.Context, *MsgDeleteFeePool) (*MsgDeleteFeePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeePool not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -188,6 +242,60 @@ func _Msg_PruneAllowances_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateFeePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFeePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFeePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateFeePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFeePool(ctx, req.(*MsgCreateFeePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeePoolGrantees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeePoolGrantees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeePoolGrantees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateFeePoolGrantees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeePoolGrantees(ctx, req.(*MsgUpdateFeePoolGrantees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteFeePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteFeePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteFeePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeleteFeePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteFeePool(ctx, req.(*MsgDeleteFeePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,6 +315,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PruneAllowances",
 			Handler:    _Msg_PruneAllowances_Handler,
 		},
+		{
+			MethodName: "CreateFeePool",
+			Handler:    _Msg_CreateFeePool_Handler,
+		},
+		{
+			MethodName: "UpdateFeePoolGrantees",
+			Handler:    _Msg_UpdateFeePoolGrantees_Handler,
+		},
+		{
+			MethodName: "DeleteFeePool",
+			Handler:    _Msg_DeleteFeePool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -13,6 +13,7 @@ import (
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/cosmos/gogoproto/proto"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
 
@@ -232,6 +233,11 @@ func (app *BaseApp) ListSnapshots(req *abci.ListSnapshotsRequest) (*abci.ListSna
 	}
 
 	for _, snapshot := range snapshots {
+		// incremental snapshots can only be restored on top of a previous state, not through state sync
+		if snapshot.Format == snapshottypes.IncrementalFormat {
+			continue
+		}
+
 		abciSnapshot, err := snapshot.ToABCI()
 		if err != nil {
 			app.logger.Error("failed to convert ABCI snapshots", "err", err)
@@ -410,6 +416,10 @@ func (app *BaseApp) PrepareProposal(req *abci.PrepareProposalRequest) (resp *abc
 		return nil, errors.New("PrepareProposal handler not set")
 	}
 
+	defer telemetry.RecordABCIDuration("PrepareProposal", time.Now())
+	_, span := telemetry.StartSpan(context.Background(), "PrepareProposal", telemetry.AttributeKeyHeight.Int64(req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	// Abort any running OE so it cannot overlap with `PrepareProposal`. This could happen if optimistic
 	// `internalFinalizeBlock` from previous round takes a long time, but consensus has moved on to next round.
 	// Overlap is undesirable, since `internalFinalizeBlock` and `PrepareProposal` could share access to
@@ -454,7 +464,7 @@ func (app *BaseApp) PrepareProposal(req *abci.PrepareProposalRequest) (resp *abc
 			Time:    req.Time,
 		}))
 
-	app.prepareProposalState.SetContext(app.prepareProposalState.Context().
+	app.prepareProposalState.SetContext(withSpan(app.prepareProposalState.Context(), span).
 		WithConsensusParams(app.GetConsensusParams(app.prepareProposalState.Context())).
 		WithBlockGasMeter(app.getBlockGasMeter(app.prepareProposalState.Context())))
 
@@ -500,6 +510,10 @@ func (app *BaseApp) ProcessProposal(req *abci.ProcessProposalRequest) (resp *abc
 		return nil, errors.New("ProcessProposal handler not set")
 	}
 
+	defer telemetry.RecordABCIDuration("ProcessProposal", time.Now())
+	_, span := telemetry.StartSpan(context.Background(), "ProcessProposal", telemetry.AttributeKeyHeight.Int64(req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	// CometBFT must never call ProcessProposal with a height of 0.
 	// Ref: https://github.com/cometbft/cometbft/blob/059798a4f5b0c9f52aa8655fa619054a0154088c/spec/core/state.md?plain=1#L37-L38
 	if req.Height < 1 {
@@ -548,7 +562,7 @@ func (app *BaseApp) ProcessProposal(req *abci.ProcessProposalRequest) (resp *abc
 			Time:    req.Time,
 		}))
 
-	app.processProposalState.SetContext(app.processProposalState.Context().
+	app.processProposalState.SetContext(withSpan(app.processProposalState.Context(), span).
 		WithConsensusParams(app.GetConsensusParams(app.processProposalState.Context())).
 		WithBlockGasMeter(app.getBlockGasMeter(app.processProposalState.Context())))
 
@@ -800,6 +814,10 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 			LastCommit:      sdk.ToSDKCommitInfo(req.DecidedLastCommit),
 		}))
 
+	// Only the span of ctx is carried to the block execution context, ctx
+	// cancellation must not leak into the state machine.
+	app.finalizeBlockState.SetContext(withSpan(app.finalizeBlockState.Context(), trace.SpanFromContext(ctx)))
+
 	// GasMeter must be set after we get a context with updated consensus params.
 	gasMeter := app.getBlockGasMeter(app.finalizeBlockState.Context())
 	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().WithBlockGasMeter(gasMeter))
@@ -841,10 +859,20 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 	//
 	// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
 	// vote extensions, so skip those.
+	// only the results of an optimistic execution may be reused, by the run of
+	// the decided block if the execution is aborted
+	recordTxResults := app.optimisticExec.Initialized()
+	if app.txResultCache != nil {
+		app.txResultCache.reset(req.Height)
+	}
 	txResults := make([]*abci.ExecTxResult, 0, len(req.Txs))
 	for txIndex, rawTx := range req.Txs {
-
-		response := app.deliverTx(rawTx)
+		var response *abci.ExecTxResult
+		if app.txResultCache != nil {
+			response = app.deliverTxReusingResult(rawTx, req, recordTxResults)
+		} else {
+			response = app.deliverTx(rawTx)
+		}
 
 		// check after every tx if we should abort
 		select {
@@ -902,6 +930,10 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 // extensions into the proposal, which should not themselves be executed in cases
 // where they adhere to the sdk.Tx interface.
 func (app *BaseApp) FinalizeBlock(req *abci.FinalizeBlockRequest) (res *abci.FinalizeBlockResponse, err error) {
+	defer telemetry.RecordABCIDuration("FinalizeBlock", time.Now())
+	ctx, span := telemetry.StartSpan(context.Background(), "FinalizeBlock", telemetry.AttributeKeyHeight.Int64(req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	defer func() {
 		// call the streaming service hooks with the FinalizeBlock messages
 		for _, streamingListener := range app.streamingManager.ABCIListeners {
@@ -936,7 +968,7 @@ func (app *BaseApp) FinalizeBlock(req *abci.FinalizeBlockRequest) (res *abci.Fin
 	}
 
 	// if no OE is running, just run the block (this is either a block replay or a OE that got aborted)
-	res, err = app.internalFinalizeBlock(context.Background(), req)
+	res, err = app.internalFinalizeBlock(ctx, req)
 	if res != nil {
 		res.AppHash = app.workingHash()
 	}
@@ -969,10 +1001,14 @@ func (app *BaseApp) checkHalt(height int64, time time.Time) error {
 // defined in config, Commit will execute a deferred function call to check
 // against that height and gracefully halt if it matches the latest committed
 // height.
-func (app *BaseApp) Commit() (*abci.CommitResponse, error) {
+func (app *BaseApp) Commit() (_ *abci.CommitResponse, err error) {
 	header := app.finalizeBlockState.Context().BlockHeader()
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
 
+	defer telemetry.RecordABCIDuration("Commit", time.Now())
+	ctx, span := telemetry.StartSpan(context.Background(), "Commit", telemetry.AttributeKeyHeight.Int64(header.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	if app.precommiter != nil {
 		app.precommiter(app.finalizeBlockState.Context())
 	}
@@ -986,7 +1022,7 @@ func (app *BaseApp) Commit() (*abci.CommitResponse, error) {
 		RetainHeight: retainHeight,
 	}
 
-	app.cms.Commit()
+	app.commitStore(ctx)
 
 	abciListeners := app.streamingManager.ABCIListeners
 	if len(abciListeners) > 0 {
@@ -1020,6 +1056,9 @@ func (app *BaseApp) Commit() (*abci.CommitResponse, error) {
 	app.setState(execModeCheck, header)
 
 	app.finalizeBlockState = nil
+	if app.txResultCache != nil {
+		app.txResultCache.clear()
+	}
 
 	if app.prepareCheckStater != nil {
 		app.prepareCheckStater(app.checkState.Context())
@@ -1049,6 +1088,22 @@ func (app *BaseApp) workingHash() []byte {
 	return commitHash
 }
 
+// commitStore commits the root multi-store, tracing it as a child span of ctx.
+func (app *BaseApp) commitStore(ctx context.Context) {
+	defer telemetry.RecordStoreCommitDuration(time.Now())
+	_, span := telemetry.StartSpan(ctx, "StoreCommit")
+	defer span.End()
+
+	commitID := app.cms.Commit()
+	span.SetAttributes(telemetry.AttributeKeyHeight.Int64(commitID.Version))
+}
+
+// withSpan returns a copy of ctx carrying span as its current OpenTelemetry span,
+// so that spans started from the context are children of span.
+func withSpan(ctx sdk.Context, span trace.Span) sdk.Context {
+	return ctx.WithContext(trace.ContextWithSpan(ctx.Context(), span))
+}
+
 func handleQueryApp(app *BaseApp, path []string, req *abci.QueryRequest) *abci.QueryResponse {
 	if len(path) >= 2 {
 		switch path[1] {
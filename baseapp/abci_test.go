@@ -11,6 +11,7 @@ import (
 	"math/rand"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -25,6 +26,9 @@ import (
 	gogotypes "github.com/cosmos/gogoproto/types"
 	any "github.com/cosmos/gogoproto/types/any"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/mock/gomock"
 
 	coretesting "cosmossdk.io/core/testing"
@@ -36,11 +40,14 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/oe"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
 	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/cosmos/cosmos-sdk/telemetry/oteltest"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -686,6 +693,74 @@ func TestABCI_FinalizeBlock_DeliverTx(t *testing.T) {
 	}
 }
 
+func TestABCI_FinalizeBlock_Tracing(t *testing.T) {
+	provider, spans, reader, err := oteltest.NewInMemoryProvider()
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, provider.Shutdown(context.Background())) })
+
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	_, err = suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	txs := [][]byte{}
+	for i := int64(0); i < 2; i++ {
+		txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, suite.ac, i, i))
+		require.NoError(t, err)
+		txs = append(txs, txBytes)
+	}
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1, Txs: txs})
+	require.NoError(t, err)
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+
+	byName := map[string][]tracetest.SpanStub{}
+	for _, span := range spans.GetSpans() {
+		byName[span.Name] = append(byName[span.Name], span)
+	}
+
+	require.Len(t, byName["FinalizeBlock"], 1)
+	finalizeBlock := byName["FinalizeBlock"][0]
+	require.Contains(t, finalizeBlock.Attributes, telemetry.AttributeKeyHeight.Int64(1))
+
+	require.Len(t, byName["Tx"], 2)
+	txSpanIDs := map[oteltrace.SpanID]bool{}
+	for _, tx := range byName["Tx"] {
+		require.Equal(t, finalizeBlock.SpanContext.SpanID(), tx.Parent.SpanID())
+		require.Contains(t, tx.Attributes, telemetry.AttributeKeyGasWanted.Int64(int64(storetypes.NewInfiniteGasMeter().Limit())))
+		txSpanIDs[tx.SpanContext.SpanID()] = true
+	}
+
+	msgTypeURL := sdk.MsgTypeURL(&baseapptestutil.MsgCounter{})
+	require.Len(t, byName[msgTypeURL], 2)
+	for _, msg := range byName[msgTypeURL] {
+		require.True(t, txSpanIDs[msg.Parent.SpanID()])
+		require.Contains(t, msg.Attributes, telemetry.AttributeKeyMsgType.String(msgTypeURL))
+	}
+
+	require.Len(t, byName["Commit"], 1)
+	require.Len(t, byName["StoreCommit"], 1)
+	require.Equal(t, byName["Commit"][0].SpanContext.SpanID(), byName["StoreCommit"][0].Parent.SpanID())
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	metricNames := []string{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metricNames = append(metricNames, m.Name)
+		}
+	}
+	require.Subset(t, metricNames, []string{"abci.duration", "tx.gas_used", "tx.msg.duration", "store.commit.duration"})
+}
+
 func TestABCI_FinalizeBlock_MultiMsg(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
@@ -2679,6 +2754,94 @@ func TestOptimisticExecution(t *testing.T) {
 	require.Equal(t, int64(50), suite.baseApp.LastBlockHeight())
 }
 
+// sharedCounterServerImpl increments a counter per message counter, below 100,
+// or a shared counter, records the block time for a message counter of 200 and
+// above, and counts its executions.
+type sharedCounterServerImpl struct {
+	t          *testing.T
+	executions *atomic.Int64
+}
+
+func (m sharedCounterServerImpl) IncrementCounter(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	m.executions.Add(1)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(capKey1)
+	if msg.Counter >= 200 {
+		setIntOnStore(store, []byte(fmt.Sprintf("time-%d", msg.Counter)), sdkCtx.HeaderInfo().Time.Unix())
+		return &baseapptestutil.MsgCreateCounterResponse{}, nil
+	}
+	key := []byte("shared")
+	if msg.Counter < 100 {
+		key = []byte(fmt.Sprintf("counter-%d", msg.Counter))
+	}
+	setIntOnStore(store, key, getIntFromStore(m.t, store, key)+1)
+	return &baseapptestutil.MsgCreateCounterResponse{}, nil
+}
+
+func TestOptimisticExecution_TxResultReuse(t *testing.T) {
+	newApp := func(opts ...func(*baseapp.BaseApp)) (*BaseAppSuite, *atomic.Int64) {
+		suite := NewBaseAppSuite(t, opts...)
+		executions := new(atomic.Int64)
+		baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), sharedCounterServerImpl{t, executions})
+
+		_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		require.NoError(t, err)
+		_, err = suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+		return suite, executions
+	}
+	suite, executions := newApp(baseapp.SetOptimisticExecution(oe.WithTxResultReuse()))
+
+	encode := func(tx sdk.Tx) []byte {
+		bz, err := suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return bz
+	}
+	txA := encode(newTxCounter(t, suite.txConfig, suite.ac, 0, 1))
+	txB := encode(newTxCounter(t, suite.txConfig, suite.ac, 0, 2))
+	txShared := encode(newTxCounter(t, suite.txConfig, suite.ac, 0, 100))
+	txOther := encode(newTxCounter(t, suite.txConfig, suite.ac, 0, 101))
+	txTime := encode(newTxCounter(t, suite.txConfig, suite.ac, 0, 200))
+
+	// the OE executes the proposal
+	respProcProp, err := suite.baseApp.ProcessProposal(&abci.ProcessProposalRequest{
+		Txs:             [][]byte{txA, txB, txShared, txTime},
+		Height:          2,
+		Time:            time.Unix(1_000_000, 0),
+		ProposerAddress: []byte("proposer-1"),
+		Hash:            []byte("proposal-1"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.PROCESS_PROPOSAL_STATUS_ACCEPT, respProcProp.Status)
+	require.Eventually(t, func() bool { return executions.Load() == 4 }, time.Second, time.Millisecond)
+
+	// the decided block is another one, of another proposer and time, with a tx
+	// writing the shared counter first: only the txs reading the shared counter
+	// or the block time are executed again
+	reqFinalizeBlock := &abci.FinalizeBlockRequest{
+		Txs:             [][]byte{txOther, txA, txB, txShared, txTime},
+		Height:          2,
+		Time:            time.Unix(1_000_005, 0),
+		ProposerAddress: []byte("proposer-2"),
+		Hash:            []byte("proposal-2"),
+	}
+	respFinalizeBlock, err := suite.baseApp.FinalizeBlock(reqFinalizeBlock)
+	require.NoError(t, err)
+	require.Equal(t, int64(4+3), executions.Load())
+
+	// the block results are those of an execution without reuse
+	expSuite, expExecutions := newApp()
+	expRespFinalizeBlock, err := expSuite.baseApp.FinalizeBlock(reqFinalizeBlock)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), expExecutions.Load())
+	require.Equal(t, expRespFinalizeBlock.TxResults, respFinalizeBlock.TxResults)
+	require.Equal(t, expRespFinalizeBlock.AppHash, respFinalizeBlock.AppHash)
+}
+
 func TestABCI_Proposal_FailReCheckTx(t *testing.T) {
 	pool := mempool.NewPriorityMempool[int64](mempool.PriorityNonceMempoolConfig[int64]{
 		TxPriority:      mempool.NewDefaultTxPriority(),
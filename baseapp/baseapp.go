@@ -189,6 +189,10 @@ type BaseApp struct {
 	// by developers.
 	optimisticExec *oe.OptimisticExecution
 
+	// txResultCache holds the tx results reusable by the FinalizeBlock runs of a
+	// height, it is nil unless enabled with the OE.
+	txResultCache *txResultCache
+
 	// includeNestedMsgsGas holds a set of message types for which gas costs for its nested messages are calculated.
 	includeNestedMsgsGas map[string]struct{}
 }
@@ -769,6 +773,7 @@ func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
 		telemetry.IncrCounter(1, "tx", resultStr)
 		telemetry.SetGauge(float32(gInfo.GasUsed), "tx", "gas", "used")
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
+		telemetry.RecordTxGasUsed(gInfo.GasUsed, resultStr == "successful")
 	}()
 
 	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx, nil)
@@ -836,12 +841,29 @@ type HasNestedMsgs interface {
 // both txbytes and the decoded tx are passed to runTx to avoid the state machine encoding the tx and decoding the transaction twice
 // passing the decoded tx to runTX is optional, it will be decoded if the tx is nil
 func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTxOnContext(app.getContextForTx(mode, txBytes), mode, txBytes, tx)
+}
+
+// runTxOnContext is runTx, with the context returned by getContextForTx, which
+// the caller may have modified.
+func (app *BaseApp) runTxOnContext(ctx sdk.Context, mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
+	// tracer is nil unless the tx is executed by SimulateWithTrace
+	tracer := simulationTracerFromContext(ctx)
+	spanCtx, span := telemetry.StartSpan(ctx.Context(), "Tx")
+	ctx = ctx.WithContext(spanCtx)
+	defer func() {
+		span.SetAttributes(
+			telemetry.AttributeKeyGasWanted.Int64(int64(gInfo.GasWanted)),
+			telemetry.AttributeKeyGasUsed.Int64(int64(gInfo.GasUsed)),
+		)
+		telemetry.EndSpan(span, err)
+	}()
+
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -918,6 +940,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.G
 		if mode == execModeSimulate {
 			anteCtx = anteCtx.WithExecMode(sdk.ExecMode(execModeSimulate))
 		}
+		anteCtx, anteFrame := tracer.enterAnte(anteCtx)
 		newCtx, err := app.anteHandler(anteCtx, tx, mode == execModeSimulate)
 
 		if !newCtx.IsZero() {
@@ -934,6 +957,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.G
 
 		// GasMeter expected to be set in AnteHandler
 		gasWanted = ctx.GasMeter().Limit()
+		if tracer != nil {
+			tracer.exit(anteFrame, ctx.GasMeter().GasConsumed(), events.ToABCIEvents(), err)
+		}
 
 		if err != nil {
 			if mode == execModeReCheck {
@@ -977,7 +1003,7 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.G
 
 	if mode == execModeSimulate {
 		for _, msg := range msgs {
-			nestedErr := app.simulateNestedMessages(ctx, msg)
+			nestedErr := app.simulateNestedMessages(withoutSimulationTracer(ctx), msg)
 			if nestedErr != nil {
 				return gInfo, nil, anteEvents, nestedErr
 			}
@@ -992,8 +1018,16 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.G
 		// We clear this to correctly order events without duplicates.
 		// Note that the state is still preserved.
 		postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
+		postCtx, postFrame := tracer.enterPost(postCtx)
+		postGasBefore := postCtx.GasMeter().GasConsumed()
 
 		newCtx, errPostHandler := app.postHandler(postCtx, tx, mode == execModeSimulate, err == nil)
+		if tracer != nil {
+			if !newCtx.IsZero() {
+				postCtx = newCtx
+			}
+			tracer.exit(postFrame, postCtx.GasMeter().GasConsumed()-postGasBefore, postCtx.EventManager().ABCIEvents(), errPostHandler)
+		}
 		if errPostHandler != nil {
 			return gInfo, nil, anteEvents, errors.Join(err, errPostHandler)
 		}
@@ -0,0 +1,146 @@
+package baseapp
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/mempool/lanes"
+)
+
+// LanedProposalHandler is a DefaultProposalHandler building blocks out of the
+// lanes of a mempool.LanedMempool. Each lane gets at most its share of the block
+// bytes and gas, and the transactions of a block are grouped by lane, in the
+// lanes order. Its ProcessProposal handler verifies this before the default
+// verification.
+type LanedProposalHandler struct {
+	*DefaultProposalHandler
+	lanes      []mempool.Lane
+	specs      []lanes.Spec
+	txVerifier ProposalTxVerifier
+}
+
+// NewLanedProposalHandler returns a LanedProposalHandler of the lanes of mp.
+func NewLanedProposalHandler(mp *mempool.LanedMempool, txVerifier ProposalTxVerifier) *LanedProposalHandler {
+	h := NewDefaultProposalHandler(mp, txVerifier)
+	h.SetTxSelector(NewLaneTxSelector(mp.Lanes()))
+	return &LanedProposalHandler{
+		DefaultProposalHandler: h,
+		lanes:                  mp.Lanes(),
+		specs:                  mempool.LaneSpecs(mp.Lanes()),
+		txVerifier:             txVerifier,
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposal handler rejecting the
+// proposals whose transactions match no lane or are not grouped by lane in the
+// lanes order, use more than the share of a lane of the block bytes or gas, or
+// fail the verification of their lane. The other proposals are verified by the
+// DefaultProposalHandler.
+//
+// Note, the byte share of a lane is checked against the block max bytes, as
+// the max tx bytes of PrepareProposal are not known.
+func (h *LanedProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	next := h.DefaultProposalHandler.ProcessProposalHandler()
+	reject := &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}
+
+	return func(ctx sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+		var maxBlockBytes, maxBlockGas uint64
+		if b := ctx.ConsensusParams().Block; b != nil { //nolint:staticcheck // ignore linting error
+			if b.MaxBytes > 0 {
+				maxBlockBytes = uint64(b.MaxBytes)
+			}
+			if b.MaxGas > 0 {
+				maxBlockGas = uint64(b.MaxGas)
+			}
+		}
+
+		checker := lanes.NewProposalChecker(h.specs, maxBlockBytes, maxBlockGas)
+		laneTxs := make([][]sdk.Tx, len(h.lanes))
+		for _, txBz := range req.Txs {
+			tx, err := h.txVerifier.TxDecode(txBz)
+			if err != nil {
+				return reject, nil
+			}
+
+			lane := mempool.LaneIndex(ctx, h.lanes, tx)
+			var txGas uint64
+			if gasTx, ok := tx.(GasTx); ok {
+				txGas = gasTx.GetGas()
+			}
+			if err := checker.Add(lane, uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz})), txGas); err != nil {
+				return reject, nil
+			}
+			laneTxs[lane] = append(laneTxs[lane], tx)
+		}
+
+		for i, lane := range h.lanes {
+			if lane.VerifyTxs == nil {
+				continue
+			}
+			if err := lane.VerifyTxs(ctx, laneTxs[i]); err != nil {
+				return reject, nil
+			}
+		}
+
+		return next(ctx, req)
+	}
+}
+
+// laneTxSelector is a TxSelector giving each lane at most its share of the
+// block bytes and gas.
+type laneTxSelector struct {
+	lanes   []mempool.Lane
+	budget  *lanes.Budget
+	laneTxs [][][]byte
+}
+
+// NewLaneTxSelector returns a TxSelector selecting the transactions of the
+// lanes, each within its share of the block bytes and gas. The transactions
+// matching no lane are skipped, and the selected transactions are grouped by
+// lane, in the lanes order.
+func NewLaneTxSelector(ls []mempool.Lane) TxSelector {
+	ts := &laneTxSelector{lanes: ls, budget: lanes.NewBudget(mempool.LaneSpecs(ls))}
+	ts.Clear()
+	return ts
+}
+
+func (ts *laneTxSelector) SelectedTxs(_ context.Context) [][]byte {
+	var txs [][]byte
+	for _, laneTxs := range ts.laneTxs {
+		txs = append(txs, laneTxs...)
+	}
+	return txs
+}
+
+func (ts *laneTxSelector) Clear() {
+	ts.budget.Reset()
+	ts.laneTxs = make([][][]byte, len(ts.lanes))
+}
+
+func (ts *laneTxSelector) SelectTxForProposal(ctx context.Context, maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool {
+	lane := -1
+	if memTx != nil {
+		lane = mempool.LaneIndex(ctx, ts.lanes, memTx)
+	}
+
+	if lane >= 0 {
+		txSize := uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+		var txGasLimit uint64
+		if gasTx, ok := memTx.(GasTx); ok {
+			txGasLimit = gasTx.GetGas()
+		}
+
+		// only add the transaction to the proposal if both the block and the lane
+		// have enough capacity
+		if ts.budget.Add(lane, txSize, txGasLimit, maxTxBytes, maxBlockGas) {
+			ts.laneTxs[lane] = append(ts.laneTxs[lane], txBz)
+		}
+	}
+
+	// check if we've reached capacity; if so, we cannot select any more transactions
+	return ts.budget.Full(maxTxBytes, maxBlockGas)
+}
@@ -0,0 +1,146 @@
+package baseapp_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+func (s *ABCIUtilsTestSuite) TestLanedProposalHandler() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	signingCtx := cdc.InterfaceRegistry().SigningContext()
+	txConfig := authtx.NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), authtx.DefaultSignModes)
+
+	// txs whose value starts with "p" go to the priority lane, the others to
+	// the default lane
+	matchPriority := func(_ context.Context, tx sdk.Tx) bool {
+		msg, ok := tx.GetMsgs()[0].(*baseapptestutil.MsgKeyValue)
+		return ok && bytes.HasPrefix(msg.Value, []byte("p"))
+	}
+	type testTx struct {
+		tx   sdk.Tx
+		bz   []byte
+		size uint64
+	}
+	newTestTx := func(value, secret string) testTx {
+		tx := buildMsg(s.T(), txConfig, signingCtx.AddressCodec(), []byte(value), [][]byte{[]byte(secret)}, []uint64{1})
+		bz, err := txConfig.TxEncoder()(tx)
+		s.Require().NoError(err)
+		return testTx{tx: tx, bz: bz, size: uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{bz}))}
+	}
+	testTxs := []testTx{
+		newTestTx("d0", "secret0"),
+		newTestTx("d1", "secret1"),
+		newTestTx("p2", "secret2"),
+		newTestTx("p3", "secret3"),
+		newTestTx("p4", "secret4"),
+	}
+	for _, tx := range testTxs {
+		s.Require().Equal(testTxs[0].size, tx.size)
+	}
+	txSize := testTxs[0].size
+
+	var verifyErr error
+	newHandler := func(app baseapp.ProposalTxVerifier) (*baseapp.LanedProposalHandler, *mempool.LanedMempool) {
+		mp, err := mempool.NewLanedMempool(
+			mempool.Lane{
+				Name:          "priority",
+				Mempool:       mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(0)),
+				Match:         matchPriority,
+				MaxBlockSpace: math.LegacyNewDecWithPrec(5, 1),
+				VerifyTxs:     func(sdk.Context, []sdk.Tx) error { return verifyErr },
+			},
+			mempool.Lane{
+				Name:          "default",
+				Mempool:       mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(0)),
+				Match:         mempool.MatchAll,
+				MaxBlockSpace: math.LegacyOneDec(),
+			},
+		)
+		s.Require().NoError(err)
+		return baseapp.NewLanedProposalHandler(mp, app), mp
+	}
+
+	ctrl := gomock.NewController(s.T())
+	app := mock.NewMockProposalTxVerifier(ctrl)
+	for _, v := range testTxs {
+		app.EXPECT().TxDecode(v.bz).Return(v.tx, nil).AnyTimes()
+		app.EXPECT().PrepareProposalVerifyTx(v.tx).Return(v.bz, nil).AnyTimes()
+		app.EXPECT().ProcessProposalVerifyTx(v.bz).Return(v.tx, nil).AnyTimes()
+	}
+
+	s.Run("prepare", func() {
+		ph, mp := newHandler(app)
+		for _, v := range testTxs {
+			s.Require().NoError(mp.Insert(s.ctx, v.tx))
+		}
+		s.Require().Equal(len(testTxs), mp.CountTx())
+
+		// the priority lane gets at most half of the block, i.e. 2 txs, and
+		// comes first
+		resp, err := ph.PrepareProposalHandler()(s.ctx, &abci.PrepareProposalRequest{MaxTxBytes: int64(4 * txSize)})
+		s.Require().NoError(err)
+		s.Require().Len(resp.Txs, 4)
+		for i, bz := range resp.Txs {
+			tx, err := app.TxDecode(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(i/2, mempool.LaneIndex(s.ctx, mp.Lanes(), tx), "tx %d", i)
+		}
+	})
+
+	ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: int64(4 * txSize), MaxGas: -1},
+	})
+	testCases := map[string]struct {
+		txs       []testTx
+		verifyErr error
+		expStatus abci.ProcessProposalStatus
+	}{
+		"lanes in order": {
+			txs:       []testTx{testTxs[2], testTxs[3], testTxs[0], testTxs[1]},
+			expStatus: abci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		"lanes out of order": {
+			txs:       []testTx{testTxs[2], testTxs[0], testTxs[3]},
+			expStatus: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		"lane above its share": {
+			txs:       []testTx{testTxs[2], testTxs[3], testTxs[4]},
+			expStatus: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		"lane verification failure": {
+			txs:       []testTx{testTxs[2], testTxs[0]},
+			verifyErr: errors.New("invalid oracle data"),
+			expStatus: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			verifyErr = tc.verifyErr
+			ph, _ := newHandler(app)
+			req := &abci.ProcessProposalRequest{}
+			for _, v := range tc.txs {
+				req.Txs = append(req.Txs, v.bz)
+			}
+			resp, err := ph.ProcessProposalHandler()(ctx, req)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expStatus, resp.Status)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
@@ -16,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp/internal/protocompat"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -111,6 +113,7 @@ func (msr *MsgServiceRouter) registerHybridHandler(sd *grpc.ServiceDesc, method
 	if err != nil {
 		return err
 	}
+	hybridHandler = traceHybridHandler(hybridHandler)
 	// map input name to output name
 	msr.responseByMsgName[string(inputName)] = string(outputName)
 	// if circuit breaker is not nil, then we decorate the hybrid handler with the circuit breaker
@@ -190,8 +193,20 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 		)
 	}
 
-	msr.routes[requestTypeName] = func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
-		ctx = ctx.WithEventManager(sdk.NewEventManager())
+	moduleName := sdk.GetModuleNameFromTypeURL(requestTypeName)
+	msr.routes[requestTypeName] = traceMsgHandler(func(ctx sdk.Context, msg sdk.Msg) (_ *sdk.Result, err error) {
+		defer telemetry.RecordMsgDuration(moduleName, requestTypeName, time.Now())
+		spanCtx, span := telemetry.StartSpan(ctx.Context(), requestTypeName,
+			telemetry.AttributeKeyModule.String(moduleName),
+			telemetry.AttributeKeyMsgType.String(requestTypeName),
+		)
+		gasBefore := ctx.GasMeter().GasConsumed()
+		defer func() {
+			span.SetAttributes(telemetry.AttributeKeyGasUsed.Int64(int64(ctx.GasMeter().GasConsumed() - gasBefore)))
+			telemetry.EndSpan(span, err)
+		}()
+
+		ctx = ctx.WithContext(spanCtx).WithEventManager(sdk.NewEventManager())
 		interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 			goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
 			return handler(goCtx, msg)
@@ -241,7 +256,7 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 			Events:       events,
 			MsgResponses: []*codectypes.Any{anyResp},
 		}, nil
-	}
+	})
 	return nil
 }
 
@@ -11,6 +11,8 @@ import (
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 
 	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 // FinalizeBlockFunc is the function that is called by the OE to finalize the
@@ -31,6 +33,8 @@ type OptimisticExecution struct {
 	cancelFunc  func() // cancel function for the context
 	initialized bool   // A boolean value indicating whether the struct has been initialized
 
+	reuseTxResults bool // whether the tx results of an aborted OE can be reused
+
 	// debugging/testing options
 	abortRate int // number from 0 to 100 that determines the percentage of OE that should be aborted
 }
@@ -54,6 +58,22 @@ func WithAbortRate(rate int) func(*OptimisticExecution) {
 	}
 }
 
+// WithTxResultReuse enables the reuse of tx results across FinalizeBlock runs
+// of the same height. When an OE is aborted because the proposal changed, the
+// txs of the new proposal whose pre-state reads and read header fields are
+// unchanged get the results of their optimistic execution instead of being
+// executed again.
+func WithTxResultReuse() func(*OptimisticExecution) {
+	return func(oe *OptimisticExecution) {
+		oe.reuseTxResults = true
+	}
+}
+
+// TxResultReuse returns true if the reuse of tx results is enabled.
+func (oe *OptimisticExecution) TxResultReuse() bool {
+	return oe != nil && oe.reuseTxResults
+}
+
 // Reset resets the OE context. Must be called whenever we want to invalidate
 // the current OE.
 func (oe *OptimisticExecution) Reset() {
@@ -131,15 +151,18 @@ func (oe *OptimisticExecution) AbortIfNeeded(reqHash []byte) bool {
 	if !bytes.Equal(oe.request.Hash, reqHash) {
 		oe.logger.Error("OE aborted due to hash mismatch", "oe_hash", hex.EncodeToString(oe.request.Hash), "req_hash", hex.EncodeToString(reqHash), "oe_height", oe.request.Height, "req_height", oe.request.Height)
 		oe.cancelFunc()
+		telemetry.IncrCounter(1, "oe", "aborted")
 		return true
 	} else if oe.abortRate > 0 && rand.Intn(100) < oe.abortRate {
 		// this is for test purposes only, we can emulate a certain percentage of
 		// OE needed to be aborted.
 		oe.cancelFunc()
 		oe.logger.Error("OE aborted due to test abort rate")
+		telemetry.IncrCounter(1, "oe", "aborted")
 		return true
 	}
 
+	telemetry.IncrCounter(1, "oe", "used")
 	return false
 }
 
@@ -32,3 +32,10 @@ func TestOptimisticExecution(t *testing.T) {
 
 	oe.Reset()
 }
+
+func TestTxResultReuse(t *testing.T) {
+	var oe *OptimisticExecution
+	assert.False(t, oe.TxResultReuse())
+	assert.False(t, NewOptimisticExecution(log.NewNopLogger(), testFinalizeBlock).TxResultReuse())
+	assert.True(t, NewOptimisticExecution(log.NewNopLogger(), testFinalizeBlock, WithTxResultReuse()).TxResultReuse())
+}
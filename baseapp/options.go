@@ -121,6 +121,9 @@ func SetStoreLoader(loader StoreLoader) func(*BaseApp) {
 func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp) {
 	return func(app *BaseApp) {
 		app.optimisticExec = oe.NewOptimisticExecution(app.logger, app.internalFinalizeBlock, opts...)
+		if app.optimisticExec.TxResultReuse() {
+			app.txResultCache = newTxResultCache()
+		}
 	}
 }
 
@@ -0,0 +1,79 @@
+package oracle
+
+import (
+	"sort"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+
+	"cosmossdk.io/math"
+)
+
+// WeightedPrice is a price reported with the given voting power.
+type WeightedPrice struct {
+	Price math.LegacyDec
+	Power int64
+}
+
+// StakeWeightedMedian returns the lowest price such that the prices up to it
+// have at least half of the total power, or a nil LegacyDec if there are no
+// prices with a positive power.
+func StakeWeightedMedian(prices []WeightedPrice) math.LegacyDec {
+	sorted := make([]WeightedPrice, 0, len(prices))
+	var total int64
+	for _, p := range prices {
+		if p.Power > 0 {
+			sorted = append(sorted, p)
+			total += p.Power
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Price.LT(sorted[j].Price) })
+
+	var cumulative int64
+	for _, p := range sorted {
+		cumulative += p.Power
+		if 2*cumulative >= total {
+			return p.Price
+		}
+	}
+	return math.LegacyDec{}
+}
+
+// AggregatePrices returns the stake-weighted median prices of the vote
+// extensions of a commit. The price of a pair is only aggregated if validators
+// with more than half of the voting power of the commit reported it, so that a
+// minority can't set it. The vote extensions which can't be decoded are
+// ignored.
+func AggregatePrices(extCommit abci.ExtendedCommitInfo) map[string]math.LegacyDec {
+	var totalPower int64
+	reports := make(map[string][]WeightedPrice)
+	for _, vote := range extCommit.Votes {
+		totalPower += vote.Validator.Power
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+		ve, err := DecodeVoteExtension(vote.VoteExtension)
+		if err != nil {
+			continue
+		}
+		for pair, price := range ve.Prices {
+			if price.IsNil() || !price.IsPositive() {
+				continue
+			}
+			reports[pair] = append(reports[pair], WeightedPrice{Price: price, Power: vote.Validator.Power})
+		}
+	}
+
+	prices := make(map[string]math.LegacyDec, len(reports))
+	for pair, weighted := range reports {
+		var power int64
+		for _, p := range weighted {
+			power += p.Power
+		}
+		if 2*power <= totalPower {
+			continue
+		}
+		prices[pair] = StakeWeightedMedian(weighted)
+	}
+	return prices
+}
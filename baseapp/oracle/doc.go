@@ -0,0 +1,35 @@
+/*
+Package oracle is a framework feeding validator observed prices to the state
+machine through vote extensions.
+
+  - Each validator reports, in its vote extension, the prices of its Providers,
+    e.g. exchange APIs or the MockProvider of tests, with the
+    VoteExtensionHandler ExtendVote handler. The providers are queried
+    concurrently, and those not returning within the provider timeout are
+    skipped. The VerifyVoteExtension handler rejects the malformed vote
+    extensions.
+  - The proposer of the next block injects the vote extensions of the last
+    commit as the first tx of its proposal, with the ProposalHandler
+    PrepareProposal handler, and the other validators verify them with its
+    ProcessProposal handler.
+  - The PreBlocker aggregates the prices of the injected vote extensions with a
+    stake-weighted median, and writes them to the Keeper, from which modules
+    read them. Clients query them with the Query service.
+
+Wiring it in an app:
+
+	oracleKeeper := oracle.NewKeeper(runtime.NewKVStoreService(keys[oracleStoreKey]))
+	oracle.RegisterQueryServer(app.GRPCQueryRouter(), oracle.NewQueryServer(oracleKeeper))
+	veHandler := oracle.NewVoteExtensionHandler(logger, []string{"ATOM/USD"}, provider)
+	veHandler.SetProviderTimeout(oracle.DefaultProviderTimeout)
+	app.SetExtendVoteHandler(veHandler.ExtendVoteHandler())
+	app.SetVerifyVoteExtensionHandler(veHandler.VerifyVoteExtensionHandler())
+
+	defaultHandler := baseapp.NewDefaultProposalHandler(mempool, app)
+	proposalHandler := oracle.NewProposalHandler(logger, stakingKeeper,
+		defaultHandler.PrepareProposalHandler(), defaultHandler.ProcessProposalHandler())
+	app.SetPrepareProposal(proposalHandler.PrepareProposalHandler())
+	app.SetProcessProposal(proposalHandler.ProcessProposalHandler())
+	app.SetPreBlocker(oracle.NewPreBlocker(oracleKeeper, app.PreBlocker))
+*/
+package oracle
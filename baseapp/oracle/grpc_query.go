@@ -0,0 +1,79 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ QueryServer = queryServer{}
+
+type queryServer struct {
+	k Keeper
+}
+
+// NewQueryServer returns the gRPC query server of the prices of the keeper, to
+// register with RegisterQueryServer on the gRPC query router of the app.
+func NewQueryServer(k Keeper) QueryServer {
+	return queryServer{k: k}
+}
+
+// Price implements the Query/Price gRPC method.
+func (q queryServer) Price(ctx context.Context, req *QueryPriceRequest) (*QueryPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Pair == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty pair")
+	}
+
+	price, err := q.k.Prices.Get(ctx, req.Pair)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no price of %q", req.Pair)
+	} else if err != nil {
+		return nil, err
+	}
+	height, err := q.lastUpdateHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryPriceResponse{Price: price, LastUpdateHeight: height}, nil
+}
+
+// Prices implements the Query/Prices gRPC method.
+func (q queryServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	prices, pageRes, err := query.CollectionPaginate(ctx, q.k.Prices, req.Pagination, func(pair string, price math.LegacyDec) (PairPrice, error) {
+		return PairPrice{Pair: pair, Price: price}, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	height, err := q.lastUpdateHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryPricesResponse{Prices: prices, LastUpdateHeight: height, Pagination: pageRes}, nil
+}
+
+// lastUpdateHeight returns the height of the last aggregation, 0 if the prices
+// were never aggregated.
+func (q queryServer) lastUpdateHeight(ctx context.Context) (int64, error) {
+	height, err := q.k.LastUpdateHeight.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return height, err
+}
@@ -0,0 +1,79 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// PricesPrefix is the prefix of the aggregated prices.
+	PricesPrefix = collections.NewPrefix(0)
+	// LastUpdateHeightPrefix is the prefix of the height of the last aggregation.
+	LastUpdateHeightPrefix = collections.NewPrefix(1)
+)
+
+// Keeper stores the aggregated prices. The price of a pair is kept until it is
+// aggregated again.
+type Keeper struct {
+	Schema collections.Schema
+	// Prices are the last aggregated prices, by pair.
+	Prices collections.Map[string, math.LegacyDec]
+	// LastUpdateHeight is the height of the last aggregation.
+	LastUpdateHeight collections.Item[int64]
+}
+
+// NewKeeper returns a Keeper storing the prices in the given store.
+func NewKeeper(storeService store.KVStoreService) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		Prices:           collections.NewMap(sb, PricesPrefix, "prices", collections.StringKey, sdk.LegacyDecValue),
+		LastUpdateHeight: collections.NewItem(sb, LastUpdateHeightPrefix, "last_update_height", collections.Int64Value),
+	}
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// SetPrices stores the prices aggregated at the given height.
+func (k Keeper) SetPrices(ctx context.Context, height int64, prices map[string]math.LegacyDec) error {
+	pairs := make([]string, 0, len(prices))
+	for pair := range prices {
+		pairs = append(pairs, pair)
+	}
+	sort.Strings(pairs)
+	for _, pair := range pairs {
+		if err := k.Prices.Set(ctx, pair, prices[pair]); err != nil {
+			return err
+		}
+	}
+	return k.LastUpdateHeight.Set(ctx, height)
+}
+
+// Price returns the last aggregated price of a pair.
+func (k Keeper) Price(ctx context.Context, pair string) (math.LegacyDec, error) {
+	price, err := k.Prices.Get(ctx, pair)
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("no price of %q: %w", pair, err)
+	}
+	return price, nil
+}
+
+// AllPrices returns the last aggregated prices, by pair.
+func (k Keeper) AllPrices(ctx context.Context) (map[string]math.LegacyDec, error) {
+	prices := make(map[string]math.LegacyDec)
+	err := k.Prices.Walk(ctx, nil, func(pair string, price math.LegacyDec) (bool, error) {
+		prices[pair] = price
+		return false, nil
+	})
+	return prices, err
+}
@@ -0,0 +1,331 @@
+package oracle_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtprotocrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtsecp256k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	protoio "github.com/cosmos/gogoproto/io"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/oracle"
+	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+const chainID = "chain-id"
+
+func dec(s string) math.LegacyDec {
+	return math.LegacyMustNewDecFromStr(s)
+}
+
+func TestStakeWeightedMedian(t *testing.T) {
+	testCases := map[string]struct {
+		prices []oracle.WeightedPrice
+		exp    math.LegacyDec
+	}{
+		"no prices": {exp: math.LegacyDec{}},
+		"single price": {
+			prices: []oracle.WeightedPrice{{Price: dec("1.5"), Power: 10}},
+			exp:    dec("1.5"),
+		},
+		"majority price": {
+			prices: []oracle.WeightedPrice{{Price: dec("1"), Power: 10}, {Price: dec("3"), Power: 60}, {Price: dec("2"), Power: 30}},
+			exp:    dec("3"),
+		},
+		"median by power": {
+			prices: []oracle.WeightedPrice{{Price: dec("4"), Power: 20}, {Price: dec("1"), Power: 30}, {Price: dec("2"), Power: 25}, {Price: dec("3"), Power: 25}},
+			exp:    dec("2"),
+		},
+		"half of the power": {
+			prices: []oracle.WeightedPrice{{Price: dec("2"), Power: 50}, {Price: dec("1"), Power: 50}},
+			exp:    dec("1"),
+		},
+		"no power": {
+			prices: []oracle.WeightedPrice{{Price: dec("1"), Power: 0}, {Price: dec("2"), Power: 10}},
+			exp:    dec("2"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := oracle.StakeWeightedMedian(tc.prices)
+			if tc.exp.IsNil() {
+				require.True(t, got.IsNil())
+				return
+			}
+			require.Equal(t, tc.exp.String(), got.String())
+		})
+	}
+}
+
+func encodeVoteExtension(t *testing.T, prices map[string]math.LegacyDec) []byte {
+	t.Helper()
+	bz, err := oracle.EncodeVoteExtension(oracle.VoteExtension{Prices: prices})
+	require.NoError(t, err)
+	return bz
+}
+
+func TestAggregatePrices(t *testing.T) {
+	vote := func(power int64, ve []byte) abci.ExtendedVoteInfo {
+		return abci.ExtendedVoteInfo{
+			Validator:     abci.Validator{Power: power},
+			VoteExtension: ve,
+			BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+		}
+	}
+	extCommit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		vote(40, encodeVoteExtension(t, map[string]math.LegacyDec{"ATOM/USD": dec("10"), "BTC/USD": dec("100")})),
+		vote(30, encodeVoteExtension(t, map[string]math.LegacyDec{"ATOM/USD": dec("12")})),
+		vote(20, encodeVoteExtension(t, map[string]math.LegacyDec{"ATOM/USD": dec("11"), "ETH/USD": dec("-1")})),
+		vote(5, []byte("invalid")),
+		{Validator: abci.Validator{Power: 5}, BlockIdFlag: cmtproto.BlockIDFlagAbsent},
+	}}
+
+	// BTC/USD is reported by 40% of the power only
+	prices := oracle.AggregatePrices(extCommit)
+	require.Len(t, prices, 1)
+	require.Equal(t, dec("11").String(), prices["ATOM/USD"].String())
+}
+
+func TestVoteExtensionHandler(t *testing.T) {
+	failing := oracle.NewMockProvider("failing", nil)
+	failing.SetError(errors.New("unavailable"))
+	h := oracle.NewVoteExtensionHandler(log.NewNopLogger(), []string{"ATOM/USD", "BTC/USD"},
+		oracle.NewMockProvider("a", map[string]math.LegacyDec{"ATOM/USD": dec("10"), "ETH/USD": dec("1")}),
+		oracle.NewMockProvider("b", map[string]math.LegacyDec{"ATOM/USD": dec("12"), "BTC/USD": dec("100")}),
+		oracle.NewMockProvider("c", map[string]math.LegacyDec{"ATOM/USD": dec("11")}),
+		failing,
+	)
+
+	resp, err := h.ExtendVoteHandler()(sdk.Context{}.WithContext(context.Background()), &abci.ExtendVoteRequest{Height: 2})
+	require.NoError(t, err)
+	ve, err := oracle.DecodeVoteExtension(resp.VoteExtension)
+	require.NoError(t, err)
+	require.Len(t, ve.Prices, 2)
+	require.Equal(t, dec("11").String(), ve.Prices["ATOM/USD"].String())
+	require.Equal(t, dec("100").String(), ve.Prices["BTC/USD"].String())
+
+	h.SetMaxVoteExtensionSize(len(resp.VoteExtension))
+	testCases := map[string]struct {
+		ve        []byte
+		expStatus abci.VerifyVoteExtensionStatus
+	}{
+		"valid":          {ve: resp.VoteExtension, expStatus: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT},
+		"empty":          {expStatus: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT},
+		"malformed":      {ve: []byte("{"), expStatus: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		"unknown pair":   {ve: encodeVoteExtension(t, map[string]math.LegacyDec{"ETH/USD": dec("1")}), expStatus: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		"negative price": {ve: encodeVoteExtension(t, map[string]math.LegacyDec{"ATOM/USD": dec("-1")}), expStatus: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+		"too large":      {ve: append(bytes.Clone(resp.VoteExtension), " "...), expStatus: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp, err := h.VerifyVoteExtensionHandler()(sdk.Context{}, &abci.VerifyVoteExtensionRequest{VoteExtension: tc.ve})
+			require.NoError(t, err)
+			require.Equal(t, tc.expStatus, resp.Status)
+		})
+	}
+}
+
+// slowProvider is a Provider which does not return until released, ignoring
+// the context.
+type slowProvider struct {
+	release chan struct{}
+}
+
+func (p slowProvider) Name() string { return "slow" }
+
+func (p slowProvider) Prices(context.Context, []string) (map[string]math.LegacyDec, error) {
+	<-p.release
+	return map[string]math.LegacyDec{"ATOM/USD": dec("1000")}, nil
+}
+
+func TestVoteExtensionHandlerProviderTimeout(t *testing.T) {
+	slow := slowProvider{release: make(chan struct{})}
+	defer close(slow.release)
+	h := oracle.NewVoteExtensionHandler(log.NewNopLogger(), []string{"ATOM/USD"},
+		slow,
+		oracle.NewMockProvider("a", map[string]math.LegacyDec{"ATOM/USD": dec("10")}),
+	)
+	h.SetProviderTimeout(50 * time.Millisecond)
+
+	start := time.Now()
+	resp, err := h.ExtendVoteHandler()(sdk.Context{}.WithContext(context.Background()), &abci.ExtendVoteRequest{Height: 2})
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), 5*time.Second)
+	ve, err := oracle.DecodeVoteExtension(resp.VoteExtension)
+	require.NoError(t, err)
+	require.Len(t, ve.Prices, 1)
+	require.Equal(t, dec("10").String(), ve.Prices["ATOM/USD"].String())
+}
+
+type testValidator struct {
+	privKey cmtsecp256k1.PrivKey
+	power   int64
+}
+
+func (v testValidator) consAddr() sdk.ConsAddress {
+	return sdk.ConsAddress(v.privKey.PubKey().Address())
+}
+
+// signedVote returns the commit vote of the validator at height-1 with the
+// given vote extension.
+func (v testValidator) signedVote(t *testing.T, height int64, ve []byte) abci.ExtendedVoteInfo {
+	t.Helper()
+	var buf bytes.Buffer
+	err := protoio.NewDelimitedWriter(&buf).WriteMsg(&cmtproto.CanonicalVoteExtension{
+		Extension: ve,
+		Height:    height - 1,
+		ChainId:   chainID,
+	})
+	require.NoError(t, err)
+	sig, err := v.privKey.Sign(buf.Bytes())
+	require.NoError(t, err)
+	return abci.ExtendedVoteInfo{
+		Validator:          abci.Validator{Address: v.consAddr(), Power: v.power},
+		VoteExtension:      ve,
+		ExtensionSignature: sig,
+		BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+	}
+}
+
+func TestProposalHandler(t *testing.T) {
+	vals := []testValidator{
+		{privKey: cmtsecp256k1.GenPrivKey(), power: 500},
+		{privKey: cmtsecp256k1.GenPrivKey(), power: 300},
+		{privKey: cmtsecp256k1.GenPrivKey(), power: 200},
+	}
+	valStore := mock.NewMockValidatorStore(gomock.NewController(t))
+	for _, val := range vals {
+		pk, err := cryptocodec.FromCmtProtoPublicKey(cmtprotocrypto.PublicKey{
+			Sum: &cmtprotocrypto.PublicKey_Secp256K1{Secp256K1: val.privKey.PubKey().Bytes()},
+		})
+		require.NoError(t, err)
+		valStore.EXPECT().GetPubKeyByConsAddr(gomock.Any(), val.consAddr()).Return(pk, nil).AnyTimes()
+	}
+
+	const height = 3
+	extCommit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		vals[0].signedVote(t, height, encodeVoteExtension(t, map[string]math.LegacyDec{"ATOM/USD": dec("11")})),
+		vals[1].signedVote(t, height, encodeVoteExtension(t, map[string]math.LegacyDec{"ATOM/USD": dec("10")})),
+		vals[2].signedVote(t, height, encodeVoteExtension(t, map[string]math.LegacyDec{"ATOM/USD": dec("12")})),
+	}}
+
+	key := storetypes.NewKVStoreKey("oracle")
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx.
+		WithConsensusParams(cmtproto.ConsensusParams{
+			Feature: &cmtproto.FeatureParams{VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: 2}},
+		}).
+		WithHeaderInfo(header.Info{Height: height, ChainID: chainID}).
+		WithCometInfo(comet.Info{LastCommit: sdk.ToSDKExtendedCommitInfo(extCommit)})
+
+	txs := [][]byte{[]byte("tx1"), []byte("tx2")}
+	var processedTxs [][]byte
+	h := oracle.NewProposalHandler(log.NewNopLogger(), valStore,
+		func(_ sdk.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
+			return &abci.PrepareProposalResponse{Txs: req.Txs}, nil
+		},
+		func(_ sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+			processedTxs = req.Txs
+			return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_ACCEPT}, nil
+		},
+	)
+
+	prepResp, err := h.PrepareProposalHandler()(ctx, &abci.PrepareProposalRequest{
+		Txs:             txs,
+		MaxTxBytes:      1 << 20,
+		LocalLastCommit: extCommit,
+		Height:          height,
+	})
+	require.NoError(t, err)
+	require.Len(t, prepResp.Txs, 3)
+	require.Equal(t, txs, prepResp.Txs[1:])
+
+	resp, err := h.ProcessProposalHandler()(ctx, &abci.ProcessProposalRequest{Txs: prepResp.Txs, Height: height})
+	require.NoError(t, err)
+	require.Equal(t, abci.PROCESS_PROPOSAL_STATUS_ACCEPT, resp.Status)
+	require.Equal(t, txs, processedTxs)
+
+	// proposals without valid injected vote extensions are rejected
+	tampered := extCommit
+	tampered.Votes = append([]abci.ExtendedVoteInfo{}, extCommit.Votes...)
+	tampered.Votes[0].VoteExtension = encodeVoteExtension(t, map[string]math.LegacyDec{"ATOM/USD": dec("1000")})
+	tamperedTx, err := oracle.EncodeInjectedTx(tampered)
+	require.NoError(t, err)
+	for name, proposal := range map[string][][]byte{
+		"no txs":              nil,
+		"no injected tx":      txs,
+		"tampered extensions": append([][]byte{tamperedTx}, txs...),
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := h.ProcessProposalHandler()(ctx, &abci.ProcessProposalRequest{Txs: proposal, Height: height})
+			require.NoError(t, err)
+			require.Equal(t, abci.PROCESS_PROPOSAL_STATUS_REJECT, resp.Status)
+		})
+	}
+
+	// the aggregated prices are written to the keeper
+	k := oracle.NewKeeper(runtime.NewKVStoreService(key))
+	nextCalled := false
+	preBlocker := oracle.NewPreBlocker(k, func(sdk.Context, *abci.FinalizeBlockRequest) error {
+		nextCalled = true
+		return nil
+	})
+	require.NoError(t, preBlocker(ctx, &abci.FinalizeBlockRequest{Txs: prepResp.Txs, Height: height}))
+	require.True(t, nextCalled)
+
+	price, err := k.Price(ctx, "ATOM/USD")
+	require.NoError(t, err)
+	require.Equal(t, dec("11").String(), price.String())
+	_, err = k.Price(ctx, "BTC/USD")
+	require.Error(t, err)
+	prices, err := k.AllPrices(ctx)
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+	lastHeight, err := k.LastUpdateHeight.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(height), lastHeight)
+
+	// the prices are queryable through the query service
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, codectypes.NewInterfaceRegistry())
+	oracle.RegisterQueryServer(queryHelper, oracle.NewQueryServer(k))
+	queryClient := oracle.NewQueryClient(queryHelper)
+
+	priceResp, err := queryClient.Price(ctx, &oracle.QueryPriceRequest{Pair: "ATOM/USD"})
+	require.NoError(t, err)
+	require.Equal(t, dec("11").String(), priceResp.Price.String())
+	require.Equal(t, int64(height), priceResp.LastUpdateHeight)
+	_, err = queryClient.Price(ctx, &oracle.QueryPriceRequest{Pair: "BTC/USD"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = queryClient.Price(ctx, &oracle.QueryPriceRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	pricesResp, err := queryClient.Prices(ctx, &oracle.QueryPricesRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, pricesResp.Prices, 1)
+	require.Equal(t, "ATOM/USD", pricesResp.Prices[0].Pair)
+	require.Equal(t, dec("11").String(), pricesResp.Prices[0].Price.String())
+	require.Equal(t, int64(height), pricesResp.LastUpdateHeight)
+	require.Equal(t, uint64(1), pricesResp.Pagination.Total)
+}
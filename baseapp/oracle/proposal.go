@@ -0,0 +1,159 @@
+package oracle
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// injectedTxPrefix prefixes the vote extensions injected in a proposal. A
+// protobuf encoded tx can't start with it, as 'o' is an invalid field tag.
+var injectedTxPrefix = []byte("oracle:")
+
+// EncodeInjectedTx encodes the extended commit injected as the first tx of a
+// proposal.
+func EncodeInjectedTx(extCommit abci.ExtendedCommitInfo) ([]byte, error) {
+	bz, err := extCommit.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return append(bytes.Clone(injectedTxPrefix), bz...), nil
+}
+
+// DecodeInjectedTx decodes the extended commit injected as the first tx of a
+// proposal.
+func DecodeInjectedTx(tx []byte) (abci.ExtendedCommitInfo, error) {
+	var extCommit abci.ExtendedCommitInfo
+	if !bytes.HasPrefix(tx, injectedTxPrefix) {
+		return extCommit, errors.New("not an injected oracle tx")
+	}
+	if err := extCommit.Unmarshal(tx[len(injectedTxPrefix):]); err != nil {
+		return extCommit, fmt.Errorf("failed to decode injected oracle tx: %w", err)
+	}
+	return extCommit, nil
+}
+
+// ProposalHandler propagates the vote extensions of the last commit to all the
+// validators. Its PrepareProposal handler injects them as the first tx of the
+// proposal, and its ProcessProposal handler verifies them, the other txs being
+// handled by the wrapped handlers. FinalizeBlock ignores the injected tx, as it
+// isn't an sdk.Tx, and PreBlocker writes the aggregated prices to the Keeper.
+type ProposalHandler struct {
+	logger   log.Logger
+	valStore baseapp.ValidatorStore
+	prepare  sdk.PrepareProposalHandler
+	process  sdk.ProcessProposalHandler
+}
+
+// NewProposalHandler returns a ProposalHandler wrapping the given handlers,
+// e.g. those of a baseapp.DefaultProposalHandler.
+func NewProposalHandler(
+	logger log.Logger,
+	valStore baseapp.ValidatorStore,
+	prepare sdk.PrepareProposalHandler,
+	process sdk.ProcessProposalHandler,
+) *ProposalHandler {
+	return &ProposalHandler{
+		logger:   logger.With(log.ModuleKey, "oracle"),
+		valStore: valStore,
+		prepare:  prepare,
+		process:  process,
+	}
+}
+
+// PrepareProposalHandler returns a PrepareProposal handler injecting the vote
+// extensions of the last commit as the first tx of the proposal.
+func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
+		if !voteExtensionsEnabled(ctx) {
+			return h.prepare(ctx, req)
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.LocalLastCommit); err != nil {
+			return nil, err
+		}
+		injectedTx, err := EncodeInjectedTx(req.LocalLastCommit)
+		if err != nil {
+			return nil, err
+		}
+
+		// leave room for the injected tx
+		wrappedReq := *req
+		wrappedReq.MaxTxBytes -= cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{injectedTx})
+		resp, err := h.prepare(ctx, &wrappedReq)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Txs = append([][]byte{injectedTx}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposal handler rejecting the
+// proposals whose first tx isn't the injected vote extensions of the last
+// commit.
+func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+		if !voteExtensionsEnabled(ctx) {
+			return h.process(ctx, req)
+		}
+
+		reject := &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}
+		if len(req.Txs) == 0 {
+			h.logger.Error("rejected proposal without injected vote extensions", "height", req.Height)
+			return reject, nil
+		}
+		extCommit, err := DecodeInjectedTx(req.Txs[0])
+		if err != nil {
+			h.logger.Error("rejected proposal with invalid injected vote extensions", "height", req.Height, "err", err)
+			return reject, nil
+		}
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, extCommit); err != nil {
+			h.logger.Error("rejected proposal with invalid injected vote extensions", "height", req.Height, "err", err)
+			return reject, nil
+		}
+
+		wrappedReq := *req
+		wrappedReq.Txs = req.Txs[1:]
+		return h.process(ctx, &wrappedReq)
+	}
+}
+
+// NewPreBlocker returns a PreBlocker writing the prices aggregated from the
+// injected vote extensions to the keeper, then calling next if it is not nil.
+func NewPreBlocker(k Keeper, next sdk.PreBlocker) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *abci.FinalizeBlockRequest) error {
+		if voteExtensionsEnabled(ctx) && len(req.Txs) > 0 {
+			if extCommit, err := DecodeInjectedTx(req.Txs[0]); err == nil {
+				if err := k.SetPrices(ctx, req.Height, AggregatePrices(extCommit)); err != nil {
+					return err
+				}
+			}
+		}
+
+		if next == nil {
+			return nil
+		}
+		return next(ctx, req)
+	}
+}
+
+// voteExtensionsEnabled returns true if the vote extensions of the last commit
+// are available, i.e. if they were enabled at the previous height.
+func voteExtensionsEnabled(ctx sdk.Context) bool {
+	cp := ctx.ConsensusParams() //nolint:staticcheck // ignore linting error
+	height := ctx.HeaderInfo().Height
+	if f := cp.Feature; f != nil && f.VoteExtensionsEnableHeight != nil && f.VoteExtensionsEnableHeight.Value != 0 {
+		return height > f.VoteExtensionsEnableHeight.Value
+	}
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight != 0 && height > cp.Abci.VoteExtensionsEnableHeight //nolint:staticcheck // ignore linting error
+}
@@ -0,0 +1,69 @@
+package oracle
+
+import (
+	"context"
+	"sync"
+
+	"cosmossdk.io/math"
+)
+
+// Provider provides the prices a validator reports in its vote extensions.
+type Provider interface {
+	// Name returns the name of the provider, used in logs.
+	Name() string
+	// Prices returns the prices of the given pairs, e.g. "ATOM/USD". The pairs
+	// the provider has no price of are omitted.
+	Prices(ctx context.Context, pairs []string) (map[string]math.LegacyDec, error)
+}
+
+var _ Provider = (*MockProvider)(nil)
+
+// MockProvider is a Provider returning locally set prices, for tests and local
+// networks.
+type MockProvider struct {
+	name string
+
+	mu     sync.Mutex
+	prices map[string]math.LegacyDec
+	err    error
+}
+
+// NewMockProvider returns a MockProvider of the given prices.
+func NewMockProvider(name string, prices map[string]math.LegacyDec) *MockProvider {
+	p := &MockProvider{name: name, prices: make(map[string]math.LegacyDec, len(prices))}
+	for pair, price := range prices {
+		p.prices[pair] = price
+	}
+	return p
+}
+
+func (p *MockProvider) Name() string { return p.name }
+
+// SetPrice sets the price of a pair.
+func (p *MockProvider) SetPrice(pair string, price math.LegacyDec) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.prices[pair] = price
+}
+
+// SetError sets the error returned by Prices, nil to return the prices again.
+func (p *MockProvider) SetError(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err = err
+}
+
+func (p *MockProvider) Prices(_ context.Context, pairs []string) (map[string]math.LegacyDec, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return nil, p.err
+	}
+	prices := make(map[string]math.LegacyDec, len(pairs))
+	for _, pair := range pairs {
+		if price, ok := p.prices[pair]; ok {
+			prices[pair] = price
+		}
+	}
+	return prices, nil
+}
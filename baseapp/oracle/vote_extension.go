@@ -0,0 +1,197 @@
+package oracle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultMaxVoteExtensionSize is the default maximum size of a vote extension.
+	DefaultMaxVoteExtensionSize = 4096
+	// DefaultProviderTimeout is the default time the providers have to return
+	// their prices in ExtendVote.
+	DefaultProviderTimeout = 500 * time.Millisecond
+)
+
+// VoteExtension is the vote extension of a validator, with the prices it
+// observed by pair.
+type VoteExtension struct {
+	Prices map[string]math.LegacyDec `json:"prices"`
+}
+
+// EncodeVoteExtension encodes a vote extension. The encoding is deterministic,
+// as the pairs are sorted.
+func EncodeVoteExtension(ve VoteExtension) ([]byte, error) {
+	return json.Marshal(ve)
+}
+
+// DecodeVoteExtension decodes a vote extension.
+func DecodeVoteExtension(bz []byte) (VoteExtension, error) {
+	var ve VoteExtension
+	if err := json.Unmarshal(bz, &ve); err != nil {
+		return VoteExtension{}, fmt.Errorf("failed to decode oracle vote extension: %w", err)
+	}
+	return ve, nil
+}
+
+// VoteExtensionHandler extends the votes of a validator with the prices of its
+// providers, and verifies the vote extensions of the other validators.
+type VoteExtensionHandler struct {
+	logger    log.Logger
+	pairs     []string
+	pairSet   map[string]struct{}
+	providers []Provider
+	maxSize   int
+	timeout   time.Duration
+}
+
+// NewVoteExtensionHandler returns a VoteExtensionHandler reporting the prices
+// of the given pairs, e.g. "ATOM/USD". The price of a pair is the lower median
+// of the prices of the providers.
+func NewVoteExtensionHandler(logger log.Logger, pairs []string, providers ...Provider) *VoteExtensionHandler {
+	pairSet := make(map[string]struct{}, len(pairs))
+	for _, pair := range pairs {
+		pairSet[pair] = struct{}{}
+	}
+	return &VoteExtensionHandler{
+		logger:    logger.With(log.ModuleKey, "oracle"),
+		pairs:     pairs,
+		pairSet:   pairSet,
+		providers: providers,
+		maxSize:   DefaultMaxVoteExtensionSize,
+		timeout:   DefaultProviderTimeout,
+	}
+}
+
+// SetMaxVoteExtensionSize sets the maximum size of the vote extensions.
+func (h *VoteExtensionHandler) SetMaxVoteExtensionSize(size int) {
+	h.maxSize = size
+}
+
+// SetProviderTimeout sets the time the providers have to return their prices
+// in ExtendVote.
+func (h *VoteExtensionHandler) SetProviderTimeout(timeout time.Duration) {
+	h.timeout = timeout
+}
+
+// providerPrices are the prices returned by a provider.
+type providerPrices struct {
+	prices map[string]math.LegacyDec
+	err    error
+}
+
+// fetchPrices fetches the prices of the providers concurrently, and returns
+// those returned within the provider timeout, in the providers order. The
+// providers not returning in time get the context error.
+func (h *VoteExtensionHandler) fetchPrices(ctx context.Context) []providerPrices {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	type result struct {
+		index int
+		providerPrices
+	}
+	// the channel is buffered so that the late providers do not block
+	results := make(chan result, len(h.providers))
+	for i, provider := range h.providers {
+		go func() {
+			prices, err := provider.Prices(ctx, h.pairs)
+			results <- result{index: i, providerPrices: providerPrices{prices: prices, err: err}}
+		}()
+	}
+
+	fetched := make([]providerPrices, len(h.providers))
+	returned := make([]bool, len(h.providers))
+	for range h.providers {
+		select {
+		case res := <-results:
+			fetched[res.index] = res.providerPrices
+			returned[res.index] = true
+		case <-ctx.Done():
+			for i := range fetched {
+				if !returned[i] {
+					fetched[i].err = ctx.Err()
+				}
+			}
+			return fetched
+		}
+	}
+	return fetched
+}
+
+// ExtendVoteHandler returns an ExtendVote handler reporting the prices of the
+// providers. The providers are queried concurrently and have the provider
+// timeout to return their prices, the providers failing or not returning in
+// time are skipped, so that the validator still votes in time.
+func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
+		reports := make(map[string][]WeightedPrice)
+		for i, res := range h.fetchPrices(ctx) {
+			if res.err != nil {
+				h.logger.Error("failed to fetch prices", "provider", h.providers[i].Name(), "height", req.Height, "err", res.err)
+				continue
+			}
+			for pair, price := range res.prices {
+				if _, ok := h.pairSet[pair]; !ok || price.IsNil() || !price.IsPositive() {
+					continue
+				}
+				reports[pair] = append(reports[pair], WeightedPrice{Price: price, Power: 1})
+			}
+		}
+
+		ve := VoteExtension{Prices: make(map[string]math.LegacyDec, len(reports))}
+		for pair, prices := range reports {
+			ve.Prices[pair] = StakeWeightedMedian(prices)
+		}
+		bz, err := EncodeVoteExtension(ve)
+		if err != nil {
+			return nil, err
+		}
+		return &abci.ExtendVoteResponse{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns a VerifyVoteExtension handler accepting
+// the empty vote extensions, of the validators which didn't extend their votes,
+// and the vote extensions reporting positive prices of known pairs.
+func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.VerifyVoteExtensionRequest) (*abci.VerifyVoteExtensionResponse, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT}, nil
+		}
+		if err := h.verifyVoteExtension(req.VoteExtension); err != nil {
+			h.logger.Error("rejected vote extension", "height", req.Height, "validator", fmt.Sprintf("%X", req.ValidatorAddress), "err", err)
+			return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_REJECT}, nil
+		}
+		return &abci.VerifyVoteExtensionResponse{Status: abci.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT}, nil
+	}
+}
+
+func (h *VoteExtensionHandler) verifyVoteExtension(bz []byte) error {
+	if len(bz) > h.maxSize {
+		return fmt.Errorf("vote extension size %d exceeds %d", len(bz), h.maxSize)
+	}
+	ve, err := DecodeVoteExtension(bz)
+	if err != nil {
+		return err
+	}
+	for pair, price := range ve.Prices {
+		if _, ok := h.pairSet[pair]; !ok {
+			return fmt.Errorf("unknown pair %q", pair)
+		}
+		if price.IsNil() || !price.IsPositive() {
+			return errors.New("prices must be positive")
+		}
+	}
+	return nil
+}
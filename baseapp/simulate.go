@@ -0,0 +1,345 @@
+package baseapp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/mem"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/simulate"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ simulate.Simulator = (*BaseApp)(nil)
+
+// SimulateWithTrace simulates a tx like Simulate, on the check state modified
+// by the overrides of opts, and returns its execution trace. The trace is
+// returned along with the error if the tx failed, and is nil if the overrides
+// could not be applied.
+func (app *BaseApp) SimulateWithTrace(txBytes []byte, opts simulate.Options) (sdk.GasInfo, *sdk.Result, *simulate.ExecutionTrace, error) {
+	cms, ok := app.cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return sdk.GasInfo{}, nil, nil, errors.New("the commit multi store does not expose its store keys")
+	}
+	keys := cms.StoreKeysByName()
+
+	ctx := app.getContextForTx(execModeSimulate, txBytes)
+	for _, o := range opts.KeyOverrides {
+		key, ok := keys[o.StoreKey]
+		if !ok {
+			return sdk.GasInfo{}, nil, nil, fmt.Errorf("unknown store key %q", o.StoreKey)
+		}
+		if len(o.Key) == 0 {
+			return sdk.GasInfo{}, nil, nil, fmt.Errorf("empty key override in store %q", o.StoreKey)
+		}
+		if o.Delete {
+			ctx.MultiStore().GetKVStore(key).Delete(o.Key)
+		} else {
+			ctx.MultiStore().GetKVStore(key).Set(o.Key, o.Value)
+		}
+	}
+	for _, override := range opts.Overrides {
+		if err := override(ctx); err != nil {
+			return sdk.GasInfo{}, nil, nil, err
+		}
+	}
+
+	tracer := &simulationTracer{keys: keys, trace: &simulate.ExecutionTrace{}}
+	ctx = ctx.
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager()).
+		WithValue(simulationTracerKey{}, tracer)
+	if opts.SkipSignatures {
+		ctx = ctx.WithIsSigverifyTx(false)
+	}
+
+	gasInfo, result, _, err := app.runTxOnContext(ctx, execModeSimulate, txBytes, nil)
+	return gasInfo, result, tracer.trace, err
+}
+
+type simulationTracerKey struct{}
+
+// simulationTracer builds the execution trace of a traced simulation. The
+// context of the simulated tx carries it, and the msg handlers and runTx
+// record their calls with it.
+type simulationTracer struct {
+	keys  map[string]storetypes.StoreKey
+	trace *simulate.ExecutionTrace
+	// calls are the calls being executed, innermost last.
+	calls []*traceFrame
+}
+
+// simulationTracerFromContext returns the tracer of the traced simulation ctx
+// belongs to, nil if it does not belong to one.
+func simulationTracerFromContext(ctx context.Context) *simulationTracer {
+	t, _ := ctx.Value(simulationTracerKey{}).(*simulationTracer)
+	return t
+}
+
+// withoutSimulationTracer returns ctx without its simulation tracer.
+func withoutSimulationTracer(ctx sdk.Context) sdk.Context {
+	if simulationTracerFromContext(ctx) == nil {
+		return ctx
+	}
+	return ctx.WithValue(simulationTracerKey{}, (*simulationTracer)(nil))
+}
+
+// traceFrame is a call being traced.
+type traceFrame struct {
+	call   *simulate.CallTrace
+	stores map[string]*storeTrace
+}
+
+// enterAnte starts tracing the ante handler, called with the returned context.
+func (t *simulationTracer) enterAnte(ctx sdk.Context) (sdk.Context, *traceFrame) {
+	if t == nil {
+		return ctx, nil
+	}
+	t.trace.Ante = &simulate.CallTrace{}
+	return t.enter(ctx, t.trace.Ante)
+}
+
+// enterPost starts tracing the post handler, called with the returned context.
+func (t *simulationTracer) enterPost(ctx sdk.Context) (sdk.Context, *traceFrame) {
+	if t == nil {
+		return ctx, nil
+	}
+	t.trace.Post = &simulate.CallTrace{}
+	return t.enter(ctx, t.trace.Post)
+}
+
+// enterMsg starts tracing the execution of msg, by the returned context.
+func (t *simulationTracer) enterMsg(ctx sdk.Context, msg protoiface.MessageV1) (sdk.Context, *traceFrame) {
+	if t == nil {
+		return ctx, nil
+	}
+	call := &simulate.CallTrace{}
+	if anyMsg, err := codectypes.NewAnyWithValue(msg); err == nil {
+		call.Msg = anyMsg
+	}
+	if len(t.calls) == 0 {
+		t.trace.Messages = append(t.trace.Messages, call)
+	} else {
+		parent := t.calls[len(t.calls)-1].call
+		parent.Calls = append(parent.Calls, call)
+	}
+	return t.enter(ctx, call)
+}
+
+func (t *simulationTracer) enter(ctx sdk.Context, call *simulate.CallTrace) (sdk.Context, *traceFrame) {
+	frame := &traceFrame{call: call, stores: make(map[string]*storeTrace)}
+	t.calls = append(t.calls, frame)
+
+	// record the accesses of the call only, not those of the enclosing calls
+	ms := ctx.MultiStore()
+	if tms, ok := ms.(*tracingMultiStore); ok {
+		ms = tms.MultiStore
+	}
+	return ctx.WithMultiStore(&tracingMultiStore{MultiStore: ms, tracer: t, frame: frame}), frame
+}
+
+// exit ends tracing the innermost call, frame.
+func (t *simulationTracer) exit(frame *traceFrame, gasUsed uint64, events []abci.Event, err error) {
+	if t == nil || frame == nil {
+		return
+	}
+	t.calls = t.calls[:len(t.calls)-1]
+
+	frame.call.GasUsed = gasUsed
+	frame.call.Events = events
+	if err != nil {
+		frame.call.Error = err.Error()
+	}
+	names := make([]string, 0, len(frame.stores))
+	for name := range frame.stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		frame.call.Stores = append(frame.call.Stores, frame.stores[name].trace)
+	}
+}
+
+// traceMsgHandler traces the executions of a msg handler in traced simulations.
+func traceMsgHandler(handler MsgServiceHandler) MsgServiceHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) (res *sdk.Result, err error) {
+		tracer := simulationTracerFromContext(ctx)
+		if tracer == nil {
+			return handler(ctx, msg)
+		}
+
+		ctx, frame := tracer.enterMsg(ctx, msg)
+		gasBefore := ctx.GasMeter().GasConsumed()
+		defer func() {
+			if r := recover(); r != nil {
+				tracer.exit(frame, ctx.GasMeter().GasConsumed()-gasBefore, nil, fmt.Errorf("panic: %v", r))
+				panic(r)
+			}
+			var events []abci.Event
+			if res != nil {
+				events = res.Events
+			}
+			tracer.exit(frame, ctx.GasMeter().GasConsumed()-gasBefore, events, err)
+		}()
+		return handler(ctx, msg)
+	}
+}
+
+// traceHybridHandler traces the executions of a hybrid handler, by which msgs
+// execute other msgs, in traced simulations.
+func traceHybridHandler(handler func(ctx context.Context, req, resp protoiface.MessageV1) error) func(ctx context.Context, req, resp protoiface.MessageV1) error {
+	return func(goCtx context.Context, req, resp protoiface.MessageV1) (err error) {
+		tracer := simulationTracerFromContext(goCtx)
+		if tracer == nil {
+			return handler(goCtx, req, resp)
+		}
+
+		ctx, frame := tracer.enterMsg(sdk.UnwrapSDKContext(goCtx), req)
+		gasBefore := ctx.GasMeter().GasConsumed()
+		eventsBefore := len(ctx.EventManager().Events())
+		defer func() {
+			if r := recover(); r != nil {
+				tracer.exit(frame, ctx.GasMeter().GasConsumed()-gasBefore, nil, fmt.Errorf("panic: %v", r))
+				panic(r)
+			}
+			var events []abci.Event
+			if err == nil {
+				events = ctx.EventManager().Events()[eventsBefore:].ToABCIEvents()
+			}
+			tracer.exit(frame, ctx.GasMeter().GasConsumed()-gasBefore, events, err)
+		}()
+		return handler(ctx, req, resp)
+	}
+}
+
+// tracingMultiStore records the store accesses of a traced call.
+type tracingMultiStore struct {
+	storetypes.MultiStore
+	tracer *simulationTracer
+	frame  *traceFrame
+}
+
+func (ms *tracingMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return &tracingKVStore{KVStore: ms.MultiStore.GetKVStore(key), trace: ms.frame.store(key.Name())}
+}
+
+// CacheMultiStore branches the store, recording the reads the branch makes
+// through it and the writes it flushes to it.
+func (ms *tracingMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(ms.tracer.keys))
+	for _, key := range ms.tracer.keys {
+		stores[key] = ms.GetKVStore(key)
+	}
+	return cachemulti.NewFromKVStore(mem.NewStore(), stores, ms.tracer.keys, nil, nil)
+}
+
+func (f *traceFrame) store(name string) *storeTrace {
+	s, ok := f.stores[name]
+	if !ok {
+		s = &storeTrace{trace: simulate.StoreTrace{StoreKey: name}, read: make(map[string]bool)}
+		f.stores[name] = s
+	}
+	return s
+}
+
+// storeTrace are the accesses of a call to a store.
+type storeTrace struct {
+	trace simulate.StoreTrace
+	// read are the keys read.
+	read map[string]bool
+}
+
+func (s *storeTrace) recordRead(key, value []byte) {
+	if s.read[string(key)] {
+		return
+	}
+	s.read[string(key)] = true
+	s.trace.Reads = append(s.trace.Reads, simulate.StoreAccess{
+		Key:     bytes.Clone(key),
+		Value:   bytes.Clone(value),
+		Deleted: value == nil,
+	})
+}
+
+func (s *storeTrace) recordWrite(key, value []byte) {
+	s.trace.Writes = append(s.trace.Writes, simulate.StoreAccess{
+		Key:     bytes.Clone(key),
+		Value:   bytes.Clone(value),
+		Deleted: value == nil,
+	})
+}
+
+// tracingKVStore records the reads from and the writes to a KVStore.
+type tracingKVStore struct {
+	storetypes.KVStore
+	trace *storeTrace
+}
+
+var _ storetypes.KVStore = (*tracingKVStore)(nil)
+
+func (s *tracingKVStore) Get(key []byte) []byte {
+	value := s.KVStore.Get(key)
+	s.trace.recordRead(key, value)
+	return value
+}
+
+func (s *tracingKVStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+func (s *tracingKVStore) Set(key, value []byte) {
+	s.trace.recordWrite(key, value)
+	s.KVStore.Set(key, value)
+}
+
+func (s *tracingKVStore) Delete(key []byte) {
+	s.trace.recordWrite(key, nil)
+	s.KVStore.Delete(key)
+}
+
+func (s *tracingKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	return &tracingIterator{Iterator: s.KVStore.Iterator(start, end), trace: s.trace}
+}
+
+func (s *tracingKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return &tracingIterator{Iterator: s.KVStore.ReverseIterator(start, end), trace: s.trace}
+}
+
+func (s *tracingKVStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *tracingKVStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// tracingIterator records the entries read from an iterator as reads.
+type tracingIterator struct {
+	storetypes.Iterator
+	trace *storeTrace
+}
+
+func (it *tracingIterator) Key() []byte {
+	key := it.Iterator.Key()
+	it.trace.recordRead(key, it.Iterator.Value())
+	return key
+}
+
+func (it *tracingIterator) Value() []byte {
+	value := it.Iterator.Value()
+	it.trace.recordRead(it.Iterator.Key(), value)
+	return value
+}
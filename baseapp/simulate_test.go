@@ -0,0 +1,151 @@
+package baseapp_test
+
+import (
+	"context"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/client/grpc/simulate"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func varint(i int64) []byte {
+	return binary.AppendVarint(nil, i)
+}
+
+func TestSimulateWithTrace(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	// the msg counter is only valid with the key override
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, suite.ac, 0, 5))
+	require.NoError(t, err)
+
+	gasInfo, result, trace, err := suite.baseApp.SimulateWithTrace(txBytes, simulate.Options{
+		KeyOverrides: []simulate.KeyOverride{{StoreKey: capKey1.Name(), Key: deliverKey, Value: varint(5)}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	require.Equal(t, []simulate.StoreTrace{{
+		StoreKey: capKey1.Name(),
+		Reads:    []simulate.StoreAccess{{Key: anteKey, Deleted: true}},
+		Writes:   []simulate.StoreAccess{{Key: anteKey, Value: varint(1)}},
+	}}, trace.Ante.Stores)
+	require.Equal(t, counterEvent("ante_handler", 0).ToABCIEvents(), trace.Ante.Events)
+
+	require.Len(t, trace.Messages, 1)
+	msgTrace := trace.Messages[0]
+	require.Equal(t, sdk.MsgTypeURL(&baseapptestutil.MsgCounter{}), msgTrace.Msg.TypeUrl)
+	require.Greater(t, msgTrace.GasUsed, uint64(5))
+	require.Equal(t, gasInfo.GasUsed, trace.Ante.GasUsed+msgTrace.GasUsed)
+	require.Empty(t, msgTrace.Error)
+	require.Empty(t, msgTrace.Calls)
+	require.Equal(t, counterEvent(sdk.EventTypeMessage, 5).ToABCIEvents(), msgTrace.Events)
+	require.Equal(t, []simulate.StoreTrace{{
+		StoreKey: capKey1.Name(),
+		Reads:    []simulate.StoreAccess{{Key: deliverKey, Value: varint(5)}},
+		Writes:   []simulate.StoreAccess{{Key: deliverKey, Value: varint(6)}},
+	}}, msgTrace.Stores)
+	require.Nil(t, trace.Post)
+
+	// the overrides are not persisted
+	txBytes, err = suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, suite.ac, 0, 0))
+	require.NoError(t, err)
+	_, _, trace, err = suite.baseApp.SimulateWithTrace(txBytes, simulate.Options{})
+	require.NoError(t, err)
+	require.Equal(t, varint(1), trace.Messages[0].Stores[0].Writes[0].Value)
+
+	// invalid overrides are rejected before the tx is simulated
+	_, _, trace, err = suite.baseApp.SimulateWithTrace(txBytes, simulate.Options{
+		KeyOverrides: []simulate.KeyOverride{{StoreKey: "unknown", Key: deliverKey}},
+	})
+	require.ErrorContains(t, err, "unknown store key")
+	require.Nil(t, trace)
+}
+
+// dispatchServerImpl executes the nested messages through the router.
+type dispatchServerImpl struct {
+	router *baseapp.MsgServiceRouter
+	ir     codectypes.InterfaceRegistry
+}
+
+func (s dispatchServerImpl) Check(ctx context.Context, msg *baseapptestutil.MsgNestedMessages) (*baseapptestutil.MsgCreateNestedMessagesResponse, error) {
+	for _, anyMsg := range msg.Messages {
+		var nested sdk.Msg
+		if err := s.ir.UnpackAny(anyMsg, &nested); err != nil {
+			return nil, err
+		}
+		name := gogoproto.MessageName(nested)
+		resp := reflect.New(gogoproto.MessageType(s.router.ResponseNameByMsgName(name)).Elem()).Interface().(gogoproto.Message)
+		if err := s.router.HybridHandlerByMsgName(name)(ctx, nested, resp); err != nil {
+			return nil, err
+		}
+	}
+	return &baseapptestutil.MsgCreateNestedMessagesResponse{}, nil
+}
+
+func TestSimulateWithTrace_NestedMessages(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+	baseapptestutil.RegisterNestedMessagesServer(suite.baseApp.MsgServiceRouter(), dispatchServerImpl{
+		router: suite.baseApp.MsgServiceRouter(),
+		ir:     suite.cdc.InterfaceRegistry(),
+	})
+
+	signer := newTxCounter(t, suite.txConfig, suite.ac, 0, 0).GetMsgs()[0].(*baseapptestutil.MsgCounter).Signer
+	nested, err := codectypes.NewAnyWithValue(&baseapptestutil.MsgCounter{Counter: 0, Signer: signer})
+	require.NoError(t, err)
+
+	builder := suite.txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgNestedMessages{Messages: []*codectypes.Any{nested}, Signer: signer}))
+	setTxSignature(t, builder, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	_, _, trace, err := suite.baseApp.SimulateWithTrace(txBytes, simulate.Options{})
+	require.NoError(t, err)
+	require.Nil(t, trace.Ante)
+
+	// the nested message is traced once, as a call of the message executing it
+	require.Len(t, trace.Messages, 1)
+	msgTrace := trace.Messages[0]
+	require.Equal(t, sdk.MsgTypeURL(&baseapptestutil.MsgNestedMessages{}), msgTrace.Msg.TypeUrl)
+	require.Empty(t, msgTrace.Stores)
+	require.Len(t, msgTrace.Calls, 1)
+
+	call := msgTrace.Calls[0]
+	require.Equal(t, nested.TypeUrl, call.Msg.TypeUrl)
+	require.Greater(t, call.GasUsed, uint64(5))
+	require.Equal(t, msgTrace.GasUsed, call.GasUsed)
+	require.Equal(t, counterEvent(sdk.EventTypeMessage, 0).ToABCIEvents(), call.Events)
+	require.Equal(t, []simulate.StoreTrace{{
+		StoreKey: capKey1.Name(),
+		Reads:    []simulate.StoreAccess{{Key: deliverKey, Deleted: true}},
+		Writes:   []simulate.StoreAccess{{Key: deliverKey, Value: varint(1)}},
+	}}, call.Stores)
+}
@@ -0,0 +1,364 @@
+package baseapp
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/mem"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// txResultCache holds the results of the txs executed by the FinalizeBlock
+// runs of a height, with the pre-state they read and the writes they made, so
+// that a later run, e.g. after an optimistic execution was aborted because the
+// proposal changed, can reuse the results of the txs whose inputs did not
+// change.
+//
+// A tx result is reused if the tx has the same hash, the header fields the tx
+// read have the same values, and the tx reads the same values from the state it
+// is executed on. The tx writes are then replayed instead of executing the tx
+// again. The results are only cached by the optimistic executions, the only
+// runs of a height which may be followed by another one.
+//
+// The FinalizeBlock runs of a height are sequential, as a run starts after the
+// previous one was aborted and waited for, so the cache is not synchronized.
+type txResultCache struct {
+	height  int64
+	results map[[sha256.Size]byte]*cachedTxResult
+}
+
+func newTxResultCache() *txResultCache {
+	return &txResultCache{results: make(map[[sha256.Size]byte]*cachedTxResult)}
+}
+
+// reset empties the cache if its results are not of the given height.
+func (c *txResultCache) reset(height int64) {
+	if c.height != height {
+		c.height = height
+		clear(c.results)
+	}
+}
+
+// clear empties the cache.
+func (c *txResultCache) clear() {
+	c.height = 0
+	clear(c.results)
+}
+
+// cachedTxResult is the result of the execution of a tx.
+type cachedTxResult struct {
+	// headerReads are the fields of the block header the tx read.
+	headerReads sdk.HeaderFields
+	// header identifies the values of the header fields the tx read.
+	header []byte
+	// accesses are the reads and writes of the tx, by store.
+	accesses map[storetypes.StoreKey]*kvStoreAccesses
+	// blockGas is the gas the tx consumed from the block gas meter.
+	blockGas uint64
+	result   *abci.ExecTxResult
+}
+
+// reusable returns true if the header and state reads of the tx are the same
+// in the block of req and on ms, and if the block gas meter has enough gas left
+// for the tx.
+func (r *cachedTxResult) reusable(req *abci.FinalizeBlockRequest, ms storetypes.MultiStore, blockGasMeter storetypes.GasMeter) bool {
+	if blockGasMeter.IsOutOfGas() || blockGasMeter.GasRemaining() < r.blockGas ||
+		!bytes.Equal(r.header, txResultHeaderOf(req, r.headerReads)) {
+		return false
+	}
+	for key, accesses := range r.accesses {
+		if !accesses.readsUnchanged(ms.GetKVStore(key)) {
+			return false
+		}
+	}
+	return true
+}
+
+// apply replays the writes of the tx on ms and consumes its block gas.
+func (r *cachedTxResult) apply(ms storetypes.MultiStore, blockGasMeter storetypes.GasMeter) {
+	for key, accesses := range r.accesses {
+		store := ms.GetKVStore(key)
+		for _, w := range accesses.writes {
+			if w.value == nil {
+				store.Delete(w.key)
+			} else {
+				store.Set(w.key, w.value)
+			}
+		}
+	}
+	blockGasMeter.ConsumeGas(r.blockGas, "block gas meter")
+}
+
+// txResultHeaderOf returns the identifier of the values of the given fields of
+// the header the block of req is executed with.
+func txResultHeaderOf(req *abci.FinalizeBlockRequest, fields sdk.HeaderFields) []byte {
+	h := sha256.New()
+	_ = binary.Write(h, binary.BigEndian, req.Height)
+	_ = binary.Write(h, binary.BigEndian, fields)
+	writeBytes := func(bz []byte) {
+		_ = binary.Write(h, binary.BigEndian, uint64(len(bz)))
+		h.Write(bz)
+	}
+	if fields&sdk.HeaderTime != 0 {
+		_ = binary.Write(h, binary.BigEndian, req.Time.UnixNano())
+	}
+	if fields&sdk.HeaderHash != 0 {
+		writeBytes(req.Hash)
+	}
+	if fields&sdk.HeaderProposer != 0 {
+		writeBytes(req.ProposerAddress)
+	}
+	if fields&sdk.HeaderValidators != 0 {
+		writeBytes(req.NextValidatorsHash)
+	}
+	if fields&sdk.HeaderLastCommit != 0 {
+		bz, _ := proto.Marshal(&req.DecidedLastCommit)
+		writeBytes(bz)
+	}
+	if fields&sdk.HeaderMisbehavior != 0 {
+		bz, _ := proto.Marshal(&abci.FinalizeBlockRequest{Misbehavior: req.Misbehavior})
+		writeBytes(bz)
+	}
+	return h.Sum(nil)
+}
+
+// deliverTxReusingResult executes the tx of the block of req like deliverTx,
+// unless the result of an execution of the tx on the same inputs is cached, in
+// which case its writes are replayed and its result is returned. If record is
+// true, the result of the execution is cached.
+func (app *BaseApp) deliverTxReusingResult(tx []byte, req *abci.FinalizeBlockRequest, record bool) *abci.ExecTxResult {
+	keys, ok := app.cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok || app.finalizeBlockState.ms.TracingEnabled() {
+		return app.deliverTx(tx)
+	}
+
+	ctx := app.finalizeBlockState.Context()
+	ms := app.finalizeBlockState.ms
+	blockGasMeter := ctx.BlockGasMeter()
+	hash := sha256.Sum256(tx)
+
+	if cached, ok := app.txResultCache.results[hash]; ok && cached.reusable(req, ms, blockGasMeter) {
+		cached.apply(ms, blockGasMeter)
+		if decoded, err := app.txDecoder(tx); err == nil {
+			// the previous execution removed the tx from the mempool, if it passed
+			// the ante handler, but it may have been inserted again since
+			_ = app.mempool.Remove(decoded)
+		}
+		telemetry.IncrCounter(1, "oe", "tx_results", "reused")
+		return proto.Clone(cached.result).(*abci.ExecTxResult)
+	}
+	if !record {
+		return app.deliverTx(tx)
+	}
+
+	// execute the tx on a branch of the finalize block state recording its
+	// header reads, and its state reads and writes
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper)
+	accesses := make(map[storetypes.StoreKey]*kvStoreAccesses)
+	for _, key := range keys.StoreKeysByName() {
+		accesses[key] = newKVStoreAccesses()
+		stores[key] = &recordingKVStore{KVStore: ms.GetKVStore(key), accesses: accesses[key]}
+	}
+	txMs := cachemulti.NewFromKVStore(mem.NewStore(), stores, keys.StoreKeysByName(), nil, nil)
+
+	outOfGas := blockGasMeter.IsOutOfGas()
+	consumed := blockGasMeter.GasConsumed()
+
+	var headerReads sdk.HeaderFields
+	app.finalizeBlockState.SetContext(ctx.WithMultiStore(txMs).WithHeaderReads(&headerReads))
+	resp := app.deliverTx(tx)
+	app.finalizeBlockState.SetContext(ctx)
+	txMs.Write()
+	telemetry.IncrCounter(1, "oe", "tx_results", "executed")
+
+	// the results of the txs which ran out of block gas depend on the txs
+	// before them
+	if outOfGas || blockGasMeter.IsPastLimit() {
+		return resp
+	}
+	for key, a := range accesses {
+		if a.empty() {
+			delete(accesses, key)
+		}
+	}
+	app.txResultCache.results[hash] = &cachedTxResult{
+		headerReads: headerReads,
+		header:      txResultHeaderOf(req, headerReads),
+		accesses:    accesses,
+		blockGas:    blockGasMeter.GasConsumed() - consumed,
+		result:      proto.Clone(resp).(*abci.ExecTxResult),
+	}
+
+	return resp
+}
+
+// kvStoreAccesses are the reads and writes of a tx on a KVStore.
+type kvStoreAccesses struct {
+	// reads are the values read by key, nil for absent keys.
+	reads     map[string][]byte
+	iterators []*iteratorReads
+	writes    []kvPair
+}
+
+func newKVStoreAccesses() *kvStoreAccesses {
+	return &kvStoreAccesses{reads: make(map[string][]byte)}
+}
+
+func (a *kvStoreAccesses) empty() bool {
+	return len(a.reads) == 0 && len(a.iterators) == 0 && len(a.writes) == 0
+}
+
+// readsUnchanged returns true if the reads are the same on store.
+func (a *kvStoreAccesses) readsUnchanged(store storetypes.KVStore) bool {
+	for key, value := range a.reads {
+		if got := store.Get([]byte(key)); (got == nil) != (value == nil) || !bytes.Equal(got, value) {
+			return false
+		}
+	}
+	for _, it := range a.iterators {
+		if !it.unchanged(store) {
+			return false
+		}
+	}
+	return true
+}
+
+// kvPair is a key and its value. As a write, it is a deletion if value is nil.
+type kvPair struct {
+	key, value []byte
+}
+
+// iteratorReads are the entries read from an iterator.
+type iteratorReads struct {
+	start, end []byte
+	reverse    bool
+	entries    []kvPair
+	// exhausted is true if the iterator was read until its end.
+	exhausted bool
+}
+
+// unchanged returns true if iterating over store reads the same entries.
+func (r *iteratorReads) unchanged(store storetypes.KVStore) bool {
+	var it storetypes.Iterator
+	if r.reverse {
+		it = store.ReverseIterator(r.start, r.end)
+	} else {
+		it = store.Iterator(r.start, r.end)
+	}
+	defer it.Close()
+
+	for _, e := range r.entries {
+		if !it.Valid() || !bytes.Equal(it.Key(), e.key) || !bytes.Equal(it.Value(), e.value) {
+			return false
+		}
+		it.Next()
+	}
+	return !r.exhausted || !it.Valid()
+}
+
+// recordingKVStore records the reads from and the writes to a KVStore.
+type recordingKVStore struct {
+	storetypes.KVStore
+	accesses *kvStoreAccesses
+}
+
+var _ storetypes.KVStore = (*recordingKVStore)(nil)
+
+func (s *recordingKVStore) Get(key []byte) []byte {
+	value := s.KVStore.Get(key)
+	if _, ok := s.accesses.reads[string(key)]; !ok {
+		s.accesses.reads[string(key)] = bytes.Clone(value)
+	}
+	return value
+}
+
+func (s *recordingKVStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+func (s *recordingKVStore) Set(key, value []byte) {
+	s.accesses.writes = append(s.accesses.writes, kvPair{key: bytes.Clone(key), value: bytes.Clone(value)})
+	s.KVStore.Set(key, value)
+}
+
+func (s *recordingKVStore) Delete(key []byte) {
+	s.accesses.writes = append(s.accesses.writes, kvPair{key: bytes.Clone(key)})
+	s.KVStore.Delete(key)
+}
+
+func (s *recordingKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	return s.recordIterator(s.KVStore.Iterator(start, end), start, end, false)
+}
+
+func (s *recordingKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.recordIterator(s.KVStore.ReverseIterator(start, end), start, end, true)
+}
+
+func (s *recordingKVStore) recordIterator(it storetypes.Iterator, start, end []byte, reverse bool) storetypes.Iterator {
+	reads := &iteratorReads{start: bytes.Clone(start), end: bytes.Clone(end), reverse: reverse}
+	s.accesses.iterators = append(s.accesses.iterators, reads)
+	return &recordingIterator{Iterator: it, reads: reads}
+}
+
+func (s *recordingKVStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *recordingKVStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// recordingIterator records the entries read from an iterator.
+type recordingIterator struct {
+	storetypes.Iterator
+	reads *iteratorReads
+	pos   int
+}
+
+// record records the current entry if it was not yet.
+func (it *recordingIterator) record() {
+	if it.pos == len(it.reads.entries) {
+		it.reads.entries = append(it.reads.entries, kvPair{
+			key:   bytes.Clone(it.Iterator.Key()),
+			value: bytes.Clone(it.Iterator.Value()),
+		})
+	}
+}
+
+func (it *recordingIterator) Valid() bool {
+	valid := it.Iterator.Valid()
+	if valid {
+		it.record()
+	} else {
+		it.reads.exhausted = true
+	}
+	return valid
+}
+
+func (it *recordingIterator) Next() {
+	it.record()
+	it.pos++
+	it.Iterator.Next()
+}
+
+func (it *recordingIterator) Key() []byte {
+	it.record()
+	return it.Iterator.Key()
+}
+
+func (it *recordingIterator) Value() []byte {
+	it.record()
+	return it.Iterator.Value()
+}
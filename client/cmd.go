@@ -131,7 +131,27 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 		clientCtx = clientCtx.WithChainID(chainID)
 	}
 
-	if clientCtx.Keyring == nil || flagSet.Changed(flags.FlagKeyringBackend) {
+	if clientCtx.KeyringRemoteSigner == "" || flagSet.Changed(flags.FlagKeyringRemote) {
+		remoteSigner, _ := flagSet.GetString(flags.FlagKeyringRemote)
+		clientCtx = clientCtx.WithKeyringRemoteSigner(remoteSigner)
+	}
+
+	remoteTLSChanged := flagSet.Changed(flags.FlagKeyringRemoteCA) || flagSet.Changed(flags.FlagKeyringRemoteCrt) || flagSet.Changed(flags.FlagKeyringRemoteKey)
+	if clientCtx.KeyringRemoteTLS.IsEmpty() || remoteTLSChanged {
+		config := clientCtx.KeyringRemoteTLS
+		if flagSet.Changed(flags.FlagKeyringRemoteCA) || config.CAFile == "" {
+			config.CAFile, _ = flagSet.GetString(flags.FlagKeyringRemoteCA)
+		}
+		if flagSet.Changed(flags.FlagKeyringRemoteCrt) || config.CertFile == "" {
+			config.CertFile, _ = flagSet.GetString(flags.FlagKeyringRemoteCrt)
+		}
+		if flagSet.Changed(flags.FlagKeyringRemoteKey) || config.KeyFile == "" {
+			config.KeyFile, _ = flagSet.GetString(flags.FlagKeyringRemoteKey)
+		}
+		clientCtx = clientCtx.WithKeyringRemoteTLS(config)
+	}
+
+	if clientCtx.Keyring == nil || flagSet.Changed(flags.FlagKeyringBackend) || flagSet.Changed(flags.FlagKeyringRemote) || remoteTLSChanged {
 		keyringBackend, _ := flagSet.GetString(flags.FlagKeyringBackend)
 
 		if keyringBackend != "" {
@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 )
 
 // DefaultConfig returns default config for the client.toml
@@ -35,6 +36,10 @@ type Config struct {
 	ChainID               string     `mapstructure:"chain-id" json:"chain-id"`
 	KeyringBackend        string     `mapstructure:"keyring-backend" json:"keyring-backend"`
 	KeyringDefaultKeyName string     `mapstructure:"keyring-default-keyname" json:"keyring-default-keyname"`
+	KeyringRemoteSigner   string     `mapstructure:"keyring-remote-signer" json:"keyring-remote-signer"`
+	KeyringRemoteCA       string     `mapstructure:"keyring-remote-signer-ca" json:"keyring-remote-signer-ca"`
+	KeyringRemoteCert     string     `mapstructure:"keyring-remote-signer-cert" json:"keyring-remote-signer-cert"`
+	KeyringRemoteKey      string     `mapstructure:"keyring-remote-signer-key" json:"keyring-remote-signer-key"`
 	Output                string     `mapstructure:"output" json:"output"`
 	Node                  string     `mapstructure:"node" json:"node"`
 	BroadcastMode         string     `mapstructure:"broadcast-mode" json:"broadcast-mode"`
@@ -112,9 +117,15 @@ func CreateClientConfig(ctx client.Context, customClientTemplate string, customC
 	ctx = ctx.WithOutputFormat(conf.Output).
 		WithChainID(conf.ChainID).
 		WithKeyringDir(ctx.HomeDir).
-		WithKeyringDefaultKeyName(conf.KeyringDefaultKeyName)
-
-	keyring, err := client.NewKeyringFromBackend(ctx, conf.KeyringBackend)
+		WithKeyringDefaultKeyName(conf.KeyringDefaultKeyName).
+		WithKeyringRemoteSigner(conf.KeyringRemoteSigner).
+		WithKeyringRemoteTLS(keyring.RemoteSignerTLSConfig{
+			CAFile:   conf.KeyringRemoteCA,
+			CertFile: conf.KeyringRemoteCert,
+			KeyFile:  conf.KeyringRemoteKey,
+		})
+
+	kr, err := client.NewKeyringFromBackend(ctx, conf.KeyringBackend)
 	if err != nil {
 		return ctx, fmt.Errorf("couldn't get keyring: %w", err)
 	}
@@ -129,7 +140,7 @@ func CreateClientConfig(ctx client.Context, customClientTemplate string, customC
 		WithNodeURI(conf.Node).
 		WithBroadcastMode(conf.BroadcastMode).
 		WithClient(client).
-		WithKeyring(keyring)
+		WithKeyring(kr)
 
 	if conf.GRPC.Address != "" {
 		grpcClient, err := getGRPCClient(conf.GRPC)
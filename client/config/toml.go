@@ -18,8 +18,16 @@ const (
 
 # The network chain ID
 chain-id = "{{ .ChainID }}"
-# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory)
+# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory|remote)
 keyring-backend = "{{ .KeyringBackend }}"
+# <host>:<port> of the signer used by the remote keyring backend
+keyring-remote-signer = "{{ .KeyringRemoteSigner }}"
+# TLS files of the connection to the remote signer: the CA certificate verifying the signer,
+# and the client certificate and key presented to it. A signer which is not on a loopback
+# address is only reached over TLS.
+keyring-remote-signer-ca = "{{ .KeyringRemoteCA }}"
+keyring-remote-signer-cert = "{{ .KeyringRemoteCert }}"
+keyring-remote-signer-key = "{{ .KeyringRemoteKey }}"
 # Default key name, if set, defines the default key to use for signing transaction when the --from flag is not specified
 keyring-default-keyname = "{{ .KeyringDefaultKeyName }}"
 # CLI output format (text|json)
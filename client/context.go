@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"path"
+	"slices"
 	"strings"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -39,6 +40,8 @@ type Context struct {
 	KeyringOptions        []keyring.Option
 	KeyringDir            string
 	KeyringDefaultKeyName string
+	KeyringRemoteSigner   string
+	KeyringRemoteTLS      keyring.RemoteSignerTLSConfig
 	Output                io.Writer
 	OutputFormat          string
 	Height                int64
@@ -196,6 +199,18 @@ func (ctx Context) WithKeyringDir(dir string) Context {
 	return ctx
 }
 
+// WithKeyringRemoteSigner returns a copy of the Context with KeyringRemoteSigner set.
+func (ctx Context) WithKeyringRemoteSigner(addr string) Context {
+	ctx.KeyringRemoteSigner = addr
+	return ctx
+}
+
+// WithKeyringRemoteTLS returns a copy of the Context with KeyringRemoteTLS set.
+func (ctx Context) WithKeyringRemoteTLS(config keyring.RemoteSignerTLSConfig) Context {
+	ctx.KeyringRemoteTLS = config
+	return ctx
+}
+
 // WithKeyringDefaultKeyName returns a copy of the Context with KeyringDefaultKeyName set.
 func (ctx Context) WithKeyringDefaultKeyName(keyName string) Context {
 	ctx.KeyringDefaultKeyName = keyName
@@ -474,5 +489,13 @@ func NewKeyringFromBackend(ctx Context, backend string) (keyring.Keyring, error)
 		backend = keyring.BackendMemory
 	}
 
-	return keyring.New(sdk.KeyringServiceName(), backend, ctx.KeyringDir, ctx.Input, ctx.Codec, ctx.KeyringOptions...)
+	opts := ctx.KeyringOptions
+	if backend == keyring.BackendRemote && ctx.KeyringRemoteSigner != "" {
+		opts = append(slices.Clone(opts), keyring.WithRemoteSigner(ctx.KeyringRemoteSigner))
+		if !ctx.KeyringRemoteTLS.IsEmpty() {
+			opts = append(opts, keyring.WithRemoteSignerTLS(ctx.KeyringRemoteTLS))
+		}
+	}
+
+	return keyring.New(sdk.KeyringServiceName(), backend, ctx.KeyringDir, ctx.Input, ctx.Codec, opts...)
 }
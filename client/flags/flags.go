@@ -67,6 +67,10 @@ const (
 	FlagSkipConfirmation = "yes"
 	FlagProve            = "prove"
 	FlagKeyringBackend   = "keyring-backend"
+	FlagKeyringRemote    = "keyring-remote-signer"
+	FlagKeyringRemoteCA  = "keyring-remote-signer-ca"
+	FlagKeyringRemoteCrt = "keyring-remote-signer-cert"
+	FlagKeyringRemoteKey = "keyring-remote-signer-key"
 	FlagPage             = "page"
 	FlagLimit            = "limit"
 	FlagSignMode         = "sign-mode"
@@ -153,7 +157,11 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 // AddKeyringFlags sets common keyring flags
 func AddKeyringFlags(flags *pflag.FlagSet) {
 	flags.String(FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
-	flags.String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	flags.String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory|remote)")
+	flags.String(FlagKeyringRemote, "", "The <host>:<port> of the signer used by the remote keyring backend")
+	flags.String(FlagKeyringRemoteCA, "", "The CA certificate file used to verify the remote signer; required with the other TLS flags to reach a signer which is not on a loopback address")
+	flags.String(FlagKeyringRemoteCrt, "", "The client certificate file presented to the remote signer")
+	flags.String(FlagKeyringRemoteKey, "", "The client private key file presented to the remote signer")
 }
 
 // AddPaginationFlagsToCmd adds common pagination flags to cmd
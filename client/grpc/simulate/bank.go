@@ -0,0 +1,124 @@
+package simulate
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OverrideBalances replaces the balances of addr in the x/bank store of
+// bankKey, adjusting the supply accordingly. It bypasses the send restrictions
+// and the blocked addresses, and is meant for the state overrides of tx
+// simulations. The overrides are raw writes following the x/bank store layout
+// exported by banktypes, so that the bank keeper does not have to expose a way
+// to set balances bypassing the send restrictions and blocked addresses.
+func OverrideBalances(ctx sdk.Context, bankKey storetypes.StoreKey, addr sdk.AccAddress, balances sdk.Coins) error {
+	if err := balances.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.MultiStore().GetKVStore(bankKey)
+	current, err := bankBalances(store, addr)
+	if err != nil {
+		return err
+	}
+
+	for _, balance := range balances {
+		if err := overrideBalance(store, addr, balance.Denom, current.AmountOf(balance.Denom), balance.Amount); err != nil {
+			return err
+		}
+	}
+	// zero the balances which are not overridden
+	for _, coin := range current {
+		if balances.AmountOf(coin.Denom).IsZero() {
+			if err := overrideBalance(store, addr, coin.Denom, coin.Amount, math.ZeroInt()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// bankBalances returns the balances of addr in the x/bank store.
+func bankBalances(store storetypes.KVStore, addr sdk.AccAddress) (sdk.Coins, error) {
+	prefix, err := collections.EncodeKeyWithPrefix(banktypes.BalancesPrefix, banktypes.BalancesKeyCodec, collections.Join(addr, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	it := storetypes.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+
+	var balances sdk.Coins
+	for ; it.Valid(); it.Next() {
+		_, key, err := banktypes.BalancesKeyCodec.Decode(it.Key()[len(banktypes.BalancesPrefix):])
+		if err != nil {
+			return nil, err
+		}
+		amount, err := banktypes.BalanceValueCodec.Decode(it.Value())
+		if err != nil {
+			return nil, err
+		}
+		balances = balances.Add(sdk.NewCoin(key.K2(), amount))
+	}
+	return balances, nil
+}
+
+// overrideBalance sets the denom balance of addr from current to amount, along
+// with its denom index entry and the supply of denom.
+func overrideBalance(store storetypes.KVStore, addr sdk.AccAddress, denom string, current, amount math.Int) error {
+	diff := amount.Sub(current)
+	if diff.IsZero() {
+		return nil
+	}
+
+	balanceKey, err := collections.EncodeKeyWithPrefix(banktypes.BalancesPrefix, banktypes.BalancesKeyCodec, collections.Join(addr, denom))
+	if err != nil {
+		return err
+	}
+	indexKey, err := collections.EncodeKeyWithPrefix(banktypes.DenomAddressPrefix, banktypes.DenomAddressKeyCodec, collections.Join(denom, addr))
+	if err != nil {
+		return err
+	}
+	if amount.IsZero() {
+		store.Delete(balanceKey)
+		store.Delete(indexKey)
+	} else {
+		bz, err := banktypes.BalanceValueCodec.Encode(amount)
+		if err != nil {
+			return err
+		}
+		store.Set(balanceKey, bz)
+		store.Set(indexKey, []byte{})
+	}
+
+	supplyKey, err := collections.EncodeKeyWithPrefix(banktypes.SupplyKey, banktypes.SupplyKeyCodec, denom)
+	if err != nil {
+		return err
+	}
+	supply := math.ZeroInt()
+	if bz := store.Get(supplyKey); bz != nil {
+		if supply, err = sdk.IntValue.Decode(bz); err != nil {
+			return err
+		}
+	}
+	supply = supply.Add(diff)
+	switch {
+	case supply.IsNegative():
+		return fmt.Errorf("negative %s supply after the balance override", denom)
+	case supply.IsZero():
+		store.Delete(supplyKey)
+	default:
+		bz, err := sdk.IntValue.Encode(supply)
+		if err != nil {
+			return err
+		}
+		store.Set(supplyKey, bz)
+	}
+	return nil
+}
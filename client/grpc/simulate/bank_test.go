@@ -0,0 +1,67 @@
+package simulate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestOverrideBalances(t *testing.T) {
+	bankKey := storetypes.NewKVStoreKey("bank")
+	ctx := testutil.DefaultContext(bankKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(bankKey)
+	addr := sdk.AccAddress("addr________________")
+	other := sdk.AccAddress("other_______________")
+
+	require.NoError(t, OverrideBalances(ctx, bankKey, other, sdk.NewCoins(sdk.NewInt64Coin("foo", 5))))
+	require.NoError(t, OverrideBalances(ctx, bankKey, addr, sdk.NewCoins(sdk.NewInt64Coin("foo", 100), sdk.NewInt64Coin("bar", 50))))
+
+	// the foo balance is lowered, the bar balance is removed and the stake
+	// balance is added
+	balances := sdk.NewCoins(sdk.NewInt64Coin("foo", 40), sdk.NewInt64Coin("stake", 10))
+	require.NoError(t, OverrideBalances(ctx, bankKey, addr, balances))
+
+	got, err := bankBalances(store, addr)
+	require.NoError(t, err)
+	require.Equal(t, balances, got)
+
+	supply := func(denom string) []byte {
+		key, err := collections.EncodeKeyWithPrefix(banktypes.SupplyKey, banktypes.SupplyKeyCodec, denom)
+		require.NoError(t, err)
+		return store.Get(key)
+	}
+	require.Equal(t, []byte("45"), supply("foo"))
+	require.Nil(t, supply("bar"))
+	require.Equal(t, []byte("10"), supply("stake"))
+
+	denomIndex := func(denom string) bool {
+		key, err := collections.EncodeKeyWithPrefix(banktypes.DenomAddressPrefix, banktypes.DenomAddressKeyCodec, collections.Join(denom, addr))
+		require.NoError(t, err)
+		return store.Has(key)
+	}
+	require.True(t, denomIndex("foo"))
+	require.False(t, denomIndex("bar"))
+
+	// balances stored as coins are decoded too
+	legacyKey, err := collections.EncodeKeyWithPrefix(banktypes.BalancesPrefix, banktypes.BalancesKeyCodec, collections.Join(other, "legacy"))
+	require.NoError(t, err)
+	legacyCoin := sdk.NewInt64Coin("legacy", 7)
+	legacyBz, err := legacyCoin.Marshal()
+	require.NoError(t, err)
+	store.Set(legacyKey, legacyBz)
+	got, err = bankBalances(store, other)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 5), sdk.NewInt64Coin("legacy", 7)), got)
+
+	// the legacy balance has no supply to lower
+	require.ErrorContains(t, OverrideBalances(ctx, bankKey, other, nil), "negative legacy supply")
+	require.Error(t, OverrideBalances(ctx, bankKey, addr, sdk.Coins{{Denom: "foo", Amount: math.NewInt(-1)}}))
+}
@@ -0,0 +1,152 @@
+package simulate
+
+import (
+	"context"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Options are the options of a traced tx simulation.
+type Options struct {
+	// KeyOverrides set or delete raw keys in the module stores before the tx is
+	// simulated.
+	KeyOverrides []KeyOverride
+	// Overrides modify the state before the tx is simulated, after the key
+	// overrides, e.g. to set sequences through a keeper.
+	Overrides []func(ctx sdk.Context) error
+	// SkipSignatures runs the tx as if signature verification was disabled.
+	SkipSignatures bool
+}
+
+// Simulator simulates txs on the latest state modified by overrides, and
+// returns their execution traces. BaseApp implements it.
+type Simulator interface {
+	SimulateWithTrace(txBytes []byte, opts Options) (sdk.GasInfo, *sdk.Result, *ExecutionTrace, error)
+}
+
+// AccountKeeper defines the account keeper the sequence overrides are applied
+// with.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	SetAccount(ctx context.Context, acc sdk.AccountI)
+}
+
+// RegisterSimulateService registers the simulate gRPC service on the provided
+// gRPC router. The balance overrides are written to the x/bank store of
+// bankKey, see OverrideBalances. The account keeper and the bank store key may
+// be nil, in which case the sequence and balance overrides are rejected.
+func RegisterSimulateService(server gogogrpc.Server, simulator Simulator, addressCodec address.Codec, ak AccountKeeper, bankKey storetypes.StoreKey) {
+	RegisterServiceServer(server, NewSimulateServer(simulator, addressCodec, ak, bankKey))
+}
+
+// RegisterGRPCGatewayRoutes mounts the simulate gRPC service's GRPC-gateway
+// routes on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
+
+var _ ServiceServer = simulateServer{}
+
+type simulateServer struct {
+	simulator    Simulator
+	addressCodec address.Codec
+	ak           AccountKeeper
+	bankKey      storetypes.StoreKey
+}
+
+// NewSimulateServer creates a new simulate service server.
+func NewSimulateServer(simulator Simulator, addressCodec address.Codec, ak AccountKeeper, bankKey storetypes.StoreKey) ServiceServer {
+	return simulateServer{
+		simulator:    simulator,
+		addressCodec: addressCodec,
+		ak:           ak,
+		bankKey:      bankKey,
+	}
+}
+
+// Simulate implements the ServiceServer.Simulate RPC method.
+func (s simulateServer) Simulate(_ context.Context, req *SimulateRequest) (*SimulateResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty txBytes is not allowed")
+	}
+
+	opts, err := s.options(req)
+	if err != nil {
+		return nil, err
+	}
+
+	gasInfo, result, trace, err := s.simulator.SimulateWithTrace(req.TxBytes, opts)
+	if err != nil && trace == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid state overrides: %v", err)
+	}
+
+	resp := &SimulateResponse{
+		GasInfo: &gasInfo,
+		Result:  result,
+		Trace:   trace,
+	}
+	if err != nil {
+		resp.Codespace, resp.Code, resp.Log = errorsmod.ABCIInfo(err, false)
+	}
+	return resp, nil
+}
+
+// options returns the simulation options of req.
+func (s simulateServer) options(req *SimulateRequest) (Options, error) {
+	opts := Options{SkipSignatures: req.SkipSignatures}
+	if req.Overrides == nil {
+		return opts, nil
+	}
+	opts.KeyOverrides = req.Overrides.Keys
+
+	for _, o := range req.Overrides.Sequences {
+		if s.ak == nil {
+			return Options{}, status.Error(codes.Unimplemented, "sequence overrides are not supported")
+		}
+		addr, err := s.addressCodec.StringToBytes(o.Address)
+		if err != nil {
+			return Options{}, status.Errorf(codes.InvalidArgument, "invalid sequence override address %s: %v", o.Address, err)
+		}
+		sequence := o.Sequence
+		opts.Overrides = append(opts.Overrides, func(ctx sdk.Context) error {
+			acc := s.ak.GetAccount(ctx, addr)
+			if acc == nil {
+				acc = s.ak.NewAccountWithAddress(ctx, addr)
+			}
+			if err := acc.SetSequence(sequence); err != nil {
+				return err
+			}
+			s.ak.SetAccount(ctx, acc)
+			return nil
+		})
+	}
+
+	for _, o := range req.Overrides.Balances {
+		if s.bankKey == nil {
+			return Options{}, status.Error(codes.Unimplemented, "balance overrides are not supported")
+		}
+		addr, err := s.addressCodec.StringToBytes(o.Address)
+		if err != nil {
+			return Options{}, status.Errorf(codes.InvalidArgument, "invalid balance override address %s: %v", o.Address, err)
+		}
+		if err := o.Balances.Validate(); err != nil {
+			return Options{}, status.Errorf(codes.InvalidArgument, "invalid balance override of %s: %v", o.Address, err)
+		}
+		balances := o.Balances
+		opts.Overrides = append(opts.Overrides, func(ctx sdk.Context) error {
+			return OverrideBalances(ctx, s.bankKey, addr, balances)
+		})
+	}
+
+	return opts, nil
+}
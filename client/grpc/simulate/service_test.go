@@ -0,0 +1,120 @@
+package simulate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// mockSimulator applies the overrides on ctx and returns err.
+type mockSimulator struct {
+	ctx sdk.Context
+	err error
+}
+
+func (s mockSimulator) SimulateWithTrace(_ []byte, opts Options) (sdk.GasInfo, *sdk.Result, *ExecutionTrace, error) {
+	for _, override := range opts.Overrides {
+		if err := override(s.ctx); err != nil {
+			return sdk.GasInfo{}, nil, nil, err
+		}
+	}
+	if s.err != nil {
+		return sdk.GasInfo{GasUsed: 10}, nil, &ExecutionTrace{}, s.err
+	}
+	return sdk.GasInfo{GasUsed: 10}, &sdk.Result{}, &ExecutionTrace{}, nil
+}
+
+type mockAccountKeeper struct {
+	accounts map[string]sdk.AccountI
+}
+
+func (k mockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return k.accounts[addr.String()]
+}
+
+func (k mockAccountKeeper) NewAccountWithAddress(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+
+func (k mockAccountKeeper) SetAccount(_ context.Context, acc sdk.AccountI) {
+	k.accounts[acc.GetAddress().String()] = acc
+}
+
+func TestSimulate(t *testing.T) {
+	ac := address.NewBech32Codec("cosmos")
+	addr := sdk.AccAddress("addr________________")
+	addrStr, err := ac.BytesToString(addr)
+	require.NoError(t, err)
+	overrides := &StateOverrides{
+		Balances:  []BalanceOverride{{Address: addrStr, Balances: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}},
+		Sequences: []SequenceOverride{{Address: addrStr, Sequence: 3}},
+	}
+
+	bankKey := storetypes.NewKVStoreKey("bank")
+	ctx := testutil.DefaultContext(bankKey, storetypes.NewTransientStoreKey("transient_test"))
+	ak := mockAccountKeeper{accounts: make(map[string]sdk.AccountI)}
+	srv := NewSimulateServer(mockSimulator{ctx: ctx}, ac, ak, bankKey)
+	resp, err := srv.Simulate(context.Background(), &SimulateRequest{TxBytes: []byte("tx"), Overrides: overrides})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Result)
+	require.Equal(t, uint64(10), resp.GasInfo.GasUsed)
+	require.Zero(t, resp.Code)
+	require.Equal(t, uint64(3), ak.accounts[addr.String()].GetSequence())
+	balances, err := bankBalances(ctx.KVStore(bankKey), addr)
+	require.NoError(t, err)
+	require.Equal(t, overrides.Balances[0].Balances, balances)
+
+	// the errors of the simulated txs are returned in the response
+	srv = NewSimulateServer(mockSimulator{err: sdkerrors.ErrInsufficientFunds}, ac, nil, nil)
+	resp, err = srv.Simulate(context.Background(), &SimulateRequest{TxBytes: []byte("tx")})
+	require.NoError(t, err)
+	require.Nil(t, resp.Result)
+	require.NotNil(t, resp.Trace)
+	require.Equal(t, sdkerrors.ErrInsufficientFunds.ABCICode(), resp.Code)
+	require.Equal(t, sdkerrors.ErrInsufficientFunds.Codespace(), resp.Codespace)
+
+	testCases := map[string]struct {
+		srv     ServiceServer
+		req     *SimulateRequest
+		expCode codes.Code
+	}{
+		"no tx": {
+			srv:     srv,
+			req:     &SimulateRequest{},
+			expCode: codes.InvalidArgument,
+		},
+		"no keepers": {
+			srv:     srv,
+			req:     &SimulateRequest{TxBytes: []byte("tx"), Overrides: overrides},
+			expCode: codes.Unimplemented,
+		},
+		"invalid address": {
+			srv:     NewSimulateServer(mockSimulator{ctx: ctx}, ac, ak, bankKey),
+			req:     &SimulateRequest{TxBytes: []byte("tx"), Overrides: &StateOverrides{Sequences: []SequenceOverride{{Address: "invalid"}}}},
+			expCode: codes.InvalidArgument,
+		},
+		"invalid balances": {
+			srv:     NewSimulateServer(mockSimulator{ctx: ctx}, ac, ak, bankKey),
+			req:     &SimulateRequest{TxBytes: []byte("tx"), Overrides: &StateOverrides{Balances: []BalanceOverride{{Address: addrStr, Balances: sdk.Coins{{Denom: "stake", Amount: math.NewInt(-1)}}}}}},
+			expCode: codes.InvalidArgument,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.srv.Simulate(context.Background(), tc.req)
+			require.Equal(t, tc.expCode, status.Code(err), err)
+		})
+	}
+}
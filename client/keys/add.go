@@ -29,18 +29,19 @@ import (
 )
 
 const (
-	flagInteractive  = "interactive"
-	flagRecover      = "recover"
-	flagNoBackup     = "no-backup"
-	flagCoinType     = "coin-type"
-	flagAccount      = "account"
-	flagIndex        = "index"
-	flagMultisig     = "multisig"
-	flagNoSort       = "nosort"
-	flagHDPath       = "hd-path"
-	flagPubKeyBase64 = "pubkey-base64"
-	flagIndiscreet   = "indiscreet"
-	flagMnemonicSrc  = "source"
+	flagInteractive     = "interactive"
+	flagRecover         = "recover"
+	flagNoBackup        = "no-backup"
+	flagCoinType        = "coin-type"
+	flagAccount         = "account"
+	flagIndex           = "index"
+	flagMultisig        = "multisig"
+	flagMultisigWeights = "multisig-weights"
+	flagNoSort          = "nosort"
+	flagHDPath          = "hd-path"
+	flagPubKeyBase64    = "pubkey-base64"
+	flagIndiscreet      = "indiscreet"
+	flagMnemonicSrc     = "source"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -74,13 +75,21 @@ sorted by address, unless the flag --nosort is set.
 Example:
 
     keys add mymultisig --multisig "keyname1,keyname2,keyname3" --multisig-threshold 2
+
+Passing the weights of the keys through --multisig-weights creates a weighted multisig key
+instead, whose threshold is the total weight of the keys whose signatures are required.
+Keys can themselves be multisig keys.
+Example:
+
+    keys add mymultisig --multisig "keyname1,keyname2,keyname3" --multisig-weights "2,1,1" --multisig-threshold 3
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmdPrepare,
 	}
 	f := cmd.Flags()
 	f.StringSlice(flagMultisig, nil, "List of key names stored in keyring to construct a public legacy multisig key")
-	f.Int(flagMultiSigThreshold, 1, "K out of N required signatures. For use in conjunction with --multisig")
+	f.Int(flagMultiSigThreshold, 1, "K out of N required signatures, or required total weight with --multisig-weights. For use in conjunction with --multisig")
+	f.UintSlice(flagMultisigWeights, nil, "Weights of the keys passed to --multisig, to construct a public weighted multisig key")
 	f.Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
 	f.String(FlagPublicKey, "", "Parse a public key in JSON format and saves key info to <name> file.")
 	f.String(flagPubKeyBase64, "", "Parse a public key in base64 format and saves key info.")
@@ -173,7 +182,12 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		if len(multisigKeys) != 0 {
 			pks := make([]cryptotypes.PubKey, len(multisigKeys))
 			multisigThreshold, _ := cmd.Flags().GetInt(flagMultiSigThreshold)
-			if err := validateMultisigThreshold(multisigThreshold, len(multisigKeys)); err != nil {
+			multisigWeights, _ := cmd.Flags().GetUintSlice(flagMultisigWeights)
+			weights, totalWeight, err := getMultisigWeights(multisigWeights, len(multisigKeys))
+			if err != nil {
+				return err
+			}
+			if err := validateMultisigThreshold(multisigThreshold, totalWeight); err != nil {
 				return err
 			}
 
@@ -197,12 +211,16 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 			}
 
 			if noSort, _ := cmd.Flags().GetBool(flagNoSort); !noSort {
-				sort.Slice(pks, func(i, j int) bool {
-					return bytes.Compare(pks[i].Address(), pks[j].Address()) < 0
-				})
+				// the weights follow their keys
+				sort.Sort(multisigMembers{pks, weights})
 			}
 
-			pk := multisig.NewLegacyAminoPubKey(multisigThreshold, pks)
+			var pk cryptotypes.PubKey
+			if len(multisigWeights) != 0 {
+				pk = multisig.NewWeightedPubKey(uint64(multisigThreshold), pks, weights)
+			} else {
+				pk = multisig.NewLegacyAminoPubKey(multisigThreshold, pks)
+			}
 			k, err := kb.SaveMultisig(name, pk)
 			if err != nil {
 				return err
@@ -442,3 +460,42 @@ func readMnemonicFromFile(filePath string) (string, error) {
 	}
 	return string(bz), nil
 }
+
+// getMultisigWeights returns the weights of n multisig keys and their total,
+// each key weighing 1 if no weights are given.
+func getMultisigWeights(weights []uint, n int) ([]uint64, int, error) {
+	if len(weights) != 0 && len(weights) != n {
+		return nil, 0, fmt.Errorf("%d multisig weights given for %d keys", len(weights), n)
+	}
+
+	res := make([]uint64, n)
+	total := 0
+	for i := range res {
+		res[i] = 1
+		if len(weights) != 0 {
+			if weights[i] == 0 {
+				return nil, 0, errors.New("multisig weights must be positive integers")
+			}
+			res[i] = uint64(weights[i])
+		}
+		total += int(res[i])
+	}
+	return res, total, nil
+}
+
+// multisigMembers sorts multisig keys by address along with their weights.
+type multisigMembers struct {
+	pks     []cryptotypes.PubKey
+	weights []uint64
+}
+
+func (m multisigMembers) Len() int { return len(m.pks) }
+
+func (m multisigMembers) Less(i, j int) bool {
+	return bytes.Compare(m.pks[i].Address(), m.pks[j].Address()) < 0
+}
+
+func (m multisigMembers) Swap(i, j int) {
+	m.pks[i], m.pks[j] = m.pks[j], m.pks[i]
+	m.weights[i], m.weights[j] = m.weights[j], m.weights[i]
+}
@@ -16,6 +16,8 @@ import (
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -214,6 +216,79 @@ func Test_runAddCmdMultisigDupKeys(t *testing.T) {
 	require.EqualError(t, cmd.ExecuteContext(ctx), "duplicate multisig keys: keyname1")
 }
 
+func Test_runAddCmdMultisigWeights(t *testing.T) {
+	mockIn := testutil.ApplyMockIODiscardOutErr(AddKeyCommand())
+	kbHome := t.TempDir()
+
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithInput(mockIn).
+		WithCodec(cdc).
+		WithAddressCodec(addresscodec.NewBech32Codec("cosmos")).
+		WithValidatorAddressCodec(addresscodec.NewBech32Codec("cosmosvaloper")).
+		WithConsensusAddressCodec(addresscodec.NewBech32Codec("cosmosvalcons"))
+
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	keyPubs := make(map[string]cryptotypes.PubKey)
+	for _, name := range []string{"keyname1", "keyname2"} {
+		k, err := kb.NewAccount(name, testdata.TestMnemonic, "", fmt.Sprintf("m/44'/118'/0'/0/%d", len(keyPubs)), hd.Secp256k1)
+		require.NoError(t, err)
+		keyPubs[name], err = k.GetPubKey()
+		require.NoError(t, err)
+	}
+
+	baseArgs := []string{
+		"multisigname",
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+		fmt.Sprintf("--%s=%s", flags.FlagOutput, flags.OutputFormatText),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=%s", flagMultisig, "keyname1,keyname2"),
+	}
+
+	// slice flags are appended to by each execution, use a new command
+	runAddCmd := func(args ...string) error {
+		cmd := AddKeyCommand()
+		cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+		_ = testutil.ApplyMockIODiscardOutErr(cmd)
+		cmd.SetArgs(append(baseArgs, args...))
+		return cmd.ExecuteContext(ctx)
+	}
+
+	require.EqualError(t, runAddCmd(
+		fmt.Sprintf("--%s=%s", flagMultisigWeights, "2,1"),
+		fmt.Sprintf("--%s=%s", flagMultiSigThreshold, "4"),
+	), "threshold k of n multisignature: 3 < 4")
+	require.EqualError(t, runAddCmd(
+		fmt.Sprintf("--%s=%s", flagMultisigWeights, "2"),
+		fmt.Sprintf("--%s=%s", flagMultiSigThreshold, "2"),
+	), "1 multisig weights given for 2 keys")
+	require.NoError(t, runAddCmd(
+		fmt.Sprintf("--%s=%s", flagMultisigWeights, "2,1"),
+		fmt.Sprintf("--%s=%s", flagMultiSigThreshold, "2"),
+	))
+
+	k, err := kb.Key("multisigname")
+	require.NoError(t, err)
+	pk, err := k.GetPubKey()
+	require.NoError(t, err)
+	weighted, ok := pk.(*multisig.WeightedPubKey)
+	require.True(t, ok)
+	require.Equal(t, uint(2), weighted.GetThreshold())
+	// the weights follow their keys once sorted
+	for i, member := range weighted.GetPubKeys() {
+		weight := uint64(1)
+		if member.Equals(keyPubs["keyname1"]) {
+			weight = 2
+		}
+		require.Equal(t, weight, weighted.GetWeights()[i])
+	}
+}
+
 func Test_runAddCmdDryRun(t *testing.T) {
 	pubkey1 := `{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"AtObiFVE4s+9+RX5SP8TN9r2mxpoaT4eGj9CJfK7VRzN"}`
 	pubkey2 := `{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A/se1vkqgdQ7VJQCM4mxN+L+ciGhnnJ4XYsQCRBMrdRi"}`
@@ -0,0 +1,129 @@
+package keys
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const (
+	flagTLSCert     = "tls-cert"
+	flagTLSKey      = "tls-key"
+	flagTLSClientCA = "tls-client-ca"
+)
+
+// ServeRemoteSignerCmd serves the keys of the keyring to the remote keyring backend.
+func ServeRemoteSignerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve-remote-signer <host:port>",
+		Short: "Serve the keys of the keyring to the remote keyring backend",
+		Long: `Serve the RemoteSigner gRPC service at the given address, signing with the keys of the keyring.
+Clients using the remote keyring backend with --keyring-remote-signer set to the address can then list
+the local and Ledger keys of the keyring and sign with them.
+
+The remote keyring backend only reaches a signer which is not on a loopback address over TLS. Set
+--tls-cert and --tls-key to serve over TLS, and --tls-client-ca to only accept clients presenting a
+certificate signed by that CA. Without a client CA the signer signs every request it receives, it
+must then only be reachable by trusted clients.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runServeRemoteSignerCmd,
+	}
+
+	cmd.Flags().String(flagTLSCert, "", "The certificate file served to the clients")
+	cmd.Flags().String(flagTLSKey, "", "The private key file of the served certificate")
+	cmd.Flags().String(flagTLSClientCA, "", "The CA certificate file the client certificates must be signed by")
+
+	return cmd
+}
+
+func runServeRemoteSignerCmd(cmd *cobra.Command, args []string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	lis, err := net.Listen("tcp", args[0])
+	if err != nil {
+		return err
+	}
+
+	serverOpts := []grpc.ServerOption{grpc.ForceServerCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec())}
+	creds, err := remoteSignerServerCredentials(cmd)
+	if err != nil {
+		return err
+	}
+	if creds != nil {
+		serverOpts = append(serverOpts, grpc.Creds(creds))
+	}
+
+	srv := grpc.NewServer(serverOpts...)
+	keyring.RegisterRemoteSignerServer(srv, keyring.NewRemoteSignerServer(clientCtx.Keyring))
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		<-ctx.Done()
+		srv.GracefulStop()
+	}()
+
+	cmd.Printf("serving remote signer on %s\n", lis.Addr())
+	return srv.Serve(lis)
+}
+
+// remoteSignerServerCredentials returns the TLS credentials set with the flags
+// of the command, or nil when the signer is served in plaintext.
+func remoteSignerServerCredentials(cmd *cobra.Command) (credentials.TransportCredentials, error) {
+	certFile, _ := cmd.Flags().GetString(flagTLSCert)
+	keyFile, _ := cmd.Flags().GetString(flagTLSKey)
+	clientCAFile, _ := cmd.Flags().GetString(flagTLSClientCA)
+
+	if certFile == "" && keyFile == "" {
+		if clientCAFile != "" {
+			return nil, fmt.Errorf("--%s requires --%s and --%s", flagTLSClientCA, flagTLSCert, flagTLSKey)
+		}
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the signer certificate: %w", err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if clientCAFile != "" {
+		pem, err := os.ReadFile(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no certificate found in the client CA file")
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
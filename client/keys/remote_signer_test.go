@@ -0,0 +1,122 @@
+package keys
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func Test_runServeRemoteSignerCmd(t *testing.T) {
+	testServeRemoteSigner(t, nil, keyring.RemoteSignerTLSConfig{})
+}
+
+func Test_runServeRemoteSignerCmdTLS(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	writeTestCertificate(t, certFile, keyFile)
+
+	args := []string{"--" + flagTLSCert, certFile, "--" + flagTLSKey, keyFile, "--" + flagTLSClientCA, certFile}
+	testServeRemoteSigner(t, args, keyring.RemoteSignerTLSConfig{CAFile: certFile, CertFile: certFile, KeyFile: keyFile})
+}
+
+func testServeRemoteSigner(t *testing.T, args []string, tlsConfig keyring.RemoteSignerTLSConfig) {
+	t.Helper()
+
+	cmd := ServeRemoteSignerCmd()
+	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+	_ = testutil.ApplyMockIODiscardOutErr(cmd)
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	kb := keyring.NewInMemory(encCfg.Codec)
+	_, err := kb.NewAccount("signer", testdata.TestMnemonic, "", "", hd.Secp256k1)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithKeyring(kb).
+		WithCodec(encCfg.Codec).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry)
+
+	// pick a free port for the signer
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := lis.Addr().String()
+	require.NoError(t, lis.Close())
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+	served := make(chan error, 1)
+	go func() {
+		cmd.SetArgs(append([]string{addr}, args...))
+		served <- cmd.ExecuteContext(ctx)
+	}()
+
+	remoteCtx := client.Context{}.
+		WithCodec(encCfg.Codec).
+		WithKeyringRemoteSigner(addr).
+		WithKeyringRemoteTLS(tlsConfig)
+	remote, err := client.NewKeyringFromBackend(remoteCtx, keyring.BackendRemote)
+	require.NoError(t, err)
+
+	var records []*keyring.Record
+	require.Eventually(t, func() bool {
+		records, err = remote.List()
+		return err == nil
+	}, 5*time.Second, 50*time.Millisecond)
+	require.Len(t, records, 1)
+	require.Equal(t, "signer", records[0].Name)
+
+	msg := []byte("message")
+	sig, pub, err := remote.Sign("signer", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	cancel()
+	require.NoError(t, <-served)
+}
+
+// writeTestCertificate writes a self-signed certificate of 127.0.0.1, usable
+// both as the CA, the signer and the client certificate.
+func writeTestCertificate(t *testing.T, certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+}
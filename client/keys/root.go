@@ -26,6 +26,9 @@ The keyring supports the following backends:
     pass        Uses the pass command line utility to store and retrieve keys.
     test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
                 and it should be use only for testing purposes.
+    remote      Uses the keys of a remote signer reached over gRPC at the --keyring-remote-signer address.
+                Keys are managed on the signer side, see the serve-remote-signer command for a reference
+                signer backed by a keyring.
 
 kwallet and pass backends depend on external tools. Refer to their respective documentation for more
 information:
@@ -49,6 +52,7 @@ The pass backend requires GnuPG: https://gnupg.org/
 		RenameKeyCommand(),
 		ParseKeyStringCommand(),
 		MigrateCommand(),
+		ServeRemoteSignerCmd(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagOutput, "text", "Output format (text|json)")
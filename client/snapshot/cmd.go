@@ -19,6 +19,8 @@ func Cmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra
 		DumpArchiveCmd(),
 		LoadArchiveCmd(),
 		DeleteSnapshotCmd(),
+		FetchSnapshotCmd(),
+		VerifySnapshotCmd(),
 	)
 	return cmd
 }
@@ -4,12 +4,15 @@ import (
 	"github.com/spf13/cobra"
 
 	"cosmossdk.io/log"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
 
+const flagIncrementalFrom = "incremental-from"
+
 // ExportSnapshotCmd returns a command to take a snapshot of the application state
 func ExportSnapshotCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
 	cmd := &cobra.Command{
@@ -24,6 +27,10 @@ func ExportSnapshotCmd[T servertypes.Application](appCreator servertypes.AppCrea
 			if err != nil {
 				return err
 			}
+			baseHeight, err := cmd.Flags().GetUint64(flagIncrementalFrom)
+			if err != nil {
+				return err
+			}
 
 			home := cfg.RootDir
 			db, err := openDB(home, server.GetAppDBBackend(viper))
@@ -37,10 +44,15 @@ func ExportSnapshotCmd[T servertypes.Application](appCreator servertypes.AppCrea
 				height = app.CommitMultiStore().LastCommitID().Version
 			}
 
-			cmd.Printf("Exporting snapshot for height %d\n", height)
-
 			sm := app.SnapshotManager()
-			snapshot, err := sm.Create(uint64(height))
+			var snapshot *snapshottypes.Snapshot
+			if baseHeight > 0 {
+				cmd.Printf("Exporting incremental snapshot for height %d since height %d\n", height, baseHeight)
+				snapshot, err = sm.CreateIncremental(baseHeight, uint64(height))
+			} else {
+				cmd.Printf("Exporting snapshot for height %d\n", height)
+				snapshot, err = sm.Create(uint64(height))
+			}
 			if err != nil {
 				return err
 			}
@@ -51,6 +63,7 @@ func ExportSnapshotCmd[T servertypes.Application](appCreator servertypes.AppCrea
 	}
 
 	cmd.Flags().Int64("height", 0, "Height to export, default to latest state height")
+	cmd.Flags().Uint64(flagIncrementalFrom, 0, "Export an incremental snapshot of the changes since the height of a previous local snapshot")
 
 	return cmd
 }
@@ -0,0 +1,228 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const (
+	flagFetchConcurrency = "fetch-concurrency"
+
+	defaultFetchConcurrency = 4
+)
+
+// FetchSnapshotCmd returns a command to fetch a snapshot from a local directory or an HTTP mirror
+// into the snapshot store.
+func FetchSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fetch <source>",
+		Short: "Fetch a snapshot from a local directory or an HTTP mirror into snapshot store",
+		Long: fmt.Sprintf(`Fetch a snapshot from a local directory or an HTTP(S) mirror into snapshot store.
+
+The source contains the snapshot metadata in the %s file and its chunks in files named after
+their index, like an extracted archive created by the dump command. Chunks are fetched
+concurrently and verified against the hashes of the metadata.`, SnapshotFileName),
+		Example: "snapshots fetch https://snapshots.example.com/100-3",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			viper := client.GetViperFromCmd(cmd)
+			snapshotStore, err := server.GetSnapshotStore(viper)
+			if err != nil {
+				return err
+			}
+
+			concurrency, err := cmd.Flags().GetInt(flagFetchConcurrency)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := fetchSnapshot(cmd.Context(), newChunkSource(args[0]), snapshotStore, concurrency)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot fetched at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(flagFetchConcurrency, defaultFetchConcurrency, "Number of chunks fetched concurrently")
+
+	return cmd
+}
+
+// chunkSource opens the files of a snapshot, the metadata file and the chunks.
+type chunkSource interface {
+	open(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+// newChunkSource returns the chunk source of an HTTP(S) URL or of a local directory.
+func newChunkSource(source string) chunkSource {
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		return httpChunkSource(strings.TrimSuffix(source, "/"))
+	}
+	return dirChunkSource(source)
+}
+
+// dirChunkSource reads the snapshot files from a local directory.
+type dirChunkSource string
+
+func (d dirChunkSource) open(_ context.Context, name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(string(d), name))
+}
+
+// httpChunkSource downloads the snapshot files from an HTTP mirror.
+type httpChunkSource string
+
+func (h httpChunkSource) open(ctx context.Context, name string) (io.ReadCloser, error) {
+	url := string(h) + "/" + name
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("failed to download %s: %s", url, res.Status)
+	}
+
+	return res.Body, nil
+}
+
+// fetchedChunk is the content of a fetched chunk, or the error fetching it.
+type fetchedChunk struct {
+	body []byte
+	err  error
+}
+
+// fetchSnapshot fetches the metadata and chunks of a snapshot from src and saves them into
+// snapshotStore. Up to concurrency chunks are fetched at a time, and each chunk is checked
+// against its hash in the metadata before being saved.
+func fetchSnapshot(ctx context.Context, src chunkSource, snapshotStore *snapshots.Store, concurrency int) (*snapshottypes.Snapshot, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	bz, err := readSource(ctx, src, SnapshotFileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot metadata: %w", err)
+	}
+	var snapshot snapshottypes.Snapshot
+	if err := snapshot.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return nil, fmt.Errorf("invalid snapshot metadata: %d chunk hashes for %d chunks",
+			len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+	}
+
+	// chunks are fetched concurrently but saved in order, a slot is released once the chunk
+	// is saved so that at most concurrency chunks are held in memory
+	results := make([]chan fetchedChunk, snapshot.Chunks)
+	for i := range results {
+		results[i] = make(chan fetchedChunk, 1)
+	}
+	slots := make(chan struct{}, max(concurrency, 1))
+	go func() {
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			go func(i uint32) {
+				body, err := readSource(ctx, src, strconv.FormatUint(uint64(i), 10))
+				if err == nil {
+					if hash := sha256.Sum256(body); !bytes.Equal(hash[:], snapshot.Metadata.ChunkHashes[i]) {
+						err = fmt.Errorf("expected %x, got %x: %w", snapshot.Metadata.ChunkHashes[i], hash, snapshottypes.ErrChunkHashMismatch)
+					}
+				}
+				if err != nil {
+					err = fmt.Errorf("failed to fetch chunk %d: %w", i, err)
+				}
+				results[i] <- fetchedChunk{body: body, err: err}
+			}(i)
+		}
+	}()
+
+	chunks := make(chan io.ReadCloser)
+	type saveResult struct {
+		snapshot *snapshottypes.Snapshot
+		err      error
+	}
+	saved := make(chan saveResult, 1)
+	go func() {
+		savedSnapshot, err := saveSnapshot(snapshotStore, &snapshot, chunks)
+		saved <- saveResult{savedSnapshot, err}
+	}()
+
+	var fetchErr error
+	for i := range results {
+		chunk := <-results[i]
+		<-slots
+		if chunk.err != nil {
+			fetchErr = chunk.err
+			break
+		}
+		chunks <- io.NopCloser(bytes.NewReader(chunk.body))
+	}
+
+	if fetchErr != nil {
+		// make the save fail instead of saving a truncated snapshot
+		pr, pw := io.Pipe()
+		_ = pw.CloseWithError(fetchErr)
+		chunks <- pr
+	}
+	close(chunks)
+
+	result := <-saved
+	if fetchErr != nil {
+		return nil, fetchErr
+	}
+	if result.err != nil {
+		return nil, fmt.Errorf("failed to save snapshot: %w", result.err)
+	}
+
+	if !reflect.DeepEqual(&snapshot, result.snapshot) {
+		_ = snapshotStore.Delete(snapshot.Height, snapshot.Format)
+		return nil, errors.New("invalid snapshot, the saved snapshot is not equal to the fetched one")
+	}
+
+	return result.snapshot, nil
+}
+
+// readSource reads the whole file name from src.
+func readSource(ctx context.Context, src chunkSource, name string) ([]byte, error) {
+	reader, err := src.open(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
@@ -0,0 +1,93 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+)
+
+// writeSnapshotDir saves a snapshot with the given chunks and writes it to a directory in the
+// layout of an extracted dump archive.
+func writeSnapshotDir(t *testing.T, chunks [][]byte) (string, *snapshottypes.Snapshot) {
+	t.Helper()
+	store, err := snapshots.NewStore(coretesting.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	snapshot, err := store.SaveIncremental(3, 7, ch)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	bz, err := snapshot.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, SnapshotFileName), bz, 0o600))
+	for i, chunk := range chunks {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, strconv.Itoa(i)), chunk, 0o600))
+	}
+
+	return dir, snapshot
+}
+
+func TestFetchSnapshot(t *testing.T) {
+	chunks := [][]byte{{1, 2, 3}, {4, 5}, {6}, {7, 8, 9, 10}}
+	dir, expected := writeSnapshotDir(t, chunks)
+	server := httptest.NewServer(http.FileServer(http.Dir(dir)))
+	defer server.Close()
+
+	sources := map[string]chunkSource{
+		"directory": newChunkSource(dir),
+		"http":      newChunkSource(server.URL + "/"),
+	}
+	for name, src := range sources {
+		t.Run(name, func(t *testing.T) {
+			store, err := snapshots.NewStore(coretesting.NewMemDB(), t.TempDir())
+			require.NoError(t, err)
+
+			snapshot, err := fetchSnapshot(context.Background(), src, store, 2)
+			require.NoError(t, err)
+			require.Equal(t, expected, snapshot)
+
+			verified, err := store.Verify(snapshot.Height, snapshot.Format)
+			require.NoError(t, err)
+			require.Equal(t, expected, verified)
+		})
+	}
+}
+
+func TestFetchSnapshot_Errors(t *testing.T) {
+	dir, _ := writeSnapshotDir(t, [][]byte{{1, 2, 3}, {4, 5}, {6}})
+
+	store, err := snapshots.NewStore(coretesting.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	_, err = fetchSnapshot(context.Background(), newChunkSource(t.TempDir()), store, 2)
+	require.ErrorContains(t, err, "failed to read snapshot metadata")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "1"), []byte{4, 6}, 0o600))
+	_, err = fetchSnapshot(context.Background(), newChunkSource(dir), store, 2)
+	require.ErrorIs(t, err, snapshottypes.ErrChunkHashMismatch)
+
+	snapshot, err := store.Get(7, snapshottypes.IncrementalFormat)
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "1"), []byte{4, 5}, 0o600))
+	require.NoError(t, os.Remove(filepath.Join(dir, "2")))
+	_, err = fetchSnapshot(context.Background(), newChunkSource(dir), store, 1)
+	require.Error(t, err)
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 )
@@ -24,6 +26,10 @@ var ListSnapshotsCmd = &cobra.Command{
 			return fmt.Errorf("failed to list snapshots: %w", err)
 		}
 		for _, snapshot := range snapshots {
+			if snapshot.Format == snapshottypes.IncrementalFormat {
+				cmd.Println("height:", snapshot.Height, "format:", snapshot.Format, "chunks:", snapshot.Chunks, "base height:", snapshot.Metadata.BaseHeight)
+				continue
+			}
 			cmd.Println("height:", snapshot.Height, "format:", snapshot.Format, "chunks:", snapshot.Chunks)
 		}
 
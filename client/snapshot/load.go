@@ -13,6 +13,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -71,7 +72,7 @@ func LoadArchiveCmd() *cobra.Command {
 			go func() {
 				defer close(quitChan)
 
-				savedSnapshot, err := snapshotStore.Save(snapshot.Height, snapshot.Format, chunks)
+				savedSnapshot, err := saveSnapshot(snapshotStore, &snapshot, chunks)
 				if err != nil {
 					cmd.Println("failed to save snapshot", err)
 					return
@@ -114,3 +115,12 @@ func LoadArchiveCmd() *cobra.Command {
 		},
 	}
 }
+
+// saveSnapshot saves the chunks of snapshot into the snapshot store, keeping the base height of
+// incremental snapshots.
+func saveSnapshot(snapshotStore *snapshots.Store, snapshot *snapshottypes.Snapshot, chunks <-chan io.ReadCloser) (*snapshottypes.Snapshot, error) {
+	if snapshot.Format == snapshottypes.IncrementalFormat {
+		return snapshotStore.SaveIncremental(snapshot.Metadata.BaseHeight, snapshot.Height, chunks)
+	}
+	return snapshotStore.Save(snapshot.Height, snapshot.Format, chunks)
+}
@@ -1,27 +1,38 @@
 package snapshot
 
 import (
+	"fmt"
 	"path/filepath"
 	"strconv"
 
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
 
+const (
+	flagSource             = "source"
+	flagRestoreConcurrency = "restore-concurrency"
+)
+
 // RestoreSnapshotCmd returns a command to restore a snapshot
 func RestoreSnapshotCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "restore <height> <format>",
 		Short: "Restore app state from local snapshot",
-		Long:  "Restore app state from local snapshot",
-		Args:  cobra.ExactArgs(2),
+		Long: `Restore app state from local snapshot.
+
+An incremental snapshot is restored on top of the current app state, which must be at its base
+height. With --source, the snapshot is first fetched into the local snapshot store.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cfg := client.GetConfigFromCmd(cmd)
 			viper := client.GetViperFromCmd(cmd)
@@ -35,6 +46,30 @@ func RestoreSnapshotCmd[T servertypes.Application](appCreator servertypes.AppCre
 				return err
 			}
 
+			source, err := cmd.Flags().GetString(flagSource)
+			if err != nil {
+				return err
+			}
+			if source != "" {
+				fetchConcurrency, err := cmd.Flags().GetInt(flagFetchConcurrency)
+				if err != nil {
+					return err
+				}
+				snapshot, err := fetchSnapshotFromSource(cmd, viper, source, fetchConcurrency)
+				if err != nil {
+					return err
+				}
+				if snapshot.Height != height || snapshot.Format != uint32(format) {
+					return fmt.Errorf("fetched snapshot at height %d, format %d instead of height %d, format %d",
+						snapshot.Height, snapshot.Format, height, format)
+				}
+			}
+
+			restoreConcurrency, err := cmd.Flags().GetInt(flagRestoreConcurrency)
+			if err != nil {
+				return err
+			}
+
 			home := cfg.RootDir
 			db, err := openDB(home, server.GetAppDBBackend(viper))
 			if err != nil {
@@ -43,13 +78,38 @@ func RestoreSnapshotCmd[T servertypes.Application](appCreator servertypes.AppCre
 			logger := log.NewLogger(cmd.OutOrStdout())
 			app := appCreator(logger, db, nil, viper)
 
+			if cms, ok := app.CommitMultiStore().(interface{ SetRestoreConcurrency(int) }); ok {
+				cms.SetRestoreConcurrency(restoreConcurrency)
+			}
+
 			sm := app.SnapshotManager()
 			return sm.RestoreLocalSnapshot(height, uint32(format))
 		},
 	}
+
+	cmd.Flags().String(flagSource, "", "Fetch the snapshot from a local directory or an HTTP(S) mirror before restoring it, see the fetch command")
+	cmd.Flags().Int(flagFetchConcurrency, defaultFetchConcurrency, "Number of chunks fetched concurrently from --source")
+	cmd.Flags().Int(flagRestoreConcurrency, 4, "Number of stores restored concurrently")
+
 	return cmd
 }
 
+// fetchSnapshotFromSource fetches the snapshot of the source into the snapshot
+// store, which is closed on return as the app opens it again to restore it.
+func fetchSnapshotFromSource(cmd *cobra.Command, v *viper.Viper, source string, concurrency int) (_ *snapshottypes.Snapshot, err error) {
+	snapshotStore, err := server.GetSnapshotStore(v)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := snapshotStore.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	return fetchSnapshot(cmd.Context(), newChunkSource(source), snapshotStore, concurrency)
+}
+
 func openDB(rootDir string, backendType dbm.BackendType) (corestore.KVStoreWithBatch, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return dbm.NewDB("application", backendType, dataDir)
@@ -0,0 +1,108 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const flagAppHash = "app-hash"
+
+// VerifySnapshotCmd returns a command to verify a local snapshot offline
+func VerifySnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify <height> <format>",
+		Short: "Verify a local snapshot against its app hash",
+		Long: `Verify the chunks of a local snapshot against the hashes of its metadata, then rebuild its
+stores in memory and print the resulting app hash. When --app-hash is given, the app hash of the
+snapshot must match it. The app hash of a height is found in the header of the next block.
+
+The app hash of an incremental snapshot depends on the state it is restored on, so only its
+chunks are verified.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			viper := client.GetViperFromCmd(cmd)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			format, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			appHashStr, err := cmd.Flags().GetString(flagAppHash)
+			if err != nil {
+				return err
+			}
+			expectedAppHash, err := hex.DecodeString(appHashStr)
+			if err != nil {
+				return fmt.Errorf("invalid app hash: %w", err)
+			}
+
+			snapshotStore, err := server.GetSnapshotStore(viper)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := snapshotStore.Verify(height, uint32(format))
+			if err != nil {
+				return err
+			}
+			if snapshot == nil {
+				return errors.New("snapshot doesn't exist")
+			}
+			cmd.Printf("Verified %d chunks of snapshot at height %d, format %d\n", snapshot.Chunks, snapshot.Height, snapshot.Format)
+
+			switch snapshot.Format {
+			case snapshottypes.IncrementalFormat:
+				if len(expectedAppHash) > 0 {
+					return fmt.Errorf("the app hash of an incremental snapshot can't be verified offline, restore it on top of height %d instead", snapshot.Metadata.BaseHeight)
+				}
+				return nil
+			case snapshottypes.CurrentFormat:
+			default:
+				return fmt.Errorf("format %d: %w", snapshot.Format, snapshottypes.ErrUnknownFormat)
+			}
+
+			_, chunks, err := snapshotStore.Load(height, uint32(format))
+			if err != nil {
+				return err
+			}
+			streamReader, err := snapshots.NewStreamReader(chunks)
+			if err != nil {
+				return err
+			}
+			defer streamReader.Close()
+
+			commitInfo, _, err := rootmulti.CommitInfoFromSnapshot(height, streamReader)
+			if err != nil {
+				return err
+			}
+
+			appHash := commitInfo.Hash()
+			cmd.Printf("App hash: %X\n", appHash)
+			if len(expectedAppHash) > 0 && !bytes.Equal(appHash, expectedAppHash) {
+				return fmt.Errorf("app hash mismatch: expected %X, got %X", expectedAppHash, appHash)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagAppHash, "", "Expected app hash of the snapshot, hex encoded")
+
+	return cmd
+}
@@ -487,16 +487,22 @@ func (f Factory) getSimPK() (cryptotypes.PubKey, error) {
 // getSimSignatureData based on the pubKey type gets the correct SignatureData type
 // to use for building a simulation tx.
 func (f Factory) getSimSignatureData(pk cryptotypes.PubKey) signing.SignatureData {
-	multisigPubKey, ok := pk.(*multisig.LegacyAminoPubKey)
-	if !ok {
+	var pubKeys []cryptotypes.PubKey
+	switch pk := pk.(type) {
+	case *multisig.LegacyAminoPubKey:
+		// the first threshold members sign
+		pubKeys = pk.GetPubKeys()
+		pubKeys = pubKeys[:min(int(pk.Threshold), len(pubKeys))]
+	case *multisig.WeightedPubKey:
+		// the threshold may require the signatures of all the members
+		pubKeys = pk.GetPubKeys()
+	default:
 		return &signing.SingleSignatureData{SignMode: f.signMode}
 	}
 
-	multiSignatureData := make([]signing.SignatureData, 0, multisigPubKey.Threshold)
-	for i := uint32(0); i < multisigPubKey.Threshold; i++ {
-		multiSignatureData = append(multiSignatureData, &signing.SingleSignatureData{
-			SignMode: f.SignMode(),
-		})
+	multiSignatureData := make([]signing.SignatureData, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		multiSignatureData = append(multiSignatureData, f.getSimSignatureData(pubKey))
 	}
 
 	return &signing.MultiSignatureData{
@@ -108,6 +108,11 @@ func TestFactory_getSimSignatureData(t *testing.T) {
 			pk:       &multisig.LegacyAminoPubKey{},
 			wantType: (*signing.MultiSignatureData)(nil),
 		},
+		{
+			name:     "weighted multisig pubkey",
+			pk:       &multisig.WeightedPubKey{},
+			wantType: (*signing.MultiSignatureData)(nil),
+		},
 	}
 
 	for _, tt := range tests {
@@ -117,3 +122,20 @@ func TestFactory_getSimSignatureData(t *testing.T) {
 		})
 	}
 }
+
+func TestFactory_getSimSignatureDataNested(t *testing.T) {
+	nested := multisig.NewLegacyAminoPubKey(2, []types.PubKey{
+		secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(),
+	})
+	pk := multisig.NewWeightedPubKey(3, []types.PubKey{secp256k1.GenPrivKey().PubKey(), nested}, []uint64{1, 2})
+
+	got := Factory{}.getSimSignatureData(pk)
+	// all the members of a weighted multisig sign
+	require.IsType(t, (*signing.MultiSignatureData)(nil), got)
+	sigs := got.(*signing.MultiSignatureData).Signatures
+	require.Len(t, sigs, 2)
+	require.IsType(t, (*signing.SingleSignatureData)(nil), sigs[0])
+	// the first threshold members of a legacy multisig sign
+	require.IsType(t, (*signing.MultiSignatureData)(nil), sigs[1])
+	require.Len(t, sigs[1].(*signing.MultiSignatureData).Signatures, 2)
+}
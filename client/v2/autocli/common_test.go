@@ -8,11 +8,14 @@ import (
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/reflect/protoregistry"
 	"gotest.tools/v3/assert"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	reflectionv2alpha1 "cosmossdk.io/api/cosmos/base/reflection/v2alpha1"
 	"cosmossdk.io/client/v2/autocli/flag"
 	"cosmossdk.io/client/v2/internal/testpb"
@@ -44,6 +47,7 @@ func initFixture(t *testing.T) *fixture {
 	server := grpc.NewServer()
 	testpb.RegisterQueryServer(server, &testEchoServer{})
 	reflectionv2alpha1.RegisterReflectionServiceServer(server, &testReflectionServer{})
+	bankv1beta1.RegisterQueryServer(server, &testBankQueryServer{})
 	listener, err := net.Listen("tcp", "127.0.0.1:0")
 	assert.NilError(t, err)
 	go func() {
@@ -123,6 +127,22 @@ func runCmd(fixture *fixture, command func(moduleName string, f *fixture) (*cobr
 	return out, cmd.Execute()
 }
 
+// testBankQueryServer serves the denom metadata of the stake denom.
+type testBankQueryServer struct {
+	bankv1beta1.UnimplementedQueryServer
+}
+
+func (testBankQueryServer) DenomMetadata(_ context.Context, req *bankv1beta1.QueryDenomMetadataRequest) (*bankv1beta1.QueryDenomMetadataResponse, error) {
+	if req.Denom != "ustake" {
+		return nil, status.Errorf(codes.NotFound, "no metadata for %s", req.Denom)
+	}
+	return &bankv1beta1.QueryDenomMetadataResponse{Metadata: &bankv1beta1.Metadata{
+		Base:       "ustake",
+		Display:    "stake",
+		DenomUnits: []*bankv1beta1.DenomUnit{{Denom: "ustake"}, {Denom: "stake", Exponent: 6}},
+	}}, nil
+}
+
 type testReflectionServer struct {
 	reflectionv2alpha1.UnimplementedReflectionServiceServer
 }
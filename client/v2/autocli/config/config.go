@@ -17,7 +17,11 @@ import (
 
 type Config struct {
 	ChainID               string     `mapstructure:"chain-id" toml:"chain-id" comment:"The chain ID of the blockchain network"`
-	KeyringBackend        string     `mapstructure:"keyring-backend" toml:"keyring-backend" comment:"The keyring backend to use (os|file|kwallet|pass|test|memory)"`
+	KeyringBackend        string     `mapstructure:"keyring-backend" toml:"keyring-backend" comment:"The keyring backend to use (os|file|kwallet|pass|test|memory|remote)"`
+	KeyringRemoteSigner   string     `mapstructure:"keyring-remote-signer" toml:"keyring-remote-signer" comment:"The <host>:<port> of the signer used by the remote keyring backend"`
+	KeyringRemoteCA       string     `mapstructure:"keyring-remote-signer-ca" toml:"keyring-remote-signer-ca" comment:"The CA certificate file used to verify the remote signer, a signer which is not on a loopback address is only reached over TLS"`
+	KeyringRemoteCert     string     `mapstructure:"keyring-remote-signer-cert" toml:"keyring-remote-signer-cert" comment:"The client certificate file presented to the remote signer"`
+	KeyringRemoteKey      string     `mapstructure:"keyring-remote-signer-key" toml:"keyring-remote-signer-key" comment:"The client private key file presented to the remote signer"`
 	KeyringDefaultKeyName string     `mapstructure:"keyring-default-keyname" toml:"keyring-default-keyname" comment:"The default key name to use for signing transactions"`
 	Output                string     `mapstructure:"output" toml:"output" comment:"The output format for queries (text|json)"`
 	Node                  string     `mapstructure:"node" toml:"node" comment:"The RPC endpoint URL for the node to connect to"`
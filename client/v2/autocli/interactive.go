@@ -0,0 +1,181 @@
+package autocli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/client/v2/autocli/flag"
+	"cosmossdk.io/client/v2/autocli/prompt"
+	"cosmossdk.io/client/v2/internal/flags"
+	"cosmossdk.io/client/v2/internal/util"
+	v2tx "cosmossdk.io/client/v2/tx"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// The actions proposed once a message is built interactively.
+const (
+	actionBroadcast = "Sign and broadcast"
+	actionSave      = "Save as unsigned transaction"
+	actionCancel    = "Cancel"
+)
+
+// defaultUnsignedTxFile is the default file the unsigned transactions built
+// interactively are saved to.
+const defaultUnsignedTxFile = "unsigned_tx.json"
+
+// addInteractiveMode adds the interactive flag to a msg command. With it, the
+// positional arguments and message flags are ignored and the user is prompted
+// for each field of the message instead, then shown the SIGN_MODE_TEXTUAL
+// rendering of the transaction before choosing to sign and broadcast it, to
+// save it as an unsigned transaction or to cancel.
+func (b *Builder) addInteractiveMode(
+	cmd *cobra.Command,
+	inputDesc protoreflect.MessageDescriptor,
+	exec func(cmd *cobra.Command, input protoreflect.Message) error,
+) {
+	if cmd.Flags().Lookup(flags.FlagInteractive) != nil {
+		// a message field is already bound to the flag
+		return
+	}
+	cmd.Flags().Bool(flags.FlagInteractive, false, "Build the message interactively, prompting for each of its fields")
+
+	args, runE := cmd.Args, cmd.RunE
+	cmd.Args = func(cmd *cobra.Command, a []string) error {
+		if interactive, _ := cmd.Flags().GetBool(flags.FlagInteractive); interactive {
+			return cobra.NoArgs(cmd, a)
+		}
+		if args == nil {
+			return nil
+		}
+		return args(cmd, a)
+	}
+	cmd.RunE = func(cmd *cobra.Command, a []string) error {
+		if interactive, _ := cmd.Flags().GetBool(flags.FlagInteractive); !interactive {
+			return runE(cmd, a)
+		}
+		return b.runInteractive(cmd, inputDesc, exec)
+	}
+}
+
+// runInteractive builds the message of inputDesc interactively and executes
+// the action chosen by the user.
+func (b *Builder) runInteractive(
+	cmd *cobra.Command,
+	inputDesc protoreflect.MessageDescriptor,
+	exec func(cmd *cobra.Command, input protoreflect.Message) error,
+) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	input := util.ResolveMessageType(b.TypeResolver, inputDesc).New()
+
+	// the signer defaults to the from address
+	fd := inputDesc.Fields().ByName(protoreflect.Name(flag.GetSignerFieldName(inputDesc)))
+	if fd != nil && !clientCtx.GetFromAddress().Empty() {
+		signer, err := b.signerFromFlag(clientCtx, fd)
+		if err != nil {
+			return err
+		}
+		input.Set(fd, protoreflect.ValueOfString(signer))
+	}
+
+	stdin := promptStdin(cmd)
+	form := prompt.Form{
+		AddressCodec:          b.AddressCodec,
+		ValidatorAddressCodec: b.ValidatorAddressCodec,
+		ConsensusAddressCodec: b.ConsensusAddressCodec,
+		TypeResolver:          b.TypeResolver,
+		FileResolver:          b.FileResolver,
+		Stdin:                 stdin,
+	}
+	if _, err := form.Prompt(input); err != nil {
+		return err
+	}
+
+	preview, err := b.previewTx(cmd, clientCtx, input)
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "\n%s\n", preview)
+
+	selectUi := promptui.Select{
+		Label: "Select action",
+		Items: []string{actionBroadcast, actionSave, actionCancel},
+		Stdin: stdin,
+	}
+	_, action, err := selectUi.Run()
+	if err != nil {
+		return fmt.Errorf("failed to prompt for action: %w", err)
+	}
+
+	switch action {
+	case actionBroadcast:
+		return exec(cmd, input)
+	case actionSave:
+		return b.saveUnsignedTx(cmd, input, stdin, exec)
+	default:
+		_, _ = fmt.Fprintln(cmd.ErrOrStderr(), "canceled transaction")
+		return nil
+	}
+}
+
+// saveUnsignedTx generates the unsigned transaction of input and saves it to
+// the file chosen by the user.
+func (b *Builder) saveUnsignedTx(
+	cmd *cobra.Command,
+	input protoreflect.Message,
+	stdin io.ReadCloser,
+	exec func(cmd *cobra.Command, input protoreflect.Message) error,
+) error {
+	filePrompt := promptui.Prompt{
+		Label:    "Enter the file to save the unsigned transaction to",
+		Default:  defaultUnsignedTxFile,
+		Validate: prompt.ValidatePromptNotEmpty,
+		Stdin:    stdin,
+	}
+	path, err := filePrompt.Run()
+	if err != nil {
+		return fmt.Errorf("failed to prompt for file: %w", err)
+	}
+
+	if err := cmd.Flags().Set(v2tx.FlagGenerateOnly, "true"); err != nil {
+		return fmt.Errorf("failed to generate the unsigned transaction: %w", err)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	out := cmd.OutOrStdout()
+	cmd.SetOut(f)
+	defer cmd.SetOut(out)
+	if err := exec(cmd, input); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "unsigned transaction saved to %s\n", path)
+	return nil
+}
+
+// promptStdin returns the input of the prompts of cmd, nil for the standard
+// input used by default.
+func promptStdin(cmd *cobra.Command) io.ReadCloser {
+	in := cmd.InOrStdin()
+	if in == os.Stdin {
+		return nil
+	}
+	if rc, ok := in.(io.ReadCloser); ok {
+		return rc
+	}
+	return io.NopCloser(in)
+}
@@ -45,6 +45,18 @@ func NewKeyringFromFlags(flagSet *pflag.FlagSet, ac address.Codec, input io.Read
 		}
 	}
 
+	if remoteSigner, err := flagSet.GetString("keyring-remote-signer"); err == nil && remoteSigner != "" {
+		opts = append(opts, keyring.WithRemoteSigner(remoteSigner))
+
+		var tlsConfig keyring.RemoteSignerTLSConfig
+		tlsConfig.CAFile, _ = flagSet.GetString("keyring-remote-signer-ca")
+		tlsConfig.CertFile, _ = flagSet.GetString("keyring-remote-signer-cert")
+		tlsConfig.KeyFile, _ = flagSet.GetString("keyring-remote-signer-key")
+		if !tlsConfig.IsEmpty() {
+			opts = append(opts, keyring.WithRemoteSignerTLS(tlsConfig))
+		}
+	}
+
 	k, err := keyring.New("autoclikeyring", backEnd, keyringDir, input, cdc, opts...)
 	if err != nil {
 		return nil, err
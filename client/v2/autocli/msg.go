@@ -141,21 +141,9 @@ func (b *Builder) BuildMsgMethodCommand(descriptor protoreflect.MethodDescriptor
 
 		// set signer to signer field if empty
 		if addr := input.Get(fd).String(); addr == "" {
-			scalarType, ok := flag.GetScalarType(fd)
-			if ok {
-				// override address codec if validator or consensus address
-				switch scalarType {
-				case flag.ValidatorAddressStringScalarType:
-					addressCodec = b.Builder.ValidatorAddressCodec
-				case flag.ConsensusAddressStringScalarType:
-					addressCodec = b.Builder.ConsensusAddressCodec
-				}
-			}
-
-			signerFromFlag := clientCtx.GetFromAddress()
-			signer, err := addressCodec.BytesToString(signerFromFlag.Bytes())
+			signer, err := b.signerFromFlag(clientCtx, fd)
 			if err != nil {
-				return fmt.Errorf("failed to set signer on message, got %v: %w", signerFromFlag, err)
+				return err
 			}
 
 			input.Set(fd, protoreflect.ValueOfString(signer))
@@ -188,9 +176,34 @@ func (b *Builder) BuildMsgMethodCommand(descriptor protoreflect.MethodDescriptor
 		cmd.Flags().Bool(flags.FlagNoProposal, false, "Skip gov proposal and submit a normal transaction")
 	}
 
+	b.addInteractiveMode(cmd, descriptor.Input(), execFunc)
+
 	return cmd, nil
 }
 
+// signerFromFlag returns the address of the from flag, encoded with the codec
+// of the signer field fd.
+func (b *Builder) signerFromFlag(clientCtx client.Context, fd protoreflect.FieldDescriptor) (string, error) {
+	addressCodec := b.Builder.AddressCodec
+	if scalarType, ok := flag.GetScalarType(fd); ok {
+		// override address codec if validator or consensus address
+		switch scalarType {
+		case flag.ValidatorAddressStringScalarType:
+			addressCodec = b.Builder.ValidatorAddressCodec
+		case flag.ConsensusAddressStringScalarType:
+			addressCodec = b.Builder.ConsensusAddressCodec
+		}
+	}
+
+	signerFromFlag := clientCtx.GetFromAddress()
+	signer, err := addressCodec.BytesToString(signerFromFlag.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("failed to set signer on message, got %v: %w", signerFromFlag, err)
+	}
+
+	return signer, nil
+}
+
 // handleGovProposal sets the authority field of the message to the gov module address and creates a gov proposal.
 func (b *Builder) handleGovProposal(
 	cmd *cobra.Command,
@@ -5,8 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -128,6 +130,59 @@ func TestMsg(t *testing.T) {
 	assertNormalizedJSONEqual(t, out.Bytes(), goldenLoad(t, "msg-output.golden"))
 }
 
+func TestMsgInteractive(t *testing.T) {
+	fixture := initFixture(t)
+	cmd, err := buildModuleMsgCommand("test", fixture)
+	assert.NilError(t, err)
+
+	// the from address is kept with enter (\r, as \n would replace it), and the
+	// first action, sign and broadcast, is selected
+	cmd.SetIn(promptInputs("\r", "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk", "1foo", ""))
+	out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(errOut)
+	cmd.SetArgs([]string{
+		"send", "--interactive",
+		"--from", "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk",
+		"--generate-only",
+		"--note", "a memo",
+		"--fees", "1500000ustake",
+		"--output", "json",
+		"--chain-id", fixture.chainID,
+		"--keyring-backend", fixture.kBackend,
+	})
+	assert.NilError(t, cmd.Execute())
+	assertNormalizedJSONEqual(t, out.Bytes(), goldenLoad(t, "msg-interactive-output.golden"))
+
+	// the preview is the SIGN_MODE_TEXTUAL rendering of the transaction
+	for _, screen := range []string{
+		"Chain id: " + fixture.chainID,
+		"*Address: cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk",
+		"> Message (1/1): /cosmos.bank.v1beta1.MsgSend",
+		"> > Amount: 1 foo",
+		"Memo: a memo",
+		"Fees: 1.5 stake",
+	} {
+		assert.Assert(t, strings.Contains(errOut.String(), screen+"\n"), "missing %q in preview:\n%s", screen, errOut.String())
+	}
+
+	// positional arguments are rejected in interactive mode
+	_, err = runCmd(fixture, buildModuleMsgCommand, "send", "--interactive",
+		"cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk", "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk", "1foo",
+	)
+	assert.ErrorContains(t, err, "unknown command")
+}
+
+// promptInputs returns the inputs of a sequence of prompts, padded to the
+// buffer size of the prompts reader so that each prompt reads a single input.
+func promptInputs(inputs ...string) io.Reader {
+	var sb strings.Builder
+	for _, input := range inputs {
+		sb.WriteString(input + "\n" + strings.Repeat("a", 4096-1-len(input)%4096))
+	}
+	return strings.NewReader(sb.String())
+}
+
 func goldenLoad(t *testing.T, filename string) []byte {
 	t.Helper()
 	content, err := os.ReadFile(filepath.Join("testdata", filename))
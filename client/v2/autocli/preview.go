@@ -0,0 +1,154 @@
+package autocli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/textual"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// previewTx returns the SIGN_MODE_TEXTUAL rendering of the unsigned transaction
+// of input: the screens of the message, fee, memo, signer and chain id the
+// signer reviews before signing, one per line. Expert screens start with a
+// '*' and each indentation level with a '>', as in ADR-050.
+func (b *Builder) previewTx(cmd *cobra.Command, clientCtx client.Context, input protoreflect.Message) (string, error) {
+	txf, err := clienttx.NewFactoryCLI(clientCtx, cmd.Flags())
+	if err != nil {
+		return "", err
+	}
+
+	// the account number and sequence are the ones used when signing
+	if !clientCtx.GenerateOnly {
+		if txf, err = txf.Prepare(clientCtx); err != nil {
+			return "", err
+		}
+	}
+	msg := dynamicpb.NewMessage(input.Descriptor())
+	proto.Merge(msg, input.Interface())
+	if txf.SimulateAndExecute() && !clientCtx.Offline {
+		_, adjusted, err := clienttx.CalculateGas(clientCtx, txf, msg)
+		if err != nil {
+			return "", err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	txBuilder, err := txf.BuildUnsignedTx(msg)
+	if err != nil {
+		return "", err
+	}
+
+	address, err := b.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+	if err != nil {
+		return "", err
+	}
+	signerData := txsigning.SignerData{
+		ChainID:       txf.ChainID(),
+		AccountNumber: txf.AccountNumber(),
+		Sequence:      txf.Sequence(),
+		Address:       address,
+	}
+
+	// As when signing, the signer info is set with a nil signature. It is only
+	// known when the from key is in the keyring, the unsigned transactions
+	// of other addresses being rendered without it.
+	if k, err := txf.Keybase().Key(clientCtx.FromName); err == nil {
+		pubKey, err := k.GetPubKey()
+		if err != nil {
+			return "", err
+		}
+		err = txBuilder.SetSignatures(signing.SignatureV2{
+			PubKey:   pubKey,
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_TEXTUAL},
+			Sequence: txf.Sequence(),
+		})
+		if err != nil {
+			return "", err
+		}
+
+		anyPk, err := codectypes.NewAnyWithValue(pubKey)
+		if err != nil {
+			return "", err
+		}
+		signerData.PubKey = &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value}
+	}
+
+	adaptableTx, ok := txBuilder.GetTx().(authsigning.V2AdaptableTx)
+	if !ok {
+		return "", fmt.Errorf("expected tx to be V2AdaptableTx, got %T", txBuilder.GetTx())
+	}
+
+	querier, err := b.coinMetadataQuerier(cmd, clientCtx)
+	if err != nil {
+		return "", err
+	}
+	handler, err := textual.NewSignModeHandler(textual.SignModeOptions{
+		CoinMetadataQuerier: querier,
+		FileResolver:        b.FileResolver,
+		TypeResolver:        b.TypeResolver,
+	})
+	if err != nil {
+		return "", err
+	}
+	screens, err := handler.GetScreens(cmd.Context(), signerData, adaptableTx.GetSigningTxData())
+	if err != nil {
+		return "", fmt.Errorf("failed to render the transaction: %w", err)
+	}
+
+	var sb strings.Builder
+	for _, screen := range screens {
+		if screen.Expert {
+			sb.WriteString("*")
+		}
+		sb.WriteString(strings.Repeat("> ", screen.Indent))
+		if screen.Title != "" {
+			sb.WriteString(screen.Title + ": ")
+		}
+		sb.WriteString(screen.Content + "\n")
+	}
+	return sb.String(), nil
+}
+
+// coinMetadataQuerier returns the bank denom metadata querier of the textual
+// rendering. In offline mode no metadata is known and the coins are rendered
+// in their base denom.
+func (b *Builder) coinMetadataQuerier(cmd *cobra.Command, clientCtx client.Context) (textual.CoinMetadataQueryFn, error) {
+	if clientCtx.Offline {
+		return func(context.Context, string) (*bankv1beta1.Metadata, error) {
+			return nil, nil
+		}, nil
+	}
+
+	conn, err := b.GetClientConn(cmd)
+	if err != nil {
+		return nil, err
+	}
+	queryClient := bankv1beta1.NewQueryClient(conn)
+	return func(ctx context.Context, denom string) (*bankv1beta1.Metadata, error) {
+		res, err := queryClient.DenomMetadata(ctx, &bankv1beta1.QueryDenomMetadataRequest{Denom: denom})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("failed to query %s metadata: %w", denom, err)
+		}
+		return res.Metadata, nil
+	}, nil
+}
@@ -1,139 +1,235 @@
 package prompt
 
 import (
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	cosmos_proto "github.com/cosmos/cosmos-proto"
 	"github.com/manifoldco/promptui"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
 	"cosmossdk.io/client/v2/autocli/flag"
+	"cosmossdk.io/client/v2/internal/coins"
 	addresscodec "cosmossdk.io/core/address"
 )
 
+const (
+	anyFullName       = "google.protobuf.Any"
+	coinFullName      = "cosmos.base.v1beta1.Coin"
+	decCoinFullName   = "cosmos.base.v1beta1.DecCoin"
+	timestampFullName = "google.protobuf.Timestamp"
+	durationFullName  = "google.protobuf.Duration"
+
+	// msgInterface is the interface accepted by the Any fields containing
+	// messages, implemented by the inputs of the Msg services.
+	msgInterface = "cosmos.base.v1beta1.Msg"
+)
+
 // PromptMessage prompts the user for values to populate a protobuf message interactively.
 // It returns the populated message and any error encountered during prompting.
 func PromptMessage(
 	addressCodec, validatorAddressCodec, consensusAddressCodec addresscodec.Codec,
 	promptPrefix string, msg protoreflect.Message,
 ) (protoreflect.Message, error) {
-	return promptMessage(addressCodec, validatorAddressCodec, consensusAddressCodec, promptPrefix, nil, msg)
+	f := Form{
+		AddressCodec:          addressCodec,
+		ValidatorAddressCodec: validatorAddressCodec,
+		ConsensusAddressCodec: consensusAddressCodec,
+	}
+	return f.promptMessage(promptPrefix, msg)
+}
+
+// Form holds the configuration of the message prompts. The prompts walk the
+// nested, repeated, map, oneof and Any fields of the message, and leaving a
+// field empty leaves it unset.
+// The address fields are validated with the address codecs, and the coins,
+// timestamps and durations are entered in their text form, e.g. 10stake,
+// 2006-01-02T15:04:05Z and 1h30m.
+type Form struct {
+	AddressCodec          addresscodec.Codec
+	ValidatorAddressCodec addresscodec.Codec
+	ConsensusAddressCodec addresscodec.Codec
+
+	// TypeResolver resolves the message types of the Any fields. If it is nil
+	// protoregistry.GlobalTypes is used.
+	TypeResolver protoregistry.MessageTypeResolver
+	// FileResolver lists the messages which can be set in the Any fields
+	// accepting an interface, offered as a selection. If it is nil, the type
+	// URL of the messages is prompted for.
+	FileResolver interface {
+		RangeFiles(func(protoreflect.FileDescriptor) bool)
+	}
+
+	// Stdin is the input of the prompts, os.Stdin if nil. It is provided to
+	// make the prompts easier to unit test by allowing injection of
+	// predefined inputs.
+	Stdin io.ReadCloser
+}
+
+// Prompt prompts the user for values to populate msg and returns it.
+func (f Form) Prompt(msg protoreflect.Message) (protoreflect.Message, error) {
+	return f.promptMessage(string(msg.Descriptor().Name()), msg)
 }
 
 // promptMessage prompts the user for values to populate a protobuf message interactively.
-// stdIn is provided to make the function easier to unit test by allowing injection of predefined inputs.
-func promptMessage(
-	addressCodec, validatorAddressCodec, consensusAddressCodec addresscodec.Codec,
-	promptPrefix string, stdIn io.ReadCloser, msg protoreflect.Message,
-) (protoreflect.Message, error) {
+func (f Form) promptMessage(promptPrefix string, msg protoreflect.Message) (protoreflect.Message, error) {
 	fields := msg.Descriptor().Fields()
+	oneofs := map[protoreflect.FullName]bool{}
 	for i := 0; i < fields.Len(); i++ {
 		field := fields.Get(i)
-		fieldName := string(field.Name())
 
-		promptUi := promptui.Prompt{
-			Validate: ValidatePromptNotEmpty,
-			Stdin:    stdIn,
-		}
-
-		// If this signer field has already a valid default value set,
-		// use that value as the default prompt value. This is useful for
-		// commands that have an authority such as gov.
-		if strings.EqualFold(fieldName, flag.GetSignerFieldName(msg.Descriptor())) {
-			if defaultValue := msg.Get(field); defaultValue.IsValid() {
-				promptUi.Default = defaultValue.String()
+		// only the member of a oneof selected by the user is prompted for
+		if oneof := field.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+			if oneofs[oneof.FullName()] {
+				continue
 			}
-		}
+			oneofs[oneof.FullName()] = true
 
-		// validate address fields
-		scalarField, ok := flag.GetScalarType(field)
-		if ok {
-			switch scalarField {
-			case flag.AddressStringScalarType:
-				promptUi.Validate = ValidateAddress(addressCodec)
-			case flag.ValidatorAddressStringScalarType:
-				promptUi.Validate = ValidateAddress(validatorAddressCodec)
-			case flag.ConsensusAddressStringScalarType:
-				promptUi.Validate = ValidateAddress(consensusAddressCodec)
-			default:
-				// prompt.Validate = ValidatePromptNotEmpty (we possibly don't want to force all fields to be non-empty)
-				promptUi.Validate = nil
-			}
-		}
-
-		// handle nested message fields recursively
-		if field.Kind() == protoreflect.MessageKind {
-			err := promptInnerMessageKind(field, addressCodec, validatorAddressCodec, consensusAddressCodec, promptPrefix, stdIn, msg)
+			selected, err := f.promptOneof(promptPrefix+"."+string(oneof.Name()), oneof)
 			if err != nil {
 				return nil, err
 			}
-			continue
-		}
-
-		// handle repeated fields by prompting for a comma-separated list of values
-		if field.IsList() {
-			list, err := promptList(field, msg, promptUi, promptPrefix)
-			if err != nil {
-				return nil, err
+			if selected == nil {
+				continue
 			}
-
-			msg.Set(field, protoreflect.ValueOfList(list))
-			continue
+			field = selected
 		}
 
-		promptUi.Label = fmt.Sprintf("Enter %s %s", promptPrefix, fieldName)
-		result, err := promptUi.Run()
-		if err != nil {
-			return msg, fmt.Errorf("failed to prompt for %s: %w", fieldName, err)
+		fieldName := promptPrefix + "." + string(field.Name())
+		var err error
+		switch {
+		case field.IsMap():
+			err = f.promptMap(field, msg, fieldName)
+		case field.Kind() == protoreflect.MessageKind && !(field.IsList() && isTextMessage(field.Message())):
+			// handle nested message fields recursively
+			err = f.promptInnerMessageKind(field, promptPrefix, msg)
+		case field.IsList():
+			// handle repeated fields by prompting for a comma-separated list of values
+			err = f.promptList(field, msg, fieldName)
+		default:
+			err = f.promptScalar(field, msg, fieldName)
 		}
-
-		v, err := valueOf(field, result)
 		if err != nil {
-			return msg, err
+			return nil, err
 		}
-		msg.Set(field, v)
 	}
 
 	return msg, nil
 }
 
+// promptScalar prompts for the value of a scalar field.
+func (f Form) promptScalar(field protoreflect.FieldDescriptor, msg protoreflect.Message, fieldName string) error {
+	promptUi := promptui.Prompt{
+		Label:    fmt.Sprintf("Enter %s", fieldName) + hint(field),
+		Validate: f.validate(field),
+	}
+
+	// If this field has already a value set, such as a signer field,
+	// use that value as the default prompt value. This is useful for
+	// commands that have an authority such as gov.
+	if msg.Has(field) {
+		promptUi.Default = formatScalar(field, msg.Get(field))
+	}
+
+	result, err := f.run(promptUi, fieldName)
+	if err != nil || result == "" {
+		return err
+	}
+
+	v, err := valueOf(field, result)
+	if err != nil {
+		return err
+	}
+	msg.Set(field, v)
+	return nil
+}
+
+// promptOneof prompts for the member of oneof to set, if any.
+func (f Form) promptOneof(oneofName string, oneof protoreflect.OneofDescriptor) (protoreflect.FieldDescriptor, error) {
+	names := make([]string, oneof.Fields().Len())
+	for i := range names {
+		names[i] = string(oneof.Fields().Get(i).Name())
+	}
+
+	result, err := f.run(promptui.Prompt{
+		Label: fmt.Sprintf("Enter %s (one of %s)", oneofName, strings.Join(names, ", ")),
+		Validate: func(input string) error {
+			if input != "" && oneof.Fields().ByName(protoreflect.Name(input)) == nil {
+				return fmt.Errorf("must be one of %s", strings.Join(names, ", "))
+			}
+			return nil
+		},
+	}, oneofName)
+	if err != nil || result == "" {
+		return nil, err
+	}
+
+	return oneof.Fields().ByName(protoreflect.Name(result)), nil
+}
+
 // valueOf converts a string input value to a protoreflect.Value based on the field's type.
-// It handles string, numeric, bool, bytes and enum field types.
+// It handles string, numeric, bool, bytes (base64 encoded) and enum field types.
 // Returns the converted value and any error that occurred during conversion.
 func valueOf(field protoreflect.FieldDescriptor, result string) (protoreflect.Value, error) {
 	switch field.Kind() {
 	case protoreflect.StringKind:
 		return protoreflect.ValueOfString(result), nil
-	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
-		resultUint, err := strconv.ParseUint(result, 10, 0)
-		if err != nil {
-			return protoreflect.Value{}, fmt.Errorf("invalid value for int: %w", err)
-		}
-
-		return protoreflect.ValueOfUint64(resultUint), nil
-	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind, protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
-		resultInt, err := strconv.ParseInt(result, 10, 0)
-		if err != nil {
-			return protoreflect.Value{}, fmt.Errorf("invalid value for int: %w", err)
-		}
-		// If a value was successfully parsed the ranges of:
-		//      [minInt,     maxInt]
-		// are within the ranges of:
-		//      [minInt64, maxInt64]
-		// of which on 64-bit machines, which are most common,
-		// int==int64
-		return protoreflect.ValueOfInt64(resultInt), nil
 	case protoreflect.BoolKind:
 		resultBool, err := strconv.ParseBool(result)
 		if err != nil {
 			return protoreflect.Value{}, fmt.Errorf("invalid value for bool: %w", err)
 		}
-
 		return protoreflect.ValueOfBool(resultBool), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		resultInt, err := strconv.ParseInt(result, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid value for int32: %w", err)
+		}
+		return protoreflect.ValueOfInt32(int32(resultInt)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		resultInt, err := strconv.ParseInt(result, 10, 64)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid value for int64: %w", err)
+		}
+		return protoreflect.ValueOfInt64(resultInt), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		resultUint, err := strconv.ParseUint(result, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid value for uint32: %w", err)
+		}
+		return protoreflect.ValueOfUint32(uint32(resultUint)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		resultUint, err := strconv.ParseUint(result, 10, 64)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid value for uint64: %w", err)
+		}
+		return protoreflect.ValueOfUint64(resultUint), nil
+	case protoreflect.FloatKind:
+		resultFloat, err := strconv.ParseFloat(result, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid value for float: %w", err)
+		}
+		return protoreflect.ValueOfFloat32(float32(resultFloat)), nil
+	case protoreflect.DoubleKind:
+		resultFloat, err := strconv.ParseFloat(result, 64)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid value for double: %w", err)
+		}
+		return protoreflect.ValueOfFloat64(resultFloat), nil
 	case protoreflect.BytesKind:
-		resultBytes := []byte(result)
+		resultBytes, err := base64.StdEncoding.DecodeString(result)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid value for bytes, expected base64: %w", err)
+		}
 		return protoreflect.ValueOfBytes(resultBytes), nil
 	case protoreflect.EnumKind:
 		enumValue := field.Enum().Values().ByName(protoreflect.Name(result))
@@ -142,118 +238,484 @@ func valueOf(field protoreflect.FieldDescriptor, result string) (protoreflect.Va
 		}
 		return protoreflect.ValueOfEnum(enumValue.Number()), nil
 	default:
-		// TODO: add more kinds
-		// skip any other types
+		return protoreflect.Value{}, fmt.Errorf("unsupported field kind %s", field.Kind())
+	}
+}
+
+// textMessageValueOf converts the text form of a coin, timestamp or duration
+// to a protoreflect.Value, setting it in msg, an empty message of the field.
+// If msg is nil, the value is only validated.
+func textMessageValueOf(field protoreflect.FieldDescriptor, msg protoreflect.Message, result string) (protoreflect.Value, error) {
+	var values map[string]protoreflect.Value
+	switch field.Message().FullName() {
+	case coinFullName:
+		coin, err := coins.ParseCoin(result)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid coin %q: %w", result, err)
+		}
+		values = map[string]protoreflect.Value{"denom": protoreflect.ValueOfString(coin.Denom), "amount": protoreflect.ValueOfString(coin.Amount)}
+	case decCoinFullName:
+		coin, err := coins.ParseDecCoin(result)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid coin %q: %w", result, err)
+		}
+		values = map[string]protoreflect.Value{"denom": protoreflect.ValueOfString(coin.Denom), "amount": protoreflect.ValueOfString(coin.Amount)}
+	case timestampFullName:
+		t, err := time.Parse(time.RFC3339Nano, result)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid timestamp %q, expected RFC 3339 format", result)
+		}
+		values = map[string]protoreflect.Value{"seconds": protoreflect.ValueOfInt64(t.Unix()), "nanos": protoreflect.ValueOfInt32(int32(t.Nanosecond()))}
+	case durationFullName:
+		d, err := time.ParseDuration(result)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid duration %q", result)
+		}
+		values = map[string]protoreflect.Value{
+			"seconds": protoreflect.ValueOfInt64(int64(d / time.Second)),
+			"nanos":   protoreflect.ValueOfInt32(int32(d % time.Second)),
+		}
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported message %s", field.Message().FullName())
+	}
+
+	if msg == nil {
 		return protoreflect.Value{}, nil
 	}
+	for name, value := range values {
+		msg.Set(msg.Descriptor().Fields().ByName(protoreflect.Name(name)), value)
+	}
+	return protoreflect.ValueOfMessage(msg), nil
+}
+
+// validate returns the validation of the text values of field: the
+// addresses are validated by their codec and the other values by parsing
+// them. Empty values are valid, they leave the field unset.
+func (f Form) validate(field protoreflect.FieldDescriptor) func(string) error {
+	if scalarType, ok := flag.GetScalarType(field); ok {
+		var ac addresscodec.Codec
+		switch scalarType {
+		case flag.AddressStringScalarType:
+			ac = f.AddressCodec
+		case flag.ValidatorAddressStringScalarType:
+			ac = f.ValidatorAddressCodec
+		case flag.ConsensusAddressStringScalarType:
+			ac = f.ConsensusAddressCodec
+		}
+		if ac != nil {
+			validateAddress := ValidateAddress(ac)
+			return func(input string) error {
+				if input == "" {
+					return nil
+				}
+				return validateAddress(input)
+			}
+		}
+	}
+
+	return func(input string) error {
+		if input == "" {
+			return nil
+		}
+		var err error
+		if field.Kind() == protoreflect.MessageKind {
+			_, err = textMessageValueOf(field, nil, input)
+		} else {
+			_, err = valueOf(field, input)
+		}
+		return err
+	}
 }
 
 // promptList prompts the user for a comma-separated list of values for a repeated field.
 // The user will be prompted to enter values separated by commas which will be parsed
-// according to the field's type using valueOf.
-func promptList(field protoreflect.FieldDescriptor, msg protoreflect.Message, promptUi promptui.Prompt, promptPrefix string) (protoreflect.List, error) {
-	promptUi.Label = fmt.Sprintf("Enter %s %s list (separate values with ',')", promptPrefix, string(field.Name()))
-	result, err := promptUi.Run()
-	if err != nil {
-		return nil, fmt.Errorf("failed to prompt for %s: %w", string(field.Name()), err)
+// according to the field's type using valueOf, or textMessageValueOf for the coins,
+// timestamps and durations.
+func (f Form) promptList(field protoreflect.FieldDescriptor, msg protoreflect.Message, fieldName string) error {
+	validate := f.validate(field)
+	result, err := f.run(promptui.Prompt{
+		Label: fmt.Sprintf("Enter %s list (separate values with ',')", fieldName) + hint(field),
+		Validate: func(input string) error {
+			for _, item := range splitList(input) {
+				if err := validate(item); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}, fieldName)
+	if err != nil || result == "" {
+		return err
 	}
 
-	list := msg.Mutable(field).List()
-	for _, item := range strings.Split(result, ",") {
-		v, err := valueOf(field, item)
+	list := msg.NewField(field).List()
+	for _, item := range splitList(result) {
+		var v protoreflect.Value
+		if field.Kind() == protoreflect.MessageKind {
+			v, err = textMessageValueOf(field, list.NewElement().Message(), item)
+		} else {
+			v, err = valueOf(field, item)
+		}
 		if err != nil {
-			return nil, err
+			return err
 		}
 		list.Append(v)
 	}
+	msg.Set(field, protoreflect.ValueOfList(list))
+	return nil
+}
 
-	return list, nil
+// promptMap prompts the user for the comma-separated key=value entries of a map
+// field with scalar values.
+func (f Form) promptMap(field protoreflect.FieldDescriptor, msg protoreflect.Message, fieldName string) error {
+	if field.MapValue().Kind() == protoreflect.MessageKind {
+		// maps of messages are left unset
+		return nil
+	}
+
+	parse := func(entry string) (protoreflect.MapKey, protoreflect.Value, error) {
+		k, v, ok := strings.Cut(entry, "=")
+		if !ok {
+			return protoreflect.MapKey{}, protoreflect.Value{}, fmt.Errorf("invalid entry %q, expected key=value", entry)
+		}
+		key, err := valueOf(field.MapKey(), strings.TrimSpace(k))
+		if err != nil {
+			return protoreflect.MapKey{}, protoreflect.Value{}, err
+		}
+		value, err := valueOf(field.MapValue(), strings.TrimSpace(v))
+		return key.MapKey(), value, err
+	}
+
+	result, err := f.run(promptui.Prompt{
+		Label: fmt.Sprintf("Enter %s entries (key=value, separate entries with ',')", fieldName),
+		Validate: func(input string) error {
+			for _, entry := range splitList(input) {
+				if _, _, err := parse(entry); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}, fieldName)
+	if err != nil || result == "" {
+		return err
+	}
+
+	m := msg.NewField(field).Map()
+	for _, entry := range splitList(result) {
+		key, value, err := parse(entry)
+		if err != nil {
+			return err
+		}
+		m.Set(key, value)
+	}
+	msg.Set(field, protoreflect.ValueOfMap(m))
+	return nil
 }
 
 // promptInnerMessageKind handles prompting for fields that are of message kind.
 // It handles both single messages and repeated message fields by delegating to
 // promptInnerMessage and promptMessageList respectively.
-func promptInnerMessageKind(
-	f protoreflect.FieldDescriptor, addressCodec addresscodec.Codec,
-	validatorAddressCodec, consensusAddressCodec addresscodec.Codec,
-	promptPrefix string, stdIn io.ReadCloser, msg protoreflect.Message,
-) error {
-	if f.IsList() {
-		return promptMessageList(f, addressCodec, validatorAddressCodec, consensusAddressCodec, promptPrefix, stdIn, msg)
-	}
-	return promptInnerMessage(f, addressCodec, validatorAddressCodec, consensusAddressCodec, promptPrefix, stdIn, msg)
+func (f Form) promptInnerMessageKind(field protoreflect.FieldDescriptor, promptPrefix string, msg protoreflect.Message) error {
+	if field.IsList() {
+		return f.promptMessageList(field, promptPrefix, msg)
+	}
+	return f.promptInnerMessage(field, promptPrefix, msg)
 }
 
-// promptInnerMessage prompts for a single nested message field. It creates a new message instance,
-// recursively prompts for its fields, and sets the populated message on the parent message.
-func promptInnerMessage(
-	f protoreflect.FieldDescriptor, addressCodec addresscodec.Codec,
-	validatorAddressCodec, consensusAddressCodec addresscodec.Codec,
-	promptPrefix string, stdIn io.ReadCloser, msg protoreflect.Message,
-) error {
-	fieldName := promptPrefix + "." + string(f.Name())
-	nestedMsg := msg.Get(f).Message()
-	nestedMsg = nestedMsg.New()
-	// Recursively prompt for nested message fields
-	updatedMsg, err := promptMessage(
-		addressCodec,
-		validatorAddressCodec,
-		consensusAddressCodec,
-		fieldName,
-		stdIn,
-		nestedMsg,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to prompt for nested message %s: %w", fieldName, err)
+// promptInnerMessage prompts for a single nested message field: a coin, timestamp,
+// duration or Any in their text form, or else a new message instance whose fields
+// are recursively prompted for. The populated message is set on the parent message,
+// unless it was left empty.
+func (f Form) promptInnerMessage(field protoreflect.FieldDescriptor, promptPrefix string, msg protoreflect.Message) error {
+	fieldName := promptPrefix + "." + string(field.Name())
+	nestedMsg := msg.NewField(field).Message()
+
+	switch {
+	case field.Message().FullName() == anyFullName:
+		ok, err := f.promptAny(field, fieldName, nestedMsg)
+		if err != nil || !ok {
+			return err
+		}
+	case isTextMessage(field.Message()):
+		result, err := f.run(promptui.Prompt{
+			Label:    fmt.Sprintf("Enter %s", fieldName) + hint(field),
+			Validate: f.validate(field),
+		}, fieldName)
+		if err != nil || result == "" {
+			return err
+		}
+		if _, err := textMessageValueOf(field, nestedMsg, result); err != nil {
+			return err
+		}
+	default:
+		// Recursively prompt for nested message fields
+		if _, err := f.promptMessage(fieldName, nestedMsg); err != nil {
+			return fmt.Errorf("failed to prompt for nested message %s: %w", fieldName, err)
+		}
+		if isEmpty(nestedMsg) {
+			return nil
+		}
 	}
 
-	msg.Set(f, protoreflect.ValueOfMessage(updatedMsg))
+	msg.Set(field, protoreflect.ValueOfMessage(nestedMsg))
 	return nil
 }
 
 // promptMessageList prompts for a repeated message field by repeatedly creating new message instances,
 // prompting for their fields, and appending them to the list until the user chooses to stop.
-func promptMessageList(
-	f protoreflect.FieldDescriptor, addressCodec addresscodec.Codec,
-	validatorAddressCodec, consensusAddressCodec addresscodec.Codec,
-	promptPrefix string, stdIn io.ReadCloser, msg protoreflect.Message,
-) error {
-	list := msg.Mutable(f).List()
+func (f Form) promptMessageList(field protoreflect.FieldDescriptor, promptPrefix string, msg protoreflect.Message) error {
+	fieldName := promptPrefix + "." + string(field.Name())
+	list := msg.NewField(field).List()
 	for {
-		fieldName := promptPrefix + "." + string(f.Name())
+		// Prompt whether to continue
+		more, err := f.confirm(fmt.Sprintf("Add an item to %s", fieldName))
+		if err != nil {
+			return err
+		}
+		if !more {
+			break
+		}
+
 		// Create and populate a new message for the list
+		itemName := fmt.Sprintf("%s[%d]", fieldName, list.Len())
 		nestedMsg := list.NewElement().Message()
-		updatedMsg, err := promptMessage(
-			addressCodec,
-			validatorAddressCodec,
-			consensusAddressCodec,
-			fieldName,
-			stdIn,
-			nestedMsg,
-		)
-		if err != nil {
+		if field.Message().FullName() == anyFullName {
+			ok, err := f.promptAny(field, itemName, nestedMsg)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+		} else if _, err := f.promptMessage(itemName, nestedMsg); err != nil {
 			return fmt.Errorf("failed to prompt for list item in %s: %w", fieldName, err)
 		}
 
-		list.Append(protoreflect.ValueOfMessage(updatedMsg))
+		list.Append(protoreflect.ValueOfMessage(nestedMsg))
+	}
 
-		// Prompt whether to continue
-		// TODO: may be better yes/no rather than interactive?
-		continuePrompt := promptui.Select{
-			Label: "Add another item?",
-			Items: []string{"No", "Yes"},
-			Stdin: stdIn,
-		}
+	if list.Len() > 0 {
+		msg.Set(field, protoreflect.ValueOfList(list))
+	}
+	return nil
+}
+
+// promptAny prompts for the type of the message of an Any field, selected
+// from the messages implementing the interface it accepts if they are known,
+// and then for its fields, packing it in anyMsg. It returns false if no type
+// is given.
+func (f Form) promptAny(field protoreflect.FieldDescriptor, fieldName string, anyMsg protoreflect.Message) (bool, error) {
+	typeURL, err := f.promptTypeURL(field, fieldName)
+	if err != nil || typeURL == "" {
+		return false, err
+	}
 
-		_, result, err := continuePrompt.Run()
+	msgType, err := f.typeResolver().FindMessageByURL(typeURL)
+	if err != nil {
+		return false, fmt.Errorf("failed to resolve %s: %w", typeURL, err)
+	}
+	innerMsg, err := f.promptMessage(fieldName, msgType.New())
+	if err != nil {
+		return false, err
+	}
+
+	bz, err := proto.MarshalOptions{Deterministic: true}.Marshal(innerMsg.Interface())
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal %s: %w", fieldName, err)
+	}
+	fields := anyMsg.Descriptor().Fields()
+	anyMsg.Set(fields.ByName("type_url"), protoreflect.ValueOfString(typeURL))
+	anyMsg.Set(fields.ByName("value"), protoreflect.ValueOfBytes(bz))
+	return true, nil
+}
+
+// promptTypeURL prompts for the type URL of the message of an Any field.
+func (f Form) promptTypeURL(field protoreflect.FieldDescriptor, fieldName string) (string, error) {
+	if candidates := f.implementations(field); len(candidates) > 0 {
+		const none = "(none)"
+		selectUi := promptui.Select{
+			Label:             fmt.Sprintf("Select %s type", fieldName),
+			Items:             append(candidates, none),
+			Stdin:             f.Stdin,
+			StartInSearchMode: len(candidates) > 10,
+			Searcher: func(input string, index int) bool {
+				return index == len(candidates) || strings.Contains(strings.ToLower(candidates[index]), strings.ToLower(input))
+			},
+		}
+		_, result, err := selectUi.Run()
 		if err != nil {
-			return fmt.Errorf("failed to prompt for continuation: %w", err)
+			return "", fmt.Errorf("failed to prompt for %s: %w", fieldName, err)
+		}
+		if result == none {
+			return "", nil
+		}
+		return result, nil
+	}
+
+	return f.run(promptui.Prompt{
+		Label: fmt.Sprintf("Enter %s type URL (e.g. /cosmos.bank.v1beta1.MsgSend)", fieldName),
+		Validate: func(input string) error {
+			if input == "" {
+				return nil
+			}
+			_, err := f.typeResolver().FindMessageByURL(input)
+			return err
+		},
+	}, fieldName)
+}
+
+// implementations returns the type URLs of the messages implementing the
+// interface accepted by an Any field, sorted. The messages are the inputs of
+// the Msg services for sdk.Msg.
+func (f Form) implementations(field protoreflect.FieldDescriptor) []string {
+	iface, _ := proto.GetExtension(field.Options(), cosmos_proto.E_AcceptsInterface).(string)
+	if iface == "" || f.FileResolver == nil {
+		return nil
+	}
+
+	seen := map[string]bool{}
+	f.FileResolver.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		if iface == msgInterface {
+			services := fd.Services()
+			for i := 0; i < services.Len(); i++ {
+				if isMsgService, _ := proto.GetExtension(services.Get(i).Options(), msgv1.E_Service).(bool); !isMsgService {
+					continue
+				}
+				methods := services.Get(i).Methods()
+				for j := 0; j < methods.Len(); j++ {
+					seen["/"+string(methods.Get(j).Input().FullName())] = true
+				}
+			}
+			return true
 		}
 
-		if result == "No" {
-			break
+		messages := fd.Messages()
+		for i := 0; i < messages.Len(); i++ {
+			implements, _ := proto.GetExtension(messages.Get(i).Options(), cosmos_proto.E_ImplementsInterface).([]string)
+			for _, name := range implements {
+				if name == iface {
+					seen["/"+string(messages.Get(i).FullName())] = true
+				}
+			}
 		}
+		return true
+	})
+
+	typeURLs := make([]string, 0, len(seen))
+	for typeURL := range seen {
+		typeURLs = append(typeURLs, typeURL)
 	}
+	sort.Strings(typeURLs)
+	return typeURLs
+}
 
-	return nil
+// run runs promptUi with the input of the form, the label of the errors being fieldName.
+func (f Form) run(promptUi promptui.Prompt, fieldName string) (string, error) {
+	promptUi.Stdin = f.Stdin
+	result, err := promptUi.Run()
+	if err != nil {
+		return "", fmt.Errorf("failed to prompt for %s: %w", fieldName, err)
+	}
+	return strings.TrimSpace(result), nil
+}
+
+// confirm asks a yes or no question, no being the default.
+func (f Form) confirm(label string) (bool, error) {
+	promptUi := promptui.Prompt{Label: label, IsConfirm: true, Stdin: f.Stdin}
+	if _, err := promptUi.Run(); err != nil {
+		if errors.Is(err, promptui.ErrAbort) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to prompt for %q: %w", label, err)
+	}
+	return true, nil
+}
+
+func (f Form) typeResolver() protoregistry.MessageTypeResolver {
+	if f.TypeResolver == nil {
+		return protoregistry.GlobalTypes
+	}
+	return f.TypeResolver
+}
+
+// hint returns the description of the expected format of the values of field
+// appended to its label.
+func hint(field protoreflect.FieldDescriptor) string {
+	switch field.Kind() {
+	case protoreflect.EnumKind:
+		values := field.Enum().Values()
+		names := make([]string, values.Len())
+		for i := range names {
+			names[i] = string(values.Get(i).Name())
+		}
+		return fmt.Sprintf(" (one of %s)", strings.Join(names, ", "))
+	case protoreflect.BytesKind:
+		return " (base64)"
+	case protoreflect.MessageKind:
+	default:
+		return ""
+	}
+
+	switch field.Message().FullName() {
+	case coinFullName, decCoinFullName:
+		return " (e.g. 10stake)"
+	case timestampFullName:
+		return " (e.g. 2006-01-02T15:04:05Z)"
+	case durationFullName:
+		return " (e.g. 1h30m)"
+	default:
+		return ""
+	}
+}
+
+// isTextMessage reports whether md is a message entered in its text form.
+func isTextMessage(md protoreflect.MessageDescriptor) bool {
+	switch md.FullName() {
+	case coinFullName, decCoinFullName, timestampFullName, durationFullName:
+		return true
+	default:
+		return false
+	}
+}
+
+// isEmpty reports whether no field of msg is set.
+func isEmpty(msg protoreflect.Message) bool {
+	empty := true
+	msg.Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+		empty = false
+		return false
+	})
+	return empty
+}
+
+// splitList splits a comma-separated list, ignoring the empty items.
+func splitList(input string) []string {
+	var items []string
+	for _, item := range strings.Split(input, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// formatScalar returns the text form of the value of a scalar field, as
+// parsed by valueOf.
+func formatScalar(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch field.Kind() {
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(value.Bytes())
+	case protoreflect.EnumKind:
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+		return strconv.Itoa(int(value.Enum()))
+	case protoreflect.FloatKind:
+		return strconv.FormatFloat(value.Float(), 'g', -1, 32)
+	case protoreflect.DoubleKind:
+		return strconv.FormatFloat(value.Float(), 'g', -1, 64)
+	default:
+		return value.String()
+	}
 }
@@ -6,8 +6,17 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	govv1 "cosmossdk.io/api/cosmos/gov/v1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
 	"cosmossdk.io/client/v2/internal/testpb"
 
 	address2 "github.com/cosmos/cosmos-sdk/codec/address"
@@ -23,37 +32,156 @@ func getReader(inputs []string) io.ReadCloser {
 	return io.NopCloser(strings.NewReader(strings.Join(paddedInputs, "")))
 }
 
+const testAddress = "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk"
+
+func newTestForm(inputs []string) Form {
+	return Form{
+		AddressCodec:          address2.NewBech32Codec("cosmos"),
+		ValidatorAddressCodec: address2.NewBech32Codec("cosmosvaloper"),
+		ConsensusAddressCodec: address2.NewBech32Codec("cosmosvalcons"),
+		Stdin:                 getReader(inputs),
+	}
+}
+
 func TestPromptMessage(t *testing.T) {
+	send := &bankv1beta1.MsgSend{
+		FromAddress: testAddress,
+		ToAddress:   testAddress,
+		Amount:      []*basev1beta1.Coin{{Denom: "foo", Amount: "1"}, {Denom: "bar", Amount: "2"}},
+	}
+	sendBz, err := proto.MarshalOptions{Deterministic: true}.Marshal(send)
+	require.NoError(t, err)
+
 	tests := []struct {
 		name   string
-		msg    protoreflect.Message
+		msg    proto.Message
 		inputs []string
+		exp    proto.Message
 	}{
 		{
-			name: "testPb",
+			name:   "coins and addresses",
+			msg:    &bankv1beta1.MsgSend{},
+			inputs: []string{testAddress, testAddress, "1foo, 2bar"},
+			exp:    send,
+		},
+		{
+			name: "repeated any, enum and empty fields",
+			msg:  &govv1.MsgSubmitProposal{},
 			inputs: []string{
-				"1", "2", "string", "bytes", "10101010", "0", "234234", "3", "4", "5", "true", "ENUM_ONE",
-				"bar", "6", "10000", "stake", "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
-				"bytes", "6", "7", "false", "false", "true,false,true", "1,2,3", "hello,hola,ciao", "ENUM_ONE,ENUM_TWO",
-				"10239", "0", "No", "bar", "343", "No", "134", "positional2", "23455", "stake", "No", "deprecate",
-				"shorthand", "false", "cosmosvaloper1tnh2q55v8wyygtt9srz5safamzdengsn9dsd7z",
+				"y", "/cosmos.bank.v1beta1.MsgSend", testAddress, testAddress, "1foo,2bar", "n",
+				"10stake", testAddress, "", "title", "summary", "", "PROPOSAL_TYPE_EXPEDITED",
+			},
+			exp: &govv1.MsgSubmitProposal{
+				Messages:       []*anypb.Any{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: sendBz}},
+				InitialDeposit: []*basev1beta1.Coin{{Denom: "stake", Amount: "10"}},
+				Proposer:       testAddress,
+				Title:          "title",
+				Summary:        "summary",
+				ProposalType:   govv1.ProposalType_PROPOSAL_TYPE_EXPEDITED,
+			},
+		},
+		{
+			name: "oneof",
+			msg:  &stakingv1beta1.StakeAuthorization{},
+			inputs: []string{
+				"10stake", "allow_list", testAddress + ", " + testAddress, "AUTHORIZATION_TYPE_DELEGATE",
+			},
+			exp: &stakingv1beta1.StakeAuthorization{
+				MaxTokens: &basev1beta1.Coin{Denom: "stake", Amount: "10"},
+				Validators: &stakingv1beta1.StakeAuthorization_AllowList{
+					AllowList: &stakingv1beta1.StakeAuthorization_Validators{Address: []string{testAddress, testAddress}},
+				},
+				AuthorizationType: stakingv1beta1.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			},
+		},
+		{
+			name: "nested messages, timestamps and durations of a dynamic message",
+			msg:  dynamicpb.NewMessage((&testpb.MsgRequest{}).ProtoReflect().Descriptor()),
+			inputs: []string{
+				"1", "2", "str", "Ynl0ZXM=", "2024-01-02T03:04:05Z", "1h30m", "-3", "-4", "true", "ENUM_ONE",
+				"bar", "5", "10stake", testAddress,
+				"", "", "", "", "", // page, left unset
+				"true,false", "1,2", "a,b", "ENUM_ONE,ENUM_TWO", "1s,2m",
+				"y", "baz", "6", "n",
+				"", "", "", "", "", "", "",
+			},
+			exp: &testpb.MsgRequest{
+				U32:          1,
+				U64:          2,
+				Str:          "str",
+				Bz:           []byte("bytes"),
+				Timestamp:    &timestamppb.Timestamp{Seconds: 1704164645},
+				Duration:     &durationpb.Duration{Seconds: 5400},
+				I32:          -3,
+				I64:          -4,
+				ABool:        true,
+				AnEnum:       testpb.Enum_ENUM_ONE,
+				AMessage:     &testpb.AMessage{Bar: "bar", Baz: 5},
+				ACoin:        &basev1beta1.Coin{Denom: "stake", Amount: "10"},
+				AnAddress:    testAddress,
+				Bools:        []bool{true, false},
+				Uints:        []uint32{1, 2},
+				Strings:      []string{"a", "b"},
+				Enums:        []testpb.Enum{testpb.Enum_ENUM_ONE, testpb.Enum_ENUM_TWO},
+				Durations:    []*durationpb.Duration{{Seconds: 1}, {Seconds: 120}},
+				SomeMessages: []*testpb.AMessage{{Bar: "baz", Baz: 6}},
 			},
-			msg: (&testpb.MsgRequest{}).ProtoReflect(),
 		},
 	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// https://github.com/manifoldco/promptui/issues/63#issuecomment-621118463
-			var paddedInputs []string
-			for _, input := range tt.inputs {
-				padding := strings.Repeat("a", 4096-1-len(input)%4096)
-				paddedInputs = append(paddedInputs, input+"\n"+padding)
-			}
-			reader := io.NopCloser(strings.NewReader(strings.Join(paddedInputs, "")))
-
-			got, err := promptMessage(address2.NewBech32Codec("cosmos"), address2.NewBech32Codec("cosmosvaloper"), address2.NewBech32Codec("cosmosvalcons"), "prefix", reader, tt.msg)
+			got, err := newTestForm(tt.inputs).Prompt(tt.msg.ProtoReflect())
 			require.NoError(t, err)
-			require.NotNil(t, got)
+			require.True(t, proto.Equal(tt.exp, got.Interface()), "got %v", got.Interface())
+		})
+	}
+}
+
+func TestPromptValidation(t *testing.T) {
+	form := newTestForm(nil)
+	fields := (&testpb.MsgRequest{}).ProtoReflect().Descriptor().Fields()
+
+	tests := []struct {
+		field  protoreflect.Name
+		input  string
+		expErr bool
+	}{
+		{field: "an_address", input: testAddress},
+		{field: "an_address", input: "cosmosvaloper1tnh2q55v8wyygtt9srz5safamzdengsn9dsd7z", expErr: true},
+		{field: "a_validator_address", input: "cosmosvaloper1tnh2q55v8wyygtt9srz5safamzdengsn9dsd7z"},
+		{field: "a_coin", input: "10stake"},
+		{field: "a_coin", input: "stake", expErr: true},
+		{field: "positional3_varargs", input: "10stake"},
+		{field: "timestamp", input: "yesterday", expErr: true},
+		{field: "duration", input: "1x", expErr: true},
+		{field: "u32", input: "4294967296", expErr: true},
+		{field: "an_enum", input: "ENUM_THREE", expErr: true},
+		{field: "bz", input: "not base64", expErr: true},
+		{field: "u64", input: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.field)+" "+tt.input, func(t *testing.T) {
+			err := form.validate(fields.ByName(tt.field))(tt.input)
+			if tt.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
 		})
 	}
 }
+
+func TestPromptMap(t *testing.T) {
+	msg := (&testpb.EchoRequest{}).ProtoReflect()
+	fields := msg.Descriptor().Fields()
+
+	form := newTestForm([]string{"a=1, b = 2"})
+	require.NoError(t, form.promptMap(fields.ByName("map_string_uint32"), msg, "prefix.map_string_uint32"))
+	require.Equal(t, map[string]uint32{"a": 1, "b": 2}, msg.Interface().(*testpb.EchoRequest).MapStringUint32)
+
+	// maps of messages are left unset
+	require.NoError(t, newTestForm(nil).promptMap(fields.ByName("map_string_coin"), msg, "prefix.map_string_coin"))
+	require.False(t, msg.Has(fields.ByName("map_string_coin")))
+}
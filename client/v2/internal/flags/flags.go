@@ -24,11 +24,22 @@ const (
 	FlagKeyringDir = "keyring-dir"
 	// FlagKeyringBackend is the flag to specify which backend to use for the keyring (e.g. os, file, test).
 	FlagKeyringBackend = "keyring-backend"
+	// FlagKeyringRemote is the flag to specify the signer address of the remote keyring backend.
+	FlagKeyringRemote = "keyring-remote-signer"
+	// FlagKeyringRemoteCA is the flag to specify the CA certificate file used to verify the remote signer.
+	FlagKeyringRemoteCA = "keyring-remote-signer-ca"
+	// FlagKeyringRemoteCert is the flag to specify the client certificate file presented to the remote signer.
+	FlagKeyringRemoteCert = "keyring-remote-signer-cert"
+	// FlagKeyringRemoteKey is the flag to specify the client private key file presented to the remote signer.
+	FlagKeyringRemoteKey = "keyring-remote-signer-key"
 
 	// FlagNoProposal is the flag convert a gov proposal command into a normal command.
 	// This is used to allow user of chains with custom authority to not use gov submit proposals for usual proposal commands.
 	FlagNoProposal = "no-proposal"
 
+	// FlagInteractive is the flag to build the message of a tx command interactively.
+	FlagInteractive = "interactive"
+
 	// FlagNode is the flag to specify the node address to connect to.
 	FlagNode = "node"
 	// FlagBroadcastMode is the flag to specify the broadcast mode for transactions.
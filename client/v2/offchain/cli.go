@@ -1,16 +1,22 @@
 package offchain
 
 import (
+	"crypto/tls"
 	"os"
 	"path/filepath"
 
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	grpcinsecure "google.golang.org/grpc/credentials/insecure"
 
 	"cosmossdk.io/client/v2/autocli/config"
 	"cosmossdk.io/client/v2/autocli/keyring"
 	"cosmossdk.io/client/v2/broadcast/comet"
 	clientcontext "cosmossdk.io/client/v2/context"
 	v2flags "cosmossdk.io/client/v2/internal/flags"
+	"cosmossdk.io/client/v2/offchain/verifier"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -24,6 +30,8 @@ const (
 	flagEncoding   = "encoding"
 	flagFileFormat = "file-format"
 	flagBech32     = "bech32"
+	flagSigners    = "signers"
+	flagSigner     = "signer"
 )
 
 // OffChain off-chain utilities.
@@ -36,6 +44,7 @@ func OffChain() *cobra.Command {
 
 	cmd.AddCommand(
 		SignFile(),
+		SignDocumentFile(),
 		VerifyFile(),
 	)
 
@@ -49,8 +58,12 @@ func SignFile() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "sign-file <keyName> <fileName>",
 		Short: "Sign a file.",
-		Long:  "Sign a file using a given key.",
-		Args:  cobra.ExactArgs(2),
+		Long: `Sign a file using a given key.
+The document has a single signer, the key, unless --signers lists all of its signers, who then add their signature in
+turn with sign-document. The key signs for its own address, unless --signer is set: to a multisig key the key is a
+member of, or to the address of an account authenticating with the key, e.g. an x/accounts account.
+Documents with several signers or signed by a multisig key can only be signed in amino-json.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ir := types.NewInterfaceRegistry()
 			cryptocodec.RegisterInterfaces(ir)
@@ -76,6 +89,8 @@ func SignFile() *cobra.Command {
 			outputFile, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
 			signMode, _ := cmd.Flags().GetString(flags.FlagSignMode)
 			bech32Prefix, _ := cmd.Flags().GetString(flagBech32)
+			signers, _ := cmd.Flags().GetStringSlice(flagSigners)
+			signer, _ := cmd.Flags().GetString(flagSigner)
 
 			ac := address.NewBech32Codec(bech32Prefix)
 			k, err := keyring.NewKeyringFromFlags(cmd.Flags(), ac, cmd.InOrStdin(), cdc)
@@ -97,7 +112,12 @@ func SignFile() *cobra.Command {
 				Keyring:               k,
 			}
 
-			signedTx, err := Sign(ctx, bz, conn, args[0], encoding, signMode, outputFormat)
+			var signedTx string
+			if len(signers) == 0 && signer == "" {
+				signedTx, err = Sign(ctx, bz, conn, args[0], encoding, signMode, outputFormat)
+			} else {
+				signedTx, err = signNewDocument(ctx, bz, args[0], encoding, signers, signer, signMode, outputFormat)
+			}
 			if err != nil {
 				return err
 			}
@@ -118,7 +138,84 @@ func SignFile() *cobra.Command {
 	cmd.Flags().String(v2flags.FlagOutput, "json", "Choose an output format for the tx (json|text")
 	cmd.Flags().String(flagEncoding, "no-encoding", "Choose an encoding method for the file content to be added as msg data (no-encoding|base64|hex)")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
-	cmd.PersistentFlags().String(flags.FlagSignMode, "direct", "Choose sign mode (direct|amino-json)")
+	cmd.Flags().StringSlice(flagSigners, nil, "The addresses of all the signers of the document, in order, when it has several")
+	cmd.Flags().String(flagSigner, "", "The multisig key or the account address the key signs for, instead of its own address")
+	cmd.PersistentFlags().String(flags.FlagSignMode, "direct", "Choose sign mode (direct|amino-json|textual)")
+	return cmd
+}
+
+// SignDocumentFile adds the signature of a key to a document file.
+func SignDocumentFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-document <keyName> <documentFileName>",
+		Short: "Sign a document.",
+		Long: `Add the signature of a given key to a document created by sign-file, for one of its other signers or as
+another member of its multisig signer, set with --signer.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ir := types.NewInterfaceRegistry()
+			cryptocodec.RegisterInterfaces(ir)
+			cdc := codec.NewProtoCodec(ir)
+
+			c, err := config.CreateClientConfigFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			keyringBackend := c.KeyringBackend
+			if !cmd.Flags().Changed(v2flags.FlagKeyringBackend) {
+				_ = cmd.Flags().Set(v2flags.FlagKeyringBackend, keyringBackend)
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			fileFormat, _ := cmd.Flags().GetString(flagFileFormat)
+			outputFormat, _ := cmd.Flags().GetString(v2flags.FlagOutput)
+			outputFile, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			signMode, _ := cmd.Flags().GetString(flags.FlagSignMode)
+			bech32Prefix, _ := cmd.Flags().GetString(flagBech32)
+			signer, _ := cmd.Flags().GetString(flagSigner)
+
+			ac := address.NewBech32Codec(bech32Prefix)
+			k, err := keyring.NewKeyringFromFlags(cmd.Flags(), ac, cmd.InOrStdin(), cdc)
+			if err != nil {
+				return err
+			}
+
+			ctx := clientcontext.Context{
+				Flags:                 cmd.Flags(),
+				AddressCodec:          ac,
+				ValidatorAddressCodec: address.NewBech32Codec(sdk.GetBech32PrefixValAddr(bech32Prefix)),
+				Cdc:                   cdc,
+				Keyring:               k,
+			}
+
+			signedDoc, err := signExistingDocument(ctx, bz, args[0], fileFormat, signer, signMode, outputFormat)
+			if err != nil {
+				return err
+			}
+
+			if outputFile != "" {
+				fp, err := os.OpenFile(filepath.Clean(outputFile), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+				if err != nil {
+					return err
+				}
+				cmd.SetOut(fp)
+			}
+
+			cmd.Println(signedDoc)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagFileFormat, "json", "Choose what's the format of the document to sign (json|text)")
+	cmd.Flags().String(v2flags.FlagOutput, "json", "Choose an output format for the tx (json|text")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+	cmd.Flags().String(flagSigner, "", "The multisig key or the account address the key signs for, instead of its own address")
+	cmd.Flags().String(flags.FlagSignMode, "amino-json", "Choose sign mode (direct|amino-json|textual)")
 	return cmd
 }
 
@@ -131,6 +228,7 @@ func VerifyFile() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ir := types.NewInterfaceRegistry()
+			cryptocodec.RegisterInterfaces(ir)
 			cdc := codec.NewProtoCodec(ir)
 
 			bz, err := os.ReadFile(args[0])
@@ -143,21 +241,56 @@ func VerifyFile() *cobra.Command {
 
 			ac := address.NewBech32Codec(bech32Prefix)
 
-			ctx := clientcontext.Context{
-				Flags:                 cmd.Flags(),
+			opts := verifier.Options{
 				AddressCodec:          ac,
 				ValidatorAddressCodec: address.NewBech32Codec(sdk.GetBech32PrefixValAddr(bech32Prefix)),
 				Cdc:                   cdc,
 			}
 
-			err = Verify(ctx, bz, fileFormat)
-			if err == nil {
-				cmd.Println("Verification OK!")
+			conn, err := chainConn(cmd, cdc)
+			if err != nil {
+				return err
+			}
+			if conn != nil {
+				opts.PubKeyResolver = verifier.NewGRPCPubKeyResolver(ac, conn, ir, verifier.DefaultAccountPubKeyFuncs())
 			}
-			return err
+
+			v, err := verifier.New(opts)
+			if err != nil {
+				return err
+			}
+
+			if _, err := v.Verify(cmd.Context(), bz, fileFormat); err != nil {
+				return err
+			}
+
+			cmd.Println("Verification OK!")
+			return nil
 		},
 	}
 
 	cmd.Flags().String(flagFileFormat, "json", "Choose what's the file format to be verified (json|text)")
+	cmd.Flags().String(v2flags.FlagNode, "", "<host>:<port> to CometBFT RPC interface of the chain the signers' public keys are resolved from")
+	cmd.Flags().String(v2flags.FlagGrpcAddress, "", "The gRPC server address of the chain the signers' public keys are resolved from")
+	cmd.Flags().Bool(v2flags.FlagGrpcInsecure, false, "Allow gRPC over insecure channels")
 	return cmd
 }
+
+// chainConn returns the connection to the chain the public keys of the
+// signers are resolved from, or nil if neither the node nor the gRPC address
+// are set.
+func chainConn(cmd *cobra.Command, cdc codec.Codec) (gogogrpc.ClientConn, error) {
+	if addr, _ := cmd.Flags().GetString(v2flags.FlagGrpcAddress); addr != "" {
+		creds := grpcinsecure.NewCredentials()
+		if insecure, _ := cmd.Flags().GetBool(v2flags.FlagGrpcInsecure); !insecure {
+			creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+		}
+		return grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	}
+
+	if node, _ := cmd.Flags().GetString(v2flags.FlagNode); node != "" {
+		return comet.NewCometBFTBroadcaster(node, comet.BroadcastSync, cdc)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,350 @@
+package offchain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	apitx "cosmossdk.io/api/cosmos/tx/v1beta1"
+	clientcontext "cosmossdk.io/client/v2/context"
+	"cosmossdk.io/client/v2/internal/offchain"
+	"cosmossdk.io/client/v2/offchain/verifier"
+	clitx "cosmossdk.io/client/v2/tx"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+var marshalOption = proto.MarshalOptions{Deterministic: true}
+
+// NewDocument returns the unsigned off-chain document of rawBytes, encoded
+// with the given encoding, for the given signers. The document has a
+// MsgSignArbitraryData per signer, each signer adding its signature with
+// SignDocument.
+func NewDocument(ctx clientcontext.Context, rawBytes []byte, encoding string, signers []string) (clitx.Tx, error) {
+	if len(signers) == 0 {
+		return nil, errors.New("document must have at least one signer")
+	}
+
+	digest, err := encodeDigest(encoding, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]*anypb.Any, len(signers))
+	for i, signer := range signers {
+		if _, err := ctx.AddressCodec.StringToBytes(signer); err != nil {
+			return nil, fmt.Errorf("invalid signer %s: %w", signer, err)
+		}
+		if slices.Contains(signers[:i], signer) {
+			return nil, fmt.Errorf("duplicate signer %s", signer)
+		}
+
+		msg := &offchain.MsgSignArbitraryData{
+			AppDomain: version.AppName,
+			Signer:    signer,
+			Data:      digest,
+		}
+		bz, err := marshalOption.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		msgs[i] = &anypb.Any{
+			TypeUrl: "/" + string(msg.ProtoReflect().Descriptor().FullName()),
+			Value:   bz,
+		}
+	}
+
+	bodyBytes, err := marshalOption.Marshal(&apitx.TxBody{Messages: msgs})
+	if err != nil {
+		return nil, err
+	}
+
+	txConfig, err := verifier.NewTxConfig(ctx.AddressCodec, ctx.ValidatorAddressCodec, ctx.Cdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeDocument(txConfig, bodyBytes, &apitx.AuthInfo{Fee: &apitx.Fee{}}, make([]clitx.Signature, len(signers)))
+}
+
+// SignDocument adds the signature of the key fromName to an off-chain
+// document. The key signs for its own address, unless signer, a key name or
+// an address, is set. If signer is a multisig key of the keyring, the key
+// signs as one of its members, its signature being added to the ones of the
+// other members. Otherwise, the key signs for the account of signer, e.g. an
+// x/accounts account authenticating with the key.
+//
+// Documents with several signers or signed by a multisig key can only be
+// signed in amino-json.
+func SignDocument(ctx clientcontext.Context, doc clitx.Tx, fromName, signer, signMode string) (clitx.Tx, error) {
+	sm, err := getSignMode(signMode)
+	if err != nil {
+		return nil, err
+	}
+
+	txConfig, err := verifier.NewTxConfig(ctx.AddressCodec, ctx.ValidatorAddressCodec, ctx.Cdc)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := ctx.Keyring.GetPubKey(fromName)
+	if err != nil {
+		return nil, err
+	}
+
+	signerAddr, signerPubKey, err := documentSigner(ctx, pubKey, signer)
+	if err != nil {
+		return nil, err
+	}
+	multisigPubKey, isMultisig := signerPubKey.(multisig.PubKey)
+
+	addr, err := ctx.AddressCodec.BytesToString(signerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	signers, err := doc.GetSigners()
+	if err != nil {
+		return nil, err
+	}
+	index := slices.IndexFunc(signers, func(s []byte) bool { return bytes.Equal(s, signerAddr) })
+	if index == -1 {
+		return nil, fmt.Errorf("%s is not a signer of the document", addr)
+	}
+	if sm != apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON && (len(signers) > 1 || isMultisig) {
+		return nil, errors.New("documents with several signers or signed by a multisig key can only be signed in amino-json")
+	}
+
+	sigs, err := doc.GetSignatures()
+	if err != nil {
+		return nil, err
+	}
+	if sigs[index].PubKey != nil && !sigs[index].PubKey.Equals(signerPubKey) {
+		return nil, fmt.Errorf("%s signed the document with another key", addr)
+	}
+
+	var memberSigs []clitx.Signature
+	if isMultisig {
+		memberSigs, err = multisigMembersSignatures(multisigPubKey, sigs[index].Data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txData, err := doc.GetSigningTxData()
+	if err != nil {
+		return nil, err
+	}
+
+	// the signer info of the signer is set before getting the sign bytes,
+	// which include it in direct and textual modes
+	sigs[index] = clitx.Signature{
+		PubKey: signerPubKey,
+		Data:   &clitx.SingleSignatureData{SignMode: sm},
+	}
+	doc, err = encodeDocument(txConfig, txData.BodyBytes, txData.AuthInfo, sigs)
+	if err != nil {
+		return nil, err
+	}
+	txData, err = doc.GetSigningTxData()
+	if err != nil {
+		return nil, err
+	}
+
+	anyPk, err := codectypes.NewAnyWithValue(signerPubKey)
+	if err != nil {
+		return nil, err
+	}
+	signerData := txsigning.SignerData{
+		ChainID:       ExpectedChainID,
+		AccountNumber: ExpectedAccountNumber,
+		Sequence:      ExpectedSequence,
+		Address:       addr,
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(context.Background(), sm, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	sigBytes, err := ctx.Keyring.Sign(fromName, signBytes, sm)
+	if err != nil {
+		return nil, err
+	}
+
+	sigData := &clitx.SingleSignatureData{SignMode: sm, Signature: sigBytes}
+	if isMultisig {
+		multiSigData, err := clitx.NewMultiSignatureData(multisigPubKey, append(memberSigs, clitx.Signature{PubKey: pubKey, Data: sigData}))
+		if err != nil {
+			return nil, err
+		}
+		sigs[index] = clitx.Signature{PubKey: multisigPubKey, Data: multiSigData}
+	} else {
+		sigs[index] = clitx.Signature{PubKey: signerPubKey, Data: sigData}
+	}
+
+	return encodeDocument(txConfig, txData.BodyBytes, txData.AuthInfo, sigs)
+}
+
+// documentSigner returns the address of the signer the key of pubKey signs for
+// and the public key of its signature.
+func documentSigner(ctx clientcontext.Context, pubKey cryptotypes.PubKey, signer string) ([]byte, cryptotypes.PubKey, error) {
+	if signer == "" {
+		return pubKey.Address(), pubKey, nil
+	}
+
+	if name, _, _, err := ctx.Keyring.KeyInfo(signer); err == nil {
+		signerPubKey, err := ctx.Keyring.GetPubKey(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, ok := signerPubKey.(multisig.PubKey); !ok && !signerPubKey.Equals(pubKey) {
+			return nil, nil, fmt.Errorf("key %s is neither a multisig key nor the signing key", name)
+		}
+		return signerPubKey.Address(), signerPubKey, nil
+	}
+
+	addr, err := ctx.AddressCodec.StringToBytes(signer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("signer %s is neither a key nor an address: %w", signer, err)
+	}
+	return addr, pubKey, nil
+}
+
+// multisigMembersSignatures returns the signatures of the members of a
+// multisig key already in its signature data.
+func multisigMembersSignatures(pubKey multisig.PubKey, data clitx.SignatureData) ([]clitx.Signature, error) {
+	multiSigData, ok := data.(*clitx.MultiSignatureData)
+	if !ok || multiSigData.BitArray == nil {
+		// no member signed yet
+		return nil, nil
+	}
+
+	bitArray := &cryptotypes.CompactBitArray{
+		ExtraBitsStored: multiSigData.BitArray.ExtraBitsStored,
+		Elems:           multiSigData.BitArray.Elems,
+	}
+	var sigs []clitx.Signature
+	for i, member := range pubKey.GetPubKeys() {
+		if !bitArray.GetIndex(i) {
+			continue
+		}
+		if len(sigs) == len(multiSigData.Signatures) {
+			return nil, errors.New("multisignature has fewer signatures than signing members")
+		}
+		sigs = append(sigs, clitx.Signature{PubKey: member, Data: multiSigData.Signatures[len(sigs)]})
+	}
+
+	return sigs, nil
+}
+
+// encodeDocument returns the document of bodyBytes and authInfo, with the
+// signer infos and signatures of sigs.
+func encodeDocument(txConfig clitx.TxConfig, bodyBytes []byte, authInfo *apitx.AuthInfo, sigs []clitx.Signature) (clitx.Tx, error) {
+	signerInfos, rawSignatures, err := clitx.SignerInfosAndSignatures(sigs)
+	if err != nil {
+		return nil, err
+	}
+
+	authInfo = proto.Clone(authInfo).(*apitx.AuthInfo)
+	authInfo.SignerInfos = signerInfos
+	authInfoBytes, err := marshalOption.Marshal(authInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	txBytes, err := marshalOption.Marshal(&apitx.TxRaw{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		Signatures:    rawSignatures,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txConfig.TxDecoder()(txBytes)
+}
+
+// signNewDocument creates the document of rawBytes for the given signers,
+// only the signer of the key fromName by default, signs it with the key and
+// encodes it in the output format.
+func signNewDocument(
+	ctx clientcontext.Context,
+	rawBytes []byte,
+	fromName, encoding string,
+	signers []string,
+	signer, signMode, output string,
+) (string, error) {
+	if len(signers) == 0 {
+		pubKey, err := ctx.Keyring.GetPubKey(fromName)
+		if err != nil {
+			return "", err
+		}
+		addr, _, err := documentSigner(ctx, pubKey, signer)
+		if err != nil {
+			return "", err
+		}
+		addrStr, err := ctx.AddressCodec.BytesToString(addr)
+		if err != nil {
+			return "", err
+		}
+		signers = []string{addrStr}
+	}
+
+	doc, err := NewDocument(ctx, rawBytes, encoding, signers)
+	if err != nil {
+		return "", err
+	}
+
+	return signEncodedDocument(ctx, doc, fromName, signer, signMode, output)
+}
+
+// signExistingDocument decodes a document in the given format, adds the
+// signature of the key fromName to it and encodes it in the output format.
+func signExistingDocument(ctx clientcontext.Context, bz []byte, fromName, format, signer, signMode, output string) (string, error) {
+	txConfig, err := verifier.NewTxConfig(ctx.AddressCodec, ctx.ValidatorAddressCodec, ctx.Cdc)
+	if err != nil {
+		return "", err
+	}
+
+	doc, err := unmarshal(format, bz, txConfig)
+	if err != nil {
+		return "", err
+	}
+
+	return signEncodedDocument(ctx, doc, fromName, signer, signMode, output)
+}
+
+// signEncodedDocument signs doc with the key fromName and encodes it in the
+// output format.
+func signEncodedDocument(ctx clientcontext.Context, doc clitx.Tx, fromName, signer, signMode, output string) (string, error) {
+	txConfig, err := verifier.NewTxConfig(ctx.AddressCodec, ctx.ValidatorAddressCodec, ctx.Cdc)
+	if err != nil {
+		return "", err
+	}
+
+	doc, err = SignDocument(ctx, doc, fromName, signer, signMode)
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := encode(output, doc, txConfig)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
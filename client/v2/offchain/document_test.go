@@ -0,0 +1,182 @@
+package offchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clientcontext "cosmossdk.io/client/v2/context"
+	"cosmossdk.io/client/v2/offchain/verifier"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// mapResolver resolves the public keys of a map keyed by address.
+type mapResolver map[string]cryptotypes.PubKey
+
+func (r mapResolver) ResolvePubKey(_ context.Context, addr []byte) (cryptotypes.PubKey, error) {
+	return r[string(addr)], nil
+}
+
+// newDocumentTestContext returns a client context with the keys alice, bob
+// and carol, and the 2-of-3 multisig key of the three, multi.
+func newDocumentTestContext(t *testing.T) clientcontext.Context {
+	t.Helper()
+	ac := address.NewBech32Codec("cosmos")
+	k := keyring.NewInMemory(getCodec())
+
+	var pubKeys []cryptotypes.PubKey
+	for _, name := range []string{"alice", "bob", "carol"} {
+		record, _, err := k.NewMnemonic(name, keyring.English, "m/44'/118'/0'/0/0", keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		pubKey, err := record.GetPubKey()
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, pubKey)
+	}
+	_, err := k.SaveMultisig("multi", kmultisig.NewLegacyAminoPubKey(2, pubKeys))
+	require.NoError(t, err)
+
+	autoKeyring, err := keyring.NewAutoCLIKeyring(k, ac)
+	require.NoError(t, err)
+
+	return clientcontext.Context{
+		AddressCodec:          ac,
+		ValidatorAddressCodec: address.NewBech32Codec("cosmosvaloper"),
+		Cdc:                   getCodec(),
+		Keyring:               autoKeyring,
+	}
+}
+
+func keyAddress(t *testing.T, ctx clientcontext.Context, name string) string {
+	t.Helper()
+	_, addr, _, err := ctx.Keyring.KeyInfo(name)
+	require.NoError(t, err)
+	return addr
+}
+
+func verifyDocument(ctx clientcontext.Context, resolver verifier.PubKeyResolver, doc string) (*verifier.Document, error) {
+	v, err := verifier.New(verifier.Options{
+		AddressCodec:          ctx.AddressCodec,
+		ValidatorAddressCodec: ctx.ValidatorAddressCodec,
+		Cdc:                   ctx.Cdc,
+		PubKeyResolver:        resolver,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.Verify(context.Background(), []byte(doc), "json")
+}
+
+func TestSignDocument_severalSigners(t *testing.T) {
+	ctx := newDocumentTestContext(t)
+	alice, bob := keyAddress(t, ctx, "alice"), keyAddress(t, ctx, "bob")
+	signers := []string{alice, bob}
+
+	_, err := signNewDocument(ctx, []byte("login"), "alice", noEncoder, signers, "", "direct", "json")
+	require.ErrorContains(t, err, "can only be signed in amino-json")
+
+	doc, err := signNewDocument(ctx, []byte("login"), "alice", noEncoder, signers, "", "amino-json", "json")
+	require.NoError(t, err)
+	_, err = verifyDocument(ctx, nil, doc)
+	require.ErrorContains(t, err, "signer "+bob+" has not signed the document")
+
+	_, err = signExistingDocument(ctx, []byte(doc), "carol", "json", "", "amino-json", "json")
+	require.ErrorContains(t, err, "is not a signer of the document")
+
+	doc, err = signExistingDocument(ctx, []byte(doc), "bob", "json", "", "amino-json", "json")
+	require.NoError(t, err)
+	verified, err := verifyDocument(ctx, nil, doc)
+	require.NoError(t, err)
+	require.Equal(t, "login", verified.Data)
+	require.Equal(t, signers, verified.Signers)
+}
+
+func TestSignDocument_multisig(t *testing.T) {
+	ctx := newDocumentTestContext(t)
+	multi := keyAddress(t, ctx, "multi")
+
+	_, err := signNewDocument(ctx, []byte("login"), "alice", noEncoder, nil, "multi", "direct", "json")
+	require.ErrorContains(t, err, "can only be signed in amino-json")
+
+	doc, err := signNewDocument(ctx, []byte("login"), "alice", noEncoder, nil, "multi", "amino-json", "json")
+	require.NoError(t, err)
+	_, err = verifyDocument(ctx, nil, doc)
+	require.Error(t, err)
+
+	// signing twice with the same member does not reach the threshold
+	doc, err = signExistingDocument(ctx, []byte(doc), "alice", "json", "multi", "amino-json", "json")
+	require.NoError(t, err)
+	_, err = verifyDocument(ctx, nil, doc)
+	require.Error(t, err)
+
+	doc, err = signExistingDocument(ctx, []byte(doc), "carol", "json", multi, "amino-json", "json")
+	require.NoError(t, err)
+	verified, err := verifyDocument(ctx, nil, doc)
+	require.NoError(t, err)
+	require.Equal(t, []string{multi}, verified.Signers)
+}
+
+func TestSignDocument_account(t *testing.T) {
+	ctx := newDocumentTestContext(t)
+	// an account whose address is not derived from the key authenticating it
+	account := "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk"
+	accountAddr, err := ctx.AddressCodec.StringToBytes(account)
+	require.NoError(t, err)
+	alice, err := ctx.Keyring.GetPubKey("alice")
+	require.NoError(t, err)
+	bob, err := ctx.Keyring.GetPubKey("bob")
+	require.NoError(t, err)
+
+	_, err = signNewDocument(ctx, []byte("login"), "alice", noEncoder, nil, "bob", "direct", "json")
+	require.ErrorContains(t, err, "neither a multisig key nor the signing key")
+
+	doc, err := signNewDocument(ctx, []byte("login"), "alice", noEncoder, nil, account, "direct", "json")
+	require.NoError(t, err)
+
+	_, err = verifyDocument(ctx, nil, doc)
+	require.ErrorContains(t, err, "signature does not match its respective signer")
+	_, err = verifyDocument(ctx, mapResolver{string(accountAddr): bob}, doc)
+	require.ErrorContains(t, err, "does not match the on-chain one")
+
+	verified, err := verifyDocument(ctx, mapResolver{string(accountAddr): alice}, doc)
+	require.NoError(t, err)
+	require.Equal(t, []string{account}, verified.Signers)
+}
+
+func TestSignDocument_textual(t *testing.T) {
+	ctx := newDocumentTestContext(t)
+
+	doc, err := signNewDocument(ctx, []byte("login"), "alice", noEncoder, nil, "", "textual", "json")
+	require.NoError(t, err)
+	verified, err := verifyDocument(ctx, nil, doc)
+	require.NoError(t, err)
+	require.Equal(t, []string{keyAddress(t, ctx, "alice")}, verified.Signers)
+
+	// the resolver falls back to the public key of the signature for the
+	// accounts it does not know
+	_, err = verifyDocument(ctx, mapResolver{}, doc)
+	require.NoError(t, err)
+}
+
+func TestNewDocument(t *testing.T) {
+	ctx := newDocumentTestContext(t)
+	alice := keyAddress(t, ctx, "alice")
+
+	_, err := NewDocument(ctx, []byte("login"), noEncoder, nil)
+	require.ErrorContains(t, err, "at least one signer")
+	_, err = NewDocument(ctx, []byte("login"), noEncoder, []string{alice, alice})
+	require.ErrorContains(t, err, "duplicate signer")
+	_, err = NewDocument(ctx, []byte("login"), noEncoder, []string{"alice"})
+	require.ErrorContains(t, err, "invalid signer")
+
+	doc, err := NewDocument(ctx, []byte("login"), b64Encoder, []string{alice})
+	require.NoError(t, err)
+	signers, err := doc.GetSigners()
+	require.NoError(t, err)
+	require.Len(t, signers, 1)
+}
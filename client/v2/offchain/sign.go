@@ -10,6 +10,7 @@ import (
 	clientcontext "cosmossdk.io/client/v2/context"
 	"cosmossdk.io/client/v2/internal/account"
 	"cosmossdk.io/client/v2/internal/offchain"
+	"cosmossdk.io/client/v2/offchain/verifier"
 	clitx "cosmossdk.io/client/v2/tx"
 
 	"github.com/cosmos/cosmos-sdk/version"
@@ -17,17 +18,16 @@ import (
 
 const (
 	// ExpectedChainID defines the chain id an off-chain message must have
-	ExpectedChainID = ""
+	ExpectedChainID = verifier.ExpectedChainID
 	// ExpectedAccountNumber defines the account number an off-chain message must have
-	ExpectedAccountNumber = 0
+	ExpectedAccountNumber = verifier.ExpectedAccountNumber
 	// ExpectedSequence defines the sequence number an off-chain message must have
-	ExpectedSequence = 0
+	ExpectedSequence = verifier.ExpectedSequence
 )
 
-var enabledSignModes = []apisigning.SignMode{
-	apisigning.SignMode_SIGN_MODE_DIRECT,
-	apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
-}
+// MsgSignArbitraryData is the message off-chain documents are made of, one per
+// signer.
+type MsgSignArbitraryData = verifier.MsgSignArbitraryData
 
 // Sign signs given bytes using the specified encoder and SignMode.
 func Sign(
@@ -41,12 +41,7 @@ func Sign(
 		return "", err
 	}
 
-	txConfig, err := clitx.NewTxConfig(clitx.ConfigOptions{
-		AddressCodec:          ctx.AddressCodec,
-		Cdc:                   ctx.Cdc,
-		ValidatorAddressCodec: ctx.ValidatorAddressCodec,
-		EnabledSignModes:      enabledSignModes,
-	})
+	txConfig, err := verifier.NewTxConfig(ctx.AddressCodec, ctx.ValidatorAddressCodec, ctx.Cdc)
 	if err != nil {
 		return "", err
 	}
@@ -119,6 +114,8 @@ func getSignMode(mode string) (apisigning.SignMode, error) {
 		return apisigning.SignMode_SIGN_MODE_DIRECT, nil
 	case "amino-json":
 		return apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, nil
+	case "textual":
+		return apisigning.SignMode_SIGN_MODE_TEXTUAL, nil
 	}
 
 	return apisigning.SignMode_SIGN_MODE_UNSPECIFIED, fmt.Errorf("unsupported sign mode: %s", mode)
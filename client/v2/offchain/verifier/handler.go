@@ -0,0 +1,49 @@
+package verifier
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// maxDocumentSize is the maximum size of the documents posted to the handler.
+const maxDocumentSize = 1 << 20
+
+// errorResponse is the response of the handler to a document that does not
+// verify.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// NewHandler returns an http.Handler verifying the off-chain documents posted
+// to it in their JSON format. It responds with the verified Document, or with
+// the reason of the failure and a 401 status if the document does not verify.
+func NewHandler(v *Verifier) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "method not allowed"})
+			return
+		}
+
+		bz, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxDocumentSize))
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+			return
+		}
+
+		doc, err := v.Verify(r.Context(), bz, "json")
+		if err != nil {
+			writeJSON(w, http.StatusUnauthorized, errorResponse{Error: err.Error()})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, doc)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
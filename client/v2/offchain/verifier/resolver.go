@@ -0,0 +1,240 @@
+package verifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	basev1 "cosmossdk.io/api/cosmos/accounts/defaults/base/v1"
+	multisigv1 "cosmossdk.io/api/cosmos/accounts/defaults/multisig/v1"
+	accountsv1 "cosmossdk.io/api/cosmos/accounts/v1"
+	"cosmossdk.io/core/address"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// PubKeyResolver resolves the public keys accounts authenticate with on chain.
+type PubKeyResolver interface {
+	// ResolvePubKey returns the public key of the account of addr, or nil if
+	// the account is not on chain or has no public key yet.
+	ResolvePubKey(ctx context.Context, addr []byte) (cryptotypes.PubKey, error)
+}
+
+// maxNestedAccounts is the maximum depth of the accounts resolved for an
+// account, e.g. of multisig accounts members of multisig accounts.
+const maxNestedAccounts = 8
+
+// AccountPubKeyFunc returns the public key the x/accounts account of addr
+// authenticates with, from the queries of the account sent through q, or nil
+// if it has none yet.
+type AccountPubKeyFunc func(ctx context.Context, q AccountQuerier, addr string) (cryptotypes.PubKey, error)
+
+// AccountQuerier queries the chain for an AccountPubKeyFunc.
+type AccountQuerier interface {
+	// QueryAccount sends req to the query handlers of the x/accounts account
+	// of addr and unmarshals their response into res.
+	QueryAccount(ctx context.Context, addr string, req, res proto.Message) error
+	// UnpackPubKey unpacks a public key returned by a query.
+	UnpackPubKey(anyPk *anypb.Any) (cryptotypes.PubKey, error)
+	// ResolvePubKey returns the public key of the account of addr, e.g. of a
+	// member of the account, or nil if it is not on chain or has no public
+	// key yet.
+	ResolvePubKey(ctx context.Context, addr string) (cryptotypes.PubKey, error)
+}
+
+// DefaultAccountPubKeyFuncs returns the AccountPubKeyFuncs of the default
+// x/accounts accounts, keyed by account type.
+func DefaultAccountPubKeyFuncs() map[string]AccountPubKeyFunc {
+	return map[string]AccountPubKeyFunc{
+		"base":     BaseAccountPubKey,
+		"multisig": MultisigAccountPubKey,
+	}
+}
+
+// BaseAccountPubKey returns the public key of a base account, through its
+// QueryPubKey query handler.
+func BaseAccountPubKey(ctx context.Context, q AccountQuerier, addr string) (cryptotypes.PubKey, error) {
+	res := &basev1.QueryPubKeyResponse{}
+	if err := q.QueryAccount(ctx, addr, &basev1.QueryPubKey{}, res); err != nil {
+		return nil, err
+	}
+	if res.PubKey == nil {
+		return nil, nil
+	}
+	return q.UnpackPubKey(res.PubKey)
+}
+
+// MultisigAccountPubKey returns the weighted multisig public key of a multisig
+// account: its members sign with their own public keys, in the order of its
+// QueryConfig query handler, and their signatures must weigh at least both
+// the threshold and the quorum of the account, like the yes votes executing
+// its proposals. All the members must have a public key.
+func MultisigAccountPubKey(ctx context.Context, q AccountQuerier, addr string) (cryptotypes.PubKey, error) {
+	res := &multisigv1.QueryConfigResponse{}
+	if err := q.QueryAccount(ctx, addr, &multisigv1.QueryConfig{}, res); err != nil {
+		return nil, err
+	}
+	if res.Config == nil || res.Config.Threshold <= 0 || res.Config.Quorum <= 0 {
+		return nil, errors.New("multisig account has no valid config")
+	}
+
+	pubKeys := make([]cryptotypes.PubKey, len(res.Members))
+	weights := make([]uint64, len(res.Members))
+	var totalWeight uint64
+	for i, member := range res.Members {
+		pubKey, err := q.ResolvePubKey(ctx, member.Address)
+		if err != nil {
+			return nil, fmt.Errorf("member %s: %w", member.Address, err)
+		}
+		if pubKey == nil {
+			return nil, fmt.Errorf("member %s has no public key", member.Address)
+		}
+		if member.Weight == 0 || totalWeight > math.MaxUint64-member.Weight {
+			return nil, fmt.Errorf("member %s has an invalid weight %d", member.Address, member.Weight)
+		}
+		pubKeys[i], weights[i] = pubKey, member.Weight
+		totalWeight += member.Weight
+	}
+
+	threshold := uint64(max(res.Config.Threshold, res.Config.Quorum))
+	if totalWeight < threshold {
+		return nil, fmt.Errorf("multisig account members weigh %d, less than its threshold %d", totalWeight, threshold)
+	}
+
+	return kmultisig.NewWeightedPubKey(threshold, pubKeys, weights), nil
+}
+
+var _ PubKeyResolver = grpcPubKeyResolver{}
+
+type grpcPubKeyResolver struct {
+	ac             address.Codec
+	conn           gogogrpc.ClientConn
+	registry       codectypes.InterfaceRegistry
+	accountPubKeys map[string]AccountPubKeyFunc
+}
+
+// NewGRPCPubKeyResolver returns a PubKeyResolver querying the public keys of
+// the accounts over conn: from x/auth, which also knows the x/accounts
+// accounts with a base account representation, and otherwise from the
+// x/accounts account itself, with the AccountPubKeyFunc of its account type
+// in accountPubKeys, e.g. DefaultAccountPubKeyFuncs. The public keys of the
+// accounts of other types are not resolved.
+func NewGRPCPubKeyResolver(
+	ac address.Codec,
+	conn gogogrpc.ClientConn,
+	registry codectypes.InterfaceRegistry,
+	accountPubKeys map[string]AccountPubKeyFunc,
+) PubKeyResolver {
+	return grpcPubKeyResolver{
+		ac:             ac,
+		conn:           conn,
+		registry:       registry,
+		accountPubKeys: accountPubKeys,
+	}
+}
+
+// ResolvePubKey implements PubKeyResolver.
+func (r grpcPubKeyResolver) ResolvePubKey(ctx context.Context, addr []byte) (cryptotypes.PubKey, error) {
+	addrStr, err := r.ac.BytesToString(addr)
+	if err != nil {
+		return nil, err
+	}
+	return r.resolvePubKey(ctx, addrStr, 0)
+}
+
+// resolvePubKey returns the public key of the account of addr, resolved for
+// an account nested depth times.
+func (r grpcPubKeyResolver) resolvePubKey(ctx context.Context, addr string, depth int) (cryptotypes.PubKey, error) {
+	if depth > maxNestedAccounts {
+		return nil, fmt.Errorf("accounts nested more than %d times", maxNestedAccounts)
+	}
+
+	res, err := authtypes.NewQueryClient(r.conn).AccountInfo(ctx, &authtypes.QueryAccountInfoRequest{Address: addr})
+	switch {
+	case err == nil:
+		if res.Info == nil || res.Info.PubKey == nil {
+			return nil, nil
+		}
+		return r.unpackPubKey(res.Info.PubKey)
+	case status.Code(err) != codes.NotFound:
+		return nil, err
+	}
+
+	return r.accountPubKey(ctx, addr, depth)
+}
+
+// accountPubKey returns the public key of the x/accounts account of addr.
+func (r grpcPubKeyResolver) accountPubKey(ctx context.Context, addr string, depth int) (cryptotypes.PubKey, error) {
+	typeRes := &accountsv1.AccountTypeResponse{}
+	err := r.conn.Invoke(ctx, accountsv1.Query_AccountType_FullMethodName, &accountsv1.AccountTypeRequest{Address: addr}, typeRes)
+	switch {
+	case status.Code(err) == codes.NotFound:
+		// x/accounts did not find the account either, it is not on chain
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to query the account type of %s: %w", addr, err)
+	}
+
+	pubKeyFn, ok := r.accountPubKeys[typeRes.AccountType]
+	if !ok {
+		return nil, fmt.Errorf("public keys of the accounts of type %s are not resolved", typeRes.AccountType)
+	}
+
+	pubKey, err := pubKeyFn(ctx, accountQuerier{r: r, depth: depth}, addr)
+	if err != nil {
+		return nil, fmt.Errorf("account of type %s exposes no public key: %w", typeRes.AccountType, err)
+	}
+	return pubKey, nil
+}
+
+var _ AccountQuerier = accountQuerier{}
+
+// accountQuerier is the AccountQuerier of an account resolved depth times
+// nested.
+type accountQuerier struct {
+	r     grpcPubKeyResolver
+	depth int
+}
+
+// QueryAccount implements AccountQuerier.
+func (q accountQuerier) QueryAccount(ctx context.Context, addr string, req, res proto.Message) error {
+	anyReq, err := anypb.New(req)
+	if err != nil {
+		return err
+	}
+	anyRes := &accountsv1.AccountQueryResponse{}
+	err = q.r.conn.Invoke(ctx, accountsv1.Query_AccountQuery_FullMethodName, &accountsv1.AccountQueryRequest{Target: addr, Request: anyReq}, anyRes)
+	if err != nil {
+		return err
+	}
+	return anyRes.Response.UnmarshalTo(res)
+}
+
+// UnpackPubKey implements AccountQuerier.
+func (q accountQuerier) UnpackPubKey(anyPk *anypb.Any) (cryptotypes.PubKey, error) {
+	return q.r.unpackPubKey(&codectypes.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value})
+}
+
+// ResolvePubKey implements AccountQuerier.
+func (q accountQuerier) ResolvePubKey(ctx context.Context, addr string) (cryptotypes.PubKey, error) {
+	return q.r.resolvePubKey(ctx, addr, q.depth+1)
+}
+
+// unpackPubKey unpacks a public key with the interface registry.
+func (r grpcPubKeyResolver) unpackPubKey(anyPk *codectypes.Any) (cryptotypes.PubKey, error) {
+	var pubKey cryptotypes.PubKey
+	if err := r.registry.UnpackAny(anyPk, &pubKey); err != nil {
+		return nil, err
+	}
+	return pubKey, nil
+}
@@ -0,0 +1,331 @@
+// Package verifier verifies ADR-036 off-chain documents, signed by one or
+// several signers, single keys or multisig keys, in any of the sign modes the
+// documents can be signed with. It can be embedded by the backends relying on
+// off-chain signatures, e.g. to authenticate their users.
+package verifier
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/client/v2/internal/offchain"
+	clitx "cosmossdk.io/client/v2/tx"
+	"cosmossdk.io/core/address"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	// ExpectedChainID defines the chain id an off-chain message must have
+	ExpectedChainID = ""
+	// ExpectedAccountNumber defines the account number an off-chain message must have
+	ExpectedAccountNumber = 0
+	// ExpectedSequence defines the sequence number an off-chain message must have
+	ExpectedSequence = 0
+)
+
+// msgTypeURL is the type URL of the messages of an off-chain document.
+const msgTypeURL = "/offchain.MsgSignArbitraryData"
+
+// SignModes are the sign modes off-chain documents can be signed with.
+// Documents with several signers or signed by a multisig key can only be
+// signed with SIGN_MODE_LEGACY_AMINO_JSON, as the other sign modes sign over
+// the signer infos of all the signers.
+var SignModes = []apisigning.SignMode{
+	apisigning.SignMode_SIGN_MODE_DIRECT,
+	apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	apisigning.SignMode_SIGN_MODE_TEXTUAL,
+}
+
+// NewTxConfig returns the TxConfig encoding, decoding and signing off-chain
+// documents in SignModes.
+func NewTxConfig(addressCodec, validatorAddressCodec address.Codec, cdc codec.BinaryCodec) (clitx.TxConfig, error) {
+	return clitx.NewTxConfig(clitx.ConfigOptions{
+		AddressCodec:          addressCodec,
+		Cdc:                   cdc,
+		ValidatorAddressCodec: validatorAddressCodec,
+		EnabledSignModes:      SignModes,
+		// off-chain documents have no coins to render
+		TextualCoinMetadataQueryFn: func(context.Context, string) (*bankv1beta1.Metadata, error) {
+			return nil, nil
+		},
+	})
+}
+
+// Options are the options of a Verifier.
+type Options struct {
+	AddressCodec          address.Codec
+	ValidatorAddressCodec address.Codec
+	Cdc                   codec.BinaryCodec
+
+	// PubKeyResolver, if set, resolves the public keys the signers authenticate
+	// with on chain. Otherwise, the signatures are verified against the public
+	// keys of the documents, which must match the addresses of the signers.
+	PubKeyResolver PubKeyResolver
+}
+
+// Document is a verified off-chain document.
+type Document struct {
+	// AppDomain is the application which requested the signatures.
+	AppDomain string `json:"app_domain"`
+	// Data is the signed data.
+	Data string `json:"data"`
+	// Signers are the addresses of the signers of the document.
+	Signers []string `json:"signers"`
+}
+
+// Verifier verifies off-chain documents.
+type Verifier struct {
+	addressCodec address.Codec
+	txConfig     clitx.TxConfig
+	resolver     PubKeyResolver
+}
+
+// New returns a Verifier with the given options.
+func New(opts Options) (*Verifier, error) {
+	if opts.AddressCodec == nil || opts.ValidatorAddressCodec == nil {
+		return nil, errors.New("address codecs cannot be nil")
+	}
+	if opts.Cdc == nil {
+		return nil, errors.New("codec cannot be nil")
+	}
+
+	txConfig, err := NewTxConfig(opts.AddressCodec, opts.ValidatorAddressCodec, opts.Cdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Verifier{
+		addressCodec: opts.AddressCodec,
+		txConfig:     txConfig,
+		resolver:     opts.PubKeyResolver,
+	}, nil
+}
+
+// Verify decodes a document in the given format, json or text, and verifies
+// it.
+func (v *Verifier) Verify(ctx context.Context, bz []byte, format string) (*Document, error) {
+	var (
+		doc clitx.Tx
+		err error
+	)
+	switch format {
+	case "json":
+		doc, err = v.txConfig.TxJSONDecoder()(bz)
+	case "text":
+		doc, err = v.txConfig.TxTextDecoder()(bz)
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return v.VerifyTx(ctx, doc)
+}
+
+// VerifyTx verifies a decoded document: all its messages must be
+// MsgSignArbitraryData of the same data, each signed by its signer.
+func (v *Verifier) VerifyTx(ctx context.Context, doc clitx.Tx) (*Document, error) {
+	txData, err := doc.GetSigningTxData()
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := v.document(txData)
+	if err != nil {
+		return nil, err
+	}
+
+	signers, err := doc.GetSigners()
+	if err != nil {
+		return nil, err
+	}
+
+	sigs, err := doc.GetSignatures()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sigs) != len(signers) {
+		return nil, errors.New("mismatch between the number of signatures and signers")
+	}
+
+	for i, sig := range sigs {
+		addr, err := v.addressCodec.BytesToString(signers[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if sig.Data == nil {
+			return nil, fmt.Errorf("signer %s has not signed the document", addr)
+		}
+
+		pubKey, err := v.signerPubKey(ctx, signers[i], sig.PubKey)
+		if err != nil {
+			return nil, fmt.Errorf("signer %s: %w", addr, err)
+		}
+
+		anyPk, err := codectypes.NewAnyWithValue(pubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		signerData := txsigning.SignerData{
+			ChainID:       ExpectedChainID,
+			AccountNumber: ExpectedAccountNumber,
+			Sequence:      ExpectedSequence,
+			Address:       addr,
+			PubKey: &anypb.Any{
+				TypeUrl: anyPk.TypeUrl,
+				Value:   anyPk.Value,
+			},
+		}
+
+		err = verifySignature(ctx, pubKey, signerData, sig.Data, v.txConfig.SignModeHandler(), txData)
+		if err != nil {
+			return nil, fmt.Errorf("signer %s: %w", addr, err)
+		}
+	}
+
+	return document, nil
+}
+
+// document returns the document of the messages of txData, checking that they
+// are all off-chain messages of the same application and data.
+func (v *Verifier) document(txData txsigning.TxData) (*Document, error) {
+	msgs := txData.Body.Messages
+	if len(msgs) == 0 {
+		return nil, errors.New("document has no messages")
+	}
+
+	document := &Document{Signers: make([]string, len(msgs))}
+	for i, anyMsg := range msgs {
+		if anyMsg.TypeUrl != msgTypeURL {
+			return nil, fmt.Errorf("unexpected message %s in document, only %s is allowed", anyMsg.TypeUrl, msgTypeURL)
+		}
+
+		msg := &offchain.MsgSignArbitraryData{}
+		if err := proto.Unmarshal(anyMsg.Value, msg); err != nil {
+			return nil, err
+		}
+
+		if i == 0 {
+			document.AppDomain, document.Data = msg.AppDomain, msg.Data
+		} else if msg.AppDomain != document.AppDomain || msg.Data != document.Data {
+			return nil, errors.New("all the messages of a document must have the same app domain and data")
+		}
+		document.Signers[i] = msg.Signer
+	}
+
+	return document, nil
+}
+
+// signerPubKey returns the public key the signature of signer is verified
+// against: the public key the signer authenticates with on chain, when
+// resolved, or the public key of its signature, which must match its address.
+func (v *Verifier) signerPubKey(ctx context.Context, signer []byte, sigPubKey cryptotypes.PubKey) (cryptotypes.PubKey, error) {
+	if v.resolver != nil {
+		pubKey, err := v.resolver.ResolvePubKey(ctx, signer)
+		if err != nil {
+			return nil, err
+		}
+		if pubKey != nil {
+			if sigPubKey != nil && !sigPubKey.Equals(pubKey) {
+				return nil, errors.New("signature public key does not match the on-chain one")
+			}
+			return pubKey, nil
+		}
+	}
+
+	if sigPubKey == nil {
+		return nil, errors.New("signature has no public key")
+	}
+	if !bytes.Equal(sigPubKey.Address(), signer) {
+		return nil, errors.New("signature does not match its respective signer")
+	}
+	return sigPubKey, nil
+}
+
+// verifySignature verifies a transaction signature contained in SignatureData abstracting over different signing modes.
+func verifySignature(
+	ctx context.Context,
+	pubKey cryptotypes.PubKey,
+	signerData txsigning.SignerData,
+	signatureData clitx.SignatureData,
+	handler *txsigning.HandlerMap,
+	txData txsigning.TxData,
+) error {
+	switch data := signatureData.(type) {
+	case *clitx.SingleSignatureData:
+		signBytes, err := handler.GetSignBytes(ctx, data.SignMode, signerData, txData)
+		if err != nil {
+			return err
+		}
+		if !pubKey.VerifySignature(signBytes, data.Signature) {
+			return errors.New("unable to verify single signer signature")
+		}
+		return nil
+	case *clitx.MultiSignatureData:
+		multiPK, ok := pubKey.(multisig.PubKey)
+		if !ok {
+			return fmt.Errorf("expected %T, got %T", (multisig.PubKey)(nil), pubKey)
+		}
+		sigData, err := multiSignatureDataToSDK(data)
+		if err != nil {
+			return err
+		}
+		return multiPK.VerifyMultisignature(func(mode signing.SignMode) ([]byte, error) {
+			return handler.GetSignBytes(ctx, apisigning.SignMode(mode), signerData, txData)
+		}, sigData)
+	default:
+		return fmt.Errorf("unexpected SignatureData %T", signatureData)
+	}
+}
+
+// multiSignatureDataToSDK converts a MultiSignatureData to the signing one
+// multisig public keys verify.
+func multiSignatureDataToSDK(data *clitx.MultiSignatureData) (*signing.MultiSignatureData, error) {
+	if data.BitArray == nil {
+		return nil, errors.New("multisignature without bit array")
+	}
+
+	sigs := make([]signing.SignatureData, len(data.Signatures))
+	for i, sig := range data.Signatures {
+		switch sig := sig.(type) {
+		case *clitx.SingleSignatureData:
+			sigs[i] = &signing.SingleSignatureData{
+				SignMode:  signing.SignMode(sig.SignMode),
+				Signature: sig.Signature,
+			}
+		case *clitx.MultiSignatureData:
+			nested, err := multiSignatureDataToSDK(sig)
+			if err != nil {
+				return nil, err
+			}
+			sigs[i] = nested
+		default:
+			return nil, fmt.Errorf("unexpected SignatureData %T", sig)
+		}
+	}
+
+	return &signing.MultiSignatureData{
+		BitArray: &cryptotypes.CompactBitArray{
+			ExtraBitsStored: data.BitArray.ExtraBitsStored,
+			Elems:           data.BitArray.Elems,
+		},
+		Signatures: sigs,
+	}, nil
+}
@@ -0,0 +1,455 @@
+package verifier
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	basev1 "cosmossdk.io/api/cosmos/accounts/defaults/base/v1"
+	multisigv1 "cosmossdk.io/api/cosmos/accounts/defaults/multisig/v1"
+	accountsv1 "cosmossdk.io/api/cosmos/accounts/v1"
+	_ "cosmossdk.io/api/cosmos/crypto/secp256k1"
+	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	apitx "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/client/v2/internal/offchain"
+	clitx "cosmossdk.io/client/v2/tx"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/codec/testutil"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	signer = "cosmos16877zjk85kwlap3wclpmx34e0xllg2erc7u7m4"
+	// signedDocument is a document signed by signer in SIGN_MODE_DIRECT.
+	signedDocument = "{\"body\":{\"messages\":[{\"@type\":\"/offchain.MsgSignArbitraryData\", \"app_domain\":\"<appd>\", \"signer\":\"cosmos16877zjk85kwlap3wclpmx34e0xllg2erc7u7m4\", \"data\":\"{\\n\\t\\\"name\\\": \\\"Sarah\\\",\\n\\t\\\"surname\\\": \\\"Connor\\\",\\n\\t\\\"age\\\": 29\\n}\\n\"}], \"timeout_timestamp\":\"0001-01-01T00:00:00Z\"}, \"auth_info\":{\"signer_infos\":[{\"public_key\":{\"@type\":\"/cosmos.crypto.secp256k1.PubKey\", \"key\":\"Ahhu3idSSUAQXtDBvBjUlCPWH3od4rXyWgb7L4scSj4m\"}, \"mode_info\":{\"single\":{\"mode\":\"SIGN_MODE_DIRECT\"}}}], \"fee\":{}}, \"signatures\":[\"tdXsO5uNqIBFSBKEA1e3Wrcb6ejriP9HwlcBTkU7EUJzuezjg6Rvr1a+Kp6umCAN7MWoBHRT2cmqzDfg6RjaYA==\"]}"
+)
+
+func getCodec() codec.Codec {
+	registry := testutil.CodecOptions{}.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+
+	return codec.NewProtoCodec(registry)
+}
+
+func signerPubKey(t *testing.T) cryptotypes.PubKey {
+	t.Helper()
+	key, err := base64.StdEncoding.DecodeString("Ahhu3idSSUAQXtDBvBjUlCPWH3od4rXyWgb7L4scSj4m")
+	require.NoError(t, err)
+	return &secp256k1.PubKey{Key: key}
+}
+
+func newVerifier(t *testing.T, resolver PubKeyResolver) *Verifier {
+	t.Helper()
+	v, err := New(Options{
+		AddressCodec:          address.NewBech32Codec("cosmos"),
+		ValidatorAddressCodec: address.NewBech32Codec("cosmosvaloper"),
+		Cdc:                   getCodec(),
+		PubKeyResolver:        resolver,
+	})
+	require.NoError(t, err)
+	return v
+}
+
+type staticResolver struct {
+	pubKey cryptotypes.PubKey
+	err    error
+}
+
+func (r staticResolver) ResolvePubKey(context.Context, []byte) (cryptotypes.PubKey, error) {
+	return r.pubKey, r.err
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	tests := []struct {
+		name     string
+		resolver PubKeyResolver
+		doc      string
+		format   string
+		wantErr  string
+	}{
+		{
+			name:   "no resolver",
+			doc:    signedDocument,
+			format: "json",
+		},
+		{
+			name:     "resolved public key",
+			resolver: staticResolver{pubKey: signerPubKey(t)},
+			doc:      signedDocument,
+			format:   "json",
+		},
+		{
+			name:     "account not on chain",
+			resolver: staticResolver{},
+			doc:      signedDocument,
+			format:   "json",
+		},
+		{
+			name:     "other resolved public key",
+			resolver: staticResolver{pubKey: secp256k1.GenPrivKey().PubKey()},
+			doc:      signedDocument,
+			format:   "json",
+			wantErr:  "does not match the on-chain one",
+		},
+		{
+			name:     "resolver error",
+			resolver: staticResolver{err: errors.New("unavailable")},
+			doc:      signedDocument,
+			format:   "json",
+			wantErr:  "unavailable",
+		},
+		{
+			name:    "tampered data",
+			doc:     strings.Replace(signedDocument, "Sarah", "John", 1),
+			format:  "json",
+			wantErr: "unable to verify single signer signature",
+		},
+		{
+			name:    "unsupported format",
+			doc:     signedDocument,
+			format:  "yaml",
+			wantErr: "unsupported format",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := newVerifier(t, tt.resolver).Verify(context.Background(), []byte(tt.doc), tt.format)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "<appd>", doc.AppDomain)
+			require.Equal(t, []string{signer}, doc.Signers)
+		})
+	}
+}
+
+func TestNewHandler(t *testing.T) {
+	handler := NewHandler(newVerifier(t, nil))
+
+	tests := []struct {
+		name     string
+		method   string
+		body     string
+		wantCode int
+	}{
+		{
+			name:     "verified",
+			method:   http.MethodPost,
+			body:     signedDocument,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "not verified",
+			method:   http.MethodPost,
+			body:     strings.Replace(signedDocument, "Sarah", "John", 1),
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "method not allowed",
+			method:   http.MethodGet,
+			wantCode: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tt.method, "/", strings.NewReader(tt.body)))
+			require.Equal(t, tt.wantCode, rec.Code)
+
+			if tt.wantCode != http.StatusOK {
+				var res errorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+				require.NotEmpty(t, res.Error)
+				return
+			}
+			var doc Document
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+			require.Equal(t, []string{signer}, doc.Signers)
+		})
+	}
+}
+
+var _ gogogrpc.ClientConn = mockClientConn{}
+
+// mockClientConn answers the x/auth and x/accounts queries of the accounts of
+// its maps, keyed by address: the x/accounts base accounts and their public
+// keys, and the multisig accounts and their config. If accountTypeErr is set,
+// the x/accounts account type queries fail with it.
+type mockClientConn struct {
+	authAccounts     map[string]*codectypes.Any
+	accountsAccounts map[string]*anypb.Any
+	multisigAccounts map[string]*multisigv1.QueryConfigResponse
+	accountTypeErr   error
+}
+
+func (c mockClientConn) Invoke(_ context.Context, method string, args, reply interface{}, _ ...grpc.CallOption) error {
+	switch method {
+	case "/cosmos.auth.v1beta1.Query/AccountInfo":
+		addr := args.(*authtypes.QueryAccountInfoRequest).Address
+		pubKey, ok := c.authAccounts[addr]
+		if !ok {
+			return status.Error(codes.NotFound, "account not found")
+		}
+		reply.(*authtypes.QueryAccountInfoResponse).Info = &authtypes.BaseAccount{Address: addr, PubKey: pubKey}
+		return nil
+	case accountsv1.Query_AccountType_FullMethodName:
+		if c.accountTypeErr != nil {
+			return c.accountTypeErr
+		}
+		addr := args.(*accountsv1.AccountTypeRequest).Address
+		if _, ok := c.multisigAccounts[addr]; ok {
+			reply.(*accountsv1.AccountTypeResponse).AccountType = "multisig"
+			return nil
+		}
+		if _, ok := c.accountsAccounts[addr]; !ok {
+			return status.Error(codes.NotFound, "account not found")
+		}
+		reply.(*accountsv1.AccountTypeResponse).AccountType = "base"
+		return nil
+	case accountsv1.Query_AccountQuery_FullMethodName:
+		req := args.(*accountsv1.AccountQueryRequest)
+		var (
+			res *anypb.Any
+			err error
+		)
+		switch {
+		case req.Request.MessageIs(&basev1.QueryPubKey{}):
+			res, err = anypb.New(&basev1.QueryPubKeyResponse{PubKey: c.accountsAccounts[req.Target]})
+		case req.Request.MessageIs(&multisigv1.QueryConfig{}):
+			res, err = anypb.New(c.multisigAccounts[req.Target])
+		default:
+			return status.Errorf(codes.InvalidArgument, "unknown query %s", req.Request.TypeUrl)
+		}
+		if err != nil {
+			return err
+		}
+		reply.(*accountsv1.AccountQueryResponse).Response = res
+		return nil
+	}
+	return status.Errorf(codes.Unimplemented, "unknown method %s", method)
+}
+
+func (c mockClientConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestGRPCPubKeyResolver(t *testing.T) {
+	ac := address.NewBech32Codec("cosmos")
+	pubKey := signerPubKey(t)
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+
+	authAccount := secp256k1.GenPrivKey().PubKey().Address()
+	authAccountNoPubKey := secp256k1.GenPrivKey().PubKey().Address()
+	xAccount := secp256k1.GenPrivKey().PubKey().Address()
+	unknown := secp256k1.GenPrivKey().PubKey().Address()
+
+	addrStr := func(addr []byte) string {
+		s, err := ac.BytesToString(addr)
+		require.NoError(t, err)
+		return s
+	}
+	conn := mockClientConn{
+		authAccounts: map[string]*codectypes.Any{
+			addrStr(authAccount):         anyPk,
+			addrStr(authAccountNoPubKey): nil,
+		},
+		accountsAccounts: map[string]*anypb.Any{
+			addrStr(xAccount): {TypeUrl: anyPk.TypeUrl, Value: anyPk.Value},
+		},
+	}
+	resolver := NewGRPCPubKeyResolver(ac, conn, getCodec().InterfaceRegistry(), DefaultAccountPubKeyFuncs())
+
+	tests := []struct {
+		name string
+		addr []byte
+		want cryptotypes.PubKey
+	}{
+		{name: "x/auth account", addr: authAccount, want: pubKey},
+		{name: "x/auth account without public key", addr: authAccountNoPubKey},
+		{name: "x/accounts account", addr: xAccount, want: pubKey},
+		{name: "unknown account", addr: unknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolver.ResolvePubKey(context.Background(), tt.addr)
+			require.NoError(t, err)
+			if tt.want == nil {
+				require.Nil(t, got)
+				return
+			}
+			require.True(t, tt.want.Equals(got))
+		})
+	}
+
+	// only the accounts which are not found are not on chain, the other
+	// errors are returned
+	conn.accountTypeErr = status.Error(codes.Unavailable, "connection refused")
+	resolver = NewGRPCPubKeyResolver(ac, conn, getCodec().InterfaceRegistry(), DefaultAccountPubKeyFuncs())
+	_, err = resolver.ResolvePubKey(context.Background(), unknown)
+	require.ErrorContains(t, err, "connection refused")
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+// multisigDocument returns a document signed by signer in amino-json, with
+// the signatures of privKeys, members of pubKey.
+func multisigDocument(t *testing.T, signer string, pubKey multisig.PubKey, privKeys ...cryptotypes.PrivKey) clitx.Tx {
+	t.Helper()
+	txConfig, err := NewTxConfig(address.NewBech32Codec("cosmos"), address.NewBech32Codec("cosmosvaloper"), getCodec())
+	require.NoError(t, err)
+
+	marshalOption := proto.MarshalOptions{Deterministic: true}
+	msg, err := marshalOption.Marshal(&offchain.MsgSignArbitraryData{AppDomain: "<appd>", Signer: signer, Data: "login"})
+	require.NoError(t, err)
+	bodyBytes, err := marshalOption.Marshal(&apitx.TxBody{Messages: []*anypb.Any{{TypeUrl: msgTypeURL, Value: msg}}})
+	require.NoError(t, err)
+
+	encode := func(sig clitx.Signature) clitx.Tx {
+		signerInfos, rawSignatures, err := clitx.SignerInfosAndSignatures([]clitx.Signature{sig})
+		require.NoError(t, err)
+		authInfoBytes, err := marshalOption.Marshal(&apitx.AuthInfo{SignerInfos: signerInfos, Fee: &apitx.Fee{}})
+		require.NoError(t, err)
+		txBytes, err := marshalOption.Marshal(&apitx.TxRaw{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes, Signatures: rawSignatures})
+		require.NoError(t, err)
+		doc, err := txConfig.TxDecoder()(txBytes)
+		require.NoError(t, err)
+		return doc
+	}
+
+	doc := encode(clitx.Signature{PubKey: pubKey, Data: &clitx.SingleSignatureData{SignMode: apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}})
+	txData, err := doc.GetSigningTxData()
+	require.NoError(t, err)
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+	signerData := txsigning.SignerData{
+		ChainID:       ExpectedChainID,
+		AccountNumber: ExpectedAccountNumber,
+		Sequence:      ExpectedSequence,
+		Address:       signer,
+		PubKey:        &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value},
+	}
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(context.Background(), apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, txData)
+	require.NoError(t, err)
+
+	sigs := make([]clitx.Signature, len(privKeys))
+	for i, privKey := range privKeys {
+		sig, err := privKey.Sign(signBytes)
+		require.NoError(t, err)
+		sigs[i] = clitx.Signature{
+			PubKey: privKey.PubKey(),
+			Data:   &clitx.SingleSignatureData{SignMode: apisigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: sig},
+		}
+	}
+	multiSigData, err := clitx.NewMultiSignatureData(pubKey, sigs)
+	require.NoError(t, err)
+
+	return encode(clitx.Signature{PubKey: pubKey, Data: multiSigData})
+}
+
+func TestVerifier_MultisigAccount(t *testing.T) {
+	ac := address.NewBech32Codec("cosmos")
+	addrStr := func(addr []byte) string {
+		s, err := ac.BytesToString(addr)
+		require.NoError(t, err)
+		return s
+	}
+	anyPk := func(pubKey cryptotypes.PubKey) *codectypes.Any {
+		anyPk, err := codectypes.NewAnyWithValue(pubKey)
+		require.NoError(t, err)
+		return anyPk
+	}
+
+	// alice and bob are x/auth accounts, carol is an x/accounts base account
+	// and dave an x/auth account without public key
+	alice, bob, carol := secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	aliceAddr, bobAddr := addrStr(alice.PubKey().Address()), addrStr(bob.PubKey().Address())
+	carolAddr, daveAddr := addrStr(carol.PubKey().Address()), addrStr(secp256k1.GenPrivKey().PubKey().Address())
+	multi := addrStr(secp256k1.GenPrivKey().PubKey().Address())
+	multiWithDave := addrStr(secp256k1.GenPrivKey().PubKey().Address())
+
+	conn := mockClientConn{
+		authAccounts: map[string]*codectypes.Any{
+			aliceAddr: anyPk(alice.PubKey()),
+			bobAddr:   anyPk(bob.PubKey()),
+			daveAddr:  nil,
+		},
+		accountsAccounts: map[string]*anypb.Any{
+			carolAddr: {TypeUrl: anyPk(carol.PubKey()).TypeUrl, Value: anyPk(carol.PubKey()).Value},
+		},
+		multisigAccounts: map[string]*multisigv1.QueryConfigResponse{
+			multi: {
+				Members: []*multisigv1.Member{{Address: aliceAddr, Weight: 1}, {Address: bobAddr, Weight: 1}, {Address: carolAddr, Weight: 2}},
+				Config:  &multisigv1.Config{Threshold: 1, Quorum: 2, VotingPeriod: 60},
+			},
+			multiWithDave: {
+				Members: []*multisigv1.Member{{Address: aliceAddr, Weight: 1}, {Address: daveAddr, Weight: 1}},
+				Config:  &multisigv1.Config{Threshold: 1, Quorum: 1, VotingPeriod: 60},
+			},
+		},
+	}
+	resolver := NewGRPCPubKeyResolver(ac, conn, getCodec().InterfaceRegistry(), DefaultAccountPubKeyFuncs())
+
+	multiAddr, err := ac.StringToBytes(multi)
+	require.NoError(t, err)
+	pubKey, err := resolver.ResolvePubKey(context.Background(), multiAddr)
+	require.NoError(t, err)
+	// the signatures must weigh the quorum, greater than the threshold
+	wantPubKey := kmultisig.NewWeightedPubKey(2, []cryptotypes.PubKey{alice.PubKey(), bob.PubKey(), carol.PubKey()}, []uint64{1, 1, 2})
+	require.True(t, wantPubKey.Equals(pubKey))
+
+	otherPubKey := kmultisig.NewWeightedPubKey(1, []cryptotypes.PubKey{alice.PubKey(), bob.PubKey(), carol.PubKey()}, []uint64{1, 1, 2})
+	tests := []struct {
+		name    string
+		doc     clitx.Tx
+		wantErr string
+	}{
+		{name: "alice and bob", doc: multisigDocument(t, multi, wantPubKey, alice, bob)},
+		{name: "carol", doc: multisigDocument(t, multi, wantPubKey, carol)},
+		{name: "alice", doc: multisigDocument(t, multi, wantPubKey, alice), wantErr: "not enough signatures set"},
+		{name: "other public key", doc: multisigDocument(t, multi, otherPubKey, alice), wantErr: "does not match the on-chain one"},
+		{name: "member without public key", doc: multisigDocument(t, multiWithDave, wantPubKey, alice), wantErr: "member " + daveAddr + " has no public key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := newVerifier(t, resolver).VerifyTx(context.Background(), tt.doc)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "login", doc.Data)
+			require.Equal(t, []string{multi}, doc.Signers)
+		})
+	}
+
+	// the accounts of the types without AccountPubKeyFunc are not resolved
+	accountPubKeys := DefaultAccountPubKeyFuncs()
+	delete(accountPubKeys, "multisig")
+	resolver = NewGRPCPubKeyResolver(ac, conn, getCodec().InterfaceRegistry(), accountPubKeys)
+	_, err = resolver.ResolvePubKey(context.Background(), multiAddr)
+	require.ErrorContains(t, err, "accounts of type multisig are not resolved")
+}
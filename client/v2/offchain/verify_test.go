@@ -7,7 +7,7 @@ import (
 
 	_ "cosmossdk.io/api/cosmos/crypto/secp256k1"
 	clientcontext "cosmossdk.io/client/v2/context"
-	clitx "cosmossdk.io/client/v2/tx"
+	"cosmossdk.io/client/v2/offchain/verifier"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
@@ -87,12 +87,7 @@ func Test_SignVerify(t *testing.T) {
 }
 
 func Test_unmarshal(t *testing.T) {
-	txConfig, err := clitx.NewTxConfig(clitx.ConfigOptions{
-		AddressCodec:          address.NewBech32Codec("cosmos"),
-		Cdc:                   getCodec(),
-		ValidatorAddressCodec: address.NewBech32Codec("cosmosvaloper"),
-		EnabledSignModes:      enabledSignModes,
-	})
+	txConfig, err := verifier.NewTxConfig(address.NewBech32Codec("cosmos"), address.NewBech32Codec("cosmosvaloper"), getCodec())
 	require.NoError(t, err)
 	tests := []struct {
 		name       string
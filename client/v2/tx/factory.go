@@ -341,13 +341,18 @@ func (f *Factory) sign(ctx context.Context, overwriteSig bool) (Tx, error) {
 		return nil, err
 	}
 
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
 	signerData := signing.SignerData{
 		ChainID:       f.txParams.ChainID,
 		AccountNumber: f.txParams.AccountNumber,
 		Sequence:      f.txParams.Sequence,
 		PubKey: &anypb.Any{
-			TypeUrl: codectypes.MsgTypeURL(pubKey),
-			Value:   pubKey.Bytes(),
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
 		},
 		Address: addr,
 	}
@@ -513,16 +518,22 @@ func (f *Factory) getSimPK() (cryptotypes.PubKey, error) {
 // getSimSignatureData based on the pubKey type gets the correct SignatureData type
 // to use for building a simulation tx.
 func (f *Factory) getSimSignatureData(pk cryptotypes.PubKey) SignatureData {
-	multisigPubKey, ok := pk.(*multisig.LegacyAminoPubKey)
-	if !ok {
+	var pubKeys []cryptotypes.PubKey
+	switch pk := pk.(type) {
+	case *multisig.LegacyAminoPubKey:
+		// the first threshold members sign
+		pubKeys = pk.GetPubKeys()
+		pubKeys = pubKeys[:min(int(pk.Threshold), len(pubKeys))]
+	case *multisig.WeightedPubKey:
+		// the threshold may require the signatures of all the members
+		pubKeys = pk.GetPubKeys()
+	default:
 		return &SingleSignatureData{SignMode: f.txParams.SignMode}
 	}
 
-	multiSignatureData := make([]SignatureData, 0, multisigPubKey.Threshold)
-	for i := uint32(0); i < multisigPubKey.Threshold; i++ {
-		multiSignatureData = append(multiSignatureData, &SingleSignatureData{
-			SignMode: f.signMode(),
-		})
+	multiSignatureData := make([]SignatureData, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		multiSignatureData = append(multiSignatureData, f.getSimSignatureData(pubKey))
 	}
 
 	return &MultiSignatureData{
@@ -604,35 +615,9 @@ func (f *Factory) getSigningTxData() (*signing.TxData, error) {
 // It takes a variable number of Signature arguments and processes each one to extract the mode information and raw signature.
 // It also converts the public key to the appropriate format and sets the signer information.
 func (f *Factory) setSignatures(signatures ...Signature) error {
-	n := len(signatures)
-	signerInfos := make([]*apitx.SignerInfo, n)
-	rawSignatures := make([][]byte, n)
-
-	for i, sig := range signatures {
-		var (
-			modeInfo *apitx.ModeInfo
-			pubKey   *codectypes.Any
-			err      error
-			anyPk    *anypb.Any
-		)
-
-		modeInfo, rawSignatures[i] = signatureDataToModeInfoAndSig(sig.Data)
-		if sig.PubKey != nil {
-			pubKey, err = codectypes.NewAnyWithValue(sig.PubKey)
-			if err != nil {
-				return err
-			}
-			anyPk = &anypb.Any{
-				TypeUrl: pubKey.TypeUrl,
-				Value:   pubKey.Value,
-			}
-		}
-
-		signerInfos[i] = &apitx.SignerInfo{
-			PublicKey: anyPk,
-			ModeInfo:  modeInfo,
-			Sequence:  sig.Sequence,
-		}
+	signerInfos, rawSignatures, err := SignerInfosAndSignatures(signatures)
+	if err != nil {
+		return err
 	}
 
 	f.tx.signerInfos = signerInfos
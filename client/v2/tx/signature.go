@@ -3,12 +3,17 @@ package tx
 import (
 	"errors"
 	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/types/known/anypb"
 
 	apicrypto "cosmossdk.io/api/cosmos/crypto/multisig/v1beta1"
 	apitxsigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	apitx "cosmossdk.io/api/cosmos/tx/v1beta1"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 )
 
 // Signature holds the necessary components to verify transaction signatures.
@@ -195,3 +200,76 @@ func SignatureDataFromProto(descData *apitxsigning.SignatureDescriptor_Data) (Si
 
 	return nil, fmt.Errorf("unexpected signature data type %T", descData)
 }
+
+// NewMultiSignatureData assembles the signatures of the members of a multisig
+// public key, such as a LegacyAminoPubKey or a WeightedPubKey, into a
+// MultiSignatureData. The signature of a nested multisig member must itself be
+// a MultiSignatureData. A member signing twice keeps its last signature.
+func NewMultiSignatureData(pubKey multisig.PubKey, sigs []Signature) (*MultiSignatureData, error) {
+	pubKeys := pubKey.GetPubKeys()
+	bitArray := cryptotypes.NewCompactBitArray(len(pubKeys))
+	memberSigs := make([]SignatureData, len(pubKeys))
+	for _, sig := range sigs {
+		if sig.PubKey == nil || sig.Data == nil {
+			return nil, errors.New("signature with empty public key or data")
+		}
+
+		index := slices.IndexFunc(pubKeys, sig.PubKey.Equals)
+		if index == -1 {
+			return nil, fmt.Errorf("public key %X is not a member of the multisig", sig.PubKey.Bytes())
+		}
+		bitArray.SetIndex(index, true)
+		memberSigs[index] = sig.Data
+	}
+
+	signatures := make([]SignatureData, 0, len(sigs))
+	for _, data := range memberSigs {
+		if data != nil {
+			signatures = append(signatures, data)
+		}
+	}
+
+	return &MultiSignatureData{
+		BitArray: &apicrypto.CompactBitArray{
+			ExtraBitsStored: bitArray.ExtraBitsStored,
+			Elems:           bitArray.Elems,
+		},
+		Signatures: signatures,
+	}, nil
+}
+
+// SignerInfosAndSignatures returns the signer infos and the raw signatures of
+// signatures, as set in the auth info and the signatures of a transaction.
+// A signature without public key or data leaves them unset in its signer info.
+func SignerInfosAndSignatures(signatures []Signature) ([]*apitx.SignerInfo, [][]byte, error) {
+	n := len(signatures)
+	signerInfos := make([]*apitx.SignerInfo, n)
+	rawSignatures := make([][]byte, n)
+
+	for i, sig := range signatures {
+		var (
+			modeInfo *apitx.ModeInfo
+			anyPk    *anypb.Any
+		)
+
+		modeInfo, rawSignatures[i] = signatureDataToModeInfoAndSig(sig.Data)
+		if sig.PubKey != nil {
+			pubKey, err := codectypes.NewAnyWithValue(sig.PubKey)
+			if err != nil {
+				return nil, nil, err
+			}
+			anyPk = &anypb.Any{
+				TypeUrl: pubKey.TypeUrl,
+				Value:   pubKey.Value,
+			}
+		}
+
+		signerInfos[i] = &apitx.SignerInfo{
+			PublicKey: anyPk,
+			ModeInfo:  modeInfo,
+			Sequence:  sig.Sequence,
+		}
+	}
+
+	return signerInfos, rawSignatures, nil
+}
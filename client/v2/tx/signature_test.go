@@ -9,6 +9,10 @@ import (
 	apimultisig "cosmossdk.io/api/cosmos/crypto/multisig/v1beta1"
 	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	apitx "cosmossdk.io/api/cosmos/tx/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
 func TestSignatureDataToModeInfoAndSig(t *testing.T) {
@@ -141,3 +145,35 @@ func TestModeInfoAndSigToSignatureData(t *testing.T) {
 		})
 	}
 }
+
+func TestNewMultiSignatureData(t *testing.T) {
+	pubKeys := []cryptotypes.PubKey{
+		secp256k1.GenPrivKey().PubKey(),
+		multisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey()}),
+		secp256k1.GenPrivKey().PubKey(),
+	}
+	pk := multisig.NewWeightedPubKey(2, pubKeys, []uint64{2, 1, 1})
+
+	single := &SingleSignatureData{SignMode: apisigning.SignMode_SIGN_MODE_DIRECT, Signature: []byte("signature")}
+	nested := &MultiSignatureData{
+		BitArray:   &apimultisig.CompactBitArray{ExtraBitsStored: 1, Elems: []byte{0x80}},
+		Signatures: []SignatureData{single},
+	}
+
+	// signatures are ordered by member
+	got, err := NewMultiSignatureData(pk, []Signature{
+		{PubKey: pubKeys[2], Data: single},
+		{PubKey: pubKeys[1], Data: nested},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &MultiSignatureData{
+		BitArray:   &apimultisig.CompactBitArray{ExtraBitsStored: 3, Elems: []byte{0x60}},
+		Signatures: []SignatureData{nested, single},
+	}, got)
+
+	_, err = NewMultiSignatureData(pk, []Signature{{PubKey: secp256k1.GenPrivKey().PubKey(), Data: single}})
+	require.ErrorContains(t, err, "is not a member of the multisig")
+
+	_, err = NewMultiSignatureData(pk, []Signature{{PubKey: pubKeys[0]}})
+	require.ErrorContains(t, err, "signature with empty public key or data")
+}
@@ -16,6 +16,7 @@ import (
 	"google.golang.org/protobuf/types/dynamicpb"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	"cosmossdk.io/x/tx/canonical"
 	"cosmossdk.io/x/tx/signing/aminojson"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -193,6 +194,19 @@ func (pc *ProtoCodec) MarshalAminoJSON(msg gogoproto.Message) ([]byte, error) {
 	return encoder.Marshal(protoMsg)
 }
 
+// MarshalCanonical marshals a gogoproto message to its canonical binary encoding,
+// which follows the ADR-027 rules regardless of the generated type, see the
+// cosmossdk.io/x/tx/canonical package.
+func (pc *ProtoCodec) MarshalCanonical(o gogoproto.Message) ([]byte, error) {
+	return canonical.MarshalOptions{FileResolver: pc.interfaceRegistry}.MarshalGogo(o)
+}
+
+// MarshalCanonicalJSON marshals a gogoproto message to its canonical JSON encoding,
+// see the cosmossdk.io/x/tx/canonical package.
+func (pc *ProtoCodec) MarshalCanonicalJSON(o gogoproto.Message) ([]byte, error) {
+	return canonical.MarshalOptions{FileResolver: pc.interfaceRegistry}.MarshalGogoJSON(o)
+}
+
 // UnmarshalJSON implements JSONCodec.UnmarshalJSON method,
 // it unmarshals from JSON using proto codec.
 // NOTE: this function must be used with a concrete type which
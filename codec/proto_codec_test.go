@@ -118,6 +118,24 @@ func TestProtoCodecMarshal(t *testing.T) {
 	require.Empty(t, bz)
 }
 
+func TestProtoCodecMarshalCanonical(t *testing.T) {
+	cdc := codec.NewProtoCodec(createTestInterfaceRegistry())
+	msg := &testdata.HasAnimal{
+		X:      1000,
+		Animal: mustAny(&testdata.Cat{Moniker: "Garfield", Lives: 6}),
+	}
+
+	bz, err := cdc.MarshalCanonical(msg)
+	require.NoError(t, err)
+	expected, err := cdc.Marshal(msg)
+	require.NoError(t, err)
+	require.Equal(t, expected, bz)
+
+	bz, err = cdc.MarshalCanonicalJSON(msg)
+	require.NoError(t, err)
+	require.Equal(t, `{"animal":{"@type":"/testpb.Cat","lives":6,"moniker":"Garfield"},"x":"1000"}`, string(bz))
+}
+
 // Emulate grpc server implementation
 // https://github.com/grpc/grpc-go/blob/b1d7f56b81b7902d871111b82dec6ba45f854ede/rpc_util.go#L590
 func grpcServerEncode(c encoding.Codec, msg interface{}) ([]byte, error) {
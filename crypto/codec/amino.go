@@ -23,6 +23,8 @@ func RegisterCrypto(registrar registry.AminoRegistrar) {
 	registrar.RegisterConcrete(&bls12_381.PubKey{}, bls12381.PubKeyName)
 	registrar.RegisterConcrete(&kmultisig.LegacyAminoPubKey{},
 		kmultisig.PubKeyAminoRoute)
+	registrar.RegisterConcrete(&kmultisig.WeightedPubKey{},
+		kmultisig.WeightedPubKeyAminoRoute)
 	registrar.RegisterInterface((*cryptotypes.PrivKey)(nil), nil)
 	registrar.RegisterConcrete(&ed25519.PrivKey{},
 		ed25519.PrivKeyName)
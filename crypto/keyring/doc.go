@@ -39,4 +39,14 @@
 //		be unlocked and it should be used only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //		are discarded when the process terminates or the type instance is garbage collected.
+//	remote	Same instance as returned by NewRemote. The keys are held by a signer reached through
+//		the RemoteSigner gRPC service at the address set with WithRemoteSigner. Keys are
+//		managed on the signer side, this backend only lists them and signs with them. A
+//		signer which is not on a loopback address is only reached over TLS, set with
+//		WithRemoteSignerTLS.
+//
+// # NewRemoteSignerServer
+//
+// The NewRemoteSignerServer constructor returns a reference RemoteSigner service signing with
+// the keys of another keyring, which the remote backend can be tested against.
 package keyring
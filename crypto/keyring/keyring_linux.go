@@ -8,6 +8,7 @@ import (
 	"io"
 
 	"github.com/99designs/keyring"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
@@ -38,6 +39,14 @@ type Options struct {
 	// indicate whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// address of the signer used by the remote backend
+	RemoteSignerAddr string
+	// gRPC dial options of the remote signer connection, replacing the
+	// connection set up from RemoteSignerTLS when not empty
+	RemoteSignerDialOptions []grpc.DialOption
+	// TLS files of the remote signer connection, which is only allowed in
+	// plaintext to a signer on a loopback address when empty
+	RemoteSignerTLS RemoteSignerTLSConfig
 	// KeyctlScope defines the scope of the keyctl's keyring.
 	KeyctlScope string
 }
@@ -60,7 +69,7 @@ func newKeyctlBackendConfig(appName, _ string, _ io.Reader, opts ...Option) keyr
 
 // New creates a new instance of a keyring.
 // Keyring options can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "keyctl".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote", "keyctl".
 func New(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
@@ -6,6 +6,8 @@ package keyring
 import (
 	"io"
 
+	"google.golang.org/grpc"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
@@ -26,6 +28,14 @@ type Options struct {
 	// indicate whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// address of the signer used by the remote backend
+	RemoteSignerAddr string
+	// gRPC dial options of the remote signer connection, replacing the
+	// connection set up from RemoteSignerTLS when not empty
+	RemoteSignerDialOptions []grpc.DialOption
+	// TLS files of the remote signer connection, which is only allowed in
+	// plaintext to a signer on a loopback address when empty
+	RemoteSignerTLS RemoteSignerTLSConfig
 }
 
 func New(
@@ -0,0 +1,370 @@
+package keyring
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/99designs/keyring"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var (
+	_ Keyring = remoteKeystore{}
+
+	// ErrRemoteUnsupported is raised when calling an operation the remote backend does not support.
+	ErrRemoteUnsupported = errors.New("operation not supported by the remote keyring backend, keys are managed by the remote signer")
+	// ErrRemoteSignerAddr is raised when opening the remote backend without a signer address.
+	ErrRemoteSignerAddr = errors.New("remote keyring backend requires a remote signer address")
+	// ErrRemoteSignerInsecure is raised when opening the remote backend on a non-loopback
+	// signer address without transport credentials.
+	ErrRemoteSignerInsecure = errors.New("remote keyring backend requires TLS to reach a signer which is not on a loopback address")
+)
+
+// RemoteSignerTLSConfig holds the TLS files of the connection to the remote signer.
+type RemoteSignerTLSConfig struct {
+	// CAFile is the PEM file of the certificate authorities the signer
+	// certificate is verified with, the system ones are used when empty.
+	CAFile string
+	// CertFile and KeyFile are the PEM files of the client certificate and
+	// key presented to the signer, for mutual TLS.
+	CertFile string
+	KeyFile  string
+}
+
+// IsEmpty returns true if no TLS file is set.
+func (c RemoteSignerTLSConfig) IsEmpty() bool {
+	return c == RemoteSignerTLSConfig{}
+}
+
+// WithRemoteSigner sets the address of the signer used by the remote backend.
+// Dial options of the gRPC connection to it, if given, replace the previous ones.
+func WithRemoteSigner(addr string, dialOpts ...grpc.DialOption) Option {
+	return func(options *Options) {
+		options.RemoteSignerAddr = addr
+		if len(dialOpts) > 0 {
+			options.RemoteSignerDialOptions = dialOpts
+		}
+	}
+}
+
+// WithRemoteSignerTLS sets the TLS files of the connection to the signer used
+// by the remote backend. They are ignored when dial options are given.
+func WithRemoteSignerTLS(config RemoteSignerTLSConfig) Option {
+	return func(options *Options) {
+		options.RemoteSignerTLS = config
+	}
+}
+
+// remoteKeystore is a Keyring whose keys are held by a signer reached through
+// the RemoteSigner gRPC service. Keys are created, imported and deleted on the
+// signer side, so the keystore only lists keys and signs with them.
+type remoteKeystore struct {
+	client   RemoteSignerClient
+	cdc      codec.Codec
+	options  Options
+	callOpts []grpc.CallOption
+}
+
+// NewRemote creates a keyring backed by the RemoteSigner service served over
+// the given connection.
+func NewRemote(conn gogogrpc.ClientConn, cdc codec.Codec, opts ...Option) Keyring {
+	options := Options{
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
+	}
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	var callOpts []grpc.CallOption
+	if pc, ok := cdc.(codec.GRPCCodecProvider); ok {
+		callOpts = append(callOpts, grpc.ForceCodec(pc.GRPCCodec()))
+	}
+
+	return remoteKeystore{
+		client:   NewRemoteSignerClient(conn),
+		cdc:      cdc,
+		options:  options,
+		callOpts: callOpts,
+	}
+}
+
+// newRemoteFromOptions connects to the signer set with WithRemoteSigner.
+func newRemoteFromOptions(cdc codec.Codec, opts ...Option) (Keyring, error) {
+	var options Options
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	if options.RemoteSignerAddr == "" {
+		return nil, ErrRemoteSignerAddr
+	}
+
+	dialOpts := options.RemoteSignerDialOptions
+	if len(dialOpts) == 0 {
+		creds, err := remoteSignerCredentials(options.RemoteSignerAddr, options.RemoteSignerTLS)
+		if err != nil {
+			return nil, err
+		}
+		dialOpts = []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	}
+
+	conn, err := grpc.NewClient(options.RemoteSignerAddr, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't connect to remote signer %s: %w", options.RemoteSignerAddr, err)
+	}
+
+	return NewRemote(conn, cdc, opts...), nil
+}
+
+// remoteSignerCredentials returns the TLS credentials of the connection to the
+// signer. Without TLS files, the connection is only allowed in plaintext to a
+// signer on a loopback address or a unix socket.
+func remoteSignerCredentials(addr string, config RemoteSignerTLSConfig) (credentials.TransportCredentials, error) {
+	if config.IsEmpty() {
+		if !isLoopbackTarget(addr) {
+			return nil, fmt.Errorf("%w: %s", ErrRemoteSignerInsecure, addr)
+		}
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if config.CAFile != "" {
+		pem, err := os.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read remote signer CA file: %w", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in remote signer CA file %s", config.CAFile)
+		}
+	}
+	if config.CertFile != "" || config.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't load remote signer client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// isLoopbackTarget returns true if the gRPC target is a unix socket or an
+// address on the loopback interface.
+func isLoopbackTarget(target string) bool {
+	if strings.HasPrefix(target, "unix:") || strings.HasPrefix(target, "unix-abstract:") {
+		return true
+	}
+	if i := strings.Index(target, ":///"); i >= 0 {
+		target = target[i+len(":///"):]
+	}
+
+	host, _, err := net.SplitHostPort(target)
+	if err != nil {
+		host = target
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// Backend returns the keyring backend option used in the config
+func (ks remoteKeystore) Backend() string {
+	return BackendRemote
+}
+
+// DB returns nil as the keys are not stored locally.
+func (ks remoteKeystore) DB() keyring.Keyring {
+	return nil
+}
+
+func (ks remoteKeystore) List() ([]*Record, error) {
+	res, err := ks.client.ListKeys(context.Background(), &ListKeysRequest{}, ks.callOpts...)
+	if err != nil {
+		return nil, wrapRemoteError(err)
+	}
+
+	for _, k := range res.Records {
+		if err := ks.checkRecord(k); err != nil {
+			return nil, err
+		}
+	}
+
+	return res.Records, nil
+}
+
+func (ks remoteKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
+}
+
+func (ks remoteKeystore) Key(uid string) (*Record, error) {
+	return ks.pubKey(&PubKeyRequest{Name: uid})
+}
+
+func (ks remoteKeystore) KeyByAddress(address []byte) (*Record, error) {
+	return ks.pubKey(&PubKeyRequest{Address: address})
+}
+
+func (ks remoteKeystore) pubKey(req *PubKeyRequest) (*Record, error) {
+	res, err := ks.client.PubKey(context.Background(), req, ks.callOpts...)
+	if err != nil {
+		return nil, wrapRemoteError(err)
+	}
+
+	if err := ks.checkRecord(res.Record); err != nil {
+		return nil, err
+	}
+
+	return res.Record, nil
+}
+
+// checkRecord ensures the signer only returns the public part of its keys.
+func (ks remoteKeystore) checkRecord(k *Record) error {
+	if k == nil || k.GetRemote() == nil {
+		return errors.New("remote signer returned a non remote key record")
+	}
+
+	return k.UnpackInterfaces(ks.cdc.InterfaceRegistry())
+}
+
+func (ks remoteKeystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	return ks.sign(&SignRequest{Name: uid, Msg: msg, SignMode: signMode})
+}
+
+func (ks remoteKeystore) SignByAddress(address, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	return ks.sign(&SignRequest{Address: address, Msg: msg, SignMode: signMode})
+}
+
+func (ks remoteKeystore) sign(req *SignRequest) ([]byte, types.PubKey, error) {
+	res, err := ks.client.Sign(context.Background(), req, ks.callOpts...)
+	if err != nil {
+		return nil, nil, wrapRemoteError(err)
+	}
+
+	var pub types.PubKey
+	if err := ks.cdc.InterfaceRegistry().UnpackAny(res.PubKey, &pub); err != nil {
+		return nil, nil, err
+	}
+
+	return res.Signature, pub, nil
+}
+
+func (ks remoteKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return ks.exportPubKeyArmor(k)
+}
+
+func (ks remoteKeystore) ExportPubKeyArmorByAddress(address []byte) (string, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return ks.exportPubKeyArmor(k)
+}
+
+func (ks remoteKeystore) exportPubKeyArmor(k *Record) (string, error) {
+	key, err := k.GetPubKey()
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := ks.cdc.MarshalInterface(key)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(bz, key.Type()), nil
+}
+
+func (ks remoteKeystore) MigrateAll() ([]*Record, error) {
+	return ks.List()
+}
+
+func (ks remoteKeystore) Delete(string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) DeleteByAddress([]byte) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) Rename(string, string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, string, SignatureAlgo) (*Record, string, error) {
+	return nil, "", ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) SaveOfflineKey(string, types.PubKey) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) SaveMultisig(string, types.PubKey) (*Record, error) {
+	return nil, ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ImportPrivKeyHex(string, string, string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ImportPubKey(string, string) error {
+	return ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", ErrRemoteUnsupported
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmorByAddress([]byte, string) (string, error) {
+	return "", ErrRemoteUnsupported
+}
+
+// wrapRemoteError converts the key not found errors of the signer so callers
+// can match them as for the other backends.
+func wrapRemoteError(err error) error {
+	if status.Code(err) == codes.NotFound {
+		return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, status.Convert(err).Message())
+	}
+	return err
+}
@@ -0,0 +1,185 @@
+package keyring
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// newTestRemote serves a reference remote signer backed by signerKr in memory
+// and returns a remote keyring connected to it.
+func newTestRemote(t *testing.T, signerKr Keyring, signModes ...signing.SignMode) Keyring {
+	t.Helper()
+
+	cdc := getCodec()
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer(grpc.ForceServerCodec(cdc.(codec.GRPCCodecProvider).GRPCCodec()))
+	RegisterRemoteSignerServer(srv, NewRemoteSignerServer(signerKr, signModes...))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return NewRemote(conn, cdc)
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	signerKr := NewInMemory(getCodec())
+	local, _, err := signerKr.NewMnemonic("local", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = signerKr.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	kr := newTestRemote(t, signerKr, signing.SignMode_SIGN_MODE_DIRECT)
+	require.Equal(t, BackendRemote, kr.Backend())
+
+	// only the keys which can sign are exposed
+	records, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "local", records[0].Name)
+	require.Equal(t, TypeRemote, records[0].GetType())
+	require.Equal(t, []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT}, records[0].GetRemote().SignModes)
+
+	localPub, err := local.GetPubKey()
+	require.NoError(t, err)
+	pub, err := records[0].GetPubKey()
+	require.NoError(t, err)
+	require.True(t, localPub.Equals(pub))
+
+	addr, err := local.GetAddress()
+	require.NoError(t, err)
+	k, err := kr.KeyByAddress(addr)
+	require.NoError(t, err)
+	require.Equal(t, "local", k.Name)
+
+	_, err = kr.Key("offline")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = kr.Key("unknown")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// sign with the accepted sign mode only
+	msg := []byte("message")
+	sig, pub, err := kr.Sign("local", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, localPub.Equals(pub))
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	sig, _, err = kr.SignByAddress(addr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, localPub.VerifySignature(msg, sig))
+
+	_, _, err = kr.Sign("local", msg, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	require.ErrorContains(t, err, "sign mode SIGN_MODE_LEGACY_AMINO_JSON is not accepted")
+
+	// armor headers are not ordered, compare the unarmored keys
+	armor, err := kr.ExportPubKeyArmor("local")
+	require.NoError(t, err)
+	bz, algo, err := crypto.UnarmorPubKeyBytes(armor)
+	require.NoError(t, err)
+	expected, err := signerKr.ExportPubKeyArmor("local")
+	require.NoError(t, err)
+	expectedBz, expectedAlgo, err := crypto.UnarmorPubKeyBytes(expected)
+	require.NoError(t, err)
+	require.Equal(t, expectedBz, bz)
+	require.Equal(t, expectedAlgo, algo)
+
+	// keys are managed on the signer side
+	require.ErrorIs(t, kr.Delete("local"), ErrRemoteUnsupported)
+	_, err = kr.ExportPrivKeyArmor("local", "passphrase")
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+}
+
+func TestNewRemoteBackend(t *testing.T) {
+	_, err := New("keybasename", BackendRemote, t.TempDir(), nil, getCodec())
+	require.ErrorIs(t, err, ErrRemoteSignerAddr)
+
+	kr, err := New("keybasename", BackendRemote, t.TempDir(), nil, getCodec(), WithRemoteSigner("localhost:9191"))
+	require.NoError(t, err)
+	require.Equal(t, BackendRemote, kr.Backend())
+
+	// a signer which is not on a loopback address requires TLS
+	_, err = New("keybasename", BackendRemote, t.TempDir(), nil, getCodec(), WithRemoteSigner("signer.example.com:9191"))
+	require.ErrorIs(t, err, ErrRemoteSignerInsecure)
+
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	writeTestCertificate(t, certFile, keyFile)
+	tlsConfig := RemoteSignerTLSConfig{CAFile: certFile, CertFile: certFile, KeyFile: keyFile}
+	_, err = New("keybasename", BackendRemote, t.TempDir(), nil, getCodec(), WithRemoteSigner("signer.example.com:9191"), WithRemoteSignerTLS(tlsConfig))
+	require.NoError(t, err)
+
+	_, err = New("keybasename", BackendRemote, t.TempDir(), nil, getCodec(), WithRemoteSigner("signer.example.com:9191"), WithRemoteSignerTLS(RemoteSignerTLSConfig{CAFile: keyFile}))
+	require.ErrorContains(t, err, "no certificate found")
+	_, err = New("keybasename", BackendRemote, t.TempDir(), nil, getCodec(), WithRemoteSigner("signer.example.com:9191"), WithRemoteSignerTLS(RemoteSignerTLSConfig{CertFile: certFile}))
+	require.ErrorContains(t, err, "client certificate")
+}
+
+func TestIsLoopbackTarget(t *testing.T) {
+	for target, expected := range map[string]bool{
+		"localhost:9191":            true,
+		"127.0.0.1:9191":            true,
+		"[::1]:9191":                true,
+		"dns:///localhost:9191":     true,
+		"unix:///var/run/signer":    true,
+		"10.0.0.1:9191":             false,
+		"signer.example.com:9191":   false,
+		"dns:///signer.example.com": false,
+	} {
+		require.Equal(t, expected, isLoopbackTarget(target), target)
+	}
+}
+
+// writeTestCertificate writes a self-signed certificate and its key in PEM.
+func writeTestCertificate(t *testing.T, certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "signer.example.com"},
+		DNSNames:              []string{"signer.example.com"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+}
@@ -0,0 +1,173 @@
+package keyring
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ RemoteSignerServer = remoteSignerServer{}
+
+// ledgerSignModes are the sign modes a Ledger device can sign with.
+var ledgerSignModes = []signing.SignMode{signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signing.SignMode_SIGN_MODE_TEXTUAL}
+
+// DefaultRemoteSignerSignModes are the sign modes accepted by the reference
+// remote signer when none are given.
+var DefaultRemoteSignerSignModes = []signing.SignMode{
+	signing.SignMode_SIGN_MODE_DIRECT,
+	signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	signing.SignMode_SIGN_MODE_TEXTUAL,
+}
+
+// remoteSignerServer is a reference RemoteSigner server signing with the keys
+// of a keyring. Offline and multisig keys are not exposed as they cannot sign.
+type remoteSignerServer struct {
+	kr        Keyring
+	signModes []signing.SignMode
+}
+
+// NewRemoteSignerServer returns a RemoteSigner server backed by the given
+// keyring, accepting the given sign modes or DefaultRemoteSignerSignModes.
+func NewRemoteSignerServer(kr Keyring, signModes ...signing.SignMode) RemoteSignerServer {
+	if len(signModes) == 0 {
+		signModes = DefaultRemoteSignerSignModes
+	}
+
+	return remoteSignerServer{kr: kr, signModes: signModes}
+}
+
+func (s remoteSignerServer) ListKeys(_ context.Context, _ *ListKeysRequest) (*ListKeysResponse, error) {
+	records, err := s.kr.List()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &ListKeysResponse{}
+	for _, k := range records {
+		remote, err := s.remoteRecord(k)
+		if err != nil {
+			return nil, err
+		}
+		if remote != nil {
+			res.Records = append(res.Records, remote)
+		}
+	}
+
+	return res, nil
+}
+
+func (s remoteSignerServer) PubKey(_ context.Context, req *PubKeyRequest) (*PubKeyResponse, error) {
+	k, err := s.key(req.Name, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	remote, err := s.remoteRecord(k)
+	if err != nil {
+		return nil, err
+	}
+	if remote == nil {
+		return nil, status.Errorf(codes.NotFound, "key %s cannot sign", k.Name)
+	}
+
+	return &PubKeyResponse{Record: remote}, nil
+}
+
+func (s remoteSignerServer) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	k, err := s.key(req.Name, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(s.keySignModes(k), req.SignMode) {
+		return nil, status.Errorf(codes.InvalidArgument, "sign mode %s is not accepted for key %s", req.SignMode, k.Name)
+	}
+
+	sig, pub, err := s.kr.Sign(k.Name, req.Msg, req.SignMode)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pubAny, err := codectypes.NewAnyWithValue(pub)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SignResponse{Signature: sig, PubKey: pubAny}, nil
+}
+
+// key looks a key up by name or by address, exactly one of which must be set.
+func (s remoteSignerServer) key(name string, address []byte) (*Record, error) {
+	var (
+		k   *Record
+		err error
+	)
+	switch {
+	case name != "" && len(address) == 0:
+		k, err = s.kr.Key(name)
+	case name == "" && len(address) != 0:
+		k, err = s.kr.KeyByAddress(address)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "exactly one of name and address must be set")
+	}
+
+	if err != nil {
+		if errors.Is(err, sdkerrors.ErrKeyNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return k, nil
+}
+
+// remoteRecord returns the public part of a key as a remote record, or nil if
+// the key cannot sign.
+func (s remoteSignerServer) remoteRecord(k *Record) (*Record, error) {
+	signModes := s.keySignModes(k)
+	if len(signModes) == 0 {
+		return nil, nil
+	}
+
+	pub, err := k.GetPubKey()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	remote, err := NewRemoteRecord(k.Name, pub, signModes)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return remote, nil
+}
+
+// keySignModes returns the sign modes accepted for a key.
+func (s remoteSignerServer) keySignModes(k *Record) []signing.SignMode {
+	var supported []signing.SignMode
+	switch k.GetType() {
+	case TypeLocal:
+		return s.signModes
+	case TypeLedger:
+		supported = ledgerSignModes
+	case TypeRemote:
+		supported = k.GetRemote().SignModes
+	default:
+		return nil
+	}
+
+	var signModes []signing.SignMode
+	for _, signMode := range s.signModes {
+		if slices.Contains(supported, signMode) {
+			signModes = append(signModes, signMode)
+		}
+	}
+	return signModes
+}
@@ -0,0 +1,22 @@
+//go:build !bls12381
+
+package bls12_381
+
+import (
+	bls "github.com/cometbft/cometbft/crypto/bls12381"
+)
+
+// AggregateSignatures aggregates signatures of bls12_381 keys into a single
+// signature. If all the keys signed the same message, the aggregate signature
+// verifies with VerifyAggregateSignature.
+func AggregateSignatures([][]byte) ([]byte, error) {
+	return nil, bls.ErrDisabled
+}
+
+// VerifyAggregateSignature verifies an aggregate signature of msg by all the
+// given keys.
+//
+// Without the bls12381 build flag, no signature is valid.
+func VerifyAggregateSignature([]*PubKey, []byte, []byte) bool {
+	return false
+}
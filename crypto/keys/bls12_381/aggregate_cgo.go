@@ -0,0 +1,57 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381
+
+import (
+	"errors"
+
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// dst is the domain separation tag the cometbft bls12381 keys sign with.
+var dst = []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_")
+
+// AggregateSignatures aggregates signatures of bls12_381 keys into a single
+// signature. If all the keys signed the same message, the aggregate signature
+// verifies with VerifyAggregateSignature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+
+	agg := new(blst.P2Aggregate)
+	if !agg.AggregateCompressed(sigs, true) {
+		return nil, errors.New("invalid signature")
+	}
+
+	return agg.ToAffine().Compress(), nil
+}
+
+// VerifyAggregateSignature verifies an aggregate signature of msg by all the
+// given keys.
+//
+// Signers must have proven the possession of their keys beforehand, for
+// instance by an individual signature, as aggregating keys over a common
+// message is otherwise subject to rogue key attacks.
+func VerifyAggregateSignature(pubKeys []*PubKey, msg, sig []byte) bool {
+	if len(pubKeys) == 0 || len(sig) != bls12381.SignatureLength {
+		return false
+	}
+
+	pks := make([]*blst.P1Affine, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		pk := new(blst.P1Affine).Deserialize(pubKey.Key)
+		if pk == nil || !pk.KeyValidate() {
+			return false
+		}
+		pks[i] = pk
+	}
+
+	signature := new(blst.P2Affine).Uncompress(sig)
+	if signature == nil {
+		return false
+	}
+
+	return signature.FastAggregateVerify(true, pks, msg, dst)
+}
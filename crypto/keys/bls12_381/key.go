@@ -9,6 +9,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 	bls "github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -101,11 +102,16 @@ func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
 
 var _ cryptotypes.PubKey = &PubKey{}
 
-// Address returns the address of the key.
+// Address returns the address of the key, used both as consensus address and
+// as account address. It only hashes the key bytes, so that addresses are
+// derived without the bls12381 build flag too.
 //
-// The function will panic if the public key is invalid.
+// The function will panic if the public key has an incorrect size.
 func (pubKey PubKey) Address() crypto.Address {
-	panic("not implemented, build flags are required to use bls12_381 keys")
+	if len(pubKey.Key) != bls.PubKeySize {
+		panic("pubkey is incorrect size")
+	}
+	return crypto.Address(tmhash.SumTruncated(pubKey.Key))
 }
 
 // VerifySignature verifies the given signature.
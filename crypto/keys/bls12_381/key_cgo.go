@@ -123,12 +123,13 @@ func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
 
 var _ cryptotypes.PubKey = &PubKey{}
 
-// Address returns the address of the key.
+// Address returns the address of the key, used both as consensus address and
+// as account address. It only hashes the key bytes, so that addresses are
+// derived without the bls12381 build flag too.
 //
-// The function will panic if the public key is invalid.
+// The function will panic if the public key has an incorrect size.
 func (pubKey PubKey) Address() crypto.Address {
-	pk, _ := bls12381.NewPublicKeyFromBytes(pubKey.Key)
-	if len(pk.Bytes()) != bls12381.PubKeySize {
+	if len(pubKey.Key) != bls12381.PubKeySize {
 		panic("pubkey is incorrect size")
 	}
 	return crypto.Address(tmhash.SumTruncated(pubKey.Key))
@@ -15,6 +15,8 @@ import (
 const (
 	// PubKeyAminoRoute defines the amino route for a multisig threshold public key
 	PubKeyAminoRoute = "tendermint/PubKeyMultisigThreshold"
+	// WeightedPubKeyAminoRoute defines the amino route for a weighted multisig public key
+	WeightedPubKeyAminoRoute = "cosmos-sdk/PubKeyMultisigWeighted"
 )
 
 // AminoCdc is being deprecated in the SDK. But even if you need to
@@ -31,4 +33,6 @@ func init() {
 		bls12381.PubKeyName)
 	AminoCdc.RegisterConcrete(&LegacyAminoPubKey{},
 		PubKeyAminoRoute)
+	AminoCdc.RegisterConcrete(&WeightedPubKey{},
+		WeightedPubKeyAminoRoute)
 }
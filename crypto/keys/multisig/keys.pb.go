@@ -66,34 +66,130 @@ func (m *LegacyAminoPubKey) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LegacyAminoPubKey proto.InternalMessageInfo
 
+// WeightedPubKey specifies a public key type which nests multiple weighted
+// public keys and a threshold on their total weight. Members can themselves be
+// multisig public keys.
+//
+// Since: cosmos-sdk 0.52
+type WeightedPubKey struct {
+	// threshold is the total weight of the members whose signatures are required.
+	Threshold uint64 `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// members are the weighted public keys of the multisig, in signing order.
+	Members []*WeightedMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (m *WeightedPubKey) Reset()         { *m = WeightedPubKey{} }
+func (m *WeightedPubKey) String() string { return proto.CompactTextString(m) }
+func (*WeightedPubKey) ProtoMessage()    {}
+func (*WeightedPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b57537e097d47d, []int{1}
+}
+func (m *WeightedPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedPubKey.Merge(m, src)
+}
+func (m *WeightedPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedPubKey proto.InternalMessageInfo
+
+// WeightedMember is a weighted member of a WeightedPubKey.
+//
+// Since: cosmos-sdk 0.52
+type WeightedMember struct {
+	PubKey *any.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// weight is the weight counted towards the threshold by a signature of the
+	// member, it must be positive.
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *WeightedMember) Reset()         { *m = WeightedMember{} }
+func (m *WeightedMember) String() string { return proto.CompactTextString(m) }
+func (*WeightedMember) ProtoMessage()    {}
+func (*WeightedMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b57537e097d47d, []int{2}
+}
+func (m *WeightedMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedMember.Merge(m, src)
+}
+func (m *WeightedMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedMember proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*LegacyAminoPubKey)(nil), "cosmos.crypto.multisig.LegacyAminoPubKey")
+	proto.RegisterType((*WeightedPubKey)(nil), "cosmos.crypto.multisig.WeightedPubKey")
+	proto.RegisterType((*WeightedMember)(nil), "cosmos.crypto.multisig.WeightedMember")
 }
 
 func init() { proto.RegisterFile("cosmos/crypto/multisig/keys.proto", fileDescriptor_46b57537e097d47d) }
 
 var fileDescriptor_46b57537e097d47d = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xaa, 0x2c, 0x28, 0xc9, 0xd7, 0xcf, 0x2d, 0xcd, 0x29, 0xc9, 0x2c,
-	0xce, 0x4c, 0xd7, 0xcf, 0x4e, 0xad, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83,
-	0x28, 0xd1, 0x83, 0x28, 0xd1, 0x83, 0x29, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1,
-	0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x24, 0xd3, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4,
-	0xd2, 0x34, 0xfd, 0xc4, 0xbc, 0x4a, 0xa8, 0x94, 0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98,
-	0x84, 0x08, 0x29, 0x1d, 0x66, 0xe4, 0x12, 0xf4, 0x49, 0x4d, 0x4f, 0x4c, 0xae, 0x74, 0x04, 0x89,
-	0x06, 0x94, 0x26, 0x79, 0xa7, 0x56, 0x0a, 0xc9, 0x70, 0x71, 0x96, 0x64, 0x14, 0xa5, 0x16, 0x67,
-	0xe4, 0xe7, 0xa4, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x06, 0x21, 0x04, 0x84, 0xfc, 0xb8, 0xb8,
-	0x0b, 0x4a, 0x93, 0x72, 0x32, 0x93, 0xe3, 0x41, 0x8e, 0x94, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36,
-	0x12, 0xd1, 0x83, 0xd8, 0xab, 0x07, 0xb3, 0x57, 0xcf, 0x31, 0xaf, 0xd2, 0x49, 0xfc, 0xd1, 0x3d,
-	0x79, 0x76, 0x88, 0xa1, 0xc5, 0x8b, 0x9e, 0x6f, 0xd0, 0x62, 0x2f, 0x28, 0x4d, 0x02, 0x69, 0x0a,
-	0xe2, 0x82, 0x98, 0x00, 0x12, 0xb7, 0x72, 0xe8, 0x58, 0x20, 0xcf, 0xd0, 0xf5, 0x7c, 0x83, 0x96,
-	0x52, 0x49, 0x6a, 0x5e, 0x4a, 0x6a, 0x51, 0x6e, 0x66, 0x5e, 0x89, 0x3e, 0x44, 0x93, 0x2f, 0xd4,
-	0xaf, 0x21, 0x30, 0xcb, 0x27, 0x3d, 0xdf, 0xa0, 0x25, 0x00, 0x77, 0x4a, 0x7c, 0x71, 0x49, 0x51,
-	0x66, 0x5e, 0xba, 0x93, 0xf7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24,
-	0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19,
-	0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xc3, 0x42, 0x1a, 0x4c, 0xe9,
-	0x16, 0xa7, 0x64, 0xc3, 0x02, 0x1d, 0xe4, 0x22, 0x78, 0xc8, 0x27, 0xb1, 0x81, 0x7d, 0x60, 0x0c,
-	0x08, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x78, 0xf0, 0xbc, 0x9a, 0x01, 0x00, 0x00,
+	// 412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x93, 0x75, 0x69, 0x71, 0x16, 0x17, 0x37, 0x2c, 0x6b, 0x5d, 0x24, 0x5b, 0x73, 0x90,
+	0x12, 0xe8, 0x0c, 0xea, 0xad, 0xa7, 0xb6, 0xd7, 0x5a, 0x91, 0x20, 0x08, 0x82, 0x94, 0x26, 0x19,
+	0x27, 0x43, 0x93, 0x4c, 0xc8, 0x4c, 0x90, 0x7c, 0x03, 0xf1, 0x24, 0x9e, 0x3d, 0x88, 0x9f, 0xa0,
+	0xdf, 0xc1, 0x8b, 0xc7, 0x1e, 0x3d, 0x89, 0xa4, 0x87, 0x7e, 0x0d, 0x99, 0x99, 0x4c, 0xab, 0x62,
+	0x2f, 0x21, 0xf3, 0xe6, 0xff, 0xde, 0xfb, 0xfd, 0xdf, 0x3c, 0xf0, 0x30, 0x62, 0x3c, 0x63, 0x1c,
+	0x45, 0x65, 0x5d, 0x08, 0x86, 0xb2, 0x2a, 0x15, 0x94, 0x53, 0x82, 0x56, 0xb8, 0xe6, 0xb0, 0x28,
+	0x99, 0x60, 0xce, 0x95, 0x96, 0x40, 0x2d, 0x81, 0x46, 0x72, 0x7d, 0x49, 0x18, 0x61, 0x4a, 0x82,
+	0xe4, 0x9f, 0x56, 0x5f, 0xdf, 0x27, 0x8c, 0x91, 0x14, 0x23, 0x75, 0x0a, 0xab, 0xb7, 0x68, 0x99,
+	0xd7, 0xed, 0xd5, 0xc5, 0x32, 0xa3, 0x39, 0x43, 0xea, 0xab, 0x43, 0xde, 0x37, 0x1b, 0x5c, 0x3c,
+	0xc3, 0x64, 0x19, 0xd5, 0x13, 0x19, 0x7d, 0x51, 0x85, 0x33, 0x5c, 0x3b, 0x0f, 0xc0, 0x6d, 0x91,
+	0x94, 0x98, 0x27, 0x2c, 0x8d, 0x7b, 0x76, 0xdf, 0x1e, 0xdc, 0x09, 0x0e, 0x01, 0xe7, 0x39, 0x38,
+	0x2b, 0xaa, 0x30, 0xa5, 0xd1, 0x42, 0x42, 0xf6, 0x4e, 0xfa, 0xb7, 0x06, 0x67, 0x4f, 0x2e, 0xa1,
+	0xee, 0x0b, 0x4d, 0x5f, 0x38, 0xc9, 0xeb, 0xe9, 0xbd, 0xe6, 0xe7, 0x4d, 0x57, 0x17, 0xe5, 0x5f,
+	0x77, 0x6b, 0xbf, 0x5b, 0x54, 0xa1, 0x4c, 0x0a, 0x80, 0xae, 0x20, 0xe3, 0xa3, 0xf1, 0xfb, 0x2f,
+	0x37, 0xd6, 0x87, 0xdd, 0xda, 0xf7, 0x04, 0xce, 0x63, 0x5c, 0x66, 0x34, 0x17, 0x48, 0x27, 0xcd,
+	0x5b, 0xaf, 0x2f, 0x4d, 0xf3, 0x4f, 0xbb, 0xb5, 0x7f, 0x77, 0x8f, 0xb2, 0xe0, 0xa2, 0xa4, 0x39,
+	0xf1, 0x3e, 0xdb, 0xe0, 0xfc, 0x15, 0xa6, 0x24, 0x11, 0x38, 0x3e, 0x66, 0xe1, 0xf4, 0x4f, 0x0b,
+	0x63, 0xd0, 0xcd, 0x70, 0x16, 0xe2, 0xd2, 0xe0, 0x3f, 0x82, 0xff, 0x1f, 0x32, 0x34, 0x65, 0xe7,
+	0x4a, 0x1e, 0x98, 0xb4, 0x91, 0x6f, 0xa0, 0xdb, 0x07, 0x1c, 0xf2, 0x78, 0xf5, 0x0f, 0xb4, 0x49,
+	0xf5, 0xde, 0x80, 0xf3, 0xbf, 0xcb, 0x38, 0x43, 0x20, 0x27, 0x21, 0xe7, 0xa7, 0xd8, 0x8e, 0x8c,
+	0x2f, 0xe8, 0x14, 0xda, 0xcc, 0x15, 0xe8, 0xbc, 0x53, 0x05, 0x7a, 0x27, 0xca, 0x49, 0x7b, 0x1a,
+	0x9d, 0x4a, 0x88, 0xe9, 0xec, 0x7b, 0xe3, 0xda, 0x9b, 0xc6, 0xb5, 0x7f, 0x35, 0xae, 0xfd, 0x71,
+	0xeb, 0x5a, 0x9b, 0xad, 0x6b, 0xfd, 0xd8, 0xba, 0xd6, 0xeb, 0xc7, 0x84, 0x8a, 0xa4, 0x0a, 0x61,
+	0xc4, 0x32, 0x64, 0xf6, 0xec, 0x40, 0xdb, 0xae, 0x9c, 0x7c, 0x8f, 0xfd, 0xde, 0x85, 0x1d, 0x05,
+	0xf0, 0xf4, 0xf7, 0x00, 0x77, 0x46, 0xdc, 0x8f, 0x98, 0x02, 0x00, 0x00,
 }
 
 func (m *LegacyAminoPubKey) Marshal() (dAtA []byte, err error) {
@@ -138,6 +234,88 @@ func (m *LegacyAminoPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WeightedPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeys(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintKeys(dAtA []byte, offset int, v uint64) int {
 	offset -= sovKeys(v)
 	base := offset
@@ -167,6 +345,40 @@ func (m *LegacyAminoPubKey) Size() (n int) {
 	return n
 }
 
+func (m *WeightedPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovKeys(uint64(m.Threshold))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovKeys(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WeightedMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovKeys(uint64(m.Weight))
+	}
+	return n
+}
+
 func sovKeys(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -276,6 +488,214 @@ func (m *LegacyAminoPubKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WeightedPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &WeightedMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &any.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipKeys(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
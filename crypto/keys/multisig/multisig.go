@@ -122,7 +122,7 @@ func (m *LegacyAminoPubKey) GetPubKeys() []cryptotypes.PubKey {
 // all constituent keys are the same, and in the same order.
 func (m *LegacyAminoPubKey) Equals(key cryptotypes.PubKey) bool {
 	otherKey, ok := key.(multisigtypes.PubKey)
-	if !ok {
+	if !ok || otherKey.Type() != m.Type() {
 		return false
 	}
 	pubKeys := m.GetPubKeys()
@@ -0,0 +1,228 @@
+package multisig
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/cosmos/gogoproto/proto"
+	gogoprotoany "github.com/cosmos/gogoproto/types/any"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var (
+	_ multisigtypes.PubKey                 = &WeightedPubKey{}
+	_ gogoprotoany.UnpackInterfacesMessage = &WeightedPubKey{}
+)
+
+// NewWeightedPubKey returns a new WeightedPubKey whose members are the given
+// public keys with their respective weights. Members can themselves be multisig
+// public keys.
+// Panics if len(pubKeys) != len(weights), if any weight is 0, if threshold is 0
+// or if threshold is greater than the total weight.
+func NewWeightedPubKey(threshold uint64, pubKeys []cryptotypes.PubKey, weights []uint64) *WeightedPubKey {
+	if len(pubKeys) != len(weights) {
+		panic("weighted multisignature: len(pubKeys) != len(weights)")
+	}
+	anyPubKeys, err := packPubKeys(pubKeys)
+	if err != nil {
+		panic(err)
+	}
+	members := make([]*WeightedMember, len(pubKeys))
+	for i, anyPubKey := range anyPubKeys {
+		members[i] = &WeightedMember{PubKey: anyPubKey, Weight: weights[i]}
+	}
+
+	m := &WeightedPubKey{Threshold: threshold, Members: members}
+	if err := m.validate(); err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// validate checks that the threshold can be reached by the members.
+func (m *WeightedPubKey) validate() error {
+	if m.Threshold == 0 {
+		return errors.New("weighted multisignature: threshold == 0")
+	}
+	var totalWeight uint64
+	for i, member := range m.Members {
+		if member == nil || member.PubKey == nil {
+			return fmt.Errorf("weighted multisignature: nil member at index %d", i)
+		}
+		if member.Weight == 0 {
+			return fmt.Errorf("weighted multisignature: weight of member %d == 0", i)
+		}
+		if totalWeight > math.MaxUint64-member.Weight {
+			return errors.New("weighted multisignature: total weight overflows")
+		}
+		totalWeight += member.Weight
+	}
+	if totalWeight < m.Threshold {
+		return fmt.Errorf("weighted multisignature: total weight %d < threshold %d", totalWeight, m.Threshold)
+	}
+	return nil
+}
+
+// Address implements cryptotypes.PubKey Address method
+func (m *WeightedPubKey) Address() cryptotypes.Address {
+	return address.Hash(proto.MessageName(m), m.Bytes())
+}
+
+// Bytes returns the proto encoded version of the WeightedPubKey
+func (m *WeightedPubKey) Bytes() []byte {
+	bz, err := m.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// VerifyMultisignature implements the multisigtypes.PubKey VerifyMultisignature method.
+// The bit array of the signature has one bit per member, and the signatures must be
+// added in the members order. The signature is valid if the total weight of the
+// members having a valid signature reaches the threshold. Signatures of nested
+// multisig members are verified recursively.
+func (m *WeightedPubKey) VerifyMultisignature(getSignBytes multisigtypes.GetSignBytesFunc, sig *signing.MultiSignatureData) error {
+	if err := m.validate(); err != nil {
+		return err
+	}
+
+	bitarray := sig.BitArray
+	sigs := sig.Signatures
+	size := bitarray.Count()
+	pubKeys := m.GetPubKeys()
+	// ensure bit array is the correct size
+	if len(pubKeys) != size {
+		return fmt.Errorf("bit array size is incorrect, expecting: %d", len(pubKeys))
+	}
+	// ensure there is one signature per set bit
+	if len(sigs) != bitarray.NumTrueBitsBefore(size) {
+		return fmt.Errorf("signature size is incorrect %d", len(sigs))
+	}
+	// ensure the set signatures weigh at least the threshold
+	var weight uint64
+	for i := 0; i < size; i++ {
+		if bitarray.GetIndex(i) {
+			// cannot overflow, the total weight was validated
+			weight += m.Members[i].Weight
+		}
+	}
+	if weight < m.Threshold {
+		return fmt.Errorf("not enough signatures set, have weight %d, expected %d", weight, m.Threshold)
+	}
+
+	// index in the list of signatures which we are concerned with.
+	sigIndex := 0
+	for i := 0; i < size; i++ {
+		if !bitarray.GetIndex(i) {
+			continue
+		}
+		switch si := sigs[sigIndex].(type) {
+		case *signing.SingleSignatureData:
+			msg, err := getSignBytes(si.SignMode)
+			if err != nil {
+				return err
+			}
+			if !pubKeys[i].VerifySignature(msg, si.Signature) {
+				return fmt.Errorf("unable to verify signature at index %d", i)
+			}
+		case *signing.MultiSignatureData:
+			nestedMultisigPk, ok := pubKeys[i].(multisigtypes.PubKey)
+			if !ok {
+				return fmt.Errorf("unable to parse pubkey of index %d", i)
+			}
+			if err := nestedMultisigPk.VerifyMultisignature(getSignBytes, si); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("improper signature data type for index %d", sigIndex)
+		}
+		sigIndex++
+	}
+	return nil
+}
+
+// VerifySignature implements cryptotypes.PubKey VerifySignature method,
+// it panics because it can't handle MultiSignatureData, as LegacyAminoPubKey.
+func (m *WeightedPubKey) VerifySignature(msg, sig []byte) bool {
+	panic("not implemented")
+}
+
+// GetPubKeys implements the PubKey.GetPubKeys method, it returns the public
+// keys of the members.
+func (m *WeightedPubKey) GetPubKeys() []cryptotypes.PubKey {
+	if m != nil {
+		pubKeys := make([]cryptotypes.PubKey, len(m.Members))
+		for i := 0; i < len(m.Members); i++ {
+			pubKeys[i] = m.Members[i].PubKey.GetCachedValue().(cryptotypes.PubKey)
+		}
+		return pubKeys
+	}
+
+	return nil
+}
+
+// GetWeights returns the weights of the members, in the order of GetPubKeys.
+func (m *WeightedPubKey) GetWeights() []uint64 {
+	if m != nil {
+		weights := make([]uint64, len(m.Members))
+		for i := 0; i < len(m.Members); i++ {
+			weights[i] = m.Members[i].Weight
+		}
+		return weights
+	}
+
+	return nil
+}
+
+// Equals returns true if m and other are both WeightedPubKey with the same
+// threshold, and all members have the same keys and weights, in the same order.
+func (m *WeightedPubKey) Equals(key cryptotypes.PubKey) bool {
+	otherKey, ok := key.(*WeightedPubKey)
+	if !ok {
+		return false
+	}
+	if m.Threshold != otherKey.Threshold || len(m.Members) != len(otherKey.Members) {
+		return false
+	}
+
+	pubKeys := m.GetPubKeys()
+	otherPubKeys := otherKey.GetPubKeys()
+	for i := 0; i < len(pubKeys); i++ {
+		if m.Members[i].Weight != otherKey.Members[i].Weight || !pubKeys[i].Equals(otherPubKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetThreshold implements the PubKey.GetThreshold method, it returns the total
+// weight of the members whose signatures are required.
+func (m *WeightedPubKey) GetThreshold() uint {
+	return uint(m.Threshold)
+}
+
+// Type returns multisig type
+func (m *WeightedPubKey) Type() string {
+	return "PubKeyMultisigWeighted"
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *WeightedPubKey) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	for _, member := range m.Members {
+		if member == nil {
+			continue
+		}
+		var pk cryptotypes.PubKey
+		err := unpacker.UnpackAny(member.PubKey, &pk)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,281 @@
+package multisig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestNewWeightedPubKey(t *testing.T) {
+	pubKeys := generatePubKeys(3)
+
+	require.NotNil(t, kmultisig.NewWeightedPubKey(4, pubKeys, []uint64{2, 1, 1}))
+	require.Panics(t, func() { kmultisig.NewWeightedPubKey(0, pubKeys, []uint64{2, 1, 1}) }, "zero threshold")
+	require.Panics(t, func() { kmultisig.NewWeightedPubKey(5, pubKeys, []uint64{2, 1, 1}) }, "unreachable threshold")
+	require.Panics(t, func() { kmultisig.NewWeightedPubKey(1, pubKeys, []uint64{2, 1}) }, "missing weight")
+	require.Panics(t, func() { kmultisig.NewWeightedPubKey(1, pubKeys, []uint64{2, 0, 1}) }, "zero weight")
+	require.Panics(t, func() { kmultisig.NewWeightedPubKey(1, pubKeys[:2], []uint64{1 << 63, 1 << 63}) }, "total weight overflow")
+}
+
+func TestWeightedAddress(t *testing.T) {
+	pubKeys := generatePubKeys(3)
+	multisigKey := kmultisig.NewWeightedPubKey(2, pubKeys, []uint64{2, 1, 1})
+
+	require.Len(t, multisigKey.Address().Bytes(), 32)
+	require.NotEqual(t, multisigKey.Address(), kmultisig.NewWeightedPubKey(2, pubKeys, []uint64{1, 1, 1}).Address())
+	require.NotEqual(t, multisigKey.Address(), kmultisig.NewWeightedPubKey(3, pubKeys, []uint64{2, 1, 1}).Address())
+}
+
+func TestWeightedEquals(t *testing.T) {
+	pubKeys := generatePubKeys(2)
+	multisigKey := kmultisig.NewWeightedPubKey(2, pubKeys, []uint64{1, 1})
+
+	testCases := []struct {
+		msg      string
+		other    cryptotypes.PubKey
+		expectEq bool
+	}{
+		{
+			"equals with proto pub key",
+			&kmultisig.WeightedPubKey{Threshold: 2, Members: multisigKey.Members},
+			true,
+		},
+		{
+			"different threshold",
+			kmultisig.NewWeightedPubKey(1, pubKeys, []uint64{1, 1}),
+			false,
+		},
+		{
+			"different weights",
+			kmultisig.NewWeightedPubKey(2, pubKeys, []uint64{2, 1}),
+			false,
+		},
+		{
+			"different pub keys order",
+			kmultisig.NewWeightedPubKey(2, []cryptotypes.PubKey{pubKeys[1], pubKeys[0]}, []uint64{1, 1}),
+			false,
+		},
+		{
+			"legacy multisig with same threshold and keys",
+			kmultisig.NewLegacyAminoPubKey(2, pubKeys),
+			false,
+		},
+		{
+			"different types",
+			secp256k1.GenPrivKey().PubKey(),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			require.Equal(t, tc.expectEq, multisigKey.Equals(tc.other))
+			require.Equal(t, tc.expectEq, tc.other.Equals(multisigKey))
+		})
+	}
+}
+
+func TestWeightedVerifyMultisignature(t *testing.T) {
+	var (
+		pk  multisig.PubKey
+		sig *signing.MultiSignatureData
+	)
+	msg := []byte{1, 2, 3, 4}
+	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+
+	testCases := []struct {
+		msg        string
+		malleate   func(*require.Assertions)
+		expectPass bool
+	}{
+		{
+			"heavy member reaches the threshold alone",
+			func(require *require.Assertions) {
+				pubKeys, sigs := generatePubKeysAndSignatures(3, msg)
+				pk = kmultisig.NewWeightedPubKey(2, pubKeys, []uint64{2, 1, 1})
+				sig = multisig.NewMultisig(len(pubKeys))
+				require.NoError(multisig.AddSignatureFromPubKey(sig, sigs[0], pubKeys[0], pubKeys))
+			},
+			true,
+		},
+		{
+			"light members reach the threshold together",
+			func(require *require.Assertions) {
+				pubKeys, sigs := generatePubKeysAndSignatures(3, msg)
+				pk = kmultisig.NewWeightedPubKey(2, pubKeys, []uint64{2, 1, 1})
+				sig = multisig.NewMultisig(len(pubKeys))
+				require.NoError(multisig.AddSignatureFromPubKey(sig, sigs[2], pubKeys[2], pubKeys))
+				require.Error(pk.VerifyMultisignature(signBytesFn, sig), "multisig passed below the threshold")
+				require.NoError(multisig.AddSignatureFromPubKey(sig, sigs[1], pubKeys[1], pubKeys))
+			},
+			true,
+		},
+		{
+			"weight below threshold",
+			func(require *require.Assertions) {
+				pubKeys, sigs := generatePubKeysAndSignatures(3, msg)
+				pk = kmultisig.NewWeightedPubKey(4, pubKeys, []uint64{2, 1, 1})
+				sig = multisig.NewMultisig(len(pubKeys))
+				require.NoError(multisig.AddSignatureFromPubKey(sig, sigs[0], pubKeys[0], pubKeys))
+				require.NoError(multisig.AddSignatureFromPubKey(sig, sigs[1], pubKeys[1], pubKeys))
+			},
+			false,
+		},
+		{
+			"wrong size for sig bit array",
+			func(require *require.Assertions) {
+				pubKeys, sigs := generatePubKeysAndSignatures(3, msg)
+				pk = kmultisig.NewWeightedPubKey(1, pubKeys, []uint64{1, 1, 1})
+				sig = multisig.NewMultisig(2)
+				multisig.AddSignature(sig, sigs[0], 0)
+			},
+			false,
+		},
+		{
+			"more signatures than set bits",
+			func(require *require.Assertions) {
+				pubKeys, sigs := generatePubKeysAndSignatures(3, msg)
+				pk = kmultisig.NewWeightedPubKey(1, pubKeys, []uint64{1, 1, 1})
+				sig = multisig.NewMultisig(len(pubKeys))
+				require.NoError(multisig.AddSignatureFromPubKey(sig, sigs[0], pubKeys[0], pubKeys))
+				sig.Signatures = append(sig.Signatures, sigs[1])
+			},
+			false,
+		},
+		{
+			"unable to verify signature",
+			func(require *require.Assertions) {
+				pubKeys := generatePubKeys(2)
+				_, sigs := generatePubKeysAndSignatures(2, msg)
+				pk = kmultisig.NewWeightedPubKey(1, pubKeys, []uint64{1, 1})
+				sig = multisig.NewMultisig(len(pubKeys))
+				require.NoError(multisig.AddSignatureFromPubKey(sig, sigs[0], pubKeys[0], pubKeys))
+			},
+			false,
+		},
+		{
+			"zero threshold",
+			func(require *require.Assertions) {
+				pubKeys := generatePubKeys(2)
+				pk = &kmultisig.WeightedPubKey{Members: kmultisig.NewWeightedPubKey(1, pubKeys, []uint64{1, 1}).Members}
+				sig = multisig.NewMultisig(len(pubKeys))
+			},
+			false,
+		},
+		{
+			"legacy multisig nested in weighted multisig",
+			func(require *require.Assertions) {
+				nestedPk, nestedSig := generateNestedMultiSignature(2, msg)
+				pubKeys, sigs := generatePubKeysAndSignatures(2, msg)
+				pubKeys = append(pubKeys, nestedPk)
+				pk = kmultisig.NewWeightedPubKey(3, pubKeys, []uint64{1, 1, 2})
+				sig = multisig.NewMultisig(len(pubKeys))
+				require.NoError(multisig.AddSignatureFromPubKey(sig, sigs[0], pubKeys[0], pubKeys))
+				require.NoError(multisig.AddSignatureFromPubKey(sig, nestedSig, nestedPk, pubKeys))
+			},
+			true,
+		},
+		{
+			"weighted multisig nested in weighted multisig",
+			func(require *require.Assertions) {
+				orgPubKeys, orgSigs := generatePubKeysAndSignatures(3, msg)
+				orgPk := kmultisig.NewWeightedPubKey(2, orgPubKeys, []uint64{2, 1, 1})
+				orgSig := multisig.NewMultisig(len(orgPubKeys))
+				require.NoError(multisig.AddSignatureFromPubKey(orgSig, orgSigs[0], orgPubKeys[0], orgPubKeys))
+
+				pubKeys, sigs := generatePubKeysAndSignatures(2, msg)
+				pubKeys = append(pubKeys, orgPk)
+				pk = kmultisig.NewWeightedPubKey(2, pubKeys, []uint64{1, 1, 1})
+				sig = multisig.NewMultisig(len(pubKeys))
+				require.NoError(multisig.AddSignatureFromPubKey(sig, sigs[1], pubKeys[1], pubKeys))
+				require.NoError(multisig.AddSignatureFromPubKey(sig, orgSig, orgPk, pubKeys))
+			},
+			true,
+		},
+		{
+			"nested weighted multisig below its threshold",
+			func(require *require.Assertions) {
+				orgPubKeys, orgSigs := generatePubKeysAndSignatures(3, msg)
+				orgPk := kmultisig.NewWeightedPubKey(3, orgPubKeys, []uint64{2, 1, 1})
+				orgSig := multisig.NewMultisig(len(orgPubKeys))
+				require.NoError(multisig.AddSignatureFromPubKey(orgSig, orgSigs[0], orgPubKeys[0], orgPubKeys))
+
+				pubKeys := []cryptotypes.PubKey{orgPk}
+				pk = kmultisig.NewWeightedPubKey(1, pubKeys, []uint64{1})
+				sig = multisig.NewMultisig(len(pubKeys))
+				require.NoError(multisig.AddSignatureFromPubKey(sig, orgSig, orgPk, pubKeys))
+			},
+			false,
+		},
+		{
+			"weighted multisig nested in legacy multisig",
+			func(require *require.Assertions) {
+				orgPubKeys, orgSigs := generatePubKeysAndSignatures(2, msg)
+				orgPk := kmultisig.NewWeightedPubKey(2, orgPubKeys, []uint64{2, 1})
+				orgSig := multisig.NewMultisig(len(orgPubKeys))
+				require.NoError(multisig.AddSignatureFromPubKey(orgSig, orgSigs[0], orgPubKeys[0], orgPubKeys))
+
+				pubKeys, sigs := generatePubKeysAndSignatures(1, msg)
+				pubKeys = append(pubKeys, orgPk)
+				pk = kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+				sig = multisig.NewMultisig(len(pubKeys))
+				require.NoError(multisig.AddSignatureFromPubKey(sig, sigs[0], pubKeys[0], pubKeys))
+				require.NoError(multisig.AddSignatureFromPubKey(sig, orgSig, orgPk, pubKeys))
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			tc.malleate(require.New(t))
+			err := pk.VerifyMultisignature(signBytesFn, sig)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestWeightedProtoAny(t *testing.T) {
+	registry := types.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	nested := kmultisig.NewLegacyAminoPubKey(1, generatePubKeys(2))
+	multisigKey := kmultisig.NewWeightedPubKey(2, append(generatePubKeys(2), nested), []uint64{1, 1, 2})
+
+	bz, err := cdc.MarshalInterface(multisigKey)
+	require.NoError(t, err)
+	var pk cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterface(bz, &pk))
+	require.True(t, multisigKey.Equals(pk))
+	require.Equal(t, multisigKey.Address(), pk.Address())
+	require.Equal(t, []uint64{1, 1, 2}, pk.(*kmultisig.WeightedPubKey).GetWeights())
+}
+
+func TestWeightedAminoBinary(t *testing.T) {
+	multisigKey := kmultisig.NewWeightedPubKey(2, generatePubKeys(2), []uint64{1, 1})
+	// weighted multisig keys can be nested in legacy multisig keys, which are
+	// amino encoded
+	legacyKey := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{multisigKey})
+
+	bz, err := legacy.Cdc.Marshal(legacyKey)
+	require.NoError(t, err)
+	var pk kmultisig.LegacyAminoPubKey
+	require.NoError(t, legacy.Cdc.Unmarshal(bz, &pk))
+	require.True(t, legacyKey.Equals(&pk))
+	require.Equal(t, legacyKey.Address(), pk.Address())
+}
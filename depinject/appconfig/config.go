@@ -164,6 +164,13 @@ func Compose(appConfig gogoproto.Message) depinject.Config {
 	return depinject.Configs(opts...)
 }
 
+// Lint composes appConfig like Compose and statically checks the resulting
+// container configuration with depinject.Lint, without calling any provider.
+// outputs are the values the app would request from depinject.Inject.
+func Lint(appConfig gogoproto.Message, outputs ...interface{}) ([]depinject.LintIssue, error) {
+	return depinject.Lint(Compose(appConfig), outputs...)
+}
+
 func dumpRegisteredModules(modules map[string]*internal.ModuleInitializer) string {
 	var mods []string
 	for name := range modules {
@@ -6,12 +6,16 @@ import (
 	"io"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	gogoany "github.com/cosmos/gogoproto/types/any"
 	"gotest.tools/v3/assert"
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	"cosmossdk.io/depinject/appconfig/v1alpha1"
 	internal "cosmossdk.io/depinject/internal/appconfig"
 	"cosmossdk.io/depinject/internal/appconfig/testpb"
 	testpbgogo "cosmossdk.io/depinject/internal/appconfiggogo/testpb"
@@ -23,6 +27,40 @@ func expectContainerErrorContains(t *testing.T, option depinject.Config, contain
 	assert.ErrorContains(t, err, contains)
 }
 
+// TestLint must run before TestCompose which resets the module registry.
+func TestLint(t *testing.T) {
+	wrapAny := func(config gogoproto.Message) *gogoany.Any {
+		a := appconfig.WrapAny(config)
+		return &gogoany.Any{TypeUrl: a.TypeUrl, Value: a.Value}
+	}
+
+	appConfig := &v1alpha1.Config{
+		Modules: []*v1alpha1.ModuleConfig{
+			{Name: "runtime", Config: wrapAny(&testpb.TestRuntimeModule{})},
+			{Name: "a", Config: wrapAny(&testpb.TestModuleA{})},
+			{Name: "b", Config: wrapAny(&testpb.TestModuleB{})},
+			{Name: "c", Config: wrapAny(&testpbgogo.TestModuleGogo{})},
+		},
+	}
+
+	var app App
+	issues, err := appconfig.Lint(appConfig, &app)
+	assert.NilError(t, err)
+	assert.Equal(t, len(issues), 1)
+	assert.Equal(t, issues[0].Kind, depinject.LintUnusedProvider)
+	assert.Equal(t, issues[0].Module, "c")
+	assert.Assert(t, strings.Contains(issues[0].Location, "ProvideModuleC"))
+
+	// module b depends on the keeper of module a
+	appConfig.Modules = []*v1alpha1.ModuleConfig{appConfig.Modules[0], appConfig.Modules[2]}
+	issues, err = appconfig.Lint(appConfig, &app)
+	assert.NilError(t, err)
+	assert.Equal(t, len(issues), 1)
+	assert.Equal(t, issues[0].Kind, depinject.LintUnresolvedInput)
+	assert.Equal(t, issues[0].Module, "b")
+	assert.Assert(t, strings.Contains(issues[0].Type, "KeeperA"))
+}
+
 func TestCompose(t *testing.T) {
 	opt := appconfig.LoadJSON([]byte(`{"modules":[{}]}`))
 	expectContainerErrorContains(t, opt, "module is missing name")
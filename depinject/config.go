@@ -51,6 +51,7 @@ func provide(ctr *container, key *moduleKey, providers []interface{}) error {
 		if err != nil {
 			return fmt.Errorf("%w\n%s", err, getStackTrace())
 		}
+		ctr.providers = append(ctr.providers, invoker{fn: &rc, modKey: key})
 	}
 	return nil
 }
@@ -14,15 +14,23 @@ type container struct {
 
 	resolvers         map[string]resolver
 	interfaceBindings map[string]interfaceBinding
+	providers         []invoker
 	invokers          []invoker
 
 	moduleKeyContext *ModuleKeyContext
 
+	// static is set for containers which are only inspected by ExportGraph or
+	// Lint and never built. Errors resolving provider inputs are then left to
+	// Lint instead of failing the registration.
+	static bool
+
 	resolveStack []resolveFrame
 	callerStack  []Location
 	callerMap    map[Location]bool
 }
 
+// invoker is a provider or invoker function registered in the container
+// together with the module it was registered in.
 type invoker struct {
 	fn     *providerDescriptor
 	modKey *moduleKey
@@ -228,7 +236,7 @@ func (c *container) addNode(provider *providerDescriptor, key *moduleKey) (inter
 		}
 
 		vr, err := c.getResolver(typ, key)
-		if err != nil {
+		if err != nil && !c.static {
 			return nil, err
 		}
 
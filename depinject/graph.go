@@ -0,0 +1,239 @@
+package depinject
+
+import (
+	"reflect"
+	"sort"
+)
+
+// GraphTypeKind describes how values of a type are provided in the container.
+type GraphTypeKind string
+
+const (
+	// GraphTypeSimple is a type provided at most once in the container.
+	GraphTypeSimple GraphTypeKind = "simple"
+
+	// GraphTypeSupplied is a type whose value was registered with Supply.
+	GraphTypeSupplied GraphTypeKind = "supplied"
+
+	// GraphTypeModuleScoped is a type provided by a module-scoped provider, i.e. a
+	// provider depending on ModuleKey.
+	GraphTypeModuleScoped GraphTypeKind = "module-scoped"
+
+	// GraphTypeOnePerModule is the map type of a OnePerModuleType.
+	GraphTypeOnePerModule GraphTypeKind = "one-per-module"
+
+	// GraphTypeManyPerContainer is the slice type of a ManyPerContainerType.
+	GraphTypeManyPerContainer GraphTypeKind = "many-per-container"
+)
+
+// Graph is a machine-readable description of the dependency graph defined by
+// a container configuration. It is meant to be serialized to JSON.
+type Graph struct {
+	// Providers are the registered providers in registration order.
+	Providers []GraphFunc `json:"providers"`
+
+	// Invokers are the registered invokers in registration order.
+	Invokers []GraphFunc `json:"invokers"`
+
+	// Types are the types which can be resolved in the container, sorted by name.
+	Types []GraphType `json:"types"`
+
+	// Bindings are the explicit interface bindings, sorted by interface and module.
+	Bindings []GraphBinding `json:"bindings"`
+}
+
+// GraphFunc describes a provider or invoker function.
+type GraphFunc struct {
+	// Location is the fully-qualified name and source position of the function.
+	Location string `json:"location"`
+
+	// Module is the name of the module the function was registered in, if any.
+	Module string `json:"module,omitempty"`
+
+	// ModuleScoped is true if the function is called once for every module
+	// depending on its outputs.
+	ModuleScoped bool `json:"module_scoped,omitempty"`
+
+	Inputs  []GraphInput `json:"inputs"`
+	Outputs []string     `json:"outputs"`
+}
+
+// GraphInput describes an input of a provider or invoker function.
+type GraphInput struct {
+	Type     string `json:"type"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+// GraphType describes a type which can be resolved in the container and what
+// provides it.
+type GraphType struct {
+	Type       string              `json:"type"`
+	Kind       GraphTypeKind       `json:"kind"`
+	ProvidedBy []GraphTypeProvider `json:"provided_by"`
+}
+
+// GraphTypeProvider references the provider, or the Supply call, of a type.
+type GraphTypeProvider struct {
+	Location string `json:"location"`
+	Module   string `json:"module,omitempty"`
+}
+
+// GraphBinding describes an explicit interface binding.
+type GraphBinding struct {
+	Interface      string `json:"interface"`
+	Implementation string `json:"implementation"`
+	Module         string `json:"module,omitempty"`
+}
+
+// ExportGraph returns the dependency graph defined by config. Providers and
+// invokers are registered but none of them is called.
+func ExportGraph(config Config) (*Graph, error) {
+	ctr, err := newStaticContainer(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return ctr.exportGraph(), nil
+}
+
+// newStaticContainer creates a container and applies config to it without
+// building it. Provider inputs which can't be resolved don't fail the
+// registration.
+func newStaticContainer(config Config) (*container, error) {
+	cfg, err := newDebugConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	ctr := newContainer(cfg)
+	ctr.static = true
+	if err := config.apply(ctr); err != nil {
+		return nil, err
+	}
+
+	return ctr, nil
+}
+
+func (c *container) exportGraph() *Graph {
+	graph := &Graph{
+		Providers: make([]GraphFunc, 0, len(c.providers)),
+		Invokers:  make([]GraphFunc, 0, len(c.invokers)),
+		Types:     []GraphType{},
+		Bindings:  []GraphBinding{},
+	}
+
+	for _, p := range c.providers {
+		graph.Providers = append(graph.Providers, newGraphFunc(p))
+	}
+
+	for _, inv := range c.invokers {
+		graph.Invokers = append(graph.Invokers, newGraphFunc(inv))
+	}
+
+	for name, r := range c.resolvers {
+		// skip the element types of one-per-module and many-per-container types
+		// as well as implicit interface bindings which share the resolver of
+		// another type
+		if name != fullyQualifiedTypeName(r.getType()) {
+			continue
+		}
+
+		kind, providers := describeResolver(r)
+		graph.Types = append(graph.Types, GraphType{
+			Type:       name,
+			Kind:       kind,
+			ProvidedBy: providers,
+		})
+	}
+	sort.Slice(graph.Types, func(i, j int) bool {
+		return graph.Types[i].Type < graph.Types[j].Type
+	})
+
+	for _, b := range c.interfaceBindings {
+		graph.Bindings = append(graph.Bindings, GraphBinding{
+			Interface:      b.interfaceName,
+			Implementation: b.implTypeName,
+			Module:         moduleKeyName(b.moduleKey),
+		})
+	}
+	sort.Slice(graph.Bindings, func(i, j int) bool {
+		if graph.Bindings[i].Interface != graph.Bindings[j].Interface {
+			return graph.Bindings[i].Interface < graph.Bindings[j].Interface
+		}
+		return graph.Bindings[i].Module < graph.Bindings[j].Module
+	})
+
+	return graph
+}
+
+func newGraphFunc(f invoker) GraphFunc {
+	gf := GraphFunc{
+		Location: f.fn.Location.String(),
+		Module:   moduleKeyName(f.modKey),
+		Inputs:   make([]GraphInput, 0, len(f.fn.Inputs)),
+		Outputs:  make([]string, 0, len(f.fn.Outputs)),
+	}
+
+	for _, in := range f.fn.Inputs {
+		if in.Ignored {
+			continue
+		}
+		if in.Type == moduleKeyType {
+			gf.ModuleScoped = true
+		}
+		gf.Inputs = append(gf.Inputs, GraphInput{
+			Type:     fullyQualifiedTypeName(in.Type),
+			Optional: in.Optional,
+		})
+	}
+
+	for _, out := range f.fn.Outputs {
+		gf.Outputs = append(gf.Outputs, fullyQualifiedTypeName(out.Type))
+	}
+
+	return gf
+}
+
+// describeResolver returns the kind of r and the providers, or Supply call,
+// it resolves values from.
+func describeResolver(r resolver) (GraphTypeKind, []GraphTypeProvider) {
+	switch r := r.(type) {
+	case *simpleResolver:
+		return GraphTypeSimple, []GraphTypeProvider{newGraphTypeProvider(r.node.provider.Location, r.node.moduleKey)}
+	case *supplyResolver:
+		return GraphTypeSupplied, []GraphTypeProvider{{Location: r.loc.String()}}
+	case *moduleDepResolver:
+		return GraphTypeModuleScoped, []GraphTypeProvider{{Location: r.node.provider.Location.String()}}
+	case *sliceGroupResolver:
+		providers := make([]GraphTypeProvider, 0, len(r.providers))
+		for _, p := range r.providers {
+			providers = append(providers, newGraphTypeProvider(p.provider.Location, p.moduleKey))
+		}
+		return GraphTypeManyPerContainer, providers
+	case *mapOfOnePerModuleResolver:
+		providers := make([]GraphTypeProvider, 0, len(r.providers))
+		for key, p := range r.providers {
+			providers = append(providers, newGraphTypeProvider(p.provider.Location, key))
+		}
+		sort.Slice(providers, func(i, j int) bool {
+			return providers[i].Module < providers[j].Module
+		})
+		return GraphTypeOnePerModule, providers
+	default:
+		panic("unexpected resolver type " + reflect.TypeOf(r).String())
+	}
+}
+
+func newGraphTypeProvider(loc Location, key *moduleKey) GraphTypeProvider {
+	return GraphTypeProvider{
+		Location: loc.String(),
+		Module:   moduleKeyName(key),
+	}
+}
+
+func moduleKeyName(key *moduleKey) string {
+	if key == nil {
+		return ""
+	}
+	return key.name
+}
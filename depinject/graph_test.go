@@ -0,0 +1,83 @@
+package depinject_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+)
+
+func InvokeHandlers(map[string]Handler) {}
+
+func TestExportGraph(t *testing.T) {
+	graph, err := depinject.ExportGraph(depinject.Configs(
+		scenarioConfig,
+		depinject.Invoke(InvokeHandlers),
+		depinject.BindInterfaceInModule("b", fullTypeName("Duck"), fullTypeName("Mallard")),
+	))
+	require.NoError(t, err)
+
+	require.Len(t, graph.Providers, 4)
+	require.Contains(t, graph.Providers[0].Location, "ProvideMsgClientA")
+	require.True(t, graph.Providers[0].ModuleScoped)
+	require.Empty(t, graph.Providers[0].Module)
+	require.Equal(t, "runtime", graph.Providers[1].Module)
+	require.Equal(t, "b", graph.Providers[3].Module)
+	require.Equal(t, []depinject.GraphInput{
+		{Type: "cosmossdk.io/depinject_test/depinject_test.ModuleB"},
+		{Type: "cosmossdk.io/depinject_test/depinject_test.KVStoreKey"},
+		{Type: "cosmossdk.io/depinject_test/depinject_test.MsgClientA"},
+	}, graph.Providers[3].Inputs)
+	require.Equal(t, []string{
+		"cosmossdk.io/depinject_test/depinject_test.KeeperB",
+		"cosmossdk.io/depinject_test/[]depinject_test.Command",
+		"cosmossdk.io/depinject_test/depinject_test.Handler",
+	}, graph.Providers[3].Outputs)
+
+	require.Len(t, graph.Invokers, 1)
+	require.Equal(t, []depinject.GraphInput{
+		{Type: "cosmossdk.io/depinject_test/map[string]depinject_test.Handler", Optional: true},
+	}, graph.Invokers[0].Inputs)
+
+	types := map[string]depinject.GraphType{}
+	for _, typ := range graph.Types {
+		types[typ.Type] = typ
+	}
+	require.Len(t, types, 8)
+
+	handlers := types["cosmossdk.io/depinject_test/map[string]depinject_test.Handler"]
+	require.Equal(t, depinject.GraphTypeOnePerModule, handlers.Kind)
+	require.Len(t, handlers.ProvidedBy, 2)
+	require.Equal(t, "a", handlers.ProvidedBy[0].Module)
+	require.Equal(t, "b", handlers.ProvidedBy[1].Module)
+
+	commands := types["cosmossdk.io/depinject_test/[]depinject_test.Command"]
+	require.Equal(t, depinject.GraphTypeManyPerContainer, commands.Kind)
+	require.Len(t, commands.ProvidedBy, 2)
+
+	require.Equal(t, depinject.GraphTypeModuleScoped, types["cosmossdk.io/depinject_test/depinject_test.KVStoreKey"].Kind)
+	require.Equal(t, depinject.GraphTypeSupplied, types["cosmossdk.io/depinject_test/depinject_test.ModuleA"].Kind)
+
+	keeperA := types["cosmossdk.io/depinject_test/depinject_test.KeeperA"]
+	require.Equal(t, depinject.GraphTypeSimple, keeperA.Kind)
+	require.Len(t, keeperA.ProvidedBy, 1)
+	require.Contains(t, keeperA.ProvidedBy[0].Location, "ModuleA.Provide")
+	require.Equal(t, "a", keeperA.ProvidedBy[0].Module)
+
+	require.Equal(t, []depinject.GraphBinding{{
+		Interface:      fullTypeName("Duck"),
+		Implementation: fullTypeName("Mallard"),
+		Module:         "b",
+	}}, graph.Bindings)
+
+	bz, err := json.Marshal(graph)
+	require.NoError(t, err)
+	var decoded depinject.Graph
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, *graph, decoded)
+
+	_, err = depinject.ExportGraph(depinject.Provide(Provide0, Provide1))
+	require.ErrorContains(t, err, "duplicate provision")
+}
@@ -0,0 +1,300 @@
+package depinject
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// LintIssueKind is the kind of problem reported by Lint.
+type LintIssueKind string
+
+const (
+	// LintUnresolvedInput is reported for a required input which no provider,
+	// Supply call or interface binding can resolve.
+	LintUnresolvedInput LintIssueKind = "unresolved-input"
+
+	// LintAmbiguousBinding is reported for an interface input with several
+	// implementations in the container and no explicit binding.
+	LintAmbiguousBinding LintIssueKind = "ambiguous-binding"
+
+	// LintUnusedProvider is reported for a provider whose outputs are never
+	// needed by an invoker or by the outputs passed to Lint.
+	LintUnusedProvider LintIssueKind = "unused-provider"
+
+	// LintCycle is reported for providers which depend on each other.
+	LintCycle LintIssueKind = "cycle"
+)
+
+// LintIssue is a problem in a container configuration found by Lint.
+type LintIssue struct {
+	Kind LintIssueKind `json:"kind"`
+
+	// Location is the location of the provider or invoker the issue was found
+	// in. It is empty for issues in the outputs passed to Lint.
+	Location string `json:"location,omitempty"`
+
+	// Module is the module the provider or invoker was registered in, if any.
+	Module string `json:"module,omitempty"`
+
+	// Type is the type the issue refers to, if any.
+	Type string `json:"type,omitempty"`
+
+	Message string `json:"message"`
+}
+
+func (i LintIssue) String() string {
+	return fmt.Sprintf("%s: %s", i.Kind, i.Message)
+}
+
+// Lint statically checks the container configuration config and the outputs
+// which would be requested from Inject. Unlike Inject, it does not call any
+// provider or invoker and reports every issue found instead of stopping at the
+// first one. outputs must be pointers, as with Inject, but only their types are
+// used.
+//
+// Providers not needed by any invoker or output are reported as unused, so
+// outputs should list everything the application extracts from the container.
+//
+// An error is returned if config can't be registered at all, e.g. because a type
+// is provided twice.
+func Lint(config Config, outputs ...interface{}) ([]LintIssue, error) {
+	ctr, err := newStaticContainer(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var outputIn []providerInput
+	for _, output := range outputs {
+		typ := reflect.TypeOf(output)
+		if typ == nil || typ.Kind() != reflect.Pointer {
+			return nil, fmt.Errorf("output type must be a pointer, %v is invalid", typ)
+		}
+
+		outputIn = append(outputIn, providerInput{Type: typ.Elem()})
+	}
+
+	outputDesc, err := expandStructArgsProvider(providerDescriptor{Inputs: outputIn})
+	if err != nil {
+		return nil, err
+	}
+
+	l := &linter{
+		ctr:          ctr,
+		providerIdxs: map[*providerDescriptor]int{},
+		deps:         make([][]int, len(ctr.providers)),
+	}
+	for i, p := range ctr.providers {
+		l.providerIdxs[p.fn] = i
+	}
+
+	for i, p := range ctr.providers {
+		l.deps[i] = l.checkInputs(p)
+	}
+
+	var roots []int
+	for _, inv := range ctr.invokers {
+		roots = append(roots, l.checkInputs(inv)...)
+	}
+	roots = append(roots, l.checkInputs(invoker{fn: &outputDesc})...)
+
+	l.checkCycles()
+	l.checkUnused(roots)
+
+	return l.issues, nil
+}
+
+type linter struct {
+	ctr          *container
+	providerIdxs map[*providerDescriptor]int
+
+	// deps are the indexes of the providers each provider depends on
+	deps   [][]int
+	issues []LintIssue
+}
+
+func (l *linter) report(kind LintIssueKind, f invoker, typ reflect.Type, msg string) {
+	issue := LintIssue{
+		Kind:    kind,
+		Module:  moduleKeyName(f.modKey),
+		Message: msg,
+	}
+	if f.fn.Location != nil {
+		issue.Location = f.fn.Location.String()
+	}
+	if typ != nil {
+		issue.Type = fullyQualifiedTypeName(typ)
+	}
+	l.issues = append(l.issues, issue)
+}
+
+// checkInputs reports the inputs of f which can't be resolved and returns the
+// indexes of the providers f depends on.
+func (l *linter) checkInputs(f invoker) []int {
+	var deps []int
+	for _, in := range f.fn.Inputs {
+		if in.Ignored || in.Type == moduleKeyType || in.Type == ownModuleKeyType {
+			continue
+		}
+
+		r, err := l.ctr.getResolver(in.Type, f.modKey)
+		if err != nil {
+			var ambiguousErr ErrMultipleImplicitInterfaceBindings
+			if errors.As(err, &ambiguousErr) {
+				l.report(LintAmbiguousBinding, f, in.Type, err.Error())
+			} else {
+				l.report(LintUnresolvedInput, f, in.Type, err.Error())
+			}
+			continue
+		}
+
+		if r == nil {
+			if !in.Optional {
+				l.report(LintUnresolvedInput, f, in.Type,
+					fmt.Sprintf("can't resolve type %v for %s", fullyQualifiedTypeName(in.Type), describeFunc(f)))
+			}
+			continue
+		}
+
+		for _, p := range resolverProviders(r) {
+			deps = append(deps, l.providerIdxs[p])
+		}
+	}
+	return deps
+}
+
+// checkCycles reports every set of providers which depend on each other,
+// using Tarjan's strongly connected components algorithm.
+func (l *linter) checkCycles() {
+	n := len(l.deps)
+	index := make([]int, n)
+	lowLink := make([]int, n)
+	onStack := make([]bool, n)
+	for i := range index {
+		index[i] = -1
+	}
+
+	var (
+		stack   []int
+		counter int
+		visit   func(int)
+	)
+	visit = func(v int) {
+		index[v] = counter
+		lowLink[v] = counter
+		counter++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		selfLoop := false
+		for _, w := range l.deps[v] {
+			if w == v {
+				selfLoop = true
+			}
+			if index[w] < 0 {
+				visit(w)
+				lowLink[v] = min(lowLink[v], lowLink[w])
+			} else if onStack[w] {
+				lowLink[v] = min(lowLink[v], index[w])
+			}
+		}
+
+		if lowLink[v] != index[v] {
+			return
+		}
+
+		var component []int
+		for {
+			w := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[w] = false
+			component = append(component, w)
+			if w == v {
+				break
+			}
+		}
+
+		if len(component) == 1 && !selfLoop {
+			return
+		}
+
+		names := make([]string, 0, len(component)+1)
+		for i := len(component) - 1; i >= 0; i-- {
+			names = append(names, describeFunc(l.ctr.providers[component[i]]))
+		}
+		names = append(names, names[0])
+		l.report(LintCycle, l.ctr.providers[v], nil,
+			fmt.Sprintf("cyclic dependency: %s", strings.Join(names, " -> ")))
+	}
+
+	for v := 0; v < n; v++ {
+		if index[v] < 0 {
+			visit(v)
+		}
+	}
+}
+
+// checkUnused reports the providers which are not reachable from roots.
+func (l *linter) checkUnused(roots []int) {
+	used := make([]bool, len(l.deps))
+	for len(roots) > 0 {
+		v := roots[len(roots)-1]
+		roots = roots[:len(roots)-1]
+		if used[v] {
+			continue
+		}
+		used[v] = true
+		roots = append(roots, l.deps[v]...)
+	}
+
+	for i, p := range l.ctr.providers {
+		if !used[i] {
+			l.report(LintUnusedProvider, p, nil,
+				fmt.Sprintf("%s is not needed by any invoker or output", describeFunc(p)))
+		}
+	}
+}
+
+// resolverProviders returns the providers r resolves values from.
+func resolverProviders(r resolver) []*providerDescriptor {
+	switch r := r.(type) {
+	case *simpleResolver:
+		return []*providerDescriptor{r.node.provider}
+	case *moduleDepResolver:
+		return []*providerDescriptor{r.node.provider}
+	case *sliceGroupResolver:
+		providers := make([]*providerDescriptor, 0, len(r.providers))
+		for _, p := range r.providers {
+			providers = append(providers, p.provider)
+		}
+		return providers
+	case *mapOfOnePerModuleResolver:
+		keys := make([]*moduleKey, 0, len(r.providers))
+		for key := range r.providers {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].name < keys[j].name
+		})
+
+		providers := make([]*providerDescriptor, 0, len(keys))
+		for _, key := range keys {
+			providers = append(providers, r.providers[key].provider)
+		}
+		return providers
+	default:
+		return nil
+	}
+}
+
+func describeFunc(f invoker) string {
+	if f.fn.Location == nil {
+		return "outputs"
+	}
+	if f.modKey != nil {
+		return fmt.Sprintf("%s (module %s)", f.fn.Location.Name(), f.modKey.name)
+	}
+	return f.fn.Location.Name()
+}
@@ -0,0 +1,116 @@
+package depinject_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+)
+
+func ProvideIntAndStringFromFloat64(x float64) (int, string) { return int(x), "hi" }
+
+func TestLint(t *testing.T) {
+	var (
+		handlers map[string]Handler
+		commands []Command
+		a        KeeperA
+		b        KeeperB
+	)
+
+	issues, err := depinject.Lint(scenarioConfig, &handlers, &commands, &a, &b)
+	require.NoError(t, err)
+	require.Empty(t, issues)
+
+	t.Run("unused providers", func(t *testing.T) {
+		issues, err := depinject.Lint(scenarioConfig, &a)
+		require.NoError(t, err)
+		require.Len(t, issues, 2)
+		require.Equal(t, depinject.LintUnusedProvider, issues[0].Kind)
+		require.Contains(t, issues[0].Location, "ProvideMsgClientA")
+		require.Equal(t, depinject.LintUnusedProvider, issues[1].Kind)
+		require.Contains(t, issues[1].Location, "ModuleB.Provide")
+		require.Equal(t, "b", issues[1].Module)
+
+		// invokers use the providers of their inputs
+		issues, err = depinject.Lint(depinject.Configs(scenarioConfig, depinject.Invoke(InvokeHandlers)), &a)
+		require.NoError(t, err)
+		require.Len(t, issues, 0)
+	})
+
+	t.Run("unresolved inputs", func(t *testing.T) {
+		var x float64
+		var y float32
+		issues, err := depinject.Lint(depinject.Provide(ProvideFloat64FromInt, ProvideFloat32FromInt), &x, &y)
+		require.NoError(t, err)
+		require.Len(t, issues, 2)
+		for _, issue := range issues {
+			require.Equal(t, depinject.LintUnresolvedInput, issue.Kind)
+			require.Equal(t, "int", issue.Type)
+		}
+		require.Contains(t, issues[0].Location, "ProvideFloat64FromInt")
+		require.Contains(t, issues[1].Location, "ProvideFloat32FromInt")
+
+		var pond Pond
+		issues, err = depinject.Lint(depinject.Configs(
+			depinject.BindInterface(fullTypeName("Duck"), fullTypeName("Marbled")),
+			depinject.Provide(ProvideMallard, ResolvePond),
+			depinject.ProvideInModule("a", ProvideModuleDuck),
+		), &pond)
+		require.NoError(t, err)
+		require.Len(t, issues, 2)
+		require.Equal(t, depinject.LintUnresolvedInput, issues[0].Kind)
+		require.Equal(t, "a", issues[0].Module)
+		require.Contains(t, issues[0].Message, "No type for explicit binding found")
+		require.Equal(t, depinject.LintUnusedProvider, issues[1].Kind)
+		require.Contains(t, issues[1].Location, "ProvideMallard")
+	})
+
+	t.Run("ambiguous bindings", func(t *testing.T) {
+		var duck Duck
+		issues, err := depinject.Lint(depinject.Provide(ProvideMallard, ProvideCanvasback), &duck)
+		require.NoError(t, err)
+		require.Len(t, issues, 3)
+		require.Equal(t, depinject.LintAmbiguousBinding, issues[0].Kind)
+		require.Equal(t, fullTypeName("Duck"), issues[0].Type)
+		require.Empty(t, issues[0].Location)
+		require.Equal(t, depinject.LintUnusedProvider, issues[1].Kind)
+		require.Equal(t, depinject.LintUnusedProvider, issues[2].Kind)
+
+		var pond Pond
+		issues, err = depinject.Lint(depinject.Provide(ProvideMallard, ProvideCanvasback, ProvideDuckWrapper, ResolvePond), &pond)
+		require.NoError(t, err)
+		require.Len(t, issues, 3)
+		require.Equal(t, depinject.LintAmbiguousBinding, issues[0].Kind)
+		require.Contains(t, issues[0].Location, "ProvideDuckWrapper")
+
+		// an explicit binding resolves the ambiguity
+		issues, err = depinject.Lint(depinject.Configs(
+			depinject.BindInterface(fullTypeName("Duck"), fullTypeName("Mallard")),
+			depinject.Provide(ProvideMallard, ProvideCanvasback, ProvideDuckWrapper, ResolvePond),
+		), &pond)
+		require.NoError(t, err)
+		require.Len(t, issues, 1)
+		require.Equal(t, depinject.LintUnusedProvider, issues[0].Kind)
+		require.Contains(t, issues[0].Location, "ProvideCanvasback")
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		var s string
+		issues, err := depinject.Lint(depinject.Provide(ProvideFloat64FromInt, ProvideIntAndStringFromFloat64), &s)
+		require.NoError(t, err)
+		require.Len(t, issues, 1)
+		require.Equal(t, depinject.LintCycle, issues[0].Kind)
+		require.Contains(t, issues[0].Message, "ProvideIntAndStringFromFloat64 -> ")
+		require.Contains(t, issues[0].Message, "ProvideFloat64FromInt")
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := depinject.Lint(depinject.Provide(Provide0, Provide1))
+		require.ErrorContains(t, err, "duplicate provision")
+
+		var x int
+		_, err = depinject.Lint(depinject.Configs(), x)
+		require.ErrorContains(t, err, "output type must be a pointer")
+	})
+}
@@ -24,6 +24,35 @@ type FilterFunc func(key, level string) bool
 // This function attempts to keep the same behavior as the CometBFT ParseLogLevel
 // However the level `none` is replaced by `disabled`.
 func ParseLogLevel(levelStr string) (FilterFunc, error) {
+	filterMap, err := parseLogLevels(levelStr)
+	if err != nil {
+		return nil, err
+	}
+
+	filterFunc := func(key, lvl string) bool {
+		zllevel, ok := filterMap[key]
+		if !ok { // no level filter for this key
+			// check if there is a default level filter
+			zllevel, ok = filterMap[defaultLogLevelKey]
+			if !ok {
+				return false
+			}
+		}
+
+		zllvl, err := zerolog.ParseLevel(lvl)
+		if err != nil {
+			panic(err)
+		}
+
+		return zllvl < zllevel
+	}
+
+	return filterFunc, nil
+}
+
+// parseLogLevels parses a list of module:level pairs as described in
+// ParseLogLevel into a map of module to level.
+func parseLogLevels(levelStr string) (map[string]zerolog.Level, error) {
 	if levelStr == "" {
 		return nil, errors.New("empty log level")
 	}
@@ -58,23 +87,5 @@ func ParseLogLevel(levelStr string) (FilterFunc, error) {
 		filterMap[module] = zllevel
 	}
 
-	filterFunc := func(key, lvl string) bool {
-		zllevel, ok := filterMap[key]
-		if !ok { // no level filter for this key
-			// check if there is a default level filter
-			zllevel, ok = filterMap[defaultLogLevelKey]
-			if !ok {
-				return false
-			}
-		}
-
-		zllvl, err := zerolog.ParseLevel(lvl)
-		if err != nil {
-			panic(err)
-		}
-
-		return zllvl < zllevel
-	}
-
-	return filterFunc, nil
+	return filterMap, nil
 }
@@ -82,6 +82,11 @@ func WithJSONMarshal(marshaler func(v any) ([]byte, error)) {
 
 type zeroLogWrapper struct {
 	*zerolog.Logger
+
+	// module is the value of the last ModuleKey given to With.
+	module  string
+	levels  *LevelRegistry
+	sampler *Sampler
 }
 
 // NewLogger returns a new logger that writes to the given destination.
@@ -132,48 +137,100 @@ func NewLogger(dst io.Writer, options ...Option) Logger {
 
 	logger = logger.Hook(logCfg.Hooks...)
 
-	return zeroLogWrapper{&logger}
+	return zeroLogWrapper{
+		Logger:  &logger,
+		levels:  logCfg.Levels,
+		sampler: logCfg.Sampler,
+	}
 }
 
 // NewCustomLogger returns a new logger with the given zerolog logger.
 func NewCustomLogger(logger zerolog.Logger) Logger {
-	return zeroLogWrapper{&logger}
+	return zeroLogWrapper{Logger: &logger}
 }
 
 // Info takes a message and a set of key/value pairs and logs with level INFO.
 // The key of the tuple must be a string.
 func (l zeroLogWrapper) Info(msg string, keyVals ...interface{}) {
+	if !l.shouldLog(zerolog.InfoLevel, msg) {
+		return
+	}
 	l.Logger.Info().Fields(keyVals).Msg(msg)
 }
 
 // Warn takes a message and a set of key/value pairs and logs with level WARN.
 // The key of the tuple must be a string.
 func (l zeroLogWrapper) Warn(msg string, keyVals ...interface{}) {
+	if !l.shouldLog(zerolog.WarnLevel, msg) {
+		return
+	}
 	l.Logger.Warn().Fields(keyVals).Msg(msg)
 }
 
 // Error takes a message and a set of key/value pairs and logs with level ERROR.
 // The key of the tuple must be a string.
 func (l zeroLogWrapper) Error(msg string, keyVals ...interface{}) {
+	if !l.shouldLog(zerolog.ErrorLevel, msg) {
+		return
+	}
 	l.Logger.Error().Fields(keyVals).Msg(msg)
 }
 
 // Debug takes a message and a set of key/value pairs and logs with level DEBUG.
 // The key of the tuple must be a string.
 func (l zeroLogWrapper) Debug(msg string, keyVals ...interface{}) {
+	if !l.shouldLog(zerolog.DebugLevel, msg) {
+		return
+	}
 	l.Logger.Debug().Fields(keyVals).Msg(msg)
 }
 
 // With returns a new wrapped logger with additional context provided by a set.
 func (l zeroLogWrapper) With(keyVals ...interface{}) Logger {
-	logger := l.Logger.With().Fields(keyVals).Logger()
-	return zeroLogWrapper{&logger}
+	return l.with(keyVals)
 }
 
 // WithContext returns a new wrapped logger with additional context provided by a set.
 func (l zeroLogWrapper) WithContext(keyVals ...interface{}) any {
+	return l.with(keyVals)
+}
+
+func (l zeroLogWrapper) with(keyVals []interface{}) zeroLogWrapper {
 	logger := l.Logger.With().Fields(keyVals).Logger()
-	return zeroLogWrapper{&logger}
+	return zeroLogWrapper{
+		Logger:  &logger,
+		module:  ModuleFromKeyVals(l.module, keyVals),
+		levels:  l.levels,
+		sampler: l.sampler,
+	}
+}
+
+// shouldLog checks a message of the given level against the level registry
+// and the sampler of the logger, if any.
+func (l zeroLogWrapper) shouldLog(level zerolog.Level, msg string) bool {
+	if l.levels != nil && !l.levels.Enabled(l.module, level) {
+		return false
+	}
+
+	// don't count the messages zerolog discards in the sampler
+	return l.sampler == nil || (level >= l.Logger.GetLevel() && l.sampler.Allow(msg))
+}
+
+// LevelRegistry returns the level registry of the logger, if any.
+func (l zeroLogWrapper) LevelRegistry() *LevelRegistry {
+	return l.levels
+}
+
+// ModuleFromKeyVals returns the value of the last ModuleKey in keyVals, or
+// module if there is none.
+func ModuleFromKeyVals(module string, keyVals []any) string {
+	for i := 0; i+1 < len(keyVals); i += 2 {
+		if key, ok := keyVals[i].(string); ok && key == ModuleKey {
+			module = fmt.Sprint(keyVals[i+1])
+		}
+	}
+
+	return module
 }
 
 // Impl returns the underlying zerolog logger.
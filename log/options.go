@@ -15,6 +15,8 @@ var defaultConfig = Config{
 	StackTrace: false,
 	TimeFormat: time.Kitchen,
 	Hooks:      nil,
+	Levels:     nil,
+	Sampler:    nil,
 }
 
 // Config defines configuration for the logger.
@@ -26,6 +28,8 @@ type Config struct {
 	StackTrace bool
 	TimeFormat string
 	Hooks      []zerolog.Hook
+	Levels     *LevelRegistry
+	Sampler    *Sampler
 }
 
 type Option func(*Config)
@@ -96,3 +100,31 @@ func HooksOption(hooks ...zerolog.Hook) Option {
 		cfg.Hooks = append(cfg.Hooks, hooks...)
 	}
 }
+
+// LevelRegistryOption sets the registry holding the level of each module.
+// The levels can then be changed while the Logger is running. The module of a
+// message is the value of the ModuleKey given to Logger.With.
+// The registry is checked before the level and filter options, which usually
+// don't need to be set alongside it.
+func LevelRegistryOption(levels *LevelRegistry) Option {
+	return func(cfg *Config) {
+		cfg.Levels = levels
+	}
+}
+
+// SamplingOption samples the messages of the Logger: in each period, the first
+// messages with the same text are written, then only every thereafter-th one.
+// It replaces any previous SamplingOption or RateLimitOption.
+func SamplingOption(first, thereafter uint64, period time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.Sampler = NewSampler(first, thereafter, period)
+	}
+}
+
+// RateLimitOption writes at most limit messages with the same text in each
+// period. It replaces any previous SamplingOption or RateLimitOption.
+func RateLimitOption(limit uint64, period time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.Sampler = NewRateLimiter(limit, period)
+	}
+}
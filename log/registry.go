@@ -0,0 +1,149 @@
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// LevelRegistry holds the log level of each module and can be changed while
+// the loggers using it are running. The level of a module without its own level
+// is the default level, set for the "*" module. When there is no default level,
+// everything is logged.
+type LevelRegistry struct {
+	mu     sync.RWMutex
+	levels map[string]zerolog.Level
+}
+
+// NewLevelRegistry returns a LevelRegistry initialized with a comma-separated
+// list of module:level pairs, in the format accepted by ParseLogLevel. An empty
+// list creates a registry without any level.
+func NewLevelRegistry(levelStr string) (*LevelRegistry, error) {
+	r := &LevelRegistry{levels: map[string]zerolog.Level{}}
+	if levelStr == "" {
+		return r, nil
+	}
+
+	levels, err := parseLogLevels(levelStr)
+	if err != nil {
+		return nil, err
+	}
+	r.levels = levels
+
+	return r, nil
+}
+
+// Enabled returns true if a message of the given level logged by module should
+// be written.
+func (r *LevelRegistry) Enabled(module string, level zerolog.Level) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	moduleLevel, ok := r.levels[module]
+	if !ok {
+		moduleLevel, ok = r.levels[defaultLogLevelKey]
+		if !ok {
+			return true
+		}
+	}
+
+	return level >= moduleLevel
+}
+
+// SetLevel sets the level of module. Use "*" to set the default level.
+func (r *LevelRegistry) SetLevel(module string, level zerolog.Level) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.levels[module] = level
+}
+
+// RemoveLevel removes the level of module, which then uses the default level.
+func (r *LevelRegistry) RemoveLevel(module string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.levels, module)
+}
+
+// Levels returns a copy of the level of each module.
+func (r *LevelRegistry) Levels() map[string]zerolog.Level {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	levels := make(map[string]zerolog.Level, len(r.levels))
+	for module, level := range r.levels {
+		levels[module] = level
+	}
+
+	return levels
+}
+
+// LevelRegistryProvider is implemented by loggers using a LevelRegistry.
+type LevelRegistryProvider interface {
+	LevelRegistry() *LevelRegistry
+}
+
+// NewLevelRegistryHandler returns an HTTP handler to read and change the levels
+// of r. All requests return the levels of all modules as a JSON object mapping
+// module names to levels.
+//
+//   - GET returns the levels.
+//   - PUT sets the levels given in the request body as a JSON object mapping
+//     module names to levels, e.g. {"bank": "debug", "*": "info"}.
+//   - DELETE removes the level of the module given by the "module" query
+//     parameter.
+//
+// The handler must only be exposed locally, as it lets anyone change what the
+// node logs.
+func NewLevelRegistryHandler(r *LevelRegistry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			var levels map[string]string
+			if err := json.NewDecoder(req.Body).Decode(&levels); err != nil {
+				http.Error(w, fmt.Sprintf("invalid levels: %v", err), http.StatusBadRequest)
+				return
+			}
+
+			parsed := make(map[string]zerolog.Level, len(levels))
+			for module, level := range levels {
+				lvl, err := zerolog.ParseLevel(level)
+				if err != nil {
+					http.Error(w, fmt.Sprintf("invalid level %q for module %q", level, module), http.StatusBadRequest)
+					return
+				}
+				parsed[module] = lvl
+			}
+
+			for module, level := range parsed {
+				r.SetLevel(module, level)
+			}
+		case http.MethodDelete:
+			module := req.URL.Query().Get("module")
+			if module == "" {
+				http.Error(w, "missing module query parameter", http.StatusBadRequest)
+				return
+			}
+
+			r.RemoveLevel(module)
+		default:
+			w.Header().Set("Allow", "GET, PUT, DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		levels := r.Levels()
+		res := make(map[string]string, len(levels))
+		for module, level := range levels {
+			res[module] = level.String()
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(res)
+	})
+}
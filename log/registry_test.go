@@ -0,0 +1,137 @@
+package log_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"cosmossdk.io/log"
+)
+
+func TestLevelRegistry(t *testing.T) {
+	if _, err := log.NewLevelRegistry("consensus:foo"); err == nil {
+		t.Fatalf("expected error for invalid log level foo")
+	}
+
+	levels, err := log.NewLevelRegistry("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !levels.Enabled("bank", zerolog.TraceLevel) {
+		t.Errorf("expected everything to be enabled without levels")
+	}
+
+	levels, err = log.NewLevelRegistry("consensus:debug,*:error")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !levels.Enabled("consensus", zerolog.DebugLevel) {
+		t.Errorf("expected consensus:debug to be enabled")
+	}
+	if levels.Enabled("bank", zerolog.InfoLevel) {
+		t.Errorf("expected bank:info to be disabled")
+	}
+	if !levels.Enabled("bank", zerolog.ErrorLevel) {
+		t.Errorf("expected bank:error to be enabled")
+	}
+
+	levels.SetLevel("bank", zerolog.DebugLevel)
+	if !levels.Enabled("bank", zerolog.DebugLevel) {
+		t.Errorf("expected bank:debug to be enabled after SetLevel")
+	}
+
+	levels.RemoveLevel("bank")
+	if levels.Enabled("bank", zerolog.InfoLevel) {
+		t.Errorf("expected bank:info to be disabled after RemoveLevel")
+	}
+
+	got := levels.Levels()
+	if len(got) != 2 || got["consensus"] != zerolog.DebugLevel || got["*"] != zerolog.ErrorLevel {
+		t.Errorf("unexpected levels: %v", got)
+	}
+}
+
+func TestLevelRegistryHandler(t *testing.T) {
+	levels, err := log.NewLevelRegistry("*:info")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	handler := log.NewLevelRegistryHandler(levels)
+
+	do := func(method, target, body string) (int, map[string]string) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(method, target, strings.NewReader(body)))
+		if rec.Code != http.StatusOK {
+			return rec.Code, nil
+		}
+
+		var res map[string]string
+		if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+			t.Fatalf("unexpected response %s: %v", rec.Body.String(), err)
+		}
+		return rec.Code, res
+	}
+
+	_, res := do(http.MethodGet, "/", "")
+	if len(res) != 1 || res["*"] != "info" {
+		t.Errorf("unexpected levels: %v", res)
+	}
+
+	_, res = do(http.MethodPut, "/", `{"bank": "debug"}`)
+	if len(res) != 2 || res["bank"] != "debug" {
+		t.Errorf("unexpected levels: %v", res)
+	}
+	if !levels.Enabled("bank", zerolog.DebugLevel) {
+		t.Errorf("expected bank:debug to be enabled")
+	}
+
+	if code, _ := do(http.MethodPut, "/", `{"bank": "foo"}`); code != http.StatusBadRequest {
+		t.Errorf("expected bad request for invalid level, got %d", code)
+	}
+
+	_, res = do(http.MethodDelete, "/?module=bank", "")
+	if len(res) != 1 {
+		t.Errorf("unexpected levels: %v", res)
+	}
+
+	if code, _ := do(http.MethodDelete, "/", ""); code != http.StatusBadRequest {
+		t.Errorf("expected bad request without module, got %d", code)
+	}
+	if code, _ := do(http.MethodPost, "/", ""); code != http.StatusMethodNotAllowed {
+		t.Errorf("expected method not allowed, got %d", code)
+	}
+}
+
+func TestLoggerLevelRegistry(t *testing.T) {
+	levels, err := log.NewLevelRegistry("*:info")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	buf := new(bytes.Buffer)
+	logger := log.NewLogger(buf, log.LevelRegistryOption(levels), log.ColorOption(false))
+	bankLogger := logger.With(log.ModuleKey, "bank")
+
+	bankLogger.Debug("hidden")
+	if buf.Len() != 0 {
+		t.Fatalf("expected debug message to be filtered, got: %s", buf.String())
+	}
+
+	levels.SetLevel("bank", zerolog.DebugLevel)
+	bankLogger.Debug("shown")
+	logger.Debug("hidden")
+	if !strings.Contains(buf.String(), "shown") || strings.Contains(buf.String(), "hidden") {
+		t.Fatalf("expected only the bank debug message, got: %s", buf.String())
+	}
+
+	provider, ok := logger.(log.LevelRegistryProvider)
+	if !ok || provider.LevelRegistry() != levels {
+		t.Fatalf("expected logger to provide its level registry")
+	}
+}
@@ -0,0 +1,58 @@
+package log
+
+import (
+	"sync"
+	"time"
+)
+
+// Sampler limits how often log messages with the same key are written. In
+// each period, the first messages with a given key are written, then only one
+// message every thereafter messages. The loggers of this package use the
+// message itself as key.
+type Sampler struct {
+	first      uint64
+	thereafter uint64
+	period     time.Duration
+
+	mu      sync.Mutex
+	resetAt time.Time
+	counts  map[string]uint64
+}
+
+// NewSampler returns a Sampler writing the first messages with a given key in
+// each period, then every thereafter-th message. When thereafter is zero, all
+// the other messages of the period are dropped.
+func NewSampler(first, thereafter uint64, period time.Duration) *Sampler {
+	return &Sampler{
+		first:      first,
+		thereafter: thereafter,
+		period:     period,
+		counts:     map[string]uint64{},
+	}
+}
+
+// NewRateLimiter returns a Sampler writing at most limit messages with a given
+// key in each period.
+func NewRateLimiter(limit uint64, period time.Duration) *Sampler {
+	return NewSampler(limit, 0, period)
+}
+
+// Allow reports whether a message with the given key should be written.
+func (s *Sampler) Allow(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// counts are reset every period, which also bounds the memory used
+	if now := time.Now(); !now.Before(s.resetAt) {
+		clear(s.counts)
+		s.resetAt = now.Add(s.period)
+	}
+
+	s.counts[key]++
+	n := s.counts[key]
+	if n <= s.first {
+		return true
+	}
+
+	return s.thereafter > 0 && (n-s.first)%s.thereafter == 0
+}
@@ -0,0 +1,55 @@
+package log_test
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+)
+
+func TestSampler(t *testing.T) {
+	sampler := log.NewSampler(2, 3, time.Hour)
+
+	var allowed []int
+	for i := 1; i <= 10; i++ {
+		if sampler.Allow("msg") {
+			allowed = append(allowed, i)
+		}
+	}
+	if want := []int{1, 2, 5, 8}; !slices.Equal(allowed, want) {
+		t.Errorf("expected %v to be allowed, got %v", want, allowed)
+	}
+
+	// keys are sampled independently
+	if !sampler.Allow("other") {
+		t.Errorf("expected first message with another key to be allowed")
+	}
+
+	limiter := log.NewRateLimiter(1, 50*time.Millisecond)
+	if !limiter.Allow("msg") || limiter.Allow("msg") {
+		t.Errorf("expected only the first message to be allowed")
+	}
+	time.Sleep(60 * time.Millisecond)
+	if !limiter.Allow("msg") {
+		t.Errorf("expected message to be allowed in the next period")
+	}
+}
+
+func TestLoggerRateLimit(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := log.NewLogger(buf, log.RateLimitOption(2, time.Hour), log.ColorOption(false)).With(log.ModuleKey, "bank")
+	for i := 0; i < 5; i++ {
+		logger.Info("noisy")
+	}
+	logger.Info("quiet")
+
+	if n := strings.Count(buf.String(), "noisy"); n != 2 {
+		t.Errorf("expected 2 noisy messages, got %d: %s", n, buf.String())
+	}
+	if !strings.Contains(buf.String(), "quiet") {
+		t.Errorf("expected quiet message, got: %s", buf.String())
+	}
+}
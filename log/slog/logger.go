@@ -3,8 +3,11 @@
 package slog
 
 import (
+	"context"
 	"log/slog"
 
+	"github.com/rs/zerolog"
+
 	"cosmossdk.io/log"
 )
 
@@ -14,37 +17,92 @@ var _ log.Logger = Logger{}
 // an instance of [*slog.Logger].
 type Logger struct {
 	log *slog.Logger
+
+	// module is the value of the last log.ModuleKey given to With.
+	module  string
+	levels  *log.LevelRegistry
+	sampler *log.Sampler
+}
+
+// Option configures a Logger.
+type Option func(*Logger)
+
+// LevelRegistryOption sets the registry holding the level of each module,
+// which is checked before calling the *slog.Logger.
+func LevelRegistryOption(levels *log.LevelRegistry) Option {
+	return func(l *Logger) {
+		l.levels = levels
+	}
+}
+
+// SamplerOption sets the sampler limiting how often messages with the same
+// text are logged. See [log.NewSampler] and [log.NewRateLimiter].
+func SamplerOption(sampler *log.Sampler) Option {
+	return func(l *Logger) {
+		l.sampler = sampler
+	}
 }
 
 // NewCustomLogger returns a Logger backed by an existing slog.Logger instance.
 // All logging methods are called directly on the *slog.Logger;
 // therefore it is the caller's responsibility to configure message filtering,
 // level filtering, output format, and so on.
-func NewCustomLogger(log *slog.Logger) Logger {
-	return Logger{log: log}
+func NewCustomLogger(log *slog.Logger, opts ...Option) Logger {
+	l := Logger{log: log}
+	for _, opt := range opts {
+		opt(&l)
+	}
+	return l
 }
 
 func (l Logger) Info(msg string, keyVals ...any) {
-	l.log.Info(msg, keyVals...)
+	if l.shouldLog(slog.LevelInfo, zerolog.InfoLevel, msg) {
+		l.log.Info(msg, keyVals...)
+	}
 }
 
 func (l Logger) Warn(msg string, keyVals ...any) {
-	l.log.Warn(msg, keyVals...)
+	if l.shouldLog(slog.LevelWarn, zerolog.WarnLevel, msg) {
+		l.log.Warn(msg, keyVals...)
+	}
 }
 
 func (l Logger) Error(msg string, keyVals ...any) {
-	l.log.Error(msg, keyVals...)
+	if l.shouldLog(slog.LevelError, zerolog.ErrorLevel, msg) {
+		l.log.Error(msg, keyVals...)
+	}
 }
 
 func (l Logger) Debug(msg string, keyVals ...any) {
-	l.log.Debug(msg, keyVals...)
+	if l.shouldLog(slog.LevelDebug, zerolog.DebugLevel, msg) {
+		l.log.Debug(msg, keyVals...)
+	}
 }
 
 func (l Logger) With(keyVals ...any) log.Logger {
-	return Logger{log: l.log.With(keyVals...)}
+	return Logger{
+		log:     l.log.With(keyVals...),
+		module:  log.ModuleFromKeyVals(l.module, keyVals),
+		levels:  l.levels,
+		sampler: l.sampler,
+	}
 }
 
 // Impl returns l's underlying [*slog.Logger].
 func (l Logger) Impl() any {
 	return l.log
 }
+
+// LevelRegistry returns the level registry of l, if any.
+func (l Logger) LevelRegistry() *log.LevelRegistry {
+	return l.levels
+}
+
+func (l Logger) shouldLog(level slog.Level, zlLevel zerolog.Level, msg string) bool {
+	if l.levels != nil && !l.levels.Enabled(l.module, zlLevel) {
+		return false
+	}
+
+	// don't count the messages the handler discards in the sampler
+	return l.sampler == nil || (l.log.Enabled(context.Background(), level) && l.sampler.Allow(msg))
+}
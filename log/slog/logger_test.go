@@ -4,8 +4,13 @@ import (
 	"bytes"
 	"encoding/json"
 	stdslog "log/slog"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/rs/zerolog"
+
+	"cosmossdk.io/log"
 	"cosmossdk.io/log/slog"
 )
 
@@ -90,3 +95,30 @@ func TestSlog(t *testing.T) {
 		t.Fatalf("unexpected log record: want %v, got %v", want, line)
 	}
 }
+
+func TestSlogLevelRegistryAndSampler(t *testing.T) {
+	var buf bytes.Buffer
+	h := stdslog.NewTextHandler(&buf, &stdslog.HandlerOptions{
+		Level: stdslog.LevelDebug,
+	})
+	levels, err := log.NewLevelRegistry("*:info")
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger := slog.NewCustomLogger(stdslog.New(h),
+		slog.LevelRegistryOption(levels),
+		slog.SamplerOption(log.NewRateLimiter(1, time.Hour)),
+	).With(log.ModuleKey, "bank")
+
+	logger.Debug("hidden")
+	if buf.Len() != 0 {
+		t.Fatalf("expected debug message to be filtered, got: %s", buf.String())
+	}
+
+	levels.SetLevel("bank", zerolog.DebugLevel)
+	logger.Debug("shown")
+	logger.Debug("shown")
+	if n := strings.Count(buf.String(), "shown"); n != 1 {
+		t.Fatalf("expected 1 message, got %d: %s", n, buf.String())
+	}
+}
@@ -58,6 +58,14 @@ func BenchmarkCompareLegacyDecAndNewDecQuotient(b *testing.B) {
 					_, _ = dv.Quo(ds)
 				}
 			})
+
+			b.Run("FixedDec", func(b *testing.B) {
+				dv, ds := must(NewFixedDecFromStr(spec.dividend)), must(NewFixedDecFromStr(spec.divisor))
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					_, _ = dv.Quo(ds)
+				}
+			})
 		})
 	}
 }
@@ -230,6 +238,14 @@ func BenchmarkCompareLegacyDecAndNewDecMul(b *testing.B) {
 					_, _ = dv.Mul(ds)
 				}
 			})
+
+			b.Run("FixedDec", func(b *testing.B) {
+				dv, ds := must(NewFixedDecFromStr(spec.multiplier)), must(NewFixedDecFromStr(spec.multiplicant))
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					_, _ = dv.Mul(ds)
+				}
+			})
 		})
 	}
 }
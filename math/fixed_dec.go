@@ -0,0 +1,417 @@
+package math
+
+import (
+	"errors"
+	"math/big"
+)
+
+// FixedDec is a signed fixed-point decimal with LegacyPrecision decimal places,
+// stored as a 256-bit integer scaled by 10^LegacyPrecision. Its arithmetic
+// doesn't allocate.
+//
+// Its operations have the precision and rounding of the LegacyDec operations of
+// the same name, and return the same results for values in its range: the
+// scaled integers whose absolute value is below 2^255, that is decimals whose
+// absolute value is below about 5.79 * 10^58. Instead of panicking, operations
+// return ErrFixedDecOverflow when their result is out of range, and
+// ErrDivideByZero on divisions by zero.
+//
+// The zero value is zero.
+type FixedDec struct {
+	// v is the scaled integer in two's complement.
+	v u256
+}
+
+// ErrFixedDecOverflow is returned when the result of a FixedDec operation is out
+// of its range.
+var ErrFixedDecOverflow = errors.New("fixed decimal overflow")
+
+const (
+	// precisionWord is 10^LegacyPrecision.
+	precisionWord uint64 = 1_000_000_000_000_000_000
+	// halfPrecisionWord is half of precisionWord.
+	halfPrecisionWord = precisionWord / 2
+)
+
+// rounding is the rounding of the last decimal place of an operation.
+type rounding int
+
+const (
+	// roundHalfEven rounds to the nearest, and to even on ties, as LegacyDec.Mul.
+	roundHalfEven rounding = iota
+	// roundDown rounds towards zero, as LegacyDec.MulTruncate.
+	roundDown
+	// roundUp rounds towards positive infinity, as LegacyDec.MulRoundUp.
+	roundUp
+)
+
+// ZeroFixedDec returns zero.
+func ZeroFixedDec() FixedDec { return FixedDec{} }
+
+// OneFixedDec returns one.
+func OneFixedDec() FixedDec { return FixedDec{v: u256{precisionWord}} }
+
+// SmallestFixedDec returns the smallest positive FixedDec, 10^-LegacyPrecision.
+func SmallestFixedDec() FixedDec { return FixedDec{v: u256{1}} }
+
+// NewFixedDec returns a FixedDec from an integer.
+func NewFixedDec(i int64) FixedDec {
+	return NewFixedDecWithPrec(i, 0)
+}
+
+// NewFixedDecWithPrec returns a FixedDec from an integer with prec decimal
+// places, e.g. NewFixedDecWithPrec(5, 2) is 0.05. It panics if prec is above
+// LegacyPrecision.
+func NewFixedDecWithPrec(i, prec int64) FixedDec {
+	// |i| * 10^18 is below 2^123, it can't overflow
+	d, _ := fromMagnitude(mulWord(u512{absInt64(i)}, precisionMultiplier(prec).Uint64()), i < 0)
+	return d
+}
+
+// NewFixedDecFromInt returns a FixedDec from an Int, or ErrFixedDecOverflow if
+// it is out of range.
+func NewFixedDecFromInt(i Int) (FixedDec, error) {
+	m, ok := bigToU256(i.BigIntMut())
+	if !ok {
+		return FixedDec{}, ErrFixedDecOverflow
+	}
+	return fromMagnitude(mulWord(m.wide(), precisionWord), i.IsNegative())
+}
+
+// NewFixedDecFromLegacyDec returns a FixedDec from a LegacyDec, or
+// ErrFixedDecOverflow if it is out of range.
+func NewFixedDecFromLegacyDec(d LegacyDec) (FixedDec, error) {
+	if d.IsNil() {
+		return FixedDec{}, errors.New("nil decimal")
+	}
+	m, ok := bigToU256(d.BigIntMut())
+	if !ok {
+		return FixedDec{}, ErrFixedDecOverflow
+	}
+	return fromMagnitude(m.wide(), d.IsNegative())
+}
+
+// NewFixedDecFromStr parses a decimal string as LegacyNewDecFromStr does.
+func NewFixedDecFromStr(s string) (FixedDec, error) {
+	d, err := LegacyNewDecFromStr(s)
+	if err != nil {
+		return FixedDec{}, err
+	}
+	return NewFixedDecFromLegacyDec(d)
+}
+
+// fromMagnitude returns the FixedDec of absolute scaled value m, or
+// ErrFixedDecOverflow if m is not below 2^255.
+func fromMagnitude(m u512, neg bool) (FixedDec, error) {
+	v, ok := m.low()
+	if !ok || v[3]>>63 != 0 {
+		return FixedDec{}, ErrFixedDecOverflow
+	}
+	if neg {
+		v = negU256(v)
+	}
+	return FixedDec{v: v}, nil
+}
+
+// magnitude returns the absolute value of the scaled integer of d.
+func (d FixedDec) magnitude() u256 {
+	if d.IsNegative() {
+		return negU256(d.v)
+	}
+	return d.v
+}
+
+func absInt64(i int64) uint64 {
+	if i < 0 {
+		return uint64(^i) + 1
+	}
+	return uint64(i)
+}
+
+// ToLegacyDec returns d as a LegacyDec.
+func (d FixedDec) ToLegacyDec() LegacyDec {
+	return LegacyNewDecFromBigIntWithPrec(d.BigInt(), LegacyPrecision)
+}
+
+// BigInt returns the scaled integer of d.
+func (d FixedDec) BigInt() *big.Int {
+	i := d.magnitude().toBig()
+	if d.IsNegative() {
+		i.Neg(i)
+	}
+	return i
+}
+
+// String returns d as LegacyDec.String does.
+func (d FixedDec) String() string {
+	return d.ToLegacyDec().String()
+}
+
+// IsZero returns true if d is zero.
+func (d FixedDec) IsZero() bool { return d.v.isZero() }
+
+// IsNegative returns true if d is below zero.
+func (d FixedDec) IsNegative() bool { return d.v[3]>>63 != 0 }
+
+// IsPositive returns true if d is above zero.
+func (d FixedDec) IsPositive() bool { return !d.IsNegative() && !d.IsZero() }
+
+// Cmp returns -1, 0 or 1 if d is lower than, equal to or greater than d2.
+func (d FixedDec) Cmp(d2 FixedDec) int {
+	neg, neg2 := d.IsNegative(), d2.IsNegative()
+	switch {
+	case neg && !neg2:
+		return -1
+	case !neg && neg2:
+		return 1
+	default:
+		// two's complement values of the same sign compare as unsigned values
+		return cmpU256(d.v, d2.v)
+	}
+}
+
+func (d FixedDec) Equal(d2 FixedDec) bool { return d.v == d2.v }
+func (d FixedDec) GT(d2 FixedDec) bool    { return d.Cmp(d2) > 0 }
+func (d FixedDec) GTE(d2 FixedDec) bool   { return d.Cmp(d2) >= 0 }
+func (d FixedDec) LT(d2 FixedDec) bool    { return d.Cmp(d2) < 0 }
+func (d FixedDec) LTE(d2 FixedDec) bool   { return d.Cmp(d2) <= 0 }
+
+// Neg returns -d, it can't overflow as the range is symmetric.
+func (d FixedDec) Neg() FixedDec { return FixedDec{v: negU256(d.v)} }
+
+// Abs returns the absolute value of d.
+func (d FixedDec) Abs() FixedDec { return FixedDec{v: d.magnitude()} }
+
+// IsInteger returns true if d has no fractional part.
+func (d FixedDec) IsInteger() bool {
+	m := d.magnitude()
+	_, r := divWord(m.wide(), precisionWord)
+	return r == 0
+}
+
+// Add returns d + d2.
+func (d FixedDec) Add(d2 FixedDec) (FixedDec, error) {
+	v, _ := addU256(d.v, d2.v)
+	return checkSum(d, d2, FixedDec{v: v})
+}
+
+// Sub returns d - d2.
+func (d FixedDec) Sub(d2 FixedDec) (FixedDec, error) {
+	v, _ := subU256(d.v, d2.v)
+	return checkSum(d, d2.Neg(), FixedDec{v: v})
+}
+
+// checkSum returns sum, the two's complement sum of d and d2, or
+// ErrFixedDecOverflow if it overflowed or is -2^255.
+func checkSum(d, d2, sum FixedDec) (FixedDec, error) {
+	neg := d.IsNegative()
+	if neg == d2.IsNegative() && sum.IsNegative() != neg {
+		return FixedDec{}, ErrFixedDecOverflow
+	}
+	if sum.v == (u256{3: 1 << 63}) {
+		return FixedDec{}, ErrFixedDecOverflow
+	}
+	return sum, nil
+}
+
+// Mul returns d * d2, rounded half to even as LegacyDec.Mul.
+func (d FixedDec) Mul(d2 FixedDec) (FixedDec, error) {
+	return d.mul(d2, roundHalfEven)
+}
+
+// MulTruncate returns d * d2, truncated as LegacyDec.MulTruncate.
+func (d FixedDec) MulTruncate(d2 FixedDec) (FixedDec, error) {
+	return d.mul(d2, roundDown)
+}
+
+// MulRoundUp returns d * d2, rounded up as LegacyDec.MulRoundUp.
+func (d FixedDec) MulRoundUp(d2 FixedDec) (FixedDec, error) {
+	return d.mul(d2, roundUp)
+}
+
+func (d FixedDec) mul(d2 FixedDec, mode rounding) (FixedDec, error) {
+	neg := d.IsNegative() != d2.IsNegative()
+	p := mulU256(d.magnitude(), d2.magnitude())
+	return fromMagnitude(chopPrecision(p, neg, mode), neg)
+}
+
+// chopPrecision returns the scaled magnitude m divided by 10^LegacyPrecision,
+// rounded as mode for a result of sign neg.
+func chopPrecision(m u512, neg bool, mode rounding) u512 {
+	q, r := divWord(m, precisionWord)
+	if r == 0 {
+		return q
+	}
+	switch mode {
+	case roundHalfEven:
+		if r > halfPrecisionWord || (r == halfPrecisionWord && q[0]&1 == 1) {
+			q = q.inc()
+		}
+	case roundUp:
+		// rounding a negative magnitude down rounds the result up
+		if !neg {
+			q = q.inc()
+		}
+	}
+	return q
+}
+
+// MulInt returns d * i.
+func (d FixedDec) MulInt(i Int) (FixedDec, error) {
+	m, ok := bigToU256(i.BigIntMut())
+	if !ok {
+		return FixedDec{}, ErrFixedDecOverflow
+	}
+	neg := d.IsNegative() != i.IsNegative()
+	p := mulU256(d.magnitude(), m)
+	return fromMagnitude(p, neg)
+}
+
+// MulInt64 returns d * i.
+func (d FixedDec) MulInt64(i int64) (FixedDec, error) {
+	neg := d.IsNegative() != (i < 0)
+	m := d.magnitude()
+	return fromMagnitude(mulWord(m.wide(), absInt64(i)), neg)
+}
+
+// Quo returns d / d2, rounded as LegacyDec.Quo: the quotient is truncated to
+// 2*LegacyPrecision decimal places, then rounded half to even.
+func (d FixedDec) Quo(d2 FixedDec) (FixedDec, error) {
+	if d2.IsZero() {
+		return FixedDec{}, ErrDivideByZero
+	}
+	neg := d.IsNegative() != d2.IsNegative()
+	m, m2 := d.magnitude(), d2.magnitude()
+	q, _ := divRem(mulWord(mulWord(m.wide(), precisionWord), precisionWord), m2)
+	return fromMagnitude(chopPrecision(q, neg, roundHalfEven), neg)
+}
+
+// QuoTruncate returns d / d2, truncated as LegacyDec.QuoTruncate.
+func (d FixedDec) QuoTruncate(d2 FixedDec) (FixedDec, error) {
+	if d2.IsZero() {
+		return FixedDec{}, ErrDivideByZero
+	}
+	neg := d.IsNegative() != d2.IsNegative()
+	m, m2 := d.magnitude(), d2.magnitude()
+	q, _ := divRem(mulWord(m.wide(), precisionWord), m2)
+	return fromMagnitude(q, neg)
+}
+
+// QuoRoundUp returns d / d2, rounded as LegacyDec.QuoRoundUp: the truncated
+// quotient is incremented when the division has a remainder and either the
+// dividend is positive and the quotient and the divisor have the same sign, or
+// the dividend is negative and they have different signs.
+func (d FixedDec) QuoRoundUp(d2 FixedDec) (FixedDec, error) {
+	if d2.IsZero() {
+		return FixedDec{}, ErrDivideByZero
+	}
+	neg, neg2 := d.IsNegative(), d2.IsNegative()
+	qNeg := neg != neg2
+	m, m2 := d.magnitude(), d2.magnitude()
+	q, hasRem := divRem(mulWord(m.wide(), precisionWord), m2)
+	if hasRem {
+		// the remainder has the sign of the dividend
+		isQNeg := qNeg && !q.isZero()
+		if (!neg && isQNeg == neg2) || (neg && isQNeg != neg2) {
+			// add one to the signed quotient
+			if isQNeg {
+				q = q.dec()
+			} else {
+				q = q.inc()
+				qNeg = false
+			}
+		}
+	}
+	return fromMagnitude(q, qNeg)
+}
+
+// QuoInt returns d / i, truncated as LegacyDec.QuoInt.
+func (d FixedDec) QuoInt(i Int) (FixedDec, error) {
+	if i.IsZero() {
+		return FixedDec{}, ErrDivideByZero
+	}
+	m2, ok := bigToU256(i.BigIntMut())
+	if !ok {
+		// |i| is above the range, the truncated quotient is zero
+		return FixedDec{}, nil
+	}
+	neg := d.IsNegative() != i.IsNegative()
+	m := d.magnitude()
+	q, _ := divRem(m.wide(), m2)
+	return fromMagnitude(q, neg)
+}
+
+// QuoInt64 returns d / i, truncated as LegacyDec.QuoInt64.
+func (d FixedDec) QuoInt64(i int64) (FixedDec, error) {
+	if i == 0 {
+		return FixedDec{}, ErrDivideByZero
+	}
+	neg := d.IsNegative() != (i < 0)
+	m := d.magnitude()
+	q, _ := divWord(m.wide(), absInt64(i))
+	return fromMagnitude(q, neg)
+}
+
+// integer returns the magnitude of the integer part of d rounded as mode.
+func (d FixedDec) integer(mode rounding) u512 {
+	m := d.magnitude()
+	return chopPrecision(m.wide(), d.IsNegative(), mode)
+}
+
+// TruncateInt returns the integer part of d.
+func (d FixedDec) TruncateInt() Int {
+	return intFromMagnitude(d.integer(roundDown), d.IsNegative())
+}
+
+// RoundInt returns d rounded half to even to an integer, as LegacyDec.RoundInt.
+func (d FixedDec) RoundInt() Int {
+	return intFromMagnitude(d.integer(roundHalfEven), d.IsNegative())
+}
+
+// TruncateInt64 returns the integer part of d, or ErrIntOverflow if it doesn't
+// fit in an int64.
+func (d FixedDec) TruncateInt64() (int64, error) {
+	return int64FromMagnitude(d.integer(roundDown), d.IsNegative())
+}
+
+// RoundInt64 returns d rounded half to even to an int64, or ErrIntOverflow if it
+// doesn't fit.
+func (d FixedDec) RoundInt64() (int64, error) {
+	return int64FromMagnitude(d.integer(roundHalfEven), d.IsNegative())
+}
+
+// TruncateDec returns the integer part of d as a FixedDec.
+func (d FixedDec) TruncateDec() FixedDec {
+	// the integer part is not above |d|, it can't overflow
+	r, _ := fromMagnitude(mulWord(d.integer(roundDown), precisionWord), d.IsNegative())
+	return r
+}
+
+// Ceil returns the smallest integer greater than or equal to d, as LegacyDec.Ceil.
+func (d FixedDec) Ceil() (FixedDec, error) {
+	return fromMagnitude(mulWord(d.integer(roundUp), precisionWord), d.IsNegative())
+}
+
+func intFromMagnitude(m u512, neg bool) Int {
+	// the integer part of a FixedDec fits in 256 bits
+	v, _ := m.low()
+	i := v.toBig()
+	if neg {
+		i.Neg(i)
+	}
+	return NewIntFromBigIntMut(i)
+}
+
+func int64FromMagnitude(m u512, neg bool) (int64, error) {
+	if m[1]|m[2]|m[3]|m[4]|m[5]|m[6]|m[7] != 0 {
+		return 0, ErrIntOverflow
+	}
+	switch {
+	case neg && m[0] <= 1<<63:
+		return int64(^(m[0] - 1)), nil
+	case !neg && m[0] < 1<<63:
+		return int64(m[0]), nil
+	default:
+		return 0, ErrIntOverflow
+	}
+}
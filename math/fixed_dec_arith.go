@@ -0,0 +1,245 @@
+package math
+
+import (
+	"encoding/binary"
+	"math/big"
+	"math/bits"
+)
+
+// u256 is an unsigned 256-bit integer of little-endian 64-bit words.
+type u256 [4]uint64
+
+func (x u256) isZero() bool {
+	return x[0]|x[1]|x[2]|x[3] == 0
+}
+
+func cmpU256(x, y u256) int {
+	for i := 3; i >= 0; i-- {
+		switch {
+		case x[i] < y[i]:
+			return -1
+		case x[i] > y[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+func addU256(x, y u256) (z u256, carry uint64) {
+	z[0], carry = bits.Add64(x[0], y[0], 0)
+	z[1], carry = bits.Add64(x[1], y[1], carry)
+	z[2], carry = bits.Add64(x[2], y[2], carry)
+	z[3], carry = bits.Add64(x[3], y[3], carry)
+	return z, carry
+}
+
+func subU256(x, y u256) (z u256, borrow uint64) {
+	z[0], borrow = bits.Sub64(x[0], y[0], 0)
+	z[1], borrow = bits.Sub64(x[1], y[1], borrow)
+	z[2], borrow = bits.Sub64(x[2], y[2], borrow)
+	z[3], borrow = bits.Sub64(x[3], y[3], borrow)
+	return z, borrow
+}
+
+// negU256 returns the two's complement of x.
+func negU256(x u256) u256 {
+	z, _ := subU256(u256{}, x)
+	return z
+}
+
+// wide returns x as a u512.
+func (x u256) wide() u512 {
+	return u512{x[0], x[1], x[2], x[3]}
+}
+
+// u512 is an unsigned 512-bit integer of little-endian 64-bit words, it holds
+// the intermediate results of the FixedDec operations.
+type u512 [8]uint64
+
+func (x u512) isZero() bool {
+	return x[0]|x[1]|x[2]|x[3]|x[4]|x[5]|x[6]|x[7] == 0
+}
+
+// low returns the 256 low bits of x and whether the high bits are zero.
+func (x u512) low() (u256, bool) {
+	return u256{x[0], x[1], x[2], x[3]}, x[4]|x[5]|x[6]|x[7] == 0
+}
+
+// mulU256 returns the product of x and y.
+func mulU256(x, y u256) (z u512) {
+	for i := 0; i < 4; i++ {
+		var carry uint64
+		for j := 0; j < 4; j++ {
+			hi, lo := bits.Mul64(x[i], y[j])
+			var c uint64
+			lo, c = bits.Add64(lo, z[i+j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			z[i+j] = lo
+			carry = hi
+		}
+		z[i+4] = carry
+	}
+	return z
+}
+
+// mulWord returns the product of x and y, x must be below 2^448.
+func mulWord(x u512, y uint64) (z u512) {
+	var carry uint64
+	for i := 0; i < 7; i++ {
+		hi, lo := bits.Mul64(x[i], y)
+		var c uint64
+		z[i], c = bits.Add64(lo, carry, 0)
+		carry = hi + c
+	}
+	z[7] = carry
+	return z
+}
+
+// divWord returns the quotient and the remainder of x divided by y.
+func divWord(x u512, y uint64) (q u512, r uint64) {
+	for i := 7; i >= 0; i-- {
+		q[i], r = bits.Div64(r, x[i], y)
+	}
+	return q, r
+}
+
+// inc adds one to x, which must be below 2^512-1.
+func (x u512) inc() u512 {
+	for i := range x {
+		x[i]++
+		if x[i] != 0 {
+			break
+		}
+	}
+	return x
+}
+
+// dec subtracts one from x, which must not be zero.
+func (x u512) dec() u512 {
+	for i := range x {
+		x[i]--
+		if x[i] != ^uint64(0) {
+			break
+		}
+	}
+	return x
+}
+
+// divRem returns the quotient of x divided by y and whether the remainder is not
+// zero. y must not be zero. It implements the algorithm D of Knuth, The Art of
+// Computer Programming, volume 2, section 4.3.1.
+func divRem(x u512, y u256) (q u512, hasRem bool) {
+	n := 4
+	for y[n-1] == 0 {
+		n--
+	}
+	if n == 1 {
+		q, r := divWord(x, y[0])
+		return q, r != 0
+	}
+	m := 8
+	for m > 0 && x[m-1] == 0 {
+		m--
+	}
+	if m < n {
+		return u512{}, !x.isZero()
+	}
+
+	// normalize so that the most significant word of the divisor has its top
+	// bit set, the dividend gets one more word
+	s := uint(bits.LeadingZeros64(y[n-1]))
+	var yn [4]uint64
+	for i := n - 1; i > 0; i-- {
+		yn[i] = y[i]<<s | y[i-1]>>(64-s)
+	}
+	yn[0] = y[0] << s
+	var xn [9]uint64
+	xn[m] = x[m-1] >> (64 - s)
+	for i := m - 1; i > 0; i-- {
+		xn[i] = x[i]<<s | x[i-1]>>(64-s)
+	}
+	xn[0] = x[0] << s
+
+	yh, yl := yn[n-1], yn[n-2]
+	for j := m - n; j >= 0; j-- {
+		x2, x1, x0 := xn[j+n], xn[j+n-1], xn[j+n-2]
+
+		// estimate the quotient word, it is at most one too large after the
+		// correction with the second divisor word
+		var qhat uint64
+		if x2 >= yh {
+			qhat = ^uint64(0)
+		} else {
+			var rhat uint64
+			qhat, rhat = bits.Div64(x2, x1, yh)
+			ph, pl := bits.Mul64(qhat, yl)
+			if ph > rhat || (ph == rhat && pl > x0) {
+				qhat--
+			}
+		}
+
+		// multiply and subtract, and add back if the estimate was too large
+		borrow := subMulWords(xn[j:j+n], yn[:n], qhat)
+		xn[j+n] = x2 - borrow
+		if x2 < borrow {
+			qhat--
+			xn[j+n] += addWords(xn[j:j+n], yn[:n])
+		}
+		q[j] = qhat
+	}
+
+	for _, w := range xn[:n] {
+		if w != 0 {
+			return q, true
+		}
+	}
+	return q, false
+}
+
+// subMulWords subtracts y*m from x in place and returns the borrow.
+func subMulWords(x, y []uint64, m uint64) uint64 {
+	var borrow uint64
+	for i := range y {
+		s, c1 := bits.Sub64(x[i], borrow, 0)
+		ph, pl := bits.Mul64(y[i], m)
+		var c2 uint64
+		x[i], c2 = bits.Sub64(s, pl, 0)
+		borrow = ph + c1 + c2
+	}
+	return borrow
+}
+
+// addWords adds y to x in place and returns the carry.
+func addWords(x, y []uint64) uint64 {
+	var carry uint64
+	for i := range y {
+		x[i], carry = bits.Add64(x[i], y[i], carry)
+	}
+	return carry
+}
+
+// bigToU256 returns the absolute value of x, and false if it doesn't fit in 256 bits.
+func bigToU256(x *big.Int) (u256, bool) {
+	if x.BitLen() > 256 {
+		return u256{}, false
+	}
+	var buf [32]byte
+	new(big.Int).Abs(x).FillBytes(buf[:])
+	return u256{
+		binary.BigEndian.Uint64(buf[24:]),
+		binary.BigEndian.Uint64(buf[16:]),
+		binary.BigEndian.Uint64(buf[8:]),
+		binary.BigEndian.Uint64(buf[:]),
+	}, true
+}
+
+func (x u256) toBig() *big.Int {
+	var buf [32]byte
+	binary.BigEndian.PutUint64(buf[:], x[3])
+	binary.BigEndian.PutUint64(buf[8:], x[2])
+	binary.BigEndian.PutUint64(buf[16:], x[1])
+	binary.BigEndian.PutUint64(buf[24:], x[0])
+	return new(big.Int).SetBytes(buf[:])
+}
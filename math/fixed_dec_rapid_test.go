@@ -0,0 +1,199 @@
+package math
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+// TestFixedDecWithRapid checks that the FixedDec operations return the results
+// of the LegacyDec operations of the same name, or an error when the LegacyDec
+// operation panics or returns a result out of the FixedDec range.
+func TestFixedDecWithRapid(t *testing.T) {
+	t.Run("TestConversions", rapid.MakeCheck(testFixedDecConversions))
+	t.Run("TestCmp", rapid.MakeCheck(testFixedDecCmp))
+	t.Run("TestNegAbs", rapid.MakeCheck(testFixedDecNegAbs))
+
+	binaryOps := map[string]struct {
+		fixed  func(FixedDec, FixedDec) (FixedDec, error)
+		legacy func(LegacyDec, LegacyDec) LegacyDec
+	}{
+		"Add":         {FixedDec.Add, LegacyDec.Add},
+		"Sub":         {FixedDec.Sub, LegacyDec.Sub},
+		"Mul":         {FixedDec.Mul, LegacyDec.Mul},
+		"MulTruncate": {FixedDec.MulTruncate, LegacyDec.MulTruncate},
+		"MulRoundUp":  {FixedDec.MulRoundUp, LegacyDec.MulRoundUp},
+		"Quo":         {FixedDec.Quo, LegacyDec.Quo},
+		"QuoTruncate": {FixedDec.QuoTruncate, LegacyDec.QuoTruncate},
+		"QuoRoundUp":  {FixedDec.QuoRoundUp, LegacyDec.QuoRoundUp},
+	}
+	for name, op := range binaryOps {
+		t.Run("Test"+name, rapid.MakeCheck(func(t *rapid.T) {
+			a, b := genFixedDec.Draw(t, "a"), genFixedDec.Draw(t, "b")
+			requireFixedDecResult(t, func() LegacyDec { return op.legacy(a.ToLegacyDec(), b.ToLegacyDec()) }, func() (FixedDec, error) { return op.fixed(a, b) })
+		}))
+	}
+
+	t.Run("TestMulInt", rapid.MakeCheck(func(t *rapid.T) {
+		a, i := genFixedDec.Draw(t, "a"), genFixedDecInt.Draw(t, "i")
+		requireFixedDecResult(t, func() LegacyDec { return a.ToLegacyDec().MulInt(i) }, func() (FixedDec, error) { return a.MulInt(i) })
+	}))
+	t.Run("TestQuoInt", rapid.MakeCheck(func(t *rapid.T) {
+		a, i := genFixedDec.Draw(t, "a"), genFixedDecInt.Draw(t, "i")
+		requireFixedDecResult(t, func() LegacyDec { return a.ToLegacyDec().QuoInt(i) }, func() (FixedDec, error) { return a.QuoInt(i) })
+	}))
+	t.Run("TestMulInt64", rapid.MakeCheck(func(t *rapid.T) {
+		a, i := genFixedDec.Draw(t, "a"), rapid.Int64().Draw(t, "i")
+		requireFixedDecResult(t, func() LegacyDec { return a.ToLegacyDec().MulInt64(i) }, func() (FixedDec, error) { return a.MulInt64(i) })
+	}))
+	t.Run("TestQuoInt64", rapid.MakeCheck(func(t *rapid.T) {
+		a, i := genFixedDec.Draw(t, "a"), rapid.Int64().Draw(t, "i")
+		requireFixedDecResult(t, func() LegacyDec { return a.ToLegacyDec().QuoInt64(i) }, func() (FixedDec, error) { return a.QuoInt64(i) })
+	}))
+	t.Run("TestCeil", rapid.MakeCheck(func(t *rapid.T) {
+		a := genFixedDec.Draw(t, "a")
+		requireFixedDecResult(t, func() LegacyDec { return a.ToLegacyDec().Ceil() }, a.Ceil)
+	}))
+	t.Run("TestIntegers", rapid.MakeCheck(testFixedDecIntegers))
+}
+
+// genFixedDec generates FixedDecs of random bit lengths, so that operations
+// sometimes overflow and sometimes don't.
+var genFixedDec = rapid.Custom(func(t *rapid.T) FixedDec {
+	switch rapid.IntRange(0, 3).Draw(t, "kind") {
+	case 0:
+		return NewFixedDecWithPrec(rapid.Int64Range(-1000, 1000).Draw(t, "small"), rapid.Int64Range(0, LegacyPrecision).Draw(t, "prec"))
+	default:
+		// half of the large values fit in 128 bits so that their products and
+		// quotients are mostly in range
+		maxBitLen := 255
+		if rapid.Bool().Draw(t, "short") {
+			maxBitLen = 128
+		}
+		bitLen := rapid.IntRange(0, maxBitLen).Draw(t, "bitLen")
+		i := new(big.Int).SetBytes(rapid.SliceOfN(rapid.Byte(), 32, 32).Draw(t, "bytes"))
+		i.Rsh(i, uint(256-bitLen))
+		if rapid.Bool().Draw(t, "neg") {
+			i.Neg(i)
+		}
+		d, err := NewFixedDecFromLegacyDec(LegacyNewDecFromBigIntWithPrec(i, LegacyPrecision))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return d
+	}
+})
+
+var genFixedDecInt = rapid.Custom(func(t *rapid.T) Int {
+	bitLen := rapid.IntRange(0, 256).Draw(t, "bitLen")
+	i := new(big.Int).SetBytes(rapid.SliceOfN(rapid.Byte(), 32, 32).Draw(t, "bytes"))
+	i.Rsh(i, uint(256-bitLen))
+	if rapid.Bool().Draw(t, "neg") {
+		i.Neg(i)
+	}
+	return NewIntFromBigInt(i)
+})
+
+// requireFixedDecResult checks that the result of a FixedDec operation is the
+// result of the equivalent LegacyDec operation.
+func requireFixedDecResult(t *rapid.T, legacy func() LegacyDec, fixed func() (FixedDec, error)) {
+	res, err := fixed()
+	var expected LegacyDec
+	legacyErr := func() (r any) {
+		defer func() { r = recover() }()
+		expected = legacy()
+		return nil
+	}()
+
+	switch {
+	case err == nil:
+		require.Nil(t, legacyErr)
+		require.Equal(t, expected.String(), res.String())
+	case errors.Is(err, ErrDivideByZero):
+		require.NotNil(t, legacyErr)
+	case errors.Is(err, ErrFixedDecOverflow):
+		if legacyErr == nil {
+			_, convErr := NewFixedDecFromLegacyDec(expected)
+			require.ErrorIs(t, convErr, ErrFixedDecOverflow)
+		}
+	default:
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func testFixedDecConversions(t *rapid.T) {
+	d := genFixedDec.Draw(t, "d")
+
+	legacy := d.ToLegacyDec()
+	require.Zero(t, d.BigInt().Cmp(legacy.BigInt()))
+	require.Equal(t, legacy.String(), d.String())
+
+	fromStr, err := NewFixedDecFromStr(d.String())
+	require.NoError(t, err)
+	require.Equal(t, d, fromStr)
+
+	fromLegacy, err := NewFixedDecFromLegacyDec(legacy)
+	require.NoError(t, err)
+	require.Equal(t, d, fromLegacy)
+
+	truncated := d.TruncateInt()
+	require.True(t, legacy.TruncateInt().Equal(truncated))
+	fromInt, err := NewFixedDecFromInt(truncated)
+	require.NoError(t, err)
+	require.Equal(t, d.TruncateDec(), fromInt)
+	require.Equal(t, legacy.IsInteger(), d.IsInteger())
+}
+
+func testFixedDecCmp(t *rapid.T) {
+	a, b := genFixedDec.Draw(t, "a"), genFixedDec.Draw(t, "b")
+	la, lb := a.ToLegacyDec(), b.ToLegacyDec()
+
+	require.Equal(t, la.BigInt().Cmp(lb.BigInt()), a.Cmp(b))
+	require.Equal(t, la.Equal(lb), a.Equal(b))
+	require.Equal(t, la.GT(lb), a.GT(b))
+	require.Equal(t, la.GTE(lb), a.GTE(b))
+	require.Equal(t, la.LT(lb), a.LT(b))
+	require.Equal(t, la.LTE(lb), a.LTE(b))
+	require.Equal(t, la.IsZero(), a.IsZero())
+	require.Equal(t, la.IsNegative(), a.IsNegative())
+	require.Equal(t, la.IsPositive(), a.IsPositive())
+}
+
+func testFixedDecNegAbs(t *rapid.T) {
+	d := genFixedDec.Draw(t, "d")
+	require.Equal(t, d.ToLegacyDec().Neg().String(), d.Neg().String())
+	require.Equal(t, d.ToLegacyDec().Abs().String(), d.Abs().String())
+}
+
+func testFixedDecIntegers(t *rapid.T) {
+	d := genFixedDec.Draw(t, "d")
+	legacy := d.ToLegacyDec()
+
+	require.True(t, legacy.RoundInt().Equal(d.RoundInt()))
+	require.Equal(t, legacy.TruncateDec().String(), d.TruncateDec().String())
+
+	for _, tc := range []struct {
+		legacy func() int64
+		fixed  func() (int64, error)
+	}{
+		{legacy.TruncateInt64, d.TruncateInt64},
+		{legacy.RoundInt64, d.RoundInt64},
+	} {
+		var expected int64
+		panicked := func() (r any) {
+			defer func() { r = recover() }()
+			expected = tc.legacy()
+			return nil
+		}()
+		res, err := tc.fixed()
+		if panicked != nil {
+			require.ErrorIs(t, err, ErrIntOverflow)
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, expected, res)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package math_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestFixedDecConversions(t *testing.T) {
+	specs := map[string]struct {
+		src    string
+		expErr error
+	}{
+		"zero":         {src: "0"},
+		"one":          {src: "1"},
+		"smallest":     {src: "0.000000000000000001"},
+		"negative":     {src: "-123.456"},
+		"max":          {src: "57896044618658097711785492504343953926634992332820282019728.792003956564819967"},
+		"min":          {src: "-57896044618658097711785492504343953926634992332820282019728.792003956564819967"},
+		"above max":    {src: "57896044618658097711785492504343953926634992332820282019728.792003956564819968", expErr: math.ErrFixedDecOverflow},
+		"below min":    {src: "-57896044618658097711785492504343953926634992332820282019728.792003956564819968", expErr: math.ErrFixedDecOverflow},
+		"invalid":      {src: "1.2.3", expErr: math.ErrLegacyInvalidDecimalStr},
+		"empty string": {src: "", expErr: math.ErrLegacyEmptyDecimalStr},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			d, err := math.NewFixedDecFromStr(spec.src)
+			if spec.expErr != nil {
+				require.ErrorIs(t, err, spec.expErr)
+				return
+			}
+			require.NoError(t, err)
+			legacy := math.LegacyMustNewDecFromStr(spec.src)
+			require.Equal(t, legacy.String(), d.String())
+			require.True(t, legacy.Equal(d.ToLegacyDec()))
+		})
+	}
+
+	_, err := math.NewFixedDecFromLegacyDec(math.LegacyDec{})
+	require.Error(t, err)
+
+	i, ok := new(big.Int).SetString("57896044618658097711785492504343953926634992332820282019729", 10)
+	require.True(t, ok)
+	_, err = math.NewFixedDecFromInt(math.NewIntFromBigInt(i))
+	require.ErrorIs(t, err, math.ErrFixedDecOverflow)
+	d, err := math.NewFixedDecFromInt(math.NewInt(-42))
+	require.NoError(t, err)
+	require.Equal(t, "-42.000000000000000000", d.String())
+
+	require.Equal(t, "0.050000000000000000", math.NewFixedDecWithPrec(5, 2).String())
+	require.Equal(t, "-9223372036854775808.000000000000000000", math.NewFixedDec(-1<<63).String())
+	require.Equal(t, "0.000000000000000001", math.SmallestFixedDec().String())
+	require.True(t, math.OneFixedDec().Equal(math.NewFixedDec(1)))
+	require.True(t, math.ZeroFixedDec().IsZero())
+}
+
+func TestFixedDecArithmetic(t *testing.T) {
+	maxDec := mustFixedDec(t, "57896044618658097711785492504343953926634992332820282019728.792003956564819967")
+	specs := map[string]struct {
+		op     func() (math.FixedDec, error)
+		exp    string
+		expErr error
+	}{
+		"add": {
+			op:  func() (math.FixedDec, error) { return mustFixedDec(t, "1.5").Add(mustFixedDec(t, "-2.25")) },
+			exp: "-0.750000000000000000",
+		},
+		"add overflow": {
+			op:     func() (math.FixedDec, error) { return maxDec.Add(math.SmallestFixedDec()) },
+			expErr: math.ErrFixedDecOverflow,
+		},
+		"sub overflow": {
+			op:     func() (math.FixedDec, error) { return maxDec.Neg().Sub(math.SmallestFixedDec()) },
+			expErr: math.ErrFixedDecOverflow,
+		},
+		"mul rounds half to even down": {
+			op: func() (math.FixedDec, error) {
+				return mustFixedDec(t, "0.000000000000000005").Mul(mustFixedDec(t, "0.1"))
+			},
+			exp: "0.000000000000000000",
+		},
+		"mul rounds half to even up": {
+			op: func() (math.FixedDec, error) {
+				return mustFixedDec(t, "0.000000000000000015").Mul(mustFixedDec(t, "0.1"))
+			},
+			exp: "0.000000000000000002",
+		},
+		"mul truncate": {
+			op: func() (math.FixedDec, error) {
+				return mustFixedDec(t, "-0.000000000000000015").MulTruncate(mustFixedDec(t, "0.1"))
+			},
+			exp: "-0.000000000000000001",
+		},
+		"mul round up negative": {
+			op: func() (math.FixedDec, error) {
+				return mustFixedDec(t, "-0.000000000000000015").MulRoundUp(mustFixedDec(t, "0.1"))
+			},
+			exp: "-0.000000000000000001",
+		},
+		"mul overflow": {
+			op:     func() (math.FixedDec, error) { return maxDec.Mul(math.NewFixedDec(2)) },
+			expErr: math.ErrFixedDecOverflow,
+		},
+		"quo": {
+			op:  func() (math.FixedDec, error) { return math.NewFixedDec(2).Quo(math.NewFixedDec(3)) },
+			exp: "0.666666666666666667",
+		},
+		"quo truncate": {
+			op:  func() (math.FixedDec, error) { return math.NewFixedDec(2).QuoTruncate(math.NewFixedDec(3)) },
+			exp: "0.666666666666666666",
+		},
+		"quo round up": {
+			op:  func() (math.FixedDec, error) { return math.NewFixedDec(1).QuoRoundUp(math.NewFixedDec(3)) },
+			exp: "0.333333333333333334",
+		},
+		"quo by zero": {
+			op:     func() (math.FixedDec, error) { return math.OneFixedDec().Quo(math.ZeroFixedDec()) },
+			expErr: math.ErrDivideByZero,
+		},
+		"quo int64 by zero": {
+			op:     func() (math.FixedDec, error) { return math.OneFixedDec().QuoInt64(0) },
+			expErr: math.ErrDivideByZero,
+		},
+		"quo int truncates": {
+			op:  func() (math.FixedDec, error) { return mustFixedDec(t, "-0.000000000000000005").QuoInt(math.NewInt(2)) },
+			exp: "-0.000000000000000002",
+		},
+		"mul int64 min": {
+			op:  func() (math.FixedDec, error) { return math.OneFixedDec().MulInt64(-1 << 63) },
+			exp: "-9223372036854775808.000000000000000000",
+		},
+		"ceil": {
+			op:  func() (math.FixedDec, error) { return mustFixedDec(t, "-1.5").Ceil() },
+			exp: "-1.000000000000000000",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			res, err := spec.op()
+			if spec.expErr != nil {
+				require.ErrorIs(t, err, spec.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.exp, res.String())
+		})
+	}
+}
+
+func TestFixedDecIntegers(t *testing.T) {
+	d := mustFixedDec(t, "2.5")
+	require.Equal(t, "2", d.TruncateInt().String())
+	require.Equal(t, "2", d.RoundInt().String())
+	require.Equal(t, "-4", mustFixedDec(t, "-3.5").RoundInt().String())
+
+	i, err := mustFixedDec(t, "-9223372036854775808.4").TruncateInt64()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1<<63), i)
+	_, err = mustFixedDec(t, "9223372036854775808").TruncateInt64()
+	require.ErrorIs(t, err, math.ErrIntOverflow)
+	_, err = mustFixedDec(t, "9223372036854775807.5").RoundInt64()
+	require.ErrorIs(t, err, math.ErrIntOverflow)
+}
+
+func mustFixedDec(t *testing.T, s string) math.FixedDec {
+	t.Helper()
+	d, err := math.NewFixedDecFromStr(s)
+	require.NoError(t, err)
+	return d
+}
@@ -30,6 +30,7 @@ type AppBuilder[T transaction.Tx] struct {
 	branch      func(state store.ReaderMap) store.WriterMap
 	txValidator func(ctx context.Context, tx T) error
 	postTxExec  func(ctx context.Context, tx T, success bool) error
+	stfOptions  []stf.Option[T]
 }
 
 // RegisterModules registers the provided modules with the module manager.
@@ -106,6 +107,7 @@ func (a *AppBuilder[T]) Build(opts ...AppBuilderOption[T]) (*App[T], error) {
 		valUpdate,
 		a.postTxExec,
 		a.branch,
+		a.stfOptions...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create STF: %w", err)
@@ -219,3 +221,11 @@ func AppBuilderWithPostTxExec[T transaction.Tx](
 		a.postTxExec = postTxExec
 	}
 }
+
+// AppBuilderWithParallelExecution enables the optimistic parallel execution of
+// the block txs with the given number of workers, see stf.WithParallelExecution.
+func AppBuilderWithParallelExecution[T transaction.Tx](workers int) AppBuilderOption[T] {
+	return func(a *AppBuilder[T]) {
+		a.stfOptions = append(a.stfOptions, stf.WithParallelExecution[T](workers))
+	}
+}
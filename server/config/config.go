@@ -22,6 +22,10 @@ const (
 	// DefaultGRPCAddress defines the default address to bind the gRPC server to.
 	DefaultGRPCAddress = "localhost:9090"
 
+	// DefaultLogAdminAddress defines the default address to bind the log admin
+	// endpoint to.
+	DefaultLogAdminAddress = "localhost:1319"
+
 	// DefaultGRPCMaxRecvMsgSize defines the default gRPC max message size in
 	// bytes the server can receive.
 	DefaultGRPCMaxRecvMsgSize = 1024 * 1024 * 10
@@ -162,6 +166,16 @@ type MempoolConfig struct {
 	MaxTxs int `mapstructure:"max-txs"`
 }
 
+// LogAdminConfig defines configuration for the local log admin endpoint, which
+// reads and changes the log level of each module at runtime.
+type LogAdminConfig struct {
+	// Enable defines if the log admin endpoint should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the log admin endpoint address to bind to.
+	Address string `mapstructure:"address"`
+}
+
 // State Streaming configuration
 type (
 	// StreamingConfig defines application configuration for external streaming services
@@ -187,6 +201,7 @@ type Config struct {
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
 	Streaming StreamingConfig  `mapstructure:"streaming"`
 	Mempool   MempoolConfig    `mapstructure:"mempool"`
+	LogAdmin  LogAdminConfig   `mapstructure:"log-admin"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -210,6 +225,7 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 
 // DefaultConfig returns server's default configuration.
 func DefaultConfig() *Config {
+	otelTraceSampleRatio := 1.0
 	return &Config{
 		BaseConfig: BaseConfig{
 			MinGasPrices:        defaultMinGasPrices,
@@ -225,8 +241,10 @@ func DefaultConfig() *Config {
 			AppDBBackend:        "",
 		},
 		Telemetry: telemetry.Config{
-			Enabled:      false,
-			GlobalLabels: [][]string{},
+			Enabled:              false,
+			GlobalLabels:         [][]string{},
+			OTelTraceSampleRatio: &otelTraceSampleRatio,
+			OTelMetricsInterval:  60,
 		},
 		API: APIConfig{
 			Enable:             false,
@@ -255,6 +273,10 @@ func DefaultConfig() *Config {
 		Mempool: MempoolConfig{
 			MaxTxs: -1,
 		},
+		LogAdmin: LogAdminConfig{
+			Enable:  false,
+			Address: DefaultLogAdminAddress,
+		},
 	}
 }
 
@@ -277,6 +299,9 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	if ratio := c.Telemetry.OTelTraceSampleRatio; ratio != nil && (*ratio < 0 || *ratio > 1) {
+		return sdkerrors.ErrAppConfig.Wrapf("otel-trace-sample-ratio must be between 0 and 1, got %f", *ratio)
+	}
 
 	return nil
 }
@@ -121,6 +121,24 @@ statsd-addr = "{{ .Telemetry.StatsdAddr }}"
 # Datadog. Only utilized if MetricsSink is set to "dogstatsd".
 datadog-hostname = "{{ .Telemetry.DatadogHostname }}"
 
+# OTelEnabled enables the OpenTelemetry provider. When enabled, spans and
+# metrics are exported to an OTLP gRPC endpoint.
+otel-enabled = {{ .Telemetry.OTelEnabled }}
+
+# OTelEndpoint defines the host:port of the OTLP gRPC endpoint. When empty,
+# the OTEL_EXPORTER_OTLP_* environment variables or the exporters defaults are used.
+otel-endpoint = "{{ .Telemetry.OTelEndpoint }}"
+
+# OTelInsecure disables the client transport security of the OTLP exporters.
+otel-insecure = {{ .Telemetry.OTelInsecure }}
+
+# OTelTraceSampleRatio defines the ratio of traces which are sampled, between 0 and 1.
+# When not set, all traces are sampled.
+{{ with .Telemetry.OTelTraceSampleRatio }}otel-trace-sample-ratio = {{ . }}{{ else }}# otel-trace-sample-ratio = 1{{ end }}
+
+# OTelMetricsInterval defines the interval in seconds at which metrics are exported.
+otel-metrics-interval = {{ .Telemetry.OTelMetricsInterval }}
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -223,4 +241,20 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 #
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
-max-txs = {{ .Mempool.MaxTxs }}
\ No newline at end of file
+max-txs = {{ .Mempool.MaxTxs }}
+
+###############################################################################
+###                           Log Admin Configuration                       ###
+###############################################################################
+
+# The log admin endpoint allows to read and change the log level of each module
+# while the node is running, e.g.:
+#   curl -X PUT -d '{"bank": "debug"}' http://localhost:1319/log/levels
+[log-admin]
+
+# Enable defines if the log admin endpoint should be enabled.
+enable = {{ .LogAdmin.Enable }}
+
+# Address defines the log admin endpoint address to bind to.
+# It must only be reachable locally, as it lets anyone change what the node logs.
+address = "{{ .LogAdmin.Address }}"
@@ -213,6 +213,33 @@ func TestGlobalLabelsWriteRead(t *testing.T) {
 	require.Equal(t, expected, actual, "config value")
 }
 
+func TestOTelTraceSampleRatioWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	ratio := 0.0
+	conf.Telemetry.OTelTraceSampleRatio = &ratio
+	require.NoError(t, WriteConfigFile(confFile, conf))
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig())
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err)
+	// a zero ratio is kept, sampling no trace
+	require.NotNil(t, cfg.Telemetry.OTelTraceSampleRatio)
+	require.Zero(t, *cfg.Telemetry.OTelTraceSampleRatio)
+
+	// without a ratio, the default one samples all traces
+	conf.Telemetry.OTelTraceSampleRatio = nil
+	require.NoError(t, WriteConfigFile(confFile, conf))
+	vpr = viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig())
+	cfg, err = ParseConfig(vpr)
+	require.NoError(t, err)
+	require.Equal(t, DefaultConfig().Telemetry.OTelTraceSampleRatio, cfg.Telemetry.OTelTraceSampleRatio)
+}
+
 func TestSetConfigTemplate(t *testing.T) {
 	conf := DefaultConfig()
 	var initBuffer, setBuffer bytes.Buffer
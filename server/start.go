@@ -5,9 +5,11 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"os"
 	"path/filepath"
 	"runtime/pprof"
@@ -235,6 +237,18 @@ func start[T types.Application](svrCtx *Context, clientCtx client.Context, appCr
 		return err
 	}
 
+	otelProvider, err := telemetry.NewOTelProvider(context.Background(), svrCfg.Telemetry)
+	if err != nil {
+		return err
+	}
+	if otelProvider != nil {
+		defer func() {
+			if err := otelProvider.Shutdown(context.Background()); err != nil {
+				svrCtx.Logger.Error("failed to shutdown the OpenTelemetry provider", "err", err)
+			}
+		}()
+	}
+
 	emitServerInfoMetrics()
 
 	if !withCmt {
@@ -287,6 +301,10 @@ func startStandAlone[T types.Application](svrCtx *Context, svrCfg serverconfig.C
 		return err
 	}
 
+	if err := startLogAdminServer(ctx, g, svrCfg.LogAdmin, svrCtx); err != nil {
+		return err
+	}
+
 	if opts.PostSetupStandalone != nil {
 		if err := opts.PostSetupStandalone(app, svrCtx, clientCtx, ctx, g); err != nil {
 			return err
@@ -353,6 +371,10 @@ func startInProcess[T types.Application](svrCtx *Context, svrCfg serverconfig.Co
 		return err
 	}
 
+	if err := startLogAdminServer(ctx, g, svrCfg.LogAdmin, svrCtx); err != nil {
+		return err
+	}
+
 	if opts.PostSetup != nil {
 		if err := opts.PostSetup(app, svrCtx, clientCtx, ctx, g); err != nil {
 			return err
@@ -566,6 +588,49 @@ func startAPIServer(
 	return nil
 }
 
+// startLogAdminServer starts, if enabled, the HTTP endpoint reading and changing
+// the log level of each module of the server logger.
+func startLogAdminServer(ctx context.Context, g *errgroup.Group, cfg serverconfig.LogAdminConfig, svrCtx *Context) error {
+	if !cfg.Enable {
+		return nil
+	}
+
+	provider, ok := svrCtx.Logger.(log.LevelRegistryProvider)
+	if !ok || provider.LevelRegistry() == nil {
+		return errors.New("log admin endpoint requires a logger created with a level registry")
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/log/levels", log.NewLevelRegistryHandler(provider.LevelRegistry()))
+	srv := &http.Server{
+		Addr:              cfg.Address,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	logger := svrCtx.Logger.With(log.ModuleKey, "log-admin")
+	g.Go(func() error {
+		errCh := make(chan error, 1)
+		go func() {
+			logger.Info("starting log admin server...", "address", cfg.Address)
+			errCh <- srv.ListenAndServe()
+		}()
+
+		select {
+		case <-ctx.Done():
+			logger.Info("stopping log admin server...", "address", cfg.Address)
+			return srv.Shutdown(context.Background())
+		case err := <-errCh:
+			if errors.Is(err, http.ErrServerClosed) {
+				return nil
+			}
+			return fmt.Errorf("failed to start log admin server: %w", err)
+		}
+	})
+
+	return nil
+}
+
 func startTelemetry(cfg serverconfig.Config) (*telemetry.Metrics, error) {
 	return telemetry.New(cfg.Telemetry)
 }
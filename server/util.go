@@ -17,7 +17,6 @@ import (
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
-	"github.com/rs/zerolog"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -182,25 +181,13 @@ func CreateSDKLogger(ctx *Context, out io.Writer) (log.Logger, error) {
 		// We use CometBFT flag (cmtcli.TraceFlag) for trace logging.
 		log.TraceOption(ctx.Viper.GetBool(FlagTrace)))
 
-	// check and set filter level or keys for the logger if any
-	logLvlStr := ctx.Viper.GetString(flags.FlagLogLevel)
-	if logLvlStr == "" {
-		return log.NewLogger(out, opts...), nil
-	}
-
-	logLvl, err := zerolog.ParseLevel(logLvlStr)
-	switch {
-	case err != nil:
-		// If the log level is not a valid zerolog level, then we try to parse it as a key filter.
-		filterFunc, err := log.ParseLogLevel(logLvlStr)
-		if err != nil {
-			return nil, err
-		}
-
-		opts = append(opts, log.FilterOption(filterFunc))
-	default:
-		opts = append(opts, log.LevelOption(logLvl))
+	// the levels are held in a registry so that they can be changed at runtime
+	// through the log admin endpoint
+	levels, err := log.NewLevelRegistry(ctx.Viper.GetString(flags.FlagLogLevel))
+	if err != nil {
+		return nil, err
 	}
+	opts = append(opts, log.LevelRegistryOption(levels))
 
 	return log.NewLogger(out, opts...), nil
 }
@@ -1,6 +1,7 @@
 package server_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -12,10 +13,13 @@ import (
 
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/log"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
@@ -486,6 +490,33 @@ func TestEmptyMinGasPrices(t *testing.T) {
 	require.Errorf(t, err, sdkerrors.ErrAppConfig.Error())
 }
 
+func TestCreateSDKLoggerLevelRegistry(t *testing.T) {
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Viper.Set(flags.FlagLogLevel, "bank:debug,*:error")
+
+	out := new(bytes.Buffer)
+	logger, err := server.CreateSDKLogger(serverCtx, out)
+	require.NoError(t, err)
+
+	provider, ok := logger.(log.LevelRegistryProvider)
+	require.True(t, ok)
+	levels := provider.LevelRegistry()
+	require.NotNil(t, levels)
+
+	logger.With(log.ModuleKey, "bank").Debug("bank debug")
+	logger.With(log.ModuleKey, "staking").Info("staking info")
+	require.Contains(t, out.String(), "bank debug")
+	require.NotContains(t, out.String(), "staking info")
+
+	levels.SetLevel("staking", zerolog.InfoLevel)
+	logger.With(log.ModuleKey, "staking").Info("staking info")
+	require.Contains(t, out.String(), "staking info")
+
+	serverCtx.Viper.Set(flags.FlagLogLevel, "bank:foo")
+	_, err = server.CreateSDKLogger(serverCtx, out)
+	require.Error(t, err)
+}
+
 type mapGetter map[string]interface{}
 
 func (m mapGetter) Get(key string) interface{} {
@@ -0,0 +1,35 @@
+package graphql
+
+func DefaultConfig() *Config {
+	return &Config{
+		Enable:   false,
+		Address:  "localhost:1318",
+		MaxLimit: 100,
+	}
+}
+
+type CfgOption func(*Config)
+
+// Config defines configuration for the GraphQL server.
+type Config struct {
+	// Enable defines if the GraphQL server should be enabled.
+	Enable bool `mapstructure:"enable" toml:"enable" comment:"Enable defines if the GraphQL server should be enabled."`
+	// Address defines the GraphQL server address to bind to.
+	Address string `mapstructure:"address" toml:"address" comment:"Address defines the GraphQL server address to bind to."`
+	// MaxLimit is the maximum number of objects returned by a list query.
+	MaxLimit int `mapstructure:"max-limit" toml:"max-limit" comment:"MaxLimit is the maximum number of objects returned by a list query."`
+}
+
+// OverwriteDefaultConfig overwrites the default config with the new config.
+func OverwriteDefaultConfig(newCfg *Config) CfgOption {
+	return func(cfg *Config) {
+		*cfg = *newCfg
+	}
+}
+
+// Enable the GraphQL server by default (default disabled).
+func Enable() CfgOption {
+	return func(cfg *Config) {
+		cfg.Enable = true
+	}
+}
@@ -0,0 +1,124 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+
+	"cosmossdk.io/schema/view"
+)
+
+const (
+	// Path is the path of the GraphQL endpoint.
+	Path = "/graphql"
+
+	ContentTypeJSON        = "application/json"
+	ContentTypeEventStream = "text/event-stream"
+	MaxBodySize            = 1 << 20 // 1 MB
+)
+
+// Request is a GraphQL request.
+type Request struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+// handler serves the GraphQL requests, as JSON in the body of POST requests
+// or in the query parameters of GET requests. Subscriptions are served as
+// server-sent events to the requests accepting text/event-stream: a "next"
+// event for each result and a "complete" event at the end.
+type handler struct {
+	schema graphql.Schema
+	data   view.AppData
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	req, err := parseRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	blockNum, err := h.data.BlockNum()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to get block number: %v", err), http.StatusInternalServerError)
+		return
+	}
+	params := graphql.Params{
+		Schema:         h.schema,
+		RequestString:  req.Query,
+		VariableValues: req.Variables,
+		OperationName:  req.OperationName,
+		RootObject:     map[string]interface{}{snapshotKey: &snapshot{blockNum: blockNum, state: h.data.AppState()}},
+		Context:        r.Context(),
+	}
+
+	if strings.Contains(r.Header.Get("Accept"), ContentTypeEventStream) {
+		h.stream(w, params)
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentTypeJSON)
+	_ = json.NewEncoder(w).Encode(graphql.Do(params))
+}
+
+// stream writes the results of a subscription as server-sent events.
+func (h *handler) stream(w http.ResponseWriter, params graphql.Params) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentTypeEventStream)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for result := range graphql.Subscribe(params) {
+		bz, err := json.Marshal(result)
+		if err != nil {
+			bz, _ = json.Marshal(map[string]interface{}{"errors": []map[string]string{{"message": err.Error()}}})
+		}
+		fmt.Fprintf(w, "event: next\ndata: %s\n\n", bz)
+		flusher.Flush()
+	}
+	fmt.Fprint(w, "event: complete\ndata:\n\n")
+	flusher.Flush()
+}
+
+// parseRequest returns the GraphQL request of r.
+func parseRequest(r *http.Request) (*Request, error) {
+	req := &Request{}
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		req.Query = query.Get("query")
+		req.OperationName = query.Get("operationName")
+		if variables := query.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				return nil, fmt.Errorf("invalid variables: %w", err)
+			}
+		}
+	case http.MethodPost:
+		defer r.Body.Close()
+		if err := json.NewDecoder(io.LimitReader(r.Body, MaxBodySize)).Decode(req); err != nil {
+			return nil, fmt.Errorf("invalid request body: %w", err)
+		}
+	}
+
+	if req.Query == "" {
+		return nil, fmt.Errorf("missing query")
+	}
+	return req, nil
+}
@@ -0,0 +1,200 @@
+package mock
+
+import (
+	"fmt"
+	"sync"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/view"
+)
+
+var (
+	_ view.AppData          = (*View)(nil)
+	_ view.AppState         = (*View)(nil)
+	_ view.ModuleState      = (*Module)(nil)
+	_ view.ObjectCollection = (*collection)(nil)
+)
+
+// View is an in-memory view.AppData, which is also its own view.AppState.
+// The modules and their objects are returned in the order they were added.
+type View struct {
+	mu       sync.RWMutex
+	blockNum uint64
+	modules  []*Module
+}
+
+// NewView returns an empty view at block 0.
+func NewView() *View {
+	return &View{}
+}
+
+// AddModule adds a module with the given schema and returns it.
+func (v *View) AddModule(name string, moduleSchema schema.ModuleSchema) *Module {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	m := &Module{view: v, name: name, schema: moduleSchema, collections: map[string]*collection{}}
+	moduleSchema.StateObjectTypes(func(typ schema.StateObjectType) bool {
+		m.collections[typ.Name] = &collection{module: m, typ: typ, index: map[string]int{}}
+		return true
+	})
+	v.modules = append(v.modules, m)
+	return m
+}
+
+// Commit increments the block number and returns it.
+func (v *View) Commit() uint64 {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.blockNum++
+	return v.blockNum
+}
+
+func (v *View) BlockNum() (uint64, error) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.blockNum, nil
+}
+
+func (v *View) AppState() view.AppState {
+	return v
+}
+
+func (v *View) GetModule(moduleName string) (view.ModuleState, error) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	for _, m := range v.modules {
+		if m.name == moduleName {
+			return m, nil
+		}
+	}
+	return nil, nil
+}
+
+func (v *View) Modules(f func(modState view.ModuleState, err error) bool) {
+	v.mu.RLock()
+	modules := append([]*Module(nil), v.modules...)
+	v.mu.RUnlock()
+	for _, m := range modules {
+		if !f(m, nil) {
+			return
+		}
+	}
+}
+
+func (v *View) NumModules() (int, error) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return len(v.modules), nil
+}
+
+// Module is the state of a module of a View.
+type Module struct {
+	view        *View
+	name        string
+	schema      schema.ModuleSchema
+	collections map[string]*collection
+}
+
+// Set applies updates to the objects of the module: an update either sets an
+// object or deletes it. The updates are validated against the module schema.
+func (m *Module) Set(updates ...schema.StateObjectUpdate) error {
+	m.view.mu.Lock()
+	defer m.view.mu.Unlock()
+
+	for _, update := range updates {
+		if err := m.schema.ValidateObjectUpdate(update); err != nil {
+			return err
+		}
+		m.collections[update.TypeName].apply(update)
+	}
+	return nil
+}
+
+func (m *Module) ModuleName() string {
+	return m.name
+}
+
+func (m *Module) ModuleSchema() schema.ModuleSchema {
+	return m.schema
+}
+
+func (m *Module) GetObjectCollection(objectType string) (view.ObjectCollection, error) {
+	coll, ok := m.collections[objectType]
+	if !ok {
+		return nil, nil
+	}
+	return coll, nil
+}
+
+func (m *Module) ObjectCollections(f func(value view.ObjectCollection, err error) bool) {
+	m.schema.StateObjectTypes(func(typ schema.StateObjectType) bool {
+		return f(m.collections[typ.Name], nil)
+	})
+}
+
+func (m *Module) NumObjectCollections() (int, error) {
+	return len(m.collections), nil
+}
+
+// collection is the collection of the objects of a type, in the order they
+// were first set. index maps the formatted keys to the positions of the
+// objects.
+type collection struct {
+	module  *Module
+	typ     schema.StateObjectType
+	objects []schema.StateObjectUpdate
+	index   map[string]int
+}
+
+func (c *collection) apply(update schema.StateObjectUpdate) {
+	key := fmt.Sprintf("%v", update.Key)
+	i, found := c.index[key]
+	switch {
+	case update.Delete && found:
+		c.objects = append(c.objects[:i], c.objects[i+1:]...)
+		delete(c.index, key)
+		for k, j := range c.index {
+			if j > i {
+				c.index[k] = j - 1
+			}
+		}
+	case update.Delete:
+	case found:
+		c.objects[i] = update
+	default:
+		c.index[key] = len(c.objects)
+		c.objects = append(c.objects, update)
+	}
+}
+
+func (c *collection) ObjectType() schema.StateObjectType {
+	return c.typ
+}
+
+func (c *collection) GetObject(key interface{}) (update schema.StateObjectUpdate, found bool, err error) {
+	c.module.view.mu.RLock()
+	defer c.module.view.mu.RUnlock()
+	i, found := c.index[fmt.Sprintf("%v", key)]
+	if !found {
+		return schema.StateObjectUpdate{}, false, nil
+	}
+	return c.objects[i], true, nil
+}
+
+func (c *collection) AllState(f func(schema.StateObjectUpdate, error) bool) {
+	c.module.view.mu.RLock()
+	objects := append([]schema.StateObjectUpdate(nil), c.objects...)
+	c.module.view.mu.RUnlock()
+	for _, object := range objects {
+		if !f(object, nil) {
+			return
+		}
+	}
+}
+
+func (c *collection) Len() (int, error) {
+	c.module.view.mu.RLock()
+	defer c.module.view.mu.RUnlock()
+	return len(c.objects), nil
+}
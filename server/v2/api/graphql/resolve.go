@@ -0,0 +1,307 @@
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/view"
+)
+
+// The operators of the filter conditions.
+const (
+	opEq     = ""
+	opNe     = "_ne"
+	opIn     = "_in"
+	opGt     = "_gt"
+	opGte    = "_gte"
+	opLt     = "_lt"
+	opLte    = "_lte"
+	opIsNull = "_is_null"
+)
+
+// filterArg is the condition of a field of a filter.
+type filterArg struct {
+	field schema.Field
+	op    string
+}
+
+// filter is a parsed filter: an object matches it if it matches all its
+// conditions and and filters, and one of its or filters if any.
+type filter struct {
+	conds []condition
+	and   []*filter
+	or    []*filter
+}
+
+type condition struct {
+	filterArg
+	// value is the operand of the condition, a list for opIn.
+	value interface{}
+}
+
+// filterType returns the GraphQL input type of the filters of objType, with a
+// field for each condition on its fields, and the and and or fields combining
+// other filters.
+func (b *schemaBuilder) filterType(typeName string, objType *objectType) *graphql.InputObject {
+	objType.filterArgs = map[string]filterArg{}
+	var filterType *graphql.InputObject
+	fields := graphql.InputObjectConfigFieldMap{}
+	for _, field := range objType.fields {
+		if !isFilterable(field.Kind) {
+			continue
+		}
+
+		ops := []string{opEq, opNe, opIn}
+		if isOrdered(field.Kind) {
+			ops = append(ops, opGt, opGte, opLt, opLte)
+		}
+		if field.Nullable {
+			ops = append(ops, opIsNull)
+		}
+
+		typ := objType.fieldTypes[field.Name]
+		for _, op := range ops {
+			var argType graphql.Input = typ
+			switch op {
+			case opIn:
+				argType = graphql.NewList(graphql.NewNonNull(typ))
+			case opIsNull:
+				argType = graphql.Boolean
+			}
+			name := gqlName(field.Name) + op
+			fields[name] = &graphql.InputObjectFieldConfig{Type: argType}
+			objType.filterArgs[name] = filterArg{field: field, op: op}
+		}
+	}
+
+	filterType = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: typeName + "_filter",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			list := graphql.NewList(graphql.NewNonNull(filterType))
+			fields["and"] = &graphql.InputObjectFieldConfig{Type: list, Description: "Filters that must all match."}
+			fields["or"] = &graphql.InputObjectFieldConfig{Type: list, Description: "Filters of which one must match."}
+			return fields
+		}),
+	})
+	return filterType
+}
+
+// parseFilter parses the filter argument of a list field of objType.
+func (b *schemaBuilder) parseFilter(objType *objectType, arg map[string]interface{}) (*filter, error) {
+	f := &filter{}
+	for name, value := range arg {
+		if name == "and" || name == "or" {
+			for _, sub := range value.([]interface{}) {
+				subFilter, err := b.parseFilter(objType, sub.(map[string]interface{}))
+				if err != nil {
+					return nil, err
+				}
+				if name == "and" {
+					f.and = append(f.and, subFilter)
+				} else {
+					f.or = append(f.or, subFilter)
+				}
+			}
+			continue
+		}
+
+		fa, ok := objType.filterArgs[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown filter field %q", name)
+		}
+		cond := condition{filterArg: fa, value: value}
+		var err error
+		switch fa.op {
+		case opIsNull:
+		case opIn:
+			if value == nil {
+				break
+			}
+			values := value.([]interface{})
+			in := make([]interface{}, len(values))
+			for i, v := range values {
+				if in[i], err = b.codec.fromGraphQL(fa.field, v); err != nil {
+					break
+				}
+			}
+			cond.value = in
+		default:
+			cond.value, err = b.codec.fromGraphQL(fa.field, value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter %s: %w", name, err)
+		}
+		f.conds = append(f.conds, cond)
+	}
+	return f, nil
+}
+
+// matches reports whether the object of the field values row matches f.
+func (f *filter) matches(row map[string]interface{}) bool {
+	for _, cond := range f.conds {
+		if !cond.matches(row[cond.field.Name]) {
+			return false
+		}
+	}
+	for _, sub := range f.and {
+		if !sub.matches(row) {
+			return false
+		}
+	}
+	if len(f.or) == 0 {
+		return true
+	}
+	for _, sub := range f.or {
+		if sub.matches(row) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches reports whether value matches the condition. A null operand only
+// matches null values.
+func (c condition) matches(value interface{}) bool {
+	if c.op == opIsNull {
+		return c.value == nil || (value == nil) == c.value.(bool)
+	}
+	if c.value == nil {
+		return (value == nil) == (c.op != opNe)
+	}
+	if value == nil {
+		return c.op == opNe
+	}
+
+	switch c.op {
+	case opIn:
+		for _, v := range c.value.([]interface{}) {
+			if compareValues(c.field, value, v) == 0 {
+				return true
+			}
+		}
+		return false
+	}
+
+	cmp := compareValues(c.field, value, c.value)
+	switch c.op {
+	case opEq:
+		return cmp == 0
+	case opNe:
+		return cmp != 0
+	case opGt:
+		return cmp > 0
+	case opGte:
+		return cmp >= 0
+	case opLt:
+		return cmp < 0
+	case opLte:
+		return cmp <= 0
+	default:
+		return false
+	}
+}
+
+// list returns the page of the objects of coll matching the filter of args.
+func (b *schemaBuilder) list(coll view.ObjectCollection, objType *objectType, args map[string]interface{}) (map[string]interface{}, error) {
+	limit, _ := args["limit"].(int)
+	offset, _ := args["offset"].(int)
+	if limit < 0 || limit > b.maxLimit {
+		return nil, fmt.Errorf("limit must be between 0 and %d", b.maxLimit)
+	}
+	if offset < 0 {
+		return nil, fmt.Errorf("offset must not be negative")
+	}
+
+	f := &filter{}
+	if arg, ok := args["filter"].(map[string]interface{}); ok {
+		var err error
+		if f, err = b.parseFilter(objType, arg); err != nil {
+			return nil, err
+		}
+	}
+
+	items := []interface{}{}
+	total := 0
+	var err error
+	if coll != nil {
+		coll.AllState(func(update schema.StateObjectUpdate, updateErr error) bool {
+			if updateErr != nil {
+				err = updateErr
+				return false
+			}
+
+			var row map[string]interface{}
+			if row, err = decodeRow(objType.typ, update); err != nil {
+				return false
+			}
+			if !f.matches(row) {
+				return true
+			}
+
+			total++
+			if total > offset && len(items) < limit {
+				var out map[string]interface{}
+				if out, err = b.output(objType, row, update.Delete); err != nil {
+					return false
+				}
+				items = append(items, out)
+			}
+			return true
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"items":         items,
+		"total_count":   total,
+		"has_next_page": offset+len(items) < total,
+	}, nil
+}
+
+// decodeRow returns the field values of the object of update.
+func decodeRow(typ schema.StateObjectType, update schema.StateObjectUpdate) (map[string]interface{}, error) {
+	row := make(map[string]interface{}, len(typ.KeyFields)+len(typ.ValueFields))
+	if err := setFields(row, typ.KeyFields, update.Key); err != nil {
+		return nil, fmt.Errorf("invalid key of %s object: %w", typ.Name, err)
+	}
+
+	if valueUpdates, ok := update.Value.(schema.ValueUpdates); ok {
+		err := valueUpdates.Iterate(func(col string, value interface{}) bool {
+			row[col] = value
+			return true
+		})
+		return row, err
+	}
+	if err := setFields(row, typ.ValueFields, update.Value); err != nil {
+		return nil, fmt.Errorf("invalid value of %s object: %w", typ.Name, err)
+	}
+	return row, nil
+}
+
+// setFields sets the values of fields in row from value, a single value for a
+// single field and a slice otherwise.
+func setFields(row map[string]interface{}, fields []schema.Field, value interface{}) error {
+	switch len(fields) {
+	case 0:
+		return nil
+	case 1:
+		row[fields[0].Name] = value
+		return nil
+	}
+
+	if value == nil {
+		return nil
+	}
+	values, ok := value.([]interface{})
+	if !ok || len(values) != len(fields) {
+		return fmt.Errorf("expected %d values, got %v", len(fields), value)
+	}
+	for i, field := range fields {
+		row[field.Name] = values[i]
+	}
+	return nil
+}
@@ -0,0 +1,361 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/view"
+)
+
+const (
+	// listSuffix is the suffix of the fields listing the objects of a type.
+	listSuffix = "_list"
+	// deletedField is the field flagging the deleted objects of the types
+	// retaining their deletions.
+	deletedField = "_deleted"
+	// snapshotKey is the key of the snapshot in the root object of the queries.
+	snapshotKey = "snapshot"
+)
+
+// snapshot is the state resolved by a query: the state of the view, or of a
+// block committed during a subscription.
+type snapshot struct {
+	blockNum uint64
+	state    view.AppState
+}
+
+// snapshotFrom returns the snapshot of the root fields: the root object of
+// queries, or the payload of subscriptions.
+func snapshotFrom(source interface{}) (*snapshot, error) {
+	switch s := source.(type) {
+	case *snapshot:
+		return s, nil
+	case map[string]interface{}:
+		if snap, ok := s[snapshotKey].(*snapshot); ok {
+			return snap, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected root value %T", source)
+}
+
+// objectType is a state object type of a module and its GraphQL type.
+type objectType struct {
+	typ     schema.StateObjectType
+	fields  []schema.Field
+	gqlType *graphql.Object
+	// fieldTypes maps the fields to the GraphQL type of their values.
+	fieldTypes map[string]graphql.Output
+	// filterArgs maps the fields of the filter of the type to their condition.
+	filterArgs map[string]filterArg
+}
+
+// schemaBuilder generates the GraphQL schema of the module schemas.
+type schemaBuilder struct {
+	codec    valueCodec
+	maxLimit int
+	// subscribe returns the snapshots of the blocks committed until ctx is
+	// done. The schema has no Subscription type if it is nil.
+	subscribe func(ctx context.Context) chan interface{}
+
+	enums map[string]*graphql.Enum
+}
+
+// build returns the GraphQL schema of the modules of state. The Query type has
+// a field for each module, itself with a field for each object type of the
+// module: one returning the object of a key, and one listing the objects
+// matching a filter. The Subscription type has an on_commit field returning
+// the Query type at each committed block.
+func (b *schemaBuilder) build(state view.AppState) (graphql.Schema, error) {
+	b.enums = map[string]*graphql.Enum{}
+	queryFields := graphql.Fields{
+		"block_num": {
+			Type:        graphql.NewNonNull(graphql.String),
+			Description: "The height of the block of the state queried.",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				snap, err := snapshotFrom(p.Source)
+				if err != nil {
+					return nil, err
+				}
+				return strconv.FormatUint(snap.blockNum, 10), nil
+			},
+		},
+	}
+
+	var err error
+	state.Modules(func(modState view.ModuleState, modErr error) bool {
+		if modErr != nil {
+			err = modErr
+			return false
+		}
+		var field *graphql.Field
+		field, err = b.moduleField(modState.ModuleName(), modState.ModuleSchema())
+		if err != nil {
+			return false
+		}
+		if field != nil {
+			queryFields[gqlName(modState.ModuleName())] = field
+		}
+		return true
+	})
+	if err != nil {
+		return graphql.Schema{}, err
+	}
+
+	query := graphql.NewObject(graphql.ObjectConfig{Name: "Query", Fields: queryFields})
+	if b.subscribe == nil {
+		return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+	}
+	subscription := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Subscription",
+		Fields: graphql.Fields{
+			"on_commit": {
+				Type:        graphql.NewNonNull(query),
+				Description: "Emits the state of each committed block.",
+				Subscribe: func(p graphql.ResolveParams) (interface{}, error) {
+					return b.subscribe(p.Context), nil
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source, nil
+				},
+			},
+		},
+	})
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query, Subscription: subscription})
+}
+
+// moduleField returns the field of a module in the Query type, or nil if the
+// module has no object types.
+func (b *schemaBuilder) moduleField(moduleName string, modSchema schema.ModuleSchema) (*graphql.Field, error) {
+	prefix := gqlName(moduleName) + "_"
+	fields := graphql.Fields{}
+
+	var err error
+	modSchema.StateObjectTypes(func(typ schema.StateObjectType) bool {
+		objType := &objectType{
+			typ:    typ,
+			fields: append(append([]schema.Field{}, typ.KeyFields...), typ.ValueFields...),
+		}
+		if err = b.buildObjectType(prefix, moduleName, modSchema, objType); err != nil {
+			return false
+		}
+
+		name := gqlName(typ.Name)
+		fields[name] = b.getField(objType)
+		if len(typ.KeyFields) > 0 {
+			fields[name+listSuffix] = b.listField(prefix, objType)
+		}
+		return true
+	})
+	if err != nil || len(fields) == 0 {
+		return nil, err
+	}
+
+	return &graphql.Field{
+		Type:        graphql.NewObject(graphql.ObjectConfig{Name: gqlName(moduleName), Fields: fields}),
+		Description: fmt.Sprintf("The state of the %s module.", moduleName),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			snap, err := snapshotFrom(p.Source)
+			if err != nil {
+				return nil, err
+			}
+			modState, err := snap.state.GetModule(moduleName)
+			if err != nil || modState == nil {
+				return nil, err
+			}
+			return modState, nil
+		},
+	}, nil
+}
+
+// buildObjectType sets the GraphQL type of objType, with a field for each of
+// its key and value fields.
+func (b *schemaBuilder) buildObjectType(prefix, moduleName string, modSchema schema.ModuleSchema, objType *objectType) error {
+	fields := graphql.Fields{}
+	objType.fieldTypes = map[string]graphql.Output{}
+	for _, field := range objType.fields {
+		typ, err := b.fieldType(prefix, modSchema, field)
+		if err != nil {
+			return fmt.Errorf("module %s, object type %s: %w", moduleName, objType.typ.Name, err)
+		}
+		objType.fieldTypes[field.Name] = typ
+		if !field.Nullable {
+			typ = graphql.NewNonNull(typ)
+		}
+		fields[gqlName(field.Name)] = &graphql.Field{Type: typ}
+	}
+	if objType.typ.RetainDeletions {
+		fields[deletedField] = &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.Boolean),
+			Description: "Whether the object was deleted from state.",
+		}
+	}
+
+	objType.gqlType = graphql.NewObject(graphql.ObjectConfig{Name: prefix + gqlName(objType.typ.Name), Fields: fields})
+	return nil
+}
+
+// fieldType returns the GraphQL type of the values of field.
+func (b *schemaBuilder) fieldType(prefix string, modSchema schema.ModuleSchema, field schema.Field) (graphql.Output, error) {
+	if field.Kind != schema.EnumKind {
+		return scalarType(field.Kind), nil
+	}
+
+	name := prefix + gqlName(field.ReferencedType)
+	if enum, ok := b.enums[name]; ok {
+		return enum, nil
+	}
+
+	enumType, ok := modSchema.LookupEnumType(field.ReferencedType)
+	if !ok {
+		return nil, fmt.Errorf("unknown enum type %q of field %q", field.ReferencedType, field.Name)
+	}
+	values := graphql.EnumValueConfigMap{}
+	for _, value := range enumType.Values {
+		values[gqlName(value.Name)] = &graphql.EnumValueConfig{Value: value.Name}
+	}
+	enum := graphql.NewEnum(graphql.EnumConfig{Name: name, Values: values})
+	b.enums[name] = enum
+	return enum, nil
+}
+
+// getField returns the field returning the object of a key, with an argument
+// for each key field, or the object of a singleton.
+func (b *schemaBuilder) getField(objType *objectType) *graphql.Field {
+	args := graphql.FieldConfigArgument{}
+	for _, field := range objType.typ.KeyFields {
+		args[gqlName(field.Name)] = &graphql.ArgumentConfig{Type: graphql.NewNonNull(objType.fieldTypes[field.Name])}
+	}
+
+	return &graphql.Field{
+		Type:        objType.gqlType,
+		Args:        args,
+		Description: fmt.Sprintf("Returns the %s object of the key, if any.", objType.typ.Name),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			coll, err := objectCollection(p.Source, objType.typ.Name)
+			if err != nil || coll == nil {
+				return nil, err
+			}
+
+			key, err := b.keyFromArgs(objType.typ, p.Args)
+			if err != nil {
+				return nil, err
+			}
+			update, found, err := coll.GetObject(key)
+			if err != nil || !found {
+				return nil, err
+			}
+			row, err := decodeRow(objType.typ, update)
+			if err != nil {
+				return nil, err
+			}
+			return b.output(objType, row, update.Delete)
+		},
+	}
+}
+
+// listField returns the field listing the objects matching a filter, with
+// pagination.
+func (b *schemaBuilder) listField(prefix string, objType *objectType) *graphql.Field {
+	typeName := prefix + gqlName(objType.typ.Name)
+	page := graphql.NewObject(graphql.ObjectConfig{
+		Name: typeName + "_page",
+		Fields: graphql.Fields{
+			"items":         {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(objType.gqlType)))},
+			"total_count":   {Type: graphql.NewNonNull(graphql.Int), Description: "The number of objects matching the filter."},
+			"has_next_page": {Type: graphql.NewNonNull(graphql.Boolean)},
+		},
+	})
+
+	return &graphql.Field{
+		Type: graphql.NewNonNull(page),
+		Args: graphql.FieldConfigArgument{
+			"filter": {Type: b.filterType(typeName, objType)},
+			"limit": {
+				Type:         graphql.Int,
+				DefaultValue: b.maxLimit,
+				Description:  fmt.Sprintf("The maximum number of objects returned, at most %d.", b.maxLimit),
+			},
+			"offset": {Type: graphql.Int, DefaultValue: 0, Description: "The number of matching objects skipped."},
+		},
+		Description: fmt.Sprintf("Lists the %s objects matching the filter, in key order.", objType.typ.Name),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			coll, err := objectCollection(p.Source, objType.typ.Name)
+			if err != nil {
+				return nil, err
+			}
+			return b.list(coll, objType, p.Args)
+		},
+	}
+}
+
+// objectCollection returns the collection of the objects of typeName of the
+// module state source.
+func objectCollection(source interface{}, typeName string) (view.ObjectCollection, error) {
+	modState, ok := source.(view.ModuleState)
+	if !ok {
+		return nil, fmt.Errorf("unexpected module value %T", source)
+	}
+	return modState.GetObjectCollection(typeName)
+}
+
+// keyFromArgs returns the key of typ from the arguments of its key fields, in
+// the format of schema.StateObjectUpdate keys.
+func (b *schemaBuilder) keyFromArgs(typ schema.StateObjectType, args map[string]interface{}) (interface{}, error) {
+	values := make([]interface{}, len(typ.KeyFields))
+	for i, field := range typ.KeyFields {
+		value, err := b.codec.fromGraphQL(field, args[gqlName(field.Name)])
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument %s: %w", field.Name, err)
+		}
+		values[i] = value
+	}
+
+	switch len(values) {
+	case 0:
+		return nil, nil
+	case 1:
+		return values[0], nil
+	default:
+		return values, nil
+	}
+}
+
+// output returns the GraphQL value of an object.
+func (b *schemaBuilder) output(objType *objectType, row map[string]interface{}, deleted bool) (map[string]interface{}, error) {
+	out := make(map[string]interface{}, len(objType.fields)+1)
+	for _, field := range objType.fields {
+		value, err := b.codec.toGraphQL(field, row[field.Name])
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		out[gqlName(field.Name)] = value
+	}
+	if objType.typ.RetainDeletions {
+		out[deletedField] = deleted
+	}
+	return out, nil
+}
+
+// gqlName returns name with the characters not allowed in GraphQL names
+// replaced by underscores.
+func gqlName(name string) string {
+	var sb strings.Builder
+	for i, r := range name {
+		switch {
+		case r == '_', 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z':
+		case '0' <= r && r <= '9':
+			if i == 0 {
+				sb.WriteByte('_')
+			}
+		default:
+			r = '_'
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,153 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	"cosmossdk.io/schema/addressutil"
+	"cosmossdk.io/schema/view"
+	serverv2 "cosmossdk.io/server/v2"
+)
+
+var (
+	_ serverv2.ServerComponent[transaction.Tx] = (*Server[transaction.Tx])(nil)
+	_ serverv2.HasConfig                       = (*Server[transaction.Tx])(nil)
+)
+
+const ServerName = "graphql"
+
+// Server serves a GraphQL schema generated from the schemas of the modules of
+// a view, at Path. The view is either the live state of the application, see
+// NewStateView, or the view of an indexer.
+type Server[T transaction.Tx] struct {
+	logger     log.Logger
+	config     *Config
+	cfgOptions []CfgOption
+
+	httpServer *http.Server
+	// ctx is the context of the requests, canceled on Stop to end the
+	// subscriptions.
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// New creates a new GraphQL server of the state of data. The subscriptions are
+// notified of the committed blocks by notifier, which must be registered as a
+// streaming listener of the consensus server, and are not served if it is nil.
+// The addresses are encoded with addressCodec, or as hex strings if it is nil.
+func New[T transaction.Tx](
+	logger log.Logger,
+	data view.AppData,
+	notifier *CommitNotifier,
+	addressCodec addressutil.AddressCodec,
+	cfg server.ConfigMap,
+	cfgOptions ...CfgOption,
+) (*Server[T], error) {
+	srv := &Server[T]{
+		logger:     logger.With(log.ModuleKey, ServerName),
+		cfgOptions: cfgOptions,
+	}
+
+	serverCfg := srv.Config().(*Config)
+	if len(cfg) > 0 {
+		if err := serverv2.UnmarshalSubConfig(cfg, srv.Name(), &serverCfg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+		}
+	}
+	srv.config = serverCfg
+	if !srv.config.Enable {
+		return srv, nil
+	}
+
+	appState := data.AppState()
+	if appState == nil {
+		return nil, errors.New("the view does not provide the app state")
+	}
+	if addressCodec == nil {
+		addressCodec = addressutil.HexAddressCodec{}
+	}
+
+	builder := &schemaBuilder{
+		codec:    valueCodec{addressCodec: addressCodec},
+		maxLimit: srv.config.MaxLimit,
+	}
+	if notifier != nil {
+		builder.subscribe = func(ctx context.Context) chan interface{} {
+			return notifier.subscribe(ctx, data)
+		}
+	}
+	schema, err := builder.build(appState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build GraphQL schema: %w", err)
+	}
+
+	srv.ctx, srv.cancel = context.WithCancel(context.Background())
+	mux := http.NewServeMux()
+	mux.Handle(Path, &handler{schema: schema, data: data})
+	srv.httpServer = &http.Server{
+		Addr:              srv.config.Address,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return srv.ctx },
+	}
+	return srv, nil
+}
+
+// NewWithConfigOptions creates a new GraphQL server with the provided config options.
+// It is *not* a fully functional server (since it has been created without dependencies)
+// The returned server should only be used to get and set configuration.
+func NewWithConfigOptions[T transaction.Tx](opts ...CfgOption) *Server[T] {
+	return &Server[T]{
+		cfgOptions: opts,
+	}
+}
+
+func (s *Server[T]) Name() string {
+	return ServerName
+}
+
+func (s *Server[T]) Start(ctx context.Context) error {
+	if !s.config.Enable {
+		s.logger.Info(fmt.Sprintf("%s server is disabled via config", s.Name()))
+		return nil
+	}
+
+	s.logger.Info("starting GraphQL server", "address", s.config.Address)
+	if err := s.httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to start GraphQL server: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Server[T]) Stop(ctx context.Context) error {
+	if !s.config.Enable {
+		return nil
+	}
+
+	s.logger.Info("stopping GraphQL server")
+	// end the subscriptions, which would otherwise keep their connection open
+	s.cancel()
+	return s.httpServer.Shutdown(ctx)
+}
+
+func (s *Server[T]) Config() any {
+	if s.config == nil || s.config.Address == "" {
+		cfg := DefaultConfig()
+
+		for _, opt := range s.cfgOptions {
+			opt(cfg)
+		}
+
+		return cfg
+	}
+
+	return s.config
+}
@@ -0,0 +1,426 @@
+package graphql
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/server"
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/decoding"
+	"cosmossdk.io/schema/view"
+	"cosmossdk.io/server/v2/api/graphql/mock"
+	"cosmossdk.io/server/v2/streaming"
+)
+
+var bankSchema = schema.MustCompileModuleSchema(
+	schema.StateObjectType{
+		Name: "balances",
+		KeyFields: []schema.Field{
+			{Name: "address", Kind: schema.AddressKind},
+			{Name: "denom", Kind: schema.StringKind},
+		},
+		ValueFields: []schema.Field{{Name: "amount", Kind: schema.IntegerKind}},
+	},
+	schema.StateObjectType{
+		Name:        "supply",
+		KeyFields:   []schema.Field{{Name: "denom", Kind: schema.StringKind}},
+		ValueFields: []schema.Field{{Name: "amount", Kind: schema.IntegerKind}},
+	},
+	schema.StateObjectType{
+		Name: "params",
+		ValueFields: []schema.Field{
+			{Name: "status", Kind: schema.EnumKind, ReferencedType: "status"},
+			{Name: "memo", Kind: schema.StringKind, Nullable: true},
+		},
+	},
+	schema.EnumType{
+		Name: "status",
+		Values: []schema.EnumValueDefinition{
+			{Name: "active", Value: 1},
+			{Name: "paused", Value: 2},
+		},
+	},
+)
+
+// newTestView returns a view with a bank module holding the balances of two
+// accounts.
+func newTestView(t *testing.T) (*mock.View, *mock.Module) {
+	t.Helper()
+	v := mock.NewView()
+	bank := v.AddModule("bank", bankSchema)
+	require.NoError(t, bank.Set(
+		schema.StateObjectUpdate{TypeName: "balances", Key: []interface{}{[]byte{1}, "uatom"}, Value: "100"},
+		schema.StateObjectUpdate{TypeName: "balances", Key: []interface{}{[]byte{1}, "ustake"}, Value: "20"},
+		schema.StateObjectUpdate{TypeName: "balances", Key: []interface{}{[]byte{2}, "uatom"}, Value: "5"},
+		schema.StateObjectUpdate{TypeName: "supply", Key: "uatom", Value: "105"},
+		schema.StateObjectUpdate{TypeName: "params", Value: []interface{}{"active", nil}},
+	))
+	v.Commit()
+	return v, bank
+}
+
+func newTestServer(t *testing.T, data view.AppData, notifier *CommitNotifier) *Server[transaction.Tx] {
+	t.Helper()
+	cfg := server.ConfigMap{
+		ServerName: map[string]any{
+			"enable":    true,
+			"max-limit": 2,
+		},
+	}
+	srv, err := New[transaction.Tx](log.NewNopLogger(), data, notifier, nil, cfg)
+	require.NoError(t, err)
+	return srv
+}
+
+// query runs a GraphQL query against h and returns its data.
+func query(t *testing.T, h http.Handler, q string) (map[string]interface{}, []interface{}) {
+	t.Helper()
+	body, err := json.Marshal(Request{Query: q})
+	require.NoError(t, err)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, Path, strings.NewReader(string(body))))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var res struct {
+		Data   map[string]interface{} `json:"data"`
+		Errors []interface{}          `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	return res.Data, res.Errors
+}
+
+func TestQuery(t *testing.T) {
+	v, _ := newTestView(t)
+	h := newTestServer(t, v, nil).httpServer.Handler
+
+	data, errs := query(t, h, `{
+		block_num
+		bank {
+			balances(address: "0x01", denom: "uatom") { address denom amount }
+			missing: balances(address: "0x03", denom: "uatom") { amount }
+			supply(denom: "uatom") { amount }
+			params { status memo }
+		}
+	}`)
+	require.Empty(t, errs)
+	require.Equal(t, map[string]interface{}{
+		"block_num": "1",
+		"bank": map[string]interface{}{
+			"balances": map[string]interface{}{"address": "0x01", "denom": "uatom", "amount": "100"},
+			"missing":  nil,
+			"supply":   map[string]interface{}{"amount": "105"},
+			"params":   map[string]interface{}{"status": "active", "memo": nil},
+		},
+	}, data)
+
+	_, errs = query(t, h, `{ bank { balances(address: "01", denom: "uatom") { amount } } }`)
+	require.Len(t, errs, 1)
+}
+
+func TestQueryList(t *testing.T) {
+	v, _ := newTestView(t)
+	h := newTestServer(t, v, nil).httpServer.Handler
+
+	testCases := []struct {
+		name      string
+		args      string
+		expAmount []interface{}
+		expTotal  float64
+		expNext   bool
+		expErr    bool
+	}{
+		{
+			name:      "first page",
+			expAmount: []interface{}{"100", "20"},
+			expTotal:  3,
+			expNext:   true,
+		},
+		{
+			name:      "second page",
+			args:      `(offset: 2)`,
+			expAmount: []interface{}{"5"},
+			expTotal:  3,
+		},
+		{
+			name:      "equality",
+			args:      `(filter: {denom: "uatom"})`,
+			expAmount: []interface{}{"100", "5"},
+			expTotal:  2,
+		},
+		{
+			name:      "integer comparison",
+			args:      `(filter: {amount_gt: "10", amount_lte: "100"})`,
+			expAmount: []interface{}{"100", "20"},
+			expTotal:  2,
+		},
+		{
+			name:      "in and not equal",
+			args:      `(filter: {address_in: ["0x01", "0x02"], denom_ne: "ustake"})`,
+			expAmount: []interface{}{"100", "5"},
+			expTotal:  2,
+		},
+		{
+			name:      "or",
+			args:      `(filter: {or: [{amount: "5"}, {denom: "ustake"}]})`,
+			expAmount: []interface{}{"20", "5"},
+			expTotal:  2,
+		},
+		{
+			name:   "limit above maximum",
+			args:   `(limit: 3)`,
+			expErr: true,
+		},
+		{
+			name:   "invalid filter value",
+			args:   `(filter: {amount_gt: "ten"})`,
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, errs := query(t, h, `{ bank { balances_list`+tc.args+` { items { amount } total_count has_next_page } } }`)
+			if tc.expErr {
+				require.NotEmpty(t, errs)
+				return
+			}
+			require.Empty(t, errs)
+
+			page := data["bank"].(map[string]interface{})["balances_list"].(map[string]interface{})
+			amounts := []interface{}{}
+			for _, item := range page["items"].([]interface{}) {
+				amounts = append(amounts, item.(map[string]interface{})["amount"])
+			}
+			require.Equal(t, tc.expAmount, amounts)
+			require.Equal(t, tc.expTotal, page["total_count"])
+			require.Equal(t, tc.expNext, page["has_next_page"])
+		})
+	}
+}
+
+func TestSubscription(t *testing.T) {
+	v, bank := newTestView(t)
+	notifier := NewCommitNotifier()
+	srv := newTestServer(t, v, notifier)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ts := httptest.NewServer(srv.httpServer.Handler)
+	defer ts.Close()
+
+	body := `{"query": "subscription { on_commit { block_num bank { supply(denom: \"uatom\") { amount } } } }"}`
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ts.URL+Path, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Accept", ContentTypeEventStream)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, ContentTypeEventStream, resp.Header.Get("Content-Type"))
+
+	// the subscription starts asynchronously, wait for it before committing
+	require.Eventually(t, func() bool {
+		notifier.mu.Lock()
+		defer notifier.mu.Unlock()
+		return len(notifier.subscribers) == 1
+	}, 5*time.Second, 5*time.Millisecond)
+
+	events := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			if data, ok := strings.CutPrefix(scanner.Text(), "data: "); ok {
+				events <- data
+			}
+		}
+	}()
+
+	for _, supply := range []string{"110", "120"} {
+		require.NoError(t, bank.Set(schema.StateObjectUpdate{TypeName: "supply", Key: "uatom", Value: supply}))
+		blockNum := v.Commit()
+		require.NoError(t, notifier.ListenDeliverBlock(ctx, streaming.ListenDeliverBlockRequest{BlockHeight: int64(blockNum)}))
+
+		select {
+		case event := <-events:
+			var res struct {
+				Data struct {
+					OnCommit struct {
+						BlockNum string `json:"block_num"`
+						Bank     struct {
+							Supply struct {
+								Amount string `json:"amount"`
+							} `json:"supply"`
+						} `json:"bank"`
+					} `json:"on_commit"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(event), &res))
+			require.Equal(t, supply, res.Data.OnCommit.Bank.Supply.Amount)
+			require.Equal(t, strconv.FormatUint(blockNum, 10), res.Data.OnCommit.BlockNum)
+		case <-time.After(5 * time.Second):
+			t.Fatal("no event received")
+		}
+	}
+}
+
+func TestCommitNotifier(t *testing.T) {
+	notifier := NewCommitNotifier()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the state view returns the state of the notified block
+	data := NewStateView(testStore{version: 7, bank: coretesting.NewMemKV()}, decoding.ModuleSetDecoderResolver(map[string]interface{}{"bank": testModule{}}))
+	snapshots := notifier.subscribe(ctx, data)
+	notifier.notify(7)
+	notifier.notify(8)
+	snap := (<-snapshots).(*snapshot)
+	require.Equal(t, uint64(7), snap.blockNum)
+	_, err := snap.state.GetModule("bank")
+	require.NoError(t, err)
+	snap = (<-snapshots).(*snapshot)
+	require.Equal(t, uint64(8), snap.blockNum)
+	_, err = snap.state.GetModule("bank")
+	require.ErrorContains(t, err, "version 8 not found")
+
+	// a subscription lagging too far behind is ended without skipping blocks
+	v, _ := newTestView(t)
+	snapshots = notifier.subscribe(ctx, v)
+	for blockNum := uint64(1); blockNum <= maxPendingBlocks+2; blockNum++ {
+		notifier.notify(blockNum)
+	}
+	var blockNums []uint64
+	for snap := range snapshots {
+		blockNums = append(blockNums, snap.(*snapshot).blockNum)
+	}
+	require.NotEmpty(t, blockNums)
+	for i, blockNum := range blockNums {
+		require.Equal(t, uint64(i+1), blockNum)
+	}
+
+	cancel()
+	require.Eventually(t, func() bool {
+		notifier.mu.Lock()
+		defer notifier.mu.Unlock()
+		return len(notifier.subscribers) == 0
+	}, 5*time.Second, 5*time.Millisecond)
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	v, _ := newTestView(t)
+	rec := httptest.NewRecorder()
+	newTestServer(t, v, nil).httpServer.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, Path, nil))
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+// testStore is a Store of a single version of the bank module.
+type testStore struct {
+	version uint64
+	bank    corestore.Reader
+}
+
+func (s testStore) StateLatest() (uint64, corestore.ReaderMap, error) {
+	return s.version, s, nil
+}
+
+func (s testStore) StateAt(version uint64) (corestore.ReaderMap, error) {
+	if version != s.version {
+		return nil, fmt.Errorf("version %d not found", version)
+	}
+	return s, nil
+}
+
+func (s testStore) GetReader(actor []byte) (corestore.Reader, error) {
+	return s.bank, nil
+}
+
+// testModule decodes the supply of the bank module, stored as the amount by
+// denom.
+type testModule struct{}
+
+func (testModule) ModuleCodec() (schema.ModuleCodec, error) {
+	return schema.ModuleCodec{
+		Schema: bankSchema,
+		KVDecoder: func(update schema.KVPairUpdate) ([]schema.StateObjectUpdate, error) {
+			return []schema.StateObjectUpdate{{TypeName: "supply", Key: string(update.Key), Value: string(update.Value)}}, nil
+		},
+	}, nil
+}
+
+func TestStateView(t *testing.T) {
+	kv := coretesting.NewMemKV()
+	require.NoError(t, kv.Set([]byte("uatom"), []byte("105")))
+	require.NoError(t, kv.Set([]byte("ustake"), []byte("20")))
+
+	data := NewStateView(testStore{version: 7, bank: kv}, decoding.ModuleSetDecoderResolver(map[string]interface{}{"bank": testModule{}}))
+	h := newTestServer(t, data, nil).httpServer.Handler
+
+	res, errs := query(t, h, `{
+		block_num
+		bank {
+			supply(denom: "ustake") { amount }
+			supply_list(filter: {amount_gt: "50"}) { items { denom } total_count }
+		}
+	}`)
+	require.Empty(t, errs)
+	require.Equal(t, map[string]interface{}{
+		"block_num": "7",
+		"bank": map[string]interface{}{
+			"supply": map[string]interface{}{"amount": "20"},
+			"supply_list": map[string]interface{}{
+				"items":       []interface{}{map[string]interface{}{"denom": "uatom"}},
+				"total_count": float64(1),
+			},
+		},
+	}, res)
+}
+
+func TestServerConfig(t *testing.T) {
+	testCases := []struct {
+		name           string
+		setupFunc      func() *Config
+		expectedConfig *Config
+	}{
+		{
+			name: "Default configuration, no custom configuration",
+			setupFunc: func() *Config {
+				s := &Server[transaction.Tx]{}
+				return s.Config().(*Config)
+			},
+			expectedConfig: DefaultConfig(),
+		},
+		{
+			name: "Custom configuration",
+			setupFunc: func() *Config {
+				s := NewWithConfigOptions[transaction.Tx](Enable(), func(config *Config) {
+					config.MaxLimit = 10
+				})
+				return s.Config().(*Config)
+			},
+			expectedConfig: &Config{
+				Enable:   true,
+				Address:  "localhost:1318",
+				MaxLimit: 10,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := tc.setupFunc()
+			require.Equal(t, tc.expectedConfig, config)
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package graphql
+
+import (
+	"errors"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/decoding"
+	"cosmossdk.io/schema/view"
+)
+
+// Store is the state storage read by the view returned by NewStateView.
+type Store interface {
+	// StateLatest returns the latest committed version and its state.
+	StateLatest() (uint64, corestore.ReaderMap, error)
+	// StateAt returns the state of a committed version.
+	StateAt(version uint64) (corestore.ReaderMap, error)
+}
+
+// NewStateView returns a view of the latest committed state of store, decoded
+// with the module codecs of resolver, which are usually generated from the
+// collections of the modules. Its AppState is a snapshot of the state at the
+// time it is called, and the subscriptions get the state of each committed
+// version.
+// The objects are decoded while iterating over the store of their module, so
+// getting an object by its key scans the store: an indexer view should be
+// preferred to serve large states.
+func NewStateView(store Store, resolver decoding.DecoderResolver) view.AppData {
+	return &stateView{store: store, resolver: resolver}
+}
+
+type stateView struct {
+	store    Store
+	resolver decoding.DecoderResolver
+}
+
+func (v *stateView) BlockNum() (uint64, error) {
+	version, _, err := v.store.StateLatest()
+	return version, err
+}
+
+func (v *stateView) AppState() view.AppState {
+	_, state, err := v.store.StateLatest()
+	return &stateAppState{resolver: v.resolver, state: state, err: err}
+}
+
+// AppStateAt returns the state of the committed version blockNum.
+func (v *stateView) AppStateAt(blockNum uint64) view.AppState {
+	state, err := v.store.StateAt(blockNum)
+	return &stateAppState{resolver: v.resolver, state: state, err: err}
+}
+
+// errStop stops the iteration over the module decoders.
+var errStop = errors.New("stop")
+
+// stateAppState is a view of the state of a version. err is the error getting
+// the state, returned by all the methods.
+type stateAppState struct {
+	resolver decoding.DecoderResolver
+	state    corestore.ReaderMap
+	err      error
+}
+
+func (s *stateAppState) GetModule(moduleName string) (view.ModuleState, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	cdc, found, err := s.resolver.LookupDecoder(moduleName)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &stateModule{app: s, name: moduleName, cdc: cdc}, nil
+}
+
+func (s *stateAppState) Modules(f func(modState view.ModuleState, err error) bool) {
+	if s.err != nil {
+		f(nil, s.err)
+		return
+	}
+
+	err := s.resolver.AllDecoders(func(moduleName string, cdc schema.ModuleCodec) error {
+		if !f(&stateModule{app: s, name: moduleName, cdc: cdc}, nil) {
+			return errStop
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errStop) {
+		f(nil, err)
+	}
+}
+
+func (s *stateAppState) NumModules() (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+
+	n := 0
+	err := s.resolver.AllDecoders(func(string, schema.ModuleCodec) error {
+		n++
+		return nil
+	})
+	return n, err
+}
+
+type stateModule struct {
+	app  *stateAppState
+	name string
+	cdc  schema.ModuleCodec
+}
+
+func (m *stateModule) ModuleName() string {
+	return m.name
+}
+
+func (m *stateModule) ModuleSchema() schema.ModuleSchema {
+	return m.cdc.Schema
+}
+
+func (m *stateModule) GetObjectCollection(objectType string) (view.ObjectCollection, error) {
+	typ, found := m.cdc.Schema.LookupStateObjectType(objectType)
+	if !found {
+		return nil, nil
+	}
+	return &stateCollection{module: m, typ: typ}, nil
+}
+
+func (m *stateModule) ObjectCollections(f func(value view.ObjectCollection, err error) bool) {
+	m.cdc.Schema.StateObjectTypes(func(typ schema.StateObjectType) bool {
+		return f(&stateCollection{module: m, typ: typ}, nil)
+	})
+}
+
+func (m *stateModule) NumObjectCollections() (int, error) {
+	n := 0
+	m.cdc.Schema.StateObjectTypes(func(schema.StateObjectType) bool {
+		n++
+		return true
+	})
+	return n, nil
+}
+
+// stateCollection is the collection of the objects of a type, decoded from the
+// store of its module.
+type stateCollection struct {
+	module *stateModule
+	typ    schema.StateObjectType
+}
+
+func (c *stateCollection) ObjectType() schema.StateObjectType {
+	return c.typ
+}
+
+func (c *stateCollection) GetObject(key interface{}) (update schema.StateObjectUpdate, found bool, err error) {
+	c.AllState(func(u schema.StateObjectUpdate, iterErr error) bool {
+		if iterErr != nil {
+			err = iterErr
+			return false
+		}
+		if keysEqual(c.typ.KeyFields, u.Key, key) {
+			update, found = u, true
+			return false
+		}
+		return true
+	})
+	return update, found, err
+}
+
+func (c *stateCollection) AllState(f func(schema.StateObjectUpdate, error) bool) {
+	if err := c.iterate(f); err != nil {
+		f(schema.StateObjectUpdate{}, err)
+	}
+}
+
+// iterate calls f with the objects decoded from the store of the module, until
+// it returns false.
+func (c *stateCollection) iterate(f func(schema.StateObjectUpdate, error) bool) error {
+	if c.module.cdc.KVDecoder == nil {
+		return nil
+	}
+
+	actor, err := c.module.app.resolver.EncodeModuleName(c.module.name)
+	if err != nil {
+		return err
+	}
+	reader, err := c.module.app.state.GetReader(actor)
+	if err != nil {
+		return err
+	}
+	it, err := reader.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		updates, err := c.module.cdc.KVDecoder(schema.KVPairUpdate{Key: it.Key(), Value: it.Value()})
+		if err != nil {
+			return err
+		}
+		for _, update := range updates {
+			if update.TypeName != c.typ.Name || update.Delete {
+				continue
+			}
+			if !f(update, nil) {
+				return nil
+			}
+		}
+	}
+	return it.Error()
+}
+
+func (c *stateCollection) Len() (int, error) {
+	n := 0
+	var err error
+	c.AllState(func(_ schema.StateObjectUpdate, iterErr error) bool {
+		if iterErr != nil {
+			err = iterErr
+			return false
+		}
+		n++
+		return true
+	})
+	return n, err
+}
+
+// keysEqual reports whether the keys a and b of an object type with the key
+// fields are equal.
+func keysEqual(fields []schema.Field, a, b interface{}) bool {
+	switch len(fields) {
+	case 0:
+		return true
+	case 1:
+		return a != nil && b != nil && compareValues(fields[0], a, b) == 0
+	}
+
+	x, okX := a.([]interface{})
+	y, okY := b.([]interface{})
+	if !okX || !okY || len(x) != len(fields) || len(y) != len(fields) {
+		return false
+	}
+	for i, field := range fields {
+		if x[i] == nil || y[i] == nil || compareValues(field, x[i], y[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,108 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+
+	"cosmossdk.io/schema/view"
+	"cosmossdk.io/server/v2/streaming"
+)
+
+// maxPendingBlocks is the number of committed blocks a subscription can lag
+// behind. A subscription lagging further is ended rather than skipping blocks.
+const maxPendingBlocks = 100
+
+var _ streaming.Listener = (*CommitNotifier)(nil)
+
+// CommitNotifier notifies the subscriptions of a GraphQL server of the
+// committed blocks. It is a streaming.Listener, to be registered in the
+// streaming manager of the consensus server, which calls it once the state of
+// each block is committed.
+type CommitNotifier struct {
+	mu          sync.Mutex
+	subscribers map[chan uint64]struct{}
+}
+
+// NewCommitNotifier returns a CommitNotifier without subscribers.
+func NewCommitNotifier() *CommitNotifier {
+	return &CommitNotifier{subscribers: map[chan uint64]struct{}{}}
+}
+
+// ListenDeliverBlock implements streaming.Listener, notifying the subscribers
+// of the committed block.
+func (n *CommitNotifier) ListenDeliverBlock(_ context.Context, req streaming.ListenDeliverBlockRequest) error {
+	n.notify(uint64(req.BlockHeight))
+	return nil
+}
+
+// ListenStateChanges implements streaming.Listener. The subscriptions read the
+// state from their view, so the changes are ignored.
+func (n *CommitNotifier) ListenStateChanges(context.Context, []*streaming.StoreKVPair) error {
+	return nil
+}
+
+// notify sends blockNum to the subscribers. The subscribers lagging more than
+// maxPendingBlocks behind are removed, which ends their subscription.
+func (n *CommitNotifier) notify(blockNum uint64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for ch := range n.subscribers {
+		select {
+		case ch <- blockNum:
+		default:
+			delete(n.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// versionedAppData is implemented by the views which return the state of past
+// blocks, such as the view returned by NewStateView.
+type versionedAppData interface {
+	AppStateAt(blockNum uint64) view.AppState
+}
+
+// subscribe returns the snapshots of data at the blocks committed until ctx is
+// done. The snapshots of the views which can't return the state of past blocks
+// are their latest state.
+func (n *CommitNotifier) subscribe(ctx context.Context, data view.AppData) chan interface{} {
+	blocks := make(chan uint64, maxPendingBlocks)
+	n.mu.Lock()
+	n.subscribers[blocks] = struct{}{}
+	n.mu.Unlock()
+
+	snapshots := make(chan interface{})
+	go func() {
+		defer close(snapshots)
+		defer func() {
+			n.mu.Lock()
+			delete(n.subscribers, blocks)
+			n.mu.Unlock()
+		}()
+
+		for {
+			var blockNum uint64
+			select {
+			case <-ctx.Done():
+				return
+			case num, ok := <-blocks:
+				if !ok {
+					return
+				}
+				blockNum = num
+			}
+
+			state := data.AppState()
+			if versioned, ok := data.(versionedAppData); ok {
+				state = versioned.AppStateAt(blockNum)
+			}
+			select {
+			case snapshots <- &snapshot{blockNum: blockNum, state: state}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return snapshots
+}
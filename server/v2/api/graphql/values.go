@@ -0,0 +1,238 @@
+package graphql
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/addressutil"
+)
+
+// scalarType returns the GraphQL scalar type of the values of kind. The 64
+// bits and arbitrary precision numbers are strings, as GraphQL integers are 32
+// bits, and the other kinds follow their schema JSON encoding.
+func scalarType(kind schema.Kind) *graphql.Scalar {
+	switch kind {
+	case schema.Int8Kind, schema.Uint8Kind, schema.Int16Kind, schema.Uint16Kind, schema.Int32Kind:
+		return graphql.Int
+	case schema.Float32Kind, schema.Float64Kind:
+		return graphql.Float
+	case schema.BoolKind:
+		return graphql.Boolean
+	default:
+		return graphql.String
+	}
+}
+
+// isOrdered reports whether the values of kind can be compared with the range
+// filters.
+func isOrdered(kind schema.Kind) bool {
+	switch kind {
+	case schema.StringKind,
+		schema.Int8Kind, schema.Uint8Kind, schema.Int16Kind, schema.Uint16Kind,
+		schema.Int32Kind, schema.Uint32Kind, schema.Int64Kind, schema.Uint64Kind,
+		schema.IntegerKind, schema.DecimalKind, schema.Float32Kind, schema.Float64Kind,
+		schema.TimeKind, schema.DurationKind:
+		return true
+	default:
+		return false
+	}
+}
+
+// isFilterable reports whether the fields of kind can be filtered on.
+func isFilterable(kind schema.Kind) bool {
+	switch kind {
+	case schema.JSONKind, schema.StructKind, schema.OneOfKind, schema.ListKind:
+		return false
+	default:
+		return true
+	}
+}
+
+// valueCodec converts the values of the schema fields from and to their
+// GraphQL representation.
+type valueCodec struct {
+	addressCodec addressutil.AddressCodec
+}
+
+// toGraphQL converts value, of the kind of field, to its GraphQL value.
+func (c valueCodec) toGraphQL(field schema.Field, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch field.Kind {
+	case schema.BytesKind:
+		return base64.StdEncoding.EncodeToString(value.([]byte)), nil
+	case schema.AddressKind:
+		return c.addressCodec.BytesToString(value.([]byte))
+	case schema.Int8Kind, schema.Uint8Kind, schema.Int16Kind, schema.Uint16Kind, schema.Int32Kind:
+		return toInt64(value), nil
+	case schema.Uint32Kind, schema.Int64Kind:
+		return strconv.FormatInt(toInt64(value), 10), nil
+	case schema.Uint64Kind:
+		return strconv.FormatUint(value.(uint64), 10), nil
+	case schema.Float32Kind:
+		return float64(value.(float32)), nil
+	case schema.TimeKind:
+		return value.(time.Time).UTC().Format(time.RFC3339Nano), nil
+	case schema.DurationKind:
+		return formatDuration(value.(time.Duration)), nil
+	case schema.JSONKind:
+		return string(value.(json.RawMessage)), nil
+	case schema.StructKind, schema.OneOfKind, schema.ListKind, schema.IntNKind, schema.UIntNKind:
+		bz, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		return string(bz), nil
+	default:
+		return value, nil
+	}
+}
+
+// fromGraphQL converts the GraphQL value of an argument of the kind of field to
+// its schema value.
+func (c valueCodec) fromGraphQL(field schema.Field, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch field.Kind {
+	case schema.Int8Kind:
+		return int8(value.(int)), nil
+	case schema.Uint8Kind:
+		return uint8(value.(int)), nil
+	case schema.Int16Kind:
+		return int16(value.(int)), nil
+	case schema.Uint16Kind:
+		return uint16(value.(int)), nil
+	case schema.Int32Kind:
+		return int32(value.(int)), nil
+	case schema.Float32Kind:
+		return float32(value.(float64)), nil
+	case schema.Float64Kind, schema.BoolKind:
+		return value, nil
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s value %v", field.Kind, value)
+	}
+	switch field.Kind {
+	case schema.BytesKind:
+		bz, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			bz, err = base64.URLEncoding.DecodeString(s)
+		}
+		return bz, err
+	case schema.AddressKind:
+		return c.addressCodec.StringToBytes(s)
+	case schema.Uint32Kind:
+		v, err := strconv.ParseUint(s, 10, 32)
+		return uint32(v), err
+	case schema.Int64Kind:
+		return strconv.ParseInt(s, 10, 64)
+	case schema.Uint64Kind:
+		return strconv.ParseUint(s, 10, 64)
+	case schema.IntegerKind, schema.DecimalKind:
+		if _, ok := new(big.Rat).SetString(s); !ok {
+			return nil, fmt.Errorf("invalid %s value %q", field.Kind, s)
+		}
+		return s, nil
+	case schema.TimeKind:
+		return time.Parse(time.RFC3339Nano, s)
+	case schema.DurationKind:
+		return time.ParseDuration(s)
+	default:
+		return s, nil
+	}
+}
+
+// compareValues compares two values of the kind of field, returning -1, 0 or
+// +1. The values must be of an ordered kind, or only compared for equality.
+func compareValues(field schema.Field, a, b interface{}) int {
+	switch field.Kind {
+	case schema.IntegerKind, schema.DecimalKind:
+		// arbitrary precision numbers are compared numerically
+		x, okX := new(big.Rat).SetString(a.(string))
+		y, okY := new(big.Rat).SetString(b.(string))
+		if okX && okY {
+			return x.Cmp(y)
+		}
+	case schema.TimeKind:
+		return a.(time.Time).Compare(b.(time.Time))
+	}
+
+	switch x := a.(type) {
+	case string:
+		return strings.Compare(x, b.(string))
+	case []byte:
+		return bytes.Compare(x, b.([]byte))
+	case json.RawMessage:
+		return bytes.Compare(x, b.(json.RawMessage))
+	case bool:
+		return cmp.Compare(boolToInt(x), boolToInt(b.(bool)))
+	case uint64:
+		return cmp.Compare(x, b.(uint64))
+	case float32:
+		return cmp.Compare(x, b.(float32))
+	case float64:
+		return cmp.Compare(x, b.(float64))
+	case time.Duration:
+		return cmp.Compare(x, b.(time.Duration))
+	case int8, uint8, int16, uint16, int32, uint32, int64:
+		return cmp.Compare(toInt64(x), toInt64(b))
+	default:
+		return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+	}
+}
+
+func toInt64(value interface{}) int64 {
+	switch v := value.(type) {
+	case int8:
+		return int64(v)
+	case uint8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case uint16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case uint32:
+		return int64(v)
+	case int64:
+		return v
+	default:
+		panic(fmt.Sprintf("unexpected integer type %T", value))
+	}
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// formatDuration formats d as a number of seconds followed by "s", without
+// trailing zeros, as in its schema JSON encoding.
+func formatDuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	s := fmt.Sprintf("%d.%09d", d/time.Second, d%time.Second)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	return sign + s + "s"
+}
@@ -0,0 +1,17 @@
+package logadmin
+
+func DefaultConfig() *Config {
+	return &Config{
+		Enable:  false,
+		Address: "localhost:1319",
+	}
+}
+
+type Config struct {
+	// Enable enables the log admin server, which reads and changes the log
+	// level of each module at runtime.
+	Enable bool `mapstructure:"enable" toml:"enable" comment:"Enable enables the log admin server, which reads and changes the log level of each module at runtime."`
+
+	// Address defines the log admin server address to bind to.
+	Address string `mapstructure:"address" toml:"address" comment:"Address defines the log admin server address to bind to. It must only be reachable locally, as it lets anyone change what the node logs."`
+}
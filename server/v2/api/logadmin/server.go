@@ -0,0 +1,98 @@
+package logadmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	serverv2 "cosmossdk.io/server/v2"
+)
+
+var (
+	_ serverv2.ServerComponent[transaction.Tx] = (*Server[transaction.Tx])(nil)
+	_ serverv2.HasConfig                       = (*Server[transaction.Tx])(nil)
+)
+
+const ServerName = "log-admin"
+
+// Server exposes the level registry of the node logger over HTTP, at
+// /log/levels. See log.NewLevelRegistryHandler for the supported requests.
+type Server[T transaction.Tx] struct {
+	logger log.Logger
+	config *Config
+	server *http.Server
+}
+
+// New creates a new log admin server. The logger must have been created with
+// a level registry, as done by serverv2.NewLogger.
+func New[T transaction.Tx](cfg server.ConfigMap, logger log.Logger) (*Server[T], error) {
+	srv := &Server[T]{}
+	serverCfg := srv.Config().(*Config)
+	if len(cfg) > 0 {
+		if err := serverv2.UnmarshalSubConfig(cfg, srv.Name(), &serverCfg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+		}
+	}
+	srv.config = serverCfg
+	srv.logger = logger.With(log.ModuleKey, srv.Name())
+
+	if !srv.config.Enable {
+		return srv, nil
+	}
+
+	provider, ok := logger.(log.LevelRegistryProvider)
+	if !ok || provider.LevelRegistry() == nil {
+		return nil, errors.New("log admin server requires a logger created with a level registry")
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/log/levels", log.NewLevelRegistryHandler(provider.LevelRegistry()))
+
+	srv.server = &http.Server{
+		Addr:              srv.config.Address,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return srv, nil
+}
+
+// Name returns the server name.
+func (s *Server[T]) Name() string {
+	return ServerName
+}
+
+func (s *Server[T]) Config() any {
+	if s.config == nil || s.config.Address == "" {
+		return DefaultConfig()
+	}
+
+	return s.config
+}
+
+func (s *Server[T]) Start(ctx context.Context) error {
+	if !s.config.Enable {
+		s.logger.Info(fmt.Sprintf("%s server is disabled via config", s.Name()))
+		return nil
+	}
+
+	s.logger.Info("starting log admin server...", "address", s.config.Address)
+	if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("failed to start log admin server: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Server[T]) Stop(ctx context.Context) error {
+	if !s.config.Enable || s.server == nil {
+		return nil
+	}
+
+	s.logger.Info("stopping log admin server...", "address", s.config.Address)
+	return s.server.Shutdown(ctx)
+}
@@ -0,0 +1,44 @@
+package logadmin
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+)
+
+func TestServer(t *testing.T) {
+	cfg := server.ConfigMap{
+		ServerName: map[string]any{
+			"enable":  true,
+			"address": "localhost:0",
+		},
+	}
+
+	_, err := New[transaction.Tx](cfg, log.NewNopLogger())
+	require.ErrorContains(t, err, "level registry")
+
+	levels, err := log.NewLevelRegistry("*:info")
+	require.NoError(t, err)
+	logger := log.NewLogger(io.Discard, log.LevelRegistryOption(levels))
+
+	srv, err := New[transaction.Tx](cfg, logger)
+	require.NoError(t, err)
+	require.Equal(t, &Config{Enable: true, Address: "localhost:0"}, srv.Config())
+
+	rec := httptest.NewRecorder()
+	srv.server.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/log/levels", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"*": "info"}`, rec.Body.String())
+
+	// a disabled server doesn't need a level registry
+	srv, err = New[transaction.Tx](server.ConfigMap{}, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Equal(t, DefaultConfig(), srv.Config())
+}
@@ -0,0 +1,106 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// sdkCodespace is the codespace of the root SDK errors.
+	sdkCodespace = "sdk"
+	// undefinedCodespace and internalCode are the codespace and code of the
+	// errors without ABCI information.
+	undefinedCodespace        = "undefined"
+	internalCode       uint32 = 1
+)
+
+// sdkHTTPStatus maps the codes of the root SDK errors to their HTTP status,
+// when it is not http.StatusBadRequest.
+var sdkHTTPStatus = map[uint32]int{
+	4:  http.StatusUnauthorized,        // unauthorized
+	9:  http.StatusNotFound,            // unknown address
+	16: http.StatusInternalServerError, // failed to marshal JSON bytes
+	19: http.StatusConflict,            // tx already in mempool
+	20: http.StatusServiceUnavailable,  // mempool is full
+	21: http.StatusRequestEntityTooLarge,
+	22: http.StatusNotFound,            // key not found
+	23: http.StatusUnauthorized,        // invalid account password
+	33: http.StatusInternalServerError, // failed packing protobuf message to Any
+	35: http.StatusInternalServerError, // internal logic error
+	36: http.StatusConflict,
+	37: http.StatusNotImplemented, // feature not supported
+	38: http.StatusNotFound,
+	39: http.StatusInternalServerError, // internal IO error
+	40: http.StatusInternalServerError, // error in app.toml
+}
+
+// ErrorResponse is the body of the error responses.
+type ErrorResponse struct {
+	Code      uint32 `json:"code"`
+	Codespace string `json:"codespace"`
+	Message   string `json:"message"`
+}
+
+// abciCoder is implemented by the errors registered with cosmossdk.io/errors.
+type abciCoder interface {
+	ABCICode() uint32
+	Codespace() string
+}
+
+// HTTPStatusFromError returns the HTTP status of err:
+//   - the status of its gRPC code, if it has a gRPC status with a known code,
+//   - the status of the root SDK errors, http.StatusBadRequest by default,
+//   - http.StatusBadRequest for the other errors registered by modules,
+//   - http.StatusInternalServerError for the errors without ABCI code.
+func HTTPStatusFromError(err error) int {
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		if code := grpcErr.GRPCStatus().Code(); code != codes.Unknown && code != codes.OK {
+			return runtime.HTTPStatusFromCode(code)
+		}
+	}
+
+	var coder abciCoder
+	if !errors.As(err, &coder) {
+		return http.StatusInternalServerError
+	}
+
+	switch {
+	case coder.Codespace() == undefinedCodespace && coder.ABCICode() == internalCode:
+		return http.StatusInternalServerError
+	case coder.Codespace() == sdkCodespace:
+		if httpStatus, ok := sdkHTTPStatus[coder.ABCICode()]; ok {
+			return httpStatus
+		}
+	}
+	return http.StatusBadRequest
+}
+
+// newErrorResponse returns the error response of err.
+func newErrorResponse(err error) ErrorResponse {
+	resp := ErrorResponse{
+		Code:      internalCode,
+		Codespace: undefinedCodespace,
+		Message:   err.Error(),
+	}
+
+	var coder abciCoder
+	if errors.As(err, &coder) {
+		resp.Code, resp.Codespace = coder.ABCICode(), coder.Codespace()
+	} else if s, ok := status.FromError(err); ok {
+		resp.Message = s.Message()
+	}
+	return resp
+}
+
+// writeError writes the error response of err, with its HTTP status.
+func writeError(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", ContentTypeJSON)
+	w.WriteHeader(HTTPStatusFromError(err))
+	_ = json.NewEncoder(w).Encode(newErrorResponse(err))
+}
@@ -0,0 +1,49 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsv2 "cosmossdk.io/errors/v2"
+)
+
+func TestHTTPStatusFromError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"unauthorized", errorsv2.ABCIError("sdk", 4, "signature verification failed"), http.StatusUnauthorized},
+		{"key not found", errorsv2.ABCIError("sdk", 22, "balance"), http.StatusNotFound},
+		{"tx in mempool", errorsv2.ABCIError("sdk", 19, "tx"), http.StatusConflict},
+		{"mempool full", errorsv2.ABCIError("sdk", 20, "tx"), http.StatusServiceUnavailable},
+		{"not supported", errorsv2.ABCIError("sdk", 37, "query"), http.StatusNotImplemented},
+		{"invalid request", errorsv2.ABCIError("sdk", 18, "empty address"), http.StatusBadRequest},
+		{"module error", errorsv2.ABCIError("bank", 5, "insufficient funds"), http.StatusBadRequest},
+		{"wrapped module error", fmt.Errorf("send: %w", errorsv2.ABCIError("bank", 5, "insufficient funds")), http.StatusBadRequest},
+		{"internal error", errorsv2.ABCIError("undefined", 1, "panic"), http.StatusInternalServerError},
+		{"error without code", errors.New("failure"), http.StatusInternalServerError},
+		{"gRPC status", status.Error(codes.NotFound, "account not found"), http.StatusNotFound},
+		{"unknown gRPC status", status.Error(codes.Unknown, "failure"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, HTTPStatusFromError(tc.err))
+		})
+	}
+}
+
+func TestNewErrorResponse(t *testing.T) {
+	resp := newErrorResponse(errorsv2.ABCIError("bank", 5, "insufficient funds"))
+	require.Equal(t, ErrorResponse{Code: 5, Codespace: "bank", Message: "insufficient funds: unknown"}, resp)
+
+	resp = newErrorResponse(status.Error(codes.InvalidArgument, "empty request"))
+	require.Equal(t, ErrorResponse{Code: internalCode, Codespace: undefinedCodespace, Message: "empty request"}, resp)
+}
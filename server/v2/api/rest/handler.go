@@ -47,7 +47,7 @@ func (h *DefaultHandler[T]) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	query, err := h.appManager.Query(r.Context(), 0, msg)
 	if err != nil {
-		http.Error(w, "Error querying", http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
@@ -0,0 +1,53 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	"google.golang.org/protobuf/encoding/protojson"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"cosmossdk.io/core/transaction"
+)
+
+// marshalJSON encodes msg to JSON with the proto field names, using protojson
+// for the protoreflect messages and gogoproto's jsonpb for the others.
+func marshalJSON(msg transaction.Msg) ([]byte, error) {
+	if m, ok := msg.(protov2.Message); ok {
+		return protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(m)
+	}
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+	if err := marshaler.Marshal(&buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// unmarshalJSON decodes bz into msg, using protojson for the protoreflect
+// messages and gogoproto's jsonpb for the others.
+func unmarshalJSON(bz []byte, msg transaction.Msg) error {
+	if len(bytes.TrimSpace(bz)) == 0 {
+		bz = []byte("{}")
+	}
+
+	if m, ok := msg.(protov2.Message); ok {
+		return protojson.Unmarshal(bz, m)
+	}
+	return jsonpb.Unmarshal(bytes.NewReader(bz), msg)
+}
+
+// writeJSON writes resp as a JSON response with the http.StatusOK status.
+func writeJSON(w http.ResponseWriter, resp any) {
+	bz, err := json.Marshal(resp)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentTypeJSON)
+	_, _ = w.Write(bz)
+}
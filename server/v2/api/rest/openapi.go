@@ -0,0 +1,310 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// OpenAPIPath is the path of the OpenAPI document of the routes.
+const OpenAPIPath = "/openapi.json"
+
+// The OpenAPI 3 objects used by the document of the routes.
+type (
+	openAPIDoc struct {
+		OpenAPI    string                           `json:"openapi"`
+		Info       openAPIInfo                      `json:"info"`
+		Paths      map[string]map[string]*operation `json:"paths"`
+		Components components                       `json:"components"`
+	}
+
+	openAPIInfo struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	}
+
+	components struct {
+		Schemas map[string]*schema `json:"schemas"`
+	}
+
+	operation struct {
+		OperationID     string              `json:"operationId"`
+		Summary         string              `json:"summary,omitempty"`
+		Description     string              `json:"description,omitempty"`
+		Tags            []string            `json:"tags"`
+		Parameters      []parameter         `json:"parameters,omitempty"`
+		RequestBody     *requestBody        `json:"requestBody,omitempty"`
+		Responses       map[string]response `json:"responses"`
+		ModuleQuerySafe bool                `json:"x-cosmos-module-query-safe,omitempty"`
+	}
+
+	parameter struct {
+		Name        string  `json:"name"`
+		In          string  `json:"in"`
+		Description string  `json:"description,omitempty"`
+		Schema      *schema `json:"schema"`
+	}
+
+	requestBody struct {
+		Description string               `json:"description,omitempty"`
+		Required    bool                 `json:"required,omitempty"`
+		Content     map[string]mediaType `json:"content"`
+	}
+
+	response struct {
+		Description string               `json:"description"`
+		Content     map[string]mediaType `json:"content,omitempty"`
+	}
+
+	mediaType struct {
+		Schema *schema `json:"schema"`
+	}
+
+	schema struct {
+		Ref                  string             `json:"$ref,omitempty"`
+		Type                 string             `json:"type,omitempty"`
+		Format               string             `json:"format,omitempty"`
+		Description          string             `json:"description,omitempty"`
+		Enum                 []string           `json:"enum,omitempty"`
+		Items                *schema            `json:"items,omitempty"`
+		Properties           map[string]*schema `json:"properties,omitempty"`
+		AdditionalProperties *schema            `json:"additionalProperties,omitempty"`
+	}
+)
+
+// newOpenAPIDoc returns the OpenAPI document of routes, with the schemas of
+// the messages derived from their protobuf descriptors.
+func newOpenAPIDoc(routes []route) *openAPIDoc {
+	doc := &openAPIDoc{
+		OpenAPI: "3.0.3",
+		Info:    openAPIInfo{Title: "Cosmos SDK REST API", Version: "1.0.0"},
+		Paths:   map[string]map[string]*operation{},
+		Components: components{Schemas: map[string]*schema{
+			"ErrorResponse": {
+				Type: "object",
+				Properties: map[string]*schema{
+					"code":      {Type: "integer", Format: "int64"},
+					"codespace": {Type: "string"},
+					"message":   {Type: "string"},
+				},
+			},
+			"SimulateResponse": {
+				Type: "object",
+				Properties: map[string]*schema{
+					"gas_wanted": {Type: "string", Format: "uint64"},
+					"gas_used":   {Type: "string", Format: "uint64"},
+					"responses":  {Type: "array", Items: &schema{Type: "object"}},
+					"events": {Type: "array", Items: &schema{
+						Type: "object",
+						Properties: map[string]*schema{
+							"type": {Type: "string"},
+							"attributes": {Type: "array", Items: &schema{
+								Type: "object",
+								Properties: map[string]*schema{
+									"key":   {Type: "string"},
+									"value": {Type: "string"},
+								},
+							}},
+						},
+					}},
+				},
+			},
+		}},
+	}
+
+	for _, r := range routes {
+		md := r.method
+		op := &operation{
+			OperationID: strings.NewReplacer(".", "_", "/", "_").Replace(strings.TrimPrefix(r.path, "/")),
+			Summary:     comments(md),
+			Tags:        []string{string(md.Parent().FullName())},
+		}
+
+		if r.simulate {
+			op.Description = "Simulates a JSON encoded transaction containing a " + string(md.Input().FullName()) + " message."
+			op.RequestBody = &requestBody{
+				Description: "JSON encoded transaction",
+				Required:    true,
+				Content:     map[string]mediaType{ContentTypeJSON: {Schema: &schema{Type: "object"}}},
+			}
+			op.Responses = operationResponses(&schema{Ref: schemaRef("SimulateResponse")})
+			doc.Paths[r.path] = map[string]*operation{"post": op}
+			continue
+		}
+
+		doc.addMessageSchema(md.Input())
+		doc.addMessageSchema(md.Output())
+		op.Parameters = []parameter{heightParameter()}
+		op.RequestBody = &requestBody{
+			Content: map[string]mediaType{ContentTypeJSON: {Schema: &schema{Ref: schemaRef(string(md.Input().FullName()))}}},
+		}
+		op.Responses = operationResponses(&schema{Ref: schemaRef(string(md.Output().FullName()))})
+		op.ModuleQuerySafe = r.moduleQuerySafe
+		doc.Paths[r.path] = map[string]*operation{"post": op}
+
+		if r.moduleQuerySafe {
+			get := *op
+			get.OperationID += "_get"
+			get.RequestBody = nil
+			get.Parameters = append([]parameter{heightParameter()}, queryParameters("", md.Input(), nil)...)
+			doc.Paths[r.path]["get"] = &get
+		}
+	}
+
+	return doc
+}
+
+// addMessageSchema adds the schemas of md and of the messages of its fields.
+func (d *openAPIDoc) addMessageSchema(md protoreflect.MessageDescriptor) {
+	name := string(md.FullName())
+	if _, ok := d.Components.Schemas[name]; ok {
+		return
+	}
+
+	s := &schema{Type: "object", Description: comments(md), Properties: map[string]*schema{}}
+	d.Components.Schemas[name] = s
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		var fs *schema
+		switch {
+		case fd.IsMap():
+			fs = &schema{Type: "object", AdditionalProperties: d.fieldSchema(fd.MapValue())}
+		case fd.IsList():
+			fs = &schema{Type: "array", Items: d.fieldSchema(fd)}
+		default:
+			fs = d.fieldSchema(fd)
+		}
+		if desc := comments(fd); desc != "" && fs.Ref == "" {
+			fs.Description = desc
+		}
+		s.Properties[string(fd.Name())] = fs
+	}
+}
+
+// fieldSchema returns the schema of a single value of fd, following the
+// protobuf JSON mapping.
+func (d *openAPIDoc) fieldSchema(fd protoreflect.FieldDescriptor) *schema {
+	if isScalarField(fd) {
+		return scalarSchema(fd)
+	}
+
+	md := fd.Message()
+	if md.FullName() == "google.protobuf.Any" {
+		return &schema{Type: "object", Properties: map[string]*schema{"@type": {Type: "string"}}}
+	}
+	d.addMessageSchema(md)
+	return &schema{Ref: schemaRef(string(md.FullName()))}
+}
+
+// scalarSchema returns the schema of a single value of the scalar field fd.
+func scalarSchema(fd protoreflect.FieldDescriptor) *schema {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return &schema{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return &schema{Type: "integer", Format: "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &schema{Type: "integer", Format: "int64"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return &schema{Type: "string", Format: "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return &schema{Type: "string", Format: "uint64"}
+	case protoreflect.FloatKind:
+		return &schema{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		return &schema{Type: "number", Format: "double"}
+	case protoreflect.StringKind:
+		return &schema{Type: "string"}
+	case protoreflect.BytesKind:
+		return &schema{Type: "string", Format: "byte"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		s := &schema{Type: "string", Enum: make([]string, values.Len())}
+		for i := 0; i < values.Len(); i++ {
+			s.Enum[i] = string(values.Get(i).Name())
+		}
+		return s
+	}
+
+	if fd.Message().FullName() == "google.protobuf.Timestamp" {
+		return &schema{Type: "string", Format: "date-time"}
+	}
+	return &schema{Type: "string"} // google.protobuf.Duration
+}
+
+// queryParameters returns the query parameters of the scalar fields of md,
+// named with their path from the request message. seen holds the messages of
+// the path, to stop at recursive messages.
+func queryParameters(prefix string, md protoreflect.MessageDescriptor, seen []protoreflect.FullName) []parameter {
+	var params []parameter
+	seen = append(seen, md.FullName())
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := prefix + string(fd.Name())
+		switch {
+		case isScalarField(fd):
+			s := scalarSchema(fd)
+			if fd.IsList() {
+				s = &schema{Type: "array", Items: s}
+			}
+			params = append(params, parameter{Name: name, In: "query", Description: comments(fd), Schema: s})
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap():
+			if !slices.Contains(seen, fd.Message().FullName()) {
+				params = append(params, queryParameters(name+".", fd.Message(), seen)...)
+			}
+		}
+	}
+	return params
+}
+
+func heightParameter() parameter {
+	return parameter{
+		Name:        BlockHeightHeader,
+		In:          "header",
+		Description: "Height of the state queried, the latest one when unset.",
+		Schema:      &schema{Type: "string", Format: "uint64"},
+	}
+}
+
+func operationResponses(s *schema) map[string]response {
+	return map[string]response{
+		"200": {Description: "A successful response.", Content: map[string]mediaType{ContentTypeJSON: {Schema: s}}},
+		"default": {
+			Description: "An error response.",
+			Content:     map[string]mediaType{ContentTypeJSON: {Schema: &schema{Ref: schemaRef("ErrorResponse")}}},
+		},
+	}
+}
+
+func schemaRef(name string) string {
+	return "#/components/schemas/" + name
+}
+
+// comments returns the leading comments of d, if its file has source info.
+func comments(d protoreflect.Descriptor) string {
+	return strings.TrimSpace(d.ParentFile().SourceLocations().ByDescriptor(d).LeadingComments)
+}
+
+// openAPIHandler serves the OpenAPI document.
+type openAPIHandler struct {
+	doc []byte
+}
+
+func newOpenAPIHandler(doc *openAPIDoc) (*openAPIHandler, error) {
+	bz, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	return &openAPIHandler{doc: bz}, nil
+}
+
+func (h *openAPIHandler) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", ContentTypeJSON)
+	_, _ = w.Write(h.doc)
+}
@@ -0,0 +1,388 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+	queryv1 "cosmossdk.io/api/cosmos/query/v1"
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/server/v2/appmanager"
+)
+
+const (
+	// SimulateSuffix is the suffix of the paths of the message simulation routes.
+	SimulateSuffix = "/simulate"
+
+	// BlockHeightHeader is the header selecting the height of the state
+	// queried, the latest one when unset.
+	BlockHeightHeader = "x-cosmos-block-height"
+)
+
+// route is a route generated from a query or a Msg service method.
+type route struct {
+	// path is the path of the route: the full name of the gRPC method, followed
+	// by SimulateSuffix for the message simulation routes.
+	path   string
+	method protoreflect.MethodDescriptor
+	// simulate is set for the message simulation routes.
+	simulate bool
+	// moduleQuerySafe is set for the queries annotated with
+	// cosmos.query.v1.module_query_safe, which are also served with GET.
+	moduleQuerySafe bool
+}
+
+// buildRoutes returns the routes of the service methods in resolver, sorted by
+// path: a query route for each method with a registered query handler, and a
+// simulation route for each method of the Msg services.
+func buildRoutes(resolver gogoproto.Resolver, queryHandlers map[string]appmodulev2.Handler) []route {
+	var routes []route
+	resolver.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			sd := services.Get(i)
+			isMsgService := proto.GetExtension(sd.Options(), msgv1.E_Service).(bool)
+
+			methods := sd.Methods()
+			for j := 0; j < methods.Len(); j++ {
+				md := methods.Get(j)
+				if md.IsStreamingClient() || md.IsStreamingServer() {
+					continue
+				}
+
+				path := "/" + string(sd.FullName()) + "/" + string(md.Name())
+				switch {
+				case isMsgService:
+					routes = append(routes, route{path: path + SimulateSuffix, method: md, simulate: true})
+				case queryHandlers[string(md.Input().FullName())].Func != nil:
+					routes = append(routes, route{
+						path:            path,
+						method:          md,
+						moduleQuerySafe: proto.GetExtension(md.Options(), queryv1.E_ModuleQuerySafe).(bool),
+					})
+				}
+			}
+		}
+		return true
+	})
+
+	slices.SortFunc(routes, func(a, b route) int { return strings.Compare(a.path, b.path) })
+	// files can be registered in both the protoregistry and gogoproto registries
+	return slices.CompactFunc(routes, func(a, b route) bool { return a.path == b.path })
+}
+
+// registerRoutes registers the handlers of routes on mux.
+func registerRoutes[T transaction.Tx](
+	mux *http.ServeMux,
+	routes []route,
+	appManager appmanager.AppManager[T],
+	queryHandlers map[string]appmodulev2.Handler,
+	txCodec transaction.Codec[T],
+) {
+	for _, r := range routes {
+		if r.simulate {
+			mux.Handle(http.MethodPost+" "+r.path, &simulateHandler[T]{
+				appManager: appManager,
+				txCodec:    txCodec,
+				msgName:    string(r.method.Input().FullName()),
+			})
+			continue
+		}
+
+		h := &queryHandler[T]{
+			appManager: appManager,
+			handler:    queryHandlers[string(r.method.Input().FullName())],
+			input:      r.method.Input(),
+		}
+		mux.Handle(http.MethodPost+" "+r.path, h)
+		if r.moduleQuerySafe {
+			mux.Handle(http.MethodGet+" "+r.path, h)
+		}
+	}
+}
+
+// queryHandler serves a query, with its request in the JSON body of POST
+// requests or in the query parameters of GET requests.
+type queryHandler[T transaction.Tx] struct {
+	appManager appmanager.AppManager[T]
+	handler    appmodulev2.Handler
+	input      protoreflect.MessageDescriptor
+}
+
+func (h *queryHandler[T]) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	height, err := parseHeight(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var bz []byte
+	if r.Method == http.MethodGet {
+		bz, err = queryParamsToJSON(r.URL.Query(), h.input)
+	} else {
+		bz, err = readJSONBody(r)
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	req := h.handler.MakeMsg()
+	if err := unmarshalJSON(bz, req); err != nil {
+		writeError(w, status.Errorf(codes.InvalidArgument, "error parsing request: %v", err))
+		return
+	}
+
+	resp, err := h.appManager.Query(r.Context(), height, req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	bz, err = marshalJSON(resp)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, json.RawMessage(bz))
+}
+
+// SimulateResponse is the response of the message simulation routes.
+type SimulateResponse struct {
+	GasWanted uint64            `json:"gas_wanted,string"`
+	GasUsed   uint64            `json:"gas_used,string"`
+	Responses []json.RawMessage `json:"responses"`
+	Events    []Event           `json:"events"`
+}
+
+// Event is an event emitted by a simulated transaction.
+type Event struct {
+	Type       string           `json:"type"`
+	Attributes []EventAttribute `json:"attributes"`
+}
+
+// EventAttribute is an attribute of an Event.
+type EventAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// simulateHandler simulates a JSON encoded transaction containing a message
+// of the route.
+type simulateHandler[T transaction.Tx] struct {
+	appManager appmanager.AppManager[T]
+	txCodec    transaction.Codec[T]
+	msgName    string
+}
+
+func (h *simulateHandler[T]) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bz, err := readJSONBody(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	tx, err := h.txCodec.DecodeJSON(bz)
+	if err != nil {
+		writeError(w, status.Errorf(codes.InvalidArgument, "error decoding transaction: %v", err))
+		return
+	}
+	msgs, err := tx.GetMessages()
+	if err != nil {
+		writeError(w, status.Errorf(codes.InvalidArgument, "error getting transaction messages: %v", err))
+		return
+	}
+	if !slices.ContainsFunc(msgs, func(msg transaction.Msg) bool { return gogoproto.MessageName(msg) == h.msgName }) {
+		writeError(w, status.Errorf(codes.InvalidArgument, "transaction does not contain a %s message", h.msgName))
+		return
+	}
+
+	result, _, err := h.appManager.Simulate(r.Context(), tx)
+	if err == nil {
+		err = result.Error
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	resp := SimulateResponse{
+		GasWanted: result.GasWanted,
+		GasUsed:   result.GasUsed,
+		Responses: make([]json.RawMessage, len(result.Resp)),
+		Events:    make([]Event, len(result.Events)),
+	}
+	for i, msg := range result.Resp {
+		if resp.Responses[i], err = marshalJSON(msg); err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+	for i, event := range result.Events {
+		attrs, err := event.Attributes()
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		resp.Events[i] = Event{Type: event.Type, Attributes: make([]EventAttribute, len(attrs))}
+		for j, attr := range attrs {
+			resp.Events[i].Attributes[j] = EventAttribute{Key: attr.Key, Value: attr.Value}
+		}
+	}
+	writeJSON(w, resp)
+}
+
+// parseHeight returns the height set by the BlockHeightHeader of r, or 0 for
+// the latest height.
+func parseHeight(r *http.Request) (uint64, error) {
+	value := r.Header.Get(BlockHeightHeader)
+	if value == "" {
+		return 0, nil
+	}
+
+	height, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid %s header %q: %v", BlockHeightHeader, value, err)
+	}
+	return height, nil
+}
+
+// readJSONBody reads the body of r, of at most MaxBodySize bytes, which must
+// have the JSON content type.
+func readJSONBody(r *http.Request) ([]byte, error) {
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || mediaType != ContentTypeJSON {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported content type %q, expected %s", contentType, ContentTypeJSON)
+		}
+	}
+
+	defer r.Body.Close()
+	bz, err := io.ReadAll(io.LimitReader(r.Body, MaxBodySize+1))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error reading body: %v", err)
+	}
+	if len(bz) > MaxBodySize {
+		return nil, status.Errorf(codes.ResourceExhausted, "body exceeds %d bytes", MaxBodySize)
+	}
+	return bz, nil
+}
+
+// queryParamsToJSON converts the query parameters of a GET request to the JSON
+// encoding of a message of type desc. The parameters are named after the fields,
+// with a dot separating the fields of nested messages (e.g. pagination.limit),
+// and repeated for the list fields.
+func queryParamsToJSON(values url.Values, desc protoreflect.MessageDescriptor) ([]byte, error) {
+	obj := map[string]any{}
+	for key, vals := range values {
+		cur, md := obj, desc
+		parts := strings.Split(key, ".")
+		for i, part := range parts {
+			fd := md.Fields().ByName(protoreflect.Name(part))
+			if fd == nil {
+				fd = md.Fields().ByJSONName(part)
+			}
+			if fd == nil {
+				return nil, status.Errorf(codes.InvalidArgument, "unknown query parameter %q", key)
+			}
+			name := string(fd.Name())
+
+			if i < len(parts)-1 {
+				if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+					return nil, status.Errorf(codes.InvalidArgument, "query parameter %q: %s is not a message", key, name)
+				}
+				child, ok := cur[name].(map[string]any)
+				if !ok {
+					child = map[string]any{}
+					cur[name] = child
+				}
+				cur, md = child, fd.Message()
+				continue
+			}
+
+			if !isScalarField(fd) {
+				return nil, status.Errorf(codes.InvalidArgument, "query parameter %q: %s cannot be set from a query parameter", key, name)
+			}
+			if !fd.IsList() {
+				if len(vals) != 1 {
+					return nil, status.Errorf(codes.InvalidArgument, "query parameter %q is repeated", key)
+				}
+				value, err := scalarValue(fd, vals[0])
+				if err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "query parameter %q: %v", key, err)
+				}
+				cur[name] = value
+				continue
+			}
+
+			list := make([]any, len(vals))
+			for j, v := range vals {
+				value, err := scalarValue(fd, v)
+				if err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "query parameter %q: %v", key, err)
+				}
+				list[j] = value
+			}
+			cur[name] = list
+		}
+	}
+	return json.Marshal(obj)
+}
+
+// isScalarField reports whether fd can be set from query parameters: all the
+// non-map fields but the messages, except the ones encoded as JSON strings.
+func isScalarField(fd protoreflect.FieldDescriptor) bool {
+	if fd.IsMap() {
+		return false
+	}
+	if fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind {
+		return true
+	}
+	switch fd.Message().FullName() {
+	case "google.protobuf.Timestamp", "google.protobuf.Duration":
+		return true
+	}
+	return false
+}
+
+// scalarValue returns the JSON value of the query parameter v of fd: a boolean
+// for the bool fields, a number for the 32 bits numbers and floats, a string
+// otherwise, as the 64 bits integers are encoded as strings in JSON.
+func scalarValue(fd protoreflect.FieldDescriptor, v string) (any, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid boolean %q", v)
+		}
+		return b, nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.FloatKind, protoreflect.DoubleKind:
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			return nil, fmt.Errorf("invalid number %q", v)
+		}
+		return json.Number(v), nil
+	case protoreflect.EnumKind:
+		if _, err := strconv.ParseInt(v, 10, 32); err == nil {
+			return json.Number(v), nil
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
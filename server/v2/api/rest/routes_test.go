@@ -0,0 +1,240 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	errorsv2 "cosmossdk.io/errors/v2"
+	"cosmossdk.io/log"
+	"cosmossdk.io/server/v2/appmanager"
+)
+
+type testTx struct {
+	msgs []transaction.Msg
+}
+
+func (t testTx) Hash() [32]byte                          { return [32]byte{} }
+func (t testTx) GetMessages() ([]transaction.Msg, error) { return t.msgs, nil }
+func (t testTx) GetSenders() ([]transaction.Identity, error) {
+	return nil, nil
+}
+func (t testTx) GetGasLimit() (uint64, error) { return 0, nil }
+func (t testTx) Bytes() []byte                { return nil }
+
+// testTxCodec decodes the JSON of a MsgSend to a tx containing it.
+type testTxCodec struct{}
+
+func (testTxCodec) Decode([]byte) (testTx, error) {
+	return testTx{}, errors.New("not implemented")
+}
+
+func (testTxCodec) DecodeJSON(bz []byte) (testTx, error) {
+	msg := &bankv1beta1.MsgSend{}
+	if err := protojson.Unmarshal(bz, msg); err != nil {
+		return testTx{}, err
+	}
+	return testTx{msgs: []transaction.Msg{msg}}, nil
+}
+
+type mockAppManager struct {
+	appmanager.AppManager[testTx]
+
+	query    func(ctx context.Context, version uint64, req transaction.Msg) (transaction.Msg, error)
+	simulate func(ctx context.Context, tx testTx) (server.TxResult, error)
+}
+
+func (m mockAppManager) Query(ctx context.Context, version uint64, req transaction.Msg) (transaction.Msg, error) {
+	return m.query(ctx, version, req)
+}
+
+func (m mockAppManager) Simulate(ctx context.Context, tx testTx) (server.TxResult, store.WriterMap, error) {
+	res, err := m.simulate(ctx, tx)
+	return res, nil, err
+}
+
+func newTestServer(t *testing.T, am mockAppManager) *Server[testTx] {
+	t.Helper()
+	queryHandlers := map[string]appmodulev2.Handler{
+		"cosmos.bank.v1beta1.QueryBalanceRequest": {
+			Func:        func(context.Context, transaction.Msg) (transaction.Msg, error) { return nil, nil },
+			MakeMsg:     func() transaction.Msg { return &bankv1beta1.QueryBalanceRequest{} },
+			MakeMsgResp: func() transaction.Msg { return &bankv1beta1.QueryBalanceResponse{} },
+		},
+		"cosmos.bank.v1beta1.QueryAllBalancesRequest": {
+			Func:        func(context.Context, transaction.Msg) (transaction.Msg, error) { return nil, nil },
+			MakeMsg:     func() transaction.Msg { return &bankv1beta1.QueryAllBalancesRequest{} },
+			MakeMsgResp: func() transaction.Msg { return &bankv1beta1.QueryAllBalancesResponse{} },
+		},
+	}
+	srv, err := New[testTx](log.NewNopLogger(), am, queryHandlers, testTxCodec{}, nil)
+	require.NoError(t, err)
+	return srv
+}
+
+func serve(srv *Server[testTx], method, target, body string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	srv.router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestQueryRoutes(t *testing.T) {
+	var (
+		gotVersion uint64
+		gotReq     transaction.Msg
+	)
+	srv := newTestServer(t, mockAppManager{
+		query: func(_ context.Context, version uint64, req transaction.Msg) (transaction.Msg, error) {
+			gotVersion, gotReq = version, req
+			if req.(*bankv1beta1.QueryBalanceRequest).Address == "unknown" {
+				return nil, errorsv2.ABCIError("sdk", 22, "account")
+			}
+			return &bankv1beta1.QueryBalanceResponse{Balance: &basev1beta1.Coin{Denom: "stake", Amount: "10"}}, nil
+		},
+	})
+	const path = "/cosmos.bank.v1beta1.Query/Balance"
+	expectedReq := &bankv1beta1.QueryBalanceRequest{Address: "addr", Denom: "stake"}
+
+	rec := serve(srv, http.MethodPost, path, `{"address":"addr","denom":"stake"}`, http.Header{
+		"Content-Type": {ContentTypeJSON},
+		http.CanonicalHeaderKey(BlockHeightHeader): {"5"},
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.JSONEq(t, `{"balance":{"denom":"stake","amount":"10"}}`, rec.Body.String())
+	require.Equal(t, uint64(5), gotVersion)
+	require.Equal(t, expectedReq.String(), gotReq.String())
+
+	// module query safe queries are also served with GET
+	rec = serve(srv, http.MethodGet, path+"?address=addr&denom=stake", "", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, uint64(0), gotVersion)
+	require.Equal(t, expectedReq.String(), gotReq.String())
+
+	rec = serve(srv, http.MethodGet, path+"?owner=addr", "", nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = serve(srv, http.MethodGet, path, "", http.Header{http.CanonicalHeaderKey(BlockHeightHeader): {"latest"}})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// the errors are mapped from their ABCI code
+	rec = serve(srv, http.MethodPost, path, `{"address":"unknown"}`, nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	var errResp ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+	require.Equal(t, ErrorResponse{Code: 22, Codespace: "sdk", Message: "account: unknown"}, errResp)
+}
+
+func TestSimulateRoute(t *testing.T) {
+	var simErr error
+	srv := newTestServer(t, mockAppManager{
+		simulate: func(_ context.Context, tx testTx) (server.TxResult, error) {
+			return server.TxResult{
+				GasWanted: 200,
+				GasUsed:   100,
+				Resp:      []transaction.Msg{&bankv1beta1.MsgSendResponse{}},
+				Events:    []event.Event{event.NewEvent("transfer", event.NewAttribute("amount", "10stake"))},
+				Error:     simErr,
+			}, nil
+		},
+	})
+	const tx = `{"from_address":"from","to_address":"to","amount":[{"denom":"stake","amount":"10"}]}`
+
+	rec := serve(srv, http.MethodPost, "/cosmos.bank.v1beta1.Msg/Send"+SimulateSuffix, tx, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.JSONEq(t, `{
+		"gas_wanted": "200",
+		"gas_used": "100",
+		"responses": [{}],
+		"events": [{"type": "transfer", "attributes": [{"key": "amount", "value": "10stake"}]}]
+	}`, rec.Body.String())
+
+	// the tx must contain a message of the route
+	rec = serve(srv, http.MethodPost, "/cosmos.bank.v1beta1.Msg/MultiSend"+SimulateSuffix, tx, nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	simErr = errorsv2.ABCIError("bank", 5, "insufficient funds")
+	rec = serve(srv, http.MethodPost, "/cosmos.bank.v1beta1.Msg/Send"+SimulateSuffix, tx, nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	var errResp ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+	require.Equal(t, uint32(5), errResp.Code)
+	require.Equal(t, "bank", errResp.Codespace)
+}
+
+func TestOpenAPI(t *testing.T) {
+	srv := newTestServer(t, mockAppManager{})
+	rec := serve(srv, http.MethodGet, OpenAPIPath, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var doc openAPIDoc
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+	require.Equal(t, "3.0.3", doc.OpenAPI)
+
+	balance := doc.Paths["/cosmos.bank.v1beta1.Query/Balance"]
+	require.Contains(t, balance, "post")
+	require.Contains(t, balance, "get")
+	require.True(t, balance["get"].ModuleQuerySafe)
+	require.Equal(t, schemaRef("cosmos.bank.v1beta1.QueryBalanceResponse"), balance["post"].Responses["200"].Content[ContentTypeJSON].Schema.Ref)
+
+	var params []string
+	for _, p := range doc.Paths["/cosmos.bank.v1beta1.Query/AllBalances"]["get"].Parameters {
+		params = append(params, p.Name)
+	}
+	require.Contains(t, params, "pagination.limit")
+	require.Contains(t, params, BlockHeightHeader)
+
+	// queries without handlers have no route
+	require.NotContains(t, doc.Paths, "/cosmos.bank.v1beta1.Query/SupplyOf")
+	require.Contains(t, doc.Paths, "/cosmos.bank.v1beta1.Msg/Send"+SimulateSuffix)
+
+	coin := doc.Components.Schemas["cosmos.base.v1beta1.Coin"]
+	require.NotNil(t, coin)
+	require.Equal(t, "string", coin.Properties["amount"].Type)
+	require.Equal(t, "array", doc.Components.Schemas["cosmos.bank.v1beta1.QueryAllBalancesResponse"].Properties["balances"].Type)
+}
+
+func TestQueryParamsToJSON(t *testing.T) {
+	desc := (&bankv1beta1.QueryAllBalancesRequest{}).ProtoReflect().Descriptor()
+	values := url.Values{
+		"address":            {"addr"},
+		"resolveDenom":       {"true"},
+		"pagination.limit":   {"10"},
+		"pagination.reverse": {"1"},
+	}
+	bz, err := queryParamsToJSON(values, desc)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"address":"addr","resolve_denom":true,"pagination":{"limit":"10","reverse":true}}`, string(bz))
+
+	req := &bankv1beta1.QueryAllBalancesRequest{}
+	require.NoError(t, unmarshalJSON(bz, req))
+	require.Equal(t, uint64(10), req.Pagination.Limit)
+
+	for _, invalid := range []url.Values{
+		{"address": {"a", "b"}},
+		{"pagination": {"10"}},
+		{"address.limit": {"10"}},
+		{"resolve_denom": {"yes"}},
+	} {
+		_, err := queryParamsToJSON(invalid, desc)
+		require.Error(t, err, invalid)
+	}
+}
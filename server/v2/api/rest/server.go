@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"net/http"
 
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
 	"cosmossdk.io/core/server"
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/log"
@@ -25,9 +28,14 @@ type Server[T transaction.Tx] struct {
 	cfgOptions []CfgOption
 }
 
+// New creates a new REST server. Besides the default handler, it serves a
+// route for each query with a handler in queryHandlers and a simulation route
+// for each message service method, all documented by an OpenAPI document.
 func New[T transaction.Tx](
 	logger log.Logger,
 	appManager appmanager.AppManager[T],
+	queryHandlers map[string]appmodulev2.Handler,
+	txCodec transaction.Codec[T],
 	cfg server.ConfigMap,
 	cfgOptions ...CfgOption,
 ) (*Server[T], error) {
@@ -37,6 +45,13 @@ func New[T transaction.Tx](
 		router:     http.NewServeMux(),
 	}
 
+	routes := buildRoutes(gogoproto.HybridResolver, queryHandlers)
+	registerRoutes(srv.router, routes, appManager, queryHandlers, txCodec)
+	openAPIHandler, err := newOpenAPIHandler(newOpenAPIDoc(routes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build OpenAPI document: %w", err)
+	}
+	srv.router.Handle(http.MethodGet+" "+OpenAPIPath, openAPIHandler)
 	srv.router.Handle("/", NewDefaultHandler(appManager))
 
 	serverCfg := srv.Config().(*Config)
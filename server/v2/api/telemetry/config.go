@@ -13,6 +13,11 @@ func DefaultConfig() *Config {
 		MetricsSink:             "",
 		StatsdAddr:              "",
 		DatadogHostname:         "",
+		OTelEnabled:             false,
+		OTelEndpoint:            "",
+		OTelInsecure:            false,
+		OTelTraceSampleRatio:    1,
+		OTelMetricsInterval:     60,
 	}
 }
 
@@ -58,4 +63,24 @@ type Config struct {
 	// DatadogHostname defines the hostname to use when emitting metrics to
 	// Datadog. Only utilized if MetricsSink is set to "dogstatsd".
 	DatadogHostname string `mapstructure:"datadog-hostname" toml:"data-dog-hostname" comment:"DatadogHostname defines the hostname to use when emitting metrics to Datadog. Only utilized if MetricsSink is set to \"dogstatsd\"."`
+
+	// OTelEnabled enables the OpenTelemetry provider. When enabled, spans and
+	// metrics are exported to an OTLP gRPC endpoint.
+	OTelEnabled bool `mapstructure:"otel-enabled" toml:"otel-enabled" comment:"OTelEnabled enables the OpenTelemetry provider. When enabled, spans and metrics are exported to an OTLP gRPC endpoint."`
+
+	// OTelEndpoint defines the host:port of the OTLP gRPC endpoint. When empty,
+	// the OTLP exporters defaults (and OTEL_EXPORTER_OTLP_* environment
+	// variables) are used.
+	OTelEndpoint string `mapstructure:"otel-endpoint" toml:"otel-endpoint" comment:"OTelEndpoint defines the host:port of the OTLP gRPC endpoint. When empty, the OTEL_EXPORTER_OTLP_* environment variables or the exporters defaults are used."`
+
+	// OTelInsecure disables the client transport security of the OTLP exporters.
+	OTelInsecure bool `mapstructure:"otel-insecure" toml:"otel-insecure" comment:"OTelInsecure disables the client transport security of the OTLP exporters."`
+
+	// OTelTraceSampleRatio defines the ratio of traces which are sampled, between
+	// 0 and 1. Spans are always sampled if their parent is.
+	OTelTraceSampleRatio float64 `mapstructure:"otel-trace-sample-ratio" toml:"otel-trace-sample-ratio" comment:"OTelTraceSampleRatio defines the ratio of traces which are sampled, between 0 and 1."`
+
+	// OTelMetricsInterval defines the interval in seconds at which metrics are
+	// exported to the OTLP endpoint.
+	OTelMetricsInterval int64 `mapstructure:"otel-metrics-interval" toml:"otel-metrics-interval" comment:"OTelMetricsInterval defines the interval in seconds at which metrics are exported."`
 }
@@ -0,0 +1,95 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// DefaultOTelMetricsInterval is the interval at which metrics are pushed to the
+// OTLP endpoint when Config.OTelMetricsInterval is not set.
+const DefaultOTelMetricsInterval = 60 * time.Second
+
+// OTelProvider defines a wrapper around the OpenTelemetry tracer and meter
+// providers. When created, it is registered as the global OpenTelemetry
+// provider, so the spans of the consensus and of the STF are exported through
+// it.
+type OTelProvider struct {
+	tracerProvider *sdktrace.TracerProvider
+	meterProvider  *sdkmetric.MeterProvider
+}
+
+// NewOTelProvider creates a new OTelProvider exporting spans and metrics to the
+// OTLP gRPC endpoint defined in the configuration. It returns nil if
+// OpenTelemetry is disabled.
+func NewOTelProvider(ctx context.Context, cfg *Config) (*OTelProvider, error) {
+	if !cfg.OTelEnabled {
+		return nil, nil
+	}
+
+	traceOpts := []otlptracegrpc.Option{}
+	metricOpts := []otlpmetricgrpc.Option{}
+	if cfg.OTelEndpoint != "" {
+		traceOpts = append(traceOpts, otlptracegrpc.WithEndpoint(cfg.OTelEndpoint))
+		metricOpts = append(metricOpts, otlpmetricgrpc.WithEndpoint(cfg.OTelEndpoint))
+	}
+	if cfg.OTelInsecure {
+		traceOpts = append(traceOpts, otlptracegrpc.WithInsecure())
+		metricOpts = append(metricOpts, otlpmetricgrpc.WithInsecure())
+	}
+
+	traceExporter, err := otlptracegrpc.New(ctx, traceOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	metricExporter, err := otlpmetricgrpc.New(ctx, metricOpts...)
+	if err != nil {
+		return nil, errors.Join(err, traceExporter.Shutdown(ctx))
+	}
+
+	interval := DefaultOTelMetricsInterval
+	if cfg.OTelMetricsInterval > 0 {
+		interval = time.Duration(cfg.OTelMetricsInterval) * time.Second
+	}
+
+	res := resource.NewSchemaless(attribute.String("service.name", cfg.ServiceName))
+
+	p := &OTelProvider{
+		tracerProvider: sdktrace.NewTracerProvider(
+			sdktrace.WithResource(res),
+			sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.OTelTraceSampleRatio))),
+			sdktrace.WithBatcher(traceExporter),
+		),
+		meterProvider: sdkmetric.NewMeterProvider(
+			sdkmetric.WithResource(res),
+			sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter, sdkmetric.WithInterval(interval))),
+		),
+	}
+
+	otel.SetTracerProvider(p.tracerProvider)
+	otel.SetMeterProvider(p.meterProvider)
+
+	return p, nil
+}
+
+// Shutdown flushes the pending spans and metrics and stops the providers. The
+// global OpenTelemetry providers are reset to no-op providers.
+func (p *OTelProvider) Shutdown(ctx context.Context) error {
+	if tp, ok := otel.GetTracerProvider().(*sdktrace.TracerProvider); ok && tp == p.tracerProvider {
+		otel.SetTracerProvider(tracenoop.NewTracerProvider())
+		otel.SetMeterProvider(metricnoop.NewMeterProvider())
+	}
+
+	return errors.Join(p.tracerProvider.Shutdown(ctx), p.meterProvider.Shutdown(ctx))
+}
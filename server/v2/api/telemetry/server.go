@@ -3,6 +3,7 @@ package telemetry
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -21,10 +22,11 @@ var (
 const ServerName = "telemetry"
 
 type Server[T transaction.Tx] struct {
-	logger  log.Logger
-	config  *Config
-	server  *http.Server
-	metrics *Metrics
+	logger       log.Logger
+	config       *Config
+	server       *http.Server
+	metrics      *Metrics
+	otelProvider *OTelProvider
 }
 
 // New creates a new telemetry server.
@@ -79,7 +81,15 @@ func (s *Server[T]) Config() any {
 	return s.config
 }
 
+// Start installs the OpenTelemetry provider, if enabled, and starts the metrics
+// server.
 func (s *Server[T]) Start(ctx context.Context) error {
+	otelProvider, err := NewOTelProvider(ctx, s.config)
+	if err != nil {
+		return fmt.Errorf("failed to start the OpenTelemetry provider: %w", err)
+	}
+	s.otelProvider = otelProvider
+
 	if !s.config.Enable {
 		s.logger.Info(fmt.Sprintf("%s server is disabled via config", s.Name()))
 		return nil
@@ -93,13 +103,19 @@ func (s *Server[T]) Start(ctx context.Context) error {
 	return nil
 }
 
+// Stop stops the metrics server and shuts the OpenTelemetry provider down.
 func (s *Server[T]) Stop(ctx context.Context) error {
+	var err error
+	if s.otelProvider != nil {
+		err = s.otelProvider.Shutdown(ctx)
+	}
+
 	if !s.config.Enable || s.server == nil {
-		return nil
+		return err
 	}
 
 	s.logger.Info("stopping telemetry server...", "address", s.config.Address)
-	return s.server.Shutdown(ctx)
+	return errors.Join(err, s.server.Shutdown(ctx))
 }
 
 func (s *Server[T]) metricsHandler(w http.ResponseWriter, r *http.Request) {
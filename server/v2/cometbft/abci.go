@@ -34,6 +34,8 @@ import (
 	"cosmossdk.io/server/v2/streaming"
 	"cosmossdk.io/store/v2/snapshots"
 	consensustypes "cosmossdk.io/x/consensus/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 const (
@@ -364,6 +366,9 @@ func (c *consensus[T]) PrepareProposal(
 	ctx context.Context,
 	req *abciproto.PrepareProposalRequest,
 ) (resp *abciproto.PrepareProposalResponse, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "PrepareProposal", telemetry.AttributeKeyHeight.Int64(req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	if req.Height < 1 {
 		return nil, errors.New("PrepareProposal called with invalid height")
 	}
@@ -407,7 +412,10 @@ func (c *consensus[T]) PrepareProposal(
 func (c *consensus[T]) ProcessProposal(
 	ctx context.Context,
 	req *abciproto.ProcessProposalRequest,
-) (*abciproto.ProcessProposalResponse, error) {
+) (_ *abciproto.ProcessProposalResponse, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "ProcessProposal", telemetry.AttributeKeyHeight.Int64(req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	if req.Height < 1 {
 		return nil, errors.New("ProcessProposal called with invalid height")
 	}
@@ -433,8 +441,7 @@ func (c *consensus[T]) ProcessProposal(
 		LastCommit:      toCoreCommitInfo(req.ProposedLastCommit),
 	})
 
-	err := c.processProposalHandler(ciCtx, c.app, c.appCodecs.TxCodec, req)
-	if err != nil {
+	if err := c.processProposalHandler(ciCtx, c.app, c.appCodecs.TxCodec, req); err != nil {
 		c.logger.Error("failed to process proposal", "height", req.Height, "time", req.Time, "hash", fmt.Sprintf("%X", req.Hash), "err", err)
 		return &abciproto.ProcessProposalResponse{
 			Status: abciproto.PROCESS_PROPOSAL_STATUS_REJECT,
@@ -462,12 +469,14 @@ func (c *consensus[T]) ProcessProposal(
 func (c *consensus[T]) FinalizeBlock(
 	ctx context.Context,
 	req *abciproto.FinalizeBlockRequest,
-) (*abciproto.FinalizeBlockResponse, error) {
+) (_ *abciproto.FinalizeBlockResponse, err error) {
+	ctx, span := telemetry.StartSpan(ctx, "FinalizeBlock", telemetry.AttributeKeyHeight.Int64(req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	var (
 		resp       *server.BlockResponse
 		newState   store.WriterMap
 		decodedTxs []T
-		err        error
 	)
 
 	if c.optimisticExec.Initialized() {
@@ -505,7 +514,7 @@ func (c *consensus[T]) FinalizeBlock(
 	if err != nil {
 		return nil, err
 	}
-	appHash, err := c.store.Commit(&store.Changeset{Version: uint64(req.Height), Changes: stateChanges})
+	appHash, err := c.commitStore(ctx, &store.Changeset{Version: uint64(req.Height), Changes: stateChanges})
 	if err != nil {
 		return nil, fmt.Errorf("unable to commit the changeset: %w", err)
 	}
@@ -547,6 +556,14 @@ func (c *consensus[T]) FinalizeBlock(
 	)
 }
 
+// commitStore commits the changeset to the store, in a span.
+func (c *consensus[T]) commitStore(ctx context.Context, cs *store.Changeset) (_ []byte, err error) {
+	_, span := telemetry.StartSpan(ctx, "StoreCommit", telemetry.AttributeKeyHeight.Int64(int64(cs.Version)))
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	return c.store.Commit(cs)
+}
+
 func (c *consensus[T]) internalFinalizeBlock(
 	ctx context.Context,
 	req *abciproto.FinalizeBlockRequest,
@@ -595,8 +612,10 @@ func (c *consensus[T]) internalFinalizeBlock(
 
 // Commit implements types.Application.
 // It is called by cometbft to notify the application that a block was committed.
-func (c *consensus[T]) Commit(ctx context.Context, _ *abciproto.CommitRequest) (*abciproto.CommitResponse, error) {
+func (c *consensus[T]) Commit(ctx context.Context, _ *abciproto.CommitRequest) (_ *abciproto.CommitResponse, err error) {
 	lastCommittedHeight := c.lastCommittedHeight.Load()
+	ctx, span := telemetry.StartSpan(ctx, "Commit", telemetry.AttributeKeyHeight.Int64(lastCommittedHeight))
+	defer func() { telemetry.EndSpan(span, err) }()
 
 	c.snapshotManager.SnapshotIfApplicable(lastCommittedHeight)
 
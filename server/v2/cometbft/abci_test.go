@@ -18,6 +18,7 @@ import (
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	gogotypes "github.com/cosmos/gogoproto/types"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
 	"cosmossdk.io/core/server"
@@ -35,6 +36,8 @@ import (
 	"cosmossdk.io/server/v2/stf/mock"
 	consensustypes "cosmossdk.io/x/consensus/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/cosmos/cosmos-sdk/telemetry/oteltest"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -304,6 +307,58 @@ func TestConsensus_FinalizeBlock_MultiTxs(t *testing.T) {
 	require.Equal(t, int64(endBlock), c.lastCommittedHeight.Load())
 }
 
+func TestConsensus_Tracing(t *testing.T) {
+	provider, spans, _, err := oteltest.NewInMemoryProvider()
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, provider.Shutdown(context.Background())) })
+
+	c := setUpConsensus(t, 100_000, mempool.NoOpMempool[mock.Tx]{})
+	proposalHandler := handlers.NewDefaultProposalHandler(c.mempool)
+	c.prepareProposalHandler = proposalHandler.PrepareHandler()
+	c.processProposalHandler = proposalHandler.ProcessHandler()
+
+	_, err = c.InitChain(context.Background(), &abciproto.InitChainRequest{
+		Time:          time.Now(),
+		ChainId:       "test",
+		InitialHeight: 1,
+	})
+	require.NoError(t, err)
+
+	txs := [][]byte{mockTx.Bytes(), mockTx.Bytes()}
+	_, err = c.PrepareProposal(context.Background(), &abciproto.PrepareProposalRequest{Height: 1, MaxTxBytes: 1 << 20})
+	require.NoError(t, err)
+	_, err = c.ProcessProposal(context.Background(), &abciproto.ProcessProposalRequest{Height: 1, Txs: txs})
+	require.NoError(t, err)
+	_, err = c.FinalizeBlock(context.Background(), &abciproto.FinalizeBlockRequest{
+		Time:   time.Now(),
+		Height: 1,
+		Hash:   emptyHash[:],
+		Txs:    txs,
+	})
+	require.NoError(t, err)
+	_, err = c.Commit(context.Background(), &abciproto.CommitRequest{})
+	require.NoError(t, err)
+
+	byName := map[string][]tracetest.SpanStub{}
+	for _, span := range spans.GetSpans() {
+		byName[span.Name] = append(byName[span.Name], span)
+	}
+
+	for _, name := range []string{"PrepareProposal", "ProcessProposal", "FinalizeBlock", "StoreCommit", "Commit"} {
+		require.Len(t, byName[name], 1, name)
+		require.Contains(t, byName[name][0].Attributes, telemetry.AttributeKeyHeight.Int64(1), name)
+	}
+
+	// the store commit and the txs executed by the STF are children of the
+	// FinalizeBlock span
+	finalizeBlock := byName["FinalizeBlock"][0].SpanContext.SpanID()
+	require.Equal(t, finalizeBlock, byName["StoreCommit"][0].Parent.SpanID())
+	require.Len(t, byName["Tx"], 2)
+	for _, tx := range byName["Tx"] {
+		require.Equal(t, finalizeBlock, tx.Parent.SpanID())
+	}
+}
+
 func TestConsensus_CheckTx(t *testing.T) {
 	c := setUpConsensus(t, 0, mempool.NoOpMempool[mock.Tx]{})
 
@@ -790,20 +845,27 @@ func TestConsensus_AppQuery(t *testing.T) {
 func setUpConsensus(t *testing.T, gasLimit uint64, mempool mempool.Mempool[mock.Tx]) *consensus[mock.Tx] {
 	t.Helper()
 
+	return setUpConsensusWithParams(t, gasLimit, mempool, &v1.ConsensusParams{
+		Block: &v1.BlockParams{
+			MaxGas: 300000,
+		},
+		Feature: &v1.FeatureParams{
+			VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: 2},
+		},
+	})
+}
+
+// setUpConsensusWithParams sets up a consensus whose consensus params are
+// cParams.
+func setUpConsensusWithParams(t *testing.T, gasLimit uint64, mempool mempool.Mempool[mock.Tx], cParams *v1.ConsensusParams) *consensus[mock.Tx] {
+	t.Helper()
+
 	queryHandler := make(map[string]appmodulev2.Handler)
 	msgRouterBuilder := getMsgRouterBuilder(t, func(ctx context.Context, msg *gogotypes.BoolValue) (*gogotypes.BoolValue, error) {
 		return msg, nil
 	})
 
 	queryRouterBuilder := getQueryRouterBuilder(t, func(ctx context.Context, q *consensustypes.QueryParamsRequest) (*consensustypes.QueryParamsResponse, error) {
-		cParams := &v1.ConsensusParams{
-			Block: &v1.BlockParams{
-				MaxGas: 300000,
-			},
-			Feature: &v1.FeatureParams{
-				VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: 2},
-			},
-		}
 		return &consensustypes.QueryParamsResponse{
 			Params: cParams,
 		}, nil
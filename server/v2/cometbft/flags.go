@@ -51,11 +51,12 @@ func prefix(f string) string {
 
 // Server flags
 var (
-	Standalone        = prefix("standalone")
-	FlagAddress       = prefix("address")
-	FlagTransport     = prefix("transport")
-	FlagHaltHeight    = prefix("halt-height")
-	FlagHaltTime      = prefix("halt-time")
-	FlagTrace         = prefix("trace")
-	FlagMempoolMaxTxs = prefix("mempool.max-txs")
+	Standalone             = prefix("standalone")
+	FlagAddress            = prefix("address")
+	FlagTransport          = prefix("transport")
+	FlagHealthCheckTimeout = prefix("health-check-timeout")
+	FlagHaltHeight         = prefix("halt-height")
+	FlagHaltTime           = prefix("halt-time")
+	FlagTrace              = prefix("trace")
+	FlagMempoolMaxTxs      = prefix("mempool.max-txs")
 )
@@ -31,6 +31,12 @@ func NewDefaultProposalHandler[T transaction.Tx](mp mempool.Mempool[T]) *Default
 	}
 }
 
+// SetTxSelector sets the TxSelector used to select the transactions of a
+// proposal in PrepareProposal.
+func (h *DefaultProposalHandler[T]) SetTxSelector(ts TxSelector[T]) {
+	h.txSelector = ts
+}
+
 func (h *DefaultProposalHandler[T]) PrepareHandler() PrepareHandler[T] {
 	return func(ctx context.Context, app AppManager[T], codec transaction.Codec[T], req *abci.PrepareProposalRequest) ([]T, error) {
 		var maxBlockGas uint64
@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/server/v2/cometbft/mempool"
+	consensustypes "cosmossdk.io/x/consensus/types"
+
+	"github.com/cosmos/cosmos-sdk/types/mempool/lanes"
+)
+
+// LanedProposalHandler is a DefaultProposalHandler building blocks out of the
+// lanes of a mempool.LanedMempool. Each lane gets at most its share of the block
+// bytes and gas, and the transactions of a block are grouped by lane, in the
+// lanes order. Its ProcessProposal handler verifies this before the default
+// verification.
+type LanedProposalHandler[T transaction.Tx] struct {
+	*DefaultProposalHandler[T]
+	lanes []mempool.Lane[T]
+	specs []lanes.Spec
+}
+
+// NewLanedProposalHandler returns a LanedProposalHandler of the lanes of mp.
+func NewLanedProposalHandler[T transaction.Tx](mp *mempool.LanedMempool[T]) *LanedProposalHandler[T] {
+	h := NewDefaultProposalHandler[T](mp)
+	h.SetTxSelector(NewLaneTxSelector(mp.Lanes()))
+	return &LanedProposalHandler[T]{
+		DefaultProposalHandler: h,
+		lanes:                  mp.Lanes(),
+		specs:                  mempool.LaneSpecs(mp.Lanes()),
+	}
+}
+
+// ProcessHandler returns a ProcessProposal handler rejecting the proposals
+// whose transactions are not grouped by lane in the lanes order, use more than
+// the share of a lane of the block bytes or gas, or fail the verification of
+// their lane. The other proposals are verified by the DefaultProposalHandler.
+//
+// Note, the byte share of a lane is checked against the block max bytes, as
+// the max tx bytes of PrepareProposal are not known.
+func (h *LanedProposalHandler[T]) ProcessHandler() ProcessHandler[T] {
+	next := h.DefaultProposalHandler.ProcessHandler()
+
+	return func(ctx context.Context, app AppManager[T], codec transaction.Codec[T], req *abci.ProcessProposalRequest) error {
+		res, err := app.Query(ctx, 0, &consensustypes.QueryParamsRequest{})
+		if err != nil {
+			return err
+		}
+
+		paramsResp, ok := res.(*consensustypes.QueryParamsResponse)
+		if !ok {
+			return fmt.Errorf("unexpected consensus params response type; expected: %T, got: %T", &consensustypes.QueryParamsResponse{}, res)
+		}
+
+		var maxBlockBytes, maxBlockGas uint64
+		if b := paramsResp.GetParams().Block; b != nil {
+			if b.MaxBytes > 0 {
+				maxBlockBytes = uint64(b.MaxBytes)
+			}
+			if b.MaxGas > 0 {
+				maxBlockGas = uint64(b.MaxGas)
+			}
+		}
+
+		checker := lanes.NewProposalChecker(h.specs, maxBlockBytes, maxBlockGas)
+		laneTxs := make([][]T, len(h.lanes))
+		for i, txBz := range req.Txs {
+			tx, err := codec.Decode(txBz)
+			if err != nil {
+				return fmt.Errorf("failed to decode tx: %w", err)
+			}
+
+			lane := mempool.LaneIndex(ctx, h.lanes, tx)
+			var txGas uint64
+			if maxBlockGas > 0 {
+				if txGas, err = tx.GetGasLimit(); err != nil {
+					return fmt.Errorf("failed to get gas limit of tx %d: %w", i, err)
+				}
+			}
+			if err := checker.Add(lane, uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz})), txGas); err != nil {
+				return err
+			}
+			laneTxs[lane] = append(laneTxs[lane], tx)
+		}
+
+		for i, lane := range h.lanes {
+			if lane.VerifyTxs == nil {
+				continue
+			}
+			if err := lane.VerifyTxs(ctx, laneTxs[i]); err != nil {
+				return fmt.Errorf("failed to verify txs of lane %q: %w", lane.Name, err)
+			}
+		}
+
+		return next(ctx, app, codec, req)
+	}
+}
+
+// laneTxSelector is a TxSelector giving each lane at most its share of the
+// block bytes and gas.
+type laneTxSelector[T transaction.Tx] struct {
+	lanes   []mempool.Lane[T]
+	budget  *lanes.Budget
+	laneTxs [][]T
+}
+
+// NewLaneTxSelector returns a TxSelector selecting the transactions of the
+// lanes, each within its share of the block bytes and gas. The transactions
+// matching no lane are skipped, and the selected transactions are grouped by
+// lane, in the lanes order.
+func NewLaneTxSelector[T transaction.Tx](ls []mempool.Lane[T]) TxSelector[T] {
+	ts := &laneTxSelector[T]{lanes: ls, budget: lanes.NewBudget(mempool.LaneSpecs(ls))}
+	ts.Clear()
+	return ts
+}
+
+func (ts *laneTxSelector[T]) SelectedTxs(_ context.Context) []T {
+	var txs []T
+	for _, laneTxs := range ts.laneTxs {
+		txs = append(txs, laneTxs...)
+	}
+	return txs
+}
+
+func (ts *laneTxSelector[T]) Clear() {
+	ts.budget.Reset()
+	ts.laneTxs = make([][]T, len(ts.lanes))
+}
+
+func (ts *laneTxSelector[T]) SelectTxForProposal(ctx context.Context, maxTxBytes, maxBlockGas uint64, tx T) bool {
+	lane := mempool.LaneIndex(ctx, ts.lanes, tx)
+	txGasLimit, err := tx.GetGasLimit()
+
+	if lane >= 0 && err == nil {
+		txSize := uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tx.Bytes()}))
+
+		// only add the transaction to the proposal if both the block and the lane
+		// have enough capacity
+		if ts.budget.Add(lane, txSize, txGasLimit, maxTxBytes, maxBlockGas) {
+			ts.laneTxs[lane] = append(ts.laneTxs[lane], tx)
+		}
+	}
+
+	// check if we've reached capacity; if so, we cannot select any more transactions
+	return ts.budget.Full(maxTxBytes, maxBlockGas)
+}
@@ -0,0 +1,197 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/math"
+	"cosmossdk.io/server/v2/cometbft/handlers"
+	"cosmossdk.io/server/v2/cometbft/mempool"
+	"cosmossdk.io/server/v2/stf/mock"
+	consensustypes "cosmossdk.io/x/consensus/types"
+
+	"github.com/cosmos/cosmos-sdk/types/mempool/lanes"
+)
+
+// testAppManager is an AppManager accepting all the txs, with the given block
+// params.
+type testAppManager struct {
+	block *cmtproto.BlockParams
+}
+
+func (testAppManager) ValidateTx(context.Context, mock.Tx) (server.TxResult, error) {
+	return server.TxResult{}, nil
+}
+
+func (am testAppManager) Query(context.Context, uint64, transaction.Msg) (transaction.Msg, error) {
+	return &consensustypes.QueryParamsResponse{Params: &cmtproto.ConsensusParams{Block: am.block}}, nil
+}
+
+// listMempool is a mempool iterating over its transactions in their insertion
+// order.
+type listMempool struct {
+	txs []mock.Tx
+}
+
+func (mp *listMempool) Insert(_ context.Context, tx mock.Tx) error {
+	mp.txs = append(mp.txs, tx)
+	return nil
+}
+
+func (mp *listMempool) Select(_ context.Context, _ []mock.Tx) mempool.Iterator[mock.Tx] {
+	if len(mp.txs) == 0 {
+		return nil
+	}
+	return &listIterator{txs: mp.txs}
+}
+
+func (mp *listMempool) SelectBy(_ context.Context, _ []mock.Tx, callback func(mock.Tx) bool) {
+	for _, tx := range mp.txs {
+		if !callback(tx) {
+			return
+		}
+	}
+}
+
+func (mp *listMempool) CountTx() int {
+	return len(mp.txs)
+}
+
+func (mp *listMempool) Remove(mock.Tx) error {
+	return mempool.ErrTxNotFound
+}
+
+type listIterator struct {
+	txs []mock.Tx
+}
+
+func (it *listIterator) Next() mempool.Iterator[mock.Tx] {
+	if len(it.txs) == 1 {
+		return nil
+	}
+	return &listIterator{txs: it.txs[1:]}
+}
+
+func (it *listIterator) Tx() mock.Tx {
+	return it.txs[0]
+}
+
+func newTestTx(sender string, gasLimit uint64) mock.Tx {
+	return mock.Tx{Sender: []byte(sender), Msg: &gogotypes.BoolValue{Value: true}, GasLimit: gasLimit}
+}
+
+// newTestLanedMempool returns a mempool whose txs sent by "p..." go to the
+// priority lane, which gets at most half of the block, and the others to the
+// default lane.
+func newTestLanedMempool(t *testing.T) *mempool.LanedMempool[mock.Tx] {
+	t.Helper()
+	mp, err := mempool.NewLanedMempool(
+		mempool.Lane[mock.Tx]{
+			Name:          "priority",
+			Mempool:       &listMempool{},
+			Match:         func(_ context.Context, tx mock.Tx) bool { return strings.HasPrefix(string(tx.Sender), "p") },
+			MaxBlockSpace: math.LegacyNewDecWithPrec(5, 1),
+			VerifyTxs: func(_ context.Context, txs []mock.Tx) error {
+				for _, tx := range txs {
+					if string(tx.Sender) == "px" {
+						return errors.New("invalid priority tx")
+					}
+				}
+				return nil
+			},
+		},
+		mempool.Lane[mock.Tx]{
+			Name:          "default",
+			Mempool:       &listMempool{},
+			Match:         mempool.MatchAll[mock.Tx],
+			MaxBlockSpace: math.LegacyOneDec(),
+		},
+	)
+	require.NoError(t, err)
+	return mp
+}
+
+func TestLanedPrepareHandler(t *testing.T) {
+	ctx := context.Background()
+	mp := newTestLanedMempool(t)
+	for _, sender := range []string{"p1", "d1", "p2", "p3", "d2", "p4"} {
+		require.NoError(t, mp.Insert(ctx, newTestTx(sender, 100)))
+	}
+	txSize := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{newTestTx("p1", 100).Bytes()})
+
+	prepare := handlers.NewLanedProposalHandler(mp).PrepareHandler()
+	senders := func(txs []mock.Tx) []string {
+		var s []string
+		for _, tx := range txs {
+			s = append(s, string(tx.Sender))
+		}
+		return s
+	}
+
+	// the priority lane gets at most half of the block bytes, i.e. 2 txs
+	txs, err := prepare(ctx, testAppManager{}, mock.TxCodec{}, &abci.PrepareProposalRequest{MaxTxBytes: 4 * txSize})
+	require.NoError(t, err)
+	require.Equal(t, []string{"p1", "p2", "d1", "d2"}, senders(txs))
+
+	// or half of the block gas, i.e. 1 tx
+	txs, err = prepare(ctx, testAppManager{block: &cmtproto.BlockParams{MaxGas: 300}}, mock.TxCodec{}, &abci.PrepareProposalRequest{MaxTxBytes: 10 * txSize})
+	require.NoError(t, err)
+	require.Equal(t, []string{"p1", "d1", "d2"}, senders(txs))
+}
+
+func TestLanedProcessHandler(t *testing.T) {
+	ctx := context.Background()
+	process := handlers.NewLanedProposalHandler(newTestLanedMempool(t)).ProcessHandler()
+	app := testAppManager{block: &cmtproto.BlockParams{MaxBytes: 1 << 20, MaxGas: 1000}}
+
+	testCases := map[string]struct {
+		txs       []mock.Tx
+		expErr    error
+		expErrMsg string
+	}{
+		"lanes in order": {
+			txs: []mock.Tx{newTestTx("p1", 200), newTestTx("p2", 200), newTestTx("d1", 500)},
+		},
+		"lanes out of order": {
+			txs:    []mock.Tx{newTestTx("p1", 100), newTestTx("d1", 100), newTestTx("p2", 100)},
+			expErr: lanes.ErrLaneOrder,
+		},
+		"lane above its share": {
+			txs:    []mock.Tx{newTestTx("p1", 300), newTestTx("p2", 300)},
+			expErr: lanes.ErrLaneBlockSpace,
+		},
+		"lane verification failure": {
+			txs:       []mock.Tx{newTestTx("px", 100), newTestTx("d1", 100)},
+			expErrMsg: "invalid priority tx",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := &abci.ProcessProposalRequest{}
+			for _, tx := range tc.txs {
+				req.Txs = append(req.Txs, tx.Bytes())
+			}
+
+			err := process(ctx, app, mock.TxCodec{}, req)
+			switch {
+			case tc.expErr != nil:
+				require.ErrorIs(t, err, tc.expErr)
+			case tc.expErrMsg != "":
+				require.ErrorContains(t, err, tc.expErrMsg)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -55,8 +55,14 @@ func (s *MockStore) Commit(changeset *corestore.Changeset) (corestore.Hash, erro
 		return []byte{}, err
 	}
 
-	_, err = s.Committer.Commit(changeset.Version)
-	return []byte{}, err
+	if _, err = s.Committer.Commit(changeset.Version); err != nil {
+		return []byte{}, err
+	}
+
+	// the hash is the one of the last commit ID, as the consensus reports it
+	// in the ABCI handshake
+	cid, err := s.LastCommitID()
+	return cid.Hash, err
 }
 
 func (s *MockStore) StateAt(version uint64) (corestore.ReaderMap, error) {
@@ -0,0 +1,200 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/mempool/lanes"
+)
+
+var _ Mempool[transaction.Tx] = (*LanedMempool[transaction.Tx])(nil)
+
+// ErrNoLane is returned when inserting a transaction which matches no lane.
+var ErrNoLane = lanes.ErrNoLane
+
+// Lane is a partition of the mempool and of the block space. A transaction
+// belongs to the first lane it matches.
+type Lane[T transaction.Tx] struct {
+	// Name is the name of the lane, used in errors.
+	Name string
+	// Mempool holds the transactions of the lane. Its iteration order is the
+	// ordering policy of the lane.
+	Mempool Mempool[T]
+	// Match reports whether the transaction belongs to the lane.
+	Match func(ctx context.Context, tx T) bool
+	// MaxBlockSpace is the share of the block bytes and gas the transactions of
+	// the lane may use, between 0 (excluded) and 1.
+	MaxBlockSpace math.LegacyDec
+	// VerifyTxs optionally verifies the transactions of the lane in a block
+	// proposal, in ProcessProposal. They are in their block order.
+	VerifyTxs func(ctx context.Context, txs []T) error
+}
+
+// Spec returns the name and block space share of the lane.
+func (l Lane[T]) Spec() lanes.Spec {
+	return lanes.Spec{Name: l.Name, MaxBlockSpace: l.MaxBlockSpace}
+}
+
+// LaneSpecs returns the specs of the lanes, in the lanes order.
+func LaneSpecs[T transaction.Tx](ls []Lane[T]) []lanes.Spec {
+	specs := make([]lanes.Spec, len(ls))
+	for i, l := range ls {
+		specs[i] = l.Spec()
+	}
+	return specs
+}
+
+// MatchAll matches all transactions, it is the predicate of a default lane.
+func MatchAll[T transaction.Tx](context.Context, T) bool { return true }
+
+// MatchMsgTypeURLs returns a predicate matching the transactions whose messages
+// all have one of the given type URLs, e.g. "/cosmos.gov.v1.MsgVote".
+func MatchMsgTypeURLs[T transaction.Tx](typeURLs ...string) func(context.Context, T) bool {
+	set := make(map[string]struct{}, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		set[typeURL] = struct{}{}
+	}
+	return func(_ context.Context, tx T) bool {
+		msgs, err := tx.GetMessages()
+		if err != nil || len(msgs) == 0 {
+			return false
+		}
+		for _, msg := range msgs {
+			if _, ok := set["/"+gogoproto.MessageName(msg)]; !ok {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// LanedMempool is a mempool made of lanes. Transactions are inserted in the
+// mempool of the first lane they match, and are selected lane after lane, in
+// the lanes order.
+type LanedMempool[T transaction.Tx] struct {
+	lanes []Lane[T]
+}
+
+// NewLanedMempool returns a LanedMempool of the given lanes.
+func NewLanedMempool[T transaction.Tx](ls ...Lane[T]) (*LanedMempool[T], error) {
+	if err := lanes.Validate(LaneSpecs(ls)); err != nil {
+		return nil, err
+	}
+	for _, lane := range ls {
+		if lane.Mempool == nil || lane.Match == nil {
+			return nil, fmt.Errorf("lane %q: mempool and match must be set", lane.Name)
+		}
+	}
+	return &LanedMempool[T]{lanes: ls}, nil
+}
+
+// Lanes returns the lanes of the mempool.
+func (mp *LanedMempool[T]) Lanes() []Lane[T] {
+	return mp.lanes
+}
+
+// LaneIndex returns the index of the lane of the transaction, or -1 if it
+// matches no lane.
+func LaneIndex[T transaction.Tx](ctx context.Context, ls []Lane[T], tx T) int {
+	return lanes.Index(len(ls), func(i int) bool { return ls[i].Match(ctx, tx) })
+}
+
+// Insert inserts the transaction in the mempool of its lane.
+func (mp *LanedMempool[T]) Insert(ctx context.Context, tx T) error {
+	i := LaneIndex(ctx, mp.lanes, tx)
+	if i < 0 {
+		return ErrNoLane
+	}
+	return mp.lanes[i].Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the transactions of all the lanes, lane after
+// lane.
+func (mp *LanedMempool[T]) Select(ctx context.Context, txs []T) Iterator[T] {
+	laneTxs := mp.splitTxs(ctx, txs)
+	return nextLaneIterator(ctx, mp.lanes, laneTxs, 0)
+}
+
+// SelectBy calls callback with the transactions of all the lanes, lane after
+// lane, while it returns true.
+func (mp *LanedMempool[T]) SelectBy(ctx context.Context, txs []T, callback func(T) bool) {
+	laneTxs := mp.splitTxs(ctx, txs)
+	stopped := false
+	for i, lane := range mp.lanes {
+		lane.Mempool.SelectBy(ctx, laneTxs[i], func(tx T) bool {
+			stopped = !callback(tx)
+			return !stopped
+		})
+		if stopped {
+			return
+		}
+	}
+}
+
+// splitTxs returns the transactions of each lane.
+func (mp *LanedMempool[T]) splitTxs(ctx context.Context, txs []T) [][]T {
+	laneTxs := make([][]T, len(mp.lanes))
+	for _, tx := range txs {
+		if i := LaneIndex(ctx, mp.lanes, tx); i >= 0 {
+			laneTxs[i] = append(laneTxs[i], tx)
+		}
+	}
+	return laneTxs
+}
+
+// CountTx returns the number of transactions of all the lanes.
+func (mp *LanedMempool[T]) CountTx() int {
+	count := 0
+	for _, lane := range mp.lanes {
+		count += lane.Mempool.CountTx()
+	}
+	return count
+}
+
+// Remove removes the transaction from the lane holding it.
+func (mp *LanedMempool[T]) Remove(tx T) error {
+	for _, lane := range mp.lanes {
+		err := lane.Mempool.Remove(tx)
+		if !errors.Is(err, ErrTxNotFound) {
+			return err
+		}
+	}
+	return ErrTxNotFound
+}
+
+// laneIterator iterates over the transactions of a lane, then of the next ones.
+type laneIterator[T transaction.Tx] struct {
+	ctx     context.Context
+	lanes   []Lane[T]
+	laneTxs [][]T
+	lane    int
+	iter    Iterator[T]
+}
+
+// nextLaneIterator returns an iterator starting at the first non empty lane from
+// the given one, or nil.
+func nextLaneIterator[T transaction.Tx](ctx context.Context, lanes []Lane[T], laneTxs [][]T, lane int) Iterator[T] {
+	for ; lane < len(lanes); lane++ {
+		if iter := lanes[lane].Mempool.Select(ctx, laneTxs[lane]); iter != nil {
+			return &laneIterator[T]{ctx: ctx, lanes: lanes, laneTxs: laneTxs, lane: lane, iter: iter}
+		}
+	}
+	return nil
+}
+
+func (it *laneIterator[T]) Next() Iterator[T] {
+	if it.iter = it.iter.Next(); it.iter != nil {
+		return it
+	}
+	return nextLaneIterator(it.ctx, it.lanes, it.laneTxs, it.lane+1)
+}
+
+func (it *laneIterator[T]) Tx() T {
+	return it.iter.Tx()
+}
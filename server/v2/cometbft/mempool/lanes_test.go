@@ -0,0 +1,144 @@
+package mempool_test
+
+import (
+	"context"
+	"testing"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/server/v2/cometbft/mempool"
+	"cosmossdk.io/server/v2/stf/mock"
+)
+
+// listMempool is a mempool iterating over its transactions in their insertion
+// order.
+type listMempool struct {
+	txs []mock.Tx
+}
+
+func (mp *listMempool) Insert(_ context.Context, tx mock.Tx) error {
+	mp.txs = append(mp.txs, tx)
+	return nil
+}
+
+func (mp *listMempool) Select(_ context.Context, _ []mock.Tx) mempool.Iterator[mock.Tx] {
+	if len(mp.txs) == 0 {
+		return nil
+	}
+	return &listIterator{txs: mp.txs}
+}
+
+func (mp *listMempool) SelectBy(_ context.Context, _ []mock.Tx, callback func(mock.Tx) bool) {
+	for _, tx := range mp.txs {
+		if !callback(tx) {
+			return
+		}
+	}
+}
+
+func (mp *listMempool) CountTx() int {
+	return len(mp.txs)
+}
+
+func (mp *listMempool) Remove(tx mock.Tx) error {
+	for i, mpTx := range mp.txs {
+		if mpTx.Hash() == tx.Hash() {
+			mp.txs = append(mp.txs[:i], mp.txs[i+1:]...)
+			return nil
+		}
+	}
+	return mempool.ErrTxNotFound
+}
+
+type listIterator struct {
+	txs []mock.Tx
+}
+
+func (it *listIterator) Next() mempool.Iterator[mock.Tx] {
+	if len(it.txs) == 1 {
+		return nil
+	}
+	return &listIterator{txs: it.txs[1:]}
+}
+
+func (it *listIterator) Tx() mock.Tx {
+	return it.txs[0]
+}
+
+func newTestTx(sender string, gasLimit uint64) mock.Tx {
+	return mock.Tx{Sender: []byte(sender), Msg: &gogotypes.BoolValue{Value: true}, GasLimit: gasLimit}
+}
+
+func TestLanedMempool(t *testing.T) {
+	ctx := context.Background()
+	mp, err := mempool.NewLanedMempool(
+		mempool.Lane[mock.Tx]{
+			Name:          "high",
+			Mempool:       &listMempool{},
+			Match:         func(_ context.Context, tx mock.Tx) bool { return tx.GasLimit >= 100 },
+			MaxBlockSpace: math.LegacyNewDecWithPrec(2, 1),
+		},
+		mempool.Lane[mock.Tx]{
+			Name:          "default",
+			Mempool:       &listMempool{},
+			Match:         mempool.MatchAll[mock.Tx],
+			MaxBlockSpace: math.LegacyOneDec(),
+		},
+	)
+	require.NoError(t, err)
+
+	txs := []mock.Tx{newTestTx("a", 1), newTestTx("b", 200), newTestTx("c", 2), newTestTx("d", 100)}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, 2, mp.Lanes()[0].Mempool.CountTx())
+
+	// the txs of the first lane come first
+	var senders []string
+	for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		senders = append(senders, string(iter.Tx().Sender))
+	}
+	require.Equal(t, []string{"b", "d", "a", "c"}, senders)
+
+	senders = nil
+	mp.SelectBy(ctx, nil, func(tx mock.Tx) bool {
+		senders = append(senders, string(tx.Sender))
+		return len(senders) < 3
+	})
+	require.Equal(t, []string{"b", "d", "a"}, senders)
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.ErrorIs(t, mp.Remove(txs[1]), mempool.ErrTxNotFound)
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestLanedMempoolNoLane(t *testing.T) {
+	mp, err := mempool.NewLanedMempool(mempool.Lane[mock.Tx]{
+		Name:          "high",
+		Mempool:       &listMempool{},
+		Match:         func(_ context.Context, tx mock.Tx) bool { return tx.GasLimit >= 100 },
+		MaxBlockSpace: math.LegacyOneDec(),
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, mp.Insert(context.Background(), newTestTx("a", 1)), mempool.ErrNoLane)
+}
+
+func TestNewLanedMempoolErrors(t *testing.T) {
+	lane := func(name string, space math.LegacyDec) mempool.Lane[mock.Tx] {
+		return mempool.Lane[mock.Tx]{Name: name, Mempool: &listMempool{}, Match: mempool.MatchAll[mock.Tx], MaxBlockSpace: space}
+	}
+
+	_, err := mempool.NewLanedMempool[mock.Tx]()
+	require.ErrorContains(t, err, "no lanes")
+	_, err = mempool.NewLanedMempool(lane("a", math.LegacyOneDec()), lane("a", math.LegacyOneDec()))
+	require.ErrorContains(t, err, "duplicate lane")
+	_, err = mempool.NewLanedMempool(lane("a", math.LegacyZeroDec()))
+	require.ErrorContains(t, err, "max block space")
+	_, err = mempool.NewLanedMempool(lane("a", math.LegacyNewDec(2)))
+	require.ErrorContains(t, err, "max block space")
+	_, err = mempool.NewLanedMempool(mempool.Lane[mock.Tx]{Name: "a", MaxBlockSpace: math.LegacyOneDec()})
+	require.ErrorContains(t, err, "mempool and match must be set")
+}
@@ -9,11 +9,12 @@ import (
 	"path/filepath"
 	"sync"
 	"sync/atomic"
+	"time"
 
-	abciserver "github.com/cometbft/cometbft/abci/server"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/node"
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
@@ -57,6 +58,11 @@ type CometBFTServer[T transaction.Tx] struct {
 	Node      *node.Node
 	Consensus abci.Application
 
+	// abciServer and remoteApp serve the application to a CometBFT node
+	// running in a separate process, in standalone mode.
+	abciServer service.Service
+	remoteApp  *remoteApp
+
 	logger        log.Logger
 	serverOptions ServerOptions[T]
 	config        Config
@@ -230,14 +236,7 @@ func (s *CometBFTServer[T]) Name() string {
 func (s *CometBFTServer[T]) Start(ctx context.Context) error {
 	wrappedLogger := cometlog.CometLoggerWrapper{Logger: s.logger}
 	if s.config.AppTomlConfig.Standalone {
-		svr, err := abciserver.NewServer(s.config.AppTomlConfig.Address, s.config.AppTomlConfig.Transport, s.Consensus)
-		if err != nil {
-			return fmt.Errorf("error creating listener: %w", err)
-		}
-
-		svr.SetLogger(wrappedLogger)
-
-		return svr.Start()
+		return s.startStandalone(ctx)
 	}
 
 	nodeKey, err := p2p.LoadOrGenNodeKey(s.config.ConfigTomlConfig.NodeKeyFile())
@@ -274,6 +273,11 @@ func (s *CometBFTServer[T]) Start(ctx context.Context) error {
 }
 
 func (s *CometBFTServer[T]) Stop(context.Context) error {
+	if s.abciServer != nil && s.abciServer.IsRunning() {
+		s.logger.Info("stopping ABCI server")
+		return s.abciServer.Stop()
+	}
+
 	if s.Node != nil && s.Node.IsRunning() {
 		s.logger.Info("stopping consensus server")
 		return s.Node.Stop()
@@ -329,6 +333,7 @@ func (s *CometBFTServer[T]) StartCmdFlags() *pflag.FlagSet {
 	flags.Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	flags.Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
 	flags.Bool(Standalone, false, "Run app without CometBFT")
+	flags.Duration(FlagHealthCheckTimeout, 30*time.Second, "Maximum duration without requests from CometBFT before its connection is reported as lost in standalone mode (0 to disable)")
 	flags.Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
 
 	// add comet flags, we use an empty command to avoid duplicating CometBFT's AddNodeFlags.
@@ -0,0 +1,175 @@
+package cometbft
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	abciserver "github.com/cometbft/cometbft/abci/server"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+	cometlog "cosmossdk.io/server/v2/cometbft/log"
+)
+
+// startStandalone serves the application over the ABCI socket or gRPC
+// interface, for a CometBFT node running in a separate process.
+// The server accepts new connections for as long as it runs, so that the node
+// can be restarted independently: on reconnection it performs the ABCI
+// handshake and replays the blocks the application is missing. Note that
+// CometBFT exits when it loses its connection, so it must be restarted when
+// the application is.
+func (s *CometBFTServer[T]) startStandalone(ctx context.Context) error {
+	cfg := s.config.AppTomlConfig
+	s.remoteApp = newRemoteApp(s.Consensus, s.logger)
+
+	svr, err := abciserver.NewServer(cfg.Address, cfg.Transport, s.remoteApp)
+	if err != nil {
+		return fmt.Errorf("error creating listener: %w", err)
+	}
+	svr.SetLogger(cometlog.CometLoggerWrapper{Logger: s.logger.With(log.ModuleKey, "abci-server")})
+
+	s.logger.Info("starting ABCI server", "address", cfg.Address, "transport", cfg.Transport)
+	if err := svr.Start(); err != nil {
+		return fmt.Errorf("failed to start ABCI server: %w", err)
+	}
+	s.abciServer = svr
+
+	if cfg.HealthCheckTimeout > 0 {
+		go s.remoteApp.monitor(ctx, cfg.HealthCheckTimeout)
+	}
+
+	return nil
+}
+
+// remoteApp is the ABCI application served to a remote CometBFT node. It
+// records the time of the last request of the node to check the health of the
+// connection.
+type remoteApp struct {
+	abci.Application
+
+	logger log.Logger
+	// lastRequest is the time of the last request, in Unix nanoseconds.
+	lastRequest atomic.Int64
+	connected   atomic.Bool
+}
+
+func newRemoteApp(app abci.Application, logger log.Logger) *remoteApp {
+	return &remoteApp{Application: app, logger: logger}
+}
+
+// Connected reports whether the node is connected: it is set by the requests
+// of the node, and unset by the health check when the node is idle for too
+// long.
+func (a *remoteApp) Connected() bool {
+	return a.connected.Load()
+}
+
+// monitor checks the connection with the node every timeout/2 until ctx is
+// done, logging when the node stops sending requests and when it reconnects.
+func (a *remoteApp) monitor(ctx context.Context, timeout time.Duration) {
+	ticker := time.NewTicker(timeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			a.check(now, timeout)
+		}
+	}
+}
+
+// check updates the connection status, given the current time.
+func (a *remoteApp) check(now time.Time, timeout time.Duration) {
+	last := a.lastRequest.Load()
+	if last == 0 {
+		a.logger.Info("waiting for CometBFT to connect")
+		return
+	}
+
+	idle := now.Sub(time.Unix(0, last))
+	if idle > timeout && a.connected.CompareAndSwap(true, false) {
+		a.logger.Error("lost connection to CometBFT, waiting for it to reconnect", "idle", idle)
+	}
+}
+
+// seen records a request of the node.
+func (a *remoteApp) seen() {
+	a.lastRequest.Store(time.Now().UnixNano())
+	if !a.connected.Swap(true) {
+		a.logger.Info("CometBFT connected")
+	}
+}
+
+func (a *remoteApp) Info(ctx context.Context, req *abci.InfoRequest) (*abci.InfoResponse, error) {
+	a.seen()
+	return a.Application.Info(ctx, req)
+}
+
+func (a *remoteApp) Query(ctx context.Context, req *abci.QueryRequest) (*abci.QueryResponse, error) {
+	a.seen()
+	return a.Application.Query(ctx, req)
+}
+
+func (a *remoteApp) CheckTx(ctx context.Context, req *abci.CheckTxRequest) (*abci.CheckTxResponse, error) {
+	a.seen()
+	return a.Application.CheckTx(ctx, req)
+}
+
+func (a *remoteApp) InitChain(ctx context.Context, req *abci.InitChainRequest) (*abci.InitChainResponse, error) {
+	a.seen()
+	return a.Application.InitChain(ctx, req)
+}
+
+func (a *remoteApp) PrepareProposal(ctx context.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
+	a.seen()
+	return a.Application.PrepareProposal(ctx, req)
+}
+
+func (a *remoteApp) ProcessProposal(ctx context.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
+	a.seen()
+	return a.Application.ProcessProposal(ctx, req)
+}
+
+func (a *remoteApp) FinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
+	a.seen()
+	return a.Application.FinalizeBlock(ctx, req)
+}
+
+func (a *remoteApp) ExtendVote(ctx context.Context, req *abci.ExtendVoteRequest) (*abci.ExtendVoteResponse, error) {
+	a.seen()
+	return a.Application.ExtendVote(ctx, req)
+}
+
+func (a *remoteApp) VerifyVoteExtension(ctx context.Context, req *abci.VerifyVoteExtensionRequest) (*abci.VerifyVoteExtensionResponse, error) {
+	a.seen()
+	return a.Application.VerifyVoteExtension(ctx, req)
+}
+
+func (a *remoteApp) Commit(ctx context.Context, req *abci.CommitRequest) (*abci.CommitResponse, error) {
+	a.seen()
+	return a.Application.Commit(ctx, req)
+}
+
+func (a *remoteApp) ListSnapshots(ctx context.Context, req *abci.ListSnapshotsRequest) (*abci.ListSnapshotsResponse, error) {
+	a.seen()
+	return a.Application.ListSnapshots(ctx, req)
+}
+
+func (a *remoteApp) OfferSnapshot(ctx context.Context, req *abci.OfferSnapshotRequest) (*abci.OfferSnapshotResponse, error) {
+	a.seen()
+	return a.Application.OfferSnapshot(ctx, req)
+}
+
+func (a *remoteApp) LoadSnapshotChunk(ctx context.Context, req *abci.LoadSnapshotChunkRequest) (*abci.LoadSnapshotChunkResponse, error) {
+	a.seen()
+	return a.Application.LoadSnapshotChunk(ctx, req)
+}
+
+func (a *remoteApp) ApplySnapshotChunk(ctx context.Context, req *abci.ApplySnapshotChunkRequest) (*abci.ApplySnapshotChunkResponse, error) {
+	a.seen()
+	return a.Application.ApplySnapshotChunk(ctx, req)
+}
@@ -0,0 +1,210 @@
+package cometbft
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	abciproto "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/p2p"
+	pvm "github.com/cometbft/cometbft/privval"
+	"github.com/cometbft/cometbft/proxy"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	cometlog "cosmossdk.io/server/v2/cometbft/log"
+	"cosmossdk.io/server/v2/cometbft/mempool"
+	"cosmossdk.io/server/v2/cometbft/oe"
+	"cosmossdk.io/server/v2/stf/mock"
+)
+
+func TestStandalone(t *testing.T) {
+	for _, transport := range []string{"socket", "grpc"} {
+		t.Run(transport, func(t *testing.T) {
+			testStandalone(t, transport)
+		})
+	}
+}
+
+// testStandalone runs a CometBFT node connected to the application through
+// transport.
+func testStandalone(t *testing.T, transport string) {
+	t.Helper()
+	cParams := cmttypes.DefaultConsensusParams().ToProto()
+	c := setUpConsensusWithParams(t, 100_000, mempool.NoOpMempool[mock.Tx]{}, &cParams)
+	opts := DefaultServerOptions[mock.Tx]()
+	c.prepareProposalHandler = opts.PrepareProposalHandler
+	c.processProposalHandler = opts.ProcessProposalHandler
+	c.extendVote = opts.ExtendVoteHandler
+	c.verifyVoteExt = opts.VerifyVoteExtensionHandler
+
+	var optimisticBlocks atomic.Int64
+	c.optimisticExec = oe.NewOptimisticExecution(log.NewNopLogger(), func(ctx context.Context, req *abciproto.FinalizeBlockRequest) (*server.BlockResponse, store.WriterMap, []mock.Tx, error) {
+		optimisticBlocks.Add(1)
+		return c.internalFinalizeBlock(ctx, req)
+	})
+
+	cfg := DefaultAppTomlConfig()
+	cfg.Standalone = true
+	cfg.Transport = transport
+	cfg.Address = fmt.Sprintf("tcp://127.0.0.1:%d", freePort(t))
+	cfg.HealthCheckTimeout = time.Second
+	srv := &CometBFTServer[mock.Tx]{
+		Consensus: c,
+		logger:    log.NewNopLogger(),
+		config:    Config{AppTomlConfig: cfg},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, srv.Start(ctx))
+	// the node must stop before the application, as CometBFT exits when it
+	// loses its connection to the application
+	t.Cleanup(func() { require.NoError(t, srv.Stop(ctx)) })
+	require.False(t, srv.remoteApp.Connected())
+
+	cmtCfg := newStandaloneCometConfig(t, cfg.Address, transport)
+	n := startCometNode(t, cmtCfg)
+	require.True(t, srv.remoteApp.Connected())
+	waitForHeight(t, n, 3)
+
+	// txs are checked by the application through the mempool connection and
+	// executed in a block
+	sub, err := n.EventBus().Subscribe(ctx, "standalone-test", cmttypes.EventQueryTx)
+	require.NoError(t, err)
+	_, err = n.Mempool().CheckTx(mockTx.Bytes(), "")
+	require.NoError(t, err)
+	select {
+	case msg := <-sub.Out():
+		txResult := msg.Data().(cmttypes.EventDataTx).TxResult
+		require.Equal(t, mockTx.Bytes(), txResult.Tx)
+		require.Zero(t, txResult.Result.Code, txResult.Result.Log)
+	case <-time.After(10 * time.Second):
+		t.Fatal("tx was not executed")
+	}
+	require.Positive(t, optimisticBlocks.Load())
+
+	// the connection is reported as lost when the node stops, and restored when
+	// it restarts and resumes from the height of the application
+	stopCometNode(t, n)
+	height := c.lastCommittedHeight.Load()
+	require.Eventually(t, func() bool { return !srv.remoteApp.Connected() }, 5*time.Second, 100*time.Millisecond)
+
+	n = startCometNode(t, cmtCfg)
+	require.True(t, srv.remoteApp.Connected())
+	waitForHeight(t, n, height+2)
+	require.Greater(t, c.lastCommittedHeight.Load(), height)
+}
+
+func TestRemoteApp_Check(t *testing.T) {
+	app := newRemoteApp(nil, log.NewNopLogger())
+	app.check(time.Now(), time.Second)
+	require.False(t, app.Connected())
+
+	app.seen()
+	require.True(t, app.Connected())
+	app.check(time.Now(), time.Second)
+	require.True(t, app.Connected())
+	app.check(time.Now().Add(2*time.Second), time.Second)
+	require.False(t, app.Connected())
+
+	app.seen()
+	require.True(t, app.Connected())
+}
+
+func freePort(t *testing.T) int {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+// newStandaloneCometConfig creates the home of a single validator CometBFT
+// node connecting to the application at proxyApp through transport.
+func newStandaloneCometConfig(t *testing.T, proxyApp, transport string) *cmtcfg.Config {
+	t.Helper()
+	cfg := cmtcfg.TestConfig().SetRoot(t.TempDir())
+	cmtcfg.EnsureRoot(cfg.RootDir)
+	cfg.ProxyApp = proxyApp
+	if transport == "grpc" {
+		// the gRPC client dials the address without its scheme
+		cfg.ProxyApp = strings.TrimPrefix(proxyApp, "tcp://")
+	}
+	cfg.ABCI = transport
+	cfg.DBBackend = "goleveldb"
+	cfg.TxIndex.Indexer = "null"
+	cfg.RPC.ListenAddress = ""
+	cfg.P2P.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", freePort(t))
+	cfg.Consensus.TimeoutCommit = 100 * time.Millisecond
+
+	pv, err := pvm.GenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile(), DefaultServerOptions[mock.Tx]().KeygenF)
+	require.NoError(t, err)
+	pv.Save()
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+
+	genDoc := cmttypes.GenesisDoc{
+		ChainID:         "test",
+		GenesisTime:     time.Now(),
+		ConsensusParams: cmttypes.DefaultConsensusParams(),
+		Validators:      []cmttypes.GenesisValidator{{Address: pubKey.Address(), PubKey: pubKey, Power: 10}},
+		AppState:        json.RawMessage("{}"),
+	}
+	require.NoError(t, genDoc.SaveAs(cfg.GenesisFile()))
+	return cfg
+}
+
+func startCometNode(t *testing.T, cfg *cmtcfg.Config) *node.Node {
+	t.Helper()
+	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
+	require.NoError(t, err)
+	pv := pvm.LoadFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile())
+
+	n, err := node.NewNode(
+		context.Background(),
+		cfg,
+		pv,
+		nodeKey,
+		proxy.NewRemoteClientCreator(cfg.ProxyApp, cfg.ABCI, true),
+		node.DefaultGenesisDocProviderFunc(cfg),
+		cmtcfg.DefaultDBProvider,
+		node.DefaultMetricsProvider(cfg.Instrumentation),
+		cometlog.CometLoggerWrapper{Logger: log.NewNopLogger()},
+	)
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	t.Cleanup(func() {
+		if n.IsRunning() {
+			stopCometNode(t, n)
+		}
+	})
+	return n
+}
+
+// stopCometNode stops n and its connections to the application, which the
+// node does not close, so that they do not make CometBFT exit once the
+// application stops.
+func stopCometNode(t *testing.T, n *node.Node) {
+	t.Helper()
+	require.NoError(t, n.Stop())
+	n.Wait()
+	require.NoError(t, n.ProxyApp().Stop())
+}
+
+func waitForHeight(t *testing.T, n *node.Node, height int64) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		return n.BlockStore().Height() >= height
+	}, 30*time.Second, 50*time.Millisecond)
+}
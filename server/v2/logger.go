@@ -3,8 +3,6 @@ package serverv2
 import (
 	"io"
 
-	"github.com/rs/zerolog"
-
 	"cosmossdk.io/core/server"
 	"cosmossdk.io/log"
 )
@@ -40,24 +38,13 @@ func NewLogger(cfg server.ConfigMap, out io.Writer) (log.Logger, error) {
 		log.TraceOption(trace),
 	)
 
-	// check and set filter level or keys for the logger if any
-	if level == "" {
-		return log.NewLogger(out, opts...), nil
-	}
-
-	logLvl, err := zerolog.ParseLevel(level)
-	switch {
-	case err != nil:
-		// If the log level is not a valid zerolog level, then we try to parse it as a key filter.
-		filterFunc, err := log.ParseLogLevel(level)
-		if err != nil {
-			return nil, err
-		}
-
-		opts = append(opts, log.FilterOption(filterFunc))
-	default:
-		opts = append(opts, log.LevelOption(logLvl))
+	// the levels are held in a registry so that they can be changed at runtime
+	// through the log admin server
+	levels, err := log.NewLevelRegistry(level)
+	if err != nil {
+		return nil, err
 	}
+	opts = append(opts, log.LevelRegistryOption(levels))
 
 	return log.NewLogger(out, opts...), nil
 }
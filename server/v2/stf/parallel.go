@@ -0,0 +1,260 @@
+package stf
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+)
+
+// Option customizes an STF.
+type Option[T transaction.Tx] func(*STF[T])
+
+// WithParallelExecution enables the optimistic parallel execution of the block
+// txs, in the style of Block-STM, with the given number of workers. A number of
+// workers not above zero means runtime.GOMAXPROCS(0).
+//
+// The txs are first executed speculatively and concurrently, each on its own
+// branch of the state left by begin block, while the keys they read are
+// tracked. Their state changes are then committed in block order: a tx which
+// read a key, or iterated over a range with a key, written by a tx before it in
+// the block is re-executed on the committed state instead. The results are
+// thus those of the sequential execution.
+//
+// The msg handlers, the tx validation and the post tx exec functions must be
+// safe for concurrent use, and so must be the reads of the state passed to
+// DeliverBlock.
+func WithParallelExecution[T transaction.Tx](workers int) Option[T] {
+	return func(s *STF[T]) {
+		if workers <= 0 {
+			workers = runtime.GOMAXPROCS(0)
+		}
+		s.parallelWorkers = workers
+	}
+}
+
+// speculativeRun is the result of the speculative execution of a tx.
+type speculativeRun struct {
+	state store.WriterMap
+	reads *readSet
+}
+
+// deliverTxsParallel executes the block txs with the optimistic parallel
+// execution and applies their state changes to state, in block order.
+func (s STF[T]) deliverTxsParallel(
+	ctx context.Context,
+	exCtx *executionContext,
+	state store.WriterMap,
+	txs []T,
+	hi header.Info,
+) ([]server.TxResult, error) {
+	txResults := make([]server.TxResult, len(txs))
+	runs := make([]speculativeRun, len(txs))
+
+	// speculatively execute all the txs on the state left by begin block
+	snapshot := newSyncReaderMap(state)
+	var (
+		next atomic.Int64
+		wg   sync.WaitGroup
+	)
+	for w := 0; w < s.parallelWorkers && w < len(txs); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(txs) || isCtxCancelled(ctx) != nil {
+					return
+				}
+				reads := newReadSet()
+				txState := s.branchFn(trackingReaderMap{state: snapshot, reads: reads})
+				txResults[i] = s.deliverTx(exCtx, txState, txs[i], transaction.ExecModeFinalize, hi, int32(i+1))
+				runs[i] = speculativeRun{state: txState, reads: reads}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// commit in block order, re-executing the txs which conflict with the ones
+	// committed before them
+	writes := writeSet{}
+	reexecuted := 0
+	for i, tx := range txs {
+		if err := isCtxCancelled(ctx); err != nil {
+			return nil, err
+		}
+		txState := runs[i].state
+		if runs[i].reads.conflicts(writes) {
+			reexecuted++
+			txState = s.branchFn(state)
+			txResults[i] = s.deliverTx(exCtx, txState, tx, transaction.ExecModeFinalize, hi, int32(i+1))
+		}
+		changes, err := txState.GetStateChanges()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get state changes of tx %d: %w", i, err)
+		}
+		if err = state.ApplyStateChanges(changes); err != nil {
+			return nil, fmt.Errorf("unable to apply state changes of tx %d: %w", i, err)
+		}
+		writes.add(changes)
+	}
+	s.logger.Debug("executed block txs in parallel", "height", hi.Height, "txs", len(txs), "re-executed", reexecuted)
+
+	return txResults, nil
+}
+
+// syncReaderMap makes the GetReader calls of a store.ReaderMap safe for
+// concurrent use, as branches memoize the readers of the actors.
+type syncReaderMap struct {
+	mu      sync.Mutex
+	state   store.ReaderMap
+	readers map[string]store.Reader
+}
+
+func newSyncReaderMap(state store.ReaderMap) *syncReaderMap {
+	return &syncReaderMap{state: state, readers: make(map[string]store.Reader)}
+}
+
+func (m *syncReaderMap) GetReader(actor []byte) (store.Reader, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if r, ok := m.readers[string(actor)]; ok {
+		return r, nil
+	}
+	r, err := m.state.GetReader(actor)
+	if err != nil {
+		return nil, err
+	}
+	m.readers[string(actor)] = r
+	return r, nil
+}
+
+// trackingReaderMap records the keys and the ranges read from a store.ReaderMap.
+type trackingReaderMap struct {
+	state store.ReaderMap
+	reads *readSet
+}
+
+func (m trackingReaderMap) GetReader(actor []byte) (store.Reader, error) {
+	r, err := m.state.GetReader(actor)
+	if err != nil {
+		return nil, err
+	}
+	return trackingReader{Reader: r, actor: string(actor), reads: m.reads}, nil
+}
+
+type trackingReader struct {
+	store.Reader
+	actor string
+	reads *readSet
+}
+
+func (r trackingReader) Get(key []byte) ([]byte, error) {
+	r.reads.addKey(r.actor, key)
+	return r.Reader.Get(key)
+}
+
+func (r trackingReader) Has(key []byte) (bool, error) {
+	r.reads.addKey(r.actor, key)
+	return r.Reader.Has(key)
+}
+
+// Iterator records the whole range, even if the iteration stops before its end.
+func (r trackingReader) Iterator(start, end []byte) (store.Iterator, error) {
+	r.reads.addRange(r.actor, start, end)
+	return r.Reader.Iterator(start, end)
+}
+
+func (r trackingReader) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	r.reads.addRange(r.actor, start, end)
+	return r.Reader.ReverseIterator(start, end)
+}
+
+// keyRange is a range of keys, nil bounds are unbounded.
+type keyRange struct {
+	start, end []byte
+}
+
+func (r keyRange) contains(key string) bool {
+	return (r.start == nil || key >= string(r.start)) && (r.end == nil || key < string(r.end))
+}
+
+// readSet is the set of the keys and ranges read by a tx, by actor.
+type readSet struct {
+	keys   map[string]map[string]struct{}
+	ranges map[string][]keyRange
+}
+
+func newReadSet() *readSet {
+	return &readSet{
+		keys:   make(map[string]map[string]struct{}),
+		ranges: make(map[string][]keyRange),
+	}
+}
+
+func (rs *readSet) addKey(actor string, key []byte) {
+	keys, ok := rs.keys[actor]
+	if !ok {
+		keys = make(map[string]struct{})
+		rs.keys[actor] = keys
+	}
+	keys[string(key)] = struct{}{}
+}
+
+func (rs *readSet) addRange(actor string, start, end []byte) {
+	rs.ranges[actor] = append(rs.ranges[actor], keyRange{
+		start: cloneBytes(start),
+		end:   cloneBytes(end),
+	})
+}
+
+// conflicts reports whether one of the reads is a key of the write set.
+func (rs *readSet) conflicts(ws writeSet) bool {
+	for actor, keys := range rs.keys {
+		written := ws[actor]
+		for key := range keys {
+			if _, ok := written[key]; ok {
+				return true
+			}
+		}
+	}
+	for actor, ranges := range rs.ranges {
+		for key := range ws[actor] {
+			for _, r := range ranges {
+				if r.contains(key) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// writeSet is the set of the keys written by the committed txs, by actor.
+type writeSet map[string]map[string]struct{}
+
+func (ws writeSet) add(changes []store.StateChanges) {
+	for _, sc := range changes {
+		keys, ok := ws[string(sc.Actor)]
+		if !ok {
+			keys = make(map[string]struct{})
+			ws[string(sc.Actor)] = keys
+		}
+		for _, kv := range sc.StateChanges {
+			keys[string(kv.Key)] = struct{}{}
+		}
+	}
+}
+
+func cloneBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append([]byte{}, b...)
+}
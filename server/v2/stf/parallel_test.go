@@ -0,0 +1,296 @@
+package stf
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/server/v2/stf/branch"
+	"cosmossdk.io/server/v2/stf/gas"
+	"cosmossdk.io/server/v2/stf/mock"
+)
+
+var (
+	bankActor = []byte("bank")
+	authActor = []byte("auth")
+)
+
+const initialBalance = 100
+
+// newTransferSTF returns an STF transferring balances with txs of
+// gogotypes.StringValue msgs "from:to:amount". The validation increments the
+// nonce of the sender and begin block sets the initial balances of the
+// accounts. msgCalls counts the executions of the msg handler.
+func newTransferSTF(t *testing.T, accounts []string, msgCalls *atomic.Int64, opts ...Option[mock.Tx]) *STF[mock.Tx] {
+	t.Helper()
+	msgRouterBuilder := NewMsgRouterBuilder()
+	err := msgRouterBuilder.RegisterHandler(
+		msgTypeURL(&gogotypes.StringValue{}),
+		func(ctx context.Context, msg transaction.Msg) (transaction.Msg, error) {
+			msgCalls.Add(1)
+			return nil, transfer(ctx, msg.(*gogotypes.StringValue).Value)
+		},
+	)
+	if err != nil {
+		t.Fatalf("RegisterHandler error: %v", err)
+	}
+
+	s, err := New(
+		coretesting.NewNopLogger(),
+		msgRouterBuilder,
+		NewMsgRouterBuilder(),
+		func(ctx context.Context, txs []mock.Tx) error { return nil },
+		func(ctx context.Context) error {
+			bank, err := ctx.(*executionContext).state.GetWriter(bankActor)
+			if err != nil {
+				return err
+			}
+			for _, acc := range accounts {
+				if err := bank.Set([]byte(acc), []byte(strconv.Itoa(initialBalance))); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		func(ctx context.Context) error { return nil },
+		func(ctx context.Context, tx mock.Tx) error {
+			auth, err := ctx.(*executionContext).state.GetWriter(authActor)
+			if err != nil {
+				return err
+			}
+			nonce, err := getInt(auth, tx.Sender)
+			if err != nil {
+				return err
+			}
+			return auth.Set(tx.Sender, []byte(strconv.Itoa(nonce+1)))
+		},
+		func(ctx context.Context) ([]appmodulev2.ValidatorUpdate, error) { return nil, nil },
+		func(ctx context.Context, tx mock.Tx, success bool) error { return nil },
+		branch.DefaultNewWriterMap,
+		opts...,
+	)
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+	s.makeGasMeter = gas.DefaultGasMeter
+	s.makeGasMeteredState = gas.DefaultWrapWithGasMeter
+	return s
+}
+
+func transfer(ctx context.Context, msg string) error {
+	parts := strings.Split(msg, ":")
+	amount, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return err
+	}
+	exCtx := ctx.(*executionContext)
+	bank, err := exCtx.state.GetWriter(bankActor)
+	if err != nil {
+		return err
+	}
+	from, err := getInt(bank, []byte(parts[0]))
+	if err != nil {
+		return err
+	}
+	if from < amount {
+		return errors.New("insufficient funds")
+	}
+	to, err := getInt(bank, []byte(parts[1]))
+	if err != nil {
+		return err
+	}
+	if err := bank.Set([]byte(parts[0]), []byte(strconv.Itoa(from-amount))); err != nil {
+		return err
+	}
+	if err := bank.Set([]byte(parts[1]), []byte(strconv.Itoa(to+amount))); err != nil {
+		return err
+	}
+	exCtx.events = append(exCtx.events, event.NewEvent("transfer", event.NewAttribute("msg", msg)))
+	return nil
+}
+
+func getInt(r store.Reader, key []byte) (int, error) {
+	bz, err := r.Get(key)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return strconv.Atoi(string(bz))
+}
+
+func transferTx(from, to string, amount int) mock.Tx {
+	return mock.Tx{
+		Sender:   []byte(from),
+		Msg:      &gogotypes.StringValue{Value: fmt.Sprintf("%s:%s:%d", from, to, amount)},
+		GasLimit: 100_000,
+	}
+}
+
+// requireDeterministicExecution delivers txs with the sequential and the
+// parallel execution, and checks that they return the same tx results and
+// state root. It returns the number of msg executions of the parallel one.
+func requireDeterministicExecution(t *testing.T, accounts []string, txs []mock.Tx) int64 {
+	t.Helper()
+	var sequentialCalls, parallelCalls atomic.Int64
+	sequential := newTransferSTF(t, accounts, &sequentialCalls)
+	parallel := newTransferSTF(t, accounts, &parallelCalls, WithParallelExecution[mock.Tx](4))
+
+	sum := sha256.Sum256([]byte("test-hash"))
+	block := &server.BlockRequest[mock.Tx]{
+		Height:  1,
+		Time:    time.Date(2024, 2, 3, 18, 23, 0, 0, time.UTC),
+		AppHash: sum[:],
+		Hash:    sum[:],
+		Txs:     txs,
+	}
+	expResult, expState, err := sequential.DeliverBlock(context.Background(), block, mock.DB())
+	if err != nil {
+		t.Fatalf("DeliverBlock error: %v", err)
+	}
+	result, state, err := parallel.DeliverBlock(context.Background(), block, mock.DB())
+	if err != nil {
+		t.Fatalf("DeliverBlock error: %v", err)
+	}
+
+	if !bytes.Equal(stateRoot(t, expState), stateRoot(t, state)) {
+		t.Errorf("state roots differ")
+	}
+	if len(result.TxResults) != len(expResult.TxResults) {
+		t.Fatalf("expected %d tx results, got %d", len(expResult.TxResults), len(result.TxResults))
+	}
+	for i, exp := range expResult.TxResults {
+		if got, want := txResultString(t, result.TxResults[i]), txResultString(t, exp); got != want {
+			t.Errorf("tx %d: expected result %s, got %s", i, want, got)
+		}
+	}
+	return parallelCalls.Load()
+}
+
+// stateRoot hashes the state changes of state, sorted by actor and key.
+func stateRoot(t *testing.T, state store.WriterMap) []byte {
+	t.Helper()
+	changes, err := state.GetStateChanges()
+	if err != nil {
+		t.Fatalf("GetStateChanges error: %v", err)
+	}
+	sort.Slice(changes, func(i, j int) bool { return bytes.Compare(changes[i].Actor, changes[j].Actor) < 0 })
+	h := sha256.New()
+	for _, sc := range changes {
+		_, _ = fmt.Fprintf(h, "%x;", sc.Actor)
+		for _, kv := range sc.StateChanges {
+			_, _ = fmt.Fprintf(h, "%x=%x,%t;", kv.Key, kv.Value, kv.Remove)
+		}
+	}
+	return h.Sum(nil)
+}
+
+func txResultString(t *testing.T, res server.TxResult) string {
+	t.Helper()
+	var sb strings.Builder
+	_, _ = fmt.Fprintf(&sb, "gas_used=%d gas_wanted=%d error=%v events=[", res.GasUsed, res.GasWanted, res.Error)
+	for _, e := range res.Events {
+		attrs, err := e.Attributes()
+		if err != nil {
+			t.Fatalf("Attributes error: %v", err)
+		}
+		_, _ = fmt.Fprintf(&sb, "%s %d/%d/%d %v;", e.Type, e.TxIndex, e.MsgIndex, e.EventIndex, attrs)
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
+
+func TestParallelExecutionDeterminism(t *testing.T) {
+	accounts := make([]string, 10)
+	for i := range accounts {
+		accounts[i] = fmt.Sprintf("acc%d", i)
+	}
+
+	for seed := int64(0); seed < 20; seed++ {
+		t.Run(fmt.Sprintf("seed %d", seed), func(t *testing.T) {
+			r := rand.New(rand.NewSource(seed))
+			txs := make([]mock.Tx, 100)
+			for i := range txs {
+				txs[i] = transferTx(accounts[r.Intn(len(accounts))], accounts[r.Intn(len(accounts))], 1+r.Intn(60))
+			}
+			if calls := requireDeterministicExecution(t, accounts, txs); calls <= int64(len(txs)) {
+				t.Errorf("expected conflicting txs to be re-executed, got %d msg executions", calls)
+			}
+		})
+	}
+}
+
+func TestParallelExecutionIndependentTxs(t *testing.T) {
+	accounts := make([]string, 100)
+	txs := make([]mock.Tx, 50)
+	for i := range accounts {
+		accounts[i] = fmt.Sprintf("acc%d", i)
+	}
+	for i := range txs {
+		txs[i] = transferTx(accounts[2*i], accounts[2*i+1], 10)
+	}
+
+	if calls := requireDeterministicExecution(t, accounts, txs); calls != int64(len(txs)) {
+		t.Errorf("expected no re-execution, got %d msg executions", calls)
+	}
+}
+
+func TestParallelExecutionCancelled(t *testing.T) {
+	var calls atomic.Int64
+	s := newTransferSTF(t, []string{"a", "b"}, &calls, WithParallelExecution[mock.Tx](2))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	sum := sha256.Sum256([]byte("test-hash"))
+	_, _, err := s.DeliverBlock(ctx, &server.BlockRequest[mock.Tx]{
+		Height:  1,
+		AppHash: sum[:],
+		Hash:    sum[:],
+		Txs:     []mock.Tx{transferTx("a", "b", 1)},
+	}, mock.DB())
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestReadSetConflicts(t *testing.T) {
+	ws := writeSet{}
+	ws.add([]store.StateChanges{{Actor: []byte("a"), StateChanges: []store.KVPair{{Key: []byte("k2")}}}})
+
+	testCases := map[string]struct {
+		read func(rs *readSet)
+		exp  bool
+	}{
+		"no reads":           {read: func(rs *readSet) {}},
+		"written key":        {read: func(rs *readSet) { rs.addKey("a", []byte("k2")) }, exp: true},
+		"other key":          {read: func(rs *readSet) { rs.addKey("a", []byte("k1")) }},
+		"other actor":        {read: func(rs *readSet) { rs.addKey("b", []byte("k2")) }},
+		"range with key":     {read: func(rs *readSet) { rs.addRange("a", []byte("k1"), []byte("k3")) }, exp: true},
+		"unbounded range":    {read: func(rs *readSet) { rs.addRange("a", nil, nil) }, exp: true},
+		"range end excluded": {read: func(rs *readSet) { rs.addRange("a", []byte("k1"), []byte("k2")) }},
+		"range after key":    {read: func(rs *readSet) { rs.addRange("a", []byte("k3"), nil) }},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rs := newReadSet()
+			tc.read(rs)
+			if got := rs.conflicts(ws); got != tc.exp {
+				t.Errorf("expected conflicts to be %t, got %t", tc.exp, got)
+			}
+		})
+	}
+}
@@ -7,6 +7,11 @@ import (
 	"fmt"
 	"strings"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
 	corecontext "cosmossdk.io/core/context"
 	"cosmossdk.io/core/event"
@@ -22,6 +27,10 @@ import (
 	"cosmossdk.io/server/v2/stf/internal"
 )
 
+// tracerName is the name of the OpenTelemetry tracer of the STF. Spans are
+// exported through the global OpenTelemetry tracer provider, if any.
+const tracerName = "cosmossdk.io/server/v2/stf"
+
 type eContextKey struct{}
 
 var executionContextKey = eContextKey{}
@@ -44,6 +53,10 @@ type STF[T transaction.Tx] struct {
 	branchFn            branchFn // branchFn is a function that given a readonly state it returns a writable version of it.
 	makeGasMeter        makeGasMeterFn
 	makeGasMeteredState makeGasMeteredStateFn
+
+	// parallelWorkers is the number of workers of the parallel execution of the
+	// block txs, zero if the txs are executed sequentially.
+	parallelWorkers int
 }
 
 // New returns a new STF instance.
@@ -58,6 +71,7 @@ func New[T transaction.Tx](
 	doValidatorUpdate func(ctx context.Context) ([]appmodulev2.ValidatorUpdate, error),
 	postTxExec func(ctx context.Context, tx T, success bool) error,
 	branch func(store store.ReaderMap) store.WriterMap,
+	opts ...Option[T],
 ) (*STF[T], error) {
 	msgRouter, err := msgRouterBuilder.build()
 	if err != nil {
@@ -68,7 +82,7 @@ func New[T transaction.Tx](
 		return nil, fmt.Errorf("build query router: %w", err)
 	}
 
-	return &STF[T]{
+	s := &STF[T]{
 		logger:              logger,
 		msgRouter:           msgRouter,
 		queryRouter:         queryRouter,
@@ -81,7 +95,12 @@ func New[T transaction.Tx](
 		branchFn:            branch,
 		makeGasMeter:        stfgas.DefaultGasMeter,
 		makeGasMeteredState: stfgas.DefaultWrapWithGasMeter,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s, nil
 }
 
 // DeliverBlock is our state transition function.
@@ -141,14 +160,22 @@ func (s STF[T]) DeliverBlock(
 	}
 
 	// execute txs
-	txResults := make([]server.TxResult, len(block.Txs))
+	var txResults []server.TxResult
 	// TODO: skip first tx if vote extensions are enabled (marko)
-	for i, txBytes := range block.Txs {
-		// check if we need to return early or continue delivering txs
-		if err = isCtxCancelled(ctx); err != nil {
+	if s.parallelWorkers > 0 {
+		txResults, err = s.deliverTxsParallel(ctx, exCtx, newState, block.Txs, hi)
+		if err != nil {
 			return nil, nil, err
 		}
-		txResults[i] = s.deliverTx(exCtx, newState, txBytes, transaction.ExecModeFinalize, hi, int32(i+1))
+	} else {
+		txResults = make([]server.TxResult, len(block.Txs))
+		for i, txBytes := range block.Txs {
+			// check if we need to return early or continue delivering txs
+			if err = isCtxCancelled(ctx); err != nil {
+				return nil, nil, err
+			}
+			txResults[i] = s.deliverTx(exCtx, newState, txBytes, transaction.ExecModeFinalize, hi, int32(i+1))
+		}
 	}
 	// reset events
 	exCtx.events = make([]event.Event, 0)
@@ -175,7 +202,16 @@ func (s STF[T]) deliverTx(
 	execMode transaction.ExecMode,
 	hi header.Info,
 	txIndex int32,
-) server.TxResult {
+) (txResult server.TxResult) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "Tx", trace.WithAttributes(attribute.Int64("height", hi.Height)))
+	defer func() {
+		span.SetAttributes(
+			attribute.Int64("gas_wanted", int64(txResult.GasWanted)),
+			attribute.Int64("gas_used", int64(txResult.GasUsed)),
+		)
+		endSpan(span, txResult.Error)
+	}()
+
 	// recover in the case of a panic
 	var recoveryError error
 	defer func() {
@@ -350,7 +386,7 @@ func (s STF[T]) runTxMsgs(
 	for i, msg := range msgs {
 		execCtx.sender = txSenders[i]
 		execCtx.events = make([]event.Event, 0) // reset events
-		resp, err := s.msgRouter.Invoke(execCtx, msg)
+		resp, err := s.invokeMsg(execCtx, msg)
 		if err != nil {
 			return nil, 0, nil, err // do not wrap the error or we lose the original error type
 		}
@@ -369,21 +405,50 @@ func (s STF[T]) runTxMsgs(
 	return msgResps, consumed, events, nil
 }
 
-// Create a message event, with two kv: action, the type url of the message
-// and module, the module of the message.
-func createMessageEvent(msg transaction.Msg, msgIndex, eventIndex int32) event.Event {
-	// Assumes that module name is the second element of the msg type URL
-	// e.g. "cosmos.bank.v1beta1.MsgSend" => "bank"
-	// It returns an empty string if the input is not a valid type URL
-	getModuleNameFromTypeURL := func(input string) string {
-		moduleName := strings.Split(input, ".")
-		if len(moduleName) > 1 {
-			return moduleName[1]
-		}
+// invokeMsg executes msg through the msg router, tracing it as a child span of
+// the execution context.
+func (s STF[T]) invokeMsg(execCtx *executionContext, msg transaction.Msg) (resp transaction.Msg, err error) {
+	typeURL := msgTypeURL(msg)
+	parentCtx := execCtx.Context
+	spanCtx, span := otel.Tracer(tracerName).Start(parentCtx, "/"+typeURL, trace.WithAttributes(
+		attribute.String("module", getModuleNameFromTypeURL(typeURL)),
+		attribute.String("msg_type", "/"+typeURL),
+	))
+	remaining := execCtx.meter.Remaining()
+	execCtx.Context = spanCtx
+	defer func() {
+		execCtx.Context = parentCtx
+		span.SetAttributes(attribute.Int64("gas_used", int64(remaining-execCtx.meter.Remaining())))
+		endSpan(span, err)
+	}()
 
-		return ""
+	return s.msgRouter.Invoke(execCtx, msg)
+}
+
+// endSpan records err, if any, on span and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 	}
+	span.End()
+}
 
+// getModuleNameFromTypeURL assumes that module name is the second element of the msg type URL
+// e.g. "cosmos.bank.v1beta1.MsgSend" => "bank"
+// It returns an empty string if the input is not a valid type URL
+func getModuleNameFromTypeURL(input string) string {
+	moduleName := strings.Split(input, ".")
+	if len(moduleName) > 1 {
+		return moduleName[1]
+	}
+
+	return ""
+}
+
+// Create a message event, with two kv: action, the type url of the message
+// and module, the module of the message.
+func createMessageEvent(msg transaction.Msg, msgIndex, eventIndex int32) event.Event {
 	return event.Event{
 		MsgIndex:   msgIndex,
 		EventIndex: eventIndex,
@@ -546,6 +611,7 @@ func (s STF[T]) clone() STF[T] {
 		branchFn:            s.branchFn,
 		makeGasMeter:        s.makeGasMeter,
 		makeGasMeteredState: s.makeGasMeteredState,
+		parallelWorkers:     s.parallelWorkers,
 	}
 }
 
@@ -9,6 +9,11 @@ import (
 	"time"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
 
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
 	"cosmossdk.io/core/event"
@@ -300,6 +305,47 @@ func TestSTF(t *testing.T) {
 		}
 	})
 
+	t.Run("tracing", func(t *testing.T) {
+		spans := tracetest.NewInMemoryExporter()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(spans))
+		otel.SetTracerProvider(tp)
+		defer otel.SetTracerProvider(tracenoop.NewTracerProvider())
+
+		_, _, err := s.DeliverBlock(context.Background(), &server.BlockRequest[mock.Tx]{
+			Height:  uint64(1),
+			Time:    time.Date(2024, 2, 3, 18, 23, 0, 0, time.UTC),
+			AppHash: sum[:],
+			Hash:    sum[:],
+			Txs:     []mock.Tx{mockTx},
+		}, state)
+		if err != nil {
+			t.Errorf("DeliverBlock error: %v", err)
+		}
+
+		stubs := spans.GetSpans()
+		if len(stubs) != 2 {
+			t.Fatalf("Expected 2 spans, got %d", len(stubs))
+		}
+		msgSpan, txSpan := stubs[0], stubs[1]
+		if txSpan.Name != "Tx" {
+			t.Errorf("Expected tx span name 'Tx', got %s", txSpan.Name)
+		}
+		msgType := "/" + msgTypeURL(mockTx.Msg)
+		if msgSpan.Name != msgType {
+			t.Errorf("Expected msg span name %s, got %s", msgType, msgSpan.Name)
+		}
+		if msgSpan.Parent.SpanID() != txSpan.SpanContext.SpanID() {
+			t.Errorf("Expected msg span to be a child of the tx span")
+		}
+		attrs := attribute.NewSet(txSpan.Attributes...)
+		if v, ok := attrs.Value("gas_wanted"); !ok || v.AsInt64() != int64(mockTx.GasLimit) {
+			t.Errorf("Expected gas_wanted attribute %d, got %v", mockTx.GasLimit, v)
+		}
+		if v, ok := attrs.Value("gas_used"); !ok || v.AsInt64() == 0 {
+			t.Errorf("Expected non zero gas_used attribute, got %v", v)
+		}
+	})
+
 	t.Run("exec tx out of gas", func(t *testing.T) {
 		s := s.clone()
 
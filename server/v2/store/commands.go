@@ -80,6 +80,11 @@ func createRootStore(v *viper.Viper, logger log.Logger) (storev2.RootStore, root
 	if err != nil {
 		return nil, root.Options{}, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
+	// offline commands prune synchronously, the background pruning would stop
+	// with the command
+	if storeConfig.Options.SCPruningOption != nil {
+		storeConfig.Options.SCPruningOption.Rate = 0
+	}
 	store, err := root.NewBuilder().Build(logger, storeConfig)
 	if err != nil {
 		return nil, root.Options{}, fmt.Errorf("failed to create store backend: %w", err)
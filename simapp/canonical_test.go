@@ -0,0 +1,79 @@
+package simapp
+
+import (
+	"encoding/hex"
+	"flag"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/x/tx/canonical"
+	"cosmossdk.io/x/tx/canonical/conformance"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var updateCanonicalVectors = flag.Bool("update-canonical-vectors", false, "regenerate the canonical encoding golden vectors")
+
+// TestCanonicalConformance checks the canonical encodings of every message
+// registered in simapp against the golden vectors of testdata, through both the
+// protoreflect and the gogoproto types.
+func TestCanonicalConformance(t *testing.T) {
+	app := Setup(t, false)
+	registry := app.InterfaceRegistry()
+	opts := canonical.MarshalOptions{FileResolver: registry}
+	path := filepath.Join("testdata", "canonical_vectors.json")
+
+	var descs []protoreflect.MessageDescriptor
+	for _, typeURL := range registry.ListImplementations(sdk.MsgInterfaceProtoName) {
+		desc, err := registry.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(typeURL, "/")))
+		require.NoError(t, err)
+		descs = append(descs, desc.(protoreflect.MessageDescriptor))
+	}
+
+	if *updateCanonicalVectors {
+		vectors, err := conformance.Generate(descs, opts)
+		require.NoError(t, err)
+		require.NoError(t, conformance.Write(path, vectors))
+	}
+
+	vectors, err := conformance.Read(path)
+	require.NoError(t, err)
+	byTypeURL := make(map[string]conformance.Vector, len(vectors))
+	for _, vector := range vectors {
+		byTypeURL[vector.TypeURL] = vector
+	}
+
+	for _, desc := range descs {
+		typeURL := "/" + string(desc.FullName())
+		t.Run(typeURL, func(t *testing.T) {
+			expected, ok := byTypeURL[typeURL]
+			require.True(t, ok, "missing vector, run the test with -update-canonical-vectors")
+
+			msg, err := conformance.Populate(desc, opts)
+			require.NoError(t, err)
+			vector, err := conformance.NewVector(msg, opts)
+			require.NoError(t, err)
+			require.Equal(t, expected, vector)
+
+			bz, err := hex.DecodeString(expected.Binary)
+			require.NoError(t, err)
+			gogoType := gogoproto.MessageType(string(desc.FullName()))
+			require.NotNil(t, gogoType)
+			gogoMsg := reflect.New(gogoType.Elem()).Interface().(gogoproto.Message)
+			require.NoError(t, gogoproto.Unmarshal(bz, gogoMsg))
+
+			gogoBz, err := opts.MarshalGogo(gogoMsg)
+			require.NoError(t, err)
+			require.Equal(t, bz, gogoBz)
+			gogoJSON, err := opts.MarshalGogoJSON(gogoMsg)
+			require.NoError(t, err)
+			require.Equal(t, expected.JSON, string(gogoJSON))
+		})
+	}
+}
@@ -12,8 +12,10 @@ import (
 	"cosmossdk.io/log"
 	runtimev2 "cosmossdk.io/runtime/v2"
 	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/server/v2/api/graphql"
 	grpcserver "cosmossdk.io/server/v2/api/grpc"
 	"cosmossdk.io/server/v2/api/grpcgateway"
+	"cosmossdk.io/server/v2/api/logadmin"
 	"cosmossdk.io/server/v2/api/rest"
 	"cosmossdk.io/server/v2/api/telemetry"
 	"cosmossdk.io/server/v2/cometbft"
@@ -92,6 +94,8 @@ func InitRootCmd[T transaction.Tx](
 			&telemetry.Server[T]{},
 			&rest.Server[T]{},
 			&grpcgateway.Server[T]{},
+			&logadmin.Server[T]{},
+			&graphql.Server[T]{},
 		)
 	}
 
@@ -103,13 +107,25 @@ func InitRootCmd[T transaction.Tx](
 	if err != nil {
 		return nil, err
 	}
-	restServer, err := rest.New[T](logger, simApp.App.AppManager, deps.GlobalConfig)
+	restServer, err := rest.New[T](
+		logger,
+		simApp.App.AppManager,
+		simApp.App.QueryHandlers(),
+		&client.DefaultTxDecoder[T]{TxConfig: deps.TxConfig},
+		deps.GlobalConfig,
+	)
 	if err != nil {
 		return nil, err
 	}
 
+	// the GraphQL subscriptions are notified of the blocks committed by the
+	// consensus component
+	graphqlNotifier := graphql.NewCommitNotifier()
+
 	// consensus component
 	if deps.ConsensusServer == nil {
+		cometOptions := initCometOptions[T]()
+		cometOptions.StreamingManager.Listeners = append(cometOptions.StreamingManager.Listeners, graphqlNotifier)
 		deps.ConsensusServer, err = cometbft.New(
 			logger,
 			simApp.Name(),
@@ -123,7 +139,7 @@ func InitRootCmd[T transaction.Tx](
 			},
 			simApp.App.QueryHandlers(),
 			simApp.App.SchemaDecoderResolver(),
-			initCometOptions[T](),
+			cometOptions,
 			deps.GlobalConfig,
 		)
 		if err != nil {
@@ -163,6 +179,22 @@ func InitRootCmd[T transaction.Tx](
 	}
 	registerGRPCGatewayRoutes[T](deps, grpcgatewayServer)
 
+	logAdminServer, err := logadmin.New[T](deps.GlobalConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	graphqlServer, err := graphql.New[T](
+		logger,
+		graphql.NewStateView(simApp.Store(), simApp.App.SchemaDecoderResolver()),
+		graphqlNotifier,
+		deps.ClientContext.AddressCodec,
+		deps.GlobalConfig,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// wire server commands
 	return serverv2.AddCommands[T](
 		rootCmd,
@@ -176,6 +208,8 @@ func InitRootCmd[T transaction.Tx](
 		telemetryServer,
 		restServer,
 		grpcgatewayServer,
+		logAdminServer,
+		graphqlServer,
 	)
 }
 
@@ -0,0 +1,394 @@
+package rootmulti
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	protoio "github.com/cosmos/gogoproto/io"
+	iavltree "github.com/cosmos/iavl"
+
+	coretesting "cosmossdk.io/core/testing"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/mem"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/transient"
+	"cosmossdk.io/store/types"
+)
+
+var _ snapshottypes.IncrementalSnapshotter = (*Store)(nil)
+
+// restoreItemBufferSize is the number of snapshot items buffered for each store being restored.
+const restoreItemBufferSize = 1024
+
+// namedStore is an IAVL store included in snapshots.
+type namedStore struct {
+	*iavl.Store
+	name string
+}
+
+// snapshotStores returns the stores to snapshot sorted by name. Only IAVL stores are supported.
+func (rs *Store) snapshotStores() ([]namedStore, error) {
+	stores := []namedStore{}
+	keys := keysFromStoreKeyMap(rs.stores)
+	for _, key := range keys {
+		switch store := rs.GetCommitKVStore(key).(type) {
+		case *iavl.Store:
+			stores = append(stores, namedStore{name: key.Name(), Store: store})
+		case *transient.Store, *mem.Store:
+			// Non-persisted stores shouldn't be snapshotted
+			continue
+		default:
+			return nil, errorsmod.Wrapf(types.ErrLogic,
+				"don't know how to snapshot store %q of type %T", key.Name(), store)
+		}
+	}
+	sort.Slice(stores, func(i, j int) bool {
+		return strings.Compare(stores[i].name, stores[j].name) == -1
+	})
+
+	return stores, nil
+}
+
+// SnapshotChanges implements snapshottypes.IncrementalSnapshotter. For each store, a
+// SnapshotStoreItem is followed by a SnapshotKVItem for every key set or deleted in heights
+// baseHeight+1 to height, in height and then key order. All these heights must still be
+// available in the IAVL stores.
+func (rs *Store) SnapshotChanges(baseHeight, height uint64, protoWriter protoio.Writer) error {
+	if baseHeight == 0 || baseHeight >= height {
+		return errorsmod.Wrapf(types.ErrLogic, "invalid base height %v for snapshot height %v", baseHeight, height)
+	}
+	if height > uint64(GetLatestVersion(rs.db)) {
+		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
+	}
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
+	}
+
+	for _, store := range stores {
+		rs.logger.Debug("starting incremental snapshot", "store", store.name, "base_height", baseHeight, "height", height)
+		err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_Store{
+				Store: &snapshottypes.SnapshotStoreItem{
+					Name: store.name,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		// the end version of TraverseStateChanges is exclusive
+		err = store.TraverseStateChanges(int64(baseHeight)+1, int64(height)+1, func(version int64, changeSet *iavltree.ChangeSet) error {
+			for _, pair := range changeSet.Pairs {
+				err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+					Item: &snapshottypes.SnapshotItem_KV{
+						KV: &snapshottypes.SnapshotKVItem{
+							Version: version,
+							Key:     pair.Key,
+							Value:   pair.Value,
+							Delete:  pair.Delete,
+						},
+					},
+				})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to snapshot changes of store %q", store.name)
+		}
+	}
+
+	return nil
+}
+
+// RestoreChanges implements snapshottypes.IncrementalSnapshotter. The changes of each height
+// are replayed in the IAVL stores and saved as a new version, so that the stores end up with
+// the same hashes as the ones the snapshot was taken from.
+func (rs *Store) RestoreChanges(
+	baseHeight, height uint64, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	if height > uint64(math.MaxInt64) {
+		return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(snapshottypes.ErrInvalidMetadata,
+			"snapshot height %v cannot exceed %v", height, int64(math.MaxInt64))
+	}
+	if baseHeight >= height {
+		return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic,
+			"invalid base height %v for snapshot height %v", baseHeight, height)
+	}
+	if latest := GetLatestVersion(rs.db); latest != int64(baseHeight) {
+		return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic,
+			"cannot restore changes since height %v on top of height %v", baseHeight, latest)
+	}
+
+	snapshotItem, err := restoreStores(protoReader, rs.restoreConcurrency, func(name string) (storeRestorer, error) {
+		store, ok := rs.GetStoreByName(name).(*iavl.Store)
+		if !ok || store == nil {
+			return nil, errorsmod.Wrapf(types.ErrLogic, "cannot restore changes into non-IAVL store %q", name)
+		}
+		if version := store.LastCommitID().Version; version != int64(baseHeight) {
+			return nil, errorsmod.Wrapf(types.ErrLogic, "store %q is at height %v instead of %v", name, version, baseHeight)
+		}
+		rs.logger.Debug("restoring incremental snapshot", "store", name)
+		return &changesRestorer{store: store, version: int64(baseHeight) + 1, height: int64(height)}, nil
+	})
+	if err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+	for _, store := range stores {
+		if version := store.LastCommitID().Version; version != int64(height) {
+			return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic,
+				"store %q missing from incremental snapshot, it is at height %v", store.name, version)
+		}
+	}
+
+	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
+	return snapshotItem, rs.LoadLatestVersion()
+}
+
+// CommitInfoFromSnapshot rebuilds in memory the stores of a snapshot in the current format read
+// from protoReader, and returns their commit info at height. Its hash is the app hash the
+// snapshot must match. The item following the stores, if any, is returned as well.
+func CommitInfoFromSnapshot(height uint64, protoReader protoio.Reader) (*types.CommitInfo, snapshottypes.SnapshotItem, error) {
+	var stores []namedStore
+	snapshotItem, err := restoreStores(protoReader, 1, func(name string) (storeRestorer, error) {
+		store := iavl.UnsafeNewStore(iavltree.NewMutableTree(coretesting.NewMemDB(), 0, true, iavltree.NewNopLogger()))
+		importer, err := store.Import(int64(height))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "import failed")
+		}
+		stores = append(stores, namedStore{Store: store, name: name})
+
+		return iavlImporter{importer}, nil
+	})
+	if err != nil {
+		return nil, snapshottypes.SnapshotItem{}, err
+	}
+
+	sort.Slice(stores, func(i, j int) bool {
+		return stores[i].name < stores[j].name
+	})
+	storeInfos := make([]types.StoreInfo, 0, len(stores))
+	for _, store := range stores {
+		storeInfos = append(storeInfos, types.StoreInfo{
+			Name:     store.name,
+			CommitId: store.LastCommitID(),
+		})
+	}
+
+	return &types.CommitInfo{
+		Version:    int64(height),
+		StoreInfos: storeInfos,
+	}, snapshotItem, nil
+}
+
+// storeRestorer restores the items of a store contained in a snapshot.
+type storeRestorer interface {
+	Add(item *snapshottypes.SnapshotItem) error
+	Commit() error
+	Close()
+}
+
+// restoreStores reads the items of each store from protoReader, up to the next item which doesn't
+// belong to a store, which is returned. A SnapshotStoreItem starts the items of a store, which are
+// passed to the storeRestorer returned by newRestorer. newRestorer is called sequentially, but up
+// to concurrency stores are restored at a time; a value lower than 1 restores them one by one.
+func restoreStores(
+	protoReader protoio.Reader, concurrency int, newRestorer func(name string) (storeRestorer, error),
+) (snapshottypes.SnapshotItem, error) {
+	var (
+		wg         sync.WaitGroup
+		sem        = make(chan struct{}, max(concurrency, 1))
+		failOnce   sync.Once
+		failed     = make(chan struct{})
+		restoreErr error
+
+		// items passes the items of the store being read to its restorer
+		items        chan *snapshottypes.SnapshotItem
+		snapshotItem snapshottypes.SnapshotItem
+	)
+	fail := func(err error) {
+		failOnce.Do(func() {
+			restoreErr = err
+			close(failed)
+		})
+	}
+
+loop:
+	for {
+		item := &snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(item)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			fail(errorsmod.Wrap(err, "invalid protobuf message"))
+			break
+		}
+
+		switch item.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			if items != nil {
+				close(items)
+				items = nil
+			}
+
+			restorer, err := newRestorer(item.GetStore().Name)
+			if err != nil {
+				fail(err)
+				break loop
+			}
+
+			select {
+			case sem <- struct{}{}:
+			case <-failed:
+				restorer.Close()
+				break loop
+			}
+
+			items = make(chan *snapshottypes.SnapshotItem, restoreItemBufferSize)
+			wg.Add(1)
+			go func(items <-chan *snapshottypes.SnapshotItem) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				defer restorer.Close()
+				// IAVL stores panic on write errors
+				defer func() {
+					if r := recover(); r != nil {
+						fail(fmt.Errorf("failed to restore store: %v", r))
+					}
+				}()
+
+				for item := range items {
+					if err := restorer.Add(item); err != nil {
+						fail(err)
+						return
+					}
+				}
+				if err := restorer.Commit(); err != nil {
+					fail(errorsmod.Wrap(err, "IAVL commit failed"))
+				}
+			}(items)
+
+		case *snapshottypes.SnapshotItem_IAVL, *snapshottypes.SnapshotItem_KV:
+			if items == nil {
+				fail(errorsmod.Wrapf(types.ErrLogic, "received %T item before store item", item.Item))
+				break loop
+			}
+
+			select {
+			case items <- item:
+			case <-failed:
+				break loop
+			}
+
+		default:
+			snapshotItem = *item
+			break loop
+		}
+	}
+
+	if items != nil {
+		close(items)
+	}
+	wg.Wait()
+
+	return snapshotItem, restoreErr
+}
+
+// iavlImporter restores the IAVL nodes of a full snapshot.
+type iavlImporter struct {
+	*iavltree.Importer
+}
+
+func (i iavlImporter) Add(item *snapshottypes.SnapshotItem) error {
+	iavlItem := item.GetIAVL()
+	if iavlItem == nil {
+		return errorsmod.Wrapf(types.ErrLogic, "unexpected %T item in snapshot", item.Item)
+	}
+	if iavlItem.Height > math.MaxInt8 {
+		return errorsmod.Wrapf(types.ErrLogic, "node height %v cannot exceed %v",
+			iavlItem.Height, math.MaxInt8)
+	}
+	node := &iavltree.ExportNode{
+		Key:     iavlItem.Key,
+		Value:   iavlItem.Value,
+		Height:  int8(iavlItem.Height),
+		Version: iavlItem.Version,
+	}
+	// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 && node.Value == nil {
+		node.Value = []byte{}
+	}
+
+	return errorsmod.Wrap(i.Importer.Add(node), "IAVL node import failed")
+}
+
+// changesRestorer replays the changes of an incremental snapshot in an IAVL store.
+type changesRestorer struct {
+	store *iavl.Store
+	// version is the version the changes are being applied to
+	version int64
+	height  int64
+}
+
+func (r *changesRestorer) Add(item *snapshottypes.SnapshotItem) error {
+	kv := item.GetKV()
+	if kv == nil {
+		return errorsmod.Wrapf(types.ErrLogic, "unexpected %T item in incremental snapshot", item.Item)
+	}
+	if kv.Version < r.version || kv.Version > r.height {
+		return errorsmod.Wrapf(types.ErrLogic, "unexpected change at height %v while restoring height %v",
+			kv.Version, r.version)
+	}
+
+	// save the versions up to the one of the change, some may have no changes
+	for r.version < kv.Version {
+		r.store.Commit()
+		r.version++
+	}
+
+	key := kv.Key
+	if key == nil {
+		key = []byte{}
+	}
+	if kv.Delete {
+		r.store.Delete(key)
+		return nil
+	}
+
+	value := kv.Value
+	if value == nil {
+		value = []byte{}
+	}
+	r.store.Set(key, value)
+	return nil
+}
+
+func (r *changesRestorer) Commit() error {
+	for r.version <= r.height {
+		r.store.Commit()
+		r.version++
+	}
+	return nil
+}
+
+func (r *changesRestorer) Close() {}
@@ -10,6 +10,7 @@ import (
 	"math/rand"
 	"testing"
 
+	protoio "github.com/cosmos/gogoproto/io"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -232,6 +233,96 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+// snapshotStream returns a reader of the snapshot items written by write.
+func snapshotStream(t *testing.T, write func(protoWriter protoio.Writer) error) *snapshots.StreamReader {
+	t.Helper()
+	chunks := make(chan io.ReadCloser, 100)
+	go func() {
+		streamWriter := snapshots.NewStreamWriter(chunks)
+		require.NotNil(t, streamWriter)
+		defer streamWriter.Close()
+		if err := write(streamWriter); err != nil {
+			streamWriter.CloseWithError(err)
+		}
+	}()
+
+	streamReader, err := snapshots.NewStreamReader(chunks)
+	require.NoError(t, err)
+	return streamReader
+}
+
+func TestMultistoreSnapshotRestore_Concurrent(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(coretesting.NewMemDB(), 5, 1000)
+	target := rootmulti.NewStore(coretesting.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, key := range source.StoreKeysByName() {
+		target.MountStoreWithDB(types.NewKVStoreKey(key.Name()), types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, target.LoadLatestVersion())
+	target.SetRestoreConcurrency(3)
+
+	version := uint64(source.LastCommitID().Version)
+	streamReader := snapshotStream(t, func(protoWriter protoio.Writer) error {
+		return source.Snapshot(version, protoWriter)
+	})
+	_, err := target.Restore(version, snapshottypes.CurrentFormat, streamReader)
+	require.NoError(t, err)
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, key := range source.StoreKeysByName() {
+		assertStoresEqual(t, source.GetStoreByName(key.Name()).(types.CommitKVStore),
+			target.GetStoreByName(key.Name()).(types.CommitKVStore), "store %q not equal", key.Name())
+	}
+}
+
+func TestMultistoreSnapshotChanges(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(coretesting.NewMemDB())
+	// a height without any change
+	source.Commit()
+	version := uint64(source.LastCommitID().Version)
+	require.EqualValues(t, 4, version)
+
+	target := newMultiStoreWithMixedMounts(coretesting.NewMemDB())
+	target.SetRestoreConcurrency(2)
+	_, err := target.Restore(1, snapshottypes.CurrentFormat, snapshotStream(t, func(protoWriter protoio.Writer) error {
+		return source.Snapshot(1, protoWriter)
+	}))
+	require.NoError(t, err)
+
+	// the changes can only be restored on top of the base height
+	_, err = target.RestoreChanges(2, version, snapshotStream(t, func(protoWriter protoio.Writer) error {
+		return source.SnapshotChanges(2, version, protoWriter)
+	}))
+	require.Error(t, err)
+
+	nextItem, err := target.RestoreChanges(1, version, snapshotStream(t, func(protoWriter protoio.Writer) error {
+		return source.SnapshotChanges(1, version, protoWriter)
+	}))
+	require.NoError(t, err)
+	require.Nil(t, nextItem.Item)
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, name := range []string{"iavl1", "iavl2", "iavl3"} {
+		assertStoresEqual(t, source.GetStoreByName(name).(types.CommitKVStore),
+			target.GetStoreByName(name).(types.CommitKVStore), "store %q not equal", name)
+	}
+
+	require.Error(t, source.SnapshotChanges(version, version, nil))
+	require.Error(t, source.SnapshotChanges(1, version+1, nil))
+}
+
+func TestCommitInfoFromSnapshot(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(coretesting.NewMemDB())
+	version := uint64(source.LastCommitID().Version)
+
+	commitInfo, nextItem, err := rootmulti.CommitInfoFromSnapshot(version, snapshotStream(t, func(protoWriter protoio.Writer) error {
+		return source.Snapshot(version, protoWriter)
+	}))
+	require.NoError(t, err)
+	require.Nil(t, nextItem.Item)
+	require.Equal(t, source.LastCommitID().Hash, commitInfo.Hash())
+	require.Len(t, commitInfo.StoreInfos, 3)
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Helper()
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
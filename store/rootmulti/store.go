@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"math"
 	"sort"
 	"strings"
 	"sync"
@@ -76,6 +75,7 @@ type Store struct {
 	listeners           map[types.StoreKey]*types.MemoryListener
 	metrics             metrics.StoreMetrics
 	commitHeader        cmtproto.Header
+	restoreConcurrency  int
 }
 
 var (
@@ -138,6 +138,12 @@ func (rs *Store) SetIAVLSyncPruning(syncPruning bool) {
 	rs.iavlSyncPruning = syncPruning
 }
 
+// SetRestoreConcurrency sets the number of stores restored concurrently from a snapshot.
+// Stores are restored one at a time by default.
+func (rs *Store) SetRestoreConcurrency(concurrency int) {
+	rs.restoreConcurrency = concurrency
+}
+
 // GetStoreType implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -852,28 +858,10 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
-	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
-	stores := []namedStore{}
-	keys := keysFromStoreKeyMap(rs.stores)
-	for _, key := range keys {
-		switch store := rs.GetCommitKVStore(key).(type) {
-		case *iavl.Store:
-			stores = append(stores, namedStore{name: key.Name(), Store: store})
-		case *transient.Store, *mem.Store:
-			// Non-persisted stores shouldn't be snapshotted
-			continue
-		default:
-			return errorsmod.Wrapf(types.ErrLogic,
-				"don't know how to snapshot store %q of type %T", key.Name(), store)
-		}
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
 	}
-	sort.Slice(stores, func(i, j int) bool {
-		return strings.Compare(stores[i].name, stores[j].name) == -1
-	})
 
 	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
 	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
@@ -945,78 +933,21 @@ func (rs *Store) Restore(
 	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
 	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
 	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
-	var importer *iavltree.Importer
-	var snapshotItem snapshottypes.SnapshotItem
-loop:
-	for {
-		snapshotItem = snapshottypes.SnapshotItem{}
-		err := protoReader.ReadMsg(&snapshotItem)
-		if errors.Is(err, io.EOF) {
-			break
-		} else if err != nil {
-			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "invalid protobuf message")
-		}
-
-		switch item := snapshotItem.Item.(type) {
-		case *snapshottypes.SnapshotItem_Store:
-			if importer != nil {
-				err = importer.Commit()
-				if err != nil {
-					return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL commit failed")
-				}
-				importer.Close()
-			}
-			store, ok := rs.GetStoreByName(item.Store.Name).(*iavl.Store)
-			if !ok || store == nil {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
-			}
-			importer, err = store.Import(int64(height))
-			if err != nil {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "import failed")
-			}
-			defer importer.Close()
-			// Importer height must reflect the node height (which usually matches the block height, but not always)
-			rs.logger.Debug("restoring snapshot", "store", item.Store.Name)
-
-		case *snapshottypes.SnapshotItem_IAVL:
-			if importer == nil {
-				rs.logger.Error("failed to restore; received IAVL node item before store item")
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "received IAVL node item before store item")
-			}
-			if item.IAVL.Height > math.MaxInt8 {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			node := &iavltree.ExportNode{
-				Key:     item.IAVL.Key,
-				Value:   item.IAVL.Value,
-				Height:  int8(item.IAVL.Height),
-				Version: item.IAVL.Version,
-			}
-			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 && node.Value == nil {
-				node.Value = []byte{}
-			}
-			err := importer.Add(node)
-			if err != nil {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL node import failed")
-			}
-
-		default:
-			break loop
+	snapshotItem, err := restoreStores(protoReader, rs.restoreConcurrency, func(name string) (storeRestorer, error) {
+		store, ok := rs.GetStoreByName(name).(*iavl.Store)
+		if !ok || store == nil {
+			return nil, errorsmod.Wrapf(types.ErrLogic, "cannot import into non-IAVL store %q", name)
 		}
-	}
-
-	if importer != nil {
-		err := importer.Commit()
+		importer, err := store.Import(int64(height))
 		if err != nil {
-			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL commit failed")
+			return nil, errorsmod.Wrap(err, "import failed")
 		}
-		importer.Close()
+		// Importer height must reflect the node height (which usually matches the block height, but not always)
+		rs.logger.Debug("restoring snapshot", "store", name)
+		return iavlImporter{importer}, nil
+	})
+	if err != nil {
+		return snapshottypes.SnapshotItem{}, err
 	}
 
 	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
@@ -171,6 +171,26 @@ func (m *mockSnapshotter) SetSnapshotInterval(snapshotInterval uint64) {
 	m.snapshotInterval = snapshotInterval
 }
 
+// mockIncrementalSnapshotter snapshots its items as changes, and records the base height of
+// the restored changes.
+type mockIncrementalSnapshotter struct {
+	mockSnapshotter
+	restoredBaseHeight uint64
+}
+
+var _ snapshottypes.IncrementalSnapshotter = (*mockIncrementalSnapshotter)(nil)
+
+func (m *mockIncrementalSnapshotter) SnapshotChanges(baseHeight, height uint64, protoWriter protoio.Writer) error {
+	return m.Snapshot(height, protoWriter)
+}
+
+func (m *mockIncrementalSnapshotter) RestoreChanges(
+	baseHeight, height uint64, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	m.restoredBaseHeight = baseHeight
+	return m.Restore(height, snapshottypes.IncrementalFormat, protoReader)
+}
+
 type mockErrorSnapshotter struct{}
 
 var _ snapshottypes.Snapshotter = (*mockErrorSnapshotter)(nil)
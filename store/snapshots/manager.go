@@ -11,6 +11,8 @@ import (
 	"sort"
 	"sync"
 
+	protoio "github.com/cosmos/gogoproto/io"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
@@ -182,14 +184,61 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	go m.createSnapshot(height, ch, func(protoWriter protoio.Writer) error {
+		return m.multistore.Snapshot(height, protoWriter)
+	})
 
 	return m.store.Save(height, types.CurrentFormat, ch)
 }
 
+// CreateIncremental creates an incremental snapshot containing the changes of the state since
+// the snapshot at baseHeight, and returns its metadata. The multistore must implement
+// types.IncrementalSnapshotter and still have all the heights since baseHeight.
+func (m *Manager) CreateIncremental(baseHeight, height uint64) (*types.Snapshot, error) {
+	if m == nil {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
+	}
+
+	incremental, ok := m.multistore.(types.IncrementalSnapshotter)
+	if !ok {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "multistore does not support incremental snapshots")
+	}
+	if baseHeight >= height {
+		return nil, errorsmod.Wrapf(storetypes.ErrLogic,
+			"base height %v must be lower than the snapshot height %v", baseHeight, height)
+	}
+
+	defer m.multistore.PruneSnapshotHeight(int64(height))
+
+	err := m.begin(opSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	defer m.end()
+
+	base, err := m.store.Get(baseHeight, types.CurrentFormat)
+	if err == nil && base == nil {
+		base, err = m.store.Get(baseHeight, types.IncrementalFormat)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to examine base snapshot")
+	}
+	if base == nil {
+		return nil, errorsmod.Wrapf(storetypes.ErrLogic, "no snapshot exists at base height %v", baseHeight)
+	}
+
+	ch := make(chan io.ReadCloser)
+	go m.createSnapshot(height, ch, func(protoWriter protoio.Writer) error {
+		return incremental.SnapshotChanges(baseHeight, height, protoWriter)
+	})
+
+	return m.store.SaveIncremental(baseHeight, height, ch)
+}
+
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
-// the produced chunks are written to the channel.
-func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
+// the produced chunks are written to the channel. The multistore items are written by
+// snapshotStores, followed by the extension payloads.
+func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser, snapshotStores func(protoio.Writer) error) {
 	streamWriter := NewStreamWriter(ch)
 	if streamWriter == nil {
 		return
@@ -200,7 +249,7 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		}
 	}()
 
-	if err := m.multistore.Snapshot(height, streamWriter); err != nil {
+	if err := snapshotStores(streamWriter); err != nil {
 		streamWriter.CloseWithError(err)
 		return
 	}
@@ -362,7 +411,15 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return payload.Payload, nil
 	}
 
-	nextItem, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	if snapshot.Format == types.IncrementalFormat {
+		incremental, ok := m.multistore.(types.IncrementalSnapshotter)
+		if !ok {
+			return errorsmod.Wrap(storetypes.ErrLogic, "multistore does not support incremental snapshots")
+		}
+		nextItem, err = incremental.RestoreChanges(snapshot.Metadata.BaseHeight, snapshot.Height, streamReader)
+	} else {
+		nextItem, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	}
 	if err != nil {
 		return errorsmod.Wrap(err, "multistore restore")
 	}
@@ -462,7 +519,8 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	return false, nil
 }
 
-// RestoreLocalSnapshot restores app state from a local snapshot.
+// RestoreLocalSnapshot restores app state from a local snapshot. An incremental snapshot is
+// restored on top of the current state, which must be at its base height.
 func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	snapshot, ch, err := m.store.Load(height, format)
 	if err != nil {
@@ -556,5 +614,5 @@ func (m *Manager) snapshot(height int64) {
 
 // Close the snapshot database.
 func (m *Manager) Close() error {
-	return m.store.db.Close()
+	return m.store.Close()
 }
@@ -115,6 +115,49 @@ func TestManager_Take(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestManager_CreateIncremental(t *testing.T) {
+	store, err := snapshots.NewStore(coretesting.NewMemDB(), GetTempDir(t))
+	require.NoError(t, err)
+	items := [][]byte{{1, 2, 3}, {4, 5, 6}}
+	snapshotter := &mockIncrementalSnapshotter{
+		mockSnapshotter: mockSnapshotter{items: items, prunedHeights: make(map[int64]struct{})},
+	}
+	manager := snapshots.NewManager(store, opts, snapshotter, nil, log.NewNopLogger())
+
+	// the multistore must support incremental snapshots
+	_, err = snapshots.NewManager(store, opts, &snapshotter.mockSnapshotter, nil, log.NewNopLogger()).CreateIncremental(2, 5)
+	require.Error(t, err)
+
+	_, err = manager.Create(2)
+	require.NoError(t, err)
+
+	// the base snapshot must exist and be lower than the snapshot height
+	_, err = manager.CreateIncremental(3, 5)
+	require.Error(t, err)
+	_, err = manager.CreateIncremental(2, 2)
+	require.Error(t, err)
+
+	snapshot, err := manager.CreateIncremental(2, 5)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), snapshot.Height)
+	assert.Equal(t, types.IncrementalFormat, snapshot.Format)
+	assert.Equal(t, uint64(2), snapshot.Metadata.BaseHeight)
+	_, didPruneHeight := snapshotter.prunedHeights[5]
+	require.True(t, didPruneHeight)
+
+	target := &mockIncrementalSnapshotter{
+		mockSnapshotter: mockSnapshotter{prunedHeights: make(map[int64]struct{})},
+	}
+	targetManager := snapshots.NewManager(store, opts, target, nil, log.NewNopLogger())
+	require.NoError(t, targetManager.RestoreLocalSnapshot(5, types.IncrementalFormat))
+	assert.Equal(t, uint64(2), target.restoredBaseHeight)
+	assert.Equal(t, items, target.items)
+
+	// incremental snapshots can't be restored through state sync
+	err = targetManager.Restore(*snapshot)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+}
+
 func TestManager_Prune(t *testing.T) {
 	store := setupStore(t)
 	snapshotter := &mockSnapshotter{}
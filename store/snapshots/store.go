@@ -1,6 +1,7 @@
 package snapshots
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
@@ -51,6 +52,11 @@ func NewStore(db corestore.KVStoreWithBatch, dir string) (*Store, error) {
 	}, nil
 }
 
+// Close closes the snapshot database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
 // Delete deletes a snapshot.
 func (s *Store) Delete(height uint64, format uint32) error {
 	s.mtx.Lock()
@@ -188,6 +194,49 @@ func (s *Store) loadChunkFile(height uint64, format, chunk uint32) (io.ReadClose
 	return os.Open(path)
 }
 
+// Verify checks the chunks of a snapshot on disk against the chunk hashes and the hash of its
+// metadata, and returns the snapshot. It returns nil if the snapshot does not exist.
+func (s *Store) Verify(height uint64, format uint32) (*types.Snapshot, error) {
+	snapshot, err := s.Get(height, format)
+	if snapshot == nil || err != nil {
+		return nil, err
+	}
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return nil, errors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk hashes, but %v chunks",
+			len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+	}
+
+	snapshotHasher := sha256.New()
+	chunkHasher := sha256.New()
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		err := func() error {
+			chunk, err := s.loadChunkFile(height, format, i)
+			if err != nil {
+				return err
+			}
+			defer chunk.Close()
+
+			chunkHasher.Reset()
+			_, err = io.Copy(io.MultiWriter(chunkHasher, snapshotHasher), chunk)
+			return err
+		}()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read snapshot chunk %d", i)
+		}
+
+		if hash := chunkHasher.Sum(nil); !bytes.Equal(hash, snapshot.Metadata.ChunkHashes[i]) {
+			return nil, errors.Wrapf(types.ErrChunkHashMismatch, "chunk %d: expected %x, got %x",
+				i, snapshot.Metadata.ChunkHashes[i], hash)
+		}
+	}
+
+	if hash := snapshotHasher.Sum(nil); !bytes.Equal(hash, snapshot.Hash) {
+		return nil, errors.Wrapf(types.ErrChunkHashMismatch, "snapshot: expected %x, got %x", snapshot.Hash, hash)
+	}
+
+	return snapshot, nil
+}
+
 // Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained.
 func (s *Store) Prune(retain uint32) (uint64, error) {
 	iter, err := s.db.ReverseIterator(encodeKey(0, 0), encodeKey(uint64(math.MaxUint64), math.MaxUint32))
@@ -232,7 +281,25 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
+	return s.save(&types.Snapshot{Height: height, Format: format}, chunks)
+}
+
+// SaveIncremental saves an incremental snapshot of the changes since baseHeight to disk,
+// returning it.
+func (s *Store) SaveIncremental(
+	baseHeight, height uint64, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	return s.save(&types.Snapshot{
+		Height:   height,
+		Format:   types.IncrementalFormat,
+		Metadata: types.Metadata{BaseHeight: baseHeight},
+	}, chunks)
+}
+
+// save saves the chunks of snapshot to disk, filling in its chunks and hashes.
+func (s *Store) save(snapshot *types.Snapshot, chunks <-chan io.ReadCloser) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
+	height, format := snapshot.Height, snapshot.Format
 	if height == 0 {
 		return nil, errors.Wrap(storetypes.ErrLogic, "snapshot height cannot be 0")
 	}
@@ -260,11 +327,6 @@ func (s *Store) Save(
 			"snapshot already exists for height %v format %v", height, format)
 	}
 
-	snapshot := &types.Snapshot{
-		Height: height,
-		Format: format,
-	}
-
 	dirCreated := false
 	index := uint32(0)
 	snapshotHasher := sha256.New()
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"io"
+	"os"
 	"testing"
 	"time"
 
@@ -331,3 +332,44 @@ func TestStore_Save(t *testing.T) {
 	require.NoError(t, err)
 	close(ch)
 }
+
+func TestStore_SaveIncremental(t *testing.T) {
+	store := setupStore(t)
+	snapshot, err := store.SaveIncremental(3, 4, makeChunks([][]byte{{1}, {2}}))
+	require.NoError(t, err)
+	assert.Equal(t, &types.Snapshot{
+		Height: 4,
+		Format: types.IncrementalFormat,
+		Chunks: 2,
+		Hash:   hash([][]byte{{1}, {2}}),
+		Metadata: types.Metadata{
+			ChunkHashes: checksums([][]byte{{1}, {2}}),
+			BaseHeight:  3,
+		},
+	}, snapshot)
+	loaded, err := store.Get(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, loaded)
+}
+
+func TestStore_Verify(t *testing.T) {
+	store := setupStore(t)
+
+	snapshot, err := store.Verify(2, 2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, snapshot.Chunks)
+
+	snapshot, err = store.Verify(9, 1)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+
+	err = os.WriteFile(store.PathChunk(2, 2, 1), []byte{2, 2, 9}, 0o600)
+	require.NoError(t, err)
+	_, err = store.Verify(2, 2)
+	require.ErrorIs(t, err, types.ErrChunkHashMismatch)
+
+	err = os.Remove(store.PathChunk(3, 2, 2))
+	require.NoError(t, err)
+	_, err = store.Verify(3, 2)
+	require.Error(t, err)
+}
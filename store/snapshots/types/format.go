@@ -4,3 +4,8 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// IncrementalFormat is the format of incremental snapshots. They contain the changes of every
+// store in each height between Metadata.BaseHeight, the height of a previous snapshot, and their
+// own height, and can only be restored on top of the state at the base height.
+const IncrementalFormat uint32 = 4
@@ -104,6 +104,9 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// base_height is the height of the snapshot an incremental snapshot contains
+	// the changes from. It is zero for full snapshots.
+	BaseHeight uint64 `protobuf:"varint,2,opt,name=base_height,json=baseHeight,proto3" json:"base_height,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -146,16 +149,23 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetBaseHeight() uint64 {
+	if m != nil {
+		return m.BaseHeight
+	}
+	return 0
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 type SnapshotItem struct {
 	// item is the specific type of snapshot item.
 	//
 	// Types that are valid to be assigned to Item:
-	//
 	//	*SnapshotItem_Store
 	//	*SnapshotItem_IAVL
 	//	*SnapshotItem_Extension
 	//	*SnapshotItem_ExtensionPayload
+	//	*SnapshotItem_KV
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
@@ -210,11 +220,15 @@ type SnapshotItem_Extension struct {
 type SnapshotItem_ExtensionPayload struct {
 	ExtensionPayload *SnapshotExtensionPayload `protobuf:"bytes,4,opt,name=extension_payload,json=extensionPayload,proto3,oneof" json:"extension_payload,omitempty"`
 }
+type SnapshotItem_KV struct {
+	KV *SnapshotKVItem `protobuf:"bytes,5,opt,name=kv,proto3,oneof" json:"kv,omitempty"`
+}
 
 func (*SnapshotItem_Store) isSnapshotItem_Item()            {}
 func (*SnapshotItem_IAVL) isSnapshotItem_Item()             {}
 func (*SnapshotItem_Extension) isSnapshotItem_Item()        {}
 func (*SnapshotItem_ExtensionPayload) isSnapshotItem_Item() {}
+func (*SnapshotItem_KV) isSnapshotItem_Item()               {}
 
 func (m *SnapshotItem) GetItem() isSnapshotItem_Item {
 	if m != nil {
@@ -251,6 +265,13 @@ func (m *SnapshotItem) GetExtensionPayload() *SnapshotExtensionPayload {
 	return nil
 }
 
+func (m *SnapshotItem) GetKV() *SnapshotKVItem {
+	if x, ok := m.GetItem().(*SnapshotItem_KV); ok {
+		return x.KV
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -258,6 +279,7 @@ func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 		(*SnapshotItem_IAVL)(nil),
 		(*SnapshotItem_Extension)(nil),
 		(*SnapshotItem_ExtensionPayload)(nil),
+		(*SnapshotItem_KV)(nil),
 	}
 }
 
@@ -475,6 +497,80 @@ func (m *SnapshotExtensionPayload) GetPayload() []byte {
 	return nil
 }
 
+// SnapshotKVItem is a change of a key in a store, contained in an incremental
+// snapshot.
+//
+// Since: cosmos-sdk 0.52
+type SnapshotKVItem struct {
+	// version is the block height of the change.
+	Version int64  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Key     []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value   []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// delete is true if the key was deleted, value is then empty.
+	Delete bool `protobuf:"varint,4,opt,name=delete,proto3" json:"delete,omitempty"`
+}
+
+func (m *SnapshotKVItem) Reset()         { *m = SnapshotKVItem{} }
+func (m *SnapshotKVItem) String() string { return proto.CompactTextString(m) }
+func (*SnapshotKVItem) ProtoMessage()    {}
+func (*SnapshotKVItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d5cca1aa5b69183, []int{7}
+}
+func (m *SnapshotKVItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotKVItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotKVItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotKVItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotKVItem.Merge(m, src)
+}
+func (m *SnapshotKVItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotKVItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotKVItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotKVItem proto.InternalMessageInfo
+
+func (m *SnapshotKVItem) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SnapshotKVItem) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *SnapshotKVItem) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *SnapshotKVItem) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Snapshot)(nil), "cosmos.store.snapshots.v1.Snapshot")
 	proto.RegisterType((*Metadata)(nil), "cosmos.store.snapshots.v1.Metadata")
@@ -483,6 +579,7 @@ func init() {
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.store.snapshots.v1.SnapshotIAVLItem")
 	proto.RegisterType((*SnapshotExtensionMeta)(nil), "cosmos.store.snapshots.v1.SnapshotExtensionMeta")
 	proto.RegisterType((*SnapshotExtensionPayload)(nil), "cosmos.store.snapshots.v1.SnapshotExtensionPayload")
+	proto.RegisterType((*SnapshotKVItem)(nil), "cosmos.store.snapshots.v1.SnapshotKVItem")
 }
 
 func init() {
@@ -490,41 +587,45 @@ func init() {
 }
 
 var fileDescriptor_3d5cca1aa5b69183 = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6f, 0xd3, 0x3c,
-	0x18, 0x8e, 0xd7, 0xb4, 0x5f, 0xf7, 0x26, 0x9f, 0xe8, 0xcc, 0x40, 0x61, 0x87, 0x2c, 0x84, 0x4b,
-	0x24, 0x68, 0xba, 0x75, 0x88, 0x03, 0xda, 0x85, 0x8a, 0x49, 0xad, 0x00, 0x69, 0xf2, 0x24, 0x84,
-	0xb8, 0x54, 0xde, 0x6a, 0x9a, 0xaa, 0x4d, 0x5d, 0xd5, 0x5e, 0x45, 0x8f, 0xfc, 0x03, 0xfe, 0x08,
-	0x37, 0x7e, 0xc4, 0x8e, 0x13, 0x27, 0x4e, 0x13, 0x6a, 0xff, 0x02, 0x3f, 0x00, 0xd9, 0x4e, 0x0a,
-	0xda, 0x52, 0x34, 0x6e, 0xef, 0xf3, 0xfa, 0x79, 0x1e, 0xfb, 0x7d, 0xec, 0x04, 0xa2, 0x33, 0x2e,
-	0x52, 0x2e, 0x1a, 0x42, 0xf2, 0x29, 0x6b, 0x88, 0x31, 0x9d, 0x88, 0x84, 0x4b, 0xd1, 0x98, 0xed,
-	0xaf, 0x40, 0x3c, 0x99, 0x72, 0xc9, 0xf1, 0x03, 0xc3, 0x8c, 0x35, 0x33, 0x5e, 0x31, 0xe3, 0xd9,
-	0xfe, 0xce, 0x76, 0x9f, 0xf7, 0xb9, 0x66, 0x35, 0x54, 0x65, 0x04, 0x3b, 0x99, 0xa0, 0x6b, 0x16,
-	0x32, 0xb5, 0x06, 0xe1, 0x17, 0x04, 0xd5, 0x93, 0xcc, 0x01, 0xdf, 0x87, 0x4a, 0xc2, 0x06, 0xfd,
-	0x44, 0x7a, 0x28, 0x40, 0x91, 0x4d, 0x32, 0xa4, 0xfa, 0x1f, 0xf8, 0x34, 0xa5, 0xd2, 0xdb, 0x08,
-	0x50, 0xf4, 0x3f, 0xc9, 0x90, 0xea, 0x9f, 0x25, 0xe7, 0xe3, 0xa1, 0xf0, 0x4a, 0xa6, 0x6f, 0x10,
-	0xc6, 0x60, 0x27, 0x54, 0x24, 0x9e, 0x1d, 0xa0, 0xc8, 0x25, 0xba, 0xc6, 0x47, 0x50, 0x4d, 0x99,
-	0xa4, 0x3d, 0x2a, 0xa9, 0x57, 0x0e, 0x50, 0xe4, 0x34, 0x1f, 0xc5, 0x6b, 0xe7, 0x88, 0xdf, 0x64,
-	0xd4, 0x96, 0x7d, 0x71, 0xb5, 0x6b, 0x91, 0x95, 0x34, 0xac, 0x43, 0x35, 0x5f, 0xc3, 0x0f, 0xc1,
-	0xd5, 0x1b, 0x76, 0xd5, 0x06, 0x4c, 0x78, 0x28, 0x28, 0x45, 0x2e, 0x71, 0x74, 0xaf, 0xad, 0x5b,
-	0xe1, 0xcf, 0x0d, 0x70, 0xf3, 0xf1, 0x3a, 0x92, 0xa5, 0xf8, 0x25, 0x94, 0xf5, 0x76, 0x7a, 0x42,
-	0xa7, 0xf9, 0xe4, 0x2f, 0x67, 0xc8, 0x75, 0x27, 0x6a, 0x49, 0x89, 0xdb, 0x16, 0x31, 0x62, 0xfc,
-	0x0a, 0xec, 0x01, 0x9d, 0x8d, 0x74, 0x1c, 0x4e, 0xf3, 0xf1, 0x2d, 0x4c, 0x3a, 0x2f, 0xde, 0xbe,
-	0x56, 0x1e, 0xad, 0xea, 0xe2, 0x6a, 0xd7, 0x56, 0xa8, 0x6d, 0x11, 0x6d, 0x82, 0x8f, 0x61, 0x93,
-	0x7d, 0x94, 0x6c, 0x2c, 0x06, 0x7c, 0xac, 0x83, 0x74, 0x9a, 0x7b, 0xb7, 0x70, 0x3c, 0xca, 0x35,
-	0x2a, 0x8f, 0xb6, 0x45, 0x7e, 0x9b, 0xe0, 0x53, 0xd8, 0x5a, 0x81, 0xee, 0x84, 0xce, 0x47, 0x9c,
-	0xf6, 0xf4, 0x65, 0x38, 0xcd, 0x83, 0x7f, 0x71, 0x3e, 0x36, 0xd2, 0xb6, 0x45, 0x6a, 0xec, 0x5a,
-	0xef, 0xf9, 0xdd, 0x6f, 0x5f, 0xeb, 0x77, 0x8c, 0x57, 0x5d, 0xf4, 0x86, 0xc1, 0x5e, 0xfc, 0xf4,
-	0x59, 0xab, 0x02, 0xf6, 0x40, 0xb2, 0x34, 0x3c, 0x84, 0xad, 0x1b, 0xe9, 0xa9, 0x57, 0x31, 0xa6,
-	0xa9, 0x49, 0x7e, 0x93, 0xe8, 0xba, 0xd0, 0x25, 0xfc, 0x84, 0xa0, 0x76, 0x3d, 0x37, 0x5c, 0x83,
-	0xd2, 0x90, 0xcd, 0xb5, 0xd8, 0x25, 0xaa, 0xc4, 0xdb, 0x50, 0x9e, 0xd1, 0xd1, 0x39, 0xd3, 0xb7,
-	0xe0, 0x12, 0x03, 0xb0, 0x07, 0xff, 0xcd, 0xd8, 0x74, 0x95, 0x65, 0x89, 0xe4, 0xf0, 0x8f, 0xd7,
-	0xad, 0xa2, 0x28, 0xe7, 0xaf, 0xbb, 0xf8, 0x0c, 0xef, 0xe0, 0x5e, 0x61, 0xd0, 0x45, 0x53, 0xac,
-	0xfb, 0x3e, 0x8a, 0x9d, 0x3b, 0xe0, 0xad, 0x0b, 0x5a, 0x1d, 0x3e, 0xbf, 0x2e, 0x33, 0x68, 0x0e,
-	0x8b, 0xe3, 0x3e, 0xbc, 0x58, 0xf8, 0xe8, 0x72, 0xe1, 0xa3, 0x1f, 0x0b, 0x1f, 0x7d, 0x5e, 0xfa,
-	0xd6, 0xe5, 0xd2, 0xb7, 0xbe, 0x2f, 0x7d, 0xeb, 0x7d, 0x68, 0xa8, 0xa2, 0x37, 0x8c, 0x07, 0xfc,
-	0xc6, 0x2f, 0x45, 0xce, 0x27, 0x4c, 0x9c, 0x56, 0xf4, 0x1f, 0xe0, 0xe0, 0x57, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x57, 0xe2, 0xd5, 0x36, 0x79, 0x04, 0x00, 0x00,
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x1c, 0x8d, 0xdb, 0xb4, 0x74, 0xbf, 0x14, 0xd8, 0xcc, 0x98, 0xc2, 0x0e, 0x6d, 0x29, 0x97, 0x20,
+	0x58, 0xba, 0x75, 0x88, 0x03, 0xda, 0x85, 0xc2, 0xa4, 0x4c, 0x03, 0x34, 0x79, 0xd2, 0x84, 0xb8,
+	0x54, 0xde, 0x6a, 0x96, 0x92, 0xa6, 0xae, 0x6a, 0x2f, 0x62, 0x47, 0xbe, 0x01, 0x5f, 0x84, 0x1b,
+	0x17, 0xbe, 0xc1, 0x8e, 0x13, 0x27, 0x4e, 0x13, 0xea, 0xbe, 0x08, 0xb2, 0x9d, 0x94, 0xfd, 0xc9,
+	0x50, 0xb9, 0xf9, 0xfd, 0xec, 0xf7, 0xfc, 0xfb, 0xbd, 0x17, 0x07, 0xbc, 0x03, 0x2e, 0x62, 0x2e,
+	0x5a, 0x42, 0xf2, 0x31, 0x6b, 0x89, 0x21, 0x1d, 0x89, 0x90, 0x4b, 0xd1, 0x4a, 0xd6, 0xa6, 0xc0,
+	0x1f, 0x8d, 0xb9, 0xe4, 0xf8, 0x81, 0x39, 0xe9, 0xeb, 0x93, 0xfe, 0xf4, 0xa4, 0x9f, 0xac, 0x2d,
+	0x2f, 0x1e, 0xf2, 0x43, 0xae, 0x4f, 0xb5, 0xd4, 0xca, 0x10, 0x96, 0x53, 0x42, 0xd7, 0x6c, 0xa4,
+	0x6c, 0x0d, 0x9a, 0xdf, 0x10, 0x54, 0x76, 0x53, 0x05, 0xbc, 0x04, 0xe5, 0x90, 0xf5, 0x0f, 0x43,
+	0xe9, 0xa2, 0x06, 0xf2, 0x6c, 0x92, 0x22, 0x55, 0xff, 0xc8, 0xc7, 0x31, 0x95, 0x6e, 0xa1, 0x81,
+	0xbc, 0xdb, 0x24, 0x45, 0xaa, 0x7e, 0x10, 0x1e, 0x0d, 0x23, 0xe1, 0x16, 0x4d, 0xdd, 0x20, 0x8c,
+	0xc1, 0x0e, 0xa9, 0x08, 0x5d, 0xbb, 0x81, 0xbc, 0x2a, 0xd1, 0x6b, 0xbc, 0x09, 0x95, 0x98, 0x49,
+	0xda, 0xa3, 0x92, 0xba, 0xa5, 0x06, 0xf2, 0x9c, 0xf6, 0x23, 0xff, 0xc6, 0x39, 0xfc, 0xb7, 0xe9,
+	0xd1, 0x8e, 0x7d, 0x72, 0x56, 0xb7, 0xc8, 0x94, 0xda, 0x7c, 0x07, 0x95, 0x6c, 0x0f, 0x3f, 0x84,
+	0xaa, 0xbe, 0xb0, 0xab, 0x2e, 0x60, 0xc2, 0x45, 0x8d, 0xa2, 0x57, 0x25, 0x8e, 0xae, 0x05, 0xba,
+	0x84, 0xeb, 0xe0, 0xec, 0x53, 0xc1, 0xba, 0xe9, 0x58, 0x05, 0x3d, 0x16, 0xa8, 0x52, 0xa0, 0x2b,
+	0xcd, 0x1f, 0x45, 0xa8, 0x66, 0xf3, 0x6f, 0x49, 0x16, 0xe3, 0xd7, 0x50, 0xd2, 0xfd, 0x68, 0x0b,
+	0x9c, 0xf6, 0xd3, 0x7f, 0x34, 0x99, 0xf1, 0x76, 0xd5, 0x96, 0x22, 0x07, 0x16, 0x31, 0x64, 0xbc,
+	0x0d, 0x76, 0x9f, 0x26, 0x03, 0x7d, 0xa1, 0xd3, 0x7e, 0x32, 0x83, 0xc8, 0xd6, 0xcb, 0xbd, 0x37,
+	0x4a, 0xa3, 0x53, 0x99, 0x9c, 0xd5, 0x6d, 0x85, 0x02, 0x8b, 0x68, 0x11, 0xbc, 0x03, 0x73, 0xec,
+	0xb3, 0x64, 0x43, 0xd1, 0xe7, 0x43, 0xed, 0xb4, 0xd3, 0x5e, 0x9d, 0x41, 0x71, 0x33, 0xe3, 0x28,
+	0xc3, 0x02, 0x8b, 0xfc, 0x15, 0xc1, 0xfb, 0xb0, 0x30, 0x05, 0xdd, 0x11, 0x3d, 0x1e, 0x70, 0xda,
+	0xd3, 0x69, 0x39, 0xed, 0xf5, 0xff, 0x51, 0xde, 0x31, 0xd4, 0xc0, 0x22, 0xf3, 0xec, 0x4a, 0x0d,
+	0xbf, 0x82, 0x42, 0x94, 0xa4, 0x51, 0x3f, 0x9e, 0x41, 0x74, 0x7b, 0x4f, 0x8f, 0x5f, 0x9e, 0x9c,
+	0xd5, 0x0b, 0xdb, 0x7b, 0x81, 0x45, 0x0a, 0x51, 0xf2, 0xe2, 0xde, 0xcf, 0xef, 0x2b, 0x77, 0x0d,
+	0x77, 0x45, 0xf4, 0xa2, 0xc6, 0xaa, 0xff, 0xec, 0x79, 0xa7, 0x0c, 0x76, 0x5f, 0xb2, 0xb8, 0xb9,
+	0x01, 0x0b, 0xd7, 0x22, 0x50, 0xdf, 0xde, 0x90, 0xc6, 0x26, 0xbe, 0x39, 0xa2, 0xd7, 0xb9, 0x2a,
+	0xcd, 0x2f, 0x08, 0xe6, 0xaf, 0x9a, 0x8f, 0xe7, 0xa1, 0x18, 0xb1, 0x63, 0x4d, 0xae, 0x12, 0xb5,
+	0xc4, 0x8b, 0x50, 0x4a, 0xe8, 0xe0, 0x88, 0xe9, 0x28, 0xab, 0xc4, 0x00, 0xec, 0xc2, 0xad, 0x84,
+	0x8d, 0xa7, 0x81, 0x14, 0x49, 0x06, 0x2f, 0xbc, 0x21, 0xe5, 0x67, 0x29, 0x7b, 0x43, 0xf9, 0x3d,
+	0xbc, 0x87, 0xfb, 0xb9, 0x69, 0xe5, 0x4d, 0x71, 0xd3, 0x2b, 0xcc, 0x57, 0xde, 0x02, 0xf7, 0xa6,
+	0xb4, 0x54, 0xf3, 0x59, 0xe6, 0x66, 0xd0, 0x0c, 0xe6, 0x4b, 0x7d, 0x82, 0x3b, 0x97, 0x33, 0xba,
+	0x38, 0x3d, 0xba, 0x3c, 0x7d, 0xea, 0x5f, 0x21, 0xc7, 0xbf, 0xe2, 0x45, 0xff, 0x96, 0xa0, 0xdc,
+	0x63, 0x03, 0x26, 0x99, 0x76, 0xa9, 0x42, 0x52, 0xd4, 0xd9, 0x38, 0x99, 0xd4, 0xd0, 0xe9, 0xa4,
+	0x86, 0x7e, 0x4f, 0x6a, 0xe8, 0xeb, 0x79, 0xcd, 0x3a, 0x3d, 0xaf, 0x59, 0xbf, 0xce, 0x6b, 0xd6,
+	0x87, 0xa6, 0x69, 0x4b, 0xf4, 0x22, 0xbf, 0xcf, 0xaf, 0xfd, 0x24, 0xe5, 0xf1, 0x88, 0x89, 0xfd,
+	0xb2, 0xfe, 0xa7, 0xad, 0xff, 0x19, 0x00, 0x83, 0xf6, 0x7d, 0xb8, 0x4b, 0x05, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -602,6 +703,11 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BaseHeight != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.BaseHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -730,6 +836,27 @@ func (m *SnapshotItem_ExtensionPayload) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *SnapshotItem_KV) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotItem_KV) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.KV != nil {
+		{
+			size, err := m.KV.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SnapshotStoreItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -872,6 +999,58 @@ func (m *SnapshotExtensionPayload) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotKVItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotKVItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotKVItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Delete {
+		i--
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSnapshot(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSnapshot(v)
 	base := offset
@@ -919,6 +1098,9 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if m.BaseHeight != 0 {
+		n += 1 + sovSnapshot(uint64(m.BaseHeight))
+	}
 	return n
 }
 
@@ -982,6 +1164,18 @@ func (m *SnapshotItem_ExtensionPayload) Size() (n int) {
 	}
 	return n
 }
+func (m *SnapshotItem_KV) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KV != nil {
+		l = m.KV.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
 func (m *SnapshotStoreItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1047,6 +1241,29 @@ func (m *SnapshotExtensionPayload) Size() (n int) {
 	return n
 }
 
+func (m *SnapshotKVItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSnapshot(uint64(m.Version))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Delete {
+		n += 2
+	}
+	return n
+}
+
 func sovSnapshot(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1288,6 +1505,25 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+			}
+			m.BaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1478,6 +1714,41 @@ func (m *SnapshotItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &SnapshotItem_ExtensionPayload{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KV", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotKVItem{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &SnapshotItem_KV{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1922,6 +2193,163 @@ func (m *SnapshotExtensionPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SnapshotKVItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotKVItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotKVItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSnapshot(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
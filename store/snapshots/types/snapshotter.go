@@ -26,6 +26,20 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// IncrementalSnapshotter is a Snapshotter which can also snapshot the changes of the state
+// between two heights, and restore them on top of the state at the first height.
+type IncrementalSnapshotter interface {
+	Snapshotter
+
+	// SnapshotChanges writes the changes made in heights baseHeight+1 to height into the
+	// protobuf writer.
+	SnapshotChanges(baseHeight, height uint64, protoWriter protoio.Writer) error
+
+	// RestoreChanges restores the changes made in heights baseHeight+1 to height on top of
+	// the state at baseHeight, which must be the latest height.
+	RestoreChanges(baseHeight, height uint64, protoReader protoio.Reader) (SnapshotItem, error)
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
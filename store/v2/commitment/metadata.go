@@ -168,6 +168,11 @@ func (m *MetadataStore) deleteRemovedStoreKeys(version uint64, removeStore func(
 	return batch.Write()
 }
 
+func (m *MetadataStore) hasCommitInfo(version uint64) (bool, error) {
+	cInfoKey := []byte(fmt.Sprintf(commitInfoKeyFmt, version))
+	return m.kv.Has(cInfoKey)
+}
+
 func (m *MetadataStore) deleteCommitInfo(version uint64) error {
 	cInfoKey := []byte(fmt.Sprintf(commitInfoKeyFmt, version))
 	return m.kv.Delete(cInfoKey)
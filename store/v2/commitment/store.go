@@ -343,8 +343,20 @@ func (c *CommitStore) ReverseIterator(storeKey []byte, version uint64, start, en
 
 // Prune implements store.Pruner.
 func (c *CommitStore) Prune(version uint64) error {
-	// prune the metadata
-	for v := version; v > 0; v-- {
+	// prune the metadata, the versions below the first missing commit info are
+	// already pruned
+	latestVersion, err := c.metadata.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	for v := min(version, latestVersion); v > 0; v-- {
+		ok, err := c.metadata.hasCommitInfo(v)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
 		if err := c.metadata.deleteCommitInfo(v); err != nil {
 			return err
 		}
@@ -26,6 +26,14 @@ type UpgradableDatabase interface {
 	PruneStoreKeys(storeKeys []string, version uint64) error
 }
 
+// Compactor defines an API for a database that supports manual compaction of
+// its key range, to reclaim the disk space of deleted keys.
+type Compactor interface {
+	// ForceCompact compacts the key range [start, limit). A nil start or limit
+	// extends the range to the first or last key of the database.
+	ForceCompact(start, limit []byte) error
+}
+
 // Committer defines an API for committing state.
 type Committer interface {
 	UpgradeableStore
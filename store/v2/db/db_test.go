@@ -107,6 +107,32 @@ func (s *DBTestSuite) TestIterator() {
 	s.Require().Equal(-1, index)
 }
 
+func (s *DBTestSuite) TestForceCompact() {
+	compactor, ok := s.db.(interface {
+		ForceCompact(start, limit []byte) error
+	})
+	if !ok {
+		s.T().Skip("compaction is not supported")
+	}
+
+	for i := 0; i < 100; i++ {
+		s.Require().NoError(s.db.Set([]byte(fmt.Sprintf("compact%03d", i)), []byte("value")))
+	}
+	for i := 0; i < 50; i++ {
+		s.Require().NoError(s.db.Delete([]byte(fmt.Sprintf("compact%03d", i))))
+	}
+
+	s.Require().NoError(compactor.ForceCompact(nil, nil))
+	s.Require().NoError(compactor.ForceCompact([]byte("compact050"), []byte("compact060")))
+
+	value, err := s.db.Get([]byte("compact050"))
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("value"), value)
+	has, err := s.db.Has([]byte("compact049"))
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
 func TestMemDBSuite(t *testing.T) {
 	suite.Run(t, &DBTestSuite{
 		db: NewMemDB(),
@@ -158,6 +158,8 @@ func (db *GoLevelDB) Stats() map[string]string {
 	return stats
 }
 
+// ForceCompact compacts the key range [start, limit). A nil start or limit
+// extends the range to the first or last key of the database.
 func (db *GoLevelDB) ForceCompact(start, limit []byte) error {
 	return db.db.CompactRange(util.Range{Start: start, Limit: limit})
 }
@@ -106,6 +106,33 @@ func (db *PebbleDB) Delete(key []byte) error {
 	return db.storage.Delete(key, &pebble.WriteOptions{Sync: false})
 }
 
+// ForceCompact compacts the key range [start, limit). A nil start or limit
+// extends the range to the first or last key of the database.
+func (db *PebbleDB) ForceCompact(start, limit []byte) error {
+	if start == nil || limit == nil {
+		itr, err := db.storage.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: limit})
+		if err != nil {
+			return fmt.Errorf("failed to create PebbleDB iterator: %w", err)
+		}
+		if start == nil && itr.First() {
+			start = slices.Clone(itr.Key())
+		}
+		if limit == nil && itr.Last() {
+			// the limit is exclusive, extend it past the last key
+			limit = append(slices.Clone(itr.Key()), 0)
+		}
+		if err := itr.Close(); err != nil {
+			return err
+		}
+		if start == nil || limit == nil {
+			// the range is empty
+			return nil
+		}
+	}
+
+	return db.storage.Compact(start, limit, true)
+}
+
 func (db *PebbleDB) Iterator(start, end []byte) (corestore.Iterator, error) {
 	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
 		return nil, storeerrors.ErrKeyEmpty
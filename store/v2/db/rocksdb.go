@@ -110,6 +110,13 @@ func (db *RocksDB) Delete(key []byte) error {
 	return db.storage.Delete(grocksdb.NewDefaultWriteOptions(), key)
 }
 
+// ForceCompact compacts the key range [start, limit). A nil start or limit
+// extends the range to the first or last key of the database.
+func (db *RocksDB) ForceCompact(start, limit []byte) error {
+	db.storage.CompactRange(grocksdb.Range{Start: start, Limit: limit})
+	return nil
+}
+
 func (db *RocksDB) Iterator(start, end []byte) (corestore.Iterator, error) {
 	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
 		return nil, storeerrors.ErrKeyEmpty
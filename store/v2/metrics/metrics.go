@@ -12,6 +12,7 @@ var _ StoreMetrics = Metrics{}
 // StoreMetrics defines the set of supported metric APIs for the store package.
 type StoreMetrics interface {
 	MeasureSince(start time.Time, keys ...string)
+	SetGauge(val float32, keys ...string)
 }
 
 // Metrics defines a default StoreMetrics implementation.
@@ -46,6 +47,12 @@ func (m Metrics) MeasureSince(start time.Time, keys ...string) {
 	metrics.MeasureSinceWithLabels(keys, start.UTC(), m.Labels)
 }
 
+// SetGauge provides a wrapper functionality for emitting a gauge metric with
+// global labels (if any).
+func (m Metrics) SetGauge(val float32, keys ...string) {
+	metrics.SetGaugeWithLabels(keys, val, m.Labels)
+}
+
 // NoOpMetrics is a no-op implementation of the StoreMetrics interface
 type NoOpMetrics struct{}
 
@@ -56,3 +63,6 @@ func NewNoOpMetrics() NoOpMetrics {
 
 // MeasureSince is a no-op implementation of the StoreMetrics interface to avoid time.Now() calls
 func (m NoOpMetrics) MeasureSince(start time.Time, keys ...string) {}
+
+// SetGauge is a no-op implementation of the StoreMetrics interface
+func (m NoOpMetrics) SetGauge(val float32, keys ...string) {}
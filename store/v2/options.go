@@ -26,6 +26,18 @@ type PruningOption struct {
 	// Interval sets the number of how often to prune.
 	// If set to 0, no pruning will be done.
 	Interval uint64 `mapstructure:"interval" toml:"interval" comment:"Height interval at which pruned heights are removed from disk."`
+
+	// Rate sets the maximum number of heights pruned per block by the background
+	// pruning worker. If set to 0, the heights are pruned synchronously at commit.
+	Rate uint64 `mapstructure:"rate" toml:"rate" comment:"Maximum number of heights pruned in the background per block. 0 prunes all pending heights synchronously at commit."`
+
+	// TimeBudget sets the maximum time in milliseconds spent by the background
+	// pruning worker per block. If set to 0, only Rate bounds the pruning.
+	TimeBudget uint64 `mapstructure:"time-budget" toml:"time-budget" comment:"Maximum time in milliseconds spent pruning in the background per block. 0 means no time limit."`
+
+	// CompactionInterval sets the number of heights pruned in the background
+	// after which the database is compacted. If set to 0, no compaction is done.
+	CompactionInterval uint64 `mapstructure:"compaction-interval" toml:"compaction-interval" comment:"Number of heights pruned in the background after which the database is compacted. 0 disables compaction."`
 }
 
 // NewPruningOption returns a new PruningOption instance based on the given pruning strategy.
@@ -59,6 +71,11 @@ func NewPruningOptionWithCustom(keepRecent, interval uint64) *PruningOption {
 	}
 }
 
+// IsBackground returns true if the pruning is done by the background worker.
+func (opts *PruningOption) IsBackground() bool {
+	return opts.Rate > 0
+}
+
 // ShouldPrune returns true if the given version should be pruned.
 // If true, it also returns the version to prune up to.
 // NOTE: The current version is not pruned.
@@ -1,52 +1,365 @@
 package pruning
 
 import (
+	"bytes"
+	"sync"
+	"time"
+
+	corelog "cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
 	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/internal/encoding"
+	"cosmossdk.io/store/v2/metrics"
 )
 
+// progressKey is the key of the background pruning progress, the last pruned
+// version followed by the version to prune to.
+var progressKey = []byte("p/progress")
+
 // Manager is a struct that manages the pruning of old versions of the SC and SS.
+//
+// When the pruning option has a rate, the heights are pruned by a background
+// worker, at most Rate heights and TimeBudget milliseconds per block, instead of
+// synchronously at commit.
 type Manager struct {
 	// scPruner is the pruner for the SC.
 	scPruner store.Pruner
 	// scPruningOption are the pruning options for the SC.
 	scPruningOption *store.PruningOption
+
+	// db persists the background pruning progress, if set.
+	db corestore.KVStoreWithBatch
+	// compactor compacts the database after CompactionInterval pruned heights, if set.
+	compactor store.Compactor
+	logger    corelog.Logger
+	metrics   metrics.StoreMetrics
+
+	mtx sync.Mutex
+	// pruned is the last version pruned by the background worker.
+	pruned uint64
+	// target is the version the background worker prunes to.
+	target uint64
+	// loaded is true once the progress is loaded from db.
+	loaded bool
+	// paused is true while a version is committed.
+	paused bool
+	// uncompacted is the number of heights pruned since the last compaction.
+	uncompacted uint64
+
+	// pruneMtx is held by the background worker while pruning a version.
+	pruneMtx  sync.Mutex
+	startOnce sync.Once
+	chSignal  chan struct{}
+	chStop    chan struct{}
+	chDone    chan struct{}
+}
+
+// ManagerOption configures the background pruning of a Manager.
+type ManagerOption func(*Manager)
+
+// WithProgressDB sets the database persisting the background pruning progress,
+// so that the pruning resumes where it stopped after a restart.
+func WithProgressDB(db corestore.KVStoreWithBatch) ManagerOption {
+	return func(m *Manager) {
+		m.db = db
+	}
+}
+
+// WithCompactor sets the database compacted by the background worker after
+// CompactionInterval pruned heights.
+func WithCompactor(compactor store.Compactor) ManagerOption {
+	return func(m *Manager) {
+		m.compactor = compactor
+	}
+}
+
+// WithLogger sets the logger of the background worker.
+func WithLogger(logger corelog.Logger) ManagerOption {
+	return func(m *Manager) {
+		m.logger = logger
+	}
 }
 
 // NewManager creates a new Pruning Manager.
-func NewManager(scPruner store.Pruner, scPruningOption *store.PruningOption) *Manager {
-	return &Manager{
+func NewManager(scPruner store.Pruner, scPruningOption *store.PruningOption, opts ...ManagerOption) *Manager {
+	m := &Manager{
 		scPruner:        scPruner,
 		scPruningOption: scPruningOption,
+		logger:          log.NewNopLogger(),
+		metrics:         metrics.NoOpMetrics{},
+		chSignal:        make(chan struct{}, 1),
+		chStop:          make(chan struct{}),
+		chDone:          make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
 	}
+
+	return m
+}
+
+// SetMetrics sets the metrics emitted by the background worker.
+func (m *Manager) SetMetrics(metrics metrics.StoreMetrics) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.metrics = metrics
 }
 
 // Prune prunes the SC and SS to the provided version.
 //
+// If the pruning is done in the background, it only schedules the pruning and
+// wakes up the background worker, which prunes at most one step per call.
+//
 // NOTE: It can be called outside the store manually.
 func (m *Manager) Prune(version uint64) error {
+	if m.scPruningOption == nil {
+		return nil
+	}
+
+	prune, pruneTo := m.scPruningOption.ShouldPrune(version)
+	if m.scPruningOption.IsBackground() {
+		return m.schedule(prune, pruneTo)
+	}
+
 	// Prune the SC.
-	if m.scPruningOption != nil {
-		if prune, pruneTo := m.scPruningOption.ShouldPrune(version); prune {
-			if err := m.scPruner.Prune(pruneTo); err != nil {
-				return err
-			}
+	if prune {
+		if err := m.scPruner.Prune(pruneTo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// schedule moves the target of the background pruning to pruneTo if prune is
+// true, and signals the background worker if some versions are pending.
+func (m *Manager) schedule(prune bool, pruneTo uint64) error {
+	m.mtx.Lock()
+	if err := m.loadProgress(); err != nil {
+		m.mtx.Unlock()
+		return err
+	}
+	if prune && pruneTo > m.target {
+		if m.target == 0 {
+			// without a recorded progress, the versions up to the previous pruning
+			// interval are assumed to be pruned as the synchronous pruning would have
+			m.pruned = max(m.pruned, pruneTo-min(pruneTo, m.scPruningOption.Interval))
+		}
+		m.target = pruneTo
+		if err := m.saveProgress(); err != nil {
+			m.mtx.Unlock()
+			return err
+		}
+	}
+	pending := m.target > m.pruned
+	m.metrics.SetGauge(float32(m.target-m.pruned), "pruning", "pending")
+	m.mtx.Unlock()
+
+	if pending {
+		m.startOnce.Do(func() {
+			go m.run()
+		})
+		select {
+		case m.chSignal <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// run is the loop of the background worker, it prunes one step per signal.
+func (m *Manager) run() {
+	defer close(m.chDone)
+
+	for {
+		select {
+		case <-m.chStop:
+			return
+		case <-m.chSignal:
+			m.step()
+		}
+	}
+}
+
+// step prunes the pending versions one by one, until Rate versions are pruned,
+// TimeBudget is exceeded or the pruning is paused for a commit.
+func (m *Manager) step() {
+	start := time.Now()
+	budget := time.Duration(m.scPruningOption.TimeBudget) * time.Millisecond
+
+	var count uint64
+	for count < m.scPruningOption.Rate {
+		m.pruneMtx.Lock()
+		m.mtx.Lock()
+		paused, version, target := m.paused, m.pruned+1, m.target
+		m.mtx.Unlock()
+		if paused || version > target {
+			m.pruneMtx.Unlock()
+			break
+		}
+
+		err := m.scPruner.Prune(version)
+		m.pruneMtx.Unlock()
+		if err != nil {
+			m.logger.Error("failed to prune in the background", "version", version, "err", err)
+			break
+		}
+
+		m.mtx.Lock()
+		m.pruned = version
+		m.uncompacted++
+		if err := m.saveProgress(); err != nil {
+			m.logger.Error("failed to save the pruning progress", "version", version, "err", err)
+		}
+		m.mtx.Unlock()
+
+		count++
+		if budget > 0 && time.Since(start) >= budget {
+			break
+		}
+	}
+
+	m.mtx.Lock()
+	pruned, pending, compact := m.pruned, m.target-m.pruned, m.shouldCompact()
+	telemetry := m.metrics
+	m.mtx.Unlock()
+
+	if count > 0 {
+		telemetry.MeasureSince(start, "pruning", "step")
+		telemetry.SetGauge(float32(pruned), "pruning", "pruned_height")
+		telemetry.SetGauge(float32(pending), "pruning", "pending")
+		m.logger.Debug("pruned in the background", "versions", count, "pruned", pruned, "pending", pending)
+	}
+
+	if compact {
+		m.compact()
+	}
+}
+
+// shouldCompact returns true if the database must be compacted. It must be
+// called with mtx held.
+func (m *Manager) shouldCompact() bool {
+	interval := m.scPruningOption.CompactionInterval
+	return m.compactor != nil && interval > 0 && m.uncompacted >= interval
+}
+
+// compact compacts the whole database.
+func (m *Manager) compact() {
+	start := time.Now()
+	if err := m.compactor.ForceCompact(nil, nil); err != nil {
+		m.logger.Error("failed to compact the database", "err", err)
+		return
+	}
+
+	m.mtx.Lock()
+	m.uncompacted = 0
+	telemetry := m.metrics
+	m.mtx.Unlock()
+
+	telemetry.MeasureSince(start, "pruning", "compaction")
+	m.logger.Info("compacted the database", "duration", time.Since(start))
+}
+
+// loadProgress loads the background pruning progress from db once. It must be
+// called with mtx held.
+func (m *Manager) loadProgress() error {
+	if m.loaded || m.db == nil {
+		return nil
+	}
+
+	bz, err := m.db.Get(progressKey)
+	if err != nil {
+		return err
+	}
+	if bz != nil {
+		pruned, n, err := encoding.DecodeUvarint(bz)
+		if err != nil {
+			return err
+		}
+		target, _, err := encoding.DecodeUvarint(bz[n:])
+		if err != nil {
+			return err
 		}
+		m.pruned, m.target = pruned, target
 	}
+	m.loaded = true
 
 	return nil
 }
 
+// saveProgress persists the background pruning progress to db. It must be
+// called with mtx held.
+func (m *Manager) saveProgress() error {
+	if m.db == nil {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(encoding.EncodeUvarintSize(m.pruned) + encoding.EncodeUvarintSize(m.target))
+	if err := encoding.EncodeUvarint(&buf, m.pruned); err != nil {
+		return err
+	}
+	if err := encoding.EncodeUvarint(&buf, m.target); err != nil {
+		return err
+	}
+
+	return m.db.Set(progressKey, buf.Bytes())
+}
+
+// Progress returns the last version pruned by the background worker and the
+// version it prunes to.
+func (m *Manager) Progress() (pruned, target uint64, err error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if err := m.loadProgress(); err != nil {
+		return 0, 0, err
+	}
+
+	return m.pruned, m.target, nil
+}
+
 func (m *Manager) signalPruning(pause bool) {
 	if scPausablePruner, ok := m.scPruner.(store.PausablePruner); ok {
 		scPausablePruner.PausePruning(pause)
 	}
 }
 
+// PausePruning pauses the pruning while a version is committed. It waits for
+// the background worker to finish the version it is pruning.
 func (m *Manager) PausePruning() {
+	m.mtx.Lock()
+	m.paused = true
+	m.mtx.Unlock()
+
+	// wait for the version being pruned in the background
+	m.pruneMtx.Lock()
 	m.signalPruning(true)
+	m.pruneMtx.Unlock()
 }
 
+// ResumePruning resumes the pruning once the version is committed, and prunes
+// or schedules the pruning of the old versions.
 func (m *Manager) ResumePruning(version uint64) error {
+	m.mtx.Lock()
+	m.paused = false
+	m.mtx.Unlock()
+
 	m.signalPruning(false)
 	return m.Prune(version)
 }
+
+// Close stops the background worker, it waits for the current step to finish.
+func (m *Manager) Close() error {
+	started := true
+	m.startOnce.Do(func() {
+		started = false
+	})
+	if started {
+		close(m.chStop)
+		<-m.chDone
+	}
+
+	return nil
+}
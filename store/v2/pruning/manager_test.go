@@ -2,6 +2,9 @@ package pruning
 
 import (
 	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -225,3 +228,100 @@ func (s *PruningManagerTestSuite) TestSignalCommit() {
 	}
 	s.Require().Eventually(checkSCPrune, 10*time.Second, 1*time.Second)
 }
+
+// mockPruner records the versions it prunes.
+type mockPruner struct {
+	mtx    sync.Mutex
+	pruned []uint64
+}
+
+func (p *mockPruner) Prune(version uint64) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.pruned = append(p.pruned, version)
+	return nil
+}
+
+func (p *mockPruner) prunedVersions() []uint64 {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return slices.Clone(p.pruned)
+}
+
+// mockCompactor counts the compactions.
+type mockCompactor struct {
+	count atomic.Int32
+}
+
+func (c *mockCompactor) ForceCompact(_, _ []byte) error {
+	c.count.Add(1)
+	return nil
+}
+
+func TestBackgroundPrune(t *testing.T) {
+	db := coretesting.NewMemDB()
+	pruner := &mockPruner{}
+	compactor := &mockCompactor{}
+	option := &store.PruningOption{KeepRecent: 5, Interval: 10, Rate: 2, CompactionInterval: 4}
+	manager := NewManager(pruner, option, WithProgressDB(db), WithCompactor(compactor))
+
+	for version := uint64(1); version <= 20; version++ {
+		manager.PausePruning()
+		require.NoError(t, manager.ResumePruning(version))
+	}
+	// each block wakes up the background worker for one step
+	require.Eventually(t, func() bool {
+		require.NoError(t, manager.Prune(21))
+		pruned, target, err := manager.Progress()
+		require.NoError(t, err)
+		return target == 14 && pruned == 14
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14}, pruner.prunedVersions())
+	require.Eventually(t, func() bool {
+		return compactor.count.Load() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, manager.Close())
+
+	// the pruning resumes from the persisted progress
+	manager = NewManager(pruner, option, WithProgressDB(db))
+	pruned, target, err := manager.Progress()
+	require.NoError(t, err)
+	require.Equal(t, uint64(14), pruned)
+	require.Equal(t, uint64(14), target)
+
+	require.NoError(t, manager.Prune(30))
+	require.Eventually(t, func() bool {
+		pruned, _, err := manager.Progress()
+		require.NoError(t, err)
+		return pruned >= 16
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, manager.Close())
+
+	// a single step prunes at most Rate versions
+	pruned, target, err = manager.Progress()
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), pruned)
+	require.Equal(t, uint64(24), target)
+	require.Equal(t, []uint64{15, 16}, pruner.prunedVersions()[14:])
+}
+
+func TestBackgroundPrunePaused(t *testing.T) {
+	pruner := &mockPruner{}
+	option := &store.PruningOption{KeepRecent: 0, Interval: 10, Rate: 10}
+	manager := NewManager(pruner, option)
+	defer func() {
+		require.NoError(t, manager.Close())
+	}()
+
+	// no version is pruned while a version is committed
+	manager.PausePruning()
+	require.NoError(t, manager.Prune(10))
+	time.Sleep(50 * time.Millisecond)
+	require.Empty(t, pruner.prunedVersions())
+
+	require.NoError(t, manager.ResumePruning(11))
+	require.Eventually(t, func() bool {
+		return len(pruner.prunedVersions()) == 9
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9}, pruner.prunedVersions())
+}
@@ -127,6 +127,10 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 		return nil, err
 	}
 
-	pm := pruning.NewManager(sc, storeOpts.SCPruningOption)
+	pmOpts := []pruning.ManagerOption{pruning.WithProgressDB(opts.SCRawDB), pruning.WithLogger(opts.Logger)}
+	if compactor, ok := opts.SCRawDB.(store.Compactor); ok {
+		pmOpts = append(pmOpts, pruning.WithCompactor(compactor))
+	}
+	pm := pruning.NewManager(sc, storeOpts.SCPruningOption, pmOpts...)
 	return New(opts.SCRawDB, opts.Logger, sc, pm, nil, metrics.NoOpMetrics{})
 }
@@ -81,6 +81,8 @@ func New(
 // Close closes the store and resets all internal fields. Note, Close() is NOT
 // idempotent and should only be called once.
 func (s *Store) Close() (err error) {
+	// stop the background pruning before closing the backends it prunes
+	err = errors.Join(err, s.pruningManager.Close())
 	err = errors.Join(err, s.stateCommitment.Close())
 	err = errors.Join(err, s.dbCloser.Close())
 
@@ -92,6 +94,7 @@ func (s *Store) Close() (err error) {
 
 func (s *Store) SetMetrics(m metrics.Metrics) {
 	s.telemetry = m
+	s.pruningManager.SetMetrics(m)
 }
 
 func (s *Store) SetInitialVersion(v uint64) error {
@@ -90,6 +90,27 @@ type Config struct {
 	// DatadogHostname defines the hostname to use when emitting metrics to
 	// Datadog. Only utilized if MetricsSink is set to "dogstatsd".
 	DatadogHostname string `mapstructure:"datadog-hostname"`
+
+	// OTelEnabled enables the OpenTelemetry provider. When enabled, spans and
+	// metrics are exported to an OTLP gRPC endpoint.
+	OTelEnabled bool `mapstructure:"otel-enabled"`
+
+	// OTelEndpoint defines the host:port of the OTLP gRPC endpoint. When empty,
+	// the OTLP exporters defaults (and OTEL_EXPORTER_OTLP_* environment
+	// variables) are used.
+	OTelEndpoint string `mapstructure:"otel-endpoint"`
+
+	// OTelInsecure disables the client transport security of the OTLP exporters.
+	OTelInsecure bool `mapstructure:"otel-insecure"`
+
+	// OTelTraceSampleRatio defines the ratio of traces which are sampled, between
+	// 0 and 1. Spans are always sampled if their parent is. When nil, all
+	// traces are sampled.
+	OTelTraceSampleRatio *float64 `mapstructure:"otel-trace-sample-ratio"`
+
+	// OTelMetricsInterval defines the interval in seconds at which metrics are
+	// exported to the OTLP endpoint.
+	OTelMetricsInterval int64 `mapstructure:"otel-metrics-interval"`
 }
 
 // Metrics defines a wrapper around application telemetry functionality. It allows
@@ -0,0 +1,249 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// OTelInstrumentationName is the name of the OpenTelemetry tracer and meter
+// used by the SDK.
+const OTelInstrumentationName = "github.com/cosmos/cosmos-sdk"
+
+// DefaultOTelMetricsInterval is the interval at which metrics are pushed to the
+// OTLP endpoint when Config.OTelMetricsInterval is not set.
+const DefaultOTelMetricsInterval = 60 * time.Second
+
+// Common OpenTelemetry attribute keys.
+const (
+	AttributeKeyModule    = attribute.Key("module")
+	AttributeKeyMsgType   = attribute.Key("msg_type")
+	AttributeKeyHeight    = attribute.Key("height")
+	AttributeKeyGasWanted = attribute.Key("gas_wanted")
+	AttributeKeyGasUsed   = attribute.Key("gas_used")
+	AttributeKeyMethod    = attribute.Key("method")
+	AttributeKeySuccess   = attribute.Key("success")
+)
+
+// otelInstruments holds the metric instruments of the active OTelProvider.
+type otelInstruments struct {
+	abciDuration        metric.Float64Histogram
+	txGasUsed           metric.Int64Histogram
+	msgDuration         metric.Float64Histogram
+	storeCommitDuration metric.Float64Histogram
+}
+
+// globalOTelInstruments is set when an OTelProvider is created and cleared
+// when it is shut down. The Record* functions are no-ops while it is nil.
+var globalOTelInstruments atomic.Pointer[otelInstruments]
+
+// OTelProvider defines a wrapper around the OpenTelemetry tracer and meter
+// providers. When created, it is registered as the global OpenTelemetry
+// provider, so spans started with StartSpan and metrics recorded with the
+// Record* functions are exported through it.
+type OTelProvider struct {
+	tracerProvider *sdktrace.TracerProvider
+	meterProvider  *sdkmetric.MeterProvider
+}
+
+// NewOTelProvider creates a new OTelProvider exporting spans and metrics to the
+// OTLP gRPC endpoint defined in the configuration. It returns nil if
+// OpenTelemetry is disabled.
+func NewOTelProvider(ctx context.Context, cfg Config) (*OTelProvider, error) {
+	if !cfg.OTelEnabled {
+		return nil, nil
+	}
+
+	traceOpts := []otlptracegrpc.Option{}
+	metricOpts := []otlpmetricgrpc.Option{}
+	if cfg.OTelEndpoint != "" {
+		traceOpts = append(traceOpts, otlptracegrpc.WithEndpoint(cfg.OTelEndpoint))
+		metricOpts = append(metricOpts, otlpmetricgrpc.WithEndpoint(cfg.OTelEndpoint))
+	}
+	if cfg.OTelInsecure {
+		traceOpts = append(traceOpts, otlptracegrpc.WithInsecure())
+		metricOpts = append(metricOpts, otlpmetricgrpc.WithInsecure())
+	}
+
+	traceExporter, err := otlptracegrpc.New(ctx, traceOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	metricExporter, err := otlpmetricgrpc.New(ctx, metricOpts...)
+	if err != nil {
+		return nil, errors.Join(err, traceExporter.Shutdown(ctx))
+	}
+
+	interval := DefaultOTelMetricsInterval
+	if cfg.OTelMetricsInterval > 0 {
+		interval = time.Duration(cfg.OTelMetricsInterval) * time.Second
+	}
+
+	res := resource.NewSchemaless(attribute.String("service.name", cfg.ServiceName))
+
+	return NewOTelProviderWithOptions(
+		[]sdktrace.TracerProviderOption{
+			sdktrace.WithResource(res),
+			sdktrace.WithSampler(traceSampler(cfg)),
+			sdktrace.WithBatcher(traceExporter),
+		},
+		[]sdkmetric.Option{
+			sdkmetric.WithResource(res),
+			sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter, sdkmetric.WithInterval(interval))),
+		},
+	)
+}
+
+// traceSampler returns the sampler of the traces defined in the configuration,
+// sampling all traces when no ratio is set.
+func traceSampler(cfg Config) sdktrace.Sampler {
+	if cfg.OTelTraceSampleRatio == nil {
+		return sdktrace.ParentBased(sdktrace.AlwaysSample())
+	}
+	return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(*cfg.OTelTraceSampleRatio))
+}
+
+// NewOTelProviderWithOptions creates a new OTelProvider from the given tracer
+// and meter provider options, e.g. to export spans and metrics to other
+// exporters than OTLP. See the oteltest package for an in-memory provider.
+func NewOTelProviderWithOptions(traceOpts []sdktrace.TracerProviderOption, metricOpts []sdkmetric.Option) (*OTelProvider, error) {
+	p := &OTelProvider{
+		tracerProvider: sdktrace.NewTracerProvider(traceOpts...),
+		meterProvider:  sdkmetric.NewMeterProvider(metricOpts...),
+	}
+
+	instruments, err := newOTelInstruments(p.meterProvider.Meter(OTelInstrumentationName))
+	if err != nil {
+		return nil, errors.Join(err, p.Shutdown(context.Background()))
+	}
+
+	otel.SetTracerProvider(p.tracerProvider)
+	otel.SetMeterProvider(p.meterProvider)
+	globalOTelInstruments.Store(instruments)
+
+	return p, nil
+}
+
+func newOTelInstruments(meter metric.Meter) (*otelInstruments, error) {
+	abciDuration, err := meter.Float64Histogram(
+		"abci.duration",
+		metric.WithDescription("Duration of the ABCI calls"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	txGasUsed, err := meter.Int64Histogram(
+		"tx.gas_used",
+		metric.WithDescription("Gas used by the transactions"),
+		metric.WithUnit("{gas}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	msgDuration, err := meter.Float64Histogram(
+		"tx.msg.duration",
+		metric.WithDescription("Duration of the message handlers"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	storeCommitDuration, err := meter.Float64Histogram(
+		"store.commit.duration",
+		metric.WithDescription("Duration of the store commits"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &otelInstruments{
+		abciDuration:        abciDuration,
+		txGasUsed:           txGasUsed,
+		msgDuration:         msgDuration,
+		storeCommitDuration: storeCommitDuration,
+	}, nil
+}
+
+// Shutdown flushes the pending spans and metrics and stops the providers. The
+// global OpenTelemetry providers are reset to no-op providers.
+func (p *OTelProvider) Shutdown(ctx context.Context) error {
+	if isGlobalOTelProvider(p) {
+		globalOTelInstruments.Store(nil)
+		otel.SetTracerProvider(tracenoop.NewTracerProvider())
+		otel.SetMeterProvider(metricnoop.NewMeterProvider())
+	}
+
+	return errors.Join(p.tracerProvider.Shutdown(ctx), p.meterProvider.Shutdown(ctx))
+}
+
+func isGlobalOTelProvider(p *OTelProvider) bool {
+	tp, ok := otel.GetTracerProvider().(*sdktrace.TracerProvider)
+	return ok && tp == p.tracerProvider
+}
+
+// StartSpan starts a new span with the given name and attributes using the
+// global OpenTelemetry tracer. The span is a child of the span in ctx, if any.
+// The returned span must be ended by the caller.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(OTelInstrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records err, if any, on span and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// RecordABCIDuration records the duration of the given ABCI method since start.
+func RecordABCIDuration(method string, start time.Time) {
+	if instruments := globalOTelInstruments.Load(); instruments != nil {
+		instruments.abciDuration.Record(context.Background(), time.Since(start).Seconds(),
+			metric.WithAttributes(AttributeKeyMethod.String(method)))
+	}
+}
+
+// RecordTxGasUsed records the gas used by a transaction.
+func RecordTxGasUsed(gasUsed uint64, success bool) {
+	if instruments := globalOTelInstruments.Load(); instruments != nil {
+		instruments.txGasUsed.Record(context.Background(), int64(gasUsed),
+			metric.WithAttributes(AttributeKeySuccess.Bool(success)))
+	}
+}
+
+// RecordMsgDuration records the duration of a message handler since start.
+func RecordMsgDuration(module, msgType string, start time.Time) {
+	if instruments := globalOTelInstruments.Load(); instruments != nil {
+		instruments.msgDuration.Record(context.Background(), time.Since(start).Seconds(),
+			metric.WithAttributes(AttributeKeyModule.String(module), AttributeKeyMsgType.String(msgType)))
+	}
+}
+
+// RecordStoreCommitDuration records the duration of a store commit since start.
+func RecordStoreCommitDuration(start time.Time) {
+	if instruments := globalOTelInstruments.Load(); instruments != nil {
+		instruments.storeCommitDuration.Record(context.Background(), time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,81 @@
+package telemetry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/cosmos/cosmos-sdk/telemetry/oteltest"
+)
+
+func TestOTelProvider_Disabled(t *testing.T) {
+	p, err := telemetry.NewOTelProvider(context.Background(), telemetry.Config{OTelEnabled: false})
+	require.Nil(t, p)
+	require.Nil(t, err)
+}
+
+func TestOTelProvider_TraceSampleRatio(t *testing.T) {
+	ratio := 0.0
+	p, err := telemetry.NewOTelProvider(context.Background(), telemetry.Config{OTelEnabled: true, OTelTraceSampleRatio: &ratio})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		// nothing listens on the OTLP endpoint, the export errors are ignored
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		_ = p.Shutdown(ctx)
+	})
+
+	// a zero ratio samples no trace
+	_, span := telemetry.StartSpan(context.Background(), "unsampled")
+	require.False(t, span.IsRecording())
+	span.End()
+}
+
+func TestOTelProvider_InMemory(t *testing.T) {
+	p, spans, reader, err := oteltest.NewInMemoryProvider()
+	require.NoError(t, err)
+
+	ctx, parent := telemetry.StartSpan(context.Background(), "parent", telemetry.AttributeKeyHeight.Int64(1))
+	_, child := telemetry.StartSpan(ctx, "child", telemetry.AttributeKeyModule.String("bank"))
+	telemetry.EndSpan(child, errors.New("boom"))
+	telemetry.EndSpan(parent, nil)
+
+	telemetry.RecordABCIDuration("Commit", time.Now())
+	telemetry.RecordTxGasUsed(100, true)
+	telemetry.RecordMsgDuration("bank", "/cosmos.bank.v1beta1.MsgSend", time.Now())
+	telemetry.RecordStoreCommitDuration(time.Now())
+
+	stubs := spans.GetSpans()
+	require.Len(t, stubs, 2)
+	require.Equal(t, "child", stubs[0].Name)
+	require.Equal(t, codes.Error, stubs[0].Status.Code)
+	require.Equal(t, stubs[1].SpanContext.SpanID(), stubs[0].Parent.SpanID())
+	require.Contains(t, stubs[0].Attributes, telemetry.AttributeKeyModule.String("bank"))
+	require.Equal(t, "parent", stubs[1].Name)
+	require.Equal(t, codes.Unset, stubs[1].Status.Code)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Equal(t, telemetry.OTelInstrumentationName, rm.ScopeMetrics[0].Scope.Name)
+	names := []string{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		names = append(names, m.Name)
+	}
+	require.ElementsMatch(t, []string{"abci.duration", "tx.gas_used", "tx.msg.duration", "store.commit.duration"}, names)
+
+	require.NoError(t, p.Shutdown(context.Background()))
+
+	// once shut down, the global providers are no-ops
+	_, span := telemetry.StartSpan(context.Background(), "after-shutdown")
+	require.False(t, span.IsRecording())
+	span.End()
+	telemetry.RecordTxGasUsed(100, true)
+	require.Len(t, spans.GetSpans(), 0)
+}
@@ -0,0 +1,32 @@
+// Package oteltest provides an in-memory OpenTelemetry provider for tests.
+package oteltest
+
+import (
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// NewInMemoryProvider creates a new telemetry.OTelProvider sampling all spans,
+// keeping them in the returned exporter and the metrics in the returned reader.
+// As any OTelProvider, it is registered as the global OpenTelemetry provider
+// until shut down.
+func NewInMemoryProvider() (*telemetry.OTelProvider, *tracetest.InMemoryExporter, *sdkmetric.ManualReader, error) {
+	spans := tracetest.NewInMemoryExporter()
+	reader := sdkmetric.NewManualReader()
+
+	p, err := telemetry.NewOTelProviderWithOptions(
+		[]sdktrace.TracerProviderOption{
+			sdktrace.WithSampler(sdktrace.AlwaysSample()),
+			sdktrace.WithSyncer(spans),
+		},
+		[]sdkmetric.Option{sdkmetric.WithReader(reader)},
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return p, spans, reader, nil
+}
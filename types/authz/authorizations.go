@@ -1,9 +1,39 @@
 package authz
 
 import (
+	"context"
+
+	"github.com/cosmos/gogoproto/proto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// GasCostPerIteration is the gas consumed by the authorizations per item they
+// iterate over when accepting a message.
+//
+// TODO: Revisit this once we have proper gas fee framework.
+// Ref: https://github.com/cosmos/cosmos-sdk/issues/9054
+// Ref: https://github.com/cosmos/cosmos-sdk/discussions/9072
+const GasCostPerIteration = uint64(10)
+
+// Authorization represents the interface of various Authorization types implemented
+// by other modules.
+type Authorization interface {
+	proto.Message
+
+	// MsgTypeURL returns the fully-qualified Msg service method URL (as described in ADR 031),
+	// which will process and accept or reject a request.
+	MsgTypeURL() string
+
+	// Accept determines whether this grant permits the provided sdk.Msg to be performed,
+	// and if so provides an upgraded authorization instance.
+	Accept(ctx context.Context, msg sdk.Msg) (AcceptResponse, error)
+
+	// ValidateBasic does a simple validation check that
+	// doesn't require access to any other information.
+	ValidateBasic() error
+}
+
 // AcceptResponse instruments the controller of an authz message if the request is accepted
 // and if it should be updated or deleted.
 type AcceptResponse struct {
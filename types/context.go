@@ -62,6 +62,33 @@ type Context struct {
 	streamingManager     storetypes.StreamingManager
 	cometInfo            comet.Info
 	headerInfo           header.Info
+	headerReads          *HeaderFields
+}
+
+// HeaderFields is a set of the fields of the block header a Context is
+// executed with.
+type HeaderFields uint8
+
+const (
+	// HeaderTime is the block time.
+	HeaderTime HeaderFields = 1 << iota
+	// HeaderHash is the block hash.
+	HeaderHash
+	// HeaderProposer is the address of the block proposer.
+	HeaderProposer
+	// HeaderValidators is the hash of the validators of the next block.
+	HeaderValidators
+	// HeaderLastCommit is the commit of the previous block.
+	HeaderLastCommit
+	// HeaderMisbehavior is the misbehavior evidence of the block.
+	HeaderMisbehavior
+)
+
+// record adds fields to the set, if not nil.
+func (f *HeaderFields) record(fields HeaderFields) {
+	if f != nil {
+		*f |= fields
+	}
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -71,11 +98,9 @@ type Request = Context
 func (c Context) Context() context.Context                      { return c.baseCtx }
 func (c Context) MultiStore() storetypes.MultiStore             { return c.ms }
 func (c Context) BlockHeight() int64                            { return c.header.Height }
-func (c Context) BlockTime() time.Time                          { return c.headerInfo.Time } // Deprecated: use HeaderInfo().Time
 func (c Context) ChainID() string                               { return c.chainID }
 func (c Context) TxBytes() []byte                               { return c.txBytes }
 func (c Context) Logger() log.Logger                            { return c.logger }
-func (c Context) VoteInfos() []abci.VoteInfo                    { return c.voteInfo }
 func (c Context) GasMeter() storetypes.GasMeter                 { return c.gasMeter }
 func (c Context) BlockGasMeter() storetypes.GasMeter            { return c.blockGasMeter }
 func (c Context) IsCheckTx() bool                               { return c.checkTx }   // Deprecated: use core/transaction service instead
@@ -88,16 +113,44 @@ func (c Context) Priority() int64                               { return c.prior
 func (c Context) KVGasConfig() storetypes.GasConfig             { return c.kvGasConfig }
 func (c Context) TransientKVGasConfig() storetypes.GasConfig    { return c.transientKVGasConfig }
 func (c Context) StreamingManager() storetypes.StreamingManager { return c.streamingManager }
-func (c Context) CometInfo() comet.Info                         { return c.cometInfo }
-func (c Context) HeaderInfo() header.Info                       { return c.headerInfo }
+
+// BlockTime returns the block time.
+//
+// Deprecated: use HeaderInfo().Time
+func (c Context) BlockTime() time.Time {
+	c.headerReads.record(HeaderTime)
+	return c.headerInfo.Time
+}
+
+// VoteInfos returns the votes of the commit of the previous block.
+//
+// Deprecated: use CometInfo().LastCommit.Votes instead
+func (c Context) VoteInfos() []abci.VoteInfo {
+	c.headerReads.record(HeaderLastCommit)
+	return c.voteInfo
+}
+
+// CometInfo returns the comet info of the block.
+func (c Context) CometInfo() comet.Info {
+	c.headerReads.record(HeaderProposer | HeaderValidators | HeaderLastCommit | HeaderMisbehavior)
+	return c.cometInfo
+}
+
+// HeaderInfo returns the header info of the block.
+func (c Context) HeaderInfo() header.Info {
+	c.headerReads.record(HeaderTime | HeaderHash)
+	return c.headerInfo
+}
 
 // BlockHeader returns the header by value.
 func (c Context) BlockHeader() cmtproto.Header {
+	c.headerReads.record(HeaderTime | HeaderProposer | HeaderValidators)
 	return c.header
 }
 
 // HeaderHash returns a copy of the header hash obtained during abci.RequestBeginBlock
 func (c Context) HeaderHash() []byte {
+	c.headerReads.record(HeaderHash)
 	hash := make([]byte, len(c.headerHash))
 	copy(hash, c.headerHash)
 	return hash
@@ -181,14 +234,14 @@ func (c Context) WithHeaderHash(hash []byte) Context {
 
 // WithProposer returns a Context with an updated proposer consensus address.
 func (c Context) WithProposer(addr ConsAddress) Context {
-	newHeader := c.BlockHeader()
+	newHeader := c.header
 	newHeader.ProposerAddress = addr.Bytes()
 	return c.WithBlockHeader(newHeader)
 }
 
 // WithBlockHeight returns a Context with an updated block height.
 func (c Context) WithBlockHeight(height int64) Context {
-	newHeader := c.BlockHeader()
+	newHeader := c.header
 	newHeader.Height = height
 	return c.WithBlockHeader(newHeader)
 }
@@ -318,6 +371,13 @@ func (c Context) WithHeaderInfo(headerInfo header.Info) Context {
 	return c
 }
 
+// WithHeaderReads returns a Context recording in reads the block header fields
+// read from it and from the Contexts derived from it.
+func (c Context) WithHeaderReads(reads *HeaderFields) Context {
+	c.headerReads = reads
+	return c
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
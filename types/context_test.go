@@ -162,6 +162,29 @@ func (s *contextTestSuite) TestContextHeader() {
 	s.Require().Equal(proposer.Bytes(), ctx.BlockHeader().ProposerAddress)
 }
 
+func (s *contextTestSuite) TestContextHeaderReads() {
+	var reads types.HeaderFields
+	ctx := types.NewContext(nil, false, nil).WithHeaderReads(&reads)
+
+	// the height and chain id are not recorded
+	_ = ctx.BlockHeight()
+	_ = ctx.ChainID()
+	s.Require().Zero(reads)
+
+	// the reads of the derived contexts are recorded
+	_ = ctx.WithBlockHeight(5).BlockTime()
+	s.Require().Equal(types.HeaderTime, reads)
+	_ = ctx.HeaderHash()
+	s.Require().Equal(types.HeaderTime|types.HeaderHash, reads)
+	_ = ctx.VoteInfos()
+	s.Require().Equal(types.HeaderTime|types.HeaderHash|types.HeaderLastCommit, reads)
+	_ = ctx.CometInfo()
+	s.Require().Equal(types.HeaderTime|types.HeaderHash|types.HeaderProposer|types.HeaderValidators|types.HeaderLastCommit|types.HeaderMisbehavior, reads)
+
+	// nothing is recorded without reads
+	_ = ctx.WithHeaderReads(nil).HeaderInfo()
+}
+
 func (s *contextTestSuite) TestContextHeaderClone() {
 	cases := map[string]struct {
 		h cmtproto.Header
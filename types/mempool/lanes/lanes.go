@@ -0,0 +1,173 @@
+// Package lanes implements the lane selection and block space budgeting shared
+// by the laned mempools and proposal handlers of baseapp and server/v2. It does
+// not depend on their transaction and context types, lanes are referred to by
+// their index in the lanes order.
+package lanes
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+var (
+	// ErrNoLane is returned for a transaction which matches no lane.
+	ErrNoLane = errors.New("tx matches no lane")
+	// ErrLaneOrder is returned for a proposal whose transactions are not
+	// grouped by lane, in the lanes order.
+	ErrLaneOrder = errors.New("txs are not grouped by lane in the lanes order")
+	// ErrLaneBlockSpace is returned for a proposal in which a lane uses more
+	// than its share of the block bytes or gas.
+	ErrLaneBlockSpace = errors.New("lane exceeds its share of the block space")
+)
+
+// Spec is the name of a lane and the share of the block space its transactions
+// may use.
+type Spec struct {
+	// Name is the name of the lane, used in errors.
+	Name string
+	// MaxBlockSpace is the share of the block bytes and gas the transactions of
+	// the lane may use, between 0 (excluded) and 1.
+	MaxBlockSpace math.LegacyDec
+}
+
+// Validate returns an error if there are no lanes, a lane name is duplicated or
+// the max block space of a lane is not in (0, 1].
+func Validate(specs []Spec) error {
+	if len(specs) == 0 {
+		return errors.New("no lanes")
+	}
+	names := make(map[string]struct{}, len(specs))
+	for _, spec := range specs {
+		if _, ok := names[spec.Name]; ok {
+			return fmt.Errorf("duplicate lane %q", spec.Name)
+		}
+		names[spec.Name] = struct{}{}
+		if spec.MaxBlockSpace.IsNil() || !spec.MaxBlockSpace.IsPositive() || spec.MaxBlockSpace.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("lane %q: max block space must be in (0, 1], got %s", spec.Name, spec.MaxBlockSpace)
+		}
+	}
+	return nil
+}
+
+// Index returns the index of the first of the n lanes matching, or -1 if none
+// does.
+func Index(n int, match func(lane int) bool) int {
+	for i := 0; i < n; i++ {
+		if match(i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Limit returns the share of limit of a lane of the given max block space.
+func Limit(maxBlockSpace math.LegacyDec, limit uint64) uint64 {
+	return math.LegacyNewDecFromInt(math.NewIntFromUint64(limit)).Mul(maxBlockSpace).TruncateInt().Uint64()
+}
+
+// Budget tracks the block bytes and gas used by the transactions selected for
+// a proposal, in total and per lane.
+type Budget struct {
+	specs      []Spec
+	totalBytes uint64
+	totalGas   uint64
+	laneBytes  []uint64
+	laneGas    []uint64
+}
+
+// NewBudget returns an empty Budget of the lanes.
+func NewBudget(specs []Spec) *Budget {
+	b := &Budget{specs: specs}
+	b.Reset()
+	return b
+}
+
+// Reset empties the budget.
+func (b *Budget) Reset() {
+	b.totalBytes = 0
+	b.totalGas = 0
+	b.laneBytes = make([]uint64, len(b.specs))
+	b.laneGas = make([]uint64, len(b.specs))
+}
+
+// Add adds a transaction of the lane to the budget if both the block and the
+// lane have enough capacity left, and reports whether it did. A zero maxGas
+// means the block gas is not limited.
+func (b *Budget) Add(lane int, txBytes, txGas, maxBytes, maxGas uint64) bool {
+	limit := b.specs[lane].MaxBlockSpace
+	fits := b.totalBytes+txBytes <= maxBytes &&
+		b.laneBytes[lane]+txBytes <= Limit(limit, maxBytes)
+	if maxGas > 0 {
+		fits = fits && b.totalGas+txGas <= maxGas &&
+			b.laneGas[lane]+txGas <= Limit(limit, maxGas)
+	}
+	if !fits {
+		return false
+	}
+
+	b.totalBytes += txBytes
+	b.laneBytes[lane] += txBytes
+	if maxGas > 0 {
+		b.totalGas += txGas
+		b.laneGas[lane] += txGas
+	}
+	return true
+}
+
+// Full reports whether the block has reached its capacity, in which case no
+// more transactions can be selected.
+func (b *Budget) Full(maxBytes, maxGas uint64) bool {
+	return b.totalBytes >= maxBytes || (maxGas > 0 && b.totalGas >= maxGas)
+}
+
+// ProposalChecker checks that the transactions of a proposal are grouped by
+// lane, in the lanes order, and that each lane uses at most its share of the
+// block bytes and gas.
+type ProposalChecker struct {
+	specs     []Spec
+	maxBytes  uint64
+	maxGas    uint64
+	lastLane  int
+	count     int
+	laneBytes []uint64
+	laneGas   []uint64
+}
+
+// NewProposalChecker returns a ProposalChecker of the lanes for a block of the
+// given max bytes and gas, a zero limit is not checked.
+func NewProposalChecker(specs []Spec, maxBytes, maxGas uint64) *ProposalChecker {
+	return &ProposalChecker{
+		specs:     specs,
+		maxBytes:  maxBytes,
+		maxGas:    maxGas,
+		laneBytes: make([]uint64, len(specs)),
+		laneGas:   make([]uint64, len(specs)),
+	}
+}
+
+// Add checks the next transaction of the proposal, of the given lane, bytes
+// and gas, a lane of -1 meaning the transaction matches no lane.
+func (c *ProposalChecker) Add(lane int, txBytes, txGas uint64) error {
+	i := c.count
+	c.count++
+
+	if lane < 0 {
+		return fmt.Errorf("tx %d: %w", i, ErrNoLane)
+	}
+	if lane < c.lastLane {
+		return fmt.Errorf("tx %d of lane %q is after a tx of lane %q: %w", i, c.specs[lane].Name, c.specs[c.lastLane].Name, ErrLaneOrder)
+	}
+	c.lastLane = lane
+
+	c.laneBytes[lane] += txBytes
+	if c.maxBytes > 0 && c.laneBytes[lane] > Limit(c.specs[lane].MaxBlockSpace, c.maxBytes) {
+		return fmt.Errorf("lane %q exceeds its share of the block bytes: %w", c.specs[lane].Name, ErrLaneBlockSpace)
+	}
+	c.laneGas[lane] += txGas
+	if c.maxGas > 0 && c.laneGas[lane] > Limit(c.specs[lane].MaxBlockSpace, c.maxGas) {
+		return fmt.Errorf("lane %q exceeds its share of the block gas: %w", c.specs[lane].Name, ErrLaneBlockSpace)
+	}
+	return nil
+}
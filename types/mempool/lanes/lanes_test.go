@@ -0,0 +1,69 @@
+package lanes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/mempool/lanes"
+)
+
+var testSpecs = []lanes.Spec{
+	{Name: "priority", MaxBlockSpace: math.LegacyNewDecWithPrec(5, 1)},
+	{Name: "default", MaxBlockSpace: math.LegacyOneDec()},
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, lanes.Validate(testSpecs))
+	require.ErrorContains(t, lanes.Validate(nil), "no lanes")
+	require.ErrorContains(t, lanes.Validate([]lanes.Spec{testSpecs[0], testSpecs[0]}), "duplicate lane")
+	require.ErrorContains(t, lanes.Validate([]lanes.Spec{{Name: "a"}}), "max block space")
+	require.ErrorContains(t, lanes.Validate([]lanes.Spec{{Name: "a", MaxBlockSpace: math.LegacyZeroDec()}}), "max block space")
+	require.ErrorContains(t, lanes.Validate([]lanes.Spec{{Name: "a", MaxBlockSpace: math.LegacyNewDec(2)}}), "max block space")
+}
+
+func TestIndex(t *testing.T) {
+	require.Equal(t, 1, lanes.Index(3, func(lane int) bool { return lane > 0 }))
+	require.Equal(t, -1, lanes.Index(3, func(int) bool { return false }))
+}
+
+func TestBudget(t *testing.T) {
+	b := lanes.NewBudget(testSpecs)
+
+	// the priority lane gets at most half of the block bytes
+	require.True(t, b.Add(0, 25, 0, 100, 0))
+	require.True(t, b.Add(0, 25, 0, 100, 0))
+	require.False(t, b.Add(0, 1, 0, 100, 0))
+	require.True(t, b.Add(1, 40, 0, 100, 0))
+	require.False(t, b.Full(100, 0))
+	require.False(t, b.Add(1, 20, 0, 100, 0))
+	require.True(t, b.Add(1, 10, 0, 100, 0))
+	require.True(t, b.Full(100, 0))
+
+	// and of the block gas, when limited
+	b.Reset()
+	require.True(t, b.Add(0, 1, 50, 100, 100))
+	require.False(t, b.Add(0, 1, 1, 100, 100))
+	require.True(t, b.Add(1, 1, 50, 100, 100))
+	require.True(t, b.Full(100, 100))
+}
+
+func TestProposalChecker(t *testing.T) {
+	c := lanes.NewProposalChecker(testSpecs, 100, 0)
+	require.NoError(t, c.Add(0, 50, 1000))
+	require.NoError(t, c.Add(1, 50, 1000))
+	require.ErrorIs(t, c.Add(0, 1, 0), lanes.ErrLaneOrder)
+
+	c = lanes.NewProposalChecker(testSpecs, 100, 0)
+	require.ErrorIs(t, c.Add(-1, 1, 0), lanes.ErrNoLane)
+
+	c = lanes.NewProposalChecker(testSpecs, 100, 0)
+	require.NoError(t, c.Add(0, 50, 0))
+	require.ErrorIs(t, c.Add(0, 1, 0), lanes.ErrLaneBlockSpace)
+
+	c = lanes.NewProposalChecker(testSpecs, 0, 100)
+	require.NoError(t, c.Add(0, 1000, 50))
+	require.ErrorIs(t, c.Add(0, 0, 1), lanes.ErrLaneBlockSpace)
+}
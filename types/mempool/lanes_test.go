@@ -0,0 +1,141 @@
+package mempool_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	countertypes "github.com/cosmos/cosmos-sdk/testutil/x/counter/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func newTestLanedMempool(t *testing.T) *mempool.LanedMempool {
+	t.Helper()
+	mp, err := mempool.NewLanedMempool(
+		mempool.Lane{
+			Name:          "high",
+			Mempool:       mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(0)),
+			Match:         func(_ context.Context, tx sdk.Tx) bool { return tx.(testTx).priority >= 10 },
+			MaxBlockSpace: math.LegacyNewDecWithPrec(2, 1),
+		},
+		mempool.Lane{
+			Name:          "default",
+			Mempool:       mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(0)),
+			Match:         mempool.MatchAll,
+			MaxBlockSpace: math.LegacyOneDec(),
+		},
+	)
+	require.NoError(t, err)
+	return mp
+}
+
+func TestLanedMempool(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, nil)
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	txs := []testTx{
+		{id: 0, priority: 1, nonce: 0, address: accounts[0].Address},
+		{id: 1, priority: 20, nonce: 0, address: accounts[1].Address},
+		{id: 2, priority: 2, nonce: 0, address: accounts[2].Address},
+		{id: 3, priority: 10, nonce: 1, address: accounts[1].Address},
+	}
+
+	mp := newTestLanedMempool(t)
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, 2, mp.Lanes()[0].Mempool.CountTx())
+	require.Equal(t, 2, mp.Lanes()[1].Mempool.CountTx())
+
+	// the txs of the first lane come first
+	var lanes []int
+	for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		lanes = append(lanes, mempool.LaneIndex(ctx, mp.Lanes(), iter.Tx()))
+	}
+	require.Equal(t, []int{0, 0, 1, 1}, lanes)
+
+	var selected []int
+	mp.SelectBy(ctx, nil, func(tx sdk.Tx) bool {
+		selected = append(selected, tx.(testTx).id)
+		return len(selected) < 3
+	})
+	require.Len(t, selected, 3)
+	require.Equal(t, []int{1, 3}, selected[:2])
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.NoError(t, mp.Remove(txs[0]))
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+	require.Equal(t, 2, mp.CountTx())
+}
+
+func TestLanedMempoolNoLane(t *testing.T) {
+	mp, err := mempool.NewLanedMempool(mempool.Lane{
+		Name:          "none",
+		Mempool:       mempool.NewSenderNonceMempool(),
+		Match:         func(context.Context, sdk.Tx) bool { return false },
+		MaxBlockSpace: math.LegacyOneDec(),
+	})
+	require.NoError(t, err)
+	err = mp.Insert(sdk.NewContext(nil, false, nil), testTx{address: simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)[0].Address})
+	require.ErrorIs(t, err, mempool.ErrNoLane)
+}
+
+func TestNewLanedMempoolValidation(t *testing.T) {
+	lane := mempool.Lane{
+		Name:          "default",
+		Mempool:       mempool.NewSenderNonceMempool(),
+		Match:         mempool.MatchAll,
+		MaxBlockSpace: math.LegacyOneDec(),
+	}
+	withSpace := func(space math.LegacyDec) mempool.Lane {
+		l := lane
+		l.MaxBlockSpace = space
+		return l
+	}
+
+	testCases := map[string]struct {
+		lanes  []mempool.Lane
+		expErr string
+	}{
+		"valid":               {lanes: []mempool.Lane{lane}},
+		"no lanes":            {expErr: "no lanes"},
+		"duplicate lane":      {lanes: []mempool.Lane{lane, lane}, expErr: "duplicate lane"},
+		"no match":            {lanes: []mempool.Lane{{Name: "x", Mempool: lane.Mempool, MaxBlockSpace: lane.MaxBlockSpace}}, expErr: "mempool and match must be set"},
+		"nil block space":     {lanes: []mempool.Lane{withSpace(math.LegacyDec{})}, expErr: "max block space"},
+		"zero block space":    {lanes: []mempool.Lane{withSpace(math.LegacyZeroDec())}, expErr: "max block space"},
+		"block space above 1": {lanes: []mempool.Lane{withSpace(math.LegacyNewDec(2))}, expErr: "max block space"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := mempool.NewLanedMempool(tc.lanes...)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// msgsTx is a testTx with messages.
+type msgsTx struct {
+	testTx
+	msgs []sdk.Msg
+}
+
+func (tx msgsTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func TestMatchMsgTypeURLs(t *testing.T) {
+	match := mempool.MatchMsgTypeURLs(sdk.MsgTypeURL(&countertypes.MsgIncreaseCounter{}))
+	ctx := context.Background()
+
+	require.True(t, match(ctx, msgsTx{msgs: []sdk.Msg{&countertypes.MsgIncreaseCounter{}, &countertypes.MsgIncreaseCounter{}}}))
+	require.False(t, match(ctx, msgsTx{}))
+	require.False(t, match(ctx, msgsTx{msgs: []sdk.Msg{&countertypes.MsgIncreaseCounter{}, &countertypes.MsgIncreaseCountResponse{}}}))
+}
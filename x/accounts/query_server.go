@@ -2,8 +2,13 @@ package accounts
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/accounts/internal/implementation"
 	v1 "cosmossdk.io/x/accounts/v1"
 )
@@ -74,6 +79,9 @@ func (q *queryServer) AccountType(ctx context.Context, request *v1.AccountTypeRe
 	}
 	accType, err := q.k.AccountsByType.Get(ctx, addr)
 	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "account %s not found", request.Address)
+		}
 		return nil, err
 	}
 	return &v1.AccountTypeResponse{
@@ -5,6 +5,8 @@ import (
 
 	"github.com/cosmos/gogoproto/types"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -57,6 +59,9 @@ func TestQueryServer(t *testing.T) {
 		typ, err := qs.AccountType(ctx, &v1.AccountTypeRequest{Address: initResp.AccountAddress})
 		require.NoError(t, err)
 		require.Equal(t, "test", typ.AccountType)
+
+		_, err = qs.AccountType(ctx, &v1.AccountTypeRequest{Address: "unknown"})
+		require.Equal(t, codes.NotFound, status.Code(err))
 	})
 
 	t.Run("schema caching", func(t *testing.T) {
@@ -1178,6 +1178,9 @@ func TestCountSubkeys(t *testing.T) {
 	multiLevelMultiKey := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{
 		multiLevelSubKey1, multiLevelSubKey2, secp256k1.GenPrivKey().PubKey(),
 	})
+	weightedMultiKey := kmultisig.NewWeightedPubKey(3, []cryptotypes.PubKey{
+		multiLevelSubKey1, secp256k1.GenPrivKey().PubKey(),
+	}, []uint64{2, 1})
 	type args struct {
 		pub cryptotypes.PubKey
 	}
@@ -1189,6 +1192,7 @@ func TestCountSubkeys(t *testing.T) {
 		{"single key", args{singleKey}, 1},
 		{"single level multikey", args{singleLevelMultiKey}, 5},
 		{"multi level multikey", args{multiLevelMultiKey}, 11},
+		{"weighted multikey", args{weightedMultiKey}, 6},
 		{"nil key", args{nil}, 0},
 	}
 	for _, tc := range testCases {
@@ -17,9 +17,10 @@ type HasExtensionOptionsTx interface {
 type ExtensionOptionChecker func(*codectypes.Any) bool
 
 // rejectExtensionOption is the default extension check that reject all tx
-// extensions.
-func rejectExtensionOption(*codectypes.Any) bool {
-	return false
+// extensions but the aggregate signature one, handled by the
+// SigVerificationDecorator.
+func rejectExtensionOption(opt *codectypes.Any) bool {
+	return opt.TypeUrl == aggregateSignatureTypeURL
 }
 
 // RejectExtensionOptionsDecorator is an AnteDecorator that rejects all extension
@@ -108,7 +108,7 @@ func (dfd *DeductFeeDecorator) checkDeductFee(ctx context.Context, feeTx sdk.Fee
 	feeGranter := feeTx.FeeGranter()
 	deductFeesFrom := feePayer
 
-	// if feegranter set, deduct fee from feegranter account.
+	// if feegranter set, deduct fee from feegranter account (which may be a feegrant fee pool).
 	// this works only when feegrant is enabled.
 	if feeGranter != nil {
 		if dfd.feegrantKeeper == nil {
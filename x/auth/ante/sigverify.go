@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	secp256k1dcrd "github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -17,8 +18,8 @@ import (
 	txsigning "cosmossdk.io/x/tx/signing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
-	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -67,6 +68,10 @@ type AccountAbstractionKeeper interface {
 // to set unordered=true with a reasonable timeout_height value, in which case
 // this nonce verification and increment will be skipped.
 //
+// Signers with a bls12_381 key can aggregate their signatures into a single one
+// with the types.ExtensionOptionAggregateSignature extension option, which is
+// verified once for all of them.
+//
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigVerificationDecorator struct {
 	ak              AccountKeeper
@@ -130,6 +135,11 @@ func verifyIsOnCurve(pubKey cryptotypes.PubKey) (err error) {
 			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "secp256r1 key is not on curve")
 		}
 
+	case *bls12_381.PubKey:
+		if _, err := bls12381.NewPublicKeyFromBytes(typedPubKey.Key); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "invalid bls12_381 key: %s", err)
+		}
+
 	case multisig.PubKey:
 		pubKeysObjects := typedPubKey.GetPubKeys()
 		ok := true
@@ -193,13 +203,26 @@ func (svd SigVerificationDecorator) ValidateTx(ctx context.Context, tx transacti
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid number of pubkeys; expected %d, got %d", len(signers), len(pubKeys))
 	}
 
+	aggregate, err := getAggregateSigners(sigTx, signatures)
+	if err != nil {
+		return err
+	}
+
 	for i := range signers {
-		err = svd.authenticate(ctx, sigTx, signers[i], signatures[i], pubKeys[i], i)
+		if aggregate.contains(i) {
+			err = svd.authenticateAggregated(ctx, sigTx, signers[i], signatures[i], aggregate)
+		} else {
+			err = svd.authenticate(ctx, sigTx, signers[i], signatures[i], pubKeys[i], i)
+		}
 		if err != nil {
 			return err
 		}
 	}
 
+	if err := svd.verifyAggregateSignature(ctx, sigTx, aggregate); err != nil {
+		return err
+	}
+
 	eventMgr := svd.ak.GetEnvironment().EventService.EventManager(ctx)
 	events := [][]event.Attribute{}
 	for i, sig := range signatures {
@@ -304,27 +327,35 @@ func (svd SigVerificationDecorator) consumeSignatureGas(
 	return svd.sigGasConsumer(svd.ak.GetEnvironment().GasService.GasMeter(ctx), signature, svd.ak.GetParams(ctx))
 }
 
-// verifySig will verify the signature of the provided signer account.
-func (svd SigVerificationDecorator) verifySig(ctx context.Context, tx sdk.Tx, acc sdk.AccountI, sig signing.SignatureV2, newlyCreated bool) error {
-	execMode := svd.ak.GetEnvironment().TransactionService.ExecMode(ctx)
-	if execMode == transaction.ExecModeCheck {
-		if sig.Sequence < acc.GetSequence() {
+// checkSequence checks the sequence a signer signed with against its account.
+func (svd SigVerificationDecorator) checkSequence(ctx context.Context, acc sdk.AccountI, sequence uint64) error {
+	if svd.ak.GetEnvironment().TransactionService.ExecMode(ctx) == transaction.ExecModeCheck {
+		if sequence < acc.GetSequence() {
 			return errorsmod.Wrapf(
 				sdkerrors.ErrWrongSequence,
-				"account sequence mismatch, expected higher than or equal to %d, got %d", acc.GetSequence(), sig.Sequence,
+				"account sequence mismatch, expected higher than or equal to %d, got %d", acc.GetSequence(), sequence,
 			)
 		}
-	} else if sig.Sequence != acc.GetSequence() {
+	} else if sequence != acc.GetSequence() {
 		return errorsmod.Wrapf(
 			sdkerrors.ErrWrongSequence,
-			"account sequence mismatch: expected %d, got %d", acc.GetSequence(), sig.Sequence,
+			"account sequence mismatch: expected %d, got %d", acc.GetSequence(), sequence,
 		)
 	}
 
+	return nil
+}
+
+// verifySig will verify the signature of the provided signer account.
+func (svd SigVerificationDecorator) verifySig(ctx context.Context, tx sdk.Tx, acc sdk.AccountI, sig signing.SignatureV2, newlyCreated bool) error {
+	if err := svd.checkSequence(ctx, acc, sig.Sequence); err != nil {
+		return err
+	}
+
 	// we're in simulation mode, or in ReCheckTx, or context is not
 	// on sig verify tx, then we do not need to verify the signatures
 	// in the tx.
-	if execMode == transaction.ExecModeSimulate ||
+	if svd.ak.GetEnvironment().TransactionService.ExecMode(ctx) == transaction.ExecModeSimulate ||
 		isRecheckTx(ctx, svd.ak.GetEnvironment().TransactionService) ||
 		!isSigverifyTx(ctx) {
 		return nil
@@ -533,6 +564,9 @@ func DefaultSigVerificationGasConsumer(meter gas.Meter, sig signing.SignatureV2,
 	case *secp256r1.PubKey:
 		return meter.Consume(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 
+	case *bls12_381.PubKey:
+		return meter.Consume(params.SigVerifyCostBls12381(), "ante verify: bls12_381")
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
@@ -621,7 +655,7 @@ func CountSubKeys(pub cryptotypes.PubKey) int {
 		return 0
 	}
 
-	v, ok := pub.(*kmultisig.LegacyAminoPubKey)
+	v, ok := pub.(multisig.PubKey)
 	if !ok {
 		return 1
 	}
@@ -0,0 +1,180 @@
+package ante
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/core/transaction"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// aggregateSignatureTypeURL is the type URL of the aggregate signature extension option.
+var aggregateSignatureTypeURL = sdk.MsgTypeURL(&types.ExtensionOptionAggregateSignature{})
+
+// aggregateSigners are the signers of a tx whose signatures are aggregated into
+// a single bls12_381 signature, see types.ExtensionOptionAggregateSignature.
+type aggregateSigners struct {
+	indexes   []uint32
+	signature []byte
+
+	// pubKeys and accountNumbers are filled while authenticating the signers.
+	pubKeys        []*bls12_381.PubKey
+	accountNumbers []uint64
+}
+
+// getAggregateSigners returns the aggregated signers of a tx, or nil if the tx
+// does not aggregate signatures.
+func getAggregateSigners(tx sdk.Tx, signatures []signing.SignatureV2) (*aggregateSigners, error) {
+	extTx, ok := tx.(HasExtensionOptionsTx)
+	if !ok {
+		return nil, nil
+	}
+
+	var ext *types.ExtensionOptionAggregateSignature
+	for _, opt := range extTx.GetExtensionOptions() {
+		if opt.TypeUrl != aggregateSignatureTypeURL {
+			continue
+		}
+		if ext != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "duplicate aggregate signature extension option")
+		}
+
+		ext = &types.ExtensionOptionAggregateSignature{}
+		if err := ext.Unmarshal(opt.Value); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrTxDecode, "invalid aggregate signature extension option: %s", err)
+		}
+	}
+	if ext == nil {
+		return nil, nil
+	}
+
+	if len(ext.SignerIndexes) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "aggregate signature without signers")
+	}
+
+	agg := &aggregateSigners{indexes: ext.SignerIndexes}
+	for i, index := range ext.SignerIndexes {
+		if int(index) >= len(signatures) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "aggregated signer index %d out of range, the tx has %d signers", index, len(signatures))
+		}
+		if i > 0 && index <= ext.SignerIndexes[i-1] {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "aggregated signer indexes must be strictly increasing")
+		}
+
+		data, ok := signatures[index].Data.(*signing.SingleSignatureData)
+		if !ok || data.SignMode != signing.SignMode_SIGN_MODE_DIRECT {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "aggregated signer %d must use a single %s mode info", index, signing.SignMode_SIGN_MODE_DIRECT)
+		}
+
+		// the aggregate signature is carried by the first aggregated signer
+		if i == 0 {
+			agg.signature = data.Signature
+		} else if len(data.Signature) != 0 {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "aggregated signer %d must have an empty signature", index)
+		}
+	}
+
+	return agg, nil
+}
+
+// contains returns whether the signature of the signer at index i is aggregated.
+func (agg *aggregateSigners) contains(i int) bool {
+	return agg != nil && slices.Contains(agg.indexes, uint32(i))
+}
+
+// authenticateAggregated authenticates a signer whose signature is aggregated.
+// Its account must exist and have a bls12_381 public key set, as aggregating
+// keys over a common sign doc requires their possession to be proven by an
+// individual signature first. The aggregate signature itself is verified once
+// all the signers are authenticated, by verifyAggregateSignature.
+func (svd SigVerificationDecorator) authenticateAggregated(ctx context.Context, tx authsigning.Tx, signer []byte, sig signing.SignatureV2, agg *aggregateSigners) error {
+	signerStr, err := svd.ak.AddressCodec().BytesToString(signer)
+	if err != nil {
+		return err
+	}
+
+	if svd.aaKeeper != nil {
+		isAa, err := svd.aaKeeper.IsAbstractedAccount(ctx, signer)
+		if err != nil {
+			return err
+		}
+		if isAa {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "abstracted account %s cannot aggregate its signature", signerStr)
+		}
+	}
+
+	acc := GetSignerAcc(ctx, svd.ak, signer)
+	if acc == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "aggregated signer %s does not exist", signerStr)
+	}
+
+	pubKey, ok := acc.GetPubKey().(*bls12_381.PubKey)
+	if !ok && svd.ak.GetEnvironment().TransactionService.ExecMode(ctx) != transaction.ExecModeSimulate {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "aggregated signer %s must have a bls12_381 public key set by an individually signed tx", signerStr)
+	}
+
+	if err := svd.checkSequence(ctx, acc, sig.Sequence); err != nil {
+		return err
+	}
+
+	// at genesis the sign doc has no account numbers, as for individual signatures
+	var accNum uint64
+	if svd.ak.GetEnvironment().HeaderService.HeaderInfo(ctx).Height != 0 {
+		accNum = acc.GetAccountNumber()
+	}
+
+	agg.pubKeys = append(agg.pubKeys, pubKey)
+	agg.accountNumbers = append(agg.accountNumbers, accNum)
+
+	if err := svd.increaseSequence(tx, acc); err != nil {
+		return err
+	}
+	svd.ak.SetAccount(ctx, acc)
+	return nil
+}
+
+// verifyAggregateSignature consumes gas for and verifies the aggregate
+// signature of the authenticated aggregated signers.
+func (svd SigVerificationDecorator) verifyAggregateSignature(ctx context.Context, tx sdk.Tx, agg *aggregateSigners) error {
+	if agg == nil {
+		return nil
+	}
+
+	env := svd.ak.GetEnvironment()
+	params := svd.ak.GetParams(ctx)
+	if err := env.GasService.GasMeter(ctx).Consume(params.SigVerifyCostBls12381Aggregate(uint64(len(agg.indexes))), "ante verify: bls12_381 aggregate"); err != nil {
+		return err
+	}
+
+	if env.TransactionService.ExecMode(ctx) == transaction.ExecModeSimulate ||
+		isRecheckTx(ctx, env.TransactionService) ||
+		!isSigverifyTx(ctx) {
+		return nil
+	}
+
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+	}
+	txData := adaptableTx.GetSigningTxData()
+
+	chainID := env.HeaderService.HeaderInfo(ctx).ChainID
+	signBytes, err := types.AggregateSignBytes(chainID, txData.BodyBytes, txData.AuthInfoBytes, agg.accountNumbers)
+	if err != nil {
+		return err
+	}
+
+	if !bls12_381.VerifyAggregateSignature(agg.pubKeys, signBytes, agg.signature) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "aggregate signature verification failed; please verify account numbers (%v) and chain-id (%s)", agg.accountNumbers, chainID)
+	}
+
+	return nil
+}
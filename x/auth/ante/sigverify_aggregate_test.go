@@ -0,0 +1,257 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// createExtTestTx creates a tx with a test msg per signer, signed individually
+// by privs and aggregating the signatures at the given indexes.
+func (suite *AnteTestSuite) createExtTestTx(t *testing.T, ctx sdk.Context, privs []cryptotypes.PrivKey, accNums, accSeqs []uint64, indexes []uint32) authsign.Tx {
+	t.Helper()
+
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	msgs := make([]sdk.Msg, len(privs))
+	for i, priv := range privs {
+		msgs[i] = testdata.NewTestMsg(sdk.AccAddress(priv.PubKey().Address()))
+	}
+	require.NoError(t, suite.txBuilder.SetMsgs(msgs...))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	ext, err := codectypes.NewAnyWithValue(&types.ExtensionOptionAggregateSignature{SignerIndexes: indexes})
+	require.NoError(t, err)
+	suite.txBuilder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(ext)
+
+	theTx, err := suite.CreateTestTx(ctx, privs, accNums, accSeqs, ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	return theTx
+}
+
+// createAggregateTestTx creates a tx with a test msg per signer, whose signers
+// at the given indexes aggregate their signatures, the others signing
+// individually.
+func (suite *AnteTestSuite) createAggregateTestTx(t *testing.T, ctx sdk.Context, privs []cryptotypes.PrivKey, accNums, accSeqs []uint64, indexes []uint32) authsign.Tx {
+	t.Helper()
+
+	theTx := suite.createExtTestTx(t, ctx, privs, accNums, accSeqs, indexes)
+
+	txData := theTx.(authsign.V2AdaptableTx).GetSigningTxData()
+	var aggAccNums []uint64
+	for _, index := range indexes {
+		aggAccNums = append(aggAccNums, accNums[index])
+	}
+	signBytes, err := types.AggregateSignBytes(ctx.ChainID(), txData.BodyBytes, txData.AuthInfoBytes, aggAccNums)
+	require.NoError(t, err)
+
+	var aggSigs [][]byte
+	for _, index := range indexes {
+		sig, err := privs[index].Sign(signBytes)
+		require.NoError(t, err)
+		aggSigs = append(aggSigs, sig)
+	}
+	aggSig, err := bls12_381.AggregateSignatures(aggSigs)
+	require.NoError(t, err)
+
+	sigs, err := theTx.GetSignaturesV2()
+	require.NoError(t, err)
+	for i, index := range indexes {
+		data := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT}
+		if i == 0 {
+			data.Signature = aggSig
+		}
+		sigs[index].Data = data
+	}
+	require.NoError(t, suite.txBuilder.SetSignatures(sigs...))
+
+	return suite.txBuilder.GetTx()
+}
+
+func TestSigVerificationAggregateSignature(t *testing.T) {
+	if !bls12381.Enabled {
+		t.Skip("bls12_381 keys require the bls12381 build flag")
+	}
+
+	suite := SetupTestSuite(t, false)
+	params := types.DefaultParams()
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil)
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	// bls12_381 accounts, the three first having their public key set by an
+	// individually signed tx
+	privs := make([]cryptotypes.PrivKey, 4)
+	accNums := make([]uint64, 4)
+	for i := range privs {
+		priv, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		privs[i] = &priv
+
+		acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, sdk.AccAddress(priv.PubKey().Address()))
+		require.NoError(t, acc.SetAccountNumber(uint64(i)+1000))
+		suite.accountKeeper.SetAccount(suite.ctx, acc)
+		accNums[i] = acc.GetAccountNumber()
+
+		if i == 3 {
+			break
+		}
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(acc.GetAddress())))
+		theTx, err := suite.CreateTestTx(suite.ctx, privs[i:i+1], accNums[i:i+1], []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		_, err = antehandler(suite.ctx, theTx, false)
+		require.NoError(t, err)
+	}
+
+	privSecp, _, addrSecp := testdata.KeyTestPubAddr()
+	accSecp := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addrSecp)
+	suite.accountKeeper.SetAccount(suite.ctx, accSecp)
+
+	testCases := []struct {
+		name    string
+		privs   []cryptotypes.PrivKey
+		accNums []uint64
+		accSeqs []uint64
+		indexes []uint32
+		expGas  uint64
+		expErr  error
+	}{
+		{
+			name:    "valid aggregate signature",
+			privs:   privs[:3],
+			accNums: accNums[:3],
+			accSeqs: []uint64{1, 1, 1},
+			indexes: []uint32{0, 1, 2},
+			expGas:  params.SigVerifyCostBls12381Aggregate(3),
+		},
+		{
+			name:    "aggregate and individual signatures",
+			privs:   []cryptotypes.PrivKey{privs[0], privSecp, privs[1]},
+			accNums: []uint64{accNums[0], accSecp.GetAccountNumber(), accNums[1]},
+			accSeqs: []uint64{1, 0, 1},
+			indexes: []uint32{0, 2},
+			expGas:  params.SigVerifyCostBls12381Aggregate(2) + params.SigVerifyCostSecp256k1,
+		},
+		{
+			name:    "wrong account numbers",
+			privs:   privs[:3],
+			accNums: []uint64{7, 8, 9},
+			accSeqs: []uint64{1, 1, 1},
+			indexes: []uint32{0, 1, 2},
+			expErr:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:    "wrong sequences",
+			privs:   privs[:3],
+			accNums: accNums[:3],
+			accSeqs: []uint64{1, 0, 1},
+			indexes: []uint32{0, 1, 2},
+			expErr:  sdkerrors.ErrWrongSequence,
+		},
+		{
+			name:    "signer without public key",
+			privs:   []cryptotypes.PrivKey{privs[0], privs[3]},
+			accNums: []uint64{accNums[0], accNums[3]},
+			accSeqs: []uint64{1, 0},
+			indexes: []uint32{0, 1},
+			expErr:  sdkerrors.ErrInvalidPubKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := suite.ctx.CacheContext()
+			// only meter the signature verifications
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithKVGasConfig(storetypes.GasConfig{})
+
+			theTx := suite.createAggregateTestTx(t, ctx, tc.privs, tc.accNums, tc.accSeqs, tc.indexes)
+			_, err := antehandler(ctx, theTx, false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expGas, ctx.GasMeter().GasConsumed())
+			for i, priv := range tc.privs {
+				acc := suite.accountKeeper.GetAccount(ctx, sdk.AccAddress(priv.PubKey().Address()))
+				require.Equal(t, tc.accSeqs[i]+1, acc.GetSequence())
+			}
+		})
+	}
+
+	t.Run("signature of a subset of the signers", func(t *testing.T) {
+		ctx, _ := suite.ctx.CacheContext()
+
+		theTx := suite.createAggregateTestTx(t, ctx, privs[:3], accNums[:3], []uint64{1, 1, 1}, []uint32{0, 1, 2})
+		sigs, err := theTx.GetSignaturesV2()
+		require.NoError(t, err)
+
+		txData := theTx.(authsign.V2AdaptableTx).GetSigningTxData()
+		signBytes, err := types.AggregateSignBytes(ctx.ChainID(), txData.BodyBytes, txData.AuthInfoBytes, accNums[:3])
+		require.NoError(t, err)
+		var subset [][]byte
+		for _, priv := range privs[:2] {
+			sig, err := priv.Sign(signBytes)
+			require.NoError(t, err)
+			subset = append(subset, sig)
+		}
+		aggSig, err := bls12_381.AggregateSignatures(subset)
+		require.NoError(t, err)
+
+		sigs[0].Data = &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: aggSig}
+		require.NoError(t, suite.txBuilder.SetSignatures(sigs...))
+		_, err = antehandler(ctx, suite.txBuilder.GetTx(), false)
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+}
+
+func TestSigVerificationAggregateSignatureExtension(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil)
+	antehandler := sdk.ChainAnteDecorators(ante.NewExtensionOptionsDecorator(nil), svd)
+
+	accs := suite.CreateTestAccounts(2)
+	privs := []cryptotypes.PrivKey{accs[0].priv, accs[1].priv}
+	accNums := []uint64{accs[0].acc.GetAccountNumber(), accs[1].acc.GetAccountNumber()}
+
+	testCases := []struct {
+		name    string
+		indexes []uint32
+		expErr  string
+	}{
+		{"no signers", []uint32{}, "aggregate signature without signers"},
+		{"index out of range", []uint32{0, 2}, "aggregated signer index 2 out of range"},
+		{"indexes not increasing", []uint32{1, 0}, "aggregated signer indexes must be strictly increasing"},
+		{"aggregated signature not empty", []uint32{0, 1}, "aggregated signer 1 must have an empty signature"},
+		{"not a bls12_381 key", []uint32{0}, "must have a bls12_381 public key"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := suite.ctx.CacheContext()
+
+			// the extension option is accepted by default, the signatures are
+			// then rejected by the sig verification
+			theTx := suite.createExtTestTx(t, ctx, privs, accNums, []uint64{0, 0}, tc.indexes)
+			_, err := antehandler(ctx, theTx, false)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
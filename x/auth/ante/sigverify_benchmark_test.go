@@ -2,15 +2,19 @@ package ante_test
 
 import (
 	crand "crypto/rand"
+	"fmt"
 	"testing"
 
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 )
 
-// This benchmark is used to asses the ante.Secp256k1ToR1GasFactor value
+// This benchmark is used to asses the ante.Secp256k1ToR1GasFactor value and the
+// bls12_381 signature verification costs, run with the bls12381 build flag.
 func BenchmarkSig(b *testing.B) {
 	require := require.New(b)
 	msg := cRandBytes(1000)
@@ -41,6 +45,44 @@ func BenchmarkSig(b *testing.B) {
 			require.True(ok)
 		}
 	})
+
+	if !bls12381.Enabled {
+		return
+	}
+
+	var (
+		pksB  []*bls12_381.PubKey
+		sigsB [][]byte
+	)
+	for i := 0; i < 16; i++ {
+		skB, err := bls12_381.GenPrivKey()
+		require.NoError(err)
+		sigB, err := skB.Sign(msg)
+		require.NoError(err)
+		pksB = append(pksB, skB.PubKey().(*bls12_381.PubKey))
+		sigsB = append(sigsB, sigB)
+	}
+
+	b.Run("bls12_381", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ok := pksB[0].VerifySignature(msg, sigsB[0])
+			require.True(ok)
+		}
+	})
+
+	for _, n := range []int{1, 4, 16} {
+		aggSig, err := bls12_381.AggregateSignatures(sigsB[:n])
+		require.NoError(err)
+
+		b.Run(fmt.Sprintf("bls12_381 aggregate/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				ok := bls12_381.VerifyAggregateSignature(pksB[:n], msg, aggSig)
+				require.True(ok)
+			}
+		})
+	}
 }
 
 // randBytes generates a random byte slice of the specified length.
@@ -1,11 +1,15 @@
 package ante_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	"cosmossdk.io/core/gas"
@@ -13,8 +17,12 @@ import (
 	gastestutil "cosmossdk.io/core/testing/gas"
 	storetypes "cosmossdk.io/store/types"
 	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/textual"
 
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -90,6 +98,13 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 			}},
 			false,
 		},
+		{
+			"PubKeyBls12381",
+			args{nil, &bls12_381.PubKey{Key: make([]byte, bls12381.PubKeySize)}, params, func(mm *gastestutil.MockMeter) {
+				mm.EXPECT().Consume(p.SigVerifyCostBls12381(), "ante verify: bls12_381").Times(1)
+			}},
+			false,
+		},
 		{
 			"Multisig",
 			args{multisignature1, multisigKey1, params, func(mm *gastestutil.MockMeter) {
@@ -280,6 +295,150 @@ func TestSigVerification(t *testing.T) {
 			})
 		}
 	}
+
+	// weighted multisig nesting a legacy multisig, signed by members reaching
+	// the threshold weight
+	priv4, _, _ := testdata.KeyTestPubAddr()
+	priv5, _, _ := testdata.KeyTestPubAddr()
+	nestedPk := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{priv3.PubKey(), priv4.PubKey()})
+	multisigPk := kmultisig.NewWeightedPubKey(3, []cryptotypes.PubKey{priv1.PubKey(), nestedPk, priv5.PubKey()}, []uint64{2, 1, 1})
+	multisigAcc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, sdk.AccAddress(multisigPk.Address()))
+	suite.accountKeeper.SetAccount(suite.ctx, multisigAcc)
+
+	for _, signMode := range enabledSignModes {
+		t.Run(fmt.Sprintf("weighted multisig with %s", signMode), func(t *testing.T) {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithExecMode(sdk.ExecModeFinalize)
+
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(multisigAcc.GetAddress())))
+			suite.txBuilder.SetFeeAmount(feeAmount)
+			suite.txBuilder.SetGasLimit(gasLimit)
+
+			// priv1 and priv4, through the nested multisig, sign. The signer
+			// info is set with empty signatures first, as for CreateTestTx.
+			nestedSig := multisig.NewMultisig(2)
+			multisig.AddSignature(nestedSig, &signing.SingleSignatureData{SignMode: signMode}, 1)
+			sig := multisig.NewMultisig(3)
+			multisig.AddSignature(sig, &signing.SingleSignatureData{SignMode: signMode}, 0)
+			multisig.AddSignature(sig, nestedSig, 1)
+			sigV2 := signing.SignatureV2{PubKey: multisigPk, Data: sig}
+			require.NoError(t, suite.txBuilder.SetSignatures(sigV2))
+
+			signerData := authsign.SignerData{
+				Address:       multisigAcc.GetAddress().String(),
+				ChainID:       ctx.ChainID(),
+				AccountNumber: multisigAcc.GetAccountNumber(),
+				PubKey:        multisigPk,
+			}
+			sig1, err := clienttx.SignWithPrivKey(ctx, signMode, signerData, suite.txBuilder, priv1, suite.clientCtx.TxConfig, 0)
+			require.NoError(t, err)
+			sig4, err := clienttx.SignWithPrivKey(ctx, signMode, signerData, suite.txBuilder, priv4, suite.clientCtx.TxConfig, 0)
+			require.NoError(t, err)
+			multisig.AddSignature(sig, sig1.Data, 0)
+			multisig.AddSignature(nestedSig, sig4.Data, 1)
+			require.NoError(t, suite.txBuilder.SetSignatures(sigV2))
+
+			_, err = antehandler(ctx, suite.txBuilder.GetTx(), false)
+			require.NoError(t, err)
+			acc := suite.accountKeeper.GetAccount(ctx, multisigAcc.GetAddress())
+			require.True(t, multisigPk.Equals(acc.GetPubKey()))
+			require.Equal(t, uint64(1), acc.GetSequence())
+		})
+	}
+}
+
+func TestWeightedMultisigTextual(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.txBankKeeper.EXPECT().DenomMetadataV2(gomock.Any(), gomock.Any()).Return(&bankv1beta1.QueryDenomMetadataResponse{}, nil).AnyTimes()
+	suite.ctx = suite.ctx.WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1, ChainID: suite.ctx.ChainID()})
+
+	cdc := codec.NewProtoCodec(suite.encCfg.InterfaceRegistry)
+	txConfig, err := authtx.NewTxConfigWithOptions(cdc, authtx.ConfigOptions{
+		TextualCoinMetadataQueryFn: txmodule.NewBankKeeperCoinMetadataQueryFn(suite.txBankKeeper),
+		EnabledSignModes:           []signing.SignMode{signing.SignMode_SIGN_MODE_TEXTUAL},
+		SigningOptions: &txsigning.Options{
+			AddressCodec:          cdc.InterfaceRegistry().SigningContext().AddressCodec(),
+			ValidatorAddressCodec: cdc.InterfaceRegistry().SigningContext().ValidatorAddressCodec(),
+		},
+	})
+	require.NoError(t, err)
+	noOpGasConsume := func(_ gas.Meter, _ signing.SignatureV2, _ types.Params) error { return nil }
+	antehandler := sdk.ChainAnteDecorators(ante.NewSigVerificationDecorator(suite.accountKeeper, txConfig.SignModeHandler(), noOpGasConsume, nil))
+
+	// a weighted multisig nesting a legacy multisig, with deterministic keys
+	// for the rendered screens to be stable
+	priv1 := secp256k1.GenPrivKeyFromSecret([]byte("member 1"))
+	priv2 := secp256k1.GenPrivKeyFromSecret([]byte("member 2"))
+	priv3 := secp256k1.GenPrivKeyFromSecret([]byte("member 3"))
+	nestedPk := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{priv2.PubKey(), priv3.PubKey()})
+	multisigPk := kmultisig.NewWeightedPubKey(3, []cryptotypes.PubKey{priv1.PubKey(), nestedPk}, []uint64{2, 1})
+	multisigAcc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, sdk.AccAddress(multisigPk.Address()))
+	suite.accountKeeper.SetAccount(suite.ctx, multisigAcc)
+
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(multisigAcc.GetAddress())))
+	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	// priv1 and priv3, through the nested multisig, sign
+	nestedSig := multisig.NewMultisig(2)
+	multisig.AddSignature(nestedSig, &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_TEXTUAL}, 1)
+	sig := multisig.NewMultisig(2)
+	multisig.AddSignature(sig, &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_TEXTUAL}, 0)
+	multisig.AddSignature(sig, nestedSig, 1)
+	sigV2 := signing.SignatureV2{PubKey: multisigPk, Data: sig}
+	require.NoError(t, txBuilder.SetSignatures(sigV2))
+
+	signerData := authsign.SignerData{
+		Address:       multisigAcc.GetAddress().String(),
+		ChainID:       suite.ctx.ChainID(),
+		AccountNumber: multisigAcc.GetAccountNumber(),
+		PubKey:        multisigPk,
+	}
+	sig1, err := clienttx.SignWithPrivKey(suite.ctx, signing.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder, priv1, txConfig, 0)
+	require.NoError(t, err)
+	sig3, err := clienttx.SignWithPrivKey(suite.ctx, signing.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder, priv3, txConfig, 0)
+	require.NoError(t, err)
+	multisig.AddSignature(sig, sig1.Data, 0)
+	multisig.AddSignature(nestedSig, sig3.Data, 1)
+	require.NoError(t, txBuilder.SetSignatures(sigV2))
+
+	_, err = antehandler(suite.ctx, txBuilder.GetTx(), false)
+	require.NoError(t, err)
+
+	// the public key is rendered, on the expert screens of the signer, with
+	// the members and their weights
+	handler, err := textual.NewSignModeHandler(textual.SignModeOptions{
+		CoinMetadataQuerier: func(context.Context, string) (*bankv1beta1.Metadata, error) { return nil, nil },
+		FileResolver:        cdc.InterfaceRegistry(),
+	})
+	require.NoError(t, err)
+	anyPk, err := codectypes.NewAnyWithValue(multisigPk)
+	require.NoError(t, err)
+	pkMsg := &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value}
+	screens, err := textual.NewAnyValueRenderer(handler).Format(suite.ctx, protoreflect.ValueOfMessage(pkMsg.ProtoReflect()))
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{
+		{Content: "/cosmos.crypto.multisig.WeightedPubKey"},
+		{Title: "Threshold", Content: "3", Indent: 1},
+		{Title: "Members", Content: "2 WeightedMember", Indent: 1},
+		{Title: "Members (1/2)", Content: "WeightedMember object", Indent: 2},
+		{Title: "Pub key", Content: "/cosmos.crypto.secp256k1.PubKey", Indent: 3},
+		{Title: "Key", Content: "0250 25D4 8AAA 2322 AE6F 167A 9CD1 CA16 8614 5A0C CA4C 4BF7 1544 5871 899C 8645 05", Indent: 4},
+		{Title: "Weight", Content: "2", Indent: 3},
+		{Title: "Members (2/2)", Content: "WeightedMember object", Indent: 2},
+		{Title: "Pub key", Content: "/cosmos.crypto.multisig.LegacyAminoPubKey", Indent: 3},
+		{Title: "Threshold", Content: "1", Indent: 4},
+		{Title: "Public keys", Content: "2 Any", Indent: 4},
+		{Title: "Public keys (1/2)", Content: "/cosmos.crypto.secp256k1.PubKey", Indent: 5},
+		{Title: "Key", Content: "02E4 48AD C748 1CB4 DFC4 196A A2AE ED54 C3D5 CF23 131A 97C6 FB66 5147 EE47 B16F B8", Indent: 6},
+		{Title: "Public keys (2/2)", Content: "/cosmos.crypto.secp256k1.PubKey", Indent: 5},
+		{Title: "Key", Content: "028F 3E32 680E D19A F45F F6C1 C4E4 9E77 DD44 6115 35E5 FC98 DCCA CF90 9ECF 5862 09", Indent: 6},
+		{Content: "End of Public keys", Indent: 4},
+		{Title: "Weight", Content: "1", Indent: 3},
+		{Content: "End of Members", Indent: 1},
+	}, screens)
 }
 
 func TestSigIntegration(t *testing.T) {
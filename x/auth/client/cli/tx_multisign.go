@@ -17,7 +17,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
-	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -119,8 +118,11 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 		// the multisig key (useful for nested multisigs).
 		skipSigVerify, _ := cmd.Flags().GetBool(flagSkipSignatureVerification)
 
-		multisigPub := pubKey.(*kmultisig.LegacyAminoPubKey)
-		multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+		multisigPub, ok := pubKey.(multisig.PubKey)
+		if !ok {
+			return fmt.Errorf("%s is not a multisig key", name)
+		}
+		multisigSig := multisig.NewMultisig(len(multisigPub.GetPubKeys()))
 		if !clientCtx.Offline {
 			accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
 			if err != nil {
@@ -319,8 +321,11 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 			if err != nil {
 				return err
 			}
-			multisigPub := pubKey.(*kmultisig.LegacyAminoPubKey)
-			multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+			multisigPub, ok := pubKey.(multisig.PubKey)
+			if !ok {
+				return fmt.Errorf("%s is not a multisig key", name)
+			}
+			multisigSig := multisig.NewMultisig(len(multisigPub.GetPubKeys()))
 
 			anyPk, err := codectypes.NewAnyWithValue(multisigPub)
 			if err != nil {
@@ -0,0 +1,14 @@
+package types
+
+// AggregateSignBytes returns the bytes the aggregated signers of a tx sign, the
+// account numbers being in the order of the aggregated signers.
+func AggregateSignBytes(chainID string, bodyBytes, authInfoBytes []byte, accountNumbers []uint64) ([]byte, error) {
+	doc := AggregateSignDoc{
+		BodyBytes:      bodyBytes,
+		AuthInfoBytes:  authInfoBytes,
+		ChainId:        chainID,
+		AccountNumbers: accountNumbers,
+	}
+
+	return doc.Marshal()
+}
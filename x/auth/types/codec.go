@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
 
@@ -61,4 +62,8 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgNonAtomicExec{},
 		&MsgMigrateAccount{},
 	)
+
+	registrar.RegisterImplementations((*tx.TxExtensionOptionI)(nil),
+		&ExtensionOptionAggregateSignature{},
+	)
 }
@@ -47,6 +47,28 @@ func (p Params) SigVerifyCostSecp256r1() uint64 {
 	return p.SigVerifyCostSecp256k1 / 2
 }
 
+// SigVerifyCostBls12381 returns gas fee of bls12_381 signature verification.
+// Set by benchmarking current implementation:
+//
+//	BenchmarkSig/secp256k1                   5796    204349 ns/op    744 B/op   15 allocs/op
+//	BenchmarkSig/bls12_381                    643   1870365 ns/op   4841 B/op   15 allocs/op
+//	BenchmarkSig/bls12_381_aggregate/1        585   1859704 ns/op   5416 B/op   23 allocs/op
+//	BenchmarkSig/bls12_381_aggregate/4        570   2459800 ns/op   5728 B/op   26 allocs/op
+//	BenchmarkSig/bls12_381_aggregate/16       382   3152904 ns/op   6976 B/op   38 allocs/op
+//
+// Based on the results above a bls12_381 verification is 9x slower than a secp256k1 one.
+func (p Params) SigVerifyCostBls12381() uint64 {
+	return p.SigVerifyCostSecp256k1 * 9
+}
+
+// SigVerifyCostBls12381Aggregate returns gas fee of the verification of a
+// bls12_381 signature aggregating the signatures of n keys over a common
+// message. Based on the benchmarks of SigVerifyCostBls12381, it costs a single
+// verification plus half a secp256k1 verification per key.
+func (p Params) SigVerifyCostBls12381Aggregate(n uint64) uint64 {
+	return p.SigVerifyCostBls12381() + n*(p.SigVerifyCostSecp256k1/2)
+}
+
 func validateTxSigLimit(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -6,6 +6,8 @@ package authz
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +32,54 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FilterOperator enumerates the comparisons available to a FieldFilter.
+type FilterOperator int32
+
+const (
+	// FILTER_OPERATOR_UNSPECIFIED defines an invalid operator.
+	FilterOperator_FILTER_OPERATOR_UNSPECIFIED FilterOperator = 0
+	// FILTER_OPERATOR_EQUAL requires the value to be equal to the single operand.
+	FilterOperator_FILTER_OPERATOR_EQUAL FilterOperator = 1
+	// FILTER_OPERATOR_NOT_EQUAL requires the value to differ from the single operand.
+	FilterOperator_FILTER_OPERATOR_NOT_EQUAL FilterOperator = 2
+	// FILTER_OPERATOR_IN requires the value to be one of the operands.
+	FilterOperator_FILTER_OPERATOR_IN FilterOperator = 3
+	// FILTER_OPERATOR_NOT_IN requires the value to be none of the operands.
+	FilterOperator_FILTER_OPERATOR_NOT_IN FilterOperator = 4
+	// FILTER_OPERATOR_LTE requires the integer value to be lower than or equal to the single operand.
+	FilterOperator_FILTER_OPERATOR_LTE FilterOperator = 5
+	// FILTER_OPERATOR_GTE requires the integer value to be greater than or equal to the single operand.
+	FilterOperator_FILTER_OPERATOR_GTE FilterOperator = 6
+)
+
+var FilterOperator_name = map[int32]string{
+	0: "FILTER_OPERATOR_UNSPECIFIED",
+	1: "FILTER_OPERATOR_EQUAL",
+	2: "FILTER_OPERATOR_NOT_EQUAL",
+	3: "FILTER_OPERATOR_IN",
+	4: "FILTER_OPERATOR_NOT_IN",
+	5: "FILTER_OPERATOR_LTE",
+	6: "FILTER_OPERATOR_GTE",
+}
+
+var FilterOperator_value = map[string]int32{
+	"FILTER_OPERATOR_UNSPECIFIED": 0,
+	"FILTER_OPERATOR_EQUAL":       1,
+	"FILTER_OPERATOR_NOT_EQUAL":   2,
+	"FILTER_OPERATOR_IN":          3,
+	"FILTER_OPERATOR_NOT_IN":      4,
+	"FILTER_OPERATOR_LTE":         5,
+	"FILTER_OPERATOR_GTE":         6,
+}
+
+func (x FilterOperator) String() string {
+	return proto.EnumName(FilterOperator_name, int32(x))
+}
+
+func (FilterOperator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{0}
+}
+
 // GenericAuthorization gives the grantee unrestricted permissions to execute
 // the provided method on behalf of the granter's account.
 type GenericAuthorization struct {
@@ -70,6 +120,108 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// FieldFilterAuthorization gives the grantee permissions to execute the provided
+// method on behalf of the granter's account, as long as every filter holds on the
+// fields of the executed message. Usage and cumulative spending can be capped.
+type FieldFilterAuthorization struct {
+	// msg, identified by its type URL, is the message the grantee may execute.
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// filters are predicates on the message fields. All of them must hold for
+	// the message to be accepted.
+	Filters []FieldFilter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters"`
+	// max_uses is the number of times the authorization can be used.
+	// Zero means the number of uses is not limited.
+	MaxUses uint64 `protobuf:"varint,3,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	// uses is the number of times the authorization has been used.
+	Uses uint64 `protobuf:"varint,4,opt,name=uses,proto3" json:"uses,omitempty"`
+	// spend_paths are the paths of the message fields holding the coins spent by
+	// the message. Each path must resolve to cosmos.base.v1beta1.Coin values.
+	SpendPaths []string `protobuf:"bytes,5,rep,name=spend_paths,json=spendPaths,proto3" json:"spend_paths,omitempty"`
+	// spend_limit is the maximum amount of coins that can be spent cumulatively
+	// through spend_paths. It must be set if and only if spend_paths is set.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// spent is the amount of coins spent so far through this authorization.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *FieldFilterAuthorization) Reset()         { *m = FieldFilterAuthorization{} }
+func (m *FieldFilterAuthorization) String() string { return proto.CompactTextString(m) }
+func (*FieldFilterAuthorization) ProtoMessage()    {}
+func (*FieldFilterAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *FieldFilterAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldFilterAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldFilterAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldFilterAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldFilterAuthorization.Merge(m, src)
+}
+func (m *FieldFilterAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldFilterAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldFilterAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldFilterAuthorization proto.InternalMessageInfo
+
+// FieldFilter is a predicate on the values found at a path of a message.
+type FieldFilter struct {
+	// path is the JSONPath-like location of the field, made of proto field names
+	// separated by dots, e.g. "amount.denom" or "$.inputs[*].address".
+	// Repeated fields are traversed element by element.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// operator is the comparison applied to every value found at path.
+	Operator FilterOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=cosmos.authz.v1beta1.FilterOperator" json:"operator,omitempty"`
+	// values are the operands of the comparison, in their textual form.
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *FieldFilter) Reset()         { *m = FieldFilter{} }
+func (m *FieldFilter) String() string { return proto.CompactTextString(m) }
+func (*FieldFilter) ProtoMessage()    {}
+func (*FieldFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *FieldFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldFilter.Merge(m, src)
+}
+func (m *FieldFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldFilter proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
@@ -84,7 +236,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{3}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,7 +278,7 @@ func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{4}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +317,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{5}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,7 +347,10 @@ func (m *GrantQueueItem) XXX_DiscardUnknown() {
 var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("cosmos.authz.v1beta1.FilterOperator", FilterOperator_name, FilterOperator_value)
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*FieldFilterAuthorization)(nil), "cosmos.authz.v1beta1.FieldFilterAuthorization")
+	proto.RegisterType((*FieldFilter)(nil), "cosmos.authz.v1beta1.FieldFilter")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
@@ -204,35 +359,57 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0x75, 0xfc, 0x99, 0xa7, 0x21, 0x88, 0x72, 0x28, 0x3d, 0x24, 0x55, 0x0e, 0x68,
-	0x42, 0x6a, 0xac, 0x15, 0x4e, 0x9c, 0x68, 0x84, 0x34, 0xc1, 0x8d, 0x30, 0x2e, 0x5c, 0x2a, 0x67,
-	0x31, 0x9e, 0xb5, 0x3a, 0x8e, 0x6c, 0x07, 0x2d, 0xfb, 0x08, 0x9c, 0xf6, 0x19, 0xf8, 0x04, 0x20,
-	0xed, 0x43, 0x54, 0x9c, 0x2a, 0x4e, 0x9c, 0xf8, 0xd3, 0x1e, 0xf8, 0x1a, 0xa8, 0x76, 0x22, 0x1a,
-	0x5a, 0x89, 0x1e, 0xb8, 0x44, 0x7e, 0xfd, 0x3e, 0xcf, 0xfb, 0x3e, 0xf9, 0x45, 0x81, 0xfd, 0x53,
-	0xa1, 0xb8, 0x50, 0x08, 0x97, 0xfa, 0xec, 0x12, 0xbd, 0x3b, 0x4a, 0x89, 0xc6, 0x47, 0xb6, 0x8a,
-	0x0a, 0x29, 0xb4, 0x70, 0x3d, 0xab, 0x88, 0xec, 0x5d, 0xad, 0xe8, 0xdd, 0xc3, 0x9c, 0xe5, 0x02,
-	0x99, 0xa7, 0x15, 0xf6, 0xee, 0x5b, 0xe1, 0xd8, 0x54, 0xa8, 0x76, 0xd9, 0x56, 0x40, 0x85, 0xa0,
-	0x13, 0x82, 0x4c, 0x95, 0x96, 0x6f, 0x91, 0x66, 0x9c, 0x28, 0x8d, 0x79, 0x51, 0x0b, 0x3c, 0x2a,
-	0xa8, 0xb0, 0xc6, 0xe5, 0xa9, 0x99, 0xf8, 0xb7, 0x0d, 0xe7, 0x95, 0x6d, 0x85, 0x1a, 0x7a, 0xc7,
-	0x24, 0x27, 0x92, 0x9d, 0x8e, 0x4a, 0x7d, 0x26, 0x24, 0xbb, 0xc4, 0x9a, 0x89, 0xdc, 0xbd, 0x0b,
-	0x3b, 0x5c, 0xd1, 0x2e, 0xe8, 0x83, 0xc3, 0xbd, 0x64, 0x79, 0x7c, 0xf2, 0xe2, 0xf3, 0xf5, 0x20,
-	0xdc, 0xf4, 0x0e, 0x51, 0xcb, 0xf9, 0xfe, 0xd7, 0xc7, 0x87, 0x81, 0x95, 0x0d, 0x54, 0x76, 0x8e,
-	0x36, 0x4d, 0x0f, 0x3f, 0x01, 0x78, 0xe3, 0x58, 0xe2, 0x5c, 0xbb, 0x29, 0x3c, 0xc0, 0xab, 0x2d,
-	0xb3, 0x71, 0x7f, 0xe8, 0x45, 0x36, 0x72, 0xd4, 0x44, 0x8e, 0x46, 0x79, 0x15, 0x3f, 0xd8, 0x2e,
-	0x42, 0xd2, 0x1e, 0xe9, 0x3e, 0x83, 0x90, 0x5c, 0x14, 0x4c, 0xda, 0x05, 0x3b, 0x66, 0x41, 0x6f,
-	0x6d, 0xc1, 0x49, 0x83, 0x32, 0xbe, 0x3d, 0xfd, 0x16, 0x80, 0xab, 0xef, 0x01, 0x48, 0x56, 0x7c,
-	0xe1, 0x87, 0x1d, 0xe8, 0x9a, 0xcc, 0x6d, 0x50, 0x43, 0x78, 0x8b, 0x2e, 0x6f, 0x89, 0xb4, 0xb0,
-	0xe2, 0xee, 0x97, 0xeb, 0x41, 0xf3, 0xad, 0x47, 0x59, 0x26, 0x89, 0x52, 0xaf, 0xb4, 0x64, 0x39,
-	0x4d, 0x1a, 0xe1, 0x1f, 0x0f, 0x31, 0x69, 0xb6, 0xf0, 0x90, 0x75, 0x50, 0x9d, 0xff, 0x0f, 0xea,
-	0x69, 0x0b, 0xd4, 0xee, 0x3f, 0x41, 0xed, 0xae, 0x41, 0x7a, 0x0c, 0xef, 0x18, 0x46, 0x2f, 0x4b,
-	0x52, 0x92, 0xe7, 0x9a, 0x70, 0x37, 0x84, 0x07, 0x5c, 0xd1, 0xb1, 0xae, 0x0a, 0x32, 0x2e, 0xe5,
-	0x44, 0x75, 0x41, 0xbf, 0x73, 0xb8, 0x97, 0xec, 0x73, 0x45, 0x4f, 0xaa, 0x82, 0xbc, 0x96, 0x13,
-	0x15, 0x0f, 0xa7, 0x3f, 0x7d, 0x67, 0x3a, 0xf7, 0xc1, 0x6c, 0xee, 0x83, 0x1f, 0x73, 0x1f, 0x5c,
-	0x2d, 0x7c, 0x67, 0xb6, 0xf0, 0x9d, 0xaf, 0x0b, 0xdf, 0x79, 0x53, 0x83, 0x51, 0xd9, 0x79, 0xc4,
-	0x04, 0xba, 0xb0, 0x3f, 0x55, 0x7a, 0xd3, 0xe4, 0x79, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x60,
-	0x57, 0xde, 0x7c, 0x79, 0x03, 0x00, 0x00,
+	// 798 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xf3, 0xb3, 0x9d, 0xb0, 0x55, 0x18, 0x42, 0x71, 0x82, 0xb0, 0x43, 0x40, 0x28, 0x8a,
+	0x54, 0x5b, 0x1b, 0x38, 0xed, 0x69, 0x93, 0xdd, 0xa4, 0x0a, 0x8a, 0x92, 0xae, 0x37, 0xb9, 0x70,
+	0xb1, 0x9c, 0x64, 0xd6, 0xb1, 0xd6, 0xf6, 0x58, 0x9e, 0xf1, 0x92, 0x2c, 0x37, 0x8e, 0x9c, 0x2a,
+	0x8e, 0x1c, 0x39, 0x21, 0x4e, 0x45, 0xea, 0x1f, 0x11, 0x71, 0x2a, 0x9c, 0x38, 0xb5, 0xd0, 0x1e,
+	0xfa, 0x6f, 0x20, 0xcf, 0xd8, 0x25, 0x49, 0x53, 0xb5, 0x07, 0xb4, 0x97, 0x6a, 0xe6, 0xbd, 0xef,
+	0x7b, 0xef, 0xf3, 0xf7, 0xde, 0x34, 0xa0, 0x32, 0xc1, 0xc4, 0xc1, 0x44, 0x35, 0x02, 0x3a, 0x7b,
+	0xab, 0xbe, 0x79, 0x3c, 0x46, 0xd4, 0x78, 0xcc, 0x6f, 0x8a, 0xe7, 0x63, 0x8a, 0x61, 0x91, 0x23,
+	0x14, 0x1e, 0x8b, 0x10, 0xe5, 0xf7, 0x0d, 0xc7, 0x72, 0xb1, 0xca, 0xfe, 0x72, 0x60, 0xb9, 0xc4,
+	0x81, 0x3a, 0xbb, 0xa9, 0x11, 0x8b, 0xa7, 0x64, 0x13, 0x63, 0xd3, 0x46, 0x2a, 0xbb, 0x8d, 0x83,
+	0x57, 0x2a, 0xb5, 0x1c, 0x44, 0xa8, 0xe1, 0x78, 0x11, 0xa0, 0x68, 0x62, 0x13, 0x73, 0x62, 0x78,
+	0x8a, 0x2b, 0x6e, 0xd2, 0x0c, 0x77, 0x11, 0xa5, 0xa4, 0x48, 0xf7, 0xd8, 0x20, 0xe8, 0x46, 0xf6,
+	0x04, 0x5b, 0x2e, 0xcf, 0x57, 0x29, 0x28, 0x1e, 0x22, 0x17, 0xf9, 0xd6, 0xa4, 0x19, 0xd0, 0x19,
+	0xf6, 0xad, 0xb7, 0x06, 0xb5, 0xb0, 0x0b, 0x0b, 0x20, 0xe5, 0x10, 0x53, 0x14, 0x2a, 0x42, 0x6d,
+	0x57, 0x0b, 0x8f, 0x4f, 0xbe, 0xfe, 0xfd, 0xf4, 0xa0, 0xba, 0xed, 0x1b, 0x95, 0x35, 0xe6, 0x0f,
+	0xd7, 0x27, 0x75, 0x99, 0xc3, 0x0e, 0xc8, 0xf4, 0xb5, 0xba, 0xad, 0x7a, 0xf5, 0xc7, 0x34, 0x10,
+	0x3b, 0x16, 0xb2, 0xa7, 0x1d, 0xcb, 0xa6, 0xc8, 0xbf, 0xa7, 0x35, 0xec, 0x80, 0xdc, 0x2b, 0x06,
+	0x24, 0x62, 0xb2, 0x92, 0xaa, 0xe5, 0x1b, 0x9f, 0x2a, 0x5b, 0x85, 0xac, 0x94, 0x6c, 0xed, 0x2e,
+	0xcf, 0xe5, 0xc4, 0x2f, 0xd7, 0x27, 0x75, 0x41, 0x8b, 0xc9, 0xb0, 0x04, 0x76, 0x1c, 0x63, 0xae,
+	0x07, 0x04, 0x11, 0x31, 0x55, 0x11, 0x6a, 0x69, 0x2d, 0xe7, 0x18, 0xf3, 0x11, 0x41, 0x04, 0x42,
+	0x90, 0x66, 0xe1, 0x34, 0x0b, 0xb3, 0x33, 0x94, 0x41, 0x9e, 0x78, 0xc8, 0x9d, 0xea, 0x9e, 0x41,
+	0x67, 0x44, 0xcc, 0x54, 0x52, 0xb5, 0x5d, 0x0d, 0xb0, 0xd0, 0x51, 0x18, 0x81, 0xdf, 0x0b, 0x31,
+	0xc2, 0xb6, 0x1c, 0x8b, 0x8a, 0x59, 0x26, 0xae, 0x14, 0x8b, 0x0b, 0x3d, 0xbf, 0xd1, 0xf6, 0x0c,
+	0x5b, 0x6e, 0xab, 0x13, 0x8a, 0xfa, 0xf5, 0x42, 0xae, 0x99, 0x16, 0x9d, 0x05, 0x63, 0x65, 0x82,
+	0x9d, 0x68, 0x01, 0xd4, 0x15, 0xcb, 0xe8, 0xc2, 0x43, 0x84, 0x11, 0xc8, 0x4f, 0xd7, 0x27, 0xf5,
+	0xf7, 0x6c, 0x64, 0x1a, 0x93, 0x85, 0x1e, 0x4e, 0x8d, 0xf0, 0x2f, 0xe2, 0x22, 0x7a, 0x61, 0x53,
+	0xf8, 0x2d, 0xc8, 0x84, 0x37, 0x2a, 0xe6, 0xde, 0x55, 0x77, 0xde, 0xef, 0x49, 0xff, 0xe1, 0x0b,
+	0xf1, 0xd9, 0x4a, 0xfd, 0xbb, 0xe6, 0x5e, 0xfd, 0x0e, 0xe4, 0x57, 0x72, 0xe1, 0x44, 0x42, 0xdf,
+	0xa3, 0x3d, 0x60, 0x67, 0xf8, 0x14, 0xec, 0x60, 0x0f, 0xf9, 0x06, 0xc5, 0xbe, 0x98, 0xac, 0x08,
+	0xb5, 0xbd, 0xc6, 0xe7, 0x77, 0x6d, 0x42, 0x58, 0x63, 0x10, 0x61, 0xb5, 0x1b, 0x16, 0xdc, 0x07,
+	0xd9, 0x37, 0x86, 0x1d, 0xb0, 0x05, 0x08, 0xc7, 0x19, 0xdd, 0xaa, 0xbf, 0x09, 0x20, 0x73, 0xe8,
+	0x1b, 0x2e, 0x85, 0x63, 0xf0, 0xc8, 0x58, 0xd5, 0xc5, 0x04, 0xe4, 0x1b, 0x45, 0x85, 0x3f, 0x32,
+	0x25, 0x7e, 0x64, 0x4a, 0xd3, 0x5d, 0xb4, 0xbe, 0x78, 0x98, 0x07, 0xda, 0x7a, 0x49, 0xf8, 0x1c,
+	0x00, 0x34, 0xf7, 0x2c, 0x9f, 0x37, 0x48, 0xb2, 0x06, 0xe5, 0x5b, 0x0d, 0x86, 0xf1, 0xe3, 0x6f,
+	0xed, 0x2c, 0xcf, 0x65, 0xe1, 0xf8, 0x42, 0x16, 0xb4, 0x15, 0x5e, 0xf5, 0xe7, 0x24, 0x80, 0x4c,
+	0xf3, 0xfa, 0xfb, 0x69, 0x80, 0x9c, 0x19, 0x46, 0x91, 0xcf, 0xbd, 0x6b, 0x89, 0x7f, 0x9e, 0x1e,
+	0xc4, 0xff, 0x9d, 0x9a, 0xd3, 0xa9, 0x8f, 0x08, 0x79, 0x49, 0x7d, 0xcb, 0x35, 0xb5, 0x18, 0xf8,
+	0x1f, 0x07, 0x89, 0xc9, 0x87, 0x71, 0xd0, 0x6d, 0xa3, 0x52, 0xff, 0xbf, 0x51, 0x4f, 0xd7, 0x8c,
+	0x4a, 0xdf, 0x6b, 0x54, 0xfa, 0x96, 0x49, 0x5f, 0x81, 0x3d, 0xe6, 0xd1, 0x8b, 0x00, 0x05, 0xa8,
+	0x4b, 0x91, 0x03, 0xab, 0xe0, 0x91, 0x43, 0x4c, 0x3d, 0xdc, 0x73, 0x3d, 0xf0, 0x6d, 0x22, 0x0a,
+	0x6c, 0x13, 0xf2, 0x0e, 0x31, 0x87, 0x0b, 0x0f, 0x8d, 0x7c, 0x9b, 0xd4, 0xff, 0x10, 0xc0, 0xde,
+	0xfa, 0x0e, 0x41, 0x19, 0x7c, 0xdc, 0xe9, 0xf6, 0x86, 0x6d, 0x4d, 0x1f, 0x1c, 0xb5, 0xb5, 0xe6,
+	0x70, 0xa0, 0xe9, 0xa3, 0xfe, 0xcb, 0xa3, 0xf6, 0xb3, 0x6e, 0xa7, 0xdb, 0x7e, 0x5e, 0x48, 0xc0,
+	0x12, 0xf8, 0x70, 0x13, 0xd0, 0x7e, 0x31, 0x6a, 0xf6, 0x0a, 0x02, 0xfc, 0x04, 0x94, 0x36, 0x53,
+	0xfd, 0xc1, 0x30, 0x4a, 0x27, 0xe1, 0x3e, 0x80, 0x9b, 0xe9, 0x6e, 0xbf, 0x90, 0x82, 0x65, 0xb0,
+	0xbf, 0x8d, 0xd6, 0xed, 0x17, 0xd2, 0xf0, 0x23, 0xf0, 0xc1, 0x66, 0xae, 0x37, 0x6c, 0x17, 0x32,
+	0xdb, 0x12, 0x87, 0xc3, 0x76, 0x21, 0xdb, 0x6a, 0x2c, 0xff, 0x91, 0x12, 0xcb, 0x4b, 0x49, 0x38,
+	0xbb, 0x94, 0x84, 0xbf, 0x2f, 0x25, 0xe1, 0xf8, 0x4a, 0x4a, 0x9c, 0x5d, 0x49, 0x89, 0xbf, 0xae,
+	0xa4, 0xc4, 0x37, 0xd1, 0xb0, 0xc9, 0xf4, 0xb5, 0x62, 0x61, 0x75, 0xce, 0x7f, 0xda, 0xc6, 0x59,
+	0xe6, 0xf1, 0x97, 0xff, 0x0e, 0x00, 0x1b, 0x3d, 0x55, 0xbf, 0xff, 0x06, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -265,6 +442,141 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FieldFilterAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldFilterAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldFilterAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SpendPaths) > 0 {
+		for iNdEx := len(m.SpendPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SpendPaths[iNdEx])
+			copy(dAtA[i:], m.SpendPaths[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.SpendPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Uses != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Uses))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxUses != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxUses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Operator != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Operator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -425,6 +737,71 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *FieldFilterAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.MaxUses != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxUses))
+	}
+	if m.Uses != 0 {
+		n += 1 + sovAuthz(uint64(m.Uses))
+	}
+	if len(m.SpendPaths) > 0 {
+		for _, s := range m.SpendPaths {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FieldFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Operator != 0 {
+		n += 1 + sovAuthz(uint64(m.Operator))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -570,6 +947,393 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FieldFilterAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldFilterAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldFilterAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, FieldFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUses", wireType)
+			}
+			m.MaxUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
+			}
+			m.Uses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendPaths = append(m.SpendPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= FilterOperator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -19,6 +19,7 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 
 	registrar.RegisterInterface((*Authorization)(nil), nil)
 	registrar.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization")
+	registrar.RegisterConcrete(&FieldFilterAuthorization{}, "cosmos-sdk/FieldFilterAuthorization")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -37,6 +38,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		"cosmos.authz.v1beta1.Authorization",
 		(*Authorization)(nil),
 		&GenericAuthorization{},
+		&FieldFilterAuthorization{},
 		&bank.SendAuthorization{},
 		&staking.StakeAuthorization{},
 	)
@@ -20,4 +20,6 @@ var (
 	ErrAuthorizationNumOfSigners = errors.Register(ModuleName, 9, "authorization can be given to msg with only one signer")
 	// ErrNegativeMaxTokens error if the max tokens is negative
 	ErrNegativeMaxTokens = errors.Register(ModuleName, 12, "max tokens should be positive")
+	// ErrInvalidAuthorization error if an authorization fails its basic validation when executed
+	ErrInvalidAuthorization = errors.Register(ModuleName, 13, "invalid authorization")
 )
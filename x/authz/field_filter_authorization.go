@@ -0,0 +1,454 @@
+package authz
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const coinMessageName protoreflect.FullName = "cosmos.base.v1beta1.Coin"
+
+// NewFieldFilterAuthorization creates a new FieldFilterAuthorization object.
+// A zero maxUses does not limit the number of uses. spendPaths and spendLimit
+// must be set together to cap the coins spent through the authorization.
+func NewFieldFilterAuthorization(msgTypeURL string, filters []FieldFilter, maxUses uint64, spendPaths []string, spendLimit sdk.Coins) *FieldFilterAuthorization {
+	return &FieldFilterAuthorization{
+		Msg:        msgTypeURL,
+		Filters:    filters,
+		MaxUses:    maxUses,
+		SpendPaths: spendPaths,
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a FieldFilterAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept.
+// The message is accepted if all the filters hold on its fields and neither the
+// usage nor the spend limit is exceeded.
+func (a FieldFilterAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.Msg {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if a.MaxUses > 0 && a.Uses >= a.MaxUses {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("authorization has no uses left")
+	}
+
+	authzEnv, ok := ctx.Value(corecontext.EnvironmentContextKey).(appmodulev2.Environment)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("environment not set")
+	}
+	consumeGas := func() error {
+		return authzEnv.GasService.GasMeter(ctx).Consume(authz.GasCostPerIteration, "field filter authorization")
+	}
+
+	m, err := toProtoReflect(msg)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	for _, f := range a.Filters {
+		if err := consumeGas(); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		segments, err := parseFieldPath(f.Path)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		fd, err := fieldDescriptorAt(m.Descriptor(), segments)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		values := valuesAt(m, segments)
+		if len(values) == 0 && !f.Operator.allowsEmpty() {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("field %s is not set", f.Path)
+		}
+
+		for _, v := range values {
+			if err := consumeGas(); err != nil {
+				return authz.AcceptResponse{}, err
+			}
+
+			s, err := formatScalar(fd, v)
+			if err != nil {
+				return authz.AcceptResponse{}, err
+			}
+			if !f.match(s) {
+				return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("field %s with value %s does not satisfy %s", f.Path, s, f.Operator)
+			}
+		}
+	}
+
+	updated := a
+	updated.Uses++
+
+	if len(a.SpendPaths) > 0 {
+		var spending sdk.Coins
+		for _, p := range a.SpendPaths {
+			if err := consumeGas(); err != nil {
+				return authz.AcceptResponse{}, err
+			}
+
+			coins, err := coinsAt(m, p)
+			if err != nil {
+				return authz.AcceptResponse{}, err
+			}
+			spending = spending.Add(coins...)
+		}
+
+		updated.Spent = a.Spent.Add(spending...)
+		if !updated.Spent.IsAllLTE(a.SpendLimit) {
+			return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+		}
+	}
+
+	if a.MaxUses > 0 && updated.Uses >= a.MaxUses {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	if len(a.SpendLimit) > 0 && a.SpendLimit.Sub(updated.Spent...).IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a FieldFilterAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return errors.New("msg type cannot be empty")
+	}
+
+	md, err := messageDescriptor(a.Msg)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range a.Filters {
+		if err := f.validate(md); err != nil {
+			return err
+		}
+	}
+
+	if a.MaxUses > 0 && a.Uses > a.MaxUses {
+		return sdkerrors.ErrInvalidRequest.Wrapf("uses %d exceed max uses %d", a.Uses, a.MaxUses)
+	}
+
+	if (len(a.SpendPaths) == 0) != (len(a.SpendLimit) == 0) {
+		return sdkerrors.ErrInvalidRequest.Wrap("spend paths and spend limit must be set together")
+	}
+
+	for _, p := range a.SpendPaths {
+		segments, err := parseFieldPath(p)
+		if err != nil {
+			return err
+		}
+		fd, err := fieldDescriptorAt(md, segments)
+		if err != nil {
+			return err
+		}
+		if fd.Message() == nil || fd.Message().FullName() != coinMessageName {
+			return sdkerrors.ErrInvalidRequest.Wrapf("spend path %s must resolve to %s", p, coinMessageName)
+		}
+	}
+
+	if len(a.SpendLimit) > 0 {
+		if err := a.SpendLimit.Validate(); err != nil {
+			return sdkerrors.ErrInvalidCoins.Wrapf("invalid spend limit: %s", err)
+		}
+	}
+
+	if err := a.Spent.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid spent amount: %s", err)
+	}
+
+	if !a.Spent.IsAllLTE(a.SpendLimit) {
+		return sdkerrors.ErrInvalidCoins.Wrap("spent amount cannot exceed spend limit")
+	}
+
+	return nil
+}
+
+// validate checks that the filter is well formed and that its path exists on
+// the message described by md.
+func (f FieldFilter) validate(md protoreflect.MessageDescriptor) error {
+	segments, err := parseFieldPath(f.Path)
+	if err != nil {
+		return err
+	}
+
+	fd, err := fieldDescriptorAt(md, segments)
+	if err != nil {
+		return err
+	}
+	if fd.Message() != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("path %s must resolve to a scalar field", f.Path)
+	}
+
+	switch f.Operator {
+	case FilterOperator_FILTER_OPERATOR_EQUAL, FilterOperator_FILTER_OPERATOR_NOT_EQUAL:
+		if len(f.Values) != 1 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("operator %s expects exactly one value", f.Operator)
+		}
+	case FilterOperator_FILTER_OPERATOR_IN, FilterOperator_FILTER_OPERATOR_NOT_IN:
+		if len(f.Values) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("operator %s expects at least one value", f.Operator)
+		}
+	case FilterOperator_FILTER_OPERATOR_LTE, FilterOperator_FILTER_OPERATOR_GTE:
+		if len(f.Values) != 1 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("operator %s expects exactly one value", f.Operator)
+		}
+		if _, ok := math.NewIntFromString(f.Values[0]); !ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("operator %s expects an integer value, got %s", f.Operator, f.Values[0])
+		}
+	default:
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid filter operator %s", f.Operator)
+	}
+
+	return nil
+}
+
+// match reports whether a single value found at the filter path satisfies the filter.
+func (f FieldFilter) match(value string) bool {
+	switch f.Operator {
+	case FilterOperator_FILTER_OPERATOR_EQUAL:
+		return value == f.Values[0]
+	case FilterOperator_FILTER_OPERATOR_NOT_EQUAL:
+		return value != f.Values[0]
+	case FilterOperator_FILTER_OPERATOR_IN:
+		return slices.Contains(f.Values, value)
+	case FilterOperator_FILTER_OPERATOR_NOT_IN:
+		return !slices.Contains(f.Values, value)
+	case FilterOperator_FILTER_OPERATOR_LTE, FilterOperator_FILTER_OPERATOR_GTE:
+		v, ok := math.NewIntFromString(value)
+		if !ok {
+			return false
+		}
+		operand, ok := math.NewIntFromString(f.Values[0])
+		if !ok {
+			return false
+		}
+		if f.Operator == FilterOperator_FILTER_OPERATOR_LTE {
+			return v.LTE(operand)
+		}
+		return v.GTE(operand)
+	default:
+		return false
+	}
+}
+
+// allowsEmpty reports whether the operator holds when no value is found at the path.
+func (o FilterOperator) allowsEmpty() bool {
+	return o == FilterOperator_FILTER_OPERATOR_NOT_EQUAL || o == FilterOperator_FILTER_OPERATOR_NOT_IN
+}
+
+// pathSegment is a field name optionally followed by a list index.
+// An index of -1 selects every element of a repeated field.
+type pathSegment struct {
+	name  protoreflect.Name
+	index int
+}
+
+// parseFieldPath parses paths of the form "$.a.b[*].c" or "a.b[0].c".
+// The "$." prefix and the "[*]" selectors are optional.
+func parseFieldPath(path string) ([]pathSegment, error) {
+	p := strings.TrimPrefix(path, "$.")
+	if p == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("field path cannot be empty")
+	}
+
+	parts := strings.Split(p, ".")
+	segments := make([]pathSegment, 0, len(parts))
+	for _, part := range parts {
+		seg := pathSegment{name: protoreflect.Name(part), index: -1}
+		if i := strings.IndexByte(part, '['); i >= 0 {
+			if !strings.HasSuffix(part, "]") {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid field path %s", path)
+			}
+			seg.name = protoreflect.Name(part[:i])
+			if selector := part[i+1 : len(part)-1]; selector != "*" {
+				idx, err := strconv.Atoi(selector)
+				if err != nil || idx < 0 {
+					return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid index in field path %s", path)
+				}
+				seg.index = idx
+			}
+		}
+		if !seg.name.IsValid() {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid field path %s", path)
+		}
+		segments = append(segments, seg)
+	}
+
+	return segments, nil
+}
+
+// fieldDescriptorAt returns the descriptor of the field the path leads to.
+func fieldDescriptorAt(md protoreflect.MessageDescriptor, segments []pathSegment) (protoreflect.FieldDescriptor, error) {
+	var fd protoreflect.FieldDescriptor
+	for i, seg := range segments {
+		if md == nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("field %s is not a message", segments[i-1].name)
+		}
+		fd = md.Fields().ByName(seg.name)
+		if fd == nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("unknown field %s in %s", seg.name, md.FullName())
+		}
+		if fd.IsMap() {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("map field %s is not supported", seg.name)
+		}
+		if seg.index >= 0 && !fd.IsList() {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("field %s is not repeated", seg.name)
+		}
+		md = fd.Message()
+	}
+
+	return fd, nil
+}
+
+// valuesAt returns every value found at the path, which must have been
+// validated with fieldDescriptorAt. Unset message fields yield no value.
+func valuesAt(m protoreflect.Message, segments []pathSegment) []protoreflect.Value {
+	seg := segments[0]
+	fd := m.Descriptor().Fields().ByName(seg.name)
+
+	var values []protoreflect.Value
+	switch {
+	case fd.IsList():
+		list := m.Get(fd).List()
+		if seg.index >= 0 {
+			if seg.index < list.Len() {
+				values = append(values, list.Get(seg.index))
+			}
+			break
+		}
+		for i := 0; i < list.Len(); i++ {
+			values = append(values, list.Get(i))
+		}
+	case fd.Message() != nil && !m.Has(fd):
+	default:
+		values = append(values, m.Get(fd))
+	}
+
+	if len(segments) == 1 {
+		return values
+	}
+
+	var res []protoreflect.Value
+	for _, v := range values {
+		res = append(res, valuesAt(v.Message(), segments[1:])...)
+	}
+
+	return res
+}
+
+// coinsAt returns the sum of the coins found at the path.
+func coinsAt(m protoreflect.Message, path string) (sdk.Coins, error) {
+	segments, err := parseFieldPath(path)
+	if err != nil {
+		return nil, err
+	}
+	fd, err := fieldDescriptorAt(m.Descriptor(), segments)
+	if err != nil {
+		return nil, err
+	}
+	if fd.Message() == nil || fd.Message().FullName() != coinMessageName {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("spend path %s must resolve to %s", path, coinMessageName)
+	}
+
+	var coins sdk.Coins
+	for _, v := range valuesAt(m, segments) {
+		cm := v.Message()
+		fields := cm.Descriptor().Fields()
+		amount, ok := math.NewIntFromString(cm.Get(fields.ByName("amount")).String())
+		if !ok {
+			return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid amount at %s", path)
+		}
+		coin := sdk.Coin{Denom: cm.Get(fields.ByName("denom")).String(), Amount: amount}
+		if err := coin.Validate(); err != nil {
+			return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid coin at %s: %s", path, err)
+		}
+		coins = coins.Add(coin)
+	}
+
+	return coins, nil
+}
+
+// formatScalar returns the textual form of a scalar value, which filter
+// operands are compared against. Enums are rendered by name and bytes in base64.
+func formatScalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) (string, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return "", sdkerrors.ErrInvalidRequest.Wrapf("field %s is not a scalar", fd.Name())
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name()), nil
+		}
+		return strconv.FormatInt(int64(v.Enum()), 10), nil
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes()), nil
+	default:
+		return v.String(), nil
+	}
+}
+
+// messageDescriptor resolves the descriptor of the message with the given type URL.
+func messageDescriptor(typeURL string) (protoreflect.MessageDescriptor, error) {
+	name := protoreflect.FullName(strings.TrimPrefix(typeURL, "/"))
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(name)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("unknown message %s", typeURL)
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("%s is not a message", typeURL)
+	}
+	return md, nil
+}
+
+// toProtoReflect returns a protoreflect view of msg, decoding gogoproto
+// messages into a dynamic message.
+func toProtoReflect(msg sdk.Msg) (protoreflect.Message, error) {
+	if m, ok := msg.(proto.Message); ok {
+		return m.ProtoReflect(), nil
+	}
+
+	md, err := messageDescriptor(sdk.MsgTypeURL(msg))
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := gogoproto.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", md.FullName(), err)
+	}
+
+	m := dynamicpb.NewMessage(md)
+	if err := proto.Unmarshal(bz, m); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", md.FullName(), err)
+	}
+	return m, nil
+}
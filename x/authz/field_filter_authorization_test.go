@@ -0,0 +1,201 @@
+package authz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	coregas "cosmossdk.io/core/gas"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/authz"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	fromAddrStr = "cosmos1ta047h6lveex7mfqta047h6ln9jal0"
+	toAddrStr   = "cosmos1ta047h6lta0hgm6lta047h6lta0stgm2m3"
+	otherAddr   = "cosmos1ta047h6lw4hxkmn0wah97h6lta0sml880l"
+)
+
+type mockGasService struct {
+	coregas.Service
+}
+
+func (m mockGasService) GasMeter(ctx context.Context) coregas.Meter {
+	return mockGasMeter{}
+}
+
+type mockGasMeter struct {
+	coregas.Meter
+}
+
+func (m mockGasMeter) Consume(amount coregas.Gas, descriptor string) error {
+	return nil
+}
+
+func TestFieldFilterAuthorizationValidateBasic(t *testing.T) {
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	testCases := []struct {
+		name   string
+		authz  *authz.FieldFilterAuthorization
+		errMsg string
+	}{
+		{
+			name:  "valid",
+			authz: authz.NewFieldFilterAuthorization(sendURL, []authz.FieldFilter{{Path: "$.to_address", Operator: authz.FilterOperator_FILTER_OPERATOR_IN, Values: []string{toAddrStr}}}, 2, []string{"amount"}, coins),
+		},
+		{
+			name:   "empty msg",
+			authz:  authz.NewFieldFilterAuthorization("", nil, 0, nil, nil),
+			errMsg: "msg type cannot be empty",
+		},
+		{
+			name:   "unknown msg",
+			authz:  authz.NewFieldFilterAuthorization("/cosmos.unknown.MsgUnknown", nil, 0, nil, nil),
+			errMsg: "unknown message",
+		},
+		{
+			name:   "unknown field",
+			authz:  authz.NewFieldFilterAuthorization(sendURL, []authz.FieldFilter{{Path: "recipient", Operator: authz.FilterOperator_FILTER_OPERATOR_EQUAL, Values: []string{toAddrStr}}}, 0, nil, nil),
+			errMsg: "unknown field recipient",
+		},
+		{
+			name:   "non scalar field",
+			authz:  authz.NewFieldFilterAuthorization(sendURL, []authz.FieldFilter{{Path: "amount", Operator: authz.FilterOperator_FILTER_OPERATOR_EQUAL, Values: []string{"1stake"}}}, 0, nil, nil),
+			errMsg: "must resolve to a scalar field",
+		},
+		{
+			name:   "unspecified operator",
+			authz:  authz.NewFieldFilterAuthorization(sendURL, []authz.FieldFilter{{Path: "to_address", Values: []string{toAddrStr}}}, 0, nil, nil),
+			errMsg: "invalid filter operator",
+		},
+		{
+			name:   "too many operands",
+			authz:  authz.NewFieldFilterAuthorization(sendURL, []authz.FieldFilter{{Path: "to_address", Operator: authz.FilterOperator_FILTER_OPERATOR_EQUAL, Values: []string{toAddrStr, otherAddr}}}, 0, nil, nil),
+			errMsg: "expects exactly one value",
+		},
+		{
+			name:   "non integer operand",
+			authz:  authz.NewFieldFilterAuthorization(sendURL, []authz.FieldFilter{{Path: "amount[*].amount", Operator: authz.FilterOperator_FILTER_OPERATOR_LTE, Values: []string{"ten"}}}, 0, nil, nil),
+			errMsg: "expects an integer value",
+		},
+		{
+			name:   "spend paths without limit",
+			authz:  authz.NewFieldFilterAuthorization(sendURL, nil, 0, []string{"amount"}, nil),
+			errMsg: "must be set together",
+		},
+		{
+			name:   "spend path not a coin",
+			authz:  authz.NewFieldFilterAuthorization(sendURL, nil, 0, []string{"to_address"}, coins),
+			errMsg: "must resolve to cosmos.base.v1beta1.Coin",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.authz.ValidateBasic()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestFieldFilterAuthorizationAccept(t *testing.T) {
+	ctx := context.WithValue(context.Background(), corecontext.EnvironmentContextKey, appmodulev2.Environment{
+		GasService: mockGasService{},
+	})
+
+	send := func(to string, amount int64) *banktypes.MsgSend {
+		return banktypes.NewMsgSend(fromAddrStr, to, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+	}
+	filters := []authz.FieldFilter{
+		{Path: "$.to_address", Operator: authz.FilterOperator_FILTER_OPERATOR_EQUAL, Values: []string{toAddrStr}},
+		{Path: "$.amount[*].denom", Operator: authz.FilterOperator_FILTER_OPERATOR_IN, Values: []string{"stake"}},
+		{Path: "$.amount[*].amount", Operator: authz.FilterOperator_FILTER_OPERATOR_LTE, Values: []string{"60"}},
+	}
+	a := authz.NewFieldFilterAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}), filters, 3, []string{"amount"}, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	require.NoError(t, a.ValidateBasic())
+
+	t.Log("verify a different message type is rejected")
+	_, err := a.Accept(ctx, &banktypes.MsgMultiSend{})
+	require.ErrorContains(t, err, "type mismatch")
+
+	t.Log("verify filters are enforced")
+	_, err = a.Accept(ctx, send(otherAddr, 10))
+	require.ErrorContains(t, err, "does not satisfy FILTER_OPERATOR_EQUAL")
+	_, err = a.Accept(ctx, send(toAddrStr, 61))
+	require.ErrorContains(t, err, "does not satisfy FILTER_OPERATOR_LTE")
+
+	t.Log("verify uses and spending are tracked")
+	resp, err := a.Accept(ctx, send(toAddrStr, 60))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated, ok := resp.Updated.(*authz.FieldFilterAuthorization)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), updated.Uses)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), updated.Spent)
+	require.NoError(t, updated.ValidateBasic())
+
+	t.Log("verify the spend limit is cumulative")
+	_, err = updated.Accept(ctx, send(toAddrStr, 50))
+	require.ErrorContains(t, err, "requested amount is more than spend limit")
+
+	t.Log("verify the grant is deleted once the spend limit is reached")
+	resp, err = updated.Accept(ctx, send(toAddrStr, 40))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	t.Log("verify the grant is deleted once max uses is reached")
+	a = authz.NewFieldFilterAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}), nil, 2, nil, nil)
+	resp, err = a.Accept(ctx, send(otherAddr, 1))
+	require.NoError(t, err)
+	require.False(t, resp.Delete)
+	updated = resp.Updated.(*authz.FieldFilterAuthorization)
+	resp, err = updated.Accept(ctx, send(otherAddr, 1))
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+}
+
+func TestFieldFilterAuthorizationRepeatedFields(t *testing.T) {
+	ctx := context.WithValue(context.Background(), corecontext.EnvironmentContextKey, appmodulev2.Environment{
+		GasService: mockGasService{},
+	})
+
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10)))
+	msg := &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(fromAddrStr, coins)},
+		Outputs: []banktypes.Output{banktypes.NewOutput(toAddrStr, coins), banktypes.NewOutput(otherAddr, coins)},
+	}
+
+	a := authz.NewFieldFilterAuthorization(sdk.MsgTypeURL(msg), []authz.FieldFilter{
+		{Path: "outputs[*].address", Operator: authz.FilterOperator_FILTER_OPERATOR_NOT_IN, Values: []string{fromAddrStr}},
+	}, 0, []string{"outputs.coins"}, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	require.NoError(t, a.ValidateBasic())
+
+	resp, err := a.Accept(ctx, msg)
+	require.NoError(t, err)
+	updated := resp.Updated.(*authz.FieldFilterAuthorization)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), updated.Spent)
+
+	t.Log("verify every element of a repeated field must satisfy the filter")
+	a.Filters[0].Values = []string{otherAddr}
+	_, err = a.Accept(ctx, msg)
+	require.ErrorContains(t, err, "does not satisfy FILTER_OPERATOR_NOT_IN")
+
+	t.Log("verify indexed paths only select a single element")
+	a.Filters[0] = authz.FieldFilter{Path: "outputs[0].address", Operator: authz.FilterOperator_FILTER_OPERATOR_EQUAL, Values: []string{toAddrStr}}
+	_, err = a.Accept(ctx, msg)
+	require.NoError(t, err)
+}
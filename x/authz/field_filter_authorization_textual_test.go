@@ -0,0 +1,73 @@
+package authz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	authzv1beta1 "cosmossdk.io/api/cosmos/authz/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/x/tx/signing/textual"
+)
+
+func TestFieldFilterAuthorizationTextual(t *testing.T) {
+	handler, err := textual.NewSignModeHandler(textual.SignModeOptions{
+		CoinMetadataQuerier: func(context.Context, string) (*bankv1beta1.Metadata, error) { return nil, nil },
+	})
+	require.NoError(t, err)
+
+	authorization, err := anyutil.New(&authzv1beta1.FieldFilterAuthorization{
+		Msg: "/cosmos.bank.v1beta1.MsgSend",
+		Filters: []*authzv1beta1.FieldFilter{{
+			Path:     "to_address",
+			Operator: authzv1beta1.FilterOperator_FILTER_OPERATOR_IN,
+			Values:   []string{toAddrStr, otherAddr},
+		}},
+		MaxUses:    5,
+		SpendPaths: []string{"amount"},
+		SpendLimit: []*basev1beta1.Coin{{Denom: "stake", Amount: "100"}},
+	})
+	require.NoError(t, err)
+	msg := &authzv1beta1.MsgGrant{
+		Granter: fromAddrStr,
+		Grantee: toAddrStr,
+		Grant:   &authzv1beta1.Grant{Authorization: authorization},
+	}
+
+	renderer, err := handler.GetMessageValueRenderer(msg.ProtoReflect().Descriptor())
+	require.NoError(t, err)
+	screens, err := renderer.Format(context.Background(), protoreflect.ValueOfMessage(msg.ProtoReflect()))
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{
+		{Content: "MsgGrant object"},
+		{Title: "Granter", Content: fromAddrStr, Indent: 1},
+		{Title: "Grantee", Content: toAddrStr, Indent: 1},
+		{Title: "Grant", Content: "Grant object", Indent: 1},
+		{Title: "Authorization", Content: "/cosmos.authz.v1beta1.FieldFilterAuthorization", Indent: 2},
+		{Title: "Msg", Content: "/cosmos.bank.v1beta1.MsgSend", Indent: 3},
+		{Title: "Filters", Content: "1 FieldFilter", Indent: 3},
+		{Title: "Filters (1/1)", Content: "FieldFilter object", Indent: 4},
+		{Title: "Path", Content: "to_address", Indent: 5},
+		{Title: "Operator", Content: "FILTER_OPERATOR_IN", Indent: 5},
+		{Title: "Values", Content: "2 String", Indent: 5},
+		{Title: "Values (1/2)", Content: toAddrStr, Indent: 6},
+		{Title: "Values (2/2)", Content: otherAddr, Indent: 6},
+		{Content: "End of Values", Indent: 5},
+		{Content: "End of Filters", Indent: 3},
+		{Title: "Max uses", Content: "5", Indent: 3},
+		{Title: "Spend paths", Content: "1 String", Indent: 3},
+		{Title: "Spend paths (1/1)", Content: "amount", Indent: 4},
+		{Content: "End of Spend paths", Indent: 3},
+		{Title: "Spend limit", Content: "100 stake", Indent: 3},
+	}, screens)
+
+	parsed, err := renderer.Parse(context.Background(), screens)
+	require.NoError(t, err)
+	require.Empty(t, cmp.Diff(msg, parsed.Message().Interface(), protocmp.Transform()))
+}
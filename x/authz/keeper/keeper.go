@@ -120,6 +120,10 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 				return nil, err
 			}
 
+			if err := validateAuthorization(authorization); err != nil {
+				return nil, err
+			}
+
 			// pass the environment in the context
 			// users on server/v2 are expected to unwrap the environment from the context
 			// users on baseapp can still unwrap the sdk context
@@ -135,6 +139,9 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 				if !ok {
 					return nil, fmt.Errorf("expected authz.Authorization but got %T", resp.Updated)
 				}
+				if err := validateAuthorization(updated); err != nil {
+					return nil, err
+				}
 				err = k.updateGrant(ctx, grantee, granter, updated)
 			}
 			if err != nil {
@@ -166,6 +173,17 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 	return results, nil
 }
 
+// validateAuthorization validates a stored authorization before it accepts a
+// message and after it is updated by Accept, so that grants made invalid by a
+// change of their validation rules, such as FieldFilterAuthorization and the
+// x/gov VoteAuthorization ones, or by their own updates are never used.
+func validateAuthorization(a authz.Authorization) error {
+	if err := a.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(authz.ErrInvalidAuthorization, err.Error())
+	}
+	return nil
+}
+
 // SaveGrant method grants the provided authorization to the grantee on the granter's account
 // with the provided expiration time and insert authorization key into the grants queue. If there is an existing authorization grant for the
 // same `sdk.Msg` type, this grant overwrites that.
@@ -365,6 +365,77 @@ func (s *TestSuite) TestDispatchAction() {
 				require.Len(authzs, 0)
 			},
 		},
+		{
+			"expect error invalid authorization",
+			authz.NewMsgExec(granteeStrAddr, []sdk.Msg{
+				&banktypes.MsgSend{
+					Amount:      coins10,
+					FromAddress: granterStrAddr,
+					ToAddress:   recipientStrAddr,
+				},
+			}),
+			true,
+			"invalid authorization",
+			"",
+			func() sdk.Context {
+				e := now.AddDate(0, 1, 0)
+				invalid := authz.NewFieldFilterAuthorization(bankSendAuthMsgType, []authz.FieldFilter{{Path: "recipient"}}, 0, nil, nil)
+				err := s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, invalid, &e)
+				require.NoError(err)
+				return s.ctx
+			},
+			func() {},
+		},
+		{
+			"expect error invalid stored send authorization",
+			authz.NewMsgExec(granteeStrAddr, []sdk.Msg{
+				&banktypes.MsgSend{
+					Amount:      coins10,
+					FromAddress: granterStrAddr,
+					ToAddress:   recipientStrAddr,
+				},
+			}),
+			true,
+			"invalid authorization",
+			"",
+			func() sdk.Context {
+				e := now.AddDate(0, 1, 0)
+				invalid := banktypes.NewSendAuthorization(sdk.Coins{}, nil, s.addrCdc)
+				err := s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, invalid, &e)
+				require.NoError(err)
+				return s.ctx
+			},
+			func() {},
+		},
+		{
+			"valid test field filter authorization tracks uses and spending",
+			authz.NewMsgExec(granteeStrAddr, []sdk.Msg{
+				&banktypes.MsgSend{
+					Amount:      coins10,
+					FromAddress: granterStrAddr,
+					ToAddress:   recipientStrAddr,
+				},
+			}),
+			false,
+			"",
+			"/cosmos.bank.v1beta1.MsgSendResponse",
+			func() sdk.Context {
+				e := now.AddDate(0, 1, 0)
+				filters := []authz.FieldFilter{{Path: "to_address", Operator: authz.FilterOperator_FILTER_OPERATOR_EQUAL, Values: []string{recipientStrAddr}}}
+				ffa := authz.NewFieldFilterAuthorization(bankSendAuthMsgType, filters, 5, []string{"amount"}, coins100)
+				err := s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, ffa, &e)
+				require.NoError(err)
+				return s.ctx
+			},
+			func() {
+				authzs, err := s.authzKeeper.GetAuthorizations(s.ctx, granteeAddr, granterAddr)
+				require.NoError(err)
+				require.Len(authzs, 1)
+				authorization := authzs[0].(*authz.FieldFilterAuthorization)
+				require.Equal(uint64(1), authorization.Uses)
+				require.Equal(coins10, authorization.Spent)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
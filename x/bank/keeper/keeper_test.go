@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	coreevent "cosmossdk.io/core/event"
 	"cosmossdk.io/core/header"
@@ -113,6 +114,7 @@ type KeeperTestSuite struct {
 	suite.Suite
 
 	ctx        context.Context
+	storeKey   *storetypes.KVStoreKey
 	bankKeeper keeper.BaseKeeper
 	addrCdc    address.Codec
 	authKeeper *banktestutil.MockAccountKeeper
@@ -146,6 +148,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	authKeeper := banktestutil.NewMockAccountKeeper(ctrl)
 	authKeeper.EXPECT().AddressCodec().Return(ac).AnyTimes()
 	suite.ctx = ctx
+	suite.storeKey = key
 	suite.authKeeper = authKeeper
 	suite.addrCdc = ac
 	suite.bankKeeper = keeper.NewBaseKeeper(
@@ -608,6 +611,51 @@ func (suite *KeeperTestSuite) TestSupply_BurnCoins() {
 	require.Equal(supplyAfterInflation.Sub(initCoins...), supplyAfterBurn)
 }
 
+// TestStoreLayout checks that the exported key codecs and prefixes, used to
+// access the store without a keeper, follow the keeper collections.
+func (suite *KeeperTestSuite) TestStoreLayout() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	store := ctx.KVStore(suite.storeKey)
+	addr := minterAcc.GetAddress()
+
+	suite.mockMintCoins(minterAcc)
+	require.NoError(suite.bankKeeper.MintCoins(ctx, authtypes.Minter, sdk.NewCoins(newFooCoin(100))))
+
+	supplyKey, err := collections.EncodeKeyWithPrefix(banktypes.SupplyKey, banktypes.SupplyKeyCodec, fooDenom)
+	require.NoError(err)
+	supply, err := sdk.IntValue.Decode(store.Get(supplyKey))
+	require.NoError(err)
+	require.Equal(math.NewInt(100), supply)
+
+	balanceKey, err := collections.EncodeKeyWithPrefix(banktypes.BalancesPrefix, banktypes.BalancesKeyCodec, collections.Join(addr, fooDenom))
+	require.NoError(err)
+	balance, err := banktypes.BalanceValueCodec.Decode(store.Get(balanceKey))
+	require.NoError(err)
+	require.Equal(math.NewInt(100), balance)
+
+	indexKey, err := collections.EncodeKeyWithPrefix(banktypes.DenomAddressPrefix, banktypes.DenomAddressKeyCodec, collections.Join(fooDenom, addr))
+	require.NoError(err)
+	require.True(store.Has(indexKey))
+
+	// the raw writes are read back by the keeper
+	barBalanceKey, err := collections.EncodeKeyWithPrefix(banktypes.BalancesPrefix, banktypes.BalancesKeyCodec, collections.Join(addr, barDenom))
+	require.NoError(err)
+	bz, err := banktypes.BalanceValueCodec.Encode(math.NewInt(50))
+	require.NoError(err)
+	store.Set(barBalanceKey, bz)
+	barIndexKey, err := collections.EncodeKeyWithPrefix(banktypes.DenomAddressPrefix, banktypes.DenomAddressKeyCodec, collections.Join(barDenom, addr))
+	require.NoError(err)
+	store.Set(barIndexKey, []byte{})
+	require.Equal(sdk.NewCoins(newFooCoin(100), newBarCoin(50)), suite.bankKeeper.GetAllBalances(ctx, addr))
+	var holders []sdk.AccAddress
+	require.NoError(suite.bankKeeper.Balances.Indexes.Denom.Walk(ctx, collections.NewPrefixedPairRange[string, sdk.AccAddress](barDenom), func(_ string, holder sdk.AccAddress) (bool, error) {
+		holders = append(holders, holder)
+		return false, nil
+	}))
+	require.Equal([]sdk.AccAddress{addr}, holders)
+}
+
 func (suite *KeeperTestSuite) TestSendCoinsNewAccount() {
 	ctx := suite.ctx
 	require := suite.Require()
@@ -41,6 +41,20 @@ var (
 	ParamsKey = collections.NewPrefix(5)
 )
 
+// KVStore key codecs, for the clients accessing the store without a keeper,
+// e.g. the balance overrides of the tx simulations.
+var (
+	// SupplyKeyCodec is the key codec of the supply store, keyed by denom.
+	SupplyKeyCodec = collections.StringKey.WithName("denom")
+	// BalancesKeyCodec is the key codec of the balances store, keyed by address
+	// and denom.
+	BalancesKeyCodec = collections.NamedPairKeyCodec("address", sdk.AccAddressKey, "denom", collections.StringKey)
+	// DenomAddressKeyCodec is the key codec of the denom to address index of the
+	// balances, keyed by denom and address. The addresses are length prefixed
+	// for backwards compatibility.
+	DenomAddressKeyCodec = collections.PairKeyCodec(collections.StringKey, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)) //nolint:staticcheck // Note: refer to the LengthPrefixedAddressKey docs to understand why we do this.
+)
+
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
 // Historically, balances were represented as Coin, now they're represented as a simple math.Int
 var BalanceValueCodec = collcodec.NewAltValueCodec(sdk.IntValue, func(bytes []byte) (math.Int, error) {
@@ -12,11 +12,6 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// TODO: Revisit this once we have proper gas fee framework.
-// Ref: https://github.com/cosmos/cosmos-sdk/issues/9054
-// Ref: https://github.com/cosmos/cosmos-sdk/discussions/9072
-const gasCostPerIteration = uint64(10)
-
 // NewSendAuthorization creates a new SendAuthorization object.
 func NewSendAuthorization(spendLimit sdk.Coins, allowed []sdk.AccAddress, addressCodec address.Codec) *SendAuthorization {
 	return &SendAuthorization{
@@ -51,7 +46,7 @@ func (a SendAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.Accep
 	toAddr := mSend.ToAddress
 	allowedList := a.GetAllowList()
 	for _, addr := range allowedList {
-		if err := authzEnv.GasService.GasMeter(ctx).Consume(gasCostPerIteration, "send authorization"); err != nil {
+		if err := authzEnv.GasService.GasMeter(ctx).Consume(authz.GasCostPerIteration, "send authorization"); err != nil {
 			return authz.AcceptResponse{}, err
 		}
 
@@ -85,6 +85,20 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the amount of coins in the community pool",
 					Example:   fmt.Sprintf(`$ %s query distribution community-pool`, version.AppName),
 				},
+				{
+					RpcMethod: "AutoCompoundSettings",
+					Use:       "auto-compound-settings <delegator-addr>",
+					Short:     "Query the auto-compounding settings of a delegator",
+					Example:   fmt.Sprintf("$ %s query distribution auto-compound-settings [delegator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
+				{
+					RpcMethod: "AutoCompoundRounds",
+					Use:       "auto-compound-rounds",
+					Short:     "Query the auto-compounding rounds that are still being processed",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -125,6 +139,39 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "amount", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "SetAutoCompound",
+					Use:       "set-auto-compound <epoch-identifier> [validator-addr]",
+					Short:     "Automatically restake the rewards of a delegation (or of all delegations) at the end of every epoch",
+					Example:   fmt.Sprintf("%s tx distribution set-auto-compound day cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "epoch_identifier"},
+						{ProtoField: "validator_address", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "RemoveAutoCompound",
+					Use:       "remove-auto-compound [validator-addr]",
+					Short:     "Stop automatically restaking the rewards of a delegation (or of all delegations)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "WithdrawTokenizeShareRecordReward",
+					Use:       "withdraw-tokenize-share-rewards <record-id>",
+					Short:     "Withdraw the rewards of the delegation of a tokenize share record you own",
+					Example:   fmt.Sprintf("%s tx distribution withdraw-tokenize-share-rewards 1 --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "record_id"},
+					},
+				},
+				{
+					RpcMethod: "WithdrawAllTokenizeShareRecordReward",
+					Use:       "withdraw-all-tokenize-share-rewards",
+					Short:     "Withdraw the rewards of the delegations of all the tokenize share records you own",
+					Example:   fmt.Sprintf("%s tx distribution withdraw-all-tokenize-share-rewards --from mykey", version.AppName),
+				},
 				{
 					RpcMethod:  "FundCommunityPool",
 					Deprecated: fmt.Sprintf("Use %s tx protocolpool fund-community-pool", version.AppName),
@@ -0,0 +1,29 @@
+package distribution
+
+import (
+	"context"
+
+	epochstypes "cosmossdk.io/x/epochs/types"
+)
+
+var (
+	_ epochstypes.EpochHooks        = AppModule{}
+	_ epochstypes.EpochRemovalHooks = AppModule{}
+)
+
+// BeforeEpochStart is a noop
+func (am AppModule) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
+
+// AfterEpochEnd queues the compounding of the rewards of the delegators which
+// opted in for the epoch identifier.
+func (am AppModule) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	return am.keeper.StartAutoCompoundRound(ctx, epochIdentifier, epochNumber)
+}
+
+// AfterEpochRemoved deletes the auto-compound settings and round of the
+// removed epoch identifier, which would never be processed again.
+func (am AppModule) AfterEpochRemoved(ctx context.Context, epochIdentifier string) error {
+	return am.keeper.RemoveAutoCompoundEpoch(ctx, epochIdentifier)
+}
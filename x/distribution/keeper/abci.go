@@ -37,5 +37,5 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 		}
 	}
 
-	return nil
+	return k.ProcessAutoCompoundQueue(ctx)
 }
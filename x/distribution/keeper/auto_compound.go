@@ -0,0 +1,421 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/distribution/types"
+	epochstypes "cosmossdk.io/x/epochs/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAutoCompoundSetting opts the delegator in to the re-delegation of the
+// rewards of its delegation to valAddr, or of all its delegations if valAddr
+// is empty, at the end of each epoch of the given identifier. Updating an
+// existing setting keeps its compounding history.
+func (k Keeper) SetAutoCompoundSetting(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, epochIdentifier string) error {
+	if epochIdentifier == "" {
+		return types.ErrEmptyEpochIdentifier
+	}
+	if _, err := k.QueryRouterService.Invoke(ctx, &epochstypes.QueryCurrentEpochRequest{Identifier: epochIdentifier}); err != nil {
+		return errorsmod.Wrapf(types.ErrUnknownEpochIdentifier, "%s: %s", epochIdentifier, err)
+	}
+
+	key := collections.Join(delAddr, valAddr)
+	setting, err := k.DelegatorsAutoCompound.Get(ctx, key)
+	switch {
+	case err == nil:
+		if err := k.AutoCompoundByEpoch.Remove(ctx, collections.Join3(setting.EpochIdentifier, delAddr, valAddr)); err != nil {
+			return err
+		}
+	case errors.Is(err, collections.ErrNotFound):
+		delegator, err := k.addrCdc.BytesToString(delAddr)
+		if err != nil {
+			return err
+		}
+		validator, err := k.validatorAddressToString(valAddr)
+		if err != nil {
+			return err
+		}
+		setting = types.AutoCompoundSetting{DelegatorAddress: delegator, ValidatorAddress: validator}
+	default:
+		return err
+	}
+
+	setting.EpochIdentifier = epochIdentifier
+	return k.setAutoCompoundSetting(ctx, delAddr, valAddr, setting)
+}
+
+// RemoveAutoCompoundSetting opts the delegator out of the re-delegation of
+// the rewards of its delegation to valAddr, or of all its delegations if
+// valAddr is empty.
+func (k Keeper) RemoveAutoCompoundSetting(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	key := collections.Join(delAddr, valAddr)
+	setting, err := k.DelegatorsAutoCompound.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ErrNoAutoCompoundSetting
+		}
+		return err
+	}
+
+	if err := k.AutoCompoundByEpoch.Remove(ctx, collections.Join3(setting.EpochIdentifier, delAddr, valAddr)); err != nil {
+		return err
+	}
+	return k.DelegatorsAutoCompound.Remove(ctx, key)
+}
+
+// RemoveAutoCompoundEpoch deletes the settings of the removed epoch
+// identifier, and its round if one is in progress.
+func (k Keeper) RemoveAutoCompoundEpoch(ctx context.Context, epochIdentifier string) error {
+	iter, err := k.AutoCompoundByEpoch.Iterate(ctx, collections.NewPrefixedTripleRange[string, sdk.AccAddress, sdk.ValAddress](epochIdentifier))
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.AutoCompoundByEpoch.Remove(ctx, key); err != nil {
+			return err
+		}
+		if err := k.DelegatorsAutoCompound.Remove(ctx, collections.Join(key.K2(), key.K3())); err != nil {
+			return err
+		}
+	}
+
+	return k.AutoCompoundQueue.Remove(ctx, epochIdentifier)
+}
+
+// StartAutoCompoundRound queues the compounding of the rewards of the
+// delegators which opted in for the epoch identifier. If the previous round
+// of the identifier is still in progress, it continues with the new epoch
+// number.
+func (k Keeper) StartAutoCompoundRound(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	round, err := k.AutoCompoundQueue.Get(ctx, epochIdentifier)
+	switch {
+	case err == nil:
+		round.EpochNumber = epochNumber
+		return k.AutoCompoundQueue.Set(ctx, epochIdentifier, round)
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	// skip the epochs no delegator compounds at
+	iter, err := k.AutoCompoundByEpoch.Iterate(ctx, collections.NewPrefixedTripleRange[string, sdk.AccAddress, sdk.ValAddress](epochIdentifier))
+	if err != nil {
+		return err
+	}
+	hasSettings := iter.Valid()
+	if err := iter.Close(); err != nil {
+		return err
+	}
+	if !hasSettings {
+		return nil
+	}
+
+	return k.AutoCompoundQueue.Set(ctx, epochIdentifier, types.AutoCompoundRound{
+		EpochIdentifier: epochIdentifier,
+		EpochNumber:     epochNumber,
+	})
+}
+
+// ProcessAutoCompoundQueue compounds the rewards of the queued rounds, up to
+// the AutoCompoundBatchSize param of delegations per block. A round spanning
+// more delegations is resumed in the next block.
+func (k Keeper) ProcessAutoCompoundQueue(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	var rounds []types.AutoCompoundRound
+	err = k.AutoCompoundQueue.Walk(ctx, nil, func(_ string, round types.AutoCompoundRound) (stop bool, err error) {
+		rounds = append(rounds, round)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	budget := int(params.AutoCompoundBatchSize)
+	for _, round := range rounds {
+		if budget <= 0 {
+			break
+		}
+
+		budget, err = k.processAutoCompoundRound(ctx, round, budget)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// processAutoCompoundRound compounds the settings of the round from its
+// cursor on, and returns the budget left once the round is completed or the
+// budget is exhausted. Every delegation compounded costs one unit of the
+// budget, the delegations of a setting covering all the delegations of its
+// delegator being spread over as many blocks as needed.
+func (k Keeper) processAutoCompoundRound(ctx context.Context, round types.AutoCompoundRound, budget int) (int, error) {
+	rng := new(collections.Range[collections.Triple[string, sdk.AccAddress, sdk.ValAddress]]).
+		Prefix(collections.TriplePrefix[string, sdk.AccAddress, sdk.ValAddress](round.EpochIdentifier))
+	var cursor collections.Triple[string, sdk.AccAddress, sdk.ValAddress]
+	if round.NextDelegatorAddress != "" {
+		delAddr, err := k.addrCdc.StringToBytes(round.NextDelegatorAddress)
+		if err != nil {
+			return 0, err
+		}
+		valAddr, err := k.validatorAddressFromString(round.NextValidatorAddress)
+		if err != nil {
+			return 0, err
+		}
+		cursor = collections.Join3(round.EpochIdentifier, sdk.AccAddress(delAddr), valAddr)
+		rng.StartInclusive(cursor)
+	}
+	nextDelegation, err := k.validatorAddressFromString(round.NextDelegationValidatorAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	// collect the keys first as compounding writes to the store, every setting
+	// costs at least one unit of the budget and the last key is the next cursor.
+	iter, err := k.AutoCompoundByEpoch.Iterate(ctx, rng)
+	if err != nil {
+		return 0, err
+	}
+	var keys []collections.Triple[string, sdk.AccAddress, sdk.ValAddress]
+	for ; iter.Valid() && len(keys) <= budget; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			_ = iter.Close()
+			return 0, err
+		}
+		keys = append(keys, key)
+	}
+	if err := iter.Close(); err != nil {
+		return 0, err
+	}
+
+	for _, key := range keys {
+		if budget <= 0 {
+			return 0, k.setAutoCompoundCursor(ctx, round, key, nil)
+		}
+
+		// the delegations of the setting at the cursor resume where the
+		// previous block stopped, if it was removed they are skipped
+		var start sdk.ValAddress
+		if len(nextDelegation) > 0 && bytes.Equal(key.K2(), cursor.K2()) && bytes.Equal(key.K3(), cursor.K3()) {
+			start = nextDelegation
+		}
+
+		processed, next, err := k.autoCompound(ctx, key.K2(), key.K3(), round.EpochNumber, start, budget)
+		if err != nil {
+			return 0, err
+		}
+		budget -= processed
+		if len(next) > 0 {
+			return 0, k.setAutoCompoundCursor(ctx, round, key, next)
+		}
+	}
+
+	return budget, k.AutoCompoundQueue.Remove(ctx, round.EpochIdentifier)
+}
+
+// setAutoCompoundCursor saves the position the round resumes from in the next
+// block: the setting at key and, for a setting covering all the delegations
+// of its delegator, the validator of its next delegation.
+func (k Keeper) setAutoCompoundCursor(ctx context.Context, round types.AutoCompoundRound, key collections.Triple[string, sdk.AccAddress, sdk.ValAddress], nextDelegation sdk.ValAddress) error {
+	delegator, err := k.addrCdc.BytesToString(key.K2())
+	if err != nil {
+		return err
+	}
+	validator, err := k.validatorAddressToString(key.K3())
+	if err != nil {
+		return err
+	}
+	delegationValidator, err := k.validatorAddressToString(nextDelegation)
+	if err != nil {
+		return err
+	}
+
+	round.NextDelegatorAddress, round.NextValidatorAddress = delegator, validator
+	round.NextDelegationValidatorAddress = delegationValidator
+	return k.AutoCompoundQueue.Set(ctx, round.EpochIdentifier, round)
+}
+
+// autoCompound re-delegates the rewards of the delegations of a setting, from
+// the delegation to start on for a setting covering all the delegations of
+// its delegator, and up to budget delegations. It returns the number of
+// delegations processed, and the validator of the next delegation to process
+// if the budget was exhausted first. Failing delegations are reported in
+// events and do not abort the processing.
+func (k Keeper) autoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, epochNumber int64, start sdk.ValAddress, budget int) (int, sdk.ValAddress, error) {
+	setting, err := k.DelegatorsAutoCompound.Get(ctx, collections.Join(delAddr, valAddr))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !bytes.Equal(withdrawAddr, delAddr) {
+		// the rewards are withdrawn to another account, there is nothing to re-delegate
+		if err := k.emitAutoCompoundFailed(ctx, setting.DelegatorAddress, setting.ValidatorAddress, epochNumber, "rewards are withdrawn to another address"); err != nil {
+			return 0, nil, err
+		}
+		return 1, nil, k.setLastAutoCompoundEpoch(ctx, delAddr, valAddr, setting, epochNumber)
+	}
+
+	validators := []sdk.ValAddress{valAddr}
+	var next sdk.ValAddress
+	if len(valAddr) == 0 {
+		validators = nil
+		var decodeErr error
+		err := k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del sdk.DelegationI) (stop bool) {
+			addr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(del.GetValidatorAddr())
+			if err != nil {
+				decodeErr = err
+				return true
+			}
+			if bytes.Compare(addr, start) >= 0 {
+				validators = append(validators, addr)
+			}
+			return false
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		if decodeErr != nil {
+			return 0, nil, decodeErr
+		}
+
+		// the delegations are processed by validator address, so that the
+		// ones left when the budget is exhausted are the ones from next on
+		slices.SortFunc(validators, func(a, b sdk.ValAddress) int { return bytes.Compare(a, b) })
+		if len(validators) > budget {
+			next = validators[budget]
+			validators = validators[:budget]
+		}
+		if len(validators) == 0 {
+			return 1, nil, k.setLastAutoCompoundEpoch(ctx, delAddr, valAddr, setting, epochNumber)
+		}
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	for _, val := range validators {
+		validator, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(val)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		var compounded sdk.Coin
+		err = k.BranchService.Execute(ctx, func(ctx context.Context) error {
+			rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, val)
+			if err != nil {
+				return err
+			}
+
+			compounded = sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom))
+			if !compounded.IsPositive() {
+				return nil
+			}
+
+			_, err = k.MsgRouterService.Invoke(ctx, &stakingtypes.MsgDelegate{
+				DelegatorAddress: setting.DelegatorAddress,
+				ValidatorAddress: validator,
+				Amount:           compounded,
+			})
+			return err
+		})
+		if err != nil {
+			k.Logger.Error("failed to auto-compound rewards", "delegator", setting.DelegatorAddress, "validator", validator, "err", err)
+			if err := k.emitAutoCompoundFailed(ctx, setting.DelegatorAddress, validator, epochNumber, err.Error()); err != nil {
+				return 0, nil, err
+			}
+			continue
+		}
+		if !compounded.IsPositive() {
+			continue
+		}
+
+		setting.TotalCompounded = setting.TotalCompounded.Add(compounded)
+		err = k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeAutoCompound,
+			event.NewAttribute(types.AttributeKeyDelegator, setting.DelegatorAddress),
+			event.NewAttribute(types.AttributeKeyValidator, validator),
+			event.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatInt(epochNumber, 10)),
+			event.NewAttribute(sdk.AttributeKeyAmount, compounded.String()),
+		)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	if len(next) > 0 {
+		// the setting is only complete for the epoch once all its delegations are processed
+		return len(validators), next, k.DelegatorsAutoCompound.Set(ctx, collections.Join(delAddr, valAddr), setting)
+	}
+	return len(validators), nil, k.setLastAutoCompoundEpoch(ctx, delAddr, valAddr, setting, epochNumber)
+}
+
+// setLastAutoCompoundEpoch records the epoch at which the setting was last processed.
+func (k Keeper) setLastAutoCompoundEpoch(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, setting types.AutoCompoundSetting, epochNumber int64) error {
+	setting.LastEpochNumber = epochNumber
+	return k.DelegatorsAutoCompound.Set(ctx, collections.Join(delAddr, valAddr), setting)
+}
+
+func (k Keeper) emitAutoCompoundFailed(ctx context.Context, delegator, validator string, epochNumber int64, reason string) error {
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeAutoCompoundFailed,
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(types.AttributeKeyValidator, validator),
+		event.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatInt(epochNumber, 10)),
+		event.NewAttribute(types.AttributeKeyError, reason),
+	)
+}
+
+func (k Keeper) setAutoCompoundSetting(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, setting types.AutoCompoundSetting) error {
+	if err := k.DelegatorsAutoCompound.Set(ctx, collections.Join(delAddr, valAddr), setting); err != nil {
+		return err
+	}
+	return k.AutoCompoundByEpoch.Set(ctx, collections.Join3(setting.EpochIdentifier, delAddr, valAddr))
+}
+
+// validatorAddressToString encodes the validator of an auto-compound setting,
+// which is empty for the settings covering all the delegations of a delegator.
+func (k Keeper) validatorAddressToString(valAddr sdk.ValAddress) (string, error) {
+	if len(valAddr) == 0 {
+		return "", nil
+	}
+	return k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+}
+
+// validatorAddressFromString decodes the validator of an auto-compound setting.
+func (k Keeper) validatorAddressFromString(validator string) (sdk.ValAddress, error) {
+	if validator == "" {
+		return sdk.ValAddress{}, nil
+	}
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, fmt.Sprintf("invalid validator address %s", validator))
+	}
+	return valAddr, nil
+}
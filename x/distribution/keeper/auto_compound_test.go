@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/distribution/keeper"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAutoCompoundSettings(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+	valAddr := sdk.ValAddress(addrs[1])
+
+	require.ErrorIs(t, distrKeeper.SetAutoCompoundSetting(ctx, addrs[0], valAddr, ""), types.ErrEmptyEpochIdentifier)
+	require.ErrorIs(t, distrKeeper.SetAutoCompoundSetting(ctx, addrs[0], valAddr, "hour"), types.ErrUnknownEpochIdentifier)
+	require.ErrorIs(t, distrKeeper.RemoveAutoCompoundSetting(ctx, addrs[0], valAddr), types.ErrNoAutoCompoundSetting)
+
+	// a setting for a single delegation and one for all the delegations
+	require.NoError(t, distrKeeper.SetAutoCompoundSetting(ctx, addrs[0], valAddr, "day"))
+	require.NoError(t, distrKeeper.SetAutoCompoundSetting(ctx, addrs[0], sdk.ValAddress{}, "day"))
+
+	setting, err := distrKeeper.DelegatorsAutoCompound.Get(ctx, collections.Join(addrs[0], sdk.ValAddress{}))
+	require.NoError(t, err)
+	require.Equal(t, "", setting.ValidatorAddress)
+	require.Equal(t, "day", setting.EpochIdentifier)
+
+	// changing the epoch moves the setting in the epoch index
+	require.NoError(t, distrKeeper.SetAutoCompoundSetting(ctx, addrs[0], valAddr, "week"))
+	has, err := distrKeeper.AutoCompoundByEpoch.Has(ctx, collections.Join3("day", addrs[0], valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = distrKeeper.AutoCompoundByEpoch.Has(ctx, collections.Join3("week", addrs[0], valAddr))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	require.NoError(t, distrKeeper.RemoveAutoCompoundSetting(ctx, addrs[0], valAddr))
+	has, err = distrKeeper.DelegatorsAutoCompound.Has(ctx, collections.Join(addrs[0], valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = distrKeeper.AutoCompoundByEpoch.Has(ctx, collections.Join3("week", addrs[0], valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestProcessAutoCompoundQueue(t *testing.T) {
+	ctx, _, distrKeeper, _ := initFixture(t)
+	delegators := simtestutil.CreateIncrementalAccounts(5)
+	withdrawAddr := sdk.AccAddress("withdraw____________")
+
+	params, err := distrKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.AutoCompoundBatchSize = 2
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// the rewards of the delegators are withdrawn to another address, so
+	// every setting is processed without compounding anything
+	for _, delAddr := range delegators {
+		require.NoError(t, distrKeeper.DelegatorsWithdrawAddress.Set(ctx, delAddr, withdrawAddr))
+		require.NoError(t, distrKeeper.SetAutoCompoundSetting(ctx, delAddr, sdk.ValAddress{}, "day"))
+	}
+
+	// no round is queued for an epoch without settings
+	require.NoError(t, distrKeeper.StartAutoCompoundRound(ctx, "week", 1))
+	has, err := distrKeeper.AutoCompoundQueue.Has(ctx, "week")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, distrKeeper.StartAutoCompoundRound(ctx, "day", 1))
+	expectedLastEpochs := []int64{1, 1, 0, 0, 0}
+	require.NoError(t, distrKeeper.ProcessAutoCompoundQueue(ctx))
+	requireLastEpochNumbers(t, ctx, distrKeeper, delegators, expectedLastEpochs)
+
+	round, err := distrKeeper.AutoCompoundQueue.Get(ctx, "day")
+	require.NoError(t, err)
+	require.Equal(t, delegators[2].String(), round.NextDelegatorAddress)
+	require.Equal(t, "", round.NextValidatorAddress)
+
+	// a new epoch ending before the round completes continues the round
+	require.NoError(t, distrKeeper.StartAutoCompoundRound(ctx, "day", 2))
+	expectedLastEpochs = []int64{1, 1, 2, 2, 0}
+	require.NoError(t, distrKeeper.ProcessAutoCompoundQueue(ctx))
+	requireLastEpochNumbers(t, ctx, distrKeeper, delegators, expectedLastEpochs)
+
+	expectedLastEpochs = []int64{1, 1, 2, 2, 2}
+	require.NoError(t, distrKeeper.ProcessAutoCompoundQueue(ctx))
+	requireLastEpochNumbers(t, ctx, distrKeeper, delegators, expectedLastEpochs)
+	has, err = distrKeeper.AutoCompoundQueue.Has(ctx, "day")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.Equal(t, len(delegators), countEvents(ctx, types.EventTypeAutoCompoundFailed))
+}
+
+func TestProcessAutoCompoundQueueAllDelegations(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	delAddr := addrs[0]
+	validators := []sdk.ValAddress{sdk.ValAddress("val3________________"), sdk.ValAddress("val1________________"), sdk.ValAddress("val2________________")}
+
+	params, err := distrKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.AutoCompoundBatchSize = 2
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// the delegator compounds its 3 delegations, whose rewards cannot be
+	// withdrawn as the validators are unknown to the mocked staking keeper
+	dep.stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), delAddr, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ sdk.AccAddress, fn func(int64, sdk.DelegationI) bool) error {
+			for i, val := range validators {
+				valAddr, err := dep.stakingKeeper.ValidatorAddressCodec().BytesToString(val)
+				require.NoError(t, err)
+				if fn(int64(i), stakingtypes.Delegation{ValidatorAddress: valAddr}) {
+					break
+				}
+			}
+			return nil
+		}).AnyTimes()
+	dep.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), gomock.Any()).Return(nil, stakingtypes.ErrNoValidatorFound).AnyTimes()
+	require.NoError(t, distrKeeper.SetAutoCompoundSetting(ctx, delAddr, sdk.ValAddress{}, "day"))
+	require.NoError(t, distrKeeper.StartAutoCompoundRound(ctx, "day", 1))
+
+	// the setting spans more delegations than the batch size, the round
+	// resumes from its next delegation in the next block
+	require.NoError(t, distrKeeper.ProcessAutoCompoundQueue(ctx))
+	round, err := distrKeeper.AutoCompoundQueue.Get(ctx, "day")
+	require.NoError(t, err)
+	require.Equal(t, delAddr.String(), round.NextDelegatorAddress)
+	require.Equal(t, "", round.NextValidatorAddress)
+	nextVal, err := dep.stakingKeeper.ValidatorAddressCodec().BytesToString(validators[0])
+	require.NoError(t, err)
+	require.Equal(t, nextVal, round.NextDelegationValidatorAddress)
+	requireLastEpochNumbers(t, ctx, distrKeeper, []sdk.AccAddress{delAddr}, []int64{0})
+	require.Equal(t, 2, countEvents(ctx, types.EventTypeAutoCompoundFailed))
+
+	require.NoError(t, distrKeeper.ProcessAutoCompoundQueue(ctx))
+	has, err := distrKeeper.AutoCompoundQueue.Has(ctx, "day")
+	require.NoError(t, err)
+	require.False(t, has)
+	requireLastEpochNumbers(t, ctx, distrKeeper, []sdk.AccAddress{delAddr}, []int64{1})
+	require.Equal(t, 3, countEvents(ctx, types.EventTypeAutoCompoundFailed))
+}
+
+func TestRemoveAutoCompoundEpoch(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+	withdrawAddr := sdk.AccAddress("withdraw____________")
+	valAddr := sdk.ValAddress(addrs[1])
+
+	require.NoError(t, distrKeeper.DelegatorsWithdrawAddress.Set(ctx, addrs[0], withdrawAddr))
+	require.NoError(t, distrKeeper.SetAutoCompoundSetting(ctx, addrs[0], sdk.ValAddress{}, "day"))
+	require.NoError(t, distrKeeper.SetAutoCompoundSetting(ctx, addrs[0], valAddr, "week"))
+	require.NoError(t, distrKeeper.StartAutoCompoundRound(ctx, "day", 1))
+
+	// removing the epoch deletes its settings and round only
+	require.NoError(t, distrKeeper.RemoveAutoCompoundEpoch(ctx, "day"))
+	has, err := distrKeeper.AutoCompoundQueue.Has(ctx, "day")
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = distrKeeper.DelegatorsAutoCompound.Has(ctx, collections.Join(addrs[0], sdk.ValAddress{}))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = distrKeeper.AutoCompoundByEpoch.Has(ctx, collections.Join3("day", addrs[0], sdk.ValAddress{}))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = distrKeeper.DelegatorsAutoCompound.Has(ctx, collections.Join(addrs[0], valAddr))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func countEvents(ctx sdk.Context, eventType string) int {
+	count := 0
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == eventType {
+			count++
+		}
+	}
+	return count
+}
+
+func requireLastEpochNumbers(t *testing.T, ctx sdk.Context, distrKeeper keeper.Keeper, delegators []sdk.AccAddress, expected []int64) {
+	t.Helper()
+
+	for i, delAddr := range delegators {
+		setting, err := distrKeeper.DelegatorsAutoCompound.Get(ctx, collections.Join(delAddr, sdk.ValAddress{}))
+		require.NoError(t, err)
+		require.Equal(t, expected[i], setting.LastEpochNumber, "delegator %d", i)
+	}
+}
@@ -1,7 +1,14 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/core/router"
+	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/x/distribution/types"
+	epochstypes "cosmossdk.io/x/epochs/types"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,3 +28,20 @@ var (
 
 	distrAcc = authtypes.NewEmptyModuleAccount(types.ModuleName)
 )
+
+// mockEpochsRouter answers the x/epochs current epoch queries of the given identifiers.
+type mockEpochsRouter struct {
+	router.Service
+	identifiers []string
+}
+
+func (r mockEpochsRouter) Invoke(_ context.Context, req transaction.Msg) (transaction.Msg, error) {
+	query, ok := req.(*epochstypes.QueryCurrentEpochRequest)
+	if !ok {
+		return nil, errors.New("unexpected request")
+	}
+	if !slices.Contains(r.identifiers, query.Identifier) {
+		return nil, errors.New("not available identifier")
+	}
+	return &epochstypes.QueryCurrentEpochResponse{CurrentEpoch: 1}, nil
+}
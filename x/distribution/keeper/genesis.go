@@ -113,6 +113,26 @@ func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) error
 		}
 	}
 
+	for _, setting := range data.AutoCompoundSettings {
+		delegatorAddress, err := k.addrCdc.StringToBytes(setting.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		valAddr, err := k.validatorAddressFromString(setting.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.setAutoCompoundSetting(ctx, delegatorAddress, valAddr, setting); err != nil {
+			return err
+		}
+	}
+	for _, round := range data.AutoCompoundRounds {
+		if err := k.AutoCompoundQueue.Set(ctx, round.EpochIdentifier, round); err != nil {
+			return err
+		}
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.DecimalPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -282,5 +302,26 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
-	return types.NewGenesisState(params, feePool, dwi, outstanding, acc, his, cur, dels, slashes), nil
+	autoCompoundSettings := make([]types.AutoCompoundSetting, 0)
+	err = k.DelegatorsAutoCompound.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.ValAddress], setting types.AutoCompoundSetting) (stop bool, err error) {
+		autoCompoundSettings = append(autoCompoundSettings, setting)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	autoCompoundRounds := make([]types.AutoCompoundRound, 0)
+	err = k.AutoCompoundQueue.Walk(ctx, nil, func(_ string, round types.AutoCompoundRound) (stop bool, err error) {
+		autoCompoundRounds = append(autoCompoundRounds, round)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.NewGenesisState(params, feePool, dwi, outstanding, acc, his, cur, dels, slashes)
+	genState.AutoCompoundSettings = autoCompoundSettings
+	genState.AutoCompoundRounds = autoCompoundRounds
+	return genState, nil
 }
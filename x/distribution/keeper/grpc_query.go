@@ -382,3 +382,46 @@ func (k Querier) CommunityPool(ctx context.Context, req *types.QueryCommunityPoo
 
 	return &types.QueryCommunityPoolResponse{Pool: sdk.NewDecCoinsFromCoins(balances...)}, nil
 }
+
+// AutoCompoundSettings queries the auto-compounding settings of a delegator
+func (k Querier) AutoCompoundSettings(ctx context.Context, req *types.QueryAutoCompoundSettingsRequest) (*types.QueryAutoCompoundSettingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delAdr, err := k.addrCdc.StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, pageRes, err := query.CollectionPaginate(ctx, k.DelegatorsAutoCompound, req.Pagination, func(_ collections.Pair[sdk.AccAddress, sdk.ValAddress], setting types.AutoCompoundSetting) (types.AutoCompoundSetting, error) {
+		return setting, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.ValAddress](delAdr))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAutoCompoundSettingsResponse{Settings: settings, Pagination: pageRes}, nil
+}
+
+// AutoCompoundRounds queries the auto-compounding rounds in progress
+func (k Querier) AutoCompoundRounds(ctx context.Context, req *types.QueryAutoCompoundRoundsRequest) (*types.QueryAutoCompoundRoundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	rounds := []types.AutoCompoundRound{}
+	err := k.AutoCompoundQueue.Walk(ctx, nil, func(_ string, round types.AutoCompoundRound) (stop bool, err error) {
+		rounds = append(rounds, round)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAutoCompoundRoundsResponse{Rounds: rounds}, nil
+}
@@ -54,6 +54,13 @@ type Keeper struct {
 	ValidatorHistoricalRewards collections.Map[collections.Pair[sdk.ValAddress, uint64], types.ValidatorHistoricalRewards]
 	// ValidatorSlashEvents key: valAddr+height+period | value: ValidatorSlashEvent
 	ValidatorSlashEvents collections.Map[collections.Triple[sdk.ValAddress, uint64, uint64], types.ValidatorSlashEvent]
+	// DelegatorsAutoCompound key: delAddr+valAddr | value: AutoCompoundSetting
+	// An empty valAddr compounds all the delegations of the delegator.
+	DelegatorsAutoCompound collections.Map[collections.Pair[sdk.AccAddress, sdk.ValAddress], types.AutoCompoundSetting]
+	// AutoCompoundByEpoch key: epochIdentifier+delAddr+valAddr | value: none
+	AutoCompoundByEpoch collections.KeySet[collections.Triple[string, sdk.AccAddress, sdk.ValAddress]]
+	// AutoCompoundQueue key: epochIdentifier | value: AutoCompoundRound
+	AutoCompoundQueue collections.Map[string, types.AutoCompoundRound]
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -153,6 +160,38 @@ func NewKeeper(
 			),
 			codec.CollValue[types.ValidatorSlashEvent](cdc),
 		),
+		DelegatorsAutoCompound: collections.NewMap(
+			sb,
+			types.AutoCompoundSettingPrefix,
+			"delegators_auto_compound",
+			collections.NamedPairKeyCodec(
+				"delegator_address",
+				sdk.AccAddressKey,
+				"validator_address",
+				sdk.ValAddressKey,
+			),
+			codec.CollValue[types.AutoCompoundSetting](cdc),
+		),
+		AutoCompoundByEpoch: collections.NewKeySet(
+			sb,
+			types.AutoCompoundByEpochPrefix,
+			"auto_compound_by_epoch",
+			collections.NamedTripleKeyCodec(
+				"epoch_identifier",
+				collections.StringKey,
+				"delegator_address",
+				sdk.AccAddressKey,
+				"validator_address",
+				sdk.ValAddressKey,
+			),
+		),
+		AutoCompoundQueue: collections.NewMap(
+			sb,
+			types.AutoCompoundRoundPrefix,
+			"auto_compound_queue",
+			collections.StringKey,
+			codec.CollValue[types.AutoCompoundRound](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
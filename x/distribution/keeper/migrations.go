@@ -40,6 +40,19 @@ func (m Migrator) Migrate3to4(ctx context.Context) error {
 	return m.migrateFunds(ctx)
 }
 
+// Migrate4to5 migrates the x/distribution module state from the consensus
+// version 4 to version 5. It sets the auto-compounding batch size param to its
+// default.
+func (m Migrator) Migrate4to5(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.AutoCompoundBatchSize = types.DefaultAutoCompoundBatchSize
+	return m.keeper.Params.Set(ctx, params)
+}
+
 func (m Migrator) migrateFunds(ctx context.Context) error {
 	macc := m.keeper.GetDistributionAccount(ctx)
 	poolMacc := m.keeper.authKeeper.GetModuleAccount(ctx, types.ProtocolPoolDistrAccount)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/errors"
 	"cosmossdk.io/x/distribution/types"
 
@@ -182,6 +183,97 @@ func (k msgServer) DepositValidatorRewardsPool(ctx context.Context, msg *types.M
 	return &types.MsgDepositValidatorRewardsPoolResponse{}, nil
 }
 
+func (k msgServer) SetAutoCompound(ctx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	delegatorAddress, err := k.addrCdc.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	valAddr, err := k.validatorAddressFromString(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if len(valAddr) > 0 {
+		delegation, err := k.stakingKeeper.Delegation(ctx, delegatorAddress, valAddr)
+		if err != nil {
+			return nil, err
+		}
+		if delegation == nil {
+			return nil, types.ErrNoDelegationExists
+		}
+	}
+
+	if err := k.SetAutoCompoundSetting(ctx, delegatorAddress, valAddr, msg.EpochIdentifier); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetAutoCompound,
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyEpochIdentifier, msg.EpochIdentifier),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
+func (k msgServer) RemoveAutoCompound(ctx context.Context, msg *types.MsgRemoveAutoCompound) (*types.MsgRemoveAutoCompoundResponse, error) {
+	delegatorAddress, err := k.addrCdc.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	valAddr, err := k.validatorAddressFromString(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := k.RemoveAutoCompoundSetting(ctx, delegatorAddress, valAddr); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRemoveAutoCompound,
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAutoCompoundResponse{}, nil
+}
+
+func (k msgServer) WithdrawTokenizeShareRecordReward(ctx context.Context, msg *types.MsgWithdrawTokenizeShareRecordReward) (*types.MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	ownerAddress, err := k.addrCdc.StringToBytes(msg.OwnerAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	amount, err := k.Keeper.WithdrawTokenizeShareRecordReward(ctx, ownerAddress, msg.RecordId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawTokenizeShareRecordRewardResponse{Amount: amount}, nil
+}
+
+func (k msgServer) WithdrawAllTokenizeShareRecordReward(ctx context.Context, msg *types.MsgWithdrawAllTokenizeShareRecordReward) (*types.MsgWithdrawAllTokenizeShareRecordRewardResponse, error) {
+	ownerAddress, err := k.addrCdc.StringToBytes(msg.OwnerAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	amount, err := k.Keeper.WithdrawAllTokenizeShareRecordReward(ctx, ownerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawAllTokenizeShareRecordRewardResponse{Amount: amount}, nil
+}
+
 func (k *Keeper) validateAuthority(authority string) error {
 	if _, err := k.addrCdc.StringToBytes(authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
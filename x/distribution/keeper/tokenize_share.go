@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegation of
+// the x/staking tokenize share record to its owner. No key controls the record
+// account, so the rewards are withdrawn to it and its whole balance, which also
+// holds the rewards withdrawn when the record delegation was modified, is sent
+// to the owner.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx context.Context, owner sdk.AccAddress, recordID uint64) (sdk.Coins, error) {
+	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerStr, err := k.addrCdc.BytesToString(owner)
+	if err != nil {
+		return nil, err
+	}
+	if record.Owner != ownerStr {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not the owner of tokenize share record %d", ownerStr, recordID)
+	}
+
+	return k.withdrawTokenizeShareRecordReward(ctx, owner, record)
+}
+
+// WithdrawAllTokenizeShareRecordReward withdraws the rewards of the
+// delegations of all the tokenize share records of the owner to the owner.
+func (k Keeper) WithdrawAllTokenizeShareRecordReward(ctx context.Context, owner sdk.AccAddress) (sdk.Coins, error) {
+	records, err := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	total := sdk.NewCoins()
+	for _, record := range records {
+		rewards, err := k.withdrawTokenizeShareRecordReward(ctx, owner, record)
+		if err != nil {
+			return nil, err
+		}
+		total = total.Add(rewards...)
+	}
+
+	return total, nil
+}
+
+func (k Keeper) withdrawTokenizeShareRecordReward(ctx context.Context, owner sdk.AccAddress, record stakingtypes.TokenizeShareRecord) (sdk.Coins, error) {
+	recordAddr, err := k.addrCdc.StringToBytes(record.ModuleAccount)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = k.stakingKeeper.Delegation(ctx, recordAddr, valAddr)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		// the record delegation is gone, only the record account balance is left
+	case err != nil:
+		return nil, err
+	default:
+		if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	balance := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+	if balance.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+	if err := k.bankKeeper.SendCoins(ctx, recordAddr, owner, balance); err != nil {
+		return nil, err
+	}
+
+	return balance, nil
+}
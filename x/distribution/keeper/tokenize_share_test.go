@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/keeper"
+	distrtestutil "cosmossdk.io/x/distribution/testutil"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+	owner := addrs[0]
+	addrCdc := dep.accountKeeper.AddressCodec()
+
+	valAddr := sdk.ValAddress(valConsAddr0)
+	valAddrStr, err := dep.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+	val, err := distrtestutil.CreateValidator(valConsPk0, valAddrStr, math.NewInt(100))
+	require.NoError(t, err)
+
+	// the whole delegation of the validator is held by the record account
+	recordAddr := stakingtypes.TokenizeShareRecordAddress(1)
+	recordAddrStr, err := addrCdc.BytesToString(recordAddr)
+	require.NoError(t, err)
+	ownerStr, err := addrCdc.BytesToString(owner)
+	require.NoError(t, err)
+	record := stakingtypes.TokenizeShareRecord{Id: 1, Owner: ownerStr, ModuleAccount: recordAddrStr, Validator: valAddrStr}
+	del := stakingtypes.NewDelegation(recordAddrStr, valAddrStr, val.DelegatorShares)
+	dep.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), recordAddr, valAddr).Return(del, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().GetTokenizeShareRecord(gomock.Any(), uint64(1)).Return(record, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().GetTokenizeShareRecordsByOwner(gomock.Any(), owner).Return([]stakingtypes.TokenizeShareRecord{record}, nil).AnyTimes()
+
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, recordAddr, valAddr))
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1})
+	rewards := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, rewards)}))
+
+	// only the owner can withdraw the rewards of the record
+	_, err = msgServer.WithdrawTokenizeShareRecordReward(ctx, &types.MsgWithdrawTokenizeShareRecordReward{OwnerAddress: recordAddrStr, RecordId: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the rewards are withdrawn to the record account, whose balance also holds
+	// the rewards withdrawn when its delegation was modified, and sent to the owner
+	withdrawn := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, rewards))
+	balance := withdrawn.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recordAddr, withdrawn)
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), recordAddr).Return(balance)
+	dep.bankKeeper.EXPECT().SendCoins(gomock.Any(), recordAddr, owner, balance)
+	res, err := msgServer.WithdrawTokenizeShareRecordReward(ctx, &types.MsgWithdrawTokenizeShareRecordReward{OwnerAddress: ownerStr, RecordId: 1})
+	require.NoError(t, err)
+	require.Equal(t, balance, res.Amount)
+
+	// nothing is left to withdraw for the records of the owner
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), recordAddr).Return(sdk.NewCoins())
+	allRes, err := msgServer.WithdrawAllTokenizeShareRecordReward(ctx, &types.MsgWithdrawAllTokenizeShareRecordReward{OwnerAddress: ownerStr})
+	require.NoError(t, err)
+	require.True(t, allRes.Amount.IsZero())
+}
@@ -61,6 +61,18 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundSettingPrefix):
+			var settingA, settingB types.AutoCompoundSetting
+			cdc.MustUnmarshal(kvA.Value, &settingA)
+			cdc.MustUnmarshal(kvB.Value, &settingB)
+			return fmt.Sprintf("%v\n%v", settingA, settingB)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundRoundPrefix):
+			var roundA, roundB types.AutoCompoundRound
+			cdc.MustUnmarshal(kvA.Value, &roundA)
+			cdc.MustUnmarshal(kvB.Value, &roundB)
+			return fmt.Sprintf("%v\n%v", roundA, roundB)
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
@@ -17,6 +17,10 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgSetWithdrawAddress{}, "cosmos-sdk/MsgModifyWithdrawAddress")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/distribution/MsgUpdateParams")
 	legacy.RegisterAminoMsg(registrar, &MsgDepositValidatorRewardsPool{}, "cosmos-sdk/distr/MsgDepositValRewards")
+	legacy.RegisterAminoMsg(registrar, &MsgSetAutoCompound{}, "cosmos-sdk/MsgSetAutoCompound")
+	legacy.RegisterAminoMsg(registrar, &MsgRemoveAutoCompound{}, "cosmos-sdk/MsgRemoveAutoCompound")
+	legacy.RegisterAminoMsg(registrar, &MsgWithdrawTokenizeShareRecordReward{}, "cosmos-sdk/MsgWithdrawTokenizeReward")
+	legacy.RegisterAminoMsg(registrar, &MsgWithdrawAllTokenizeShareRecordReward{}, "cosmos-sdk/MsgWithdrawAllTokenizeReward")
 
 	registrar.RegisterConcrete(Params{}, "cosmos-sdk/x/distribution/Params")
 }
@@ -29,6 +33,10 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgSetWithdrawAddress{},
 		&MsgUpdateParams{},
 		&MsgDepositValidatorRewardsPool{},
+		&MsgSetAutoCompound{},
+		&MsgRemoveAutoCompound{},
+		&MsgWithdrawTokenizeShareRecordReward{},
+		&MsgWithdrawAllTokenizeShareRecordReward{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
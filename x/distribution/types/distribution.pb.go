@@ -38,6 +38,9 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_batch_size is the maximum number of delegations whose
+	// rewards are compounded in a block, 0 disables auto-compounding.
+	AutoCompoundBatchSize uint32 `protobuf:"varint,5,opt,name=auto_compound_batch_size,json=autoCompoundBatchSize,proto3" json:"auto_compound_batch_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +83,13 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundBatchSize() uint32 {
+	if m != nil {
+		return m.AutoCompoundBatchSize
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -637,6 +647,179 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// AutoCompoundSetting defines the opt-in of a delegator to the periodic
+// re-delegation of its staking rewards to the same validator.
+type AutoCompoundSetting struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator of the compounded delegation, empty
+	// when all the delegations of the delegator are compounded.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// epoch_identifier is the x/epochs identifier of the epoch at the end of
+	// which the rewards are compounded.
+	EpochIdentifier string `protobuf:"bytes,3,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// last_epoch_number is the number of the last epoch the rewards were
+	// compounded at, 0 if they never were.
+	LastEpochNumber int64 `protobuf:"varint,4,opt,name=last_epoch_number,json=lastEpochNumber,proto3" json:"last_epoch_number,omitempty"`
+	// total_compounded is the total amount of rewards re-delegated.
+	TotalCompounded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_compounded,json=totalCompounded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_compounded"`
+}
+
+func (m *AutoCompoundSetting) Reset()         { *m = AutoCompoundSetting{} }
+func (m *AutoCompoundSetting) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundSetting) ProtoMessage()    {}
+func (*AutoCompoundSetting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *AutoCompoundSetting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundSetting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundSetting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundSetting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundSetting.Merge(m, src)
+}
+func (m *AutoCompoundSetting) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundSetting) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundSetting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundSetting proto.InternalMessageInfo
+
+func (m *AutoCompoundSetting) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *AutoCompoundSetting) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *AutoCompoundSetting) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *AutoCompoundSetting) GetLastEpochNumber() int64 {
+	if m != nil {
+		return m.LastEpochNumber
+	}
+	return 0
+}
+
+func (m *AutoCompoundSetting) GetTotalCompounded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalCompounded
+	}
+	return nil
+}
+
+// AutoCompoundRound tracks the compounding of the settings of an epoch
+// identifier, which is spread over as many blocks as needed to respect
+// the batch size.
+type AutoCompoundRound struct {
+	// epoch_identifier is the x/epochs identifier of the epoch that ended.
+	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// epoch_number is the number of the epoch that ended.
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// next_delegator_address is the delegator of the next setting to process,
+	// empty if the round has not started yet.
+	NextDelegatorAddress string `protobuf:"bytes,3,opt,name=next_delegator_address,json=nextDelegatorAddress,proto3" json:"next_delegator_address,omitempty"`
+	// next_validator_address is the validator of the next setting to process.
+	NextValidatorAddress string `protobuf:"bytes,4,opt,name=next_validator_address,json=nextValidatorAddress,proto3" json:"next_validator_address,omitempty"`
+	// next_delegation_validator_address is the validator of the next delegation
+	// to process when the next setting covers all the delegations of its
+	// delegator, empty to start from its first delegation.
+	NextDelegationValidatorAddress string `protobuf:"bytes,5,opt,name=next_delegation_validator_address,json=nextDelegationValidatorAddress,proto3" json:"next_delegation_validator_address,omitempty"`
+}
+
+func (m *AutoCompoundRound) Reset()         { *m = AutoCompoundRound{} }
+func (m *AutoCompoundRound) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundRound) ProtoMessage()    {}
+func (*AutoCompoundRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{13}
+}
+func (m *AutoCompoundRound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundRound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundRound.Merge(m, src)
+}
+func (m *AutoCompoundRound) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundRound proto.InternalMessageInfo
+
+func (m *AutoCompoundRound) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *AutoCompoundRound) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *AutoCompoundRound) GetNextDelegatorAddress() string {
+	if m != nil {
+		return m.NextDelegatorAddress
+	}
+	return ""
+}
+
+func (m *AutoCompoundRound) GetNextValidatorAddress() string {
+	if m != nil {
+		return m.NextValidatorAddress
+	}
+	return ""
+}
+
+func (m *AutoCompoundRound) GetNextDelegationValidatorAddress() string {
+	if m != nil {
+		return m.NextDelegationValidatorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -650,6 +833,8 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*AutoCompoundSetting)(nil), "cosmos.distribution.v1beta1.AutoCompoundSetting")
+	proto.RegisterType((*AutoCompoundRound)(nil), "cosmos.distribution.v1beta1.AutoCompoundRound")
 }
 
 func init() {
@@ -657,73 +842,88 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x9d, 0xae, 0xa8,
-	0x08, 0x81, 0xd8, 0xa4, 0x95, 0x10, 0xf2, 0x05, 0x35, 0x76, 0x2b, 0x90, 0x0a, 0x44, 0x1b, 0x04,
-	0x12, 0x1c, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0x64, 0x77, 0x66, 0x99, 0x19, 0x3b, 0xc9, 0x09, 0x8e,
-	0x85, 0x03, 0x70, 0x03, 0xf5, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
-	0x01, 0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x04, 0x14,
-	0x82, 0xa2, 0x5e, 0x2c, 0xcf, 0x7b, 0xb3, 0xef, 0xfb, 0xbe, 0x37, 0xef, 0xbd, 0x19, 0x58, 0xf1,
-	0xb9, 0x8c, 0xb8, 0xac, 0x06, 0x54, 0x2a, 0x41, 0x9b, 0x1d, 0x45, 0x39, 0xab, 0x76, 0x97, 0x9b,
-	0x44, 0xe1, 0xe5, 0x23, 0xc6, 0x4a, 0x2c, 0xb8, 0xe2, 0xe8, 0x8a, 0xd9, 0x5f, 0x39, 0xe2, 0xb2,
-	0xfb, 0x8b, 0xd3, 0x2d, 0xde, 0xe2, 0xc9, 0xbe, 0xaa, 0xfe, 0x67, 0x3e, 0x29, 0x96, 0x2c, 0x44,
-	0x13, 0x4b, 0x92, 0x86, 0xf6, 0x39, 0xb5, 0x21, 0x8b, 0x73, 0xc6, 0xef, 0x99, 0x0f, 0x6d, 0x7c,
-	0xe3, 0xba, 0x84, 0x23, 0xca, 0x78, 0x35, 0xf9, 0x35, 0x26, 0xe7, 0xfe, 0x20, 0xcc, 0xaf, 0x62,
-	0x81, 0x23, 0x89, 0x3e, 0x80, 0x17, 0x7d, 0x1e, 0x45, 0x1d, 0x46, 0xd5, 0xb6, 0xa7, 0xf0, 0x56,
-	0x01, 0xcc, 0x83, 0x85, 0xd1, 0x95, 0x57, 0x1e, 0xed, 0x95, 0x73, 0xbf, 0xec, 0x95, 0x2d, 0x55,
-	0x19, 0x6c, 0x54, 0x28, 0xaf, 0x46, 0x58, 0xb5, 0x2b, 0x77, 0x49, 0x0b, 0xfb, 0xdb, 0x0d, 0xe2,
-	0x3f, 0xd9, 0x5d, 0x82, 0x16, 0xa9, 0x41, 0xfc, 0x87, 0x87, 0x3b, 0x8b, 0xc0, 0x1d, 0x4f, 0x83,
-	0xbd, 0x83, 0xb7, 0xd0, 0x87, 0x70, 0x5a, 0x13, 0xd6, 0xac, 0x62, 0x2e, 0x89, 0xf0, 0x04, 0xd9,
-	0xc4, 0x22, 0x28, 0x0c, 0x24, 0x18, 0xaf, 0x9e, 0x0d, 0xa3, 0x00, 0x5c, 0xa4, 0xa3, 0xae, 0xda,
-	0xa0, 0x6e, 0x12, 0x13, 0x85, 0x70, 0xa6, 0xc9, 0x59, 0x47, 0xfe, 0x0d, 0x6c, 0xf0, 0x7f, 0x82,
-	0x4d, 0x25, 0x61, 0x8f, 0xa1, 0xdd, 0x80, 0x33, 0x9b, 0x54, 0xb5, 0x03, 0x81, 0x37, 0x3d, 0x1c,
-	0x04, 0xc2, 0x23, 0x0c, 0x37, 0x43, 0x12, 0x14, 0x86, 0xe6, 0xc1, 0xc2, 0x05, 0x77, 0xaa, 0xe7,
-	0xbc, 0x15, 0x04, 0xe2, 0xb6, 0x71, 0xd5, 0xae, 0x7f, 0x76, 0xb8, 0xb3, 0x38, 0x6f, 0x00, 0x96,
-	0x64, 0xb0, 0x51, 0xdd, 0x3a, 0x5a, 0x31, 0xe6, 0x44, 0x9c, 0x9f, 0x00, 0x2c, 0xbe, 0x8b, 0x43,
-	0x1a, 0x60, 0xc5, 0xc5, 0xeb, 0x54, 0x2a, 0x2e, 0xa8, 0x8f, 0x43, 0x03, 0x2c, 0xd1, 0xe7, 0x00,
-	0x5e, 0xf6, 0x3b, 0x51, 0x27, 0xc4, 0x8a, 0x76, 0x89, 0x15, 0xe9, 0x09, 0xac, 0x28, 0x2f, 0x80,
-	0xf9, 0xc1, 0x85, 0xb1, 0x1b, 0x57, 0x6d, 0x3d, 0x56, 0x74, 0x96, 0x7a, 0x75, 0xa5, 0x15, 0xd5,
-	0x39, 0x65, 0x26, 0x11, 0xdf, 0xfd, 0x5a, 0x7e, 0xb1, 0x45, 0x55, 0xbb, 0xd3, 0xac, 0xf8, 0x3c,
-	0xb2, 0xf5, 0x52, 0xed, 0xa3, 0xa6, 0xb6, 0x63, 0x22, 0x7b, 0xdf, 0x48, 0x73, 0xb6, 0x33, 0x19,
-	0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x3c, 0x9c, 0x14, 0x64, 0x9d, 0x08, 0xc2, 0x7c, 0xe2, 0xf9,
-	0xbc, 0xc3, 0x54, 0x72, 0xbe, 0x17, 0xdd, 0x89, 0xd4, 0x5c, 0xd7, 0x56, 0xe7, 0x5b, 0x00, 0x2f,
-	0xa7, 0xc2, 0xea, 0x1d, 0x21, 0x08, 0x53, 0x3d, 0x55, 0x31, 0x1c, 0x31, 0x4a, 0xe4, 0x39, 0x8b,
-	0xe8, 0xc1, 0xa0, 0x59, 0x98, 0x8f, 0x89, 0xa0, 0xdc, 0x54, 0xe3, 0x90, 0x6b, 0x57, 0xce, 0xd7,
-	0x00, 0x96, 0x52, 0x96, 0xb7, 0x7c, 0xab, 0x99, 0x04, 0x75, 0x1e, 0x45, 0x54, 0x4a, 0xca, 0x19,
-	0xea, 0x42, 0xe8, 0xa7, 0xab, 0x73, 0xe6, 0xdb, 0x87, 0xe4, 0x7c, 0x01, 0xe0, 0x95, 0x94, 0xda,
-	0xdb, 0x1d, 0x25, 0x15, 0x66, 0x01, 0x65, 0xad, 0xa7, 0x96, 0x44, 0xcd, 0x68, 0x2a, 0x65, 0xb4,
-	0x16, 0x62, 0xd9, 0xbe, 0xdd, 0x25, 0x4c, 0xa1, 0x17, 0xe0, 0x33, 0xdd, 0x9e, 0xd9, 0xb3, 0x69,
-	0x06, 0x49, 0x9a, 0x27, 0x53, 0xfb, 0x6a, 0x62, 0x46, 0x6f, 0xc2, 0x0b, 0xeb, 0x02, 0xfb, 0xba,
-	0x03, 0xec, 0x5c, 0x58, 0xfe, 0xcf, 0xad, 0xea, 0xa6, 0x21, 0x9c, 0x4f, 0x01, 0x9c, 0x3e, 0x81,
-	0x91, 0x44, 0x1f, 0xc1, 0xd9, 0x8c, 0x92, 0xd4, 0x0e, 0x8f, 0x24, 0x1e, 0x9b, 0xab, 0x97, 0x2b,
-	0xff, 0x32, 0x95, 0x2b, 0x27, 0x84, 0x5c, 0x19, 0xd5, 0x3c, 0x4d, 0x42, 0xa6, 0xbb, 0x27, 0x40,
-	0x3a, 0x9f, 0x0c, 0xc0, 0x91, 0x3b, 0x84, 0xac, 0x72, 0x1e, 0xa2, 0x8f, 0xe1, 0x44, 0x36, 0x67,
-	0x63, 0xce, 0xc3, 0x53, 0x1d, 0x51, 0xed, 0xac, 0x47, 0x54, 0x00, 0x6e, 0x36, 0xd7, 0x13, 0x02,
-	0x0a, 0x8e, 0x07, 0xc4, 0xa7, 0x11, 0x0e, 0x0d, 0xfc, 0xc0, 0x29, 0xe0, 0x6f, 0x9e, 0x01, 0xde,
-	0x1d, 0xb3, 0x30, 0x1a, 0xd5, 0xf9, 0x6a, 0x00, 0x16, 0xeb, 0xfd, 0x3c, 0xd6, 0x62, 0xc2, 0x02,
-	0x33, 0x4c, 0x71, 0x88, 0xa6, 0xe1, 0xb0, 0xa2, 0x2a, 0x24, 0xe6, 0xd6, 0x71, 0xcd, 0x02, 0xcd,
-	0xc3, 0xb1, 0x80, 0x48, 0x5f, 0xd0, 0x38, 0xab, 0x0a, 0xb7, 0xdf, 0x84, 0xae, 0xc2, 0x51, 0x41,
-	0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x19, 0xf0, 0x6e, 0x66, 0x40, 0xdb, 0x30, 0x8f, 0xa3, 0x64, 0x10,
-	0x0d, 0x25, 0x22, 0xe7, 0x4e, 0x14, 0x99, 0x28, 0xbc, 0x63, 0x15, 0x2e, 0x9c, 0x42, 0x61, 0x22,
-	0xef, 0xfe, 0xe1, 0xce, 0xe2, 0x78, 0x98, 0x94, 0xa1, 0xe7, 0x67, 0x1d, 0x61, 0x01, 0x6b, 0x0b,
-	0xf7, 0x1e, 0x94, 0x73, 0x7f, 0x3c, 0x28, 0xe7, 0xbe, 0xdf, 0x5d, 0x2a, 0x5a, 0xd4, 0x16, 0xef,
-	0xf6, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x07, 0x00, 0x67, 0x1a, 0x44, 0x47, 0xd2, 0x55, 0xa3,
-	0xb0, 0x50, 0x94, 0xb5, 0xde, 0x60, 0xeb, 0xc9, 0x40, 0x8d, 0x05, 0xe9, 0x52, 0xae, 0x2f, 0xb3,
-	0xfe, 0xde, 0x99, 0xe8, 0x99, 0x6d, 0xeb, 0xdc, 0x85, 0xc3, 0x52, 0xe1, 0x0d, 0x62, 0xfb, 0xe6,
-	0xac, 0x77, 0xb6, 0x09, 0x82, 0x1a, 0x30, 0xdf, 0x26, 0xb4, 0xd5, 0x36, 0x09, 0x1d, 0x5a, 0x79,
-	0xe9, 0xcf, 0xbd, 0xf2, 0xa4, 0x2f, 0x88, 0x1e, 0xf2, 0xcc, 0x33, 0xae, 0x6f, 0x0e, 0x77, 0x16,
-	0x8f, 0xdb, 0x6c, 0x02, 0xcc, 0xc2, 0xf9, 0x1d, 0xc0, 0x39, 0x2b, 0x8b, 0x72, 0x96, 0x0a, 0xb4,
-	0xd7, 0xe6, 0x5b, 0xf0, 0x52, 0xd6, 0x84, 0xfa, 0xde, 0x24, 0x52, 0xda, 0x17, 0xc7, 0xb5, 0x27,
-	0xbb, 0x4b, 0xcf, 0x5a, 0x6a, 0xd9, 0xfc, 0x35, 0x5b, 0xd6, 0x94, 0xd0, 0x63, 0x2e, 0x9b, 0x29,
-	0xd6, 0x8e, 0x18, 0xcc, 0xa7, 0x4f, 0x8a, 0xf3, 0x1c, 0x78, 0x16, 0xa5, 0x36, 0xa4, 0x8f, 0xd7,
-	0xf9, 0x11, 0xc0, 0xeb, 0xff, 0x5c, 0xd4, 0xef, 0x51, 0xd5, 0x6e, 0x90, 0x98, 0x4b, 0xaa, 0xce,
-	0xa9, 0xbe, 0x67, 0xfb, 0xea, 0x5b, 0xbb, 0xec, 0x0a, 0x15, 0xe0, 0x48, 0x60, 0x80, 0x0b, 0xc3,
-	0x89, 0xa3, 0xb7, 0xac, 0x3d, 0x77, 0xef, 0x14, 0x25, 0xb9, 0xf2, 0xda, 0xc3, 0xfd, 0x12, 0x78,
-	0xb4, 0x5f, 0x02, 0x8f, 0xf7, 0x4b, 0xe0, 0xb7, 0xfd, 0x12, 0xf8, 0xf2, 0xa0, 0x94, 0x7b, 0x7c,
-	0x50, 0xca, 0xfd, 0x7c, 0x50, 0xca, 0xbd, 0x7f, 0xed, 0x48, 0x59, 0x1d, 0x7b, 0xbb, 0x24, 0x49,
-	0x6b, 0xe6, 0x93, 0xe7, 0xe5, 0xcd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x08, 0x33, 0x8d,
-	0x11, 0x0b, 0x00, 0x00,
+	// 1295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0x49, 0xda, 0x4c, 0xd2, 0x3a, 0x99, 0x38, 0xa9, 0x9b, 0x16, 0xc7, 0xb1, 0xa8,
+	0x48, 0x03, 0xb1, 0xfb, 0x21, 0x10, 0xca, 0x05, 0x35, 0x4e, 0x2a, 0x2a, 0x95, 0x12, 0x6d, 0x10,
+	0x95, 0xe0, 0xb0, 0x1a, 0xef, 0x4e, 0xec, 0xa1, 0xbb, 0x33, 0xcb, 0xcc, 0xd8, 0x4d, 0x7a, 0x81,
+	0x63, 0x41, 0x7c, 0xdd, 0x40, 0x9c, 0x2a, 0x38, 0x50, 0x71, 0xca, 0x21, 0x7f, 0x44, 0xc5, 0xa9,
+	0x8a, 0x00, 0xa1, 0x1e, 0x0a, 0xa4, 0x87, 0x54, 0xfc, 0x15, 0x68, 0x3e, 0xbc, 0x76, 0x1c, 0x03,
+	0x21, 0x55, 0xe0, 0x62, 0x65, 0xdf, 0x9b, 0x79, 0xbf, 0xdf, 0x7b, 0xef, 0x37, 0x6f, 0x26, 0xa0,
+	0xe4, 0x33, 0x11, 0x31, 0x51, 0x0e, 0x88, 0x90, 0x9c, 0x54, 0x1b, 0x92, 0x30, 0x5a, 0x6e, 0x5e,
+	0xac, 0x62, 0x89, 0x2e, 0xee, 0x31, 0x96, 0x62, 0xce, 0x24, 0x83, 0x67, 0xcc, 0xfa, 0xd2, 0x1e,
+	0x97, 0x5d, 0x3f, 0x95, 0xad, 0xb1, 0x1a, 0xd3, 0xeb, 0xca, 0xea, 0x2f, 0xb3, 0x65, 0x2a, 0x6f,
+	0x21, 0xaa, 0x48, 0xe0, 0x24, 0xb4, 0xcf, 0x88, 0x0d, 0x39, 0x75, 0xda, 0xf8, 0x3d, 0xb3, 0xd1,
+	0xc6, 0x37, 0xae, 0x31, 0x14, 0x11, 0xca, 0xca, 0xfa, 0xd7, 0x98, 0x8a, 0x4f, 0xd3, 0x60, 0x70,
+	0x05, 0x71, 0x14, 0x09, 0xf8, 0x2e, 0x38, 0xe1, 0xb3, 0x28, 0x6a, 0x50, 0x22, 0x37, 0x3c, 0x89,
+	0xd6, 0x73, 0x4e, 0xc1, 0x99, 0x1d, 0x5a, 0x7c, 0xe5, 0xc1, 0xe3, 0xe9, 0xbe, 0x47, 0x8f, 0xa7,
+	0x2d, 0x55, 0x11, 0xdc, 0x2a, 0x11, 0x56, 0x8e, 0x90, 0xac, 0x97, 0xae, 0xe3, 0x1a, 0xf2, 0x37,
+	0x96, 0xb0, 0xbf, 0xbd, 0x35, 0x0f, 0x2c, 0xd2, 0x12, 0xf6, 0xef, 0xef, 0x6e, 0xce, 0x39, 0xee,
+	0x48, 0x12, 0xec, 0x2d, 0xb4, 0x0e, 0xdf, 0x03, 0x59, 0x45, 0x58, 0xb1, 0x8a, 0x99, 0xc0, 0xdc,
+	0xe3, 0xf8, 0x36, 0xe2, 0x41, 0x2e, 0xa5, 0x31, 0x5e, 0x3d, 0x1c, 0x46, 0xce, 0x71, 0xa1, 0x8a,
+	0xba, 0x62, 0x83, 0xba, 0x3a, 0x26, 0x0c, 0xc1, 0x44, 0x95, 0xd1, 0x86, 0xd8, 0x07, 0x96, 0x7e,
+	0x46, 0xb0, 0x71, 0x1d, 0xb6, 0x0b, 0xed, 0x12, 0x98, 0xb8, 0x4d, 0x64, 0x3d, 0xe0, 0xe8, 0xb6,
+	0x87, 0x82, 0x80, 0x7b, 0x98, 0xa2, 0x6a, 0x88, 0x83, 0x5c, 0x7f, 0xc1, 0x99, 0x3d, 0xee, 0x8e,
+	0xb7, 0x9c, 0x57, 0x82, 0x80, 0x2f, 0x1b, 0x17, 0xbc, 0x0e, 0x72, 0xa8, 0x21, 0x99, 0xe7, 0xb3,
+	0x28, 0x66, 0x0d, 0x1a, 0x78, 0x55, 0x24, 0xfd, 0xba, 0x27, 0xc8, 0x1d, 0x9c, 0x1b, 0x28, 0x38,
+	0xb3, 0x27, 0x16, 0xc7, 0x1f, 0x6d, 0xcd, 0x67, 0x0c, 0x83, 0x79, 0x11, 0xdc, 0x2a, 0x5c, 0x28,
+	0xbd, 0x7c, 0xc9, 0x9d, 0x50, 0x9b, 0x2a, 0x76, 0xcf, 0xa2, 0xda, 0xb2, 0x4a, 0xee, 0xe0, 0x85,
+	0x73, 0x1f, 0xef, 0x6e, 0xce, 0x15, 0xda, 0x8b, 0xcb, 0xeb, 0x7b, 0xf5, 0x67, 0xfa, 0x5b, 0xfc,
+	0xd9, 0x01, 0x53, 0x6f, 0xa3, 0x90, 0x04, 0x48, 0x32, 0xfe, 0x3a, 0x11, 0x92, 0x71, 0xe2, 0xa3,
+	0xd0, 0xa4, 0x21, 0xe0, 0x67, 0x0e, 0x38, 0xe5, 0x37, 0xa2, 0x46, 0x88, 0x24, 0x69, 0x62, 0x5b,
+	0x32, 0x8f, 0x23, 0x49, 0x58, 0xce, 0x29, 0xa4, 0x67, 0x87, 0x2f, 0x9d, 0xb5, 0xea, 0x2e, 0xa9,
+	0x9a, 0xb7, 0x54, 0xaa, 0xea, 0x53, 0x61, 0x84, 0x9a, 0xb2, 0x7e, 0xff, 0xeb, 0xf4, 0x8b, 0x35,
+	0x22, 0xeb, 0x8d, 0x6a, 0xc9, 0x67, 0x91, 0x55, 0x5f, 0xb9, 0x83, 0x9a, 0xdc, 0x88, 0xb1, 0x68,
+	0xed, 0x11, 0x46, 0x29, 0x13, 0x6d, 0x58, 0x43, 0xc6, 0x55, 0xa0, 0xf0, 0x05, 0x90, 0xe1, 0x78,
+	0x0d, 0x73, 0x4c, 0x7d, 0xec, 0xf9, 0xac, 0x41, 0xa5, 0x56, 0xcb, 0x09, 0xf7, 0x64, 0x62, 0xae,
+	0x28, 0x6b, 0xf1, 0x5b, 0x07, 0x9c, 0x4a, 0x12, 0xab, 0x34, 0x38, 0xc7, 0x54, 0xb6, 0xb2, 0x8a,
+	0xc1, 0x31, 0x93, 0x89, 0x38, 0xe2, 0x24, 0x5a, 0x30, 0x70, 0x12, 0x0c, 0xc6, 0x98, 0x13, 0x66,
+	0xb4, 0xdd, 0xef, 0xda, 0xaf, 0xe2, 0x57, 0x0e, 0xc8, 0x27, 0x2c, 0xaf, 0xf8, 0x36, 0x67, 0x1c,
+	0x54, 0x58, 0x14, 0x11, 0x21, 0x08, 0xa3, 0xb0, 0x09, 0x80, 0x9f, 0x7c, 0x1d, 0x31, 0xdf, 0x0e,
+	0xa4, 0xe2, 0xe7, 0x0e, 0x38, 0x93, 0x50, 0x7b, 0xb3, 0x21, 0x85, 0x44, 0x34, 0x20, 0xb4, 0xf6,
+	0xbf, 0x15, 0x51, 0x31, 0x1a, 0x4f, 0x18, 0xad, 0x86, 0x48, 0xd4, 0x97, 0x9b, 0x98, 0x4a, 0x78,
+	0x1e, 0x8c, 0x36, 0x5b, 0x66, 0xcf, 0x96, 0xd9, 0xd1, 0x65, 0xce, 0x24, 0xf6, 0x15, 0x6d, 0x86,
+	0x6f, 0x80, 0xe3, 0x6b, 0x1c, 0xf9, 0xea, 0x04, 0xd8, 0x29, 0x73, 0xf1, 0x5f, 0x1f, 0x7c, 0x37,
+	0x09, 0x51, 0xfc, 0xc8, 0x01, 0xd9, 0x1e, 0x8c, 0x04, 0x7c, 0x1f, 0x4c, 0xb6, 0x29, 0x09, 0xe5,
+	0xf0, 0xb0, 0xf6, 0xd8, 0x5a, 0x5d, 0x28, 0xfd, 0xcd, 0x8c, 0x2f, 0xf5, 0x08, 0xb9, 0x38, 0xa4,
+	0x78, 0x9a, 0x82, 0x64, 0x9b, 0x3d, 0x20, 0x8b, 0x1f, 0xa6, 0xc0, 0xb1, 0xab, 0x18, 0xaf, 0x30,
+	0x16, 0xc2, 0x0f, 0xc0, 0xc9, 0xf6, 0xd4, 0x8e, 0x19, 0x0b, 0x0f, 0xd4, 0xa2, 0x85, 0xc3, 0xb6,
+	0x28, 0xe7, 0xb8, 0xed, 0x5b, 0x42, 0x13, 0x90, 0x60, 0x24, 0xc0, 0x3e, 0x89, 0x50, 0x68, 0xe0,
+	0x53, 0x07, 0x80, 0xbf, 0x7c, 0x08, 0x78, 0x77, 0xd8, 0xc2, 0x28, 0xd4, 0xe2, 0x97, 0x29, 0x30,
+	0x55, 0xe9, 0xe4, 0xb1, 0x1a, 0x63, 0x1a, 0x98, 0xd1, 0x8c, 0x42, 0x98, 0x05, 0x03, 0x92, 0xc8,
+	0x10, 0x9b, 0x3b, 0xcc, 0x35, 0x1f, 0xb0, 0x00, 0x86, 0x03, 0x2c, 0x7c, 0x4e, 0xe2, 0xb6, 0x2a,
+	0xdc, 0x4e, 0x13, 0x3c, 0x0b, 0x86, 0x38, 0xf6, 0x49, 0x4c, 0x30, 0x95, 0xe6, 0xba, 0x70, 0xdb,
+	0x06, 0xb8, 0x01, 0x06, 0x51, 0xa4, 0x07, 0x51, 0xbf, 0x4e, 0xf2, 0x74, 0xcf, 0x24, 0x75, 0x86,
+	0x57, 0x6d, 0x86, 0xb3, 0x07, 0xc8, 0x50, 0xa7, 0xf7, 0xf5, 0xee, 0xe6, 0xdc, 0x48, 0xa8, 0x65,
+	0xe8, 0xf9, 0xed, 0x13, 0x61, 0x01, 0x17, 0x66, 0xef, 0xde, 0x9b, 0xee, 0x7b, 0x7a, 0x6f, 0xba,
+	0xef, 0x87, 0xad, 0xf9, 0x29, 0x8b, 0x5a, 0x63, 0xcd, 0x0e, 0x50, 0x2a, 0x15, 0x67, 0xa7, 0xf8,
+	0xa3, 0x03, 0x26, 0x96, 0xb0, 0x8a, 0xa4, 0x54, 0x23, 0x11, 0x97, 0x84, 0xd6, 0xae, 0xd1, 0x35,
+	0x3d, 0x50, 0x63, 0x8e, 0x9b, 0x84, 0xa9, 0xab, 0xb1, 0xf3, 0xec, 0x9c, 0x6c, 0x99, 0xed, 0xd1,
+	0xb9, 0x0e, 0x06, 0x84, 0x44, 0xb7, 0x70, 0x2e, 0xf5, 0x4c, 0x2f, 0x00, 0x13, 0x04, 0x2e, 0x81,
+	0xc1, 0x3a, 0x26, 0xb5, 0xba, 0x29, 0x68, 0xff, 0xe2, 0x4b, 0x7f, 0x3c, 0x9e, 0xce, 0xf8, 0x1c,
+	0xab, 0x21, 0x4f, 0x3d, 0xe3, 0xfa, 0x66, 0x77, 0x73, 0xae, 0xdb, 0x66, 0x0b, 0x60, 0x3e, 0x8a,
+	0xbf, 0x3b, 0xe0, 0xb4, 0x4d, 0x8b, 0x30, 0x9a, 0x24, 0x68, 0x2f, 0xe1, 0x1b, 0x60, 0xac, 0x7d,
+	0x08, 0xd5, 0x2d, 0x8c, 0x85, 0xb0, 0xef, 0x97, 0x99, 0xed, 0xad, 0xf9, 0xe7, 0x2c, 0xb5, 0xf6,
+	0xfc, 0x35, 0x4b, 0x56, 0x25, 0x57, 0x63, 0x6e, 0xb4, 0xd9, 0x65, 0x87, 0x14, 0x0c, 0x26, 0x0f,
+	0x94, 0xa3, 0x1c, 0x78, 0x16, 0x65, 0xa1, 0x5f, 0xb5, 0xb7, 0xf8, 0x93, 0x03, 0xce, 0xfd, 0xb5,
+	0xa8, 0x6f, 0x12, 0x59, 0x5f, 0xc2, 0x31, 0x13, 0x44, 0x1e, 0x91, 0xbe, 0x27, 0x3b, 0xf4, 0xad,
+	0x5c, 0xf6, 0x0b, 0xe6, 0xc0, 0xb1, 0xc0, 0x00, 0xeb, 0xd7, 0xc9, 0x90, 0xdb, 0xfa, 0x5c, 0x78,
+	0xfe, 0xee, 0x41, 0x24, 0xf9, 0x5d, 0x1a, 0x8c, 0x5f, 0xe9, 0x78, 0xba, 0xac, 0x62, 0xa9, 0x44,
+	0x09, 0x97, 0xc1, 0x58, 0xd0, 0x6a, 0x64, 0x57, 0xd7, 0x72, 0xdb, 0x5b, 0xf3, 0x59, 0x1b, 0xb4,
+	0xab, 0x59, 0xc9, 0x96, 0x56, 0xb3, 0x7a, 0x36, 0x3f, 0x75, 0xf8, 0xe6, 0x9f, 0x07, 0xa3, 0x38,
+	0x66, 0x7e, 0xdd, 0x23, 0x01, 0xa6, 0x92, 0xac, 0x11, 0xcc, 0x6d, 0xad, 0x32, 0xda, 0x7e, 0x2d,
+	0x31, 0xc3, 0x39, 0x30, 0x16, 0x22, 0x21, 0x3d, 0xb3, 0x9e, 0x36, 0xa2, 0x2a, 0xe6, 0xba, 0x78,
+	0x69, 0x37, 0xa3, 0x1c, 0xcb, 0xca, 0x7e, 0x43, 0x9b, 0xe1, 0x27, 0x0e, 0x18, 0x95, 0x4c, 0xa2,
+	0x30, 0x79, 0xf6, 0xe1, 0x20, 0x37, 0xf0, 0x5f, 0x0d, 0x92, 0x8c, 0x86, 0xae, 0x24, 0xc8, 0x0b,
+	0xe3, 0xdb, 0xfb, 0x5f, 0x98, 0xc5, 0x4f, 0xd3, 0x60, 0xac, 0xb3, 0x53, 0xae, 0xfa, 0xe9, 0x59,
+	0x10, 0xa7, 0x77, 0x41, 0x66, 0xc0, 0xc8, 0x9e, 0x5a, 0xa4, 0x74, 0x2d, 0x86, 0x71, 0x47, 0x1d,
+	0x6e, 0x80, 0x49, 0x8a, 0xd7, 0xa5, 0xb7, 0xbf, 0xf5, 0xe9, 0x7f, 0x68, 0x7d, 0x56, 0xed, 0x5b,
+	0xea, 0x6e, 0xff, 0x4d, 0x1b, 0x6f, 0xbf, 0x06, 0xfa, 0x0f, 0xaa, 0x01, 0x1d, 0xb8, 0xdb, 0x07,
+	0x43, 0x30, 0xd3, 0x49, 0x54, 0x4d, 0xa6, 0xfd, 0x18, 0x03, 0x07, 0xc5, 0xc8, 0x77, 0x90, 0x27,
+	0x8c, 0x76, 0xaf, 0xea, 0xd9, 0x8f, 0xc5, 0xd7, 0xee, 0xef, 0xe4, 0x9d, 0x07, 0x3b, 0x79, 0xe7,
+	0xe1, 0x4e, 0xde, 0xf9, 0x6d, 0x27, 0xef, 0x7c, 0xf1, 0x24, 0xdf, 0xf7, 0xf0, 0x49, 0xbe, 0xef,
+	0x97, 0x27, 0xf9, 0xbe, 0x77, 0x66, 0xf6, 0x0c, 0xe4, 0xae, 0x57, 0xbf, 0x96, 0x44, 0x75, 0x50,
+	0xff, 0x9b, 0x77, 0xf9, 0xcf, 0x01, 0x00, 0x36, 0x6b, 0xdc, 0x36, 0x99, 0x0e, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -757,6 +957,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundBatchSize != that1.AutoCompoundBatchSize {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1072,6 +1275,83 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *AutoCompoundSetting) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoCompoundSetting)
+	if !ok {
+		that2, ok := that.(AutoCompoundSetting)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.EpochIdentifier != that1.EpochIdentifier {
+		return false
+	}
+	if this.LastEpochNumber != that1.LastEpochNumber {
+		return false
+	}
+	if len(this.TotalCompounded) != len(that1.TotalCompounded) {
+		return false
+	}
+	for i := range this.TotalCompounded {
+		if !this.TotalCompounded[i].Equal(&that1.TotalCompounded[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AutoCompoundRound) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoCompoundRound)
+	if !ok {
+		that2, ok := that.(AutoCompoundRound)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EpochIdentifier != that1.EpochIdentifier {
+		return false
+	}
+	if this.EpochNumber != that1.EpochNumber {
+		return false
+	}
+	if this.NextDelegatorAddress != that1.NextDelegatorAddress {
+		return false
+	}
+	if this.NextValidatorAddress != that1.NextValidatorAddress {
+		return false
+	}
+	if this.NextDelegationValidatorAddress != that1.NextDelegationValidatorAddress {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1092,6 +1372,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundBatchSize != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundBatchSize))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1622,6 +1907,125 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundSetting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundSetting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundSetting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalCompounded) > 0 {
+		for iNdEx := len(m.TotalCompounded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalCompounded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.LastEpochNumber != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.LastEpochNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompoundRound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundRound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextDelegationValidatorAddress) > 0 {
+		i -= len(m.NextDelegationValidatorAddress)
+		copy(dAtA[i:], m.NextDelegationValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.NextDelegationValidatorAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NextValidatorAddress) > 0 {
+		i -= len(m.NextValidatorAddress)
+		copy(dAtA[i:], m.NextValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.NextValidatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NextDelegatorAddress) > 0 {
+		i -= len(m.NextDelegatorAddress)
+		copy(dAtA[i:], m.NextDelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.NextDelegatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1648,6 +2052,9 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoCompoundBatchSize != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundBatchSize))
+	}
 	return n
 }
 
@@ -1859,11 +2266,69 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
-func sovDistribution(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDistribution(x uint64) (n int) {
-	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *AutoCompoundSetting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.LastEpochNumber != 0 {
+		n += 1 + sovDistribution(uint64(m.LastEpochNumber))
+	}
+	if len(m.TotalCompounded) > 0 {
+		for _, e := range m.TotalCompounded {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AutoCompoundRound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovDistribution(uint64(m.EpochNumber))
+	}
+	l = len(m.NextDelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.NextValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.NextDelegationValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func sovDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDistribution(x uint64) (n int) {
+	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -2016,6 +2481,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundBatchSize", wireType)
+			}
+			m.AutoCompoundBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3344,6 +3828,402 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoCompoundSetting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundSetting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundSetting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochNumber", wireType)
+			}
+			m.LastEpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCompounded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalCompounded = append(m.TotalCompounded, types.Coin{})
+			if err := m.TotalCompounded[len(m.TotalCompounded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoCompoundRound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundRound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundRound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextDelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDelegationValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextDelegationValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,4 +18,7 @@ var (
 	ErrNoDelegationExists      = errors.Register(ModuleName, 13, "delegation does not exist")
 	ErrInvalidProposalContent  = errors.Register(ModuleName, 14, "invalid proposal content")
 	ErrInvalidSigner           = errors.Register(ModuleName, 15, "expected authority account as only signer for proposal message")
+	ErrEmptyEpochIdentifier    = errors.Register(ModuleName, 16, "epoch identifier is empty")
+	ErrNoAutoCompoundSetting   = errors.Register(ModuleName, 17, "auto-compound setting does not exist")
+	ErrUnknownEpochIdentifier  = errors.Register(ModuleName, 18, "epoch identifier does not exist")
 )
@@ -8,8 +8,15 @@ const (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetAutoCompound    = "set_auto_compound"
+	EventTypeRemoveAutoCompound = "remove_auto_compound"
+	EventTypeAutoCompound       = "auto_compound"
+	EventTypeAutoCompoundFailed = "auto_compound_failed"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEpochIdentifier = "epoch_identifier"
+	AttributeKeyEpochNumber     = "epoch_number"
+	AttributeKeyError           = "error"
 )
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
@@ -30,6 +32,8 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundSettings:            []AutoCompoundSetting{},
+		AutoCompoundRounds:              []AutoCompoundRound{},
 	}
 }
 
@@ -38,5 +42,42 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	if err := validateAutoCompound(gs.AutoCompoundSettings, gs.AutoCompoundRounds); err != nil {
+		return err
+	}
 	return gs.FeePool.ValidateGenesis()
 }
+
+func validateAutoCompound(settings []AutoCompoundSetting, rounds []AutoCompoundRound) error {
+	seenSettings := make(map[string]bool, len(settings))
+	for _, setting := range settings {
+		if setting.DelegatorAddress == "" {
+			return ErrEmptyDelegatorAddr
+		}
+		if setting.EpochIdentifier == "" {
+			return ErrEmptyEpochIdentifier
+		}
+		if !setting.TotalCompounded.IsValid() {
+			return fmt.Errorf("invalid total compounded of %s: %s", setting.DelegatorAddress, setting.TotalCompounded)
+		}
+
+		key := setting.DelegatorAddress + "/" + setting.ValidatorAddress
+		if seenSettings[key] {
+			return fmt.Errorf("duplicate auto-compound setting for delegator %s and validator %q", setting.DelegatorAddress, setting.ValidatorAddress)
+		}
+		seenSettings[key] = true
+	}
+
+	seenRounds := make(map[string]bool, len(rounds))
+	for _, round := range rounds {
+		if round.EpochIdentifier == "" {
+			return ErrEmptyEpochIdentifier
+		}
+		if seenRounds[round.EpochIdentifier] {
+			return fmt.Errorf("duplicate auto-compound round for epoch identifier %s", round.EpochIdentifier)
+		}
+		seenRounds[round.EpochIdentifier] = true
+	}
+
+	return nil
+}
@@ -346,6 +346,10 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_settings defines the auto-compounding settings of the delegators at genesis.
+	AutoCompoundSettings []AutoCompoundSetting `protobuf:"bytes,11,rep,name=auto_compound_settings,json=autoCompoundSettings,proto3" json:"auto_compound_settings"`
+	// auto_compound_rounds defines the auto-compounding rounds in progress at genesis.
+	AutoCompoundRounds []AutoCompoundRound `protobuf:"bytes,12,rep,name=auto_compound_rounds,json=autoCompoundRounds,proto3" json:"auto_compound_rounds"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -397,65 +401,70 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 925 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x8e, 0x93, 0x34, 0xcd, 0x4e, 0x8a, 0xd8, 0x9d, 0x6e, 0x17, 0xef, 0xb6, 0x75, 0x36, 0xa5,
-	0x87, 0x02, 0x5a, 0x87, 0x06, 0x04, 0x55, 0x11, 0xa0, 0x26, 0x6d, 0x81, 0x1e, 0xa0, 0x4a, 0x24,
-	0x10, 0x08, 0x29, 0x9a, 0xd8, 0x13, 0x67, 0xd4, 0xc4, 0x13, 0x79, 0x26, 0x0e, 0x20, 0x71, 0xe0,
-	0x54, 0xc4, 0x89, 0x13, 0xe2, 0x58, 0x71, 0xaa, 0x90, 0x90, 0x38, 0xf4, 0x47, 0x54, 0xe2, 0x52,
-	0x55, 0x1c, 0x38, 0x01, 0xca, 0x1e, 0x40, 0x1c, 0xf9, 0x05, 0xc8, 0x9e, 0xb1, 0x3d, 0x59, 0xbb,
-	0xd9, 0xec, 0xd2, 0x5c, 0x76, 0xe3, 0x99, 0xf7, 0xde, 0xf7, 0x7d, 0xef, 0x3d, 0xbf, 0x67, 0xf0,
-	0x82, 0x45, 0xd9, 0x88, 0xb2, 0xba, 0x4d, 0x18, 0xf7, 0x48, 0x6f, 0xc2, 0x09, 0x75, 0xeb, 0xfe,
-	0xe5, 0x1e, 0xe6, 0xe8, 0x72, 0xdd, 0xc1, 0x2e, 0x66, 0x84, 0x99, 0x63, 0x8f, 0x72, 0x0a, 0xcf,
-	0x0a, 0x53, 0x53, 0x35, 0x35, 0xa5, 0xe9, 0xce, 0xa6, 0x43, 0x1d, 0x1a, 0xda, 0xd5, 0x83, 0x5f,
-	0xc2, 0x65, 0xc7, 0x90, 0xd1, 0x7b, 0x88, 0xe1, 0x38, 0xaa, 0x45, 0x89, 0x2b, 0xef, 0xcd, 0x45,
-	0xe8, 0x73, 0x38, 0xc2, 0x7e, 0x5b, 0xd8, 0x77, 0x05, 0x90, 0xe4, 0x23, 0xae, 0x36, 0xd0, 0x88,
-	0xb8, 0xb4, 0x1e, 0xfe, 0x15, 0x47, 0x17, 0x7e, 0xd2, 0xc0, 0x99, 0xeb, 0x78, 0x88, 0x1d, 0xc4,
-	0xa9, 0xf7, 0x11, 0xe1, 0x03, 0xdb, 0x43, 0xd3, 0xf7, 0xdc, 0x3e, 0x85, 0x37, 0xc0, 0x86, 0x1d,
-	0x5d, 0x74, 0x91, 0x6d, 0x7b, 0x98, 0x31, 0x5d, 0xdb, 0xd5, 0x2e, 0xad, 0x35, 0xf5, 0xc7, 0x0f,
-	0xf6, 0x36, 0x65, 0xe4, 0x6b, 0xe2, 0xa6, 0xc3, 0x3d, 0xe2, 0x3a, 0xed, 0xf5, 0xd8, 0x45, 0x9e,
-	0xc3, 0x16, 0x58, 0x9f, 0xca, 0xb0, 0x71, 0x94, 0xfc, 0x21, 0x51, 0x9e, 0x8d, 0x3c, 0xe4, 0xf1,
-	0xd5, 0xf2, 0xd7, 0xf7, 0xaa, 0xb9, 0xbf, 0xef, 0x55, 0x73, 0x17, 0xee, 0xe6, 0x41, 0xed, 0x43,
-	0x34, 0x24, 0x76, 0x80, 0xf1, 0xc1, 0x84, 0x33, 0x8e, 0x5c, 0x3b, 0xf0, 0xc1, 0x53, 0xe4, 0xd9,
-	0xac, 0x8d, 0x2d, 0xea, 0xd9, 0xf0, 0x7d, 0xb0, 0xe1, 0x47, 0x46, 0x07, 0xb8, 0xd7, 0x1e, 0x3f,
-	0xd8, 0x3b, 0x2f, 0x51, 0xe3, 0x40, 0x07, 0x44, 0xf8, 0x07, 0xce, 0xe1, 0x5d, 0x0d, 0x9c, 0xa6,
-	0x09, 0x58, 0xd7, 0x13, 0x68, 0x7a, 0x7e, 0xb7, 0x70, 0xa9, 0xd2, 0x38, 0x27, 0x4b, 0x64, 0x06,
-	0x25, 0x8c, 0xaa, 0x6d, 0x5e, 0xc7, 0x56, 0x8b, 0x12, 0xb7, 0x79, 0xe5, 0xe1, 0xef, 0xd5, 0xdc,
-	0x8f, 0x7f, 0x54, 0x5f, 0x72, 0x08, 0x1f, 0x4c, 0x7a, 0xa6, 0x45, 0x47, 0xb2, 0x2a, 0xf2, 0xdf,
-	0x1e, 0xb3, 0xef, 0xd4, 0xf9, 0xe7, 0x63, 0xcc, 0x22, 0x1f, 0x76, 0xff, 0xaf, 0x9f, 0x5f, 0xd4,
-	0xda, 0x90, 0xa6, 0xf4, 0x29, 0x99, 0xf8, 0x47, 0x03, 0x17, 0x13, 0x01, 0x96, 0x35, 0x19, 0x4d,
-	0x86, 0x88, 0x63, 0xbb, 0x45, 0x47, 0x23, 0xc2, 0x18, 0xa1, 0xee, 0x8a, 0x92, 0x31, 0x00, 0x15,
-	0x94, 0xc0, 0x85, 0xc5, 0xac, 0x34, 0xde, 0x30, 0x17, 0x74, 0xbe, 0xb9, 0x98, 0x67, 0x73, 0x2d,
-	0x48, 0x91, 0xd0, 0xac, 0x86, 0x56, 0xc4, 0xfe, 0xab, 0x81, 0xdd, 0x38, 0xc8, 0xbb, 0x84, 0x71,
-	0xea, 0x11, 0x0b, 0x0d, 0x57, 0x5b, 0xf5, 0x2d, 0x50, 0x1a, 0x63, 0x8f, 0x50, 0xa1, 0xb1, 0xd8,
-	0x96, 0x4f, 0xf0, 0x53, 0x70, 0x32, 0x6a, 0x80, 0x42, 0x28, 0xfe, 0xf5, 0xe5, 0xc4, 0xa7, 0x78,
-	0xab, 0xc2, 0xa3, 0x90, 0x8a, 0xe8, 0x5f, 0x35, 0x70, 0x3e, 0x76, 0x6e, 0x4d, 0x3c, 0x0f, 0xbb,
-	0x7c, 0xb5, 0x8a, 0x3f, 0x4e, 0x94, 0x89, 0xb2, 0xbe, 0xba, 0x9c, 0xb2, 0x79, 0x72, 0x87, 0xc8,
-	0xfa, 0x21, 0x0f, 0xce, 0xc6, 0x23, 0xa7, 0xc3, 0x91, 0xc7, 0x89, 0xeb, 0x04, 0x23, 0x47, 0x8a,
-	0x7a, 0x4a, 0x83, 0x27, 0x33, 0x37, 0xf9, 0xe3, 0xe7, 0xa6, 0x07, 0x9e, 0x61, 0x92, 0x6c, 0x97,
-	0xb8, 0x7d, 0x2a, 0x6b, 0xdf, 0x58, 0x98, 0xa1, 0x4c, 0x9d, 0x6a, 0x7e, 0x4e, 0x31, 0xe5, 0x42,
-	0x49, 0xd2, 0x77, 0x79, 0xb0, 0x1d, 0x53, 0xeb, 0x0c, 0x11, 0x1b, 0xdc, 0xf0, 0xc3, 0x0c, 0xaf,
-	0xaa, 0xd3, 0x07, 0x98, 0x38, 0x03, 0x1e, 0x75, 0xba, 0x78, 0x52, 0xde, 0x80, 0xc2, 0xdc, 0x1b,
-	0x40, 0xc1, 0x99, 0x04, 0x9f, 0x05, 0xec, 0xba, 0x38, 0xa0, 0xa7, 0x17, 0xc3, 0x9c, 0xbc, 0xbc,
-	0x5c, 0xd7, 0x24, 0xb2, 0xd4, 0x8c, 0x9c, 0xf6, 0xd3, 0xf7, 0x4a, 0x62, 0x7e, 0x29, 0x83, 0x53,
-	0xef, 0x88, 0x9d, 0xdb, 0xe1, 0x88, 0x63, 0x78, 0x13, 0x94, 0xc6, 0xc8, 0x43, 0x23, 0x91, 0x80,
-	0x4a, 0xe3, 0xf9, 0x85, 0xe0, 0xb7, 0x43, 0x53, 0x15, 0x4f, 0x7a, 0xc3, 0x5b, 0xa0, 0xdc, 0xc7,
-	0xb8, 0x3b, 0xa6, 0x74, 0x28, 0x9b, 0xff, 0xe2, 0xc2, 0x48, 0x37, 0x31, 0xbe, 0x4d, 0xe9, 0x70,
-	0xae, 0xd9, 0xfb, 0xe2, 0x0c, 0x4e, 0x81, 0x9e, 0xb4, 0x70, 0xbc, 0xfe, 0x82, 0xae, 0x09, 0x46,
-	0x46, 0x61, 0xf9, 0xb6, 0x51, 0x37, 0xb2, 0x8a, 0xb4, 0x65, 0x67, 0x59, 0x30, 0xf8, 0x45, 0xf6,
-	0x9e, 0x3a, 0x11, 0x62, 0xbe, 0xb5, 0x5c, 0x59, 0x9e, 0xb4, 0x55, 0x55, 0xfc, 0x8c, 0xd5, 0x04,
-	0xbf, 0xd7, 0x40, 0x4d, 0xe9, 0xca, 0x64, 0x8e, 0x77, 0xad, 0x78, 0xd4, 0x33, 0xbd, 0x14, 0x52,
-	0xb9, 0xf6, 0x3f, 0xd6, 0x45, 0x9a, 0x4d, 0xd5, 0x5f, 0xe8, 0xc0, 0xe0, 0x37, 0x1a, 0x38, 0x97,
-	0x50, 0x1b, 0xc4, 0x73, 0x38, 0x4e, 0xd0, 0xc9, 0x90, 0xd5, 0x9b, 0xc7, 0x9c, 0xe3, 0x69, 0x46,
-	0x3b, 0xfe, 0x13, 0x8d, 0xe1, 0x57, 0x1a, 0xd8, 0x4e, 0xc8, 0x58, 0x62, 0x74, 0xc6, 0x4c, 0xca,
-	0x21, 0x93, 0xab, 0xc7, 0x99, 0xbb, 0x69, 0x1a, 0xcf, 0xf9, 0xd9, 0x96, 0xf0, 0x4b, 0xb5, 0x41,
-	0xe7, 0xc6, 0x1a, 0xd3, 0xd7, 0x42, 0x06, 0x57, 0x8e, 0x3e, 0xd7, 0xd2, 0xf8, 0x49, 0x9b, 0xaa,
-	0x76, 0x0c, 0x4e, 0xc1, 0x56, 0xe6, 0xfc, 0x60, 0x3a, 0x08, 0xc1, 0x5f, 0x3b, 0xea, 0x00, 0x49,
-	0x43, 0x6f, 0x66, 0x8c, 0x11, 0x65, 0x0b, 0xdd, 0x2a, 0x96, 0x8b, 0xeb, 0x27, 0x9a, 0x6f, 0xdf,
-	0x9f, 0x19, 0xda, 0xc3, 0x99, 0xa1, 0x3d, 0x9a, 0x19, 0xda, 0x9f, 0x33, 0x43, 0xfb, 0x76, 0xdf,
-	0xc8, 0x3d, 0xda, 0x37, 0x72, 0xbf, 0xed, 0x1b, 0xb9, 0x4f, 0x6a, 0x82, 0x05, 0xb3, 0xef, 0x98,
-	0x84, 0xd6, 0x3f, 0x9b, 0xff, 0x04, 0x0f, 0xbf, 0xd8, 0x7a, 0xa5, 0xf0, 0x33, 0xfa, 0x95, 0xff,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x9c, 0xf4, 0xd9, 0x24, 0x0c, 0x00, 0x00,
+	// 1001 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x69, 0x9a, 0x8c, 0x83, 0x9a, 0x4c, 0x53, 0xb3, 0x49, 0x53, 0xdb, 0x29, 0x3d,
+	0x14, 0x50, 0xd6, 0xad, 0xf9, 0x55, 0x15, 0x01, 0x8a, 0xdd, 0x16, 0xe8, 0x01, 0x2a, 0x5b, 0x02,
+	0x81, 0x90, 0xac, 0xf1, 0xee, 0xc4, 0x1e, 0xd5, 0xde, 0xb1, 0xf6, 0xcd, 0xda, 0x80, 0x04, 0x12,
+	0x12, 0x52, 0x11, 0x27, 0x4e, 0x88, 0x63, 0xc5, 0xa9, 0x42, 0x42, 0xe2, 0x90, 0x3f, 0xa2, 0xc7,
+	0x2a, 0xe2, 0x80, 0x38, 0x00, 0x4a, 0x0e, 0x20, 0x8e, 0xfc, 0x05, 0x68, 0x77, 0xc6, 0xbb, 0xe3,
+	0xec, 0xd6, 0x71, 0x03, 0xb9, 0x6c, 0xe2, 0x99, 0xf7, 0xde, 0xf7, 0x7d, 0xef, 0x3d, 0xbf, 0xe7,
+	0x45, 0xcf, 0xda, 0x1c, 0xfa, 0x1c, 0x2a, 0x0e, 0x03, 0xe1, 0xb1, 0xb6, 0x2f, 0x18, 0x77, 0x2b,
+	0xc3, 0xab, 0x6d, 0x2a, 0xc8, 0xd5, 0x4a, 0x87, 0xba, 0x14, 0x18, 0x58, 0x03, 0x8f, 0x0b, 0x8e,
+	0xcf, 0x4b, 0x53, 0x4b, 0x37, 0xb5, 0x94, 0xe9, 0xfa, 0x6a, 0x87, 0x77, 0x78, 0x68, 0x57, 0x09,
+	0xfe, 0x93, 0x2e, 0xeb, 0x45, 0x15, 0xbd, 0x4d, 0x80, 0x46, 0x51, 0x6d, 0xce, 0x5c, 0x75, 0x6f,
+	0x4d, 0x43, 0x9f, 0xc0, 0x91, 0xf6, 0x6b, 0xd2, 0xbe, 0x25, 0x81, 0x14, 0x1f, 0x79, 0xb5, 0x42,
+	0xfa, 0xcc, 0xe5, 0x95, 0xf0, 0x29, 0x8f, 0x2e, 0xfe, 0x68, 0xa0, 0x73, 0x37, 0x68, 0x8f, 0x76,
+	0x88, 0xe0, 0xde, 0xfb, 0x4c, 0x74, 0x1d, 0x8f, 0x8c, 0xde, 0x76, 0x77, 0x38, 0xbe, 0x89, 0x56,
+	0x9c, 0xf1, 0x45, 0x8b, 0x38, 0x8e, 0x47, 0x01, 0x4c, 0xa3, 0x6c, 0x5c, 0x5e, 0xac, 0x99, 0x7b,
+	0xbb, 0x5b, 0xab, 0x2a, 0xf2, 0xb6, 0xbc, 0x69, 0x0a, 0x8f, 0xb9, 0x9d, 0xc6, 0x72, 0xe4, 0xa2,
+	0xce, 0x71, 0x1d, 0x2d, 0x8f, 0x54, 0xd8, 0x28, 0x4a, 0xf6, 0x88, 0x28, 0x67, 0xc6, 0x1e, 0xea,
+	0xf8, 0xfa, 0xc2, 0x57, 0xf7, 0x4b, 0x99, 0xbf, 0xee, 0x97, 0x32, 0x17, 0xef, 0x65, 0xd1, 0xe6,
+	0x7b, 0xa4, 0xc7, 0x9c, 0x00, 0xe3, 0x5d, 0x5f, 0x80, 0x20, 0xae, 0x13, 0xf8, 0xd0, 0x11, 0xf1,
+	0x1c, 0x68, 0x50, 0x9b, 0x7b, 0x0e, 0x7e, 0x07, 0xad, 0x0c, 0xc7, 0x46, 0x87, 0xb8, 0x6f, 0xee,
+	0xed, 0x6e, 0x5d, 0x50, 0xa8, 0x51, 0xa0, 0x43, 0x22, 0x86, 0x87, 0xce, 0xf1, 0x3d, 0x03, 0x9d,
+	0xe5, 0x31, 0x58, 0xcb, 0x93, 0x68, 0x66, 0xb6, 0x9c, 0xbb, 0x9c, 0xaf, 0x6e, 0xa8, 0x12, 0x59,
+	0x41, 0x09, 0xc7, 0xd5, 0xb6, 0x6e, 0x50, 0xbb, 0xce, 0x99, 0x5b, 0xbb, 0xf6, 0xf0, 0xb7, 0x52,
+	0xe6, 0x87, 0xdf, 0x4b, 0xcf, 0x77, 0x98, 0xe8, 0xfa, 0x6d, 0xcb, 0xe6, 0x7d, 0x55, 0x15, 0xf5,
+	0x67, 0x0b, 0x9c, 0xbb, 0x15, 0xf1, 0xc9, 0x80, 0xc2, 0xd8, 0x07, 0x1e, 0xfc, 0xf9, 0xd3, 0x73,
+	0x46, 0x03, 0xf3, 0x84, 0x3e, 0x2d, 0x13, 0x7f, 0x1b, 0xe8, 0x52, 0x2c, 0xc0, 0xb6, 0xfd, 0xbe,
+	0xdf, 0x23, 0x82, 0x3a, 0x75, 0xde, 0xef, 0x33, 0x00, 0xc6, 0xdd, 0x13, 0x4a, 0x46, 0x17, 0xe5,
+	0x49, 0x0c, 0x17, 0x16, 0x33, 0x5f, 0x7d, 0xd5, 0x9a, 0xd2, 0xf9, 0xd6, 0x74, 0x9e, 0xb5, 0xc5,
+	0x20, 0x45, 0x52, 0xb3, 0x1e, 0x5a, 0x13, 0xfb, 0x8f, 0x81, 0xca, 0x51, 0x90, 0xb7, 0x18, 0x08,
+	0xee, 0x31, 0x9b, 0xf4, 0x4e, 0xb6, 0xea, 0x05, 0x34, 0x3f, 0xa0, 0x1e, 0xe3, 0x52, 0xe3, 0x5c,
+	0x43, 0x7d, 0xc2, 0x1f, 0xa1, 0xd3, 0xe3, 0x06, 0xc8, 0x85, 0xe2, 0x5f, 0x99, 0x4d, 0x7c, 0x82,
+	0xb7, 0x2e, 0x7c, 0x1c, 0x52, 0x13, 0xfd, 0xb3, 0x81, 0x2e, 0x44, 0xce, 0x75, 0xdf, 0xf3, 0xa8,
+	0x2b, 0x4e, 0x56, 0xf1, 0x07, 0xb1, 0x32, 0x59, 0xd6, 0x17, 0x67, 0x53, 0x36, 0x49, 0xee, 0x08,
+	0x59, 0xdf, 0x67, 0xd1, 0xf9, 0x68, 0xe4, 0x34, 0x05, 0xf1, 0x04, 0x73, 0x3b, 0xc1, 0xc8, 0x51,
+	0xa2, 0xfe, 0xa7, 0xc1, 0x93, 0x9a, 0x9b, 0xec, 0xf1, 0x73, 0xd3, 0x46, 0x4f, 0x81, 0x22, 0xdb,
+	0x62, 0xee, 0x0e, 0x57, 0xb5, 0xaf, 0x4e, 0xcd, 0x50, 0xaa, 0x4e, 0x3d, 0x3f, 0x4b, 0xa0, 0x5d,
+	0x68, 0x49, 0xfa, 0x36, 0x8b, 0xd6, 0x22, 0x6a, 0xcd, 0x1e, 0x81, 0xee, 0xcd, 0x61, 0x98, 0xe1,
+	0x93, 0xea, 0xf4, 0x2e, 0x65, 0x9d, 0xae, 0x18, 0x77, 0xba, 0xfc, 0xa4, 0x7d, 0x03, 0x72, 0x13,
+	0xdf, 0x00, 0x8e, 0xce, 0xc5, 0xf8, 0x10, 0xb0, 0x6b, 0xd1, 0x80, 0x9e, 0x39, 0x17, 0xe6, 0xe4,
+	0xca, 0x6c, 0x5d, 0x13, 0xcb, 0xd2, 0x33, 0x72, 0x76, 0x98, 0xbc, 0xd7, 0x12, 0xb3, 0x87, 0xd0,
+	0xd2, 0x9b, 0x72, 0xe7, 0x36, 0x05, 0x11, 0x14, 0xdf, 0x42, 0xf3, 0x03, 0xe2, 0x91, 0xbe, 0x4c,
+	0x40, 0xbe, 0xfa, 0xcc, 0x54, 0xf0, 0x3b, 0xa1, 0xa9, 0x8e, 0xa7, 0xbc, 0xf1, 0x6d, 0xb4, 0xb0,
+	0x43, 0x69, 0x6b, 0xc0, 0x79, 0x4f, 0x35, 0xff, 0xa5, 0xa9, 0x91, 0x6e, 0x51, 0x7a, 0x87, 0xf3,
+	0xde, 0x44, 0xb3, 0xef, 0xc8, 0x33, 0x3c, 0x42, 0x66, 0xdc, 0xc2, 0xd1, 0xfa, 0x0b, 0xba, 0x26,
+	0x18, 0x19, 0xb9, 0xd9, 0xdb, 0x46, 0xdf, 0xc8, 0x3a, 0x52, 0xc1, 0x49, 0xb3, 0x00, 0xfc, 0x69,
+	0xfa, 0x9e, 0x3a, 0x15, 0x62, 0xbe, 0x3e, 0x5b, 0x59, 0x1e, 0xb7, 0x55, 0x75, 0xfc, 0x94, 0xd5,
+	0x84, 0xbf, 0x33, 0xd0, 0xa6, 0xd6, 0x95, 0xf1, 0x1c, 0x6f, 0xd9, 0xd1, 0xa8, 0x07, 0x73, 0x3e,
+	0xa4, 0xb2, 0xfd, 0x1f, 0xd6, 0x45, 0x92, 0x4d, 0x69, 0x38, 0xd5, 0x01, 0xf0, 0xd7, 0x06, 0xda,
+	0x88, 0xa9, 0x75, 0xa3, 0x39, 0x1c, 0x25, 0xe8, 0x74, 0xc8, 0xea, 0xb5, 0x63, 0xce, 0xf1, 0x24,
+	0xa3, 0xf5, 0xe1, 0x63, 0x8d, 0xf1, 0x17, 0x06, 0x5a, 0x8b, 0xc9, 0xd8, 0x72, 0x74, 0x46, 0x4c,
+	0x16, 0x42, 0x26, 0xd7, 0x8f, 0x33, 0x77, 0x93, 0x34, 0x9e, 0x1e, 0xa6, 0x5b, 0xe2, 0xcf, 0xf4,
+	0x06, 0x9d, 0x18, 0x6b, 0x60, 0x2e, 0x86, 0x0c, 0xae, 0x3d, 0xf9, 0x5c, 0x4b, 0xe2, 0x17, 0x9c,
+	0x34, 0x3b, 0xc0, 0x23, 0x54, 0x48, 0x9d, 0x1f, 0x60, 0xa2, 0x10, 0xfc, 0xe5, 0x27, 0x1d, 0x20,
+	0x49, 0xe8, 0xd5, 0x94, 0x31, 0x02, 0xf8, 0x4b, 0x03, 0x15, 0x88, 0x2f, 0x78, 0xd0, 0x92, 0x03,
+	0xee, 0xbb, 0x4e, 0x0b, 0xa8, 0x08, 0x78, 0x81, 0x99, 0x2f, 0xe7, 0x8e, 0x1c, 0x5d, 0xdb, 0xbe,
+	0xe0, 0x75, 0xe5, 0xd9, 0x94, 0x8e, 0xb5, 0x8d, 0x00, 0xf3, 0xd7, 0xdd, 0xad, 0x33, 0xf1, 0xaf,
+	0xb9, 0xf2, 0x15, 0xeb, 0xa5, 0xaa, 0xa2, 0x41, 0x92, 0x2e, 0x80, 0x3f, 0x47, 0xab, 0x93, 0x2c,
+	0xbc, 0xe0, 0x09, 0xe6, 0x52, 0xc8, 0xc1, 0x9a, 0x99, 0x43, 0x23, 0x78, 0x1c, 0xc1, 0x00, 0x93,
+	0xc3, 0x0e, 0xda, 0x32, 0xbe, 0x3d, 0xb7, 0x30, 0xb7, 0x7c, 0xaa, 0xf6, 0xc6, 0x83, 0xfd, 0xa2,
+	0xf1, 0x70, 0xbf, 0x68, 0x3c, 0xda, 0x2f, 0x1a, 0x7f, 0xec, 0x17, 0x8d, 0x6f, 0x0e, 0x8a, 0x99,
+	0x47, 0x07, 0xc5, 0xcc, 0x2f, 0x07, 0xc5, 0xcc, 0x87, 0x9b, 0x32, 0x2e, 0x38, 0x77, 0x2d, 0xc6,
+	0x2b, 0x1f, 0x4f, 0xbe, 0x89, 0x84, 0x3f, 0x5c, 0xdb, 0xf3, 0xe1, 0xdb, 0xc4, 0x0b, 0xff, 0x0e,
+	0x00, 0xb0, 0x48, 0x06, 0x3b, 0x2b, 0x0d, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -781,6 +790,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundRounds) > 0 {
+		for iNdEx := len(m.AutoCompoundRounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundRounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AutoCompoundSettings) > 0 {
+		for iNdEx := len(m.AutoCompoundSettings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundSettings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1089,6 +1126,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundSettings) > 0 {
+		for _, e := range m.AutoCompoundSettings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundRounds) > 0 {
+		for _, e := range m.AutoCompoundRounds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2325,6 +2374,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundSettings = append(m.AutoCompoundSettings, AutoCompoundSetting{})
+			if err := m.AutoCompoundSettings[len(m.AutoCompoundSettings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundRounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundRounds = append(m.AutoCompoundRounds, AutoCompoundRound{})
+			if err := m.AutoCompoundRounds[len(m.AutoCompoundRounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -53,16 +53,25 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height><period>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0a<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: AutoCompoundSetting
+//
+// - 0x0b<epochIdentifier><accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: []byte{}
+//
+// - 0x0c<epochIdentifier>: AutoCompoundRound
 var (
-	FeePoolKey                           = collections.NewPrefix(0) // key for global distribution state
-	ValidatorOutstandingRewardsPrefix    = collections.NewPrefix(2) // key for outstanding rewards
-	DelegatorWithdrawAddrPrefix          = collections.NewPrefix(3) // key for delegator withdraw address
-	DelegatorStartingInfoPrefix          = collections.NewPrefix(4) // key for delegator starting info
-	ValidatorHistoricalRewardsPrefix     = collections.NewPrefix(5) // key for historical validators rewards / stake
-	ValidatorCurrentRewardsPrefix        = collections.NewPrefix(6) // key for current validator rewards
-	ValidatorAccumulatedCommissionPrefix = collections.NewPrefix(7) // key for accumulated validator commission
-	ValidatorSlashEventPrefix            = collections.NewPrefix(8) // key for validator slash fraction
-	ParamsKey                            = collections.NewPrefix(9) // key for distribution module params
+	FeePoolKey                           = collections.NewPrefix(0)  // key for global distribution state
+	ValidatorOutstandingRewardsPrefix    = collections.NewPrefix(2)  // key for outstanding rewards
+	DelegatorWithdrawAddrPrefix          = collections.NewPrefix(3)  // key for delegator withdraw address
+	DelegatorStartingInfoPrefix          = collections.NewPrefix(4)  // key for delegator starting info
+	ValidatorHistoricalRewardsPrefix     = collections.NewPrefix(5)  // key for historical validators rewards / stake
+	ValidatorCurrentRewardsPrefix        = collections.NewPrefix(6)  // key for current validator rewards
+	ValidatorAccumulatedCommissionPrefix = collections.NewPrefix(7)  // key for accumulated validator commission
+	ValidatorSlashEventPrefix            = collections.NewPrefix(8)  // key for validator slash fraction
+	ParamsKey                            = collections.NewPrefix(9)  // key for distribution module params
+	AutoCompoundSettingPrefix            = collections.NewPrefix(10) // key for delegator auto-compounding settings
+	AutoCompoundByEpochPrefix            = collections.NewPrefix(11) // key for the index of auto-compounding settings by epoch identifier
+	AutoCompoundRoundPrefix              = collections.NewPrefix(12) // key for the auto-compounding rounds in progress
 )
 
 // Reserved prefixes
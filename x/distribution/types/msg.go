@@ -13,6 +13,10 @@ var (
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
 	_ sdk.Msg = (*MsgFundCommunityPool)(nil)
 	_ sdk.Msg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ sdk.Msg = (*MsgSetAutoCompound)(nil)
+	_ sdk.Msg = (*MsgRemoveAutoCompound)(nil)
+	_ sdk.Msg = (*MsgWithdrawTokenizeShareRecordReward)(nil)
+	_ sdk.Msg = (*MsgWithdrawAllTokenizeShareRecordReward)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr string) *MsgSetWithdrawAddress {
@@ -53,3 +57,21 @@ func NewMsgDepositValidatorRewardsPool(depositor, valAddr string, amount sdk.Coi
 		ValidatorAddress: valAddr,
 	}
 }
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound. An empty validator
+// address compounds all the delegations of the delegator.
+func NewMsgSetAutoCompound(delAddr, valAddr, epochIdentifier string) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		EpochIdentifier:  epochIdentifier,
+	}
+}
+
+// NewMsgRemoveAutoCompound returns a new MsgRemoveAutoCompound.
+func NewMsgRemoveAutoCompound(delAddr, valAddr string) *MsgRemoveAutoCompound {
+	return &MsgRemoveAutoCompound{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+	}
+}
@@ -7,13 +7,18 @@ import (
 	"cosmossdk.io/math"
 )
 
+// DefaultAutoCompoundBatchSize is the default maximum number of delegations
+// whose rewards are compounded in a block.
+const DefaultAutoCompoundBatchSize uint32 = 100
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        math.LegacyNewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
-		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:          math.LegacyNewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:    math.LegacyZeroDec(),            // deprecated
+		BonusProposerReward:   math.LegacyZeroDec(),            // deprecated
+		WithdrawAddrEnabled:   true,
+		AutoCompoundBatchSize: DefaultAutoCompoundBatchSize,
 	}
 }
 
@@ -960,6 +960,203 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryAutoCompoundSettingsRequest is the request type for the
+// Query/AutoCompoundSettings RPC method.
+type QueryAutoCompoundSettingsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAutoCompoundSettingsRequest) Reset()         { *m = QueryAutoCompoundSettingsRequest{} }
+func (m *QueryAutoCompoundSettingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundSettingsRequest) ProtoMessage()    {}
+func (*QueryAutoCompoundSettingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryAutoCompoundSettingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundSettingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundSettingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundSettingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundSettingsRequest.Merge(m, src)
+}
+func (m *QueryAutoCompoundSettingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundSettingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundSettingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundSettingsRequest proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundSettingsRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QueryAutoCompoundSettingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAutoCompoundSettingsResponse is the response type for the
+// Query/AutoCompoundSettings RPC method.
+type QueryAutoCompoundSettingsResponse struct {
+	// settings defines the auto-compounding settings of the delegator.
+	Settings []AutoCompoundSetting `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAutoCompoundSettingsResponse) Reset()         { *m = QueryAutoCompoundSettingsResponse{} }
+func (m *QueryAutoCompoundSettingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundSettingsResponse) ProtoMessage()    {}
+func (*QueryAutoCompoundSettingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryAutoCompoundSettingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundSettingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundSettingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundSettingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundSettingsResponse.Merge(m, src)
+}
+func (m *QueryAutoCompoundSettingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundSettingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundSettingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundSettingsResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundSettingsResponse) GetSettings() []AutoCompoundSetting {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+func (m *QueryAutoCompoundSettingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAutoCompoundRoundsRequest is the request type for the
+// Query/AutoCompoundRounds RPC method.
+type QueryAutoCompoundRoundsRequest struct {
+}
+
+func (m *QueryAutoCompoundRoundsRequest) Reset()         { *m = QueryAutoCompoundRoundsRequest{} }
+func (m *QueryAutoCompoundRoundsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundRoundsRequest) ProtoMessage()    {}
+func (*QueryAutoCompoundRoundsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{22}
+}
+func (m *QueryAutoCompoundRoundsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundRoundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundRoundsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundRoundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundRoundsRequest.Merge(m, src)
+}
+func (m *QueryAutoCompoundRoundsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundRoundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundRoundsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundRoundsRequest proto.InternalMessageInfo
+
+// QueryAutoCompoundRoundsResponse is the response type for the
+// Query/AutoCompoundRounds RPC method.
+type QueryAutoCompoundRoundsResponse struct {
+	// rounds defines the auto-compounding rounds in progress.
+	Rounds []AutoCompoundRound `protobuf:"bytes,1,rep,name=rounds,proto3" json:"rounds"`
+}
+
+func (m *QueryAutoCompoundRoundsResponse) Reset()         { *m = QueryAutoCompoundRoundsResponse{} }
+func (m *QueryAutoCompoundRoundsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundRoundsResponse) ProtoMessage()    {}
+func (*QueryAutoCompoundRoundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{23}
+}
+func (m *QueryAutoCompoundRoundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundRoundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundRoundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundRoundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundRoundsResponse.Merge(m, src)
+}
+func (m *QueryAutoCompoundRoundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundRoundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundRoundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundRoundsResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundRoundsResponse) GetRounds() []AutoCompoundRound {
+	if m != nil {
+		return m.Rounds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -981,6 +1178,10 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryAutoCompoundSettingsRequest)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundSettingsRequest")
+	proto.RegisterType((*QueryAutoCompoundSettingsResponse)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundSettingsResponse")
+	proto.RegisterType((*QueryAutoCompoundRoundsRequest)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundRoundsRequest")
+	proto.RegisterType((*QueryAutoCompoundRoundsResponse)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundRoundsResponse")
 }
 
 func init() {
@@ -988,89 +1189,99 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0x38, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x06, 0xe4, 0x6c, 0x53, 0x27, 0xdd,
-	0xd0, 0x26, 0x6a, 0x14, 0x6f, 0x9a, 0x8a, 0x52, 0x12, 0x2a, 0x88, 0x9d, 0x84, 0x56, 0x44, 0x69,
-	0xeb, 0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x9c, 0xa5, 0xf6, 0x8e, 0xb3, 0xb3, 0x8e, 0x89,
-	0xaa, 0x5e, 0xca, 0xa5, 0xf4, 0x84, 0xe0, 0xc2, 0x0d, 0x8e, 0x88, 0x13, 0x87, 0xdc, 0xe1, 0x58,
-	0xc1, 0xa5, 0x0a, 0x12, 0x42, 0x1c, 0x00, 0x25, 0x48, 0x94, 0x03, 0x88, 0x1b, 0x57, 0xe4, 0x99,
-	0x59, 0x7b, 0xd7, 0x5e, 0xaf, 0xff, 0xc9, 0x97, 0xc4, 0x9a, 0x9d, 0xf7, 0x7d, 0xef, 0x7b, 0xef,
-	0xcd, 0xec, 0x67, 0xc3, 0x74, 0x86, 0xd0, 0x3c, 0xa1, 0x8a, 0x66, 0x50, 0xdb, 0x32, 0xd2, 0x45,
-	0xdb, 0x20, 0xa6, 0xb2, 0x7b, 0x31, 0xad, 0xdb, 0xea, 0x45, 0x65, 0xa7, 0xa8, 0x5b, 0x7b, 0xb1,
-	0x82, 0x45, 0x6c, 0x82, 0x4f, 0xf3, 0x8d, 0x31, 0xf7, 0xc6, 0x98, 0xd8, 0x28, 0x5d, 0x10, 0x28,
-	0x69, 0x95, 0xea, 0x3c, 0xaa, 0x82, 0x51, 0x50, 0xb3, 0x86, 0xa9, 0xb2, 0xdd, 0x0c, 0x48, 0x1a,
-	0xcd, 0x92, 0x2c, 0x61, 0x1f, 0x95, 0xf2, 0x27, 0xb1, 0x3a, 0x9e, 0x25, 0x24, 0x9b, 0xd3, 0x15,
-	0xb5, 0x60, 0x28, 0xaa, 0x69, 0x12, 0x9b, 0x85, 0x50, 0xf1, 0x34, 0xea, 0xc6, 0x77, 0x90, 0x33,
-	0xc4, 0x70, 0x30, 0x63, 0x41, 0x2a, 0x3c, 0x19, 0xf3, 0xfd, 0x63, 0x7c, 0x7f, 0x8a, 0xa7, 0x21,
-	0x94, 0xf1, 0x47, 0x61, 0x35, 0x6f, 0x98, 0x44, 0x61, 0x7f, 0xf9, 0x92, 0x3c, 0x0a, 0xf8, 0x56,
-	0x59, 0xd3, 0x4d, 0xd5, 0x52, 0xf3, 0x34, 0xa9, 0xef, 0x14, 0x75, 0x6a, 0xcb, 0x77, 0xe1, 0x94,
-	0x67, 0x95, 0x16, 0x88, 0x49, 0x75, 0xbc, 0x06, 0x83, 0x05, 0xb6, 0x12, 0x41, 0x93, 0x68, 0xe6,
-	0xc4, 0xc2, 0x54, 0x2c, 0xa0, 0x70, 0x31, 0x1e, 0x1c, 0x1f, 0x7a, 0xf2, 0xcb, 0x44, 0xdf, 0x97,
-	0x7f, 0x7c, 0x7d, 0x01, 0x25, 0x45, 0xb4, 0x5c, 0x82, 0x73, 0x0c, 0xfe, 0x6d, 0x35, 0x67, 0x68,
-	0xaa, 0x4d, 0xac, 0x15, 0x57, 0xfc, 0x75, 0x73, 0x8b, 0x88, 0x3c, 0xf0, 0x06, 0x84, 0x77, 0x9d,
-	0x3d, 0x29, 0x55, 0xd3, 0x2c, 0x9d, 0x72, 0xee, 0xa1, 0xf8, 0xd9, 0x83, 0xfd, 0xb9, 0x33, 0x82,
-	0xbe, 0x82, 0xb3, 0xcc, 0xb7, 0xdc, 0xb6, 0x2d, 0xc3, 0xcc, 0x26, 0x47, 0x76, 0x6b, 0xd6, 0xe5,
-	0xbf, 0x43, 0x70, 0xbe, 0x19, 0xb3, 0xd0, 0xba, 0x0e, 0x23, 0xa4, 0xa0, 0x5b, 0x9d, 0x31, 0x0f,
-	0x3b, 0xa1, 0x62, 0x19, 0x3f, 0x44, 0x10, 0xa6, 0x7a, 0x6e, 0x2b, 0x95, 0x26, 0xa6, 0x96, 0xb2,
-	0xf4, 0x92, 0x6a, 0x69, 0x34, 0x12, 0x9a, 0xec, 0x9f, 0x39, 0xb1, 0x30, 0xee, 0x54, 0xb1, 0x3c,
-	0x01, 0x95, 0xea, 0xad, 0xe8, 0x99, 0x04, 0x31, 0xcc, 0xf8, 0x95, 0x72, 0xf9, 0xbe, 0xfa, 0x75,
-	0x62, 0x36, 0x6b, 0xd8, 0xdb, 0xc5, 0x74, 0x2c, 0x43, 0xf2, 0xa2, 0xa9, 0xe2, 0xdf, 0x1c, 0xd5,
-	0xee, 0x29, 0xf6, 0x5e, 0x41, 0xa7, 0x4e, 0x0c, 0xe5, 0xd5, 0x1e, 0x2e, 0x13, 0xc6, 0x89, 0xa9,
-	0x25, 0x39, 0x1d, 0xde, 0x01, 0xc8, 0x90, 0x7c, 0xde, 0xa0, 0xd4, 0x20, 0x66, 0xa4, 0xbf, 0x05,
-	0xf2, 0x4b, 0x1d, 0x90, 0x27, 0x5d, 0x24, 0xf2, 0x1e, 0x4c, 0x7b, 0xeb, 0x7d, 0xa3, 0x68, 0x53,
-	0x5b, 0x35, 0xb5, 0x72, 0x95, 0x78, 0x5a, 0xbd, 0xea, 0xf5, 0x47, 0x08, 0x66, 0x9a, 0x73, 0x8b,
-	0x6e, 0xdf, 0x85, 0xe3, 0x4e, 0x53, 0xf8, 0x68, 0x5f, 0x09, 0x1c, 0xed, 0x00, 0x48, 0xf7, 0xbc,
-	0x3b, 0x98, 0xf2, 0x0e, 0x4c, 0x78, 0x53, 0x49, 0x54, 0x4a, 0xd4, 0x2b, 0xf9, 0x8f, 0x11, 0x4c,
-	0x36, 0xe6, 0x14, 0xb2, 0xb7, 0x3c, 0x13, 0xc1, 0x95, 0x2f, 0xb5, 0xa6, 0x7c, 0x39, 0x93, 0x29,
-	0xe6, 0x8b, 0x39, 0xd5, 0xd6, 0xb5, 0x2a, 0xb0, 0x5b, 0xbc, 0x7b, 0x0c, 0x1e, 0x87, 0x60, 0xdc,
-	0x9b, 0xcc, 0xed, 0x9c, 0x4a, 0xb7, 0xf5, 0x5e, 0x35, 0x1f, 0x4f, 0xc3, 0x30, 0xb5, 0x55, 0xcb,
-	0x36, 0xcc, 0x6c, 0x6a, 0x5b, 0x37, 0xb2, 0xdb, 0x76, 0x24, 0x34, 0x89, 0x66, 0x06, 0x92, 0xcf,
-	0x39, 0xcb, 0xd7, 0xd8, 0x2a, 0x9e, 0x82, 0x93, 0x3a, 0x6b, 0x9f, 0xb3, 0xad, 0x9f, 0x6d, 0xfb,
-	0x3f, 0x5f, 0x14, 0x9b, 0xd6, 0x00, 0xaa, 0x57, 0x7d, 0x64, 0x80, 0x95, 0xe9, 0xbc, 0xe7, 0xe0,
-	0xf0, 0xb7, 0x49, 0xf5, 0xe6, 0xcb, 0xea, 0x42, 0x59, 0xd2, 0x15, 0xb9, 0x38, 0xf0, 0xe8, 0x8b,
-	0x89, 0x3e, 0xf9, 0x1b, 0x04, 0x67, 0x1a, 0x14, 0x43, 0xb4, 0xe5, 0x2d, 0x38, 0x4e, 0xf9, 0x52,
-	0x04, 0xb1, 0x53, 0x3a, 0xdf, 0x5a, 0x4f, 0x18, 0xce, 0xea, 0xae, 0x6e, 0xda, 0x9e, 0x29, 0x14,
-	0x58, 0xf8, 0x0d, 0x8f, 0x8c, 0x10, 0x93, 0x31, 0xdd, 0x54, 0x06, 0xcf, 0xc9, 0xad, 0x43, 0xfe,
-	0xd6, 0x51, 0xb0, 0xa2, 0xe7, 0xf4, 0x2c, 0x5b, 0xab, 0x39, 0xcc, 0xab, 0x10, 0xd6, 0xf8, 0xb3,
-	0xba, 0x7e, 0x46, 0x0e, 0xf6, 0xe7, 0x46, 0x05, 0x69, 0x4d, 0x1b, 0x2b, 0x21, 0x4e, 0x1b, 0x7d,
-	0xc7, 0x22, 0xd4, 0xf1, 0x58, 0x2c, 0xfe, 0xaf, 0xdc, 0x80, 0x67, 0xe5, 0x26, 0x7c, 0x82, 0x20,
-	0xda, 0x48, 0x82, 0xe8, 0x42, 0xc1, 0x7d, 0x27, 0xf4, 0xf2, 0xa2, 0xae, 0x5c, 0x13, 0x45, 0x90,
-	0x6b, 0x72, 0xba, 0x43, 0x6c, 0x35, 0xd7, 0x93, 0xda, 0xba, 0x6a, 0xf1, 0x0f, 0x82, 0xa9, 0x40,
-	0x5e, 0x51, 0x90, 0xf7, 0x6a, 0x0b, 0x72, 0x39, 0x70, 0x2c, 0xab, 0x68, 0x2b, 0x0e, 0x37, 0x47,
-	0xf4, 0xbb, 0x22, 0x71, 0x0e, 0x8e, 0xd9, 0x65, 0xd2, 0x1e, 0xbf, 0x14, 0x39, 0x89, 0x6c, 0x89,
-	0x0b, 0xb9, 0x92, 0x59, 0x65, 0x84, 0x7a, 0x57, 0xe6, 0x75, 0x71, 0x21, 0xfb, 0x72, 0x8a, 0x12,
-	0x47, 0x01, 0x2a, 0x43, 0xcb, 0xab, 0x3c, 0x94, 0x74, 0xad, 0xb8, 0xd0, 0x4a, 0xf0, 0xa2, 0x17,
-	0x6d, 0xd3, 0xb0, 0xb7, 0x35, 0x4b, 0x2d, 0x09, 0xe2, 0x9e, 0xc9, 0xd8, 0x15, 0xe6, 0xad, 0x31,
-	0xb1, 0xd0, 0x92, 0x80, 0x91, 0x92, 0x78, 0xd4, 0x32, 0xf1, 0x70, 0xc9, 0x0b, 0xe6, 0xe2, 0x5d,
-	0x80, 0x31, 0xc6, 0x5b, 0x7e, 0xdb, 0x14, 0x4d, 0xc3, 0xde, 0xbb, 0x49, 0x48, 0x4e, 0xa8, 0x5c,
-	0x7c, 0xfe, 0x60, 0x7f, 0x6e, 0xb8, 0xda, 0xfa, 0xc9, 0xf9, 0xd8, 0x4b, 0xf3, 0x11, 0x24, 0x7f,
-	0x8e, 0x40, 0xf2, 0x0b, 0x12, 0x19, 0xbe, 0x0f, 0x03, 0x05, 0x42, 0x72, 0x3d, 0x3e, 0xde, 0x8c,
-	0xa3, 0x41, 0x86, 0x0b, 0x3f, 0x87, 0xe1, 0x18, 0xcb, 0x10, 0x7f, 0x86, 0x60, 0x90, 0x5b, 0x66,
-	0xac, 0x04, 0x9e, 0xab, 0x7a, 0xbf, 0x2e, 0xcd, 0xb7, 0x1e, 0xc0, 0xa5, 0xcb, 0xb3, 0x0f, 0x7f,
-	0xf8, 0xfd, 0xd3, 0xd0, 0x39, 0x3c, 0xa5, 0x04, 0x7d, 0xbd, 0xe0, 0x7e, 0x1d, 0xff, 0x89, 0x60,
-	0xac, 0xa1, 0x63, 0xc6, 0xf1, 0xe6, 0xe4, 0xcd, 0x8c, 0xbe, 0x94, 0xe8, 0x0a, 0x43, 0x68, 0x4a,
-	0x30, 0x4d, 0x57, 0xf1, 0x52, 0xa0, 0xa6, 0xea, 0x69, 0x52, 0xee, 0xd7, 0xbd, 0x5c, 0x1e, 0xe0,
-	0x0f, 0x43, 0x70, 0x3a, 0xc0, 0xde, 0xe1, 0x95, 0x36, 0x32, 0x6d, 0x68, 0x76, 0xa5, 0xd5, 0x2e,
-	0x51, 0x84, 0xe2, 0x4d, 0xa6, 0xf8, 0x16, 0xbe, 0xd1, 0x85, 0x62, 0x85, 0x54, 0xf1, 0x9d, 0x6f,
-	0x26, 0xf8, 0x10, 0xc1, 0x29, 0x1f, 0xe3, 0x88, 0x5f, 0x6d, 0x23, 0xef, 0x3a, 0x8f, 0x2b, 0x5d,
-	0xed, 0x30, 0x5a, 0xa8, 0xdd, 0x60, 0x6a, 0xaf, 0xe1, 0xb5, 0x6e, 0xd4, 0x56, 0x5d, 0x29, 0xfe,
-	0x11, 0xc1, 0x48, 0xad, 0x07, 0xc3, 0xaf, 0xb4, 0x91, 0xa3, 0xd7, 0xc4, 0x4a, 0x8b, 0x9d, 0x84,
-	0x0a, 0x6d, 0x6f, 0x32, 0x6d, 0xab, 0x38, 0xd1, 0x8d, 0x36, 0xc7, 0xe8, 0xfd, 0x85, 0x20, 0x5c,
-	0xe7, 0x6b, 0x70, 0x0b, 0xe9, 0x35, 0xf2, 0x73, 0xd2, 0x52, 0x47, 0xb1, 0x42, 0x5b, 0x8a, 0x69,
-	0x7b, 0x07, 0x6f, 0x06, 0x6a, 0xab, 0xbc, 0x72, 0xa8, 0x72, 0xbf, 0xee, 0x8d, 0xf5, 0x40, 0x11,
-	0x93, 0xe9, 0x7b, 0x66, 0x9f, 0x21, 0x78, 0xc1, 0xdf, 0xbb, 0xe0, 0xd7, 0xda, 0x49, 0xdc, 0xc7,
-	0x6d, 0x49, 0xaf, 0x77, 0x0e, 0xd0, 0x56, 0x6b, 0x5b, 0x93, 0xcf, 0x0e, 0xa6, 0x8f, 0x81, 0x68,
-	0xe5, 0x60, 0x36, 0xf6, 0x3a, 0xad, 0x1c, 0xcc, 0x00, 0xd7, 0xd2, 0xe2, 0xc1, 0x6c, 0xa2, 0xb0,
-	0x3a, 0xdb, 0xf8, 0x5f, 0x04, 0x91, 0x46, 0xf6, 0x02, 0x2f, 0xb7, 0x91, 0xab, 0xbf, 0x27, 0x92,
-	0xe2, 0xdd, 0x40, 0x08, 0xcd, 0x77, 0x98, 0xe6, 0x0d, 0xbc, 0xde, 0x8d, 0xe6, 0x5a, 0x7f, 0x84,
-	0xbf, 0x47, 0x70, 0xd2, 0xe3, 0x55, 0xf0, 0xe5, 0xe6, 0xb9, 0xfa, 0x39, 0x22, 0xe9, 0xe5, 0xb6,
-	0xe3, 0x84, 0xb0, 0xeb, 0xdf, 0xd5, 0x1b, 0x15, 0xa6, 0x75, 0x0e, 0xcf, 0x06, 0x6a, 0xcd, 0x38,
-	0x70, 0xa9, 0xb2, 0xe1, 0x79, 0x14, 0x42, 0xf1, 0xa5, 0x27, 0x87, 0x51, 0xf4, 0xf4, 0x30, 0x8a,
-	0x7e, 0x3b, 0x8c, 0xa2, 0x8f, 0x8f, 0xa2, 0x7d, 0x4f, 0x8f, 0xa2, 0x7d, 0x3f, 0x1d, 0x45, 0xfb,
-	0xde, 0x3d, 0xcb, 0x81, 0xa8, 0x76, 0x2f, 0x66, 0x10, 0xe5, 0x03, 0x2f, 0x20, 0x33, 0x51, 0xe9,
-	0x41, 0xf6, 0x03, 0xe5, 0xa5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x54, 0xf1, 0x6b, 0xc6,
-	0x15, 0x00, 0x00,
+	// 1468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4f, 0x6c, 0x14, 0x55,
+	0x18, 0xef, 0x5b, 0x4a, 0x81, 0x0f, 0xb1, 0xed, 0xa3, 0x98, 0x65, 0x80, 0x6d, 0x99, 0x0a, 0x6d,
+	0x20, 0xdd, 0x29, 0x25, 0x20, 0xb6, 0xa0, 0x76, 0xb7, 0xad, 0x10, 0x1a, 0xfe, 0x2c, 0x68, 0xa3,
+	0x86, 0x6c, 0xa6, 0x3b, 0xc3, 0x76, 0x64, 0x77, 0xde, 0x76, 0xe6, 0x6d, 0x6b, 0x43, 0xb8, 0xe0,
+	0x05, 0x38, 0x19, 0xbd, 0x78, 0xd3, 0xa3, 0xf1, 0xe4, 0x81, 0x83, 0x37, 0x3d, 0x19, 0xa2, 0x17,
+	0x82, 0x89, 0x31, 0x26, 0xfe, 0x49, 0x6b, 0x22, 0x1e, 0x34, 0xde, 0x3c, 0x78, 0x31, 0xf3, 0xde,
+	0x9b, 0xdd, 0x99, 0xdd, 0x99, 0xd9, 0x7f, 0xec, 0xa5, 0x6c, 0xde, 0xbc, 0xef, 0xf7, 0xfb, 0x7e,
+	0xdf, 0xf7, 0xbd, 0xf7, 0xbe, 0x2f, 0xc0, 0x58, 0x8e, 0xd8, 0x45, 0x62, 0x2b, 0x9a, 0x61, 0x53,
+	0xcb, 0x58, 0x2e, 0x53, 0x83, 0x98, 0xca, 0xda, 0x89, 0x65, 0x9d, 0xaa, 0x27, 0x94, 0xd5, 0xb2,
+	0x6e, 0x6d, 0x24, 0x4b, 0x16, 0xa1, 0x04, 0x1f, 0xe0, 0x1b, 0x93, 0xde, 0x8d, 0x49, 0xb1, 0x51,
+	0x3a, 0x26, 0x50, 0x96, 0x55, 0x5b, 0xe7, 0x56, 0x15, 0x8c, 0x92, 0x9a, 0x37, 0x4c, 0x95, 0xed,
+	0x66, 0x40, 0xd2, 0x50, 0x9e, 0xe4, 0x09, 0xfb, 0xa9, 0x38, 0xbf, 0xc4, 0xea, 0xc1, 0x3c, 0x21,
+	0xf9, 0x82, 0xae, 0xa8, 0x25, 0x43, 0x51, 0x4d, 0x93, 0x50, 0x66, 0x62, 0x8b, 0xaf, 0x09, 0x2f,
+	0xbe, 0x8b, 0x9c, 0x23, 0x86, 0x8b, 0x99, 0x8c, 0x52, 0xe1, 0xf3, 0x98, 0xef, 0xdf, 0xcf, 0xf7,
+	0x67, 0xb9, 0x1b, 0x42, 0x19, 0xff, 0x34, 0xa8, 0x16, 0x0d, 0x93, 0x28, 0xec, 0x2f, 0x5f, 0x92,
+	0x87, 0x00, 0x5f, 0x75, 0x34, 0x5d, 0x51, 0x2d, 0xb5, 0x68, 0x67, 0xf4, 0xd5, 0xb2, 0x6e, 0x53,
+	0xf9, 0x06, 0xec, 0xf5, 0xad, 0xda, 0x25, 0x62, 0xda, 0x3a, 0x5e, 0x80, 0xbe, 0x12, 0x5b, 0x89,
+	0xa3, 0x11, 0x34, 0xbe, 0x7b, 0x6a, 0x34, 0x19, 0x11, 0xb8, 0x24, 0x37, 0x4e, 0xed, 0x7a, 0xf4,
+	0xcb, 0x70, 0xcf, 0x67, 0x7f, 0x7c, 0x71, 0x0c, 0x65, 0x84, 0xb5, 0xbc, 0x0e, 0x47, 0x18, 0xfc,
+	0x9b, 0x6a, 0xc1, 0xd0, 0x54, 0x4a, 0xac, 0x39, 0x8f, 0xfd, 0x05, 0xf3, 0x26, 0x11, 0x7e, 0xe0,
+	0x4b, 0x30, 0xb8, 0xe6, 0xee, 0xc9, 0xaa, 0x9a, 0x66, 0xe9, 0x36, 0xe7, 0xde, 0x95, 0x3a, 0xfc,
+	0xe4, 0xe1, 0xc4, 0x21, 0x41, 0x5f, 0xc1, 0x99, 0xe5, 0x5b, 0xae, 0x51, 0xcb, 0x30, 0xf3, 0x99,
+	0x81, 0xb5, 0x9a, 0x75, 0xf9, 0xef, 0x18, 0x1c, 0x6d, 0xc4, 0x2c, 0xb4, 0x2e, 0xc2, 0x00, 0x29,
+	0xe9, 0x56, 0x7b, 0xcc, 0xfd, 0xae, 0xa9, 0x58, 0xc6, 0x77, 0x11, 0x0c, 0xda, 0x7a, 0xe1, 0x66,
+	0x76, 0x99, 0x98, 0x5a, 0xd6, 0xd2, 0xd7, 0x55, 0x4b, 0xb3, 0xe3, 0xb1, 0x91, 0x6d, 0xe3, 0xbb,
+	0xa7, 0x0e, 0xba, 0x51, 0x74, 0x2a, 0xa0, 0x12, 0xbd, 0x39, 0x3d, 0x97, 0x26, 0x86, 0x99, 0x3a,
+	0xe3, 0x84, 0xef, 0xf3, 0x5f, 0x87, 0x8f, 0xe7, 0x0d, 0xba, 0x52, 0x5e, 0x4e, 0xe6, 0x48, 0x51,
+	0x24, 0x55, 0xfc, 0x33, 0x61, 0x6b, 0xb7, 0x14, 0xba, 0x51, 0xd2, 0x6d, 0xd7, 0xc6, 0xe6, 0xd1,
+	0xee, 0x77, 0x08, 0x53, 0xc4, 0xd4, 0x32, 0x9c, 0x0e, 0xaf, 0x02, 0xe4, 0x48, 0xb1, 0x68, 0xd8,
+	0xb6, 0x41, 0xcc, 0xf8, 0xb6, 0x26, 0xc8, 0x4f, 0xb6, 0x41, 0x9e, 0xf1, 0x90, 0xc8, 0x1b, 0x30,
+	0xe6, 0x8f, 0xf7, 0xe5, 0x32, 0xb5, 0xa9, 0x6a, 0x6a, 0x4e, 0x94, 0xb8, 0x5b, 0xdd, 0xca, 0xf5,
+	0x7d, 0x04, 0xe3, 0x8d, 0xb9, 0x45, 0xb6, 0x6f, 0xc0, 0x0e, 0x37, 0x29, 0xbc, 0xb4, 0xcf, 0x44,
+	0x96, 0x76, 0x04, 0xa4, 0xb7, 0xde, 0x5d, 0x4c, 0x79, 0x15, 0x86, 0xfd, 0xae, 0xa4, 0x2b, 0x21,
+	0xea, 0x96, 0xfc, 0x07, 0x08, 0x46, 0xc2, 0x39, 0x85, 0xec, 0x9b, 0xbe, 0x8a, 0xe0, 0xca, 0x67,
+	0x9a, 0x53, 0x3e, 0x9b, 0xcb, 0x95, 0x8b, 0xe5, 0x82, 0x4a, 0x75, 0xad, 0x0a, 0xec, 0x15, 0xef,
+	0x2d, 0x83, 0x07, 0x31, 0x38, 0xe8, 0x77, 0xe6, 0x5a, 0x41, 0xb5, 0x57, 0xf4, 0x6e, 0x25, 0x1f,
+	0x8f, 0x41, 0xbf, 0x4d, 0x55, 0x8b, 0x1a, 0x66, 0x3e, 0xbb, 0xa2, 0x1b, 0xf9, 0x15, 0x1a, 0x8f,
+	0x8d, 0xa0, 0xf1, 0xde, 0xcc, 0xf3, 0xee, 0xf2, 0x79, 0xb6, 0x8a, 0x47, 0x61, 0x8f, 0x6e, 0x6a,
+	0x9e, 0x6d, 0xdb, 0xd8, 0xb6, 0xe7, 0xf8, 0xa2, 0xd8, 0xb4, 0x00, 0x50, 0xbd, 0xea, 0xe3, 0xbd,
+	0x2c, 0x4c, 0x47, 0x7d, 0x07, 0x87, 0xbf, 0x26, 0xd5, 0x9b, 0x2f, 0xaf, 0x0b, 0x65, 0x19, 0x8f,
+	0xe5, 0x74, 0xef, 0xbd, 0x4f, 0x87, 0x7b, 0xe4, 0xaf, 0x10, 0x1c, 0x0a, 0x09, 0x86, 0x48, 0xcb,
+	0x1b, 0xb0, 0xc3, 0xe6, 0x4b, 0x71, 0xc4, 0x4e, 0xe9, 0x64, 0x73, 0x39, 0x61, 0x38, 0xf3, 0x6b,
+	0xba, 0x49, 0x7d, 0x55, 0x28, 0xb0, 0xf0, 0xeb, 0x3e, 0x19, 0x31, 0x26, 0x63, 0xac, 0xa1, 0x0c,
+	0xee, 0x93, 0x57, 0x87, 0xfc, 0xb5, 0xab, 0x60, 0x4e, 0x2f, 0xe8, 0x79, 0xb6, 0x56, 0x73, 0x98,
+	0xe7, 0x61, 0x50, 0xe3, 0xdf, 0xea, 0xf2, 0x19, 0x7f, 0xf2, 0x70, 0x62, 0x48, 0x90, 0xd6, 0xa4,
+	0xb1, 0x62, 0xe2, 0xa6, 0x31, 0xb0, 0x2c, 0x62, 0x6d, 0x97, 0xc5, 0xf4, 0x4e, 0x27, 0x01, 0x4f,
+	0x9d, 0x24, 0x7c, 0x88, 0x20, 0x11, 0x26, 0x41, 0x64, 0xa1, 0xe4, 0xbd, 0x13, 0xba, 0x79, 0x51,
+	0x57, 0xae, 0x89, 0x32, 0xc8, 0x35, 0x3e, 0x5d, 0x27, 0x54, 0x2d, 0x74, 0x25, 0xb6, 0x9e, 0x58,
+	0xfc, 0x83, 0x60, 0x34, 0x92, 0x57, 0x04, 0xe4, 0x9d, 0xda, 0x80, 0x9c, 0x8e, 0x2c, 0xcb, 0x2a,
+	0xda, 0x9c, 0xcb, 0xcd, 0x11, 0x83, 0xae, 0x48, 0x5c, 0x80, 0xed, 0xd4, 0x21, 0xed, 0xf2, 0xa3,
+	0xc8, 0x49, 0x64, 0x4b, 0x5c, 0xc8, 0x15, 0xcf, 0x2a, 0x25, 0xd4, 0xbd, 0x30, 0x2f, 0xc2, 0x48,
+	0x38, 0xa7, 0x08, 0x71, 0x02, 0xa0, 0x52, 0xb4, 0x3c, 0xca, 0xbb, 0x32, 0x9e, 0x15, 0x0f, 0xda,
+	0x3a, 0xbc, 0xe8, 0x47, 0x5b, 0x32, 0xe8, 0x8a, 0x66, 0xa9, 0xeb, 0x82, 0xb8, 0x6b, 0x32, 0xd6,
+	0xe0, 0x48, 0x03, 0x62, 0xa1, 0x25, 0x0d, 0x03, 0xeb, 0xe2, 0x53, 0xd3, 0xc4, 0xfd, 0xeb, 0x7e,
+	0x30, 0x0f, 0xef, 0x14, 0xec, 0x67, 0xbc, 0xce, 0x6b, 0x53, 0x36, 0x0d, 0xba, 0x71, 0x85, 0x90,
+	0x82, 0x50, 0x39, 0xbd, 0xef, 0xc9, 0xc3, 0x89, 0xfe, 0x6a, 0xea, 0x47, 0x26, 0x93, 0xa7, 0x26,
+	0xe3, 0x48, 0xfe, 0x04, 0x81, 0x14, 0x64, 0x24, 0x3c, 0x7c, 0x17, 0x7a, 0x4b, 0x84, 0x14, 0xba,
+	0x7c, 0xbc, 0x19, 0x47, 0x98, 0x87, 0xdf, 0xb8, 0xcf, 0xf4, 0x6c, 0x99, 0x92, 0x34, 0x29, 0x96,
+	0x48, 0xd9, 0xd4, 0xae, 0xe9, 0xd4, 0x79, 0xa2, 0x9e, 0xf5, 0x6d, 0xba, 0x10, 0x70, 0xff, 0xb7,
+	0xf3, 0x8c, 0xed, 0xad, 0x97, 0x32, 0x25, 0xff, 0x84, 0xe0, 0x70, 0x84, 0x10, 0x11, 0xf1, 0x25,
+	0xd8, 0x69, 0x8b, 0xb5, 0xa6, 0x9e, 0xb6, 0x00, 0x30, 0xef, 0xed, 0x51, 0x01, 0x7b, 0x66, 0x6f,
+	0x5b, 0xb0, 0xb8, 0x53, 0xe2, 0xb1, 0xf0, 0xba, 0x93, 0x71, 0xfe, 0xb8, 0x29, 0x0a, 0x36, 0xbb,
+	0x8f, 0x60, 0x38, 0xd4, 0x4e, 0x44, 0xe4, 0x2a, 0xf4, 0x59, 0x6c, 0x45, 0xc4, 0x23, 0xd9, 0x74,
+	0x3c, 0x18, 0x90, 0x6f, 0xbc, 0xe2, 0x40, 0x81, 0xbe, 0x4c, 0x7d, 0xb9, 0x0f, 0xb6, 0x33, 0x5f,
+	0xf0, 0xc7, 0x08, 0xfa, 0xf8, 0x6c, 0x86, 0x95, 0x48, 0xb2, 0xfa, 0xc1, 0x50, 0x9a, 0x6c, 0xde,
+	0x80, 0xeb, 0x93, 0x8f, 0xdf, 0xfd, 0xfe, 0xf7, 0x8f, 0x62, 0x47, 0xf0, 0xa8, 0x12, 0x35, 0xc7,
+	0xf2, 0xc1, 0x10, 0xff, 0x89, 0x60, 0x7f, 0xe8, 0x68, 0x86, 0x53, 0x8d, 0xc9, 0x1b, 0x4d, 0x94,
+	0x52, 0xba, 0x23, 0x0c, 0xa1, 0x29, 0xcd, 0x34, 0x9d, 0xc3, 0x33, 0x91, 0x9a, 0xaa, 0xd7, 0xb6,
+	0x72, 0xbb, 0xae, 0x8b, 0xb9, 0x83, 0xdf, 0x8f, 0xc1, 0x81, 0x88, 0x39, 0x02, 0xcf, 0xb5, 0xe0,
+	0x69, 0xe8, 0x54, 0x25, 0xcd, 0x77, 0x88, 0x22, 0x14, 0x2f, 0x31, 0xc5, 0x57, 0xf1, 0xe5, 0x0e,
+	0x14, 0x2b, 0xa4, 0x8a, 0xef, 0x8e, 0xc0, 0x78, 0x13, 0xc1, 0xde, 0x80, 0x09, 0x05, 0x9f, 0x6d,
+	0xc1, 0xef, 0xba, 0x61, 0x4a, 0x3a, 0xd7, 0xa6, 0xb5, 0x50, 0x7b, 0x89, 0xa9, 0x3d, 0x8f, 0x17,
+	0x3a, 0x51, 0x5b, 0x1d, 0x7f, 0xf0, 0x0f, 0x08, 0x06, 0x6a, 0x9b, 0x7d, 0xfc, 0x72, 0x0b, 0x3e,
+	0xfa, 0xa7, 0x25, 0x69, 0xba, 0x1d, 0x53, 0xa1, 0xed, 0x22, 0xd3, 0x36, 0x8f, 0xd3, 0x9d, 0x68,
+	0x73, 0x27, 0x8a, 0xbf, 0x10, 0x0c, 0xd6, 0x35, 0xd0, 0xb8, 0x09, 0xf7, 0xc2, 0x06, 0x07, 0x69,
+	0xa6, 0x2d, 0x5b, 0xa1, 0x2d, 0xcb, 0xb4, 0xbd, 0x85, 0x97, 0x22, 0xb5, 0x55, 0xde, 0x45, 0x5b,
+	0xb9, 0x5d, 0xf7, 0xac, 0xde, 0x51, 0x44, 0x65, 0x06, 0x9e, 0xd9, 0xa7, 0x08, 0x5e, 0x08, 0x6e,
+	0x92, 0xf1, 0xab, 0xad, 0x38, 0x1e, 0xd0, 0xd6, 0x4b, 0xaf, 0xb5, 0x0f, 0xd0, 0x52, 0x6a, 0x9b,
+	0x93, 0xcf, 0x0e, 0x66, 0x40, 0xa7, 0xda, 0xcc, 0xc1, 0x0c, 0x6f, 0xaa, 0xa5, 0x73, 0x6d, 0x5a,
+	0xb7, 0x74, 0x30, 0x1b, 0x28, 0xac, 0xd6, 0x36, 0xfe, 0x17, 0x41, 0x3c, 0xac, 0x8f, 0xc5, 0xb3,
+	0x2d, 0xf8, 0x1a, 0xdc, 0x7c, 0x4b, 0xa9, 0x4e, 0x20, 0x84, 0xe6, 0xeb, 0x4c, 0xf3, 0x25, 0xbc,
+	0xd8, 0x89, 0xe6, 0xda, 0x46, 0x1c, 0x7f, 0x87, 0x60, 0x8f, 0xaf, 0x29, 0xc6, 0xa7, 0x1b, 0xfb,
+	0x1a, 0xd4, 0x7a, 0x4b, 0x2f, 0xb5, 0x6c, 0x27, 0x84, 0x5d, 0xf8, 0xb6, 0xbe, 0x23, 0x66, 0x5a,
+	0x27, 0xf0, 0xf1, 0x48, 0xad, 0x39, 0x17, 0x2e, 0xeb, 0x74, 0xd6, 0xf7, 0x62, 0x08, 0xff, 0x87,
+	0x60, 0x28, 0xa8, 0xef, 0xc4, 0x4d, 0xd4, 0x5b, 0x44, 0xe3, 0x2d, 0xbd, 0xd2, 0xae, 0xb9, 0x90,
+	0xa8, 0xd5, 0x4b, 0x9c, 0x62, 0x12, 0x2f, 0xe2, 0x0b, 0x9d, 0xa4, 0x53, 0x2d, 0x53, 0x92, 0xcd,
+	0x09, 0x56, 0xfc, 0x33, 0x02, 0x5c, 0xdf, 0x61, 0xe2, 0x99, 0xd6, 0x9c, 0xf7, 0xf5, 0xb3, 0xd2,
+	0xd9, 0xf6, 0x8c, 0x85, 0xee, 0xc5, 0x30, 0xdd, 0x27, 0xf1, 0x89, 0x48, 0xdd, 0x3e, 0x61, 0x59,
+	0xde, 0xcf, 0xa6, 0x66, 0x1e, 0x6d, 0x26, 0xd0, 0xe3, 0xcd, 0x04, 0xfa, 0x6d, 0x33, 0x81, 0x3e,
+	0xd8, 0x4a, 0xf4, 0x3c, 0xde, 0x4a, 0xf4, 0xfc, 0xb8, 0x95, 0xe8, 0x79, 0xfb, 0x30, 0xc7, 0xb2,
+	0xb5, 0x5b, 0x49, 0x83, 0x28, 0xef, 0xf9, 0x31, 0xd9, 0x2c, 0xb6, 0xdc, 0xc7, 0xfe, 0x9f, 0xe3,
+	0xe4, 0xff, 0x03, 0x00, 0x94, 0x92, 0x86, 0xd0, 0x0d, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1108,6 +1319,10 @@ type QueryClient interface {
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// AutoCompoundSettings queries the auto-compounding settings of a delegator.
+	AutoCompoundSettings(ctx context.Context, in *QueryAutoCompoundSettingsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundSettingsResponse, error)
+	// AutoCompoundRounds queries the auto-compounding rounds in progress.
+	AutoCompoundRounds(ctx context.Context, in *QueryAutoCompoundRoundsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundRoundsResponse, error)
 }
 
 type queryClient struct {
@@ -1209,6 +1424,24 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) AutoCompoundSettings(ctx context.Context, in *QueryAutoCompoundSettingsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundSettingsResponse, error) {
+	out := new(QueryAutoCompoundSettingsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/AutoCompoundSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AutoCompoundRounds(ctx context.Context, in *QueryAutoCompoundRoundsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundRoundsResponse, error) {
+	out := new(QueryAutoCompoundRoundsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/AutoCompoundRounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1234,6 +1467,10 @@ type QueryServer interface {
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// AutoCompoundSettings queries the auto-compounding settings of a delegator.
+	AutoCompoundSettings(context.Context, *QueryAutoCompoundSettingsRequest) (*QueryAutoCompoundSettingsResponse, error)
+	// AutoCompoundRounds queries the auto-compounding rounds in progress.
+	AutoCompoundRounds(context.Context, *QueryAutoCompoundRoundsRequest) (*QueryAutoCompoundRoundsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1270,6 +1507,12 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) AutoCompoundSettings(ctx context.Context, req *QueryAutoCompoundSettingsRequest) (*QueryAutoCompoundSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompoundSettings not implemented")
+}
+func (*UnimplementedQueryServer) AutoCompoundRounds(ctx context.Context, req *QueryAutoCompoundRoundsRequest) (*QueryAutoCompoundRoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompoundRounds not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1455,6 +1698,42 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutoCompoundSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/AutoCompoundSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundSettings(ctx, req.(*QueryAutoCompoundSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoCompoundRounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundRoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundRounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/AutoCompoundRounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundRounds(ctx, req.(*QueryAutoCompoundRoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
@@ -1500,6 +1779,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "AutoCompoundSettings",
+			Handler:    _Query_AutoCompoundSettings_Handler,
+		},
+		{
+			MethodName: "AutoCompoundRounds",
+			Handler:    _Query_AutoCompoundRounds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -2213,64 +2500,215 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAutoCompoundSettingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAutoCompoundSettingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorDistributionInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAutoCompoundSettingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorDistributionInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAutoCompoundSettingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundSettingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundSettingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.SelfBondRewards) > 0 {
-		for _, e := range m.SelfBondRewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Settings) > 0 {
+		for iNdEx := len(m.Settings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundRoundsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundRoundsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundRoundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundRoundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundRoundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundRoundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rounds) > 0 {
+		for iNdEx := len(m.Rounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorDistributionInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorDistributionInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SelfBondRewards) > 0 {
+		for _, e := range m.SelfBondRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
 	if len(m.Commission) > 0 {
@@ -2516,6 +2954,66 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAutoCompoundSettingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundSettingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Settings) > 0 {
+		for _, e := range m.Settings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundRoundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAutoCompoundRoundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rounds) > 0 {
+		for _, e := range m.Rounds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4353,6 +4851,378 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAutoCompoundSettingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundSettingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundSettingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundSettingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundSettingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundSettingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settings = append(m.Settings, AutoCompoundSetting{})
+			if err := m.Settings[len(m.Settings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundRoundsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundRoundsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundRoundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundRoundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundRoundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundRoundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rounds = append(m.Rounds, AutoCompoundRound{})
+			if err := m.Rounds[len(m.Rounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,8 +1,12 @@
 package epochs
 
 import (
+	"fmt"
+
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	epochsv1beta1 "cosmossdk.io/api/cosmos/epochs/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/version"
 )
 
 // AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
@@ -23,5 +27,34 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 			},
 		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: epochsv1beta1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "CreateEpoch",
+					Use:            "create-epoch-proposal <identifier> <duration> [start-time]",
+					Short:          "Submit a proposal to register a new epoch identifier",
+					Example:        fmt.Sprintf(`%s tx epochs create-epoch-proposal fortnight 336h`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}, {ProtoField: "duration"}, {ProtoField: "start_time", Optional: true}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "UpdateEpochDuration",
+					Use:            "update-epoch-duration-proposal <identifier> <duration>",
+					Short:          "Submit a proposal to change the duration of an epoch, effective at the next epoch boundary",
+					Example:        fmt.Sprintf(`%s tx epochs update-epoch-duration-proposal day 12h`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}, {ProtoField: "duration"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "DeleteEpoch",
+					Use:            "delete-epoch-proposal <identifier>",
+					Short:          "Submit a proposal to remove an epoch identifier",
+					Example:        fmt.Sprintf(`%s tx epochs delete-epoch-proposal fortnight`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}},
+					GovProposal:    true,
+				},
+			},
+		},
 	}
 }
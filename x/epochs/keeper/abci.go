@@ -59,6 +59,12 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 				k.Logger.Debug(fmt.Sprintf("Starting epoch with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
 			}
 
+			// apply a duration update scheduled during the previous epoch
+			if epochInfo.NextDuration != 0 {
+				epochInfo.Duration = epochInfo.NextDuration
+				epochInfo.NextDuration = 0
+			}
+
 			// emit new epoch start event, set epoch info, and run BeforeEpochStart hook
 			err = k.EventService.EventManager(ctx).Emit(&types.EventEpochStart{
 				EpochNumber:    epochInfo.CurrentEpoch,
@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/epochs/types"
 )
 
@@ -64,3 +67,46 @@ func (k Keeper) NumBlocksSinceEpochStart(ctx context.Context, identifier string)
 	}
 	return k.HeaderService.HeaderInfo(ctx).Height - epoch.CurrentEpochStartHeight, nil
 }
+
+// UpdateEpochDuration schedules a new duration for an existing epoch.
+// If the epoch has not started counting yet the duration is replaced right away,
+// otherwise it takes effect once the current epoch ends.
+func (k Keeper) UpdateEpochDuration(ctx context.Context, identifier string, duration time.Duration) error {
+	if duration <= 0 {
+		return errors.New("epoch duration must be positive")
+	}
+
+	epoch, err := k.EpochInfo.Get(ctx, identifier)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return fmt.Errorf("%w: %s", types.ErrEpochNotFound, identifier)
+		}
+		return err
+	}
+
+	if !epoch.EpochCountingStarted {
+		epoch.Duration = duration
+		epoch.NextDuration = 0
+	} else {
+		epoch.NextDuration = duration
+	}
+
+	return k.EpochInfo.Set(ctx, identifier, epoch)
+}
+
+// RemoveEpochInfo deletes an epoch by identifier and notifies the hooks of its removal.
+func (k Keeper) RemoveEpochInfo(ctx context.Context, identifier string) error {
+	isExist, err := k.EpochInfo.Has(ctx, identifier)
+	if err != nil {
+		return err
+	}
+	if !isExist {
+		return fmt.Errorf("%w: %s", types.ErrEpochNotFound, identifier)
+	}
+
+	if err := k.EpochInfo.Remove(ctx, identifier); err != nil {
+		return err
+	}
+
+	return k.AfterEpochRemoved(ctx, identifier)
+}
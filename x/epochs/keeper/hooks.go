@@ -25,3 +25,12 @@ func (k Keeper) AfterEpochEnd(ctx context.Context, identifier string, epochNumbe
 func (k Keeper) BeforeEpochStart(ctx context.Context, identifier string, epochNumber int64) error {
 	return k.Hooks().BeforeEpochStart(ctx, identifier, epochNumber)
 }
+
+// AfterEpochRemoved is called once an epoch identifier has been deleted.
+// Only hooks implementing types.EpochRemovalHooks are notified.
+func (k Keeper) AfterEpochRemoved(ctx context.Context, identifier string) error {
+	if h, ok := k.Hooks().(types.EpochRemovalHooks); ok {
+		return h.AfterEpochRemoved(ctx, identifier)
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 type KeeperTestSuite struct {
@@ -29,6 +30,7 @@ type KeeperTestSuite struct {
 	environment  appmodule.Environment
 	EpochsKeeper *epochskeeper.Keeper
 	queryClient  types.QueryClient
+	msgServer    types.MsgServer
 }
 
 func (s *KeeperTestSuite) SetupTest() {
@@ -47,6 +49,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
 	grpcQueryService.SetInterfaceRegistry(encCfg.InterfaceRegistry)
 	s.queryClient = types.NewQueryClient(grpcQueryService)
+	s.msgServer = epochskeeper.NewMsgServerImpl(s.EpochsKeeper)
 }
 
 func Setup(t *testing.T) (sdk.Context, *epochskeeper.Keeper, appmodule.Environment) {
@@ -62,6 +65,7 @@ func Setup(t *testing.T) (sdk.Context, *epochskeeper.Keeper, appmodule.Environme
 	epochsKeeper := epochskeeper.NewKeeper(
 		environment,
 		encCfg.Codec,
+		authtypes.NewModuleAddress("gov").String(),
 	)
 	epochsKeeper = epochsKeeper.SetHooks(types.NewMultiEpochHooks())
 	ctx.WithHeaderInfo(header.Info{Height: 1, Time: time.Now().UTC(), ChainID: "epochs"})
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/epochs/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/epochs MsgServer interface.
+func NewMsgServerImpl(k *Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// CreateEpoch registers a new epoch identifier.
+func (ms msgServer) CreateEpoch(ctx context.Context, msg *types.MsgCreateEpoch) (*types.MsgCreateEpochResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	epoch := types.NewGenesisEpochInfo(msg.Identifier, msg.Duration)
+	epoch.StartTime = msg.StartTime
+	if err := ms.AddEpochInfo(ctx, epoch); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateEpochResponse{}, nil
+}
+
+// UpdateEpochDuration schedules a new duration for an existing epoch.
+func (ms msgServer) UpdateEpochDuration(ctx context.Context, msg *types.MsgUpdateEpochDuration) (*types.MsgUpdateEpochDurationResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := ms.Keeper.UpdateEpochDuration(ctx, msg.Identifier, msg.Duration); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateEpochDurationResponse{}, nil
+}
+
+// DeleteEpoch removes an existing epoch identifier.
+func (ms msgServer) DeleteEpoch(ctx context.Context, msg *types.MsgDeleteEpoch) (*types.MsgDeleteEpochResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := ms.RemoveEpochInfo(ctx, msg.Identifier); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteEpochResponse{}, nil
+}
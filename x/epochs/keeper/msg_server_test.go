@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/core/header"
+	coretesting "cosmossdk.io/core/testing"
+	storetypes "cosmossdk.io/store/types"
+	epochskeeper "cosmossdk.io/x/epochs/keeper"
+	"cosmossdk.io/x/epochs/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (s *KeeperTestSuite) TestCreateEpoch() {
+	startTime := time.Unix(1656907200, 0).UTC()
+	testCases := []struct {
+		name      string
+		request   *types.MsgCreateEpoch
+		expectErr bool
+	}{
+		{
+			name: "invalid authority",
+			request: &types.MsgCreateEpoch{
+				Authority:  "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+				Identifier: "fortnight",
+				Duration:   14 * 24 * time.Hour,
+			},
+			expectErr: true,
+		},
+		{
+			name: "zero duration",
+			request: &types.MsgCreateEpoch{
+				Authority:  s.EpochsKeeper.GetAuthority(),
+				Identifier: "fortnight",
+			},
+			expectErr: true,
+		},
+		{
+			name: "existing identifier",
+			request: &types.MsgCreateEpoch{
+				Authority:  s.EpochsKeeper.GetAuthority(),
+				Identifier: "day",
+				Duration:   time.Hour,
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid epoch",
+			request: &types.MsgCreateEpoch{
+				Authority:  s.EpochsKeeper.GetAuthority(),
+				Identifier: "fortnight",
+				StartTime:  startTime,
+				Duration:   14 * 24 * time.Hour,
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			_, err := s.msgServer.CreateEpoch(s.Ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			epoch, err := s.EpochsKeeper.GetEpochInfo(s.Ctx, tc.request.Identifier)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.request.Duration, epoch.Duration)
+			s.Require().Equal(tc.request.StartTime, epoch.StartTime)
+			s.Require().False(epoch.EpochCountingStarted)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateEpochDuration() {
+	s.SetupTest()
+	authority := s.EpochsKeeper.GetAuthority()
+
+	_, err := s.msgServer.UpdateEpochDuration(s.Ctx, &types.MsgUpdateEpochDuration{
+		Authority:  "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+		Identifier: "day",
+		Duration:   time.Hour,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateEpochDuration(s.Ctx, &types.MsgUpdateEpochDuration{
+		Authority:  authority,
+		Identifier: "unknown",
+		Duration:   time.Hour,
+	})
+	s.Require().ErrorIs(err, types.ErrEpochNotFound)
+
+	_, err = s.msgServer.UpdateEpochDuration(s.Ctx, &types.MsgUpdateEpochDuration{
+		Authority:  authority,
+		Identifier: "day",
+	})
+	s.Require().Error(err)
+
+	// start counting so that the update is deferred to the next boundary
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(s.Ctx))
+	before, err := s.EpochsKeeper.GetEpochInfo(s.Ctx, "day")
+	s.Require().NoError(err)
+	s.Require().True(before.EpochCountingStarted)
+
+	_, err = s.msgServer.UpdateEpochDuration(s.Ctx, &types.MsgUpdateEpochDuration{
+		Authority:  authority,
+		Identifier: "day",
+		Duration:   12 * time.Hour,
+	})
+	s.Require().NoError(err)
+
+	pending, err := s.EpochsKeeper.GetEpochInfo(s.Ctx, "day")
+	s.Require().NoError(err)
+	s.Require().Equal(24*time.Hour, pending.Duration)
+	s.Require().Equal(12*time.Hour, pending.NextDuration)
+
+	// the current epoch still ends after the original duration
+	s.Ctx = s.Ctx.WithHeaderInfo(header.Info{Height: 2, Time: before.CurrentEpochStartTime.Add(13 * time.Hour)})
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(s.Ctx))
+	epoch, err := s.EpochsKeeper.GetEpochInfo(s.Ctx, "day")
+	s.Require().NoError(err)
+	s.Require().Equal(before.CurrentEpoch, epoch.CurrentEpoch)
+
+	s.Ctx = s.Ctx.WithHeaderInfo(header.Info{Height: 3, Time: before.CurrentEpochStartTime.Add(25 * time.Hour)})
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(s.Ctx))
+	epoch, err = s.EpochsKeeper.GetEpochInfo(s.Ctx, "day")
+	s.Require().NoError(err)
+	s.Require().Equal(before.CurrentEpoch+1, epoch.CurrentEpoch)
+	s.Require().Equal(12*time.Hour, epoch.Duration)
+	s.Require().Zero(epoch.NextDuration)
+}
+
+type removalHook struct {
+	types.EpochHooks
+	removed []string
+}
+
+func (h *removalHook) AfterEpochRemoved(_ context.Context, epochIdentifier string) error {
+	h.removed = append(h.removed, epochIdentifier)
+	return nil
+}
+
+func (s *KeeperTestSuite) TestDeleteEpoch() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	environment := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger())
+	ctx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+
+	hook := &removalHook{EpochHooks: types.MultiEpochHooks{}}
+	epochsKeeper := epochskeeper.NewKeeper(environment, encCfg.Codec, authtypes.NewModuleAddress("gov").String())
+	epochsKeeper.SetHooks(types.NewMultiEpochHooks(hook))
+	s.Require().NoError(epochsKeeper.InitGenesis(ctx, *types.DefaultGenesis()))
+	msgServer := epochskeeper.NewMsgServerImpl(epochsKeeper)
+
+	_, err := msgServer.DeleteEpoch(ctx, &types.MsgDeleteEpoch{
+		Authority:  "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+		Identifier: "day",
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = msgServer.DeleteEpoch(ctx, &types.MsgDeleteEpoch{
+		Authority:  epochsKeeper.GetAuthority(),
+		Identifier: "unknown",
+	})
+	s.Require().ErrorIs(err, types.ErrEpochNotFound)
+	s.Require().Empty(hook.removed)
+
+	_, err = msgServer.DeleteEpoch(ctx, &types.MsgDeleteEpoch{
+		Authority:  epochsKeeper.GetAuthority(),
+		Identifier: "day",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"day"}, hook.removed)
+
+	has, err := epochsKeeper.EpochInfo.Has(ctx, "day")
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
@@ -0,0 +1,9 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/epochs module sentinel errors
+var (
+	ErrInvalidSigner = errors.Register(ModuleName, 2, "expected authority account as only signer for proposal message")
+	ErrEpochNotFound = errors.Register(ModuleName, 3, "epoch not found")
+)
@@ -44,8 +44,11 @@ func (epoch EpochInfo) Validate() error {
 	if epoch.Identifier == "" {
 		return errors.New("epoch identifier should NOT be empty")
 	}
-	if epoch.Duration == 0 {
-		return errors.New("epoch duration should NOT be 0")
+	if epoch.Duration <= 0 {
+		return errors.New("epoch duration must be positive")
+	}
+	if epoch.NextDuration < 0 {
+		return errors.New("epoch NextDuration must be non-negative")
 	}
 	if epoch.CurrentEpoch < 0 {
 		return errors.New("epoch CurrentEpoch must be non-negative")
@@ -71,6 +71,9 @@ type EpochInfo struct {
 	// current_epoch_start_height is the block height at which the current epoch
 	// started. (The block height at which the timer last ticked)
 	CurrentEpochStartHeight int64 `protobuf:"varint,8,opt,name=current_epoch_start_height,json=currentEpochStartHeight,proto3" json:"current_epoch_start_height,omitempty"`
+	// next_duration is the duration scheduled to replace duration once the
+	// current epoch ends. It is zero when no update is pending.
+	NextDuration time.Duration `protobuf:"bytes,9,opt,name=next_duration,json=nextDuration,proto3,stdduration" json:"next_duration,omitempty"`
 }
 
 func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
@@ -155,6 +158,13 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+func (m *EpochInfo) GetNextDuration() time.Duration {
+	if m != nil {
+		return m.NextDuration
+	}
+	return 0
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
@@ -210,35 +220,36 @@ func init() {
 }
 
 var fileDescriptor_3a3d6d4398875177 = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0x24, 0x04, 0xe7, 0x5a, 0x24, 0x74, 0x6a, 0xe1, 0x30, 0xd2, 0xd9, 0x6a, 0x17,
-	0x0f, 0xe8, 0xac, 0x16, 0x98, 0x90, 0x18, 0x5c, 0x10, 0x7f, 0x06, 0x06, 0x07, 0x16, 0x24, 0x14,
-	0x39, 0xf6, 0xc5, 0x39, 0x81, 0x7d, 0x96, 0xef, 0x35, 0xa2, 0xdf, 0xa2, 0x23, 0x1f, 0x88, 0xa1,
-	0x63, 0x47, 0xa6, 0x82, 0x92, 0x8d, 0x4f, 0x81, 0x7c, 0x67, 0x47, 0x81, 0x76, 0x61, 0x4b, 0xee,
-	0x79, 0xde, 0xdf, 0x73, 0xcf, 0xf9, 0xc5, 0x87, 0xa9, 0xd2, 0x85, 0xd2, 0xa1, 0xa8, 0x54, 0xba,
-	0xd4, 0xe1, 0x97, 0xa3, 0xb9, 0x80, 0xe4, 0x28, 0xcc, 0x45, 0x29, 0xb4, 0xd4, 0xbc, 0xaa, 0x15,
-	0x28, 0xb2, 0x6f, 0x4d, 0xdc, 0x9a, 0x78, 0x67, 0x72, 0xf7, 0x72, 0x95, 0x2b, 0xe3, 0x08, 0xdb,
-	0x5f, 0xd6, 0xec, 0xb2, 0x5c, 0xa9, 0xfc, 0xb3, 0x08, 0xcd, 0xbf, 0x79, 0xb3, 0x08, 0xb3, 0xa6,
-	0x4e, 0x40, 0xaa, 0xb2, 0xd3, 0xbd, 0x7f, 0x75, 0x90, 0x85, 0xd0, 0x90, 0x14, 0x95, 0x35, 0x1c,
-	0x7c, 0x1f, 0xe2, 0xc9, 0x8b, 0x36, 0xe9, 0x75, 0xb9, 0x50, 0x84, 0x61, 0x2c, 0x33, 0x51, 0x82,
-	0x5c, 0x48, 0x51, 0x53, 0xe4, 0xa3, 0x60, 0x12, 0x6f, 0x9d, 0x90, 0x13, 0x8c, 0x35, 0x24, 0x35,
-	0xcc, 0x5a, 0x0c, 0xbd, 0xe1, 0xa3, 0x60, 0xe7, 0xd8, 0xe5, 0x36, 0x83, 0xf7, 0x19, 0xfc, 0x5d,
-	0x9f, 0x11, 0x39, 0xe7, 0x97, 0xde, 0xe0, 0xec, 0xa7, 0x87, 0xe2, 0x89, 0x99, 0x6b, 0x15, 0xf2,
-	0x1e, 0x3b, 0xfd, 0x2d, 0xe9, 0xd0, 0x20, 0xee, 0x5f, 0x41, 0x3c, 0xef, 0x0c, 0x11, 0x6b, 0x09,
-	0xbf, 0x2f, 0x3d, 0xd2, 0x8f, 0x3c, 0x54, 0x85, 0x04, 0x51, 0x54, 0x70, 0xfa, 0xad, 0xe5, 0x6e,
-	0x50, 0xe4, 0x10, 0xdf, 0x4e, 0x9b, 0xba, 0x16, 0x25, 0xcc, 0xcc, 0xd3, 0xd1, 0x91, 0x8f, 0x82,
-	0x61, 0xbc, 0xdb, 0x1d, 0x9a, 0x92, 0xe4, 0x23, 0xa6, 0x7f, 0x99, 0x66, 0x5b, 0x75, 0x6e, 0xfe,
-	0x47, 0x9d, 0xfd, 0x6d, 0xea, 0x74, 0x53, 0xed, 0x31, 0xbe, 0x6b, 0xb1, 0xa9, 0x6a, 0x4a, 0x90,
-	0x65, 0x6e, 0xf9, 0x22, 0xa3, 0x63, 0x1f, 0x05, 0x4e, 0xbc, 0x67, 0xd4, 0x93, 0x4e, 0x9c, 0x5a,
-	0x8d, 0x3c, 0xc5, 0xee, 0x75, 0x97, 0x5a, 0x0a, 0x99, 0x2f, 0x81, 0x3a, 0xa6, 0xc6, 0xbd, 0x2b,
-	0x81, 0xaf, 0x8c, 0xfc, 0x66, 0xe4, 0xdc, 0xba, 0xe3, 0x1c, 0xbc, 0xc5, 0xbb, 0x2f, 0xed, 0x16,
-	0x4d, 0x21, 0x01, 0x41, 0x9e, 0xe1, 0xb1, 0xdd, 0x1f, 0x8a, 0xfc, 0x61, 0xb0, 0x73, 0xec, 0xf3,
-	0x6b, 0xb7, 0x8a, 0x6f, 0x3e, 0x7d, 0x34, 0x6a, 0xbb, 0xc5, 0xdd, 0x54, 0xf4, 0xe4, 0x7c, 0xc5,
-	0xd0, 0xc5, 0x8a, 0xa1, 0x5f, 0x2b, 0x86, 0xce, 0xd6, 0x6c, 0x70, 0xb1, 0x66, 0x83, 0x1f, 0x6b,
-	0x36, 0xf8, 0xf0, 0xc0, 0x82, 0x74, 0xf6, 0x89, 0x4b, 0x15, 0x7e, 0xed, 0x77, 0x19, 0x4e, 0x2b,
-	0xa1, 0xe7, 0x63, 0xf3, 0x68, 0x8f, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x7e, 0x30, 0x02, 0x6b,
-	0xe9, 0x02, 0x00, 0x00,
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
+	0x1c, 0xad, 0x69, 0x29, 0xa9, 0xd7, 0x49, 0xc8, 0xda, 0x58, 0x28, 0x92, 0x1b, 0xad, 0x97, 0x1c,
+	0x90, 0xa3, 0x0d, 0x38, 0x21, 0x71, 0xe8, 0x40, 0xfc, 0x39, 0x70, 0x48, 0xe1, 0x82, 0x84, 0xaa,
+	0x34, 0x75, 0x53, 0x0b, 0x62, 0x47, 0xf1, 0xaf, 0x68, 0xfb, 0x16, 0x3b, 0xf2, 0x15, 0xf8, 0x26,
+	0x3b, 0xee, 0xc8, 0x69, 0xa0, 0xf6, 0xc6, 0xa7, 0x40, 0xb6, 0x93, 0xaa, 0xdb, 0x2a, 0x21, 0x6e,
+	0x89, 0xdf, 0xfb, 0xbd, 0xe7, 0xf7, 0xf4, 0x33, 0x1e, 0xa4, 0x4a, 0xe7, 0x4a, 0x47, 0xbc, 0x50,
+	0xe9, 0x5c, 0x47, 0xdf, 0x8e, 0x26, 0x1c, 0x92, 0xa3, 0x28, 0xe3, 0x92, 0x6b, 0xa1, 0x59, 0x51,
+	0x2a, 0x50, 0x64, 0xdf, 0x91, 0x98, 0x23, 0xb1, 0x8a, 0xd4, 0xdb, 0xcb, 0x54, 0xa6, 0x2c, 0x23,
+	0x32, 0x5f, 0x8e, 0xdc, 0xa3, 0x99, 0x52, 0xd9, 0x57, 0x1e, 0xd9, 0xbf, 0xc9, 0x62, 0x16, 0x4d,
+	0x17, 0x65, 0x02, 0x42, 0xc9, 0x0a, 0xef, 0xdf, 0xc4, 0x41, 0xe4, 0x5c, 0x43, 0x92, 0x17, 0x8e,
+	0x70, 0xf8, 0xa3, 0x85, 0x3b, 0xaf, 0x8c, 0xd3, 0x5b, 0x39, 0x53, 0x84, 0x62, 0x2c, 0xa6, 0x5c,
+	0x82, 0x98, 0x09, 0x5e, 0xfa, 0x28, 0x40, 0x61, 0x27, 0xde, 0x38, 0x21, 0x27, 0x18, 0x6b, 0x48,
+	0x4a, 0x18, 0x1b, 0x19, 0xff, 0x4e, 0x80, 0xc2, 0x9d, 0xe3, 0x1e, 0x73, 0x1e, 0xac, 0xf6, 0x60,
+	0x1f, 0x6a, 0x8f, 0xa1, 0x77, 0x71, 0xd5, 0x6f, 0x9c, 0xff, 0xea, 0xa3, 0xb8, 0x63, 0xe7, 0x0c,
+	0x42, 0x3e, 0x62, 0xaf, 0xbe, 0xa5, 0xdf, 0xb4, 0x12, 0x0f, 0x6f, 0x49, 0xbc, 0xac, 0x08, 0x43,
+	0x6a, 0x14, 0xfe, 0x5c, 0xf5, 0x49, 0x3d, 0xf2, 0x58, 0xe5, 0x02, 0x78, 0x5e, 0xc0, 0xd9, 0x77,
+	0xa3, 0xbb, 0x96, 0x22, 0x03, 0xbc, 0x9b, 0x2e, 0xca, 0x92, 0x4b, 0x18, 0xdb, 0xea, 0xfc, 0x56,
+	0x80, 0xc2, 0x66, 0xdc, 0xad, 0x0e, 0x6d, 0x48, 0xf2, 0x19, 0xfb, 0xd7, 0x48, 0xe3, 0x8d, 0x38,
+	0x77, 0xff, 0x23, 0xce, 0xfe, 0xa6, 0xea, 0x68, 0x1d, 0xed, 0x29, 0x7e, 0xe0, 0x64, 0x53, 0xb5,
+	0x90, 0x20, 0x64, 0xe6, 0xf4, 0xf9, 0xd4, 0x6f, 0x07, 0x28, 0xf4, 0xe2, 0x3d, 0x8b, 0x9e, 0x54,
+	0xe0, 0xc8, 0x61, 0xe4, 0x39, 0xee, 0x6d, 0xbb, 0xd4, 0x9c, 0x8b, 0x6c, 0x0e, 0xbe, 0x67, 0x63,
+	0x1c, 0xdc, 0x32, 0x7c, 0x63, 0x61, 0x92, 0xe2, 0x5d, 0xc9, 0x4f, 0x61, 0xbc, 0xae, 0xb4, 0xf3,
+	0xaf, 0x4a, 0x07, 0x55, 0xa5, 0x07, 0xd7, 0xe6, 0x6e, 0xf4, 0xda, 0x35, 0x60, 0x3d, 0xf2, 0xae,
+	0xe5, 0xdd, 0xbb, 0xef, 0x1d, 0xbe, 0xc7, 0xdd, 0xd7, 0x6e, 0x55, 0x47, 0x90, 0x00, 0x27, 0x2f,
+	0x70, 0xdb, 0x2d, 0xa9, 0x8f, 0x82, 0x66, 0xb8, 0x73, 0x1c, 0xb0, 0xad, 0xab, 0xcb, 0xd6, 0xfb,
+	0x35, 0x6c, 0x19, 0xeb, 0xb8, 0x9a, 0x1a, 0x3e, 0xbb, 0x58, 0x52, 0x74, 0xb9, 0xa4, 0xe8, 0xf7,
+	0x92, 0xa2, 0xf3, 0x15, 0x6d, 0x5c, 0xae, 0x68, 0xe3, 0xe7, 0x8a, 0x36, 0x3e, 0x3d, 0x72, 0x42,
+	0x7a, 0xfa, 0x85, 0x09, 0x15, 0x9d, 0xd6, 0x0f, 0x06, 0xce, 0x0a, 0xae, 0x27, 0x6d, 0x1b, 0xe9,
+	0xc9, 0xdf, 0x01, 0x00, 0x22, 0x27, 0x68, 0x8c, 0x4e, 0x03, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -261,6 +272,14 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NextDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NextDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
 	if m.CurrentEpochStartHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochStartHeight))
 		i--
@@ -276,12 +295,12 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CurrentEpochStartTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CurrentEpochStartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x2a
 	if m.CurrentEpoch != 0 {
@@ -289,21 +308,21 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x20
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintGenesis(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x1a
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintGenesis(dAtA, i, uint64(n3))
 	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
 	dAtA[i] = 0x12
 	if len(m.Identifier) > 0 {
 		i -= len(m.Identifier)
@@ -388,6 +407,8 @@ func (m *EpochInfo) Size() (n int) {
 	if m.CurrentEpochStartHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.CurrentEpochStartHeight))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NextDuration)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -630,6 +651,39 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.NextDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
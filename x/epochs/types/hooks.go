@@ -12,7 +12,17 @@ type EpochHooks interface {
 	BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error
 }
 
-var _ EpochHooks = MultiEpochHooks{}
+// EpochRemovalHooks is an optional extension of EpochHooks for modules that
+// need to be notified when an epoch identifier is removed.
+type EpochRemovalHooks interface {
+	// AfterEpochRemoved is called once the epoch with the given identifier has been deleted.
+	AfterEpochRemoved(ctx context.Context, epochIdentifier string) error
+}
+
+var (
+	_ EpochHooks        = MultiEpochHooks{}
+	_ EpochRemovalHooks = MultiEpochHooks{}
+)
 
 // combine multiple gamm hooks, all hook functions are run in array sequence.
 type MultiEpochHooks []EpochHooks
@@ -39,6 +49,17 @@ func (h MultiEpochHooks) BeforeEpochStart(ctx context.Context, epochIdentifier s
 	return errs
 }
 
+// AfterEpochRemoved is called when an epoch is removed, only hooks implementing EpochRemovalHooks are called.
+func (h MultiEpochHooks) AfterEpochRemoved(ctx context.Context, epochIdentifier string) error {
+	var errs error
+	for i := range h {
+		if rh, ok := h[i].(EpochRemovalHooks); ok {
+			errs = errors.Join(errs, rh.AfterEpochRemoved(ctx, epochIdentifier))
+		}
+	}
+	return errs
+}
+
 // EpochHooksWrapper is a wrapper for modules to inject EpochHooks using depinject.
 type EpochHooksWrapper struct{ EpochHooks }
 
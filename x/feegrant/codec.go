@@ -13,6 +13,9 @@ import (
 func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgGrantAllowance{}, "cosmos-sdk/MsgGrantAllowance")
 	legacy.RegisterAminoMsg(registrar, &MsgRevokeAllowance{}, "cosmos-sdk/MsgRevokeAllowance")
+	legacy.RegisterAminoMsg(registrar, &MsgCreateFeePool{}, "cosmos-sdk/MsgCreateFeePool")
+	legacy.RegisterAminoMsg(registrar, &MsgUpdateFeePoolGrantees{}, "cosmos-sdk/MsgUpdateFeePoolGrantees")
+	legacy.RegisterAminoMsg(registrar, &MsgDeleteFeePool{}, "cosmos-sdk/MsgDeleteFeePool")
 
 	registrar.RegisterInterface((*FeeAllowanceI)(nil), nil)
 	registrar.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance")
@@ -25,6 +28,9 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
 		&MsgGrantAllowance{},
 		&MsgRevokeAllowance{},
+		&MsgCreateFeePool{},
+		&MsgUpdateFeePoolGrantees{},
+		&MsgDeleteFeePool{},
 	)
 
 	registrar.RegisterInterface(
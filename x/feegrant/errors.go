@@ -20,4 +20,6 @@ var (
 	ErrNoMessages = errors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = errors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrFeePoolNotFound error if the fee pool does not exist
+	ErrFeePoolNotFound = errors.Register(DefaultCodespace, 8, "fee pool not found")
 )
@@ -8,7 +8,13 @@ const (
 	EventTypeUpdateFeeGrant = "update_feegrant"
 	EventTypePruneFeeGrant  = "prune_feegrant"
 
+	EventTypeCreateFeePool = "create_fee_pool"
+	EventTypeUpdateFeePool = "update_fee_pool"
+	EventTypeDeleteFeePool = "delete_fee_pool"
+	EventTypeUseFeePool    = "use_fee_pool"
+
 	AttributeKeyGranter = "granter"
 	AttributeKeyGrantee = "grantee"
 	AttributeKeyPruner  = "pruner"
+	AttributeKeyPoolID  = "pool_id"
 )
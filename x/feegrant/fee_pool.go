@@ -0,0 +1,146 @@
+package feegrant
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/core/appmodule"
+	corecontext "cosmossdk.io/core/context"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeePoolAddress returns the address of the account holding the funds of the
+// fee pool with the given id. Transactions set it as fee granter to have their
+// fees paid by the pool.
+func FeePoolAddress(poolID uint64) sdk.AccAddress {
+	return address.Module(ModuleName, []byte("fee_pool"), sdk.Uint64ToBigEndian(poolID))
+}
+
+// NewFeePoolGrantee returns the usage of a grantee newly added to the pool,
+// with a full quota for the period starting at blockTime.
+func NewFeePoolGrantee(pool FeePool, grantee string, blockTime time.Time) FeePoolGrantee {
+	return FeePoolGrantee{
+		PoolId:         pool.Id,
+		Grantee:        grantee,
+		PeriodCanSpend: pool.PeriodSpendLimit,
+		PeriodReset:    blockTime.Add(pool.Period),
+	}
+}
+
+// Accept checks that the pool can pay the fee of the grantee for the given
+// messages and updates the usage of both the pool and the grantee.
+// It is checked in Keeper.UseGrantedFees, and the pool and grantee are saved
+// again after an acceptance.
+func (p *FeePool) Accept(ctx context.Context, grantee *FeePoolGrantee, fee sdk.Coins, msgs []sdk.Msg) error {
+	environment, ok := ctx.Value(corecontext.EnvironmentContextKey).(appmodule.Environment)
+	if !ok {
+		return errorsmod.Wrap(ErrFeeLimitExpired, "environment not set")
+	}
+
+	blockTime := environment.HeaderService.HeaderInfo(ctx).Time
+	if p.Expiration != nil && blockTime.After(*p.Expiration) {
+		return errorsmod.Wrap(ErrFeeLimitExpired, "fee pool")
+	}
+
+	if len(p.AllowedMessages) > 0 {
+		allowed := make(map[string]struct{}, len(p.AllowedMessages))
+		for _, msg := range p.AllowedMessages {
+			allowed[msg] = struct{}{}
+		}
+
+		gasMeter := environment.GasService.GasMeter(ctx)
+		for _, msg := range msgs {
+			if err := gasMeter.Consume(gasCostPerIteration, "check msg"); err != nil {
+				return err
+			}
+			if _, ok := allowed[sdk.MsgTypeURL(msg)]; !ok {
+				return errorsmod.Wrap(ErrMessageNotAllowed, "message does not exist in allowed messages")
+			}
+		}
+	}
+
+	spent := p.Spent.Add(fee...)
+	if !p.SpendLimit.Empty() && !spent.IsAllLTE(p.SpendLimit) {
+		return errorsmod.Wrap(ErrFeeLimitExceeded, "pool limit")
+	}
+
+	if !p.PeriodSpendLimit.Empty() {
+		grantee.tryResetPeriod(blockTime, p.Period, p.PeriodSpendLimit)
+
+		canSpend, isNeg := grantee.PeriodCanSpend.SafeSub(fee...)
+		if isNeg {
+			return errorsmod.Wrap(ErrFeeLimitExceeded, "grantee period limit")
+		}
+		grantee.PeriodCanSpend = canSpend
+	}
+
+	p.Spent = spent
+	grantee.Spent = grantee.Spent.Add(fee...)
+	grantee.Uses++
+
+	return nil
+}
+
+// tryResetPeriod tops up the quota of the grantee if the PeriodReset has been hit.
+// Like PeriodicAllowance, it steps from the previous reset time when within one
+// period, and from blockTime otherwise.
+func (g *FeePoolGrantee) tryResetPeriod(blockTime time.Time, period time.Duration, limit sdk.Coins) {
+	if blockTime.Before(g.PeriodReset) {
+		return
+	}
+
+	g.PeriodCanSpend = limit
+	g.PeriodReset = g.PeriodReset.Add(period)
+	if blockTime.After(g.PeriodReset) {
+		g.PeriodReset = blockTime.Add(period)
+	}
+}
+
+// ValidateBasic enforces basic sanity checks on the pool limits.
+func (p FeePool) ValidateBasic() error {
+	if !p.SpendLimit.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit is invalid: %s", p.SpendLimit)
+	}
+	if !p.PeriodSpendLimit.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "period spend limit is invalid: %s", p.PeriodSpendLimit)
+	}
+	if !p.Spent.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "spent amount is invalid: %s", p.Spent)
+	}
+
+	if p.Period < 0 {
+		return errorsmod.Wrap(ErrInvalidDuration, "negative clock step")
+	}
+	if !p.PeriodSpendLimit.Empty() && p.Period == 0 {
+		return errorsmod.Wrap(ErrInvalidDuration, "period must be set with a period spend limit")
+	}
+
+	// ensure PeriodSpendLimit can be compared to the pool limit (same coin types)
+	if !p.SpendLimit.Empty() && !p.PeriodSpendLimit.DenomsSubsetOf(p.SpendLimit) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "period spend limit has different currency than pool spend limit")
+	}
+
+	for _, msg := range p.AllowedMessages {
+		if msg == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "allowed message cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic enforces basic sanity checks on the grantee usage.
+func (g FeePoolGrantee) ValidateBasic() error {
+	if !g.PeriodCanSpend.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "can spend amount is invalid: %s", g.PeriodCanSpend)
+	}
+	if !g.Spent.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "spent amount is invalid: %s", g.Spent)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package feegrant_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeePoolValidateBasic(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 10))
+
+	cases := map[string]struct {
+		pool  feegrant.FeePool
+		valid bool
+	}{
+		"empty": {
+			pool:  feegrant.FeePool{},
+			valid: true,
+		},
+		"pool and grantee limits": {
+			pool:  feegrant.FeePool{SpendLimit: atom, Period: time.Hour, PeriodSpendLimit: atom},
+			valid: true,
+		},
+		"period limit without period": {
+			pool: feegrant.FeePool{PeriodSpendLimit: atom},
+		},
+		"negative period": {
+			pool: feegrant.FeePool{Period: -time.Hour},
+		},
+		"mismatched denoms": {
+			pool: feegrant.FeePool{SpendLimit: atom, Period: time.Hour, PeriodSpendLimit: eth},
+		},
+		"invalid spend limit": {
+			pool: feegrant.FeePool{SpendLimit: sdk.Coins{sdk.Coin{Denom: "atom", Amount: atom[0].Amount.Neg()}}},
+		},
+		"empty allowed message": {
+			pool: feegrant.FeePool{AllowedMessages: []string{""}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.pool.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestFeePoolAccept(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	now := time.Now().Round(0).UTC()
+
+	withTime := func(blockTime time.Time) context.Context {
+		return context.WithValue(testCtx.Ctx.WithHeaderInfo(header.Info{Time: blockTime}), corecontext.EnvironmentContextKey, appmodulev2.Environment{
+			HeaderService: mockHeaderService{},
+			GasService:    mockGasService{},
+		})
+	}
+
+	atom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amount)) }
+	send := []sdk.Msg{&banktypes.MsgSend{}}
+	expiration := now.Add(48 * time.Hour)
+
+	pool := feegrant.FeePool{
+		SpendLimit:       atom(100),
+		Period:           time.Hour,
+		PeriodSpendLimit: atom(30),
+		AllowedMessages:  []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		Expiration:       &expiration,
+	}
+	require.NoError(t, pool.ValidateBasic())
+
+	alice := feegrant.NewFeePoolGrantee(pool, "alice", now)
+	bob := feegrant.NewFeePoolGrantee(pool, "bob", now)
+	require.Equal(t, atom(30), alice.PeriodCanSpend)
+	require.Equal(t, now.Add(time.Hour), alice.PeriodReset)
+
+	t.Log("verify fees are deducted from the grantee quota and accounted on the pool")
+	require.NoError(t, pool.Accept(withTime(now), &alice, atom(20), send))
+	require.Equal(t, atom(10), alice.PeriodCanSpend)
+	require.Equal(t, atom(20), alice.Spent)
+	require.Equal(t, uint64(1), alice.Uses)
+	require.Equal(t, atom(20), pool.Spent)
+
+	t.Log("verify the grantee quota is enforced")
+	err := pool.Accept(withTime(now), &alice, atom(11), send)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+
+	t.Log("verify the quota is reset after the period")
+	require.NoError(t, pool.Accept(withTime(now.Add(time.Hour)), &alice, atom(30), send))
+	require.Equal(t, now.Add(2*time.Hour), alice.PeriodReset)
+	require.Equal(t, atom(50), pool.Spent)
+
+	t.Log("verify the pool limit is shared by all grantees")
+	require.NoError(t, pool.Accept(withTime(now), &bob, atom(30), send))
+	require.NoError(t, pool.Accept(withTime(now.Add(3*time.Hour)), &bob, atom(20), send))
+	require.Equal(t, now.Add(4*time.Hour), bob.PeriodReset)
+	require.Equal(t, atom(100), pool.Spent)
+	err = pool.Accept(withTime(now.Add(3*time.Hour)), &bob, atom(1), send)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+
+	pool.SpendLimit = nil
+
+	t.Log("verify only allowed messages are paid for")
+	err = pool.Accept(withTime(now.Add(5*time.Hour)), &bob, atom(1), []sdk.Msg{&banktypes.MsgMultiSend{}})
+	require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+
+	t.Log("verify an expired pool does not pay fees")
+	err = pool.Accept(withTime(expiration.Add(time.Second)), &bob, atom(1), send)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExpired)
+}
@@ -1,6 +1,8 @@
 package feegrant
 
 import (
+	"fmt"
+
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
 )
 
@@ -25,6 +27,29 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	pools := make(map[uint64]struct{}, len(data.FeePools))
+	for _, p := range data.FeePools {
+		if _, ok := pools[p.Id]; ok {
+			return fmt.Errorf("duplicate fee pool %d", p.Id)
+		}
+		pools[p.Id] = struct{}{}
+
+		if err := p.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, g := range data.FeePoolGrantees {
+		if _, ok := pools[g.PoolId]; !ok {
+			return fmt.Errorf("grantee %s of unknown fee pool %d", g.Grantee, g.PoolId)
+		}
+
+		if err := g.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,316 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	corecontext "cosmossdk.io/core/context"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// CreateFeePool stores a new fee pool controlled by the granter, adds the given
+// grantees to it and transfers funds from the granter to the pool account.
+// The id and address of the pool are assigned by the keeper.
+func (k Keeper) CreateFeePool(ctx context.Context, granter sdk.AccAddress, pool feegrant.FeePool, funds sdk.Coins, grantees []sdk.AccAddress) (uint64, sdk.AccAddress, error) {
+	if err := pool.ValidateBasic(); err != nil {
+		return 0, nil, err
+	}
+
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	if pool.Expiration != nil && pool.Expiration.Before(now) {
+		return 0, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "expiration is before current block time")
+	}
+
+	poolID, err := k.FeePoolSeq.Next(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	poolAddr := feegrant.FeePoolAddress(poolID)
+
+	granterStr, err := k.addrCdc.BytesToString(granter)
+	if err != nil {
+		return 0, nil, err
+	}
+	poolAddrStr, err := k.addrCdc.BytesToString(poolAddr)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	pool.Id = poolID
+	pool.Granter = granterStr
+	pool.Address = poolAddrStr
+	pool.Spent = nil
+	pool.GranteeCount = 0
+
+	if err := k.FeePoolsByAddress.Set(ctx, poolAddr, poolID); err != nil {
+		return 0, nil, err
+	}
+
+	if err := k.addFeePoolGrantees(ctx, &pool, grantees); err != nil {
+		return 0, nil, err
+	}
+
+	if err := k.FeePools.Set(ctx, poolID, pool); err != nil {
+		return 0, nil, err
+	}
+
+	if !funds.IsZero() {
+		if _, err := k.MsgRouterService.Invoke(ctx, &banktypes.MsgSend{
+			FromAddress: granterStr,
+			ToAddress:   poolAddrStr,
+			Amount:      funds,
+		}); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	return poolID, poolAddr, k.EventService.EventManager(ctx).EmitKV(
+		feegrant.EventTypeCreateFeePool,
+		event.NewAttribute(feegrant.AttributeKeyPoolID, strconv.FormatUint(poolID, 10)),
+		event.NewAttribute(feegrant.AttributeKeyGranter, granterStr),
+	)
+}
+
+// UpdateFeePoolGrantees adds and removes grantees of the fee pool controlled by the granter.
+// Adding an existing grantee or removing an unknown one is a no-op.
+func (k Keeper) UpdateFeePoolGrantees(ctx context.Context, granter sdk.AccAddress, poolID uint64, add, remove []sdk.AccAddress) error {
+	pool, err := k.getFeePoolOf(ctx, granter, poolID)
+	if err != nil {
+		return err
+	}
+
+	if err := k.addFeePoolGrantees(ctx, &pool, add); err != nil {
+		return err
+	}
+
+	for _, grantee := range remove {
+		key := collections.Join(poolID, grantee)
+		has, err := k.FeePoolGranteesByPool.Has(ctx, key)
+		if err != nil {
+			return err
+		}
+		if !has {
+			continue
+		}
+
+		if err := k.FeePoolGranteesByPool.Remove(ctx, key); err != nil {
+			return err
+		}
+		pool.GranteeCount--
+	}
+
+	if err := k.FeePools.Set(ctx, poolID, pool); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		feegrant.EventTypeUpdateFeePool,
+		event.NewAttribute(feegrant.AttributeKeyPoolID, strconv.FormatUint(poolID, 10)),
+		event.NewAttribute(feegrant.AttributeKeyGranter, pool.Granter),
+	)
+}
+
+// DeleteFeePool removes the fee pool controlled by the granter along with its
+// grantees, and sends the remaining balance of the pool back to the granter.
+func (k Keeper) DeleteFeePool(ctx context.Context, granter sdk.AccAddress, poolID uint64) (sdk.Coins, error) {
+	pool, err := k.getFeePoolOf(ctx, granter, poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	poolAddr := feegrant.FeePoolAddress(poolID)
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](poolID)
+	if err := k.FeePoolGranteesByPool.Clear(ctx, rng); err != nil {
+		return nil, err
+	}
+	if err := k.FeePoolsByAddress.Remove(ctx, poolAddr); err != nil {
+		return nil, err
+	}
+	if err := k.FeePools.Remove(ctx, poolID); err != nil {
+		return nil, err
+	}
+
+	refund, err := k.feePoolBalance(ctx, pool.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if !refund.IsZero() {
+		if _, err := k.MsgRouterService.Invoke(ctx, &banktypes.MsgSend{
+			FromAddress: pool.Address,
+			ToAddress:   pool.Granter,
+			Amount:      refund,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return refund, k.EventService.EventManager(ctx).EmitKV(
+		feegrant.EventTypeDeleteFeePool,
+		event.NewAttribute(feegrant.AttributeKeyPoolID, strconv.FormatUint(poolID, 10)),
+		event.NewAttribute(feegrant.AttributeKeyGranter, pool.Granter),
+	)
+}
+
+// GetFeePool returns the fee pool with the given id.
+func (k Keeper) GetFeePool(ctx context.Context, poolID uint64) (feegrant.FeePool, error) {
+	pool, err := k.FeePools.Get(ctx, poolID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return feegrant.FeePool{}, errorsmod.Wrapf(feegrant.ErrFeePoolNotFound, "pool %d", poolID)
+	}
+	return pool, err
+}
+
+// getFeePoolOf returns the fee pool with the given id if it is controlled by the granter.
+func (k Keeper) getFeePoolOf(ctx context.Context, granter sdk.AccAddress, poolID uint64) (feegrant.FeePool, error) {
+	pool, err := k.GetFeePool(ctx, poolID)
+	if err != nil {
+		return feegrant.FeePool{}, err
+	}
+
+	granterStr, err := k.addrCdc.BytesToString(granter)
+	if err != nil {
+		return feegrant.FeePool{}, err
+	}
+	if pool.Granter != granterStr {
+		return feegrant.FeePool{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the granter of pool %d", granterStr, poolID)
+	}
+
+	return pool, nil
+}
+
+// addFeePoolGrantees adds the grantees to the pool, skipping the existing ones,
+// and updates the grantee count of the pool. The pool itself is not saved.
+func (k Keeper) addFeePoolGrantees(ctx context.Context, pool *feegrant.FeePool, grantees []sdk.AccAddress) error {
+	now := k.HeaderService.HeaderInfo(ctx).Time
+	for _, grantee := range grantees {
+		key := collections.Join(pool.Id, grantee)
+		has, err := k.FeePoolGranteesByPool.Has(ctx, key)
+		if err != nil {
+			return err
+		}
+		if has {
+			continue
+		}
+
+		granteeStr, err := k.addrCdc.BytesToString(grantee)
+		if err != nil {
+			return err
+		}
+
+		if err := k.FeePoolGranteesByPool.Set(ctx, key, feegrant.NewFeePoolGrantee(*pool, granteeStr, now)); err != nil {
+			return err
+		}
+		pool.GranteeCount++
+	}
+
+	return nil
+}
+
+// useFeePool will try to pay the given fee from the fee pool as requested by the grantee.
+func (k Keeper) useFeePool(ctx context.Context, poolID uint64, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	pool, err := k.GetFeePool(ctx, poolID)
+	if err != nil {
+		return err
+	}
+
+	key := collections.Join(poolID, grantee)
+	poolGrantee, err := k.FeePoolGranteesByPool.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrapf(feegrant.ErrNoAllowance, "not a grantee of pool %d", poolID)
+		}
+		return err
+	}
+
+	if err := pool.Accept(context.WithValue(ctx, corecontext.EnvironmentContextKey, k.Environment), &poolGrantee, fee, msgs); err != nil {
+		return err
+	}
+
+	if err := k.FeePools.Set(ctx, poolID, pool); err != nil {
+		return err
+	}
+	if err := k.FeePoolGranteesByPool.Set(ctx, key, poolGrantee); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		feegrant.EventTypeUseFeePool,
+		event.NewAttribute(feegrant.AttributeKeyPoolID, strconv.FormatUint(poolID, 10)),
+		event.NewAttribute(feegrant.AttributeKeyGrantee, poolGrantee.Grantee),
+	)
+}
+
+// feePoolBalance returns the balance of the pool account.
+func (k Keeper) feePoolBalance(ctx context.Context, poolAddr string) (sdk.Coins, error) {
+	var (
+		balance sdk.Coins
+		nextKey []byte
+	)
+	for {
+		res, err := k.QueryRouterService.Invoke(ctx, &banktypes.QueryAllBalancesRequest{
+			Address:    poolAddr,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+		balancesRes, ok := res.(*banktypes.QueryAllBalancesResponse)
+		if !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "unexpected balances query response %T", res)
+		}
+
+		balance = balance.Add(balancesRes.Balances...)
+		if balancesRes.Pagination == nil || len(balancesRes.Pagination.NextKey) == 0 {
+			return balance, nil
+		}
+		nextKey = balancesRes.Pagination.NextKey
+	}
+}
+
+// initFeePoolsGenesis stores the fee pools and grantees of a *previously validated* genesis.
+func (k Keeper) initFeePoolsGenesis(ctx context.Context, pools []feegrant.FeePool, grantees []feegrant.FeePoolGrantee) error {
+	var lastID uint64
+	for _, pool := range pools {
+		poolAddr := feegrant.FeePoolAddress(pool.Id)
+		poolAddrStr, err := k.addrCdc.BytesToString(poolAddr)
+		if err != nil {
+			return err
+		}
+		pool.Address = poolAddrStr
+
+		if err := k.FeePools.Set(ctx, pool.Id, pool); err != nil {
+			return err
+		}
+		if err := k.FeePoolsByAddress.Set(ctx, poolAddr, pool.Id); err != nil {
+			return err
+		}
+
+		lastID = max(lastID, pool.Id)
+	}
+
+	for _, g := range grantees {
+		grantee, err := k.addrCdc.StringToBytes(g.Grantee)
+		if err != nil {
+			return err
+		}
+		if err := k.FeePoolGranteesByPool.Set(ctx, collections.Join(g.PoolId, sdk.AccAddress(grantee)), g); err != nil {
+			return err
+		}
+	}
+
+	if len(pools) == 0 {
+		return nil
+	}
+
+	return k.FeePoolSeq.Set(ctx, lastID+1)
+}
@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/router"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/core/transaction"
+	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/feegrant"
+	"cosmossdk.io/x/feegrant/keeper"
+	"cosmossdk.io/x/feegrant/module"
+
+	codecaddress "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// mockBankRouter routes bank sends and balance queries to in-memory balances.
+type mockBankRouter struct {
+	router.Service
+	balances map[string]sdk.Coins
+}
+
+func (r *mockBankRouter) Invoke(_ context.Context, req transaction.Msg) (transaction.Msg, error) {
+	switch req := req.(type) {
+	case *banktypes.MsgSend:
+		left, isNeg := r.balances[req.FromAddress].SafeSub(req.Amount...)
+		if isNeg {
+			return nil, fmt.Errorf("insufficient funds")
+		}
+		r.balances[req.FromAddress] = left
+		r.balances[req.ToAddress] = r.balances[req.ToAddress].Add(req.Amount...)
+		return &banktypes.MsgSendResponse{}, nil
+	case *banktypes.QueryAllBalancesRequest:
+		return &banktypes.QueryAllBalancesResponse{Balances: r.balances[req.Address]}, nil
+	default:
+		return nil, fmt.Errorf("unexpected request %T", req)
+	}
+}
+
+func (suite *KeeperTestSuite) TestFeePool() {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, module.AppModule{})
+	now := time.Now().Round(0).UTC()
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: now})
+
+	granter, grantee1, grantee2, outsider := suite.encodedAddrs[0], suite.encodedAddrs[1], suite.encodedAddrs[2], suite.encodedAddrs[3]
+	bank := &mockBankRouter{balances: map[string]sdk.Coins{granter: suite.atom}}
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), coretesting.NewNopLogger())
+	env.MsgRouterService = bank
+	env.QueryRouterService = bank
+	k := keeper.NewKeeper(env, encCfg.Codec, codecaddress.NewBech32Codec("cosmos"))
+	msgSrvr := keeper.NewMsgServerImpl(k)
+
+	atom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amount)) }
+
+	// create a pool funded with 100atom, capped at 50atom with 20atom per grantee per day
+	res, err := msgSrvr.CreateFeePool(ctx, &feegrant.MsgCreateFeePool{
+		Granter:          granter,
+		Funds:            atom(100),
+		SpendLimit:       atom(50),
+		Period:           24 * time.Hour,
+		PeriodSpendLimit: atom(20),
+		Grantees:         []string{grantee1, grantee1},
+	})
+	suite.Require().NoError(err)
+	poolAddr := feegrant.FeePoolAddress(res.PoolId)
+	suite.Require().Equal(poolAddr.String(), res.Address)
+	suite.Require().Equal(atom(100), bank.balances[res.Address])
+	suite.Require().Equal(atom(455), bank.balances[granter])
+
+	pool, err := k.FeePool(ctx, &feegrant.QueryFeePoolRequest{PoolId: res.PoolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(granter, pool.Pool.Granter)
+	suite.Require().Equal(uint64(1), pool.Pool.GranteeCount)
+
+	// only the granter can manage the pool
+	_, err = msgSrvr.UpdateFeePoolGrantees(ctx, &feegrant.MsgUpdateFeePoolGrantees{Granter: outsider, PoolId: res.PoolId, Add: []string{outsider}})
+	suite.Require().ErrorContains(err, "is not the granter of pool")
+	_, err = msgSrvr.UpdateFeePoolGrantees(ctx, &feegrant.MsgUpdateFeePoolGrantees{Granter: granter, PoolId: res.PoolId, Add: []string{grantee2}})
+	suite.Require().NoError(err)
+
+	// grantees use the pool when the fee granter is the pool address
+	suite.Require().NoError(k.UseGrantedFees(ctx, poolAddr, suite.addrs[1], atom(15), nil))
+	err = k.UseGrantedFees(ctx, poolAddr, suite.addrs[1], atom(10), nil)
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+	suite.Require().NoError(k.UseGrantedFees(ctx, poolAddr, suite.addrs[2], atom(20), nil))
+	err = k.UseGrantedFees(ctx, poolAddr, suite.addrs[3], atom(1), nil)
+	suite.Require().ErrorIs(err, feegrant.ErrNoAllowance)
+
+	// the pool-wide cap applies once quotas are reset
+	nextDay := ctx.WithHeaderInfo(header.Info{Time: now.Add(25 * time.Hour)})
+	err = k.UseGrantedFees(nextDay, poolAddr, suite.addrs[1], atom(16), nil)
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+	suite.Require().NoError(k.UseGrantedFees(nextDay, poolAddr, suite.addrs[1], atom(15), nil))
+
+	usage, err := k.FeePoolGrantee(ctx, &feegrant.QueryFeePoolGranteeRequest{PoolId: res.PoolId, Grantee: grantee1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(atom(30), usage.Grantee.Spent)
+	suite.Require().Equal(uint64(2), usage.Grantee.Uses)
+
+	grantees, err := k.FeePoolGrantees(ctx, &feegrant.QueryFeePoolGranteesRequest{PoolId: res.PoolId})
+	suite.Require().NoError(err)
+	suite.Require().Len(grantees.Grantees, 2)
+
+	pools, err := k.FeePoolsByGranter(ctx, &feegrant.QueryFeePoolsByGranterRequest{Granter: granter})
+	suite.Require().NoError(err)
+	suite.Require().Len(pools.Pools, 1)
+	suite.Require().Equal(atom(50), pools.Pools[0].Spent)
+
+	// removing a grantee revokes its access to the pool
+	_, err = msgSrvr.UpdateFeePoolGrantees(ctx, &feegrant.MsgUpdateFeePoolGrantees{Granter: granter, PoolId: res.PoolId, Remove: []string{grantee2, outsider}})
+	suite.Require().NoError(err)
+	pool, err = k.FeePool(ctx, &feegrant.QueryFeePoolRequest{PoolId: res.PoolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), pool.Pool.GranteeCount)
+
+	// the pool survives a genesis export and import
+	genesis, err := k.ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(feegrant.ValidateGenesis(*genesis))
+	suite.Require().Len(genesis.FeePools, 1)
+	suite.Require().Len(genesis.FeePoolGrantees, 1)
+
+	// deleting the pool refunds its balance to the granter
+	bank.balances[res.Address] = atom(60)
+	delRes, err := msgSrvr.DeleteFeePool(ctx, &feegrant.MsgDeleteFeePool{Granter: granter, PoolId: res.PoolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(atom(60), delRes.Refund)
+	suite.Require().Equal(atom(515), bank.balances[granter])
+	err = k.UseGrantedFees(ctx, poolAddr, suite.addrs[1], atom(1), nil)
+	suite.Require().Error(err)
+	_, err = k.FeePool(ctx, &feegrant.QueryFeePoolRequest{PoolId: res.PoolId})
+	suite.Require().Error(err)
+
+	// importing the exported genesis restores the pool
+	suite.Require().NoError(k.InitGenesis(ctx, genesis))
+	restored, err := k.GetFeePool(ctx, res.PoolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genesis.FeePools[0], restored)
+	suite.Require().NoError(k.UseGrantedFees(ctx, poolAddr, suite.addrs[1], atom(0), nil))
+	next, err := k.FeePoolSeq.Peek(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.PoolId+1, next)
+}
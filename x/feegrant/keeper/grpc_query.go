@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"github.com/cosmos/gogoproto/proto"
 	"google.golang.org/grpc/codes"
@@ -116,3 +117,85 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// FeePool returns a fee pool and its usage.
+func (q Keeper) FeePool(ctx context.Context, req *feegrant.QueryFeePoolRequest) (*feegrant.QueryFeePoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pool, err := q.GetFeePool(ctx, req.PoolId)
+	if err != nil {
+		if errors.Is(err, feegrant.ErrFeePoolNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryFeePoolResponse{Pool: &pool}, nil
+}
+
+// FeePoolsByGranter returns the fee pools created by the given granter.
+func (q Keeper) FeePoolsByGranter(ctx context.Context, req *feegrant.QueryFeePoolsByGranterRequest) (*feegrant.QueryFeePoolsByGranterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := q.addrCdc.StringToBytes(req.Granter); err != nil {
+		return nil, err
+	}
+
+	pools, pageRes, err := query.CollectionFilteredPaginate(ctx, q.FeePools, req.Pagination,
+		func(_ uint64, pool feegrant.FeePool) (include bool, err error) {
+			return pool.Granter == req.Granter, nil
+		},
+		func(_ uint64, pool feegrant.FeePool) (feegrant.FeePool, error) {
+			return pool, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryFeePoolsByGranterResponse{Pools: pools, Pagination: pageRes}, nil
+}
+
+// FeePoolGrantee returns the quota and usage of a grantee of a fee pool.
+func (q Keeper) FeePoolGrantee(ctx context.Context, req *feegrant.QueryFeePoolGranteeRequest) (*feegrant.QueryFeePoolGranteeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	grantee, err := q.addrCdc.StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	poolGrantee, err := q.FeePoolGranteesByPool.Get(ctx, collections.Join(req.PoolId, sdk.AccAddress(grantee)))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%s is not a grantee of pool %d", req.Grantee, req.PoolId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryFeePoolGranteeResponse{Grantee: &poolGrantee}, nil
+}
+
+// FeePoolGrantees returns the grantees of a fee pool and their usage.
+func (q Keeper) FeePoolGrantees(ctx context.Context, req *feegrant.QueryFeePoolGranteesRequest) (*feegrant.QueryFeePoolGranteesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	grantees, pageRes, err := query.CollectionPaginate(ctx, q.FeePoolGranteesByPool, req.Pagination,
+		func(_ collections.Pair[uint64, sdk.AccAddress], grantee feegrant.FeePoolGrantee) (feegrant.FeePoolGrantee, error) {
+			return grantee, nil
+		}, query.WithCollectionPaginationPairPrefix[uint64, sdk.AccAddress](req.PoolId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QueryFeePoolGranteesResponse{Grantees: grantees, Pagination: pageRes}, nil
+}
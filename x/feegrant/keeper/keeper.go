@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"cosmossdk.io/collections"
@@ -30,6 +31,14 @@ type Keeper struct {
 	FeeAllowance collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant]
 	// FeeAllowanceQueue key: expiration time+grantee+granter | value: bool
 	FeeAllowanceQueue collections.Map[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], bool]
+	// FeePools key: pool id | value: FeePool
+	FeePools collections.Map[uint64, feegrant.FeePool]
+	// FeePoolSeq is the sequence of fee pool ids
+	FeePoolSeq collections.Sequence
+	// FeePoolsByAddress key: pool address | value: pool id
+	FeePoolsByAddress collections.Map[sdk.AccAddress, uint64]
+	// FeePoolGranteesByPool key: pool id+grantee | value: FeePoolGrantee
+	FeePoolGranteesByPool collections.Map[collections.Pair[uint64, sdk.AccAddress], feegrant.FeePoolGrantee]
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
@@ -56,6 +65,10 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, addrCdc address
 			collections.TripleKeyCodec(sdk.TimeKey, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			collections.BoolValue,
 		),
+		FeePools:              collections.NewMap(sb, feegrant.FeePoolKeyPrefix, "fee_pools", collections.Uint64Key, codec.CollValue[feegrant.FeePool](cdc)),
+		FeePoolSeq:            collections.NewSequence(sb, feegrant.FeePoolSeqPrefix, "fee_pool_seq"),
+		FeePoolsByAddress:     collections.NewMap(sb, feegrant.FeePoolByAddressKeyPrefix, "fee_pools_by_address", sdk.AccAddressKey, collections.Uint64Value),
+		FeePoolGranteesByPool: collections.NewMap(sb, feegrant.FeePoolGranteeKeyPrefix, "fee_pool_grantees", collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey), codec.CollValue[feegrant.FeePoolGrantee](cdc)),
 	}
 }
 
@@ -211,8 +224,16 @@ func (k Keeper) IterateAllFeeAllowances(ctx context.Context, cb func(grant feegr
 	})
 }
 
-// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
+// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee.
+// If the granter is the address of a fee pool, the fee is paid by the pool within its limits.
 func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	poolID, err := k.FeePoolsByAddress.Get(ctx, granter)
+	if err == nil {
+		return k.useFeePool(ctx, poolID, grantee, fee, msgs)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
 	grant, err := k.GetAllowance(ctx, granter, grantee)
 	if err != nil {
 		return err
@@ -275,7 +296,8 @@ func (k Keeper) InitGenesis(ctx context.Context, data *feegrant.GenesisState) er
 			return err
 		}
 	}
-	return nil
+
+	return k.initFeePoolsGenesis(ctx, data.FeePools, data.FeePoolGrantees)
 }
 
 // ExportGenesis will dump the contents of the keeper into a serializable GenesisState.
@@ -286,10 +308,33 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*feegrant.GenesisState, erro
 		grants = append(grants, grant)
 		return false
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	var pools []feegrant.FeePool
+	err = k.FeePools.Walk(ctx, nil, func(_ uint64, pool feegrant.FeePool) (stop bool, err error) {
+		pools = append(pools, pool)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var poolGrantees []feegrant.FeePoolGrantee
+	err = k.FeePoolGranteesByPool.Walk(ctx, nil, func(_ collections.Pair[uint64, sdk.AccAddress], grantee feegrant.FeePoolGrantee) (stop bool, err error) {
+		poolGrantees = append(poolGrantees, grantee)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return &feegrant.GenesisState{
-		Allowances: grants,
-	}, err
+		Allowances:      grants,
+		FeePools:        pools,
+		FeePoolGrantees: poolGrantees,
+	}, nil
 }
 
 // RemoveExpiredAllowances iterates grantsByExpiryQueue and deletes the expired grants.
@@ -8,6 +8,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/feegrant"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -103,3 +104,92 @@ func (k msgServer) PruneAllowances(ctx context.Context, req *feegrant.MsgPruneAl
 
 	return &feegrant.MsgPruneAllowancesResponse{}, nil
 }
+
+// CreateFeePool creates a fee pool funded by the granter.
+func (k msgServer) CreateFeePool(ctx context.Context, msg *feegrant.MsgCreateFeePool) (*feegrant.MsgCreateFeePoolResponse, error) {
+	granter, err := k.addrCdc.StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if !msg.Funds.IsValid() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "funds are invalid: %s", msg.Funds)
+	}
+
+	grantees, err := k.granteesToBytes(msg.Grantees)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := feegrant.FeePool{
+		SpendLimit:       msg.SpendLimit,
+		Period:           msg.Period,
+		PeriodSpendLimit: msg.PeriodSpendLimit,
+		AllowedMessages:  msg.AllowedMessages,
+		Expiration:       msg.Expiration,
+	}
+
+	poolID, poolAddr, err := k.Keeper.CreateFeePool(ctx, granter, pool, msg.Funds, grantees)
+	if err != nil {
+		return nil, err
+	}
+
+	poolAddrStr, err := k.addrCdc.BytesToString(poolAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgCreateFeePoolResponse{PoolId: poolID, Address: poolAddrStr}, nil
+}
+
+// UpdateFeePoolGrantees adds and removes grantees of a fee pool.
+func (k msgServer) UpdateFeePoolGrantees(ctx context.Context, msg *feegrant.MsgUpdateFeePoolGrantees) (*feegrant.MsgUpdateFeePoolGranteesResponse, error) {
+	granter, err := k.addrCdc.StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	add, err := k.granteesToBytes(msg.Add)
+	if err != nil {
+		return nil, err
+	}
+
+	remove, err := k.granteesToBytes(msg.Remove)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.UpdateFeePoolGrantees(ctx, granter, msg.PoolId, add, remove); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgUpdateFeePoolGranteesResponse{}, nil
+}
+
+// DeleteFeePool deletes a fee pool and refunds its remaining balance to the granter.
+func (k msgServer) DeleteFeePool(ctx context.Context, msg *feegrant.MsgDeleteFeePool) (*feegrant.MsgDeleteFeePoolResponse, error) {
+	granter, err := k.addrCdc.StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	refund, err := k.Keeper.DeleteFeePool(ctx, granter, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgDeleteFeePoolResponse{Refund: refund}, nil
+}
+
+func (k msgServer) granteesToBytes(grantees []string) ([]sdk.AccAddress, error) {
+	addrs := make([]sdk.AccAddress, len(grantees))
+	for i, grantee := range grantees {
+		addr, err := k.addrCdc.StringToBytes(grantee)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address %s: %s", grantee, err)
+		}
+		addrs[i] = addr
+	}
+
+	return addrs, nil
+}
@@ -20,4 +20,19 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = collections.NewPrefix(1)
+
+	// FeePoolKeyPrefix is the set of the kvstore for fee pool data
+	// - 0x02<pool_id>: FeePool
+	FeePoolKeyPrefix = collections.NewPrefix(2)
+
+	// FeePoolSeqPrefix is the key of the fee pool id sequence
+	FeePoolSeqPrefix = collections.NewPrefix(3)
+
+	// FeePoolByAddressKeyPrefix is the set of the kvstore indexing fee pools by account address
+	// - 0x04<pool_address_bytes>: pool_id
+	FeePoolByAddressKeyPrefix = collections.NewPrefix(4)
+
+	// FeePoolGranteeKeyPrefix is the set of the kvstore for fee pool grantee data
+	// - 0x05<pool_id><grantee_address_bytes>: FeePoolGrantee
+	FeePoolGranteeKeyPrefix = collections.NewPrefix(5)
 )
@@ -44,6 +44,43 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "granter"},
 					},
 				},
+				{
+					RpcMethod: "FeePool",
+					Use:       "pool <pool-id>",
+					Short:     "Query a fee pool and its usage",
+					Example:   fmt.Sprintf(`$ %s query feegrant pool 1`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "pool_id"},
+					},
+				},
+				{
+					RpcMethod: "FeePoolsByGranter",
+					Use:       "pools-by-granter <granter>",
+					Short:     "Query all fee pools created by a granter",
+					Example:   fmt.Sprintf(`$ %s query feegrant pools-by-granter [granter]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+					},
+				},
+				{
+					RpcMethod: "FeePoolGrantee",
+					Use:       "pool-grantee <pool-id> <grantee>",
+					Short:     "Query the quota and usage of a grantee of a fee pool",
+					Example:   fmt.Sprintf(`$ %s query feegrant pool-grantee 1 [grantee]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "pool_id"},
+						{ProtoField: "grantee"},
+					},
+				},
+				{
+					RpcMethod: "FeePoolGrantees",
+					Use:       "pool-grantees <pool-id>",
+					Short:     "Query all grantees of a fee pool and their usage",
+					Example:   fmt.Sprintf(`$ %s query feegrant pool-grantees 1`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "pool_id"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -67,6 +104,39 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Long:      "Prune up to 75 expired allowances in order to reduce the size of the store when the number of expired allowances is large.",
 					Example:   fmt.Sprintf(`$ %s tx feegrant prune --from [mykey]`, version.AppName),
 				},
+				{
+					RpcMethod: "CreateFeePool",
+					Use:       "create-pool <granter> <funds>",
+					Short:     "Create a fee pool shared by many grantees",
+					Long:      "Create a fee pool funded by the granter. Grantees set the pool address as fee granter to have their fees paid by the pool, within the pool-wide spend limit and the per-grantee period spend limit. Note, the '--from' flag is ignored as it is implied from [granter]",
+					Example:   fmt.Sprintf(`$ %s tx feegrant create-pool [granter] 1000stake --spend-limit 500stake --period 24h --period-spend-limit 10stake --grantees [grantee1],[grantee2]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "funds"},
+					},
+				},
+				{
+					RpcMethod: "UpdateFeePoolGrantees",
+					Use:       "update-pool-grantees <granter> <pool-id>",
+					Short:     "Add and remove grantees of a fee pool",
+					Long:      "Add and remove grantees of a fee pool in bulk. Note, the '--from' flag is ignored as it is implied from [granter]",
+					Example:   fmt.Sprintf(`$ %s tx feegrant update-pool-grantees [granter] 1 --add [grantee1],[grantee2] --remove [grantee3]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "pool_id"},
+					},
+				},
+				{
+					RpcMethod: "DeleteFeePool",
+					Use:       "delete-pool <granter> <pool-id>",
+					Short:     "Delete a fee pool and refund its balance",
+					Long:      "Delete a fee pool and send its remaining balance back to the granter. Note, the '--from' flag is ignored as it is implied from [granter]",
+					Example:   fmt.Sprintf(`$ %s tx feegrant delete-pool [granter] 1`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "pool_id"},
+					},
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
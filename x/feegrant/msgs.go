@@ -13,6 +13,8 @@ import (
 
 var (
 	_, _ sdk.Msg                              = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
+	_, _ sdk.Msg                              = &MsgCreateFeePool{}, &MsgUpdateFeePoolGrantees{}
+	_    sdk.Msg                              = &MsgDeleteFeePool{}
 	_    gogoprotoany.UnpackInterfacesMessage = &MsgGrantAllowance{}
 )
 
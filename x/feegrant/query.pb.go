@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -349,6 +350,417 @@ func (m *QueryAllowancesByGranterResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryFeePoolRequest is the request type for the Query/FeePool RPC method.
+type QueryFeePoolRequest struct {
+	// pool_id is the identifier of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *QueryFeePoolRequest) Reset()         { *m = QueryFeePoolRequest{} }
+func (m *QueryFeePoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePoolRequest) ProtoMessage()    {}
+func (*QueryFeePoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{6}
+}
+func (m *QueryFeePoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeePoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePoolRequest.Merge(m, src)
+}
+func (m *QueryFeePoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeePoolRequest proto.InternalMessageInfo
+
+func (m *QueryFeePoolRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// QueryFeePoolResponse is the response type for the Query/FeePool RPC method.
+type QueryFeePoolResponse struct {
+	// pool is the fee pool.
+	Pool *FeePool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+}
+
+func (m *QueryFeePoolResponse) Reset()         { *m = QueryFeePoolResponse{} }
+func (m *QueryFeePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePoolResponse) ProtoMessage()    {}
+func (*QueryFeePoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{7}
+}
+func (m *QueryFeePoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePoolResponse.Merge(m, src)
+}
+func (m *QueryFeePoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeePoolResponse proto.InternalMessageInfo
+
+func (m *QueryFeePoolResponse) GetPool() *FeePool {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+// QueryFeePoolsByGranterRequest is the request type for the Query/FeePoolsByGranter RPC method.
+type QueryFeePoolsByGranterRequest struct {
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeePoolsByGranterRequest) Reset()         { *m = QueryFeePoolsByGranterRequest{} }
+func (m *QueryFeePoolsByGranterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePoolsByGranterRequest) ProtoMessage()    {}
+func (*QueryFeePoolsByGranterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{8}
+}
+func (m *QueryFeePoolsByGranterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePoolsByGranterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePoolsByGranterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeePoolsByGranterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePoolsByGranterRequest.Merge(m, src)
+}
+func (m *QueryFeePoolsByGranterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePoolsByGranterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePoolsByGranterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeePoolsByGranterRequest proto.InternalMessageInfo
+
+func (m *QueryFeePoolsByGranterRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QueryFeePoolsByGranterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeePoolsByGranterResponse is the response type for the Query/FeePoolsByGranter RPC method.
+type QueryFeePoolsByGranterResponse struct {
+	// pools are the fee pools created by the granter.
+	Pools []FeePool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeePoolsByGranterResponse) Reset()         { *m = QueryFeePoolsByGranterResponse{} }
+func (m *QueryFeePoolsByGranterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePoolsByGranterResponse) ProtoMessage()    {}
+func (*QueryFeePoolsByGranterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{9}
+}
+func (m *QueryFeePoolsByGranterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePoolsByGranterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePoolsByGranterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeePoolsByGranterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePoolsByGranterResponse.Merge(m, src)
+}
+func (m *QueryFeePoolsByGranterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePoolsByGranterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePoolsByGranterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeePoolsByGranterResponse proto.InternalMessageInfo
+
+func (m *QueryFeePoolsByGranterResponse) GetPools() []FeePool {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+func (m *QueryFeePoolsByGranterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeePoolGranteeRequest is the request type for the Query/FeePoolGrantee RPC method.
+type QueryFeePoolGranteeRequest struct {
+	// pool_id is the identifier of the pool.
+	PoolId  uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (m *QueryFeePoolGranteeRequest) Reset()         { *m = QueryFeePoolGranteeRequest{} }
+func (m *QueryFeePoolGranteeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePoolGranteeRequest) ProtoMessage()    {}
+func (*QueryFeePoolGranteeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{10}
+}
+func (m *QueryFeePoolGranteeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePoolGranteeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePoolGranteeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeePoolGranteeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePoolGranteeRequest.Merge(m, src)
+}
+func (m *QueryFeePoolGranteeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePoolGranteeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePoolGranteeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeePoolGranteeRequest proto.InternalMessageInfo
+
+func (m *QueryFeePoolGranteeRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryFeePoolGranteeRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+// QueryFeePoolGranteeResponse is the response type for the Query/FeePoolGrantee RPC method.
+type QueryFeePoolGranteeResponse struct {
+	// grantee is the quota and usage of the grantee.
+	Grantee *FeePoolGrantee `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (m *QueryFeePoolGranteeResponse) Reset()         { *m = QueryFeePoolGranteeResponse{} }
+func (m *QueryFeePoolGranteeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePoolGranteeResponse) ProtoMessage()    {}
+func (*QueryFeePoolGranteeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{11}
+}
+func (m *QueryFeePoolGranteeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePoolGranteeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePoolGranteeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeePoolGranteeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePoolGranteeResponse.Merge(m, src)
+}
+func (m *QueryFeePoolGranteeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePoolGranteeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePoolGranteeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeePoolGranteeResponse proto.InternalMessageInfo
+
+func (m *QueryFeePoolGranteeResponse) GetGrantee() *FeePoolGrantee {
+	if m != nil {
+		return m.Grantee
+	}
+	return nil
+}
+
+// QueryFeePoolGranteesRequest is the request type for the Query/FeePoolGrantees RPC method.
+type QueryFeePoolGranteesRequest struct {
+	// pool_id is the identifier of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeePoolGranteesRequest) Reset()         { *m = QueryFeePoolGranteesRequest{} }
+func (m *QueryFeePoolGranteesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePoolGranteesRequest) ProtoMessage()    {}
+func (*QueryFeePoolGranteesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{12}
+}
+func (m *QueryFeePoolGranteesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePoolGranteesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePoolGranteesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeePoolGranteesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePoolGranteesRequest.Merge(m, src)
+}
+func (m *QueryFeePoolGranteesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePoolGranteesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePoolGranteesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeePoolGranteesRequest proto.InternalMessageInfo
+
+func (m *QueryFeePoolGranteesRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryFeePoolGranteesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeePoolGranteesResponse is the response type for the Query/FeePoolGrantees RPC method.
+type QueryFeePoolGranteesResponse struct {
+	// grantees are the grantees of the pool and their usage.
+	Grantees []FeePoolGrantee `protobuf:"bytes,1,rep,name=grantees,proto3" json:"grantees"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeePoolGranteesResponse) Reset()         { *m = QueryFeePoolGranteesResponse{} }
+func (m *QueryFeePoolGranteesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePoolGranteesResponse) ProtoMessage()    {}
+func (*QueryFeePoolGranteesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{13}
+}
+func (m *QueryFeePoolGranteesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePoolGranteesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePoolGranteesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeePoolGranteesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePoolGranteesResponse.Merge(m, src)
+}
+func (m *QueryFeePoolGranteesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePoolGranteesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePoolGranteesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeePoolGranteesResponse proto.InternalMessageInfo
+
+func (m *QueryFeePoolGranteesResponse) GetGrantees() []FeePoolGrantee {
+	if m != nil {
+		return m.Grantees
+	}
+	return nil
+}
+
+func (m *QueryFeePoolGranteesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAllowanceRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceRequest")
 	proto.RegisterType((*QueryAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceResponse")
@@ -356,6 +768,14 @@ func init() {
 	proto.RegisterType((*QueryAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesResponse")
 	proto.RegisterType((*QueryAllowancesByGranterRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterRequest")
 	proto.RegisterType((*QueryAllowancesByGranterResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterResponse")
+	proto.RegisterType((*QueryFeePoolRequest)(nil), "cosmos.feegrant.v1beta1.QueryFeePoolRequest")
+	proto.RegisterType((*QueryFeePoolResponse)(nil), "cosmos.feegrant.v1beta1.QueryFeePoolResponse")
+	proto.RegisterType((*QueryFeePoolsByGranterRequest)(nil), "cosmos.feegrant.v1beta1.QueryFeePoolsByGranterRequest")
+	proto.RegisterType((*QueryFeePoolsByGranterResponse)(nil), "cosmos.feegrant.v1beta1.QueryFeePoolsByGranterResponse")
+	proto.RegisterType((*QueryFeePoolGranteeRequest)(nil), "cosmos.feegrant.v1beta1.QueryFeePoolGranteeRequest")
+	proto.RegisterType((*QueryFeePoolGranteeResponse)(nil), "cosmos.feegrant.v1beta1.QueryFeePoolGranteeResponse")
+	proto.RegisterType((*QueryFeePoolGranteesRequest)(nil), "cosmos.feegrant.v1beta1.QueryFeePoolGranteesRequest")
+	proto.RegisterType((*QueryFeePoolGranteesResponse)(nil), "cosmos.feegrant.v1beta1.QueryFeePoolGranteesResponse")
 }
 
 func init() {
@@ -363,41 +783,60 @@ func init() {
 }
 
 var fileDescriptor_59efc303945de53f = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0x33, 0xfd, 0xfd, 0x54, 0xf2, 0xf4, 0x20, 0x4c, 0xd5, 0xc6, 0x45, 0xd6, 0xb0, 0x42,
-	0xfd, 0x47, 0x66, 0x9a, 0xa8, 0xa5, 0x8a, 0x14, 0x1a, 0xc4, 0x5c, 0x35, 0x82, 0x07, 0x2f, 0x32,
-	0xe9, 0x3e, 0x2e, 0x4b, 0xe3, 0x4e, 0xba, 0xb3, 0x51, 0x8b, 0x14, 0xc1, 0x57, 0x20, 0xe8, 0x2b,
-	0xf0, 0xe0, 0xc9, 0x53, 0xe9, 0x0b, 0xf0, 0x28, 0x9e, 0x42, 0xbd, 0x78, 0x94, 0xc4, 0x17, 0x22,
-	0x99, 0x9d, 0xdd, 0x8d, 0x49, 0x96, 0x2e, 0xea, 0xc1, 0x5b, 0x26, 0xfb, 0xfd, 0x3e, 0xfb, 0xf9,
-	0x3e, 0xcf, 0xcc, 0x2c, 0x5c, 0xd8, 0x92, 0xea, 0xa9, 0x54, 0xfc, 0x09, 0xa2, 0x17, 0x8a, 0x20,
-	0xe2, 0xcf, 0xea, 0x1d, 0x8c, 0x44, 0x9d, 0xef, 0xf4, 0x31, 0xdc, 0x65, 0xbd, 0x50, 0x46, 0x92,
-	0x2e, 0xc7, 0x22, 0x96, 0x88, 0x98, 0x11, 0x59, 0x2b, 0x79, 0xee, 0x54, 0xa9, 0x0b, 0x58, 0x57,
-	0x8c, 0xae, 0x23, 0x14, 0xc6, 0x95, 0x53, 0x65, 0x4f, 0x78, 0x7e, 0x20, 0x22, 0x5f, 0x06, 0x46,
-	0x7b, 0xce, 0x93, 0xd2, 0xeb, 0x22, 0x17, 0x3d, 0x9f, 0x8b, 0x20, 0x90, 0x91, 0x7e, 0xa8, 0xcc,
-	0xd3, 0xb3, 0x71, 0xa5, 0xc7, 0x7a, 0xc5, 0x0d, 0x97, 0x5e, 0x38, 0xaf, 0xe0, 0xf4, 0xfd, 0x71,
-	0xe9, 0xcd, 0x6e, 0x57, 0x3e, 0x17, 0xc1, 0x16, 0xb6, 0x71, 0xa7, 0x8f, 0x2a, 0xa2, 0x0d, 0x38,
-	0xa1, 0x61, 0x30, 0xac, 0x90, 0x2a, 0xb9, 0x54, 0x6e, 0x56, 0x0e, 0x0f, 0x6a, 0xa7, 0x8c, 0x77,
-	0xd3, 0x75, 0x43, 0x54, 0xea, 0x41, 0x14, 0xfa, 0x81, 0xd7, 0x4e, 0x84, 0x99, 0x07, 0x2b, 0x0b,
-	0xc5, 0x3c, 0xe8, 0x3c, 0x84, 0x33, 0xd3, 0x00, 0xaa, 0x27, 0x03, 0x85, 0xf4, 0x36, 0x94, 0x45,
-	0xf2, 0xa7, 0x66, 0x58, 0x6c, 0xd8, 0x2c, 0xa7, 0xa9, 0xac, 0x35, 0x5e, 0xb5, 0x33, 0x83, 0xf3,
-	0x8e, 0x4c, 0x17, 0x56, 0x33, 0xd1, 0xb0, 0x68, 0x34, 0xa4, 0x77, 0x01, 0xb2, 0xa6, 0xeb, 0x74,
-	0x8b, 0x8d, 0x95, 0x84, 0x66, 0x3c, 0x21, 0x16, 0xcf, 0x3e, 0xe1, 0xb9, 0x27, 0xbc, 0xa4, 0x95,
-	0xed, 0x09, 0xa7, 0xf3, 0x9e, 0xc0, 0xf2, 0x0c, 0x96, 0x09, 0xbc, 0x01, 0x90, 0xf2, 0xab, 0x0a,
-	0xa9, 0xfe, 0x57, 0x20, 0xf1, 0x84, 0x83, 0xb6, 0xe6, 0x30, 0x5e, 0x3c, 0x92, 0x31, 0x7e, 0xf9,
-	0x2f, 0x90, 0xfb, 0x04, 0xce, 0x4f, 0x41, 0x36, 0x77, 0x5b, 0xf1, 0x90, 0xff, 0x64, 0x7f, 0xfc,
-	0xa5, 0x26, 0xde, 0x5a, 0x3a, 0x3c, 0xa8, 0x9d, 0x8c, 0x6d, 0x35, 0xe5, 0x6e, 0x57, 0x57, 0xd9,
-	0xf5, 0x35, 0xe7, 0x13, 0x81, 0x6a, 0x3e, 0xf4, 0x3f, 0xd6, 0xe2, 0xb9, 0x11, 0x1a, 0xfb, 0xff,
-	0xc3, 0x31, 0x1d, 0x81, 0x7e, 0x24, 0x50, 0x4e, 0x73, 0x50, 0x96, 0x4b, 0x38, 0xf7, 0xec, 0x5a,
-	0xbc, 0xb0, 0x3e, 0x26, 0x73, 0x36, 0x5e, 0x7f, 0xfd, 0xf1, 0x76, 0x61, 0x9d, 0xae, 0xf1, 0xbc,
-	0xbb, 0x29, 0xed, 0x01, 0x7f, 0x69, 0xa6, 0xb9, 0x97, 0xfc, 0xc2, 0x3d, 0xfa, 0x81, 0x00, 0x64,
-	0x6d, 0xa7, 0x45, 0xdf, 0x9f, 0x9c, 0x48, 0x6b, 0xb5, 0xb8, 0xc1, 0x10, 0xdf, 0xd0, 0xc4, 0x9c,
-	0xd6, 0x8e, 0x26, 0x56, 0x13, 0xa0, 0x03, 0x02, 0x4b, 0x73, 0xf6, 0x07, 0x5d, 0x2f, 0x0a, 0x30,
-	0x7d, 0x0e, 0xac, 0x9b, 0xbf, 0xe1, 0x34, 0x19, 0xee, 0x7c, 0x99, 0xdd, 0x03, 0x3a, 0xd6, 0x55,
-	0x7a, 0x39, 0x37, 0x96, 0xaf, 0x54, 0x1f, 0xdd, 0x6c, 0x0a, 0xcd, 0xfa, 0xe7, 0xa1, 0x4d, 0x06,
-	0x43, 0x9b, 0x7c, 0x1f, 0xda, 0xe4, 0xcd, 0xc8, 0x2e, 0x0d, 0x46, 0x76, 0xe9, 0xdb, 0xc8, 0x2e,
-	0x3d, 0x32, 0x5f, 0x20, 0xe5, 0x6e, 0x33, 0x5f, 0xf2, 0x17, 0x69, 0xad, 0xce, 0x71, 0x7d, 0xf7,
-	0x5f, 0xfb, 0x19, 0x00, 0x00, 0xff, 0xff, 0x84, 0x03, 0x50, 0xd8, 0xc8, 0x06, 0x00, 0x00,
+	// 834 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcf, 0x4f, 0x13, 0x4d,
+	0x18, 0xc7, 0x3b, 0xbc, 0xfc, 0x78, 0x79, 0x48, 0x5e, 0xf2, 0x0e, 0x28, 0x75, 0xc5, 0xa5, 0x59,
+	0x13, 0x44, 0xb4, 0xbb, 0xb4, 0x05, 0x04, 0x51, 0x92, 0x56, 0x03, 0x21, 0xf1, 0x80, 0x35, 0xf1,
+	0xe0, 0xa5, 0x6e, 0xe9, 0xb8, 0xd9, 0x50, 0x77, 0x4a, 0xa7, 0xa8, 0x84, 0xa0, 0x89, 0x7f, 0x01,
+	0x89, 0x1e, 0x4c, 0xbc, 0x79, 0xd0, 0x8b, 0x27, 0xc2, 0xc5, 0x1b, 0xde, 0x88, 0x27, 0x82, 0x17,
+	0x4f, 0xc6, 0x80, 0x7f, 0x88, 0xe9, 0xec, 0xec, 0x76, 0xfb, 0x63, 0xe9, 0x16, 0x7b, 0xe0, 0xd6,
+	0xdd, 0x7d, 0xbe, 0xcf, 0x7c, 0xbe, 0xcf, 0x33, 0xf3, 0x6c, 0x17, 0x2e, 0xaf, 0x50, 0xf6, 0x94,
+	0x32, 0xed, 0x09, 0x21, 0x46, 0x51, 0xb7, 0x4a, 0xda, 0xb3, 0x58, 0x96, 0x94, 0xf4, 0x98, 0xb6,
+	0xb6, 0x4e, 0x8a, 0x1b, 0x6a, 0xa1, 0x48, 0x4b, 0x14, 0x0f, 0xd9, 0x41, 0xaa, 0x13, 0xa4, 0x8a,
+	0x20, 0x69, 0xd4, 0x4f, 0xed, 0x46, 0xf2, 0x04, 0xd2, 0xb8, 0x88, 0xcb, 0xea, 0x8c, 0xd8, 0x99,
+	0xdd, 0xc8, 0x82, 0x6e, 0x98, 0x96, 0x5e, 0x32, 0xa9, 0x25, 0x62, 0x87, 0x0d, 0x4a, 0x8d, 0x3c,
+	0xd1, 0xf4, 0x82, 0xa9, 0xe9, 0x96, 0x45, 0x4b, 0xfc, 0x21, 0x13, 0x4f, 0x2f, 0xd8, 0x99, 0x32,
+	0xfc, 0x4a, 0x13, 0x5c, 0xf6, 0xa3, 0x41, 0x83, 0x1a, 0xd4, 0xbe, 0x5f, 0xfe, 0x65, 0xdf, 0x55,
+	0x5e, 0xc1, 0xb9, 0xfb, 0xe5, 0x05, 0x93, 0xf9, 0x3c, 0x7d, 0xae, 0x5b, 0x2b, 0x24, 0x4d, 0xd6,
+	0xd6, 0x09, 0x2b, 0xe1, 0x38, 0xf4, 0x70, 0x44, 0x52, 0x0c, 0xa3, 0x08, 0x1a, 0xeb, 0x4d, 0x85,
+	0x0f, 0x77, 0xa3, 0x83, 0x22, 0x63, 0x32, 0x97, 0x2b, 0x12, 0xc6, 0x1e, 0x94, 0x8a, 0xa6, 0x65,
+	0xa4, 0x9d, 0xc0, 0x8a, 0x86, 0x84, 0x3b, 0x82, 0x69, 0x88, 0xf2, 0x10, 0xce, 0xd7, 0x02, 0xb0,
+	0x02, 0xb5, 0x18, 0xc1, 0xb7, 0xa0, 0x57, 0x77, 0x6e, 0x72, 0x86, 0xbe, 0xb8, 0xac, 0xfa, 0x94,
+	0x5a, 0x5d, 0x2c, 0x5f, 0xa5, 0x2b, 0x02, 0xe5, 0x2d, 0xaa, 0x4d, 0xcc, 0xea, 0xac, 0x91, 0xa0,
+	0xd6, 0x08, 0x5e, 0x00, 0xa8, 0xb4, 0x82, 0xbb, 0xeb, 0x8b, 0x8f, 0x3a, 0x34, 0xe5, 0xbe, 0xa9,
+	0xf6, 0x8e, 0x70, 0x78, 0x96, 0x75, 0xc3, 0x29, 0x65, 0xda, 0xa3, 0x54, 0x3e, 0x20, 0x18, 0xaa,
+	0xc3, 0x12, 0x86, 0xe7, 0x01, 0x5c, 0x7e, 0x16, 0x46, 0x91, 0x7f, 0x02, 0x38, 0xf6, 0x28, 0xf0,
+	0x62, 0x03, 0xc6, 0x2b, 0x4d, 0x19, 0xed, 0xc5, 0xab, 0x20, 0x77, 0x10, 0x8c, 0xd4, 0x40, 0xa6,
+	0x36, 0x16, 0xed, 0x26, 0xff, 0xcd, 0xfe, 0x68, 0x53, 0x11, 0x6f, 0x0e, 0x1c, 0xee, 0x46, 0xfb,
+	0x6d, 0x59, 0x94, 0xe5, 0x56, 0x23, 0x13, 0xea, 0xe4, 0xb4, 0xb2, 0x87, 0x20, 0xe2, 0x0f, 0x7d,
+	0xc6, 0x4a, 0xdc, 0xd8, 0x82, 0x0a, 0x03, 0xdc, 0xc1, 0x02, 0x21, 0xcb, 0x94, 0xe6, 0x9d, 0x52,
+	0x0f, 0x41, 0x4f, 0x81, 0xd2, 0x7c, 0xc6, 0xcc, 0xf1, 0x52, 0x77, 0xa6, 0xbb, 0xcb, 0x97, 0x4b,
+	0x39, 0xe5, 0x1e, 0x0c, 0x56, 0xc7, 0x0b, 0x97, 0x93, 0xd0, 0x59, 0x8e, 0x10, 0x87, 0x26, 0xe2,
+	0xeb, 0xcf, 0xd1, 0xf1, 0x68, 0xe5, 0x3d, 0x82, 0x4b, 0xde, 0x74, 0x67, 0xaa, 0xe7, 0xca, 0x27,
+	0x04, 0xb2, 0x1f, 0x9d, 0x3b, 0x30, 0xba, 0xca, 0x46, 0x9c, 0xbe, 0x36, 0xf5, 0x9d, 0xea, 0xdc,
+	0xff, 0x39, 0x12, 0x4a, 0xdb, 0xa2, 0xf6, 0x9d, 0x1e, 0x13, 0x24, 0x2f, 0xa8, 0x4d, 0x49, 0x9a,
+	0x35, 0xf3, 0x54, 0xc3, 0xf3, 0x31, 0x5c, 0x6c, 0xb8, 0x94, 0x28, 0x48, 0xb2, 0x7a, 0xd0, 0x79,
+	0xfc, 0xf8, 0x95, 0xc4, 0xc9, 0xe0, 0xae, 0xf0, 0xb2, 0xe1, 0x0a, 0xac, 0xa9, 0x9b, 0x76, 0xb5,
+	0x7d, 0x07, 0xc1, 0x70, 0x63, 0x00, 0xe1, 0x71, 0x09, 0xfe, 0x15, 0xac, 0x4e, 0xdf, 0x83, 0x9a,
+	0x14, 0xed, 0x77, 0xe5, 0x6d, 0xdb, 0x01, 0xf1, 0x6d, 0x80, 0x2e, 0x0e, 0x8d, 0x3f, 0x23, 0xe8,
+	0x75, 0xe7, 0x11, 0x56, 0x7d, 0xc9, 0x1a, 0xbe, 0x83, 0x25, 0x2d, 0x70, 0xbc, 0x0d, 0xa1, 0xcc,
+	0xbf, 0xfe, 0xfe, 0xfb, 0x4d, 0xc7, 0x0c, 0x9e, 0xd6, 0xfc, 0xfe, 0x79, 0xb8, 0xb3, 0x4c, 0xdb,
+	0x14, 0x27, 0x74, 0xcb, 0xf9, 0x45, 0xb6, 0xf0, 0x47, 0x04, 0x90, 0xac, 0x4c, 0xbb, 0xa0, 0xeb,
+	0x3b, 0xdb, 0x41, 0x9a, 0x08, 0x2e, 0x10, 0xc4, 0x53, 0x9c, 0x58, 0xc3, 0xd1, 0xe6, 0xc4, 0xcc,
+	0x03, 0x7a, 0x80, 0x60, 0xa0, 0xc1, 0x9c, 0xc7, 0x33, 0x41, 0x01, 0x6a, 0x67, 0x9b, 0x34, 0x7b,
+	0x0a, 0xa5, 0xf0, 0x70, 0xf7, 0x5b, 0xfd, 0x2c, 0xe7, 0xb6, 0xae, 0xe1, 0xab, 0xbe, 0xb6, 0x4c,
+	0xc6, 0xd6, 0x49, 0xae, 0xd2, 0x05, 0xfc, 0x0e, 0x41, 0x8f, 0xd8, 0xa0, 0xf8, 0xfa, 0xc9, 0x30,
+	0xd5, 0xef, 0x07, 0x29, 0x1a, 0x30, 0x5a, 0xe0, 0x4e, 0x70, 0xb6, 0x71, 0x3c, 0xe6, 0xcb, 0xc6,
+	0x07, 0xa2, 0xb6, 0x29, 0x4e, 0xf6, 0x16, 0xfe, 0x8a, 0xe0, 0xff, 0xba, 0xb1, 0x8b, 0xa7, 0x03,
+	0x2d, 0x5b, 0x5f, 0xe9, 0x1b, 0x2d, 0xeb, 0x04, 0xf8, 0x1c, 0x07, 0x9f, 0xc2, 0x89, 0x93, 0xc1,
+	0x33, 0xd9, 0x8d, 0x8c, 0xa8, 0xaa, 0xa7, 0xbc, 0x7b, 0x08, 0xfe, 0xab, 0x3e, 0xff, 0x38, 0x11,
+	0x08, 0xa4, 0x7a, 0x7e, 0x4b, 0x93, 0xad, 0x89, 0x04, 0xfa, 0x1d, 0x8e, 0x7e, 0x1b, 0xcf, 0x05,
+	0xad, 0xb9, 0xe6, 0x4c, 0x25, 0xcf, 0xa6, 0xff, 0x82, 0xa0, 0xbf, 0x3a, 0x3f, 0xc3, 0x2d, 0xe1,
+	0xb8, 0xe7, 0x74, 0xaa, 0x45, 0x95, 0x70, 0x31, 0xcb, 0x5d, 0x24, 0x70, 0xac, 0x65, 0x17, 0xa9,
+	0xd8, 0xfe, 0x91, 0x8c, 0x0e, 0x8e, 0x64, 0xf4, 0xeb, 0x48, 0x46, 0xdb, 0xc7, 0x72, 0xe8, 0xe0,
+	0x58, 0x0e, 0xfd, 0x38, 0x96, 0x43, 0x8f, 0xc4, 0xd7, 0x13, 0xcb, 0xad, 0xaa, 0x26, 0xd5, 0x5e,
+	0xb8, 0x39, 0xb3, 0xdd, 0xfc, 0x0b, 0x25, 0xf1, 0x67, 0x00, 0x55, 0x65, 0x0c, 0xe6, 0x84, 0x0d,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -418,6 +857,14 @@ type QueryClient interface {
 	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// FeePool returns a fee pool and its usage.
+	FeePool(ctx context.Context, in *QueryFeePoolRequest, opts ...grpc.CallOption) (*QueryFeePoolResponse, error)
+	// FeePoolsByGranter returns the fee pools created by a granter.
+	FeePoolsByGranter(ctx context.Context, in *QueryFeePoolsByGranterRequest, opts ...grpc.CallOption) (*QueryFeePoolsByGranterResponse, error)
+	// FeePoolGrantee returns the quota and usage of a grantee of a fee pool.
+	FeePoolGrantee(ctx context.Context, in *QueryFeePoolGranteeRequest, opts ...grpc.CallOption) (*QueryFeePoolGranteeResponse, error)
+	// FeePoolGrantees returns the grantees of a fee pool and their usage.
+	FeePoolGrantees(ctx context.Context, in *QueryFeePoolGranteesRequest, opts ...grpc.CallOption) (*QueryFeePoolGranteesResponse, error)
 }
 
 type queryClient struct {
@@ -455,15 +902,59 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Allowance returns granted allowance to the grantee by the granter.
-	Allowance(context.Context, *QueryAllowanceRequest) (*QueryAllowanceResponse, error)
-	// Allowances returns all the grants for the given grantee address.
-	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
-	// AllowancesByGranter returns all the grants given by an address
-	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
-}
+func (c *queryClient) FeePool(ctx context.Context, in *QueryFeePoolRequest, opts ...grpc.CallOption) (*QueryFeePoolResponse, error) {
+	out := new(QueryFeePoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/FeePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePoolsByGranter(ctx context.Context, in *QueryFeePoolsByGranterRequest, opts ...grpc.CallOption) (*QueryFeePoolsByGranterResponse, error) {
+	out := new(QueryFeePoolsByGranterResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/FeePoolsByGranter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePoolGrantee(ctx context.Context, in *QueryFeePoolGranteeRequest, opts ...grpc.CallOption) (*QueryFeePoolGranteeResponse, error) {
+	out := new(QueryFeePoolGranteeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/FeePoolGrantee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePoolGrantees(ctx context.Context, in *QueryFeePoolGranteesRequest, opts ...grpc.CallOption) (*QueryFeePoolGranteesResponse, error) {
+	out := new(QueryFeePoolGranteesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/FeePoolGrantees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Allowance returns granted allowance to the grantee by the granter.
+	Allowance(context.Context, *QueryAllowanceRequest) (*QueryAllowanceResponse, error)
+	// Allowances returns all the grants for the given grantee address.
+	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
+	// AllowancesByGranter returns all the grants given by an address
+	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// FeePool returns a fee pool and its usage.
+	FeePool(context.Context, *QueryFeePoolRequest) (*QueryFeePoolResponse, error)
+	// FeePoolsByGranter returns the fee pools created by a granter.
+	FeePoolsByGranter(context.Context, *QueryFeePoolsByGranterRequest) (*QueryFeePoolsByGranterResponse, error)
+	// FeePoolGrantee returns the quota and usage of a grantee of a fee pool.
+	FeePoolGrantee(context.Context, *QueryFeePoolGranteeRequest) (*QueryFeePoolGranteeResponse, error)
+	// FeePoolGrantees returns the grantees of a fee pool and their usage.
+	FeePoolGrantees(context.Context, *QueryFeePoolGranteesRequest) (*QueryFeePoolGranteesResponse, error)
+}
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
 type UnimplementedQueryServer struct {
@@ -478,6 +969,18 @@ func (*UnimplementedQueryServer) Allowances(ctx context.Context, req *QueryAllow
 func (*UnimplementedQueryServer) AllowancesByGranter(ctx context.Context, req *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (*UnimplementedQueryServer) FeePool(ctx context.Context, req *QueryFeePoolRequest) (*QueryFeePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePool not implemented")
+}
+func (*UnimplementedQueryServer) FeePoolsByGranter(ctx context.Context, req *QueryFeePoolsByGranterRequest) (*QueryFeePoolsByGranterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePoolsByGranter not implemented")
+}
+func (*UnimplementedQueryServer) FeePoolGrantee(ctx context.Context, req *QueryFeePoolGranteeRequest) (*QueryFeePoolGranteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePoolGrantee not implemented")
+}
+func (*UnimplementedQueryServer) FeePoolGrantees(ctx context.Context, req *QueryFeePoolGranteesRequest) (*QueryFeePoolGranteesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePoolGrantees not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -537,6 +1040,78 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/FeePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePool(ctx, req.(*QueryFeePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePoolsByGranter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePoolsByGranterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePoolsByGranter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/FeePoolsByGranter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePoolsByGranter(ctx, req.(*QueryFeePoolsByGranterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePoolGrantee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePoolGranteeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePoolGrantee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/FeePoolGrantee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePoolGrantee(ctx, req.(*QueryFeePoolGranteeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePoolGrantees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePoolGranteesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePoolGrantees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/FeePoolGrantees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePoolGrantees(ctx, req.(*QueryFeePoolGranteesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Query",
@@ -554,6 +1129,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "FeePool",
+			Handler:    _Query_FeePool_Handler,
+		},
+		{
+			MethodName: "FeePoolsByGranter",
+			Handler:    _Query_FeePoolsByGranter_Handler,
+		},
+		{
+			MethodName: "FeePoolGrantee",
+			Handler:    _Query_FeePoolGrantee_Handler,
+		},
+		{
+			MethodName: "FeePoolGrantees",
+			Handler:    _Query_FeePoolGrantees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -813,126 +1404,1240 @@ func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFeePoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAllowanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryFeePoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeePoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllowancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFeePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllowancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Allowances) > 0 {
-		for _, e := range m.Allowances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllowancesByGranterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeePoolsByGranterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePoolsByGranterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePoolsByGranterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllowancesByGranterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeePoolsByGranterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePoolsByGranterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePoolsByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Allowances) > 0 {
-		for _, e := range m.Allowances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePoolGranteeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePoolGranteeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePoolGranteeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePoolGranteeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePoolGranteeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePoolGranteeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Grantee != nil {
+		{
+			size, err := m.Grantee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePoolGranteesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePoolGranteesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePoolGranteesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePoolGranteesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePoolGranteesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePoolGranteesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grantees) > 0 {
+		for iNdEx := len(m.Grantees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grantees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesByGranterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryAllowancesByGranterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryFeePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePoolsByGranterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePoolsByGranterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePoolGranteeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePoolGranteeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Grantee != nil {
+		l = m.Grantee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePoolGranteesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePoolGranteesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grantees) > 0 {
+		for _, e := range m.Grantees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &Grant{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, &Grant{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, &Grant{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeePoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -955,49 +2660,17 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeePoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeePoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1007,24 +2680,11 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1046,7 +2706,7 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeePoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1069,15 +2729,15 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeePoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1104,10 +2764,10 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allowance == nil {
-				m.Allowance = &Grant{}
+			if m.Pool == nil {
+				m.Pool = &FeePool{}
 			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1132,7 +2792,7 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeePoolsByGranterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1155,15 +2815,15 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeePoolsByGranterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeePoolsByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1191,7 +2851,7 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1250,7 +2910,7 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeePoolsByGranterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1273,15 +2933,15 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeePoolsByGranterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeePoolsByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1308,8 +2968,8 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowances = append(m.Allowances, &Grant{})
-			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pools = append(m.Pools, FeePool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1370,7 +3030,7 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeePoolGranteeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1393,15 +3053,34 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeePoolGranteeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeePoolGranteeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1429,8 +3108,163 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePoolGranteeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePoolGranteeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePoolGranteeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Grantee == nil {
+				m.Grantee = &FeePoolGrantee{}
+			}
+			if err := m.Grantee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePoolGranteesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePoolGranteesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePoolGranteesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -1488,7 +3322,7 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeePoolGranteesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1511,15 +3345,15 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeePoolGranteesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeePoolGranteesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1546,8 +3380,8 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowances = append(m.Allowances, &Grant{})
-			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Grantees = append(m.Grantees, FeePoolGrantee{})
+			if err := m.Grantees[len(m.Grantees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20,6 +20,16 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &grantA)
 			cdc.MustUnmarshal(kvB.Value, &grantB)
 			return fmt.Sprintf("%v\n%v", grantA, grantB)
+		case bytes.Equal(kvA.Key[:1], feegrant.FeePoolKeyPrefix):
+			var poolA, poolB feegrant.FeePool
+			cdc.MustUnmarshal(kvA.Value, &poolA)
+			cdc.MustUnmarshal(kvB.Value, &poolB)
+			return fmt.Sprintf("%v\n%v", poolA, poolB)
+		case bytes.Equal(kvA.Key[:1], feegrant.FeePoolGranteeKeyPrefix):
+			var granteeA, granteeB feegrant.FeePoolGrantee
+			cdc.MustUnmarshal(kvA.Value, &granteeA)
+			cdc.MustUnmarshal(kvB.Value, &granteeB)
+			return fmt.Sprintf("%v\n%v", granteeA, granteeB)
 		default:
 			panic(fmt.Sprintf("invalid feegrant key %X", kvA.Key))
 		}
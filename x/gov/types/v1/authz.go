@@ -0,0 +1,109 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewVoteAuthorization creates a new VoteAuthorization object.
+func NewVoteAuthorization(options []VoteOption, proposalTypes []ProposalType, proposalMessageTypes []string) *VoteAuthorization {
+	return &VoteAuthorization{
+		Options:              options,
+		ProposalTypes:        proposalTypes,
+		ProposalMessageTypes: proposalMessageTypes,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+// A VoteAuthorization only delegates MsgVote: authz grants are keyed by message
+// type, so weighted votes (MsgVoteWeighted) cannot be delegated with the same
+// restrictions and require a GenericAuthorization instead.
+func (a VoteAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgVote{})
+}
+
+// Accept implements Authorization.Accept.
+// The vote is accepted if its option is allowed and the proposal it targets
+// matches the proposal restrictions of the authorization.
+func (a VoteAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mVote, ok := msg.(*MsgVote)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if len(a.Options) > 0 && !slices.Contains(a.Options, mVote.Option) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot vote %s", mVote.Option)
+	}
+
+	if len(a.ProposalTypes) == 0 && len(a.ProposalMessageTypes) == 0 {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	authzEnv, ok := ctx.Value(corecontext.EnvironmentContextKey).(appmodulev2.Environment)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("environment not set")
+	}
+
+	res, err := authzEnv.QueryRouterService.Invoke(ctx, &QueryProposalRequest{ProposalId: mVote.ProposalId})
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+	proposalRes, ok := res.(*QueryProposalResponse)
+	if !ok || proposalRes.Proposal == nil {
+		return authz.AcceptResponse{}, fmt.Errorf("unexpected proposal query response %T", res)
+	}
+	proposal := proposalRes.Proposal
+
+	if len(a.ProposalTypes) > 0 && !slices.Contains(a.ProposalTypes, proposal.ProposalType) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot vote on %s proposals", proposal.ProposalType)
+	}
+
+	if len(a.ProposalMessageTypes) > 0 {
+		for _, m := range proposal.Messages {
+			if err := authzEnv.GasService.GasMeter(ctx).Consume(authz.GasCostPerIteration, "vote authorization"); err != nil {
+				return authz.AcceptResponse{}, err
+			}
+
+			if !slices.Contains(a.ProposalMessageTypes, m.TypeUrl) {
+				return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot vote on proposals containing %s", m.TypeUrl)
+			}
+		}
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a VoteAuthorization) ValidateBasic() error {
+	for _, option := range a.Options {
+		if !ValidVoteOption(option) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid vote option %s", option)
+		}
+	}
+
+	for _, proposalType := range a.ProposalTypes {
+		if proposalType == ProposalType_PROPOSAL_TYPE_UNSPECIFIED {
+			return sdkerrors.ErrInvalidRequest.Wrap("proposal type cannot be unspecified")
+		}
+		if _, ok := ProposalType_name[int32(proposalType)]; !ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid proposal type %d", proposalType)
+		}
+	}
+
+	for _, typeURL := range a.ProposalMessageTypes {
+		if typeURL == "" {
+			return errors.New("proposal message type cannot be empty")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	coregas "cosmossdk.io/core/gas"
+	"cosmossdk.io/core/router"
+	"cosmossdk.io/core/transaction"
+	banktypes "cosmossdk.io/x/bank/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
+)
+
+type mockGasService struct {
+	coregas.Service
+}
+
+func (m mockGasService) GasMeter(ctx context.Context) coregas.Meter {
+	return mockGasMeter{}
+}
+
+type mockGasMeter struct {
+	coregas.Meter
+}
+
+func (m mockGasMeter) Consume(amount coregas.Gas, descriptor string) error {
+	return nil
+}
+
+// mockQueryRouter answers proposal queries with a fixed proposal.
+type mockQueryRouter struct {
+	router.Service
+	proposal v1.Proposal
+}
+
+func (m mockQueryRouter) Invoke(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+	return &v1.QueryProposalResponse{Proposal: &m.proposal}, nil
+}
+
+func TestVoteAuthorization(t *testing.T) {
+	proposal, err := v1.NewProposal([]sdk.Msg{banktypes.NewMsgSend(addrStrs[0], addrStrs[1], coinsPos)}, 1, time.Now(), time.Now(), "", "title", "summary", addrStrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+
+	ctx := context.WithValue(context.Background(), corecontext.EnvironmentContextKey, appmodulev2.Environment{
+		GasService:         mockGasService{},
+		QueryRouterService: mockQueryRouter{proposal: proposal},
+	})
+
+	vote := v1.NewMsgVote(addrStrs[1], 1, v1.OptionYes, "")
+
+	testCases := []struct {
+		name   string
+		authz  *v1.VoteAuthorization
+		errMsg string
+	}{
+		{
+			name:  "unrestricted",
+			authz: v1.NewVoteAuthorization(nil, nil, nil),
+		},
+		{
+			name:  "allowed option",
+			authz: v1.NewVoteAuthorization([]v1.VoteOption{v1.OptionYes, v1.OptionAbstain}, nil, nil),
+		},
+		{
+			name:   "disallowed option",
+			authz:  v1.NewVoteAuthorization([]v1.VoteOption{v1.OptionNo}, nil, nil),
+			errMsg: "cannot vote VOTE_OPTION_YES",
+		},
+		{
+			name:  "allowed proposal type",
+			authz: v1.NewVoteAuthorization(nil, []v1.ProposalType{v1.ProposalType_PROPOSAL_TYPE_STANDARD}, nil),
+		},
+		{
+			name:   "disallowed proposal type",
+			authz:  v1.NewVoteAuthorization(nil, []v1.ProposalType{v1.ProposalType_PROPOSAL_TYPE_EXPEDITED}, nil),
+			errMsg: "cannot vote on PROPOSAL_TYPE_STANDARD proposals",
+		},
+		{
+			name:  "allowed proposal message type",
+			authz: v1.NewVoteAuthorization(nil, nil, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}),
+		},
+		{
+			name:   "disallowed proposal message type",
+			authz:  v1.NewVoteAuthorization(nil, nil, []string{sdk.MsgTypeURL(&v1.MsgUpdateParams{})}),
+			errMsg: "cannot vote on proposals containing /cosmos.bank.v1beta1.MsgSend",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.authz.ValidateBasic())
+			require.Equal(t, sdk.MsgTypeURL(&v1.MsgVote{}), tc.authz.MsgTypeURL())
+
+			resp, err := tc.authz.Accept(ctx, vote)
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.False(t, resp.Delete)
+			require.Nil(t, resp.Updated)
+		})
+	}
+}
+
+func TestVoteAuthorizationValidateBasic(t *testing.T) {
+	require.Error(t, v1.NewVoteAuthorization([]v1.VoteOption{v1.VoteOption(42)}, nil, nil).ValidateBasic())
+	require.Error(t, v1.NewVoteAuthorization(nil, []v1.ProposalType{v1.ProposalType_PROPOSAL_TYPE_UNSPECIFIED}, nil).ValidateBasic())
+	require.Error(t, v1.NewVoteAuthorization(nil, nil, []string{""}).ValidateBasic())
+}
+
+func TestVoteAuthorizationRegistered(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	v1.RegisterInterfaces(registry)
+
+	anyAuthz, err := codectypes.NewAnyWithValue(v1.NewVoteAuthorization([]v1.VoteOption{v1.OptionYes}, nil, nil))
+	require.NoError(t, err)
+
+	var authorization authz.Authorization
+	require.NoError(t, registry.UnpackAny(anyAuthz, &authorization))
+	require.Equal(t, sdk.MsgTypeURL(&v1.MsgVote{}), authorization.MsgTypeURL())
+}
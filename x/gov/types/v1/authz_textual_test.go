@@ -0,0 +1,67 @@
+package v1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	authzv1beta1 "cosmossdk.io/api/cosmos/authz/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	govv1 "cosmossdk.io/api/cosmos/gov/v1"
+	"cosmossdk.io/x/tx/signing/textual"
+)
+
+func TestVoteAuthorizationTextual(t *testing.T) {
+	const (
+		granter = "cosmos1ta047h6lveex7mfqta047h6ln9jal0"
+		grantee = "cosmos1ta047h6lta0hgm6lta047h6lta0stgm2m3"
+	)
+
+	handler, err := textual.NewSignModeHandler(textual.SignModeOptions{
+		CoinMetadataQuerier: func(context.Context, string) (*bankv1beta1.Metadata, error) { return nil, nil },
+	})
+	require.NoError(t, err)
+
+	authorization, err := anyutil.New(&govv1.VoteAuthorization{
+		Options:              []govv1.VoteOption{govv1.VoteOption_VOTE_OPTION_YES, govv1.VoteOption_VOTE_OPTION_ABSTAIN},
+		ProposalTypes:        []govv1.ProposalType{govv1.ProposalType_PROPOSAL_TYPE_STANDARD},
+		ProposalMessageTypes: []string{"/cosmos.bank.v1beta1.MsgSend"},
+	})
+	require.NoError(t, err)
+	msg := &authzv1beta1.MsgGrant{
+		Granter: granter,
+		Grantee: grantee,
+		Grant:   &authzv1beta1.Grant{Authorization: authorization},
+	}
+
+	renderer, err := handler.GetMessageValueRenderer(msg.ProtoReflect().Descriptor())
+	require.NoError(t, err)
+	screens, err := renderer.Format(context.Background(), protoreflect.ValueOfMessage(msg.ProtoReflect()))
+	require.NoError(t, err)
+	require.Equal(t, []textual.Screen{
+		{Content: "MsgGrant object"},
+		{Title: "Granter", Content: granter, Indent: 1},
+		{Title: "Grantee", Content: grantee, Indent: 1},
+		{Title: "Grant", Content: "Grant object", Indent: 1},
+		{Title: "Authorization", Content: "/cosmos.gov.v1.VoteAuthorization", Indent: 2},
+		{Title: "Options", Content: "2 VoteOption", Indent: 3},
+		{Title: "Options (1/2)", Content: "VOTE_OPTION_YES", Indent: 4},
+		{Title: "Options (2/2)", Content: "VOTE_OPTION_ABSTAIN", Indent: 4},
+		{Content: "End of Options", Indent: 3},
+		{Title: "Proposal types", Content: "1 ProposalType", Indent: 3},
+		{Title: "Proposal types (1/1)", Content: "PROPOSAL_TYPE_STANDARD", Indent: 4},
+		{Content: "End of Proposal types", Indent: 3},
+		{Title: "Proposal message types", Content: "1 String", Indent: 3},
+		{Title: "Proposal message types (1/1)", Content: "/cosmos.bank.v1beta1.MsgSend", Indent: 4},
+		{Content: "End of Proposal message types", Indent: 3},
+	}, screens)
+
+	parsed, err := renderer.Parse(context.Background(), screens)
+	require.NoError(t, err)
+	require.Empty(t, cmp.Diff(msg, parsed.Message().Interface(), protocmp.Transform()))
+}
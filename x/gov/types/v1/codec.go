@@ -5,6 +5,7 @@ import (
 	coretransaction "cosmossdk.io/core/transaction"
 
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/types/authz"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
@@ -20,6 +21,7 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateMessageParams{}, "x/gov/v1/MsgUpdateMessageParams")
 	legacy.RegisterAminoMsg(registrar, &MsgSudoExec{}, "cosmos-sdk/x/gov/v1/MsgSudoExec")
+	registrar.RegisterConcrete(&VoteAuthorization{}, "cosmos-sdk/v1/VoteAuthorization")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -36,5 +38,9 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgSudoExec{},
 	)
 
+	registrar.RegisterImplementations((*authz.Authorization)(nil),
+		&VoteAuthorization{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
@@ -132,6 +132,29 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod:      "TokenizeShareRecord",
+					Use:            "tokenize-share-record <id>",
+					Short:          "Query a tokenize share record by its id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "TokenizeShareRecordsByOwner",
+					Use:            "tokenize-share-records-by-owner <owner>",
+					Short:          "Query all tokenize share records owned by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+				{
+					RpcMethod: "TotalLiquidStaked",
+					Use:       "total-liquid-staked",
+					Short:     "Query the total amount of tokens held by tokenize share records",
+				},
+				{
+					RpcMethod:      "ValidatorLiquidShares",
+					Use:            "validator-liquid-shares <validator-addr>",
+					Short:          "Query the delegation shares of a validator held by tokenize share records",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_addr"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -175,6 +198,21 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`%s tx staking rotate-cons-pubkey myvalidator {"@type":"/cosmos.crypto.ed25519.PubKey","key":"oWg2ISpLF405Jcm2vXV+2v4fnjodh6aafuIdeoW+rUw="}`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "new_pubkey"}},
 				},
+				{
+					RpcMethod:      "TokenizeShares",
+					Use:            "tokenize-shares <validator-addr> <amount> <share-owner> --from <delegator_address>",
+					Short:          "Tokenize delegation shares into transferable share tokens",
+					Long:           "Move an amount of a delegation into a tokenize share record and receive share tokens representing it. The share owner controls the record.",
+					Example:        fmt.Sprintf(`%s tx staking tokenize-shares cosmosvaloper... 100stake cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}, {ProtoField: "tokenized_share_owner"}},
+				},
+				{
+					RpcMethod:      "RedeemTokensForShares",
+					Use:            "redeem-tokens <amount> --from <delegator_address>",
+					Short:          "Redeem share tokens for the delegation shares they represent",
+					Example:        fmt.Sprintf(`%s tx staking redeem-tokens 100cosmosvaloper.../1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal <params>",
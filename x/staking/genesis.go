@@ -54,9 +54,32 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateTokenizeShareRecords(data *types.GenesisState) error {
+	ids := make(map[uint64]bool, len(data.TokenizeShareRecords))
+	for _, record := range data.TokenizeShareRecords {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record id in genesis state: %d", record.Id)
+		}
+		if record.Id == 0 || record.Id > data.LastTokenizeShareRecordId {
+			return fmt.Errorf("invalid tokenize share record id %d, last id is %d", record.Id, data.LastTokenizeShareRecordId)
+		}
+		ids[record.Id] = true
+	}
+
+	if !data.TotalLiquidStakedTokens.IsNil() && data.TotalLiquidStakedTokens.IsNegative() {
+		return fmt.Errorf("total liquid staked tokens cannot be negative: %s", data.TotalLiquidStakedTokens)
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -41,6 +41,22 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate tokenize share records
+		{"tokenize share records", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{{Id: 1}, {Id: 2}}
+			data.LastTokenizeShareRecordId = 2
+		}, false},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{{Id: 1}, {Id: 1}}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"tokenize share record id after last id", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{{Id: 2}}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"negative total liquid staked tokens", func(data *types.GenesisState) {
+			data.TotalLiquidStakedTokens = math.NewInt(-1)
+		}, true},
 	}
 
 	for _, tt := range tests {
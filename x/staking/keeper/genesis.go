@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -195,6 +196,42 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.setTokenizeShareRecord(ctx, record, owner); err != nil {
+			return nil, err
+		}
+
+		// the liquid shares of the validators are the shares of the record delegations
+		valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+		delegation, err := k.Delegations.Get(ctx, collections.Join(types.TokenizeShareRecordAddress(record.Id), sdk.ValAddress(valAddr)))
+		if err != nil {
+			return nil, fmt.Errorf("delegation of tokenize share record %d not found: %w", record.Id, err)
+		}
+		if err := k.addLiquidShares(ctx, valAddr, delegation.Shares); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.LastTokenizeShareRecordID.Set(ctx, data.LastTokenizeShareRecordId); err != nil {
+		return nil, err
+	}
+
+	totalLiquidStakedTokens := data.TotalLiquidStakedTokens
+	if totalLiquidStakedTokens.IsNil() {
+		totalLiquidStakedTokens = math.ZeroInt()
+	}
+	if err := k.TotalLiquidStakedTokens.Set(ctx, totalLiquidStakedTokens); err != nil {
+		return nil, err
+	}
+
 	// don't need to run CometBFT updates if we exported
 	var moduleValidatorUpdates []appmodule.ValidatorUpdate
 	if data.Exported {
@@ -331,6 +368,25 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	var tokenizeShareRecords []types.TokenizeShareRecord
+	err = k.TokenizeShareRecords.Walk(ctx, nil, func(_ uint64, record types.TokenizeShareRecord) (stop bool, err error) {
+		tokenizeShareRecords = append(tokenizeShareRecords, record)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lastTokenizeShareRecordID, err := k.LastTokenizeShareRecordID.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	totalLiquidStakedTokens, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		Params:               params,
 		LastTotalPower:       totalPower,
@@ -343,5 +399,9 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		RotationIndexRecords: rotationIndex,
 		RotationHistory:      conspubKeyRotationHistory,
 		RotationQueue:        rotationQueue,
+
+		TokenizeShareRecords:      tokenizeShareRecords,
+		LastTokenizeShareRecordId: lastTokenizeShareRecordID,
+		TotalLiquidStakedTokens:   totalLiquidStakedTokens,
 	}, nil
 }
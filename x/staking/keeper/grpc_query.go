@@ -511,6 +511,79 @@ func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*type
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// TokenizeShareRecord queries a tokenize share record by id
+func (k Querier) TokenizeShareRecord(ctx context.Context, req *types.QueryTokenizeShareRecordRequest) (*types.QueryTokenizeShareRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	record, err := k.GetTokenizeShareRecord(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrTokenizeShareRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "tokenize share record %d not found", req.Id)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordsByOwner queries the tokenize share records of an owner
+func (k Querier) TokenizeShareRecordsByOwner(ctx context.Context, req *types.QueryTokenizeShareRecordsByOwnerRequest) (*types.QueryTokenizeShareRecordsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+	}
+
+	records, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.TokenizeShareRecordByOwnerIndex,
+		req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.TokenizeShareRecord, error) {
+			return k.GetTokenizeShareRecord(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](owner),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordsByOwnerResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// TotalLiquidStaked queries the amount of tokens tokenized into share denoms
+func (k Querier) TotalLiquidStaked(ctx context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	tokens, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: tokens}, nil
+}
+
+// ValidatorLiquidShares queries the delegation shares of a validator tokenized into share denoms
+func (k Querier) ValidatorLiquidShares(ctx context.Context, req *types.QueryValidatorLiquidSharesRequest) (*types.QueryValidatorLiquidSharesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+	}
+
+	shares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorLiquidSharesResponse{LiquidShares: shares}, nil
+}
+
 func queryRedelegation(ctx context.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
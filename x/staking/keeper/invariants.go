@@ -21,6 +21,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "liquid-staking",
+		LiquidStakingInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -41,7 +43,12 @@ func AllInvariants(k *Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return LiquidStakingInvariant(k)(ctx)
 	}
 }
 
@@ -224,3 +231,80 @@ func DelegatorSharesInvariant(k *Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// LiquidStakingInvariant checks that the share tokens of every tokenize share
+// record are backed by its delegation and that the liquid shares tracked for
+// each validator equal the shares delegated by its records.
+func LiquidStakingInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		liquidShares := map[string]math.LegacyDec{}
+		err := k.TokenizeShareRecords.Walk(ctx, nil, func(id uint64, record types.TokenizeShareRecord) (stop bool, err error) {
+			valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+			if err != nil {
+				return true, err
+			}
+
+			delegation, err := k.Delegations.Get(ctx, collections.Join(types.TokenizeShareRecordAddress(id), sdk.ValAddress(valAddr)))
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tno delegation for tokenize share record %d\n", id)
+				return false, nil
+			}
+
+			supply := k.bankKeeper.GetSupply(ctx, record.ShareDenom())
+			if !supply.Amount.Equal(delegation.Shares.TruncateInt()) {
+				broken = true
+				msg += fmt.Sprintf("\tshare token supply of record %d is %v, delegation shares are %v\n", id, supply.Amount, delegation.Shares)
+			}
+
+			shares, ok := liquidShares[record.Validator]
+			if !ok {
+				shares = math.LegacyZeroDec()
+			}
+			liquidShares[record.Validator] = shares.Add(delegation.Shares)
+			return false, nil
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		err = k.LiquidSharesByValidator.Walk(ctx, nil, func(valAddr sdk.ValAddress, shares math.LegacyDec) (stop bool, err error) {
+			operator, err := k.validatorAddressCodec.BytesToString(valAddr)
+			if err != nil {
+				return true, err
+			}
+
+			expected, ok := liquidShares[operator]
+			if !ok || !expected.Equal(shares) {
+				broken = true
+				msg += fmt.Sprintf("\tvalidator %s has %v liquid shares, records delegate %v\n", operator, shares, expected)
+			}
+			delete(liquidShares, operator)
+			return false, nil
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		for operator, shares := range liquidShares {
+			broken = true
+			msg += fmt.Sprintf("\tvalidator %s has no liquid shares, records delegate %v\n", operator, shares)
+		}
+
+		total, err := k.GetTotalLiquidStakedTokens(ctx)
+		if err != nil {
+			panic(err)
+		}
+		if total.IsNegative() {
+			broken = true
+			msg += fmt.Sprintf("\tnegative total liquid staked tokens: %v\n", total)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "liquid staking", msg), broken
+	}
+}
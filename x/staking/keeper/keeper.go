@@ -107,6 +107,16 @@ type Keeper struct {
 	// ValidatorConsPubKeyRotationHistory: consPubkey rotation history by validator
 	// A index is being added with key `BlockConsPubKeyRotationHistory`: consPubkey rotation history by height
 	RotationHistory *collections.IndexedMap[collections.Pair[[]byte, uint64], types.ConsPubKeyRotationHistory, rotationHistoryIndexes]
+	// TokenizeShareRecords key: recordID | value: TokenizeShareRecord
+	TokenizeShareRecords collections.Map[uint64, types.TokenizeShareRecord]
+	// LastTokenizeShareRecordID value: id of the last created tokenize share record
+	LastTokenizeShareRecordID collections.Item[uint64]
+	// TokenizeShareRecordByOwnerIndex key: owner+recordID | value: none used (index key for tokenize share records by owner)
+	TokenizeShareRecordByOwnerIndex collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// LiquidSharesByValidator key: valAddr | value: delegation shares of the validator held by tokenize share records
+	LiquidSharesByValidator collections.Map[sdk.ValAddress, math.LegacyDec]
+	// TotalLiquidStakedTokens value: amount of tokens tokenized into share denoms, net of slashing
+	TotalLiquidStakedTokens collections.Item[math.Int]
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -321,6 +331,36 @@ func NewKeeper(
 			codec.CollValue[types.ConsPubKeyRotationHistory](cdc),
 			NewRotationHistoryIndexes(sb),
 		),
+
+		// key format is: 121 | recordID
+		TokenizeShareRecords: collections.NewMap(
+			sb, types.TokenizeShareRecordKey,
+			"tokenize_share_records",
+			collections.Uint64Key,
+			codec.CollValue[types.TokenizeShareRecord](cdc),
+		),
+		// key is: 122
+		LastTokenizeShareRecordID: collections.NewItem(sb, types.LastTokenizeShareRecordIDKey, "last_tokenize_share_record_id", collections.Uint64Value),
+		// key format is: 123 | owner | recordID
+		TokenizeShareRecordByOwnerIndex: collections.NewKeySet(
+			sb, types.TokenizeShareRecordByOwnerKey,
+			"tokenize_share_records_by_owner",
+			collections.NamedPairKeyCodec(
+				"owner",
+				sdk.AccAddressKey,
+				"record_id",
+				collections.Uint64Key,
+			),
+		),
+		// key format is: 124 | valAddr
+		LiquidSharesByValidator: collections.NewMap(
+			sb, types.ValidatorLiquidSharesKey,
+			"validator_liquid_shares",
+			sdk.ValAddressKey,
+			sdk.LegacyDecValue,
+		),
+		// key is: 125
+		TotalLiquidStakedTokens: collections.NewItem(sb, types.TotalLiquidStakedTokensKey, "total_liquid_staked_tokens", sdk.IntValue),
 	}
 
 	schema, err := sb.Build()
@@ -0,0 +1,437 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TokenizeShares moves the delegation shares worth amount tokens from the
+// delegator to the module account of a new tokenize share record, and mints
+// share tokens of the record to the delegator. The share tokens can be
+// transferred, and redeemed against the delegation of the record with
+// RedeemTokensForShares.
+func (k Keeper) TokenizeShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int, owner sdk.AccAddress,
+) (sdk.Coin, uint64, error) {
+	if bytes.Equal(delAddr, valAddr) {
+		return sdk.Coin{}, 0, types.ErrValidatorSelfDelegationTokenizing
+	}
+
+	// shares being redelegated are still subject to slashing of the source
+	// validator, which would not be accounted to the record.
+	hasRedelegation, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	if hasRedelegation {
+		return sdk.Coin{}, 0, types.ErrRedelegationInProgress
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	if !shares.TruncateInt().IsPositive() {
+		return sdk.Coin{}, 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "too few shares to tokenize")
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, valAddr, shares, validator.TokensFromShares(shares).TruncateInt()); err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	recordID, err := k.LastTokenizeShareRecordID.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return sdk.Coin{}, 0, err
+	}
+	recordID++
+
+	recordAddr := types.TokenizeShareRecordAddress(recordID)
+	recordAddrStr, err := k.authKeeper.AddressCodec().BytesToString(recordAddr)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	ownerStr, err := k.authKeeper.AddressCodec().BytesToString(owner)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	record := types.TokenizeShareRecord{
+		Id:            recordID,
+		Owner:         ownerStr,
+		ModuleAccount: recordAddrStr,
+		Validator:     validator.GetOperator(),
+	}
+
+	// move the delegation to the record, the tokens stay in the same pool
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	// unbonding the last shares of an unbonded validator removes it
+	validator, err = k.GetValidator(ctx, valAddr)
+	switch {
+	case errors.Is(err, types.ErrNoValidatorFound):
+		return sdk.Coin{}, 0, errorsmod.Wrapf(types.ErrNoValidatorFound, "validator %s was removed with its last shares, they cannot be tokenized", record.Validator)
+	case err != nil:
+		return sdk.Coin{}, 0, err
+	}
+
+	newShares, err := k.Delegate(ctx, recordAddr, returnAmount, tokenSource(validator), validator, false)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	shareTokens := sdk.NewCoin(record.ShareDenom(), newShares.TruncateInt())
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	if err := k.LastTokenizeShareRecordID.Set(ctx, recordID); err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	if err := k.setTokenizeShareRecord(ctx, record, owner); err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	if err := k.addLiquidShares(ctx, valAddr, newShares); err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	if err := k.addTotalLiquidStakedTokens(ctx, returnAmount); err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	return shareTokens, recordID, nil
+}
+
+// RedeemTokensForShares burns the share tokens of the delegator and moves the
+// corresponding shares of the tokenize share record delegation back to the
+// delegator. The record is deleted once all its share tokens are redeemed.
+func (k Keeper) RedeemTokensForShares(ctx context.Context, delAddr sdk.AccAddress, shareTokens sdk.Coin) (sdk.Coin, error) {
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareTokens.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	recordAddr := types.TokenizeShareRecordAddress(record.Id)
+
+	delegation, err := k.Delegations.Get(ctx, collections.Join(recordAddr, sdk.ValAddress(valAddr)))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.Coin{}, types.ErrNoDelegatorForAddress
+		}
+		return sdk.Coin{}, err
+	}
+
+	// redeeming the whole supply also redeems the fractional shares left over
+	// when the share tokens were minted.
+	supply := k.bankKeeper.GetSupply(ctx, shareTokens.Denom).Amount
+	redeemAll := shareTokens.Amount.Equal(supply)
+	shares := math.LegacyNewDecFromInt(shareTokens.Amount)
+	if redeemAll {
+		shares = delegation.Shares
+	}
+	if shares.GT(delegation.Shares) {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrNotEnoughDelegationShares, delegation.Shares.String())
+	}
+
+	burn := sdk.NewCoins(shareTokens)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, burn); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, k.authKeeper.GetModuleAddress(types.ModuleName), burn); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, recordAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	switch {
+	case errors.Is(err, types.ErrNoValidatorFound):
+		// the validator was unbonded and removed with its last delegation,
+		// the tokens are returned to the delegator.
+		if returnAmount.IsPositive() {
+			bondDenom, err := k.BondDenom(ctx)
+			if err != nil {
+				return sdk.Coin{}, err
+			}
+			coins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+			if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, coins); err != nil {
+				return sdk.Coin{}, err
+			}
+		}
+	case err != nil:
+		return sdk.Coin{}, err
+	default:
+		if _, err := k.Delegate(ctx, delAddr, returnAmount, tokenSource(validator), validator, false); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if err := k.removeLiquidShares(ctx, valAddr, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.subTotalLiquidStakedTokens(ctx, returnAmount); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if redeemAll {
+		if err := k.withdrawTokenizeShareRecordBalance(ctx, record); err != nil {
+			return sdk.Coin{}, err
+		}
+		if err := k.deleteTokenizeShareRecord(ctx, record); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(bondDenom, returnAmount), nil
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with the given id.
+func (k Keeper) GetTokenizeShareRecord(ctx context.Context, id uint64) (types.TokenizeShareRecord, error) {
+	record, err := k.TokenizeShareRecords.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotFound, "id %d", id)
+	}
+	return record, err
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record the share denom was minted for.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx context.Context, denom string) (types.TokenizeShareRecord, error) {
+	validator, id, err := types.ParseShareDenom(denom)
+	if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+	if record.Validator != validator {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrInvalidShareDenom, "%s does not match record %d", denom, id)
+	}
+
+	return record, nil
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records of the owner.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx context.Context, owner sdk.AccAddress) ([]types.TokenizeShareRecord, error) {
+	iter, err := k.TokenizeShareRecordByOwnerIndex.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](owner))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var records []types.TokenizeShareRecord
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		record, err := k.GetTokenizeShareRecord(ctx, key.K2())
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens tokenized into share denoms, net of slashing.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	total, err := k.TotalLiquidStakedTokens.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return total, err
+}
+
+// GetValidatorLiquidShares returns the delegation shares of the validator held by tokenize share records.
+func (k Keeper) GetValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	shares, err := k.LiquidSharesByValidator.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyZeroDec(), nil
+	}
+	return shares, err
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing the shares of the
+// validator would exceed the global liquid staking cap or the validator bond factor.
+func (k Keeper) checkLiquidStakingCaps(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec, tokens math.Int) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.GlobalLiquidStakingCap.LT(math.LegacyOneDec()) {
+		totalBonded, err := k.TotalBondedTokens(ctx)
+		if err != nil {
+			return err
+		}
+		totalLiquid, err := k.GetTotalLiquidStakedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		if totalBonded.IsZero() {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+		liquidRatio := math.LegacyNewDecFromInt(totalLiquid.Add(tokens)).QuoInt(totalBonded)
+		if liquidRatio.GT(params.GlobalLiquidStakingCap) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	if !params.ValidatorBondFactor.IsNegative() {
+		bondShares := math.LegacyZeroDec()
+		selfDelegation, err := k.Delegations.Get(ctx, collections.Join(sdk.AccAddress(valAddr), valAddr))
+		if err == nil {
+			bondShares = selfDelegation.Shares
+		} else if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		if liquidShares.Add(shares).GT(bondShares.Mul(params.ValidatorBondFactor)) {
+			return types.ErrInsufficientValidatorBondShares
+		}
+	}
+
+	return nil
+}
+
+// slashLiquidStakedTokens decreases the total liquid staked tokens by the
+// share of the slashed tokens of the validator held by tokenize share records.
+func (k Keeper) slashLiquidStakedTokens(ctx context.Context, validator types.Validator, slashedTokens math.Int) error {
+	if validator.DelegatorShares.IsZero() {
+		return nil
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil || liquidShares.IsZero() {
+		return err
+	}
+
+	slashedLiquidTokens := math.LegacyNewDecFromInt(slashedTokens).Mul(liquidShares).Quo(validator.DelegatorShares).TruncateInt()
+	return k.subTotalLiquidStakedTokens(ctx, slashedLiquidTokens)
+}
+
+func (k Keeper) setTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord, owner sdk.AccAddress) error {
+	if err := k.TokenizeShareRecords.Set(ctx, record.Id, record); err != nil {
+		return err
+	}
+	return k.TokenizeShareRecordByOwnerIndex.Set(ctx, collections.Join(owner, record.Id))
+}
+
+// withdrawTokenizeShareRecordBalance sends the balance of the record account,
+// the rewards of the record delegation withdrawn by the distribution hooks, to
+// the record owner. No key controls the record account, the balance would be
+// lost once the record is deleted.
+func (k Keeper) withdrawTokenizeShareRecordBalance(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	balance := k.bankKeeper.GetAllBalances(ctx, types.TokenizeShareRecordAddress(record.Id))
+	if balance.IsZero() {
+		return nil
+	}
+	return k.bankKeeper.SendCoins(ctx, types.TokenizeShareRecordAddress(record.Id), owner, balance)
+}
+
+func (k Keeper) deleteTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+	if err := k.TokenizeShareRecordByOwnerIndex.Remove(ctx, collections.Join(sdk.AccAddress(owner), record.Id)); err != nil {
+		return err
+	}
+	return k.TokenizeShareRecords.Remove(ctx, record.Id)
+}
+
+func (k Keeper) addLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	return k.LiquidSharesByValidator.Set(ctx, valAddr, liquidShares.Add(shares))
+}
+
+func (k Keeper) removeLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	liquidShares = liquidShares.Sub(shares)
+	if !liquidShares.IsPositive() {
+		return k.LiquidSharesByValidator.Remove(ctx, valAddr)
+	}
+	return k.LiquidSharesByValidator.Set(ctx, valAddr, liquidShares)
+}
+
+func (k Keeper) addTotalLiquidStakedTokens(ctx context.Context, tokens math.Int) error {
+	total, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+	return k.TotalLiquidStakedTokens.Set(ctx, total.Add(tokens))
+}
+
+// subTotalLiquidStakedTokens decreases the total liquid staked tokens, which
+// cannot go below zero because of rounding when slashing.
+func (k Keeper) subTotalLiquidStakedTokens(ctx context.Context, tokens math.Int) error {
+	total, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+	return k.TotalLiquidStakedTokens.Set(ctx, math.MaxInt(total.Sub(tokens), math.ZeroInt()))
+}
+
+// tokenSource returns the bond status of the pool holding the tokens of the validator.
+func tokenSource(validator types.Validator) types.BondStatus {
+	if validator.IsBonded() {
+		return types.Bonded
+	}
+	return types.Unbonded
+}
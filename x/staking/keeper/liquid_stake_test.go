@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (s *KeeperTestSuite) TestTokenizeShares() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(2)
+	operator, delegator, owner := addrs[0], addrs[1], sdk.AccAddress("owner_______________")
+	delTokens := keeper.TokensFromConsensusPower(ctx, 10)
+
+	// create a bonded validator with a self-delegation and a delegation of 10 tokens each
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(delTokens.MulRaw(2))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	require.True(validator.IsBonded())
+	delShares := issuedShares.QuoInt64(2)
+	require.NoError(keeper.SetDelegation(ctx, stakingtypes.NewDelegation(s.addressToString(operator), s.valAddressToString(valAddrs[0]), delShares)))
+	require.NoError(keeper.SetDelegation(ctx, stakingtypes.NewDelegation(s.addressToString(delegator), s.valAddressToString(valAddrs[0]), delShares)))
+
+	// the operator cannot tokenize its self-delegation
+	_, _, err := keeper.TokenizeShares(ctx, operator, valAddrs[0], delTokens, owner)
+	require.ErrorIs(err, stakingtypes.ErrValidatorSelfDelegationTokenizing)
+
+	// the liquid shares of the validator are capped by its self-delegation
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.ValidatorBondFactor = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(keeper.Params.Set(ctx, params))
+	_, _, err = keeper.TokenizeShares(ctx, delegator, valAddrs[0], delTokens.QuoRaw(2).AddRaw(1), owner)
+	require.ErrorIs(err, stakingtypes.ErrInsufficientValidatorBondShares)
+
+	// the liquid staked tokens are capped by the bonded tokens
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(keeper.Params.Set(ctx, params))
+	bondedAcc := authtypes.NewEmptyModuleAccount(stakingtypes.BondedPoolName)
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), stakingtypes.BondedPoolName).Return(bondedAcc).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).Return(sdk.NewCoin(sdk.DefaultBondDenom, delTokens.MulRaw(2))).AnyTimes()
+	_, _, err = keeper.TokenizeShares(ctx, delegator, valAddrs[0], delTokens.QuoRaw(4), owner)
+	require.ErrorIs(err, stakingtypes.ErrGlobalLiquidStakingCapExceeded)
+
+	// tokenize 4 tokens within both caps
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+	require.NoError(keeper.Params.Set(ctx, params))
+	amount := delTokens.MulRaw(2).QuoRaw(5)
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), stakingtypes.ModuleName, gomock.Any())
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), stakingtypes.ModuleName, delegator, gomock.Any())
+	shareTokens, recordID, err := keeper.TokenizeShares(ctx, delegator, valAddrs[0], amount, owner)
+	require.NoError(err)
+	require.Equal(uint64(1), recordID)
+	require.Equal(s.valAddressToString(valAddrs[0])+"/1", shareTokens.Denom)
+	require.Equal(amount, shareTokens.Amount)
+
+	record, err := keeper.GetTokenizeShareRecordByDenom(ctx, shareTokens.Denom)
+	require.NoError(err)
+	require.Equal(s.addressToString(owner), record.Owner)
+	require.Equal(s.addressToString(stakingtypes.TokenizeShareRecordAddress(1)), record.ModuleAccount)
+
+	recordDelegation, err := keeper.Delegations.Get(ctx, collections.Join(stakingtypes.TokenizeShareRecordAddress(1), valAddrs[0]))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(amount), recordDelegation.Shares)
+	delegation, err := keeper.Delegations.Get(ctx, collections.Join(delegator, valAddrs[0]))
+	require.NoError(err)
+	require.Equal(delShares.Sub(math.LegacyNewDecFromInt(amount)), delegation.Shares)
+
+	liquidShares, err := keeper.GetValidatorLiquidShares(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(amount), liquidShares)
+	total, err := keeper.GetTotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.Equal(amount, total)
+
+	// the validator tokens are unchanged
+	validator, err = keeper.GetValidator(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(delTokens.MulRaw(2), validator.Tokens)
+
+	// the record and the totals are queryable
+	recordRes, err := s.queryClient.TokenizeShareRecord(ctx, &stakingtypes.QueryTokenizeShareRecordRequest{Id: recordID})
+	require.NoError(err)
+	require.Equal(record, recordRes.Record)
+	byOwnerRes, err := s.queryClient.TokenizeShareRecordsByOwner(ctx, &stakingtypes.QueryTokenizeShareRecordsByOwnerRequest{Owner: s.addressToString(owner)})
+	require.NoError(err)
+	require.Equal([]stakingtypes.TokenizeShareRecord{record}, byOwnerRes.Records)
+	ownerRecords, err := keeper.GetTokenizeShareRecordsByOwner(ctx, owner)
+	require.NoError(err)
+	require.Equal([]stakingtypes.TokenizeShareRecord{record}, ownerRecords)
+	_, err = s.queryClient.TokenizeShareRecord(ctx, &stakingtypes.QueryTokenizeShareRecordRequest{Id: 2})
+	require.Error(err)
+
+	// redeem half of the share tokens
+	redeem := sdk.NewCoin(shareTokens.Denom, amount.QuoRaw(2))
+	moduleAddr := authtypes.NewModuleAddress(stakingtypes.ModuleName)
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), shareTokens.Denom).Return(shareTokens)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), delegator, stakingtypes.ModuleName, sdk.NewCoins(redeem))
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.ModuleName).Return(moduleAddr).AnyTimes()
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), moduleAddr, sdk.NewCoins(redeem))
+	returned, err := keeper.RedeemTokensForShares(ctx, delegator, redeem)
+	require.NoError(err)
+	require.Equal(sdk.NewCoin(sdk.DefaultBondDenom, redeem.Amount), returned)
+
+	liquidShares, err = keeper.GetValidatorLiquidShares(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(amount.Sub(redeem.Amount)), liquidShares)
+	_, err = keeper.GetTokenizeShareRecord(ctx, recordID)
+	require.NoError(err)
+
+	// redeeming the rest of the supply deletes the record, the rewards of the
+	// record delegation withdrawn to the record account go to the owner
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 7))
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), shareTokens.Denom).Return(shareTokens.Sub(redeem))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), delegator, stakingtypes.ModuleName, sdk.NewCoins(redeem))
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), moduleAddr, sdk.NewCoins(redeem))
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), stakingtypes.TokenizeShareRecordAddress(recordID)).Return(rewards)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), stakingtypes.TokenizeShareRecordAddress(recordID), owner, rewards)
+	_, err = keeper.RedeemTokensForShares(ctx, delegator, redeem)
+	require.NoError(err)
+
+	_, err = keeper.GetTokenizeShareRecord(ctx, recordID)
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotFound)
+	has, err := keeper.LiquidSharesByValidator.Has(ctx, valAddrs[0])
+	require.NoError(err)
+	require.False(has)
+	total, err = keeper.GetTotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.True(total.IsZero())
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(delegator, valAddrs[0]))
+	require.NoError(err)
+	require.Equal(delShares, delegation.Shares)
+}
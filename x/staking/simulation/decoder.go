@@ -83,6 +83,13 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &historyB)
 
 			return fmt.Sprintf("%v\n%v", historyA, historyB)
+		case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordKey):
+			var recordA, recordB types.TokenizeShareRecord
+
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
 		default:
 			panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 		}
@@ -50,4 +50,12 @@ var (
 	// consensus key errors
 	ErrExceedingMaxConsPubKeyRotations = errors.Register(ModuleName, 46, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid       = errors.Register(ModuleName, 47, "consensus pubkey len is invalid")
+
+	// liquid staking errors
+	ErrTokenizeShareRecordNotFound       = errors.Register(ModuleName, 48, "tokenize share record not found")
+	ErrInvalidShareDenom                 = errors.Register(ModuleName, 49, "invalid tokenized share denom")
+	ErrGlobalLiquidStakingCapExceeded    = errors.Register(ModuleName, 50, "delegation or tokenization exceeds the global liquid staking cap")
+	ErrInsufficientValidatorBondShares   = errors.Register(ModuleName, 51, "insufficient validator bond shares")
+	ErrRedelegationInProgress            = errors.Register(ModuleName, 52, "delegator is not allowed to tokenize shares from validator with a redelegation in progress")
+	ErrValidatorSelfDelegationTokenizing = errors.Register(ModuleName, 53, "validator operator cannot tokenize its self-delegation")
 )
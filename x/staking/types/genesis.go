@@ -5,6 +5,8 @@ import (
 
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
@@ -20,7 +22,8 @@ func NewGenesisState(params Params, validators []Validator, delegations []Delega
 // DefaultGenesisState gets the raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:                  DefaultParams(),
+		TotalLiquidStakedTokens: math.ZeroInt(),
 	}
 }
 
@@ -53,6 +53,13 @@ type GenesisState struct {
 	RotationIndexRecords []RotationIndexRecord       `protobuf:"bytes,9,rep,name=rotation_index_records,json=rotationIndexRecords,proto3" json:"rotation_index_records"`
 	RotationHistory      []ConsPubKeyRotationHistory `protobuf:"bytes,10,rep,name=rotation_history,json=rotationHistory,proto3" json:"rotation_history"`
 	RotationQueue        []RotationQueueRecord       `protobuf:"bytes,11,rep,name=rotation_queue,json=rotationQueue,proto3" json:"rotation_queue"`
+	// tokenize_share_records defines the tokenized delegations at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,12,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last created tokenize share record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,13,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// total_liquid_staked_tokens tracks the amount of tokens tokenized into share
+	// denoms, net of slashing.
+	TotalLiquidStakedTokens cosmossdk_io_math.Int `protobuf:"bytes,14,opt,name=total_liquid_staked_tokens,json=totalLiquidStakedTokens,proto3,customtype=cosmossdk.io/math.Int" json:"total_liquid_staked_tokens"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -158,6 +165,20 @@ func (m *GenesisState) GetRotationQueue() []RotationQueueRecord {
 	return nil
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -301,52 +322,57 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xc7, 0xbb, 0xfc, 0x29, 0xed, 0x14, 0xf8, 0xf1, 0x1b, 0x0a, 0x59, 0x1b, 0xd3, 0xd6, 0x86,
-	0x43, 0x83, 0x61, 0xd7, 0xa2, 0xf1, 0xe0, 0x8d, 0x6a, 0xa2, 0x04, 0x22, 0xb8, 0x80, 0x07, 0x12,
-	0xb3, 0x99, 0xb2, 0xc3, 0xb2, 0x61, 0xbb, 0x53, 0x67, 0xa6, 0x95, 0xbe, 0x03, 0x8f, 0xdc, 0xbd,
-	0x70, 0xf4, 0xe8, 0x81, 0x17, 0xc1, 0xc5, 0x84, 0x70, 0x32, 0x1e, 0xd0, 0xc0, 0x41, 0x5f, 0x86,
-	0xd9, 0x99, 0xdd, 0x65, 0x9a, 0xb2, 0x44, 0x2f, 0x4d, 0xa7, 0xcf, 0xf7, 0xfb, 0xf9, 0x3e, 0xcf,
-	0x76, 0xe7, 0x01, 0x0b, 0x7b, 0x84, 0xb5, 0x09, 0x33, 0x19, 0x47, 0x87, 0x5e, 0xe0, 0x9a, 0xbd,
-	0x46, 0x0b, 0x73, 0xd4, 0x30, 0x5d, 0x1c, 0x60, 0xe6, 0x31, 0xa3, 0x43, 0x09, 0x27, 0x70, 0x5e,
-	0xaa, 0x8c, 0x48, 0x65, 0x44, 0xaa, 0x52, 0xd1, 0x25, 0x2e, 0x11, 0x12, 0x33, 0xfc, 0x26, 0xd5,
-	0xa5, 0x34, 0x66, 0xec, 0x96, 0xaa, 0x7b, 0x52, 0x65, 0x4b, 0x7b, 0x14, 0x20, 0x4b, 0xff, 0xa3,
-	0xb6, 0x17, 0x10, 0x53, 0x7c, 0x46, 0x3f, 0x55, 0x5c, 0x42, 0x5c, 0x1f, 0x9b, 0xe2, 0xd4, 0xea,
-	0xee, 0x9b, 0xdc, 0x6b, 0x63, 0xc6, 0x51, 0xbb, 0x23, 0x05, 0xb5, 0xaf, 0x13, 0x60, 0xf2, 0xa5,
-	0x6c, 0x7a, 0x8b, 0x23, 0x8e, 0xe1, 0x0a, 0xc8, 0x76, 0x10, 0x45, 0x6d, 0xa6, 0x6b, 0x55, 0xad,
-	0x5e, 0x58, 0x2e, 0x1b, 0xb7, 0x0f, 0x61, 0x6c, 0x0a, 0x55, 0x33, 0x7f, 0x76, 0x59, 0xc9, 0x7c,
-	0xfe, 0xf5, 0x65, 0x51, 0xb3, 0x22, 0x23, 0xdc, 0x05, 0x33, 0x3e, 0x62, 0xdc, 0xe6, 0x84, 0x23,
-	0xdf, 0xee, 0x90, 0x0f, 0x98, 0xea, 0x23, 0x55, 0xad, 0x3e, 0xd9, 0x7c, 0x14, 0x8a, 0xbf, 0x5f,
-	0x56, 0xe6, 0x24, 0x93, 0x39, 0x87, 0x86, 0x47, 0xcc, 0x36, 0xe2, 0x07, 0xc6, 0x6a, 0xc0, 0x2f,
-	0x4e, 0x97, 0x40, 0x14, 0xb6, 0x1a, 0x70, 0xc9, 0x9c, 0x0e, 0x49, 0xdb, 0x21, 0x68, 0x33, 0xe4,
-	0x40, 0x0f, 0xcc, 0x09, 0x76, 0x0f, 0xf9, 0x9e, 0x83, 0x38, 0xa1, 0x92, 0xcf, 0xf4, 0xd1, 0xea,
-	0x68, 0xbd, 0xb0, 0xbc, 0x98, 0xd6, 0xed, 0x3a, 0x62, 0xfc, 0x6d, 0xec, 0x11, 0x28, 0xb5, 0xf3,
-	0x59, 0x7f, 0xa8, 0xcc, 0xe0, 0x3a, 0x00, 0x49, 0x0a, 0xd3, 0xc7, 0x04, 0xff, 0x41, 0x1a, 0x3f,
-	0x31, 0xab, 0x58, 0xc5, 0x0f, 0x37, 0x40, 0xc1, 0xc1, 0x3e, 0x76, 0x11, 0xf7, 0x48, 0xc0, 0xf4,
-	0x71, 0x81, 0xab, 0xa5, 0xe1, 0x5e, 0x24, 0x52, 0x95, 0xa7, 0x12, 0xe0, 0x21, 0x98, 0xeb, 0x06,
-	0x2d, 0x12, 0x38, 0x5e, 0xe0, 0xda, 0x2a, 0x3a, 0x2b, 0xd0, 0x0f, 0xd3, 0xd0, 0x3b, 0xb1, 0xe9,
-	0xf6, 0x8c, 0x62, 0x77, 0xb8, 0xce, 0xe0, 0x0e, 0x98, 0xa2, 0x58, 0x0d, 0x99, 0x10, 0x21, 0x0b,
-	0x69, 0x21, 0x96, 0x22, 0x56, 0xe9, 0x83, 0x14, 0x58, 0x02, 0x39, 0x7c, 0xd4, 0x21, 0x94, 0x63,
-	0x47, 0xcf, 0x55, 0xb5, 0x7a, 0xce, 0x4a, 0xce, 0xd0, 0x07, 0xf3, 0x94, 0x70, 0x21, 0xb4, 0xbd,
-	0xc0, 0xc1, 0x47, 0x36, 0xc5, 0x7b, 0x84, 0x3a, 0x4c, 0xcf, 0xdf, 0x3d, 0xa0, 0x15, 0xb9, 0x56,
-	0x43, 0x93, 0x25, 0x3c, 0x03, 0x03, 0xd2, 0xe1, 0x3a, 0x83, 0x2e, 0x98, 0x49, 0xd2, 0x0e, 0x3c,
-	0xc6, 0x09, 0xed, 0xeb, 0x40, 0xe4, 0x34, 0xd2, 0x72, 0x9e, 0x93, 0x80, 0x6d, 0x76, 0x5b, 0x6b,
-	0xb8, 0x1f, 0x27, 0xbe, 0x92, 0x46, 0x35, 0xed, 0x3f, 0x3a, 0x58, 0x83, 0xef, 0xc0, 0x74, 0x12,
-	0xf4, 0xbe, 0x8b, 0xbb, 0x58, 0x2f, 0xfc, 0xdd, 0x38, 0x6f, 0x42, 0xf1, 0xf0, 0x38, 0x53, 0x54,
-	0xad, 0xd7, 0x0e, 0x00, 0x1c, 0x7e, 0xd5, 0xe1, 0x32, 0x98, 0x40, 0x8e, 0x43, 0x31, 0x93, 0xb7,
-	0x3a, 0xdf, 0xd4, 0x2f, 0x4e, 0x97, 0x8a, 0x51, 0xe0, 0x8a, 0xac, 0x6c, 0x71, 0xea, 0x05, 0xae,
-	0x15, 0x0b, 0x61, 0x11, 0x8c, 0xdf, 0x5c, 0xdd, 0x51, 0x4b, 0x1e, 0x9e, 0xe5, 0x3e, 0x9e, 0x54,
-	0x32, 0xbf, 0x4f, 0x2a, 0x99, 0x1a, 0x01, 0xb3, 0xb7, 0x3c, 0x69, 0xa8, 0x0f, 0x46, 0x4d, 0xde,
-	0x00, 0x9f, 0x80, 0xb1, 0x70, 0xfb, 0xe8, 0x59, 0xb1, 0x57, 0x4a, 0x86, 0x5c, 0x4d, 0x46, 0xbc,
-	0x9a, 0x8c, 0xed, 0x78, 0x35, 0x35, 0xc7, 0x8e, 0x7f, 0x54, 0x34, 0x4b, 0xa8, 0x95, 0xc0, 0x4f,
-	0xda, 0x4d, 0xa2, 0xf2, 0x30, 0xe0, 0x6b, 0x90, 0xef, 0x21, 0xdf, 0x0e, 0x63, 0xe2, 0xa5, 0xd5,
-	0xb8, 0xe3, 0x9a, 0x86, 0xe3, 0xb2, 0x8d, 0x7d, 0x41, 0xc2, 0x4e, 0xf8, 0x27, 0xae, 0xe1, 0x3e,
-	0xb3, 0x72, 0xbd, 0xa8, 0x94, 0xf4, 0x39, 0xf2, 0x2f, 0x7d, 0x36, 0x9f, 0x9e, 0x5d, 0x95, 0xb5,
-	0xf3, 0xab, 0xb2, 0xf6, 0xf3, 0xaa, 0xac, 0x1d, 0x5f, 0x97, 0x33, 0xe7, 0xd7, 0xe5, 0xcc, 0xb7,
-	0xeb, 0x72, 0x66, 0xf7, 0xfe, 0xc0, 0xb2, 0x3b, 0x4a, 0xf6, 0x3b, 0xef, 0x77, 0x30, 0x6b, 0x65,
-	0x05, 0xf7, 0xf1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0x7b, 0xa0, 0x65, 0x52, 0x06, 0x00,
-	0x00,
+	// 787 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x41, 0x4f, 0x33, 0x45,
+	0x1c, 0xc6, 0xbb, 0x50, 0x4a, 0x3b, 0x2d, 0x88, 0x43, 0xc1, 0xa5, 0xd1, 0xb6, 0x36, 0x1c, 0x1a,
+	0x0c, 0xbb, 0x16, 0x8d, 0x07, 0x4f, 0x52, 0x4d, 0xb4, 0x81, 0x08, 0x6e, 0xc1, 0x03, 0x89, 0xd9,
+	0x4c, 0xd9, 0x61, 0x3b, 0xe9, 0x76, 0xa7, 0xcc, 0x4c, 0x2b, 0xf5, 0x13, 0x78, 0xe4, 0xee, 0x85,
+	0xa3, 0x47, 0x0f, 0x7c, 0x08, 0x8e, 0x84, 0x93, 0xf1, 0x80, 0x06, 0x0e, 0x7a, 0xf0, 0x43, 0x98,
+	0x9d, 0xd9, 0x5d, 0xb6, 0x69, 0x4b, 0x7c, 0xdf, 0x0b, 0x61, 0xfb, 0x7f, 0x9e, 0xdf, 0x33, 0xff,
+	0xed, 0xee, 0x53, 0xb0, 0x7d, 0x4e, 0x79, 0x9f, 0x72, 0x93, 0x0b, 0xd4, 0x23, 0xbe, 0x6b, 0x8e,
+	0x1a, 0x1d, 0x2c, 0x50, 0xc3, 0x74, 0xb1, 0x8f, 0x39, 0xe1, 0xc6, 0x80, 0x51, 0x41, 0xe1, 0xa6,
+	0x52, 0x19, 0xa1, 0xca, 0x08, 0x55, 0xa5, 0xa2, 0x4b, 0x5d, 0x2a, 0x25, 0x66, 0xf0, 0x9f, 0x52,
+	0x97, 0xe6, 0x31, 0x23, 0xb7, 0x52, 0x6d, 0x29, 0x95, 0xad, 0xec, 0x61, 0x80, 0x1a, 0xbd, 0x8b,
+	0xfa, 0xc4, 0xa7, 0xa6, 0xfc, 0x1b, 0x7e, 0x54, 0x71, 0x29, 0x75, 0x3d, 0x6c, 0xca, 0xab, 0xce,
+	0xf0, 0xc2, 0x14, 0xa4, 0x8f, 0xb9, 0x40, 0xfd, 0x81, 0x12, 0xd4, 0xfe, 0xcd, 0x81, 0xc2, 0xd7,
+	0xea, 0xd0, 0x6d, 0x81, 0x04, 0x86, 0xfb, 0x20, 0x33, 0x40, 0x0c, 0xf5, 0xb9, 0xae, 0x55, 0xb5,
+	0x7a, 0x7e, 0xaf, 0x6c, 0xcc, 0x5e, 0xc2, 0x38, 0x96, 0xaa, 0x66, 0xee, 0xee, 0xb1, 0x92, 0xfa,
+	0xf5, 0xef, 0xdf, 0x76, 0x34, 0x2b, 0x34, 0xc2, 0x33, 0xb0, 0xe6, 0x21, 0x2e, 0x6c, 0x41, 0x05,
+	0xf2, 0xec, 0x01, 0xfd, 0x11, 0x33, 0x7d, 0xa1, 0xaa, 0xd5, 0x0b, 0xcd, 0x8f, 0x03, 0xf1, 0x1f,
+	0x8f, 0x95, 0x0d, 0xc5, 0xe4, 0x4e, 0xcf, 0x20, 0xd4, 0xec, 0x23, 0xd1, 0x35, 0x5a, 0xbe, 0x78,
+	0xb8, 0xdd, 0x05, 0x61, 0x58, 0xcb, 0x17, 0x8a, 0xb9, 0x1a, 0x90, 0x4e, 0x02, 0xd0, 0x71, 0xc0,
+	0x81, 0x04, 0x6c, 0x48, 0xf6, 0x08, 0x79, 0xc4, 0x41, 0x82, 0x32, 0xc5, 0xe7, 0xfa, 0x62, 0x75,
+	0xb1, 0x9e, 0xdf, 0xdb, 0x99, 0x77, 0xda, 0x43, 0xc4, 0xc5, 0xf7, 0x91, 0x47, 0xa2, 0x92, 0x27,
+	0x5f, 0xf7, 0xa6, 0xc6, 0x1c, 0x1e, 0x02, 0x10, 0xa7, 0x70, 0x3d, 0x2d, 0xf9, 0x1f, 0xce, 0xe3,
+	0xc7, 0xe6, 0x24, 0x36, 0xe1, 0x87, 0x47, 0x20, 0xef, 0x60, 0x0f, 0xbb, 0x48, 0x10, 0xea, 0x73,
+	0x7d, 0x49, 0xe2, 0x6a, 0xf3, 0x70, 0x5f, 0xc5, 0xd2, 0x24, 0x2f, 0x49, 0x80, 0x3d, 0xb0, 0x31,
+	0xf4, 0x3b, 0xd4, 0x77, 0x88, 0xef, 0xda, 0x49, 0x74, 0x46, 0xa2, 0x3f, 0x9a, 0x87, 0x3e, 0x8d,
+	0x4c, 0xb3, 0x33, 0x8a, 0xc3, 0xe9, 0x39, 0x87, 0xa7, 0x60, 0x85, 0xe1, 0x64, 0xc8, 0xb2, 0x0c,
+	0xd9, 0x9e, 0x17, 0x62, 0x61, 0x67, 0x26, 0x7d, 0x92, 0x02, 0x4b, 0x20, 0x8b, 0xaf, 0x06, 0x94,
+	0x09, 0xec, 0xe8, 0xd9, 0xaa, 0x56, 0xcf, 0x5a, 0xf1, 0x35, 0xf4, 0xc0, 0x26, 0xa3, 0x42, 0x0a,
+	0x6d, 0xe2, 0x3b, 0xf8, 0xca, 0x66, 0xf8, 0x9c, 0x32, 0x87, 0xeb, 0xb9, 0xd7, 0x17, 0xb4, 0x42,
+	0x57, 0x2b, 0x30, 0x59, 0xd2, 0x33, 0xb1, 0x20, 0x9b, 0x9e, 0x73, 0xe8, 0x82, 0xb5, 0x38, 0xad,
+	0x4b, 0xb8, 0xa0, 0x6c, 0xac, 0x03, 0x99, 0xd3, 0x98, 0x97, 0xf3, 0x25, 0xf5, 0xf9, 0xf1, 0xb0,
+	0x73, 0x80, 0xc7, 0x51, 0xe2, 0x37, 0xca, 0x98, 0x4c, 0x7b, 0x87, 0x4d, 0xce, 0xe0, 0x0f, 0x60,
+	0x35, 0x0e, 0xba, 0x1c, 0xe2, 0x21, 0xd6, 0xf3, 0xff, 0x6f, 0x9d, 0xef, 0x02, 0xf1, 0xf4, 0x3a,
+	0x2b, 0x2c, 0x39, 0x0f, 0xee, 0x9a, 0xa0, 0x3d, 0xec, 0x93, 0x9f, 0xb0, 0xcd, 0xbb, 0x88, 0xe1,
+	0xf8, 0xae, 0x15, 0x5e, 0x8f, 0x39, 0x09, 0x5d, 0xed, 0xc0, 0x34, 0xe3, 0xae, 0x89, 0xe9, 0x39,
+	0x87, 0x5f, 0x80, 0x0f, 0xc2, 0x37, 0x7d, 0x46, 0xa4, 0x4d, 0x1c, 0x7d, 0xa5, 0xaa, 0xd5, 0xd3,
+	0xd6, 0x96, 0x7a, 0x89, 0xa7, 0x00, 0x2d, 0x07, 0xf6, 0x41, 0x49, 0xd5, 0x84, 0x47, 0x2e, 0x87,
+	0xc4, 0xb1, 0x83, 0x63, 0x61, 0x47, 0x01, 0xb9, 0xbe, 0xfa, 0x96, 0xad, 0xf1, 0x9e, 0x64, 0x1e,
+	0x4a, 0x64, 0x5b, 0x12, 0x65, 0x3a, 0xaf, 0x75, 0x01, 0x9c, 0x6e, 0x02, 0xb8, 0x07, 0x96, 0x91,
+	0xe3, 0x30, 0xcc, 0x55, 0xe9, 0xe5, 0x9a, 0xfa, 0xc3, 0xed, 0x6e, 0x31, 0x84, 0xee, 0xab, 0x49,
+	0x5b, 0x30, 0xe2, 0xbb, 0x56, 0x24, 0x84, 0x45, 0xb0, 0xf4, 0xd2, 0x6c, 0x8b, 0x96, 0xba, 0xf8,
+	0x3c, 0xfb, 0xf3, 0x4d, 0x25, 0xf5, 0xcf, 0x4d, 0x25, 0x55, 0xa3, 0x60, 0x7d, 0xc6, 0x83, 0x08,
+	0xf5, 0xc9, 0xa8, 0xc2, 0x0b, 0xf0, 0x53, 0x90, 0x0e, 0xca, 0x59, 0xcf, 0xc8, 0xda, 0x2d, 0x19,
+	0xaa, 0xb9, 0x8d, 0xa8, 0xb9, 0x8d, 0x93, 0xa8, 0xb9, 0x9b, 0xe9, 0xeb, 0x3f, 0x2b, 0x9a, 0x25,
+	0xd5, 0x89, 0xc0, 0x5f, 0xb4, 0x97, 0xc4, 0xc4, 0xb3, 0x02, 0xbf, 0x05, 0xb9, 0x11, 0xf2, 0xec,
+	0x20, 0x26, 0xea, 0xf4, 0xc6, 0x2b, 0x2d, 0x16, 0xac, 0xcb, 0x8f, 0x2e, 0x24, 0x09, 0x3b, 0xc1,
+	0x33, 0x7e, 0x80, 0xc7, 0xdc, 0xca, 0x8e, 0xc2, 0x51, 0x7c, 0xce, 0x85, 0x37, 0x39, 0x67, 0xf3,
+	0xb3, 0xbb, 0xa7, 0xb2, 0x76, 0xff, 0x54, 0xd6, 0xfe, 0x7a, 0x2a, 0x6b, 0xd7, 0xcf, 0xe5, 0xd4,
+	0xfd, 0x73, 0x39, 0xf5, 0xfb, 0x73, 0x39, 0x75, 0xf6, 0xfe, 0xc4, 0xb7, 0x7a, 0x15, 0xff, 0xfc,
+	0x89, 0xf1, 0x00, 0xf3, 0x4e, 0x46, 0x72, 0x3f, 0xf9, 0x6f, 0x00, 0x24, 0xf2, 0x71, 0x65, 0x71,
+	0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -369,6 +395,35 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.TotalLiquidStakedTokens.Size()
+		i -= size
+		if _, err := m.TotalLiquidStakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.RotationQueue) > 0 {
 		for iNdEx := len(m.RotationQueue) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -706,6 +761,17 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	l = m.TotalLiquidStakedTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1152,6 +1218,92 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidStakedTokens", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -63,6 +63,12 @@ var (
 	ValidatorConsensusKeyRotationRecordIndexKey = collections.NewPrefix(104) // this key is used to restrict the validator next rotation within waiting (unbonding) period
 	ConsAddrToValidatorIdentifierMapPrefix      = collections.NewPrefix(105) // prefix for rotated cons address to new cons address
 	OldToNewConsAddrMap                         = collections.NewPrefix(106) // prefix for rotated cons address to new cons address
+
+	TokenizeShareRecordKey        = collections.NewPrefix(121) // prefix for tokenize share records
+	LastTokenizeShareRecordIDKey  = collections.NewPrefix(122) // key for the last tokenize share record id
+	TokenizeShareRecordByOwnerKey = collections.NewPrefix(123) // prefix for tokenize share records, by owner
+	ValidatorLiquidSharesKey      = collections.NewPrefix(124) // prefix for the tokenized delegation shares of each validator
+	TotalLiquidStakedTokensKey    = collections.NewPrefix(125) // key for the total amount of tokenized tokens
 )
 
 // Reserved kvstore keys
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// TokenizeShareRecordAddress returns the address of the account holding the
+// delegation of the tokenize share record with the given id.
+func TokenizeShareRecordAddress(id uint64) sdk.AccAddress {
+	return address.Module(ModuleName, []byte("tokenize_share_record"), sdk.Uint64ToBigEndian(id))
+}
+
+// ShareDenom returns the denom of the share tokens minted for the record,
+// in the form {validator}/{record id}.
+func (r TokenizeShareRecord) ShareDenom() string {
+	return fmt.Sprintf("%s/%d", r.Validator, r.Id)
+}
+
+// ParseShareDenom returns the validator address and the record id encoded
+// in a share denom.
+func ParseShareDenom(denom string) (validator string, recordID uint64, err error) {
+	i := strings.LastIndex(denom, "/")
+	if i <= 0 {
+		return "", 0, ErrInvalidShareDenom.Wrap(denom)
+	}
+
+	recordID, err = strconv.ParseUint(denom[i+1:], 10, 64)
+	if err != nil {
+		return "", 0, ErrInvalidShareDenom.Wrapf("%s: %s", denom, err)
+	}
+
+	return denom[:i], recordID, nil
+}
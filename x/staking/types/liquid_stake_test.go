@@ -0,0 +1,23 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/staking/types"
+)
+
+func TestParseShareDenom(t *testing.T) {
+	record := types.TokenizeShareRecord{Id: 7, Validator: "cosmosvaloper1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqkh6yvw"}
+
+	validator, id, err := types.ParseShareDenom(record.ShareDenom())
+	require.NoError(t, err)
+	require.Equal(t, record.Validator, validator)
+	require.Equal(t, record.Id, id)
+
+	for _, denom := range []string{"stake", "/1", "cosmosvaloper1qqqq/", "cosmosvaloper1qqqq/x"} {
+		_, _, err := types.ParseShareDenom(denom)
+		require.ErrorIs(t, err, types.ErrInvalidShareDenom, denom)
+	}
+}
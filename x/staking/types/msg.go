@@ -23,6 +23,8 @@ var (
 	_ coretransaction.Msg                  = &MsgBeginRedelegate{}
 	_ coretransaction.Msg                  = &MsgCancelUnbondingDelegation{}
 	_ coretransaction.Msg                  = &MsgUpdateParams{}
+	_ coretransaction.Msg                  = &MsgTokenizeShares{}
+	_ coretransaction.Msg                  = &MsgRedeemTokensForShares{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -120,6 +122,24 @@ func NewMsgDelegate(delAddr, valAddr string, amount sdk.Coin) *MsgDelegate {
 	}
 }
 
+// NewMsgTokenizeShares creates a new MsgTokenizeShares instance.
+func NewMsgTokenizeShares(delAddr, valAddr string, amount sdk.Coin, owner string) *MsgTokenizeShares {
+	return &MsgTokenizeShares{
+		DelegatorAddress:    delAddr,
+		ValidatorAddress:    valAddr,
+		Amount:              amount,
+		TokenizedShareOwner: owner,
+	}
+}
+
+// NewMsgRedeemTokensForShares creates a new MsgRedeemTokensForShares instance.
+func NewMsgRedeemTokensForShares(delAddr string, amount sdk.Coin) *MsgRedeemTokensForShares {
+	return &MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
 // NewMsgBeginRedelegate creates a new MsgBeginRedelegate instance.
 func NewMsgBeginRedelegate(
 	delAddr, valSrcAddr, valDstAddr string, amount sdk.Coin,
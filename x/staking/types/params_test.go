@@ -46,4 +46,16 @@ func TestValidateParams(t *testing.T) {
 	// check keyRotationFee
 	params.KeyRotationFee = coinZero
 	require.Error(t, params.Validate())
+
+	// validate liquid staking params
+	params = types.DefaultParams()
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = math.LegacyDec{}
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ValidatorBondFactor = math.LegacyDec{}
+	require.Error(t, params.Validate())
 }
@@ -1000,6 +1000,13 @@ type Params struct {
 	// key_rotation_fee is fee to be spent when rotating validator's key
 	// (either consensus pubkey or operator key)
 	KeyRotationFee types.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded tokens
+	// that can be tokenized into liquid staking share denoms.
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_bond_factor is the maximum ratio between the tokenized shares of
+	// a validator and its self-delegation shares. A negative value disables the
+	// check.
+	ValidatorBondFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_bond_factor"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1416,6 +1423,81 @@ func (m *ValAddrsOfRotatedConsKeys) GetAddresses() [][]byte {
 	return nil
 }
 
+// TokenizeShareRecord represents a delegation that was tokenized into a
+// transferable share denom. The delegation is held by the module account of
+// the record, and share tokens are redeemed against it.
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the account entitled to the record, set when the shares are tokenized.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the address of the account holding the tokenized delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the address of the validator the tokenized shares are delegated to.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
@@ -1443,6 +1525,7 @@ func init() {
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
 	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
 	proto.RegisterType((*ValAddrsOfRotatedConsKeys)(nil), "cosmos.staking.v1beta1.ValAddrsOfRotatedConsKeys")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
 }
 
 func init() {
@@ -1450,142 +1533,150 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 2154 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4b, 0x6c, 0x1b, 0xc7,
-	0x19, 0xd6, 0x92, 0x0c, 0x25, 0xfe, 0x14, 0x45, 0x6a, 0xfc, 0xa2, 0xe8, 0x58, 0x92, 0x19, 0x27,
-	0x91, 0xdd, 0x98, 0x8c, 0xdc, 0xc2, 0x05, 0x84, 0x20, 0x85, 0x29, 0xca, 0x36, 0x93, 0x58, 0x52,
-	0x97, 0xa2, 0xfa, 0x40, 0x9b, 0xc5, 0x70, 0x77, 0x48, 0x6d, 0x45, 0xee, 0xb2, 0x3b, 0x43, 0xd9,
-	0xbc, 0xf7, 0x10, 0xb8, 0x28, 0x90, 0x53, 0x91, 0x8b, 0x11, 0x03, 0xbd, 0xb4, 0xb7, 0x1c, 0x8c,
-	0xde, 0x7b, 0x4b, 0x0b, 0x14, 0x30, 0x7c, 0x2a, 0x02, 0xd4, 0x2d, 0xec, 0x43, 0x82, 0xe6, 0xd2,
-	0xf6, 0xd4, 0x63, 0x31, 0x8f, 0x7d, 0x50, 0x14, 0x2d, 0x4b, 0xf6, 0x21, 0x68, 0x2f, 0xc4, 0xce,
-	0xcc, 0xff, 0x7f, 0x33, 0xff, 0x7b, 0xe6, 0x27, 0x5c, 0x30, 0x5d, 0xda, 0x75, 0x69, 0x99, 0x32,
-	0xbc, 0x6b, 0x3b, 0xed, 0xf2, 0xde, 0x72, 0x93, 0x30, 0xbc, 0xec, 0x8f, 0x4b, 0x3d, 0xcf, 0x65,
-	0x2e, 0x3a, 0x2d, 0xa9, 0x4a, 0xfe, 0xac, 0xa2, 0x2a, 0xcc, 0xe2, 0xae, 0xed, 0xb8, 0x65, 0xf1,
-	0x2b, 0x49, 0x0b, 0xaf, 0x9a, 0x6e, 0x97, 0xb0, 0x66, 0x8b, 0x95, 0x71, 0xd3, 0xb4, 0xcb, 0x7b,
-	0xcb, 0x65, 0x36, 0xe8, 0x11, 0xaa, 0x56, 0xcf, 0x05, 0xab, 0x62, 0x76, 0xff, 0xf2, 0xbc, 0x3a,
-	0x4d, 0x13, 0x53, 0x12, 0x1c, 0xc5, 0x74, 0x6d, 0x47, 0xad, 0xcf, 0xc9, 0x75, 0x43, 0x8c, 0xca,
-	0xea, 0x50, 0x72, 0xe9, 0x64, 0xdb, 0x6d, 0xbb, 0x72, 0x9e, 0x7f, 0xf9, 0x0c, 0x6d, 0xd7, 0x6d,
-	0x77, 0x48, 0x59, 0x8c, 0x9a, 0xfd, 0x56, 0x19, 0x3b, 0x03, 0x7f, 0xaf, 0xfd, 0x4b, 0x56, 0xdf,
-	0xc3, 0xcc, 0x76, 0xfd, 0xbd, 0x16, 0xf6, 0xaf, 0x33, 0xbb, 0x4b, 0x28, 0xc3, 0xdd, 0x9e, 0x24,
-	0x28, 0x7e, 0xa2, 0xc1, 0xcc, 0x4d, 0x9b, 0x32, 0xd7, 0xb3, 0x4d, 0xdc, 0xa9, 0x39, 0x2d, 0x17,
-	0xbd, 0x03, 0xc9, 0x1d, 0x82, 0x2d, 0xe2, 0xe5, 0xb5, 0x45, 0x6d, 0x29, 0x7d, 0x65, 0xae, 0xe4,
-	0xcb, 0x5b, 0x92, 0x62, 0xee, 0x2d, 0x97, 0x6e, 0x0a, 0x82, 0x4a, 0xea, 0xf3, 0xc7, 0x0b, 0x13,
-	0xbf, 0xfd, 0xf2, 0xb3, 0x4b, 0x9a, 0xae, 0x78, 0x50, 0x15, 0x92, 0x7b, 0xb8, 0x43, 0x09, 0xcb,
-	0xc7, 0x16, 0xe3, 0x4b, 0xe9, 0x2b, 0xe7, 0x4b, 0x07, 0xab, 0xbd, 0xb4, 0x8d, 0x3b, 0xb6, 0x85,
-	0x99, 0x3b, 0x8c, 0x22, 0x79, 0x57, 0x62, 0x79, 0xad, 0xf8, 0xeb, 0x18, 0x64, 0x57, 0xdd, 0x6e,
-	0xd7, 0xa6, 0xd4, 0x76, 0x1d, 0x1d, 0x33, 0x42, 0xd1, 0x7b, 0x90, 0xf0, 0x30, 0x23, 0xe2, 0x64,
-	0xa9, 0xca, 0x55, 0xce, 0xf8, 0xc5, 0xe3, 0x85, 0xb3, 0x72, 0x0b, 0x6a, 0xed, 0x96, 0x6c, 0xb7,
-	0xdc, 0xc5, 0x6c, 0xa7, 0xf4, 0x01, 0x69, 0x63, 0x73, 0x50, 0x25, 0xe6, 0xa3, 0x07, 0x97, 0x41,
-	0x9d, 0xa0, 0x4a, 0x4c, 0xb9, 0x8b, 0xc0, 0x40, 0xdf, 0x87, 0xa9, 0x2e, 0xbe, 0x63, 0x08, 0xbc,
-	0xd8, 0x0b, 0xe1, 0x4d, 0x76, 0xf1, 0x1d, 0x7e, 0x3e, 0xf4, 0x21, 0x64, 0x39, 0xa4, 0xb9, 0x83,
-	0x9d, 0x36, 0x91, 0xc8, 0xf1, 0x17, 0x42, 0xce, 0x74, 0xf1, 0x9d, 0x55, 0x81, 0xc6, 0xf1, 0x57,
-	0x12, 0x5f, 0xdd, 0x5f, 0xd0, 0x8a, 0x7f, 0xd0, 0x00, 0x42, 0xc5, 0x20, 0x0c, 0x39, 0x33, 0x18,
-	0x89, 0x4d, 0xa9, 0xb2, 0xdc, 0x9b, 0xe3, 0x74, 0xbf, 0x4f, 0xad, 0x95, 0x0c, 0x3f, 0xde, 0xc3,
-	0xc7, 0x0b, 0x9a, 0xdc, 0x35, 0x6b, 0x8e, 0xa8, 0x3d, 0xdd, 0xef, 0x59, 0x98, 0x11, 0x83, 0xfb,
-	0x8f, 0xd0, 0x56, 0xfa, 0x4a, 0xa1, 0x24, 0x9d, 0xab, 0xe4, 0x3b, 0x57, 0x69, 0xcb, 0x77, 0x2e,
-	0x09, 0xf8, 0xf1, 0xdf, 0x7c, 0x40, 0x90, 0xdc, 0x7c, 0x5d, 0xc9, 0xf0, 0x2f, 0x0d, 0xd2, 0x55,
-	0x42, 0x4d, 0xcf, 0xee, 0x71, 0x77, 0x45, 0x79, 0x98, 0xec, 0xba, 0x8e, 0xbd, 0xab, 0xbc, 0x2e,
-	0xa5, 0xfb, 0x43, 0x54, 0x80, 0x29, 0xdb, 0x22, 0x0e, 0xb3, 0xd9, 0x40, 0x9a, 0x49, 0x0f, 0xc6,
-	0x9c, 0xeb, 0x36, 0x69, 0x52, 0xdb, 0xd7, 0xb3, 0xee, 0x0f, 0xd1, 0x45, 0xc8, 0x51, 0x62, 0xf6,
-	0x3d, 0x9b, 0x0d, 0x0c, 0xd3, 0x75, 0x18, 0x36, 0x59, 0x3e, 0x21, 0x48, 0xb2, 0xfe, 0xfc, 0xaa,
-	0x9c, 0xe6, 0x20, 0x16, 0x61, 0xd8, 0xee, 0xd0, 0xfc, 0x2b, 0x12, 0x44, 0x0d, 0xd1, 0x0d, 0x98,
-	0xea, 0x12, 0x86, 0x2d, 0xcc, 0x70, 0x3e, 0x29, 0x64, 0x5e, 0x1c, 0xa7, 0xd1, 0x5b, 0x8a, 0x4e,
-	0x38, 0xb3, 0x90, 0x7a, 0x42, 0x0f, 0x98, 0x95, 0xcc, 0x2d, 0x98, 0xf2, 0xc9, 0xd0, 0x1b, 0x90,
-	0xed, 0x79, 0x6e, 0xcb, 0xee, 0x10, 0xa3, 0x67, 0x9b, 0x46, 0xdf, 0xb3, 0x95, 0xdc, 0x19, 0x35,
-	0xbd, 0x69, 0x9b, 0x0d, 0xcf, 0x46, 0x6f, 0x01, 0xa2, 0xae, 0x69, 0xe3, 0x8e, 0xb1, 0x83, 0x1d,
-	0xab, 0x43, 0x38, 0x25, 0x15, 0xa1, 0x95, 0xd2, 0x73, 0x72, 0xe5, 0xa6, 0x58, 0x68, 0x78, 0x36,
-	0x55, 0xfb, 0xdc, 0x9b, 0x84, 0x54, 0x10, 0x5d, 0x68, 0x15, 0x72, 0x6e, 0x8f, 0x78, 0xfc, 0xdb,
-	0xc0, 0x96, 0xe5, 0x11, 0x4a, 0x55, 0xf8, 0xe4, 0x1f, 0x3d, 0xb8, 0x7c, 0x52, 0xc9, 0x73, 0x4d,
-	0xae, 0xd4, 0x99, 0x67, 0x3b, 0x6d, 0x3d, 0xeb, 0x73, 0xa8, 0x69, 0xf4, 0x23, 0xee, 0x63, 0x0e,
-	0x25, 0x0e, 0xed, 0x53, 0xa3, 0xd7, 0x6f, 0xee, 0x92, 0x81, 0xf2, 0x82, 0x93, 0x23, 0x5e, 0x70,
-	0xcd, 0x19, 0x54, 0xf2, 0x7f, 0x0a, 0xa1, 0x4d, 0x6f, 0xd0, 0x63, 0x6e, 0x69, 0xb3, 0xdf, 0x7c,
-	0x9f, 0x0c, 0xb8, 0x6f, 0x29, 0x9c, 0x4d, 0x01, 0x83, 0x4e, 0x43, 0xf2, 0x67, 0xd8, 0xee, 0x10,
-	0x4b, 0x98, 0x70, 0x4a, 0x57, 0x23, 0xb4, 0x02, 0x49, 0xca, 0x30, 0xeb, 0x53, 0x61, 0xb7, 0x99,
-	0x2b, 0xc5, 0x71, 0xaa, 0xaf, 0xb8, 0x8e, 0x55, 0x17, 0x94, 0xba, 0xe2, 0x40, 0xab, 0x90, 0x64,
-	0xee, 0x2e, 0x71, 0x94, 0x45, 0x2b, 0xdf, 0x52, 0xe1, 0x77, 0x6a, 0x34, 0xfc, 0x6a, 0x0e, 0x8b,
-	0x04, 0x5e, 0xcd, 0x61, 0xba, 0x62, 0x45, 0x3f, 0x81, 0x9c, 0x45, 0x3a, 0xa4, 0x2d, 0x34, 0x47,
-	0x77, 0xb0, 0x47, 0xa8, 0xf0, 0x82, 0x54, 0x65, 0xf9, 0xc8, 0xd1, 0xac, 0x67, 0x03, 0xa8, 0xba,
-	0x40, 0x42, 0x9b, 0x90, 0xb6, 0x42, 0xff, 0xcf, 0x4f, 0x0a, 0x65, 0xbe, 0x36, 0x4e, 0xc6, 0x48,
-	0xa8, 0x44, 0xd3, 0x65, 0x14, 0x82, 0xbb, 0x7c, 0xdf, 0x69, 0xba, 0x8e, 0x65, 0x3b, 0x6d, 0x63,
-	0x87, 0xd8, 0xed, 0x1d, 0x96, 0x9f, 0x5a, 0xd4, 0x96, 0xe2, 0x7a, 0x36, 0x98, 0xbf, 0x29, 0xa6,
-	0xd1, 0x26, 0xcc, 0x84, 0xa4, 0x22, 0xa4, 0x53, 0x47, 0x0d, 0xe9, 0x4c, 0x00, 0xc0, 0x49, 0xd0,
-	0x2d, 0x80, 0x30, 0x69, 0xe4, 0x41, 0xa0, 0x15, 0x0f, 0x4f, 0x3f, 0x51, 0x61, 0x22, 0x00, 0xc8,
-	0x81, 0x13, 0x5d, 0xdb, 0x31, 0x28, 0xe9, 0xb4, 0x0c, 0xa5, 0x39, 0x8e, 0x9b, 0x16, 0xea, 0x7f,
-	0xf7, 0x08, 0xd6, 0xfc, 0xe2, 0xc1, 0xe5, 0xac, 0x1c, 0x5d, 0xa6, 0xd6, 0xee, 0xe2, 0xdb, 0xa5,
-	0xef, 0x7c, 0x57, 0x9f, 0xed, 0xda, 0x4e, 0x9d, 0x74, 0x5a, 0xd5, 0x00, 0x18, 0xbd, 0x03, 0x67,
-	0x43, 0x85, 0xb8, 0x8e, 0xb1, 0xe3, 0x76, 0x2c, 0xc3, 0x23, 0x2d, 0xc3, 0x74, 0xfb, 0x0e, 0xcb,
-	0x4f, 0x0b, 0x35, 0x9e, 0x09, 0x48, 0x36, 0x9c, 0x9b, 0x6e, 0xc7, 0xd2, 0x49, 0x6b, 0x95, 0x2f,
-	0xa3, 0xd7, 0x20, 0xd4, 0x86, 0x61, 0x5b, 0x34, 0x9f, 0x59, 0x8c, 0x2f, 0x25, 0xf4, 0xe9, 0x60,
-	0xb2, 0x66, 0xd1, 0x95, 0xa9, 0x8f, 0xee, 0x2f, 0x4c, 0x7c, 0x75, 0x7f, 0x61, 0xa2, 0x78, 0x1d,
-	0xa6, 0xb7, 0x71, 0x47, 0x85, 0x16, 0xa1, 0xe8, 0x2a, 0xa4, 0xb0, 0x3f, 0xc8, 0x6b, 0x3c, 0xb4,
-	0x9f, 0x11, 0x9a, 0x21, 0x69, 0xf1, 0x77, 0x1a, 0x24, 0xab, 0xdb, 0x9b, 0xd8, 0xf6, 0xd0, 0x1a,
-	0xcc, 0x86, 0xbe, 0xfa, 0xbc, 0x51, 0x1e, 0xba, 0xb7, 0x1f, 0xe6, 0xeb, 0x30, 0xbb, 0xe7, 0x27,
-	0x8e, 0x00, 0x46, 0xd6, 0xc6, 0xf3, 0x8f, 0x1e, 0x5c, 0x3e, 0xa7, 0x60, 0x82, 0xe4, 0xb2, 0x0f,
-	0x6f, 0x6f, 0xdf, 0x7c, 0x44, 0xe6, 0xf7, 0x60, 0x52, 0x1e, 0x95, 0xa2, 0xef, 0xc1, 0x2b, 0x3d,
-	0xfe, 0x21, 0x44, 0x4d, 0x5f, 0x99, 0x1f, 0xeb, 0xf3, 0x82, 0x3e, 0xea, 0x21, 0x92, 0xaf, 0xf8,
-	0xcb, 0x18, 0x40, 0x75, 0x7b, 0x7b, 0xcb, 0xb3, 0x7b, 0x1d, 0xc2, 0x5e, 0x96, 0xec, 0x0d, 0x38,
-	0x15, 0xca, 0x4e, 0x3d, 0xf3, 0xe8, 0xf2, 0x9f, 0x08, 0xf8, 0xeb, 0x9e, 0x79, 0x20, 0xac, 0x45,
-	0x59, 0x00, 0x1b, 0x3f, 0x3a, 0x6c, 0x95, 0xb2, 0x51, 0xcd, 0xfe, 0x10, 0xd2, 0xa1, 0x32, 0x28,
-	0xaa, 0xc1, 0x14, 0x53, 0xdf, 0x4a, 0xc1, 0xc5, 0xf1, 0x0a, 0xf6, 0xd9, 0xa2, 0x4a, 0x0e, 0xd8,
-	0x8b, 0xff, 0xd1, 0x00, 0x22, 0x31, 0xf2, 0xcd, 0xf4, 0x31, 0x54, 0x83, 0xa4, 0x4a, 0xce, 0xf1,
-	0xe3, 0x26, 0x67, 0x05, 0x10, 0x51, 0xea, 0xaf, 0x62, 0x70, 0xa2, 0xe1, 0x47, 0xef, 0x37, 0x5f,
-	0x07, 0x0d, 0x98, 0x24, 0x0e, 0xf3, 0x6c, 0xa1, 0x04, 0x6e, 0xf3, 0xb7, 0xc7, 0xd9, 0xfc, 0x00,
-	0xa1, 0xd6, 0x1c, 0xe6, 0x0d, 0xa2, 0x1e, 0xe0, 0x63, 0x45, 0xf4, 0xf1, 0x69, 0x1c, 0xf2, 0xe3,
-	0x58, 0xd1, 0x9b, 0x90, 0x35, 0x3d, 0x22, 0x26, 0xfc, 0xba, 0xa3, 0x89, 0x84, 0x39, 0xe3, 0x4f,
-	0xab, 0xb2, 0xa3, 0x03, 0xbf, 0x59, 0x72, 0xe7, 0xe2, 0xa4, 0xc7, 0xbb, 0x4a, 0xce, 0x84, 0x08,
-	0xa2, 0xf0, 0x6c, 0x41, 0xd6, 0x76, 0x6c, 0xc6, 0x6f, 0x48, 0x4d, 0xdc, 0xc1, 0x8e, 0xe9, 0x5f,
-	0xb9, 0x8f, 0x54, 0xf3, 0x67, 0x14, 0x46, 0x45, 0x42, 0xa0, 0x35, 0x98, 0xf4, 0xd1, 0x12, 0x47,
-	0x47, 0xf3, 0x79, 0xd1, 0xeb, 0x30, 0x1d, 0x2d, 0x0c, 0xe2, 0x36, 0x92, 0xa8, 0xc4, 0xf2, 0x9a,
-	0x9e, 0x8e, 0xd4, 0x86, 0xc3, 0xaa, 0x4f, 0xf2, 0x99, 0xd5, 0x47, 0x5d, 0xfa, 0x3e, 0x8d, 0xc3,
-	0xac, 0x4e, 0xac, 0xff, 0x7d, 0xd3, 0x6c, 0x02, 0xc8, 0x70, 0xe5, 0xd9, 0x54, 0x59, 0xe7, 0x18,
-	0x31, 0x9f, 0x92, 0x20, 0x55, 0xca, 0xd0, 0xf9, 0x83, 0xac, 0xf4, 0xf2, 0x2d, 0xf4, 0xd7, 0x18,
-	0x4c, 0x47, 0x2d, 0xf4, 0x7f, 0x59, 0xb8, 0xd0, 0x7a, 0x98, 0xaa, 0x12, 0x22, 0x55, 0x5d, 0x1c,
-	0x97, 0xaa, 0x46, 0xbc, 0xf9, 0x90, 0x1c, 0xf5, 0x75, 0x1c, 0x92, 0x9b, 0xd8, 0xc3, 0x5d, 0x8a,
-	0x36, 0x46, 0xee, 0xb7, 0x7e, 0x2b, 0x63, 0xbf, 0x33, 0x57, 0x55, 0xbf, 0x44, 0xfa, 0xf2, 0x27,
-	0xe3, 0xae, 0xb7, 0xaf, 0xc3, 0x0c, 0x7f, 0xd8, 0x07, 0x02, 0x49, 0xe5, 0x66, 0xc4, 0xfb, 0x3c,
-	0x90, 0x9e, 0xa2, 0x05, 0x48, 0x73, 0xb2, 0x30, 0x17, 0x73, 0x1a, 0xe8, 0xe2, 0x3b, 0x6b, 0x72,
-	0x06, 0x2d, 0x03, 0xda, 0x09, 0xba, 0x2d, 0x46, 0xa8, 0x08, 0x6d, 0x29, 0x23, 0xd2, 0xc2, 0x6c,
-	0xb8, 0xea, 0xb3, 0x9c, 0x03, 0xe0, 0x27, 0x31, 0x2c, 0xe2, 0xb8, 0x5d, 0xf5, 0x42, 0x4d, 0xf1,
-	0x99, 0x2a, 0x9f, 0x40, 0xbf, 0xd0, 0xe4, 0x55, 0x79, 0x5f, 0x0b, 0x40, 0xbd, 0x54, 0xb6, 0x9e,
-	0x23, 0x30, 0xfe, 0xfd, 0x78, 0xa1, 0x30, 0xc0, 0xdd, 0xce, 0x4a, 0xf1, 0x00, 0x9c, 0xe2, 0x41,
-	0x5d, 0x09, 0x7e, 0x81, 0x1e, 0x6e, 0x21, 0xa0, 0x1a, 0xe4, 0x76, 0xc9, 0xc0, 0xf0, 0x5c, 0x26,
-	0x93, 0x4d, 0x8b, 0x10, 0xf5, 0xa6, 0x99, 0xf3, 0xed, 0xdb, 0xc4, 0x94, 0x44, 0x9e, 0x00, 0xb6,
-	0x53, 0x49, 0xf0, 0xd3, 0xe9, 0x33, 0xbb, 0x64, 0xa0, 0x2b, 0xbe, 0xeb, 0x84, 0xac, 0x5c, 0xe0,
-	0xd1, 0x72, 0xf7, 0xcb, 0xcf, 0x2e, 0x9d, 0x0d, 0x2f, 0xee, 0xe5, 0x3b, 0x41, 0x73, 0x4f, 0x9a,
-	0x98, 0x5f, 0x7e, 0x51, 0x58, 0x88, 0x74, 0x42, 0x7b, 0xfc, 0x5d, 0xc9, 0xdf, 0x21, 0x91, 0xf7,
-	0x82, 0xf6, 0xec, 0x77, 0x48, 0xc8, 0x3f, 0xf4, 0x0e, 0x89, 0x84, 0xe8, 0xbb, 0x61, 0x1d, 0x88,
-	0x1d, 0x26, 0x4d, 0xd4, 0x3b, 0x15, 0x93, 0x88, 0xfc, 0x89, 0xe2, 0x9f, 0x35, 0x98, 0x1b, 0xf1,
-	0xe6, 0xe0, 0xc8, 0x26, 0x20, 0x2f, 0xb2, 0x28, 0xbc, 0x62, 0xa0, 0x8e, 0x7e, 0xbc, 0xe0, 0x98,
-	0xf5, 0x46, 0x0a, 0xc1, 0xcb, 0x29, 0x68, 0x2a, 0x93, 0xfd, 0x51, 0x83, 0x93, 0xd1, 0x03, 0x04,
-	0xa2, 0xd4, 0x61, 0x3a, 0xba, 0xb5, 0x12, 0xe2, 0xc2, 0xf3, 0x08, 0x11, 0x3d, 0xff, 0x10, 0x08,
-	0xda, 0x0e, 0x33, 0x86, 0x6c, 0x29, 0x2e, 0x3f, 0xb7, 0x52, 0xfc, 0x83, 0x1d, 0x98, 0x39, 0xa4,
-	0x6d, 0xbe, 0xd6, 0x20, 0xb1, 0xe9, 0xba, 0x1d, 0xf4, 0x73, 0x98, 0x75, 0x5c, 0x66, 0xf0, 0xc8,
-	0x22, 0x96, 0xa1, 0xda, 0x07, 0x32, 0x1b, 0xaf, 0x3d, 0x53, 0x57, 0xff, 0x78, 0xbc, 0x30, 0xca,
-	0x39, 0xac, 0x40, 0xd5, 0x56, 0x73, 0x5c, 0x56, 0x11, 0x44, 0x5b, 0xb2, 0xc3, 0xd0, 0x82, 0xcc,
-	0xf0, 0x76, 0x32, 0x63, 0x5f, 0x3b, 0x6c, 0xbb, 0xcc, 0xa1, 0x5b, 0x4d, 0x37, 0x23, 0xfb, 0xac,
-	0x4c, 0x71, 0xab, 0xfd, 0x93, 0x5b, 0xee, 0x43, 0xc8, 0x05, 0xe9, 0xaa, 0x21, 0x7a, 0x72, 0x14,
-	0x5d, 0x87, 0x49, 0xd9, 0x9e, 0xf3, 0x1f, 0x0c, 0xe7, 0xc3, 0x86, 0x2f, 0x6e, 0x9a, 0x76, 0x69,
-	0x2f, 0xd2, 0xac, 0x95, 0x4c, 0x43, 0xfa, 0x54, 0xcc, 0xa2, 0x67, 0xfb, 0x30, 0x06, 0x73, 0xab,
-	0xae, 0x43, 0x55, 0xb3, 0x47, 0x45, 0xb5, 0x6c, 0x30, 0x0f, 0xd0, 0xc5, 0x31, 0xad, 0xa8, 0xe9,
-	0xd1, 0x86, 0xd3, 0x36, 0x64, 0x79, 0x89, 0x35, 0x5d, 0xe7, 0x05, 0xfb, 0x4d, 0x19, 0xb7, 0x63,
-	0xa9, 0x13, 0xed, 0x92, 0x01, 0xc7, 0x75, 0xc8, 0xed, 0x21, 0xdc, 0xf8, 0xf1, 0x70, 0x1d, 0x72,
-	0x3b, 0x82, 0x7b, 0x1a, 0x92, 0xea, 0x7e, 0x95, 0x10, 0xb7, 0x07, 0x35, 0x42, 0x57, 0x21, 0xce,
-	0x53, 0xe1, 0x2b, 0x47, 0x48, 0x1e, 0x9c, 0x21, 0x52, 0xd6, 0xea, 0x30, 0xa7, 0xba, 0x05, 0x74,
-	0xa3, 0x25, 0x34, 0x4a, 0x84, 0x40, 0xef, 0x93, 0xc1, 0x01, 0xad, 0x83, 0xe9, 0xe7, 0x6a, 0x1d,
-	0x5c, 0xfa, 0xbd, 0x06, 0x10, 0xf6, 0xcd, 0xd0, 0x5b, 0x70, 0xa6, 0xb2, 0xb1, 0x5e, 0x35, 0xea,
-	0x5b, 0xd7, 0xb6, 0x1a, 0x75, 0xa3, 0xb1, 0x5e, 0xdf, 0x5c, 0x5b, 0xad, 0x5d, 0xaf, 0xad, 0x55,
-	0x73, 0x13, 0x85, 0xec, 0xdd, 0x7b, 0x8b, 0xe9, 0x86, 0x43, 0x7b, 0xc4, 0xb4, 0x5b, 0x36, 0xb1,
-	0xd0, 0x1b, 0x70, 0x72, 0x98, 0x9a, 0x8f, 0xd6, 0xaa, 0x39, 0xad, 0x30, 0x7d, 0xf7, 0xde, 0xe2,
-	0x94, 0x7c, 0x27, 0x10, 0x0b, 0x2d, 0xc1, 0xa9, 0x51, 0xba, 0xda, 0xfa, 0x8d, 0x5c, 0xac, 0x90,
-	0xb9, 0x7b, 0x6f, 0x31, 0x15, 0x3c, 0x28, 0x50, 0x11, 0x50, 0x94, 0x52, 0xe1, 0xc5, 0x0b, 0x70,
-	0xf7, 0xde, 0x62, 0x52, 0x86, 0x4c, 0x21, 0xf1, 0xd1, 0x6f, 0xe6, 0x27, 0x2e, 0xfd, 0x14, 0xa0,
-	0xe6, 0xb4, 0x3c, 0x6c, 0x8a, 0xd4, 0x50, 0x80, 0xd3, 0xb5, 0xf5, 0xeb, 0xfa, 0xb5, 0xd5, 0xad,
-	0xda, 0xc6, 0xfa, 0xf0, 0xb1, 0xf7, 0xad, 0x55, 0x37, 0x1a, 0x95, 0x0f, 0xd6, 0x8c, 0x7a, 0xed,
-	0xc6, 0x7a, 0x4e, 0x43, 0x67, 0xe0, 0xc4, 0xd0, 0xda, 0x0f, 0xd6, 0xb7, 0x6a, 0xb7, 0xd6, 0x72,
-	0xb1, 0xca, 0xd5, 0xcf, 0x9f, 0xcc, 0x6b, 0x0f, 0x9f, 0xcc, 0x6b, 0x7f, 0x7f, 0x32, 0xaf, 0x7d,
-	0xfc, 0x74, 0x7e, 0xe2, 0xe1, 0xd3, 0xf9, 0x89, 0xbf, 0x3c, 0x9d, 0x9f, 0xf8, 0xf1, 0xab, 0x43,
-	0xc1, 0x18, 0x96, 0x23, 0xf1, 0x97, 0x48, 0x33, 0x29, 0xbc, 0xe6, 0xdb, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0x54, 0xe6, 0x7d, 0x82, 0x8a, 0x1a, 0x00, 0x00,
+	// 2286 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0x34, 0x25, 0x3e, 0x8a, 0x22, 0x35, 0x76, 0x6c, 0x4a, 0x8e, 0x25, 0x99, 0x71,
+	0x12, 0xc5, 0xff, 0x98, 0x8a, 0xfc, 0x2f, 0x5c, 0x40, 0x08, 0x62, 0x88, 0xa2, 0x64, 0x33, 0xb1,
+	0x25, 0x75, 0x29, 0xa9, 0x1f, 0x68, 0xb3, 0x18, 0xee, 0x0e, 0xa9, 0x8d, 0x96, 0x3b, 0xf4, 0xce,
+	0x52, 0x36, 0x7b, 0xee, 0x21, 0x70, 0x51, 0x20, 0xa7, 0x22, 0x17, 0x23, 0x06, 0x7a, 0x69, 0x6f,
+	0x39, 0x18, 0xbd, 0xf7, 0x96, 0x06, 0x28, 0x60, 0xf8, 0xd2, 0x22, 0x40, 0xdd, 0xc2, 0x3e, 0x24,
+	0x68, 0x2f, 0x6d, 0x4f, 0x3d, 0x16, 0xf3, 0xb1, 0x1f, 0x94, 0x44, 0x4b, 0xb2, 0x7d, 0x08, 0xda,
+	0x0b, 0xb1, 0x33, 0xef, 0xbd, 0xdf, 0xcc, 0x7b, 0xf3, 0x3e, 0x66, 0x1e, 0xe1, 0x82, 0x49, 0x59,
+	0x9b, 0xb2, 0x39, 0xe6, 0xe3, 0x1d, 0xdb, 0x6d, 0xcd, 0xed, 0xce, 0x37, 0x88, 0x8f, 0xe7, 0x83,
+	0x71, 0xb9, 0xe3, 0x51, 0x9f, 0xa2, 0xd3, 0x92, 0xab, 0x1c, 0xcc, 0x2a, 0xae, 0xc9, 0x71, 0xdc,
+	0xb6, 0x5d, 0x3a, 0x27, 0x7e, 0x25, 0xeb, 0xe4, 0xab, 0x26, 0x6d, 0x13, 0xbf, 0xd1, 0xf4, 0xe7,
+	0x70, 0xc3, 0xb4, 0xe7, 0x76, 0xe7, 0xe7, 0xfc, 0x5e, 0x87, 0x30, 0x45, 0x3d, 0x17, 0x52, 0xc5,
+	0xec, 0x5e, 0xf2, 0x94, 0xda, 0x4d, 0x03, 0x33, 0x12, 0x6e, 0xc5, 0xa4, 0xb6, 0xab, 0xe8, 0x13,
+	0x92, 0x6e, 0x88, 0xd1, 0x9c, 0xda, 0x94, 0x24, 0x9d, 0x6a, 0xd1, 0x16, 0x95, 0xf3, 0xfc, 0x2b,
+	0x10, 0x68, 0x51, 0xda, 0x72, 0xc8, 0x9c, 0x18, 0x35, 0xba, 0xcd, 0x39, 0xec, 0xf6, 0x82, 0xb5,
+	0xf6, 0x92, 0xac, 0xae, 0x87, 0x7d, 0x9b, 0x06, 0x6b, 0x4d, 0xef, 0xa5, 0xfb, 0x76, 0x9b, 0x30,
+	0x1f, 0xb7, 0x3b, 0x92, 0xa1, 0xf4, 0xa9, 0x06, 0x63, 0xd7, 0x6d, 0xe6, 0x53, 0xcf, 0x36, 0xb1,
+	0x53, 0x73, 0x9b, 0x14, 0xbd, 0x0b, 0xe9, 0x6d, 0x82, 0x2d, 0xe2, 0x15, 0xb5, 0x19, 0x6d, 0x36,
+	0x7b, 0x79, 0xa2, 0x1c, 0xe8, 0x5b, 0x96, 0x6a, 0xee, 0xce, 0x97, 0xaf, 0x0b, 0x86, 0x4a, 0xe6,
+	0x8b, 0xc7, 0xd3, 0x43, 0xbf, 0xfe, 0xfa, 0xf3, 0x8b, 0x9a, 0xae, 0x64, 0x50, 0x15, 0xd2, 0xbb,
+	0xd8, 0x61, 0xc4, 0x2f, 0x26, 0x66, 0x92, 0xb3, 0xd9, 0xcb, 0xe7, 0xcb, 0x07, 0x9b, 0xbd, 0xbc,
+	0x85, 0x1d, 0xdb, 0xc2, 0x3e, 0xed, 0x47, 0x91, 0xb2, 0x0b, 0x89, 0xa2, 0x56, 0xfa, 0x65, 0x02,
+	0xf2, 0x4b, 0xb4, 0xdd, 0xb6, 0x19, 0xb3, 0xa9, 0xab, 0x63, 0x9f, 0x30, 0xf4, 0x3e, 0xa4, 0x3c,
+	0xec, 0x13, 0xb1, 0xb3, 0x4c, 0xe5, 0x0a, 0x17, 0xfc, 0xea, 0xf1, 0xf4, 0x59, 0xb9, 0x04, 0xb3,
+	0x76, 0xca, 0x36, 0x9d, 0x6b, 0x63, 0x7f, 0xbb, 0x7c, 0x83, 0xb4, 0xb0, 0xd9, 0xab, 0x12, 0xf3,
+	0xd1, 0x83, 0x4b, 0xa0, 0x76, 0x50, 0x25, 0xa6, 0x5c, 0x45, 0x60, 0xa0, 0xef, 0xc1, 0x48, 0x1b,
+	0xdf, 0x31, 0x04, 0x5e, 0xe2, 0x85, 0xf0, 0x86, 0xdb, 0xf8, 0x0e, 0xdf, 0x1f, 0xfa, 0x10, 0xf2,
+	0x1c, 0xd2, 0xdc, 0xc6, 0x6e, 0x8b, 0x48, 0xe4, 0xe4, 0x0b, 0x21, 0xe7, 0xda, 0xf8, 0xce, 0x92,
+	0x40, 0xe3, 0xf8, 0x0b, 0xa9, 0x6f, 0xee, 0x4f, 0x6b, 0xa5, 0xdf, 0x69, 0x00, 0x91, 0x61, 0x10,
+	0x86, 0x82, 0x19, 0x8e, 0xc4, 0xa2, 0x4c, 0x9d, 0xdc, 0x9b, 0x83, 0x6c, 0xbf, 0xc7, 0xac, 0x95,
+	0x1c, 0xdf, 0xde, 0xc3, 0xc7, 0xd3, 0x9a, 0x5c, 0x35, 0x6f, 0xee, 0x33, 0x7b, 0xb6, 0xdb, 0xb1,
+	0xb0, 0x4f, 0x0c, 0xee, 0x3f, 0xc2, 0x5a, 0xd9, 0xcb, 0x93, 0x65, 0xe9, 0x5c, 0xe5, 0xc0, 0xb9,
+	0xca, 0x1b, 0x81, 0x73, 0x49, 0xc0, 0x4f, 0xfe, 0x12, 0x00, 0x82, 0x94, 0xe6, 0x74, 0xa5, 0xc3,
+	0x3f, 0x35, 0xc8, 0x56, 0x09, 0x33, 0x3d, 0xbb, 0xc3, 0xdd, 0x15, 0x15, 0x61, 0xb8, 0x4d, 0x5d,
+	0x7b, 0x47, 0x79, 0x5d, 0x46, 0x0f, 0x86, 0x68, 0x12, 0x46, 0x6c, 0x8b, 0xb8, 0xbe, 0xed, 0xf7,
+	0xe4, 0x31, 0xe9, 0xe1, 0x98, 0x4b, 0xdd, 0x26, 0x0d, 0x66, 0x07, 0x76, 0xd6, 0x83, 0x21, 0x7a,
+	0x0b, 0x0a, 0x8c, 0x98, 0x5d, 0xcf, 0xf6, 0x7b, 0x86, 0x49, 0x5d, 0x1f, 0x9b, 0x7e, 0x31, 0x25,
+	0x58, 0xf2, 0xc1, 0xfc, 0x92, 0x9c, 0xe6, 0x20, 0x16, 0xf1, 0xb1, 0xed, 0xb0, 0xe2, 0x09, 0x09,
+	0xa2, 0x86, 0xe8, 0x1a, 0x8c, 0xb4, 0x89, 0x8f, 0x2d, 0xec, 0xe3, 0x62, 0x5a, 0xe8, 0x3c, 0x33,
+	0xc8, 0xa2, 0x37, 0x15, 0x9f, 0x70, 0x66, 0xa1, 0xf5, 0x90, 0x1e, 0x0a, 0x2b, 0x9d, 0x9b, 0x30,
+	0x12, 0xb0, 0xa1, 0x37, 0x20, 0xdf, 0xf1, 0x68, 0xd3, 0x76, 0x88, 0xd1, 0xb1, 0x4d, 0xa3, 0xeb,
+	0xd9, 0x4a, 0xef, 0x9c, 0x9a, 0x5e, 0xb7, 0xcd, 0x4d, 0xcf, 0x46, 0x6f, 0x03, 0x62, 0xd4, 0xb4,
+	0xb1, 0x63, 0x6c, 0x63, 0xd7, 0x72, 0x08, 0xe7, 0x64, 0x22, 0xb4, 0x32, 0x7a, 0x41, 0x52, 0xae,
+	0x0b, 0xc2, 0xa6, 0x67, 0x33, 0xb5, 0xce, 0xbd, 0x61, 0xc8, 0x84, 0xd1, 0x85, 0x96, 0xa0, 0x40,
+	0x3b, 0xc4, 0xe3, 0xdf, 0x06, 0xb6, 0x2c, 0x8f, 0x30, 0xa6, 0xc2, 0xa7, 0xf8, 0xe8, 0xc1, 0xa5,
+	0x53, 0x4a, 0x9f, 0x45, 0x49, 0xa9, 0xfb, 0x9e, 0xed, 0xb6, 0xf4, 0x7c, 0x20, 0xa1, 0xa6, 0xd1,
+	0x0f, 0xb9, 0x8f, 0xb9, 0x8c, 0xb8, 0xac, 0xcb, 0x8c, 0x4e, 0xb7, 0xb1, 0x43, 0x7a, 0xca, 0x0b,
+	0x4e, 0xed, 0xf3, 0x82, 0x45, 0xb7, 0x57, 0x29, 0x7e, 0x19, 0x41, 0x9b, 0x5e, 0xaf, 0xe3, 0xd3,
+	0xf2, 0x7a, 0xb7, 0xf1, 0x01, 0xe9, 0xe9, 0xf9, 0x10, 0x67, 0x5d, 0xc0, 0xa0, 0xd3, 0x90, 0xfe,
+	0x08, 0xdb, 0x0e, 0xb1, 0xc4, 0x11, 0x8e, 0xe8, 0x6a, 0x84, 0x16, 0x20, 0xcd, 0x7c, 0xec, 0x77,
+	0x99, 0x38, 0xb7, 0xb1, 0xcb, 0xa5, 0x41, 0xa6, 0xaf, 0x50, 0xd7, 0xaa, 0x0b, 0x4e, 0x5d, 0x49,
+	0xa0, 0x25, 0x48, 0xfb, 0x74, 0x87, 0xb8, 0xea, 0x44, 0x2b, 0xff, 0xa7, 0xc2, 0xef, 0x95, 0xfd,
+	0xe1, 0x57, 0x73, 0xfd, 0x58, 0xe0, 0xd5, 0x5c, 0x5f, 0x57, 0xa2, 0xe8, 0xc7, 0x50, 0xb0, 0x88,
+	0x43, 0x5a, 0xc2, 0x72, 0x6c, 0x1b, 0x7b, 0x84, 0x09, 0x2f, 0xc8, 0x54, 0xe6, 0x8f, 0x1d, 0xcd,
+	0x7a, 0x3e, 0x84, 0xaa, 0x0b, 0x24, 0xb4, 0x0e, 0x59, 0x2b, 0xf2, 0xff, 0xe2, 0xb0, 0x30, 0xe6,
+	0x6b, 0x83, 0x74, 0x8c, 0x85, 0x4a, 0x3c, 0x5d, 0xc6, 0x21, 0xb8, 0xcb, 0x77, 0xdd, 0x06, 0x75,
+	0x2d, 0xdb, 0x6d, 0x19, 0xdb, 0xc4, 0x6e, 0x6d, 0xfb, 0xc5, 0x91, 0x19, 0x6d, 0x36, 0xa9, 0xe7,
+	0xc3, 0xf9, 0xeb, 0x62, 0x1a, 0xad, 0xc3, 0x58, 0xc4, 0x2a, 0x42, 0x3a, 0x73, 0xdc, 0x90, 0xce,
+	0x85, 0x00, 0x9c, 0x05, 0xdd, 0x04, 0x88, 0x92, 0x46, 0x11, 0x04, 0x5a, 0xe9, 0xf0, 0xf4, 0x13,
+	0x57, 0x26, 0x06, 0x80, 0x5c, 0x38, 0xd9, 0xb6, 0x5d, 0x83, 0x11, 0xa7, 0x69, 0x28, 0xcb, 0x71,
+	0xdc, 0xac, 0x30, 0xff, 0x7b, 0xc7, 0x38, 0xcd, 0xaf, 0x1e, 0x5c, 0xca, 0xcb, 0xd1, 0x25, 0x66,
+	0xed, 0xcc, 0xbc, 0x53, 0xfe, 0xce, 0x77, 0xf5, 0xf1, 0xb6, 0xed, 0xd6, 0x89, 0xd3, 0xac, 0x86,
+	0xc0, 0xe8, 0x5d, 0x38, 0x1b, 0x19, 0x84, 0xba, 0xc6, 0x36, 0x75, 0x2c, 0xc3, 0x23, 0x4d, 0xc3,
+	0xa4, 0x5d, 0xd7, 0x2f, 0x8e, 0x0a, 0x33, 0x9e, 0x09, 0x59, 0xd6, 0xdc, 0xeb, 0xd4, 0xb1, 0x74,
+	0xd2, 0x5c, 0xe2, 0x64, 0xf4, 0x1a, 0x44, 0xd6, 0x30, 0x6c, 0x8b, 0x15, 0x73, 0x33, 0xc9, 0xd9,
+	0x94, 0x3e, 0x1a, 0x4e, 0xd6, 0x2c, 0xb6, 0x30, 0xf2, 0xf1, 0xfd, 0xe9, 0xa1, 0x6f, 0xee, 0x4f,
+	0x0f, 0x95, 0x56, 0x60, 0x74, 0x0b, 0x3b, 0x2a, 0xb4, 0x08, 0x43, 0x57, 0x20, 0x83, 0x83, 0x41,
+	0x51, 0x9b, 0x49, 0x3e, 0x33, 0x34, 0x23, 0xd6, 0xd2, 0x6f, 0x34, 0x48, 0x57, 0xb7, 0xd6, 0xb1,
+	0xed, 0xa1, 0x65, 0x18, 0x8f, 0x7c, 0xf5, 0xa8, 0x51, 0x1e, 0xb9, 0x77, 0x10, 0xe6, 0xab, 0x30,
+	0xbe, 0x1b, 0x24, 0x8e, 0x10, 0x46, 0xd6, 0xc6, 0xf3, 0x8f, 0x1e, 0x5c, 0x3a, 0xa7, 0x60, 0xc2,
+	0xe4, 0xb2, 0x07, 0x6f, 0x77, 0xcf, 0x7c, 0x4c, 0xe7, 0xf7, 0x61, 0x58, 0x6e, 0x95, 0xa1, 0xab,
+	0x70, 0xa2, 0xc3, 0x3f, 0x84, 0xaa, 0xd9, 0xcb, 0x53, 0x03, 0x7d, 0x5e, 0xf0, 0xc7, 0x3d, 0x44,
+	0xca, 0x95, 0x7e, 0x9e, 0x00, 0xa8, 0x6e, 0x6d, 0x6d, 0x78, 0x76, 0xc7, 0x21, 0xfe, 0xcb, 0xd2,
+	0x7d, 0x13, 0x5e, 0x89, 0x74, 0x67, 0x9e, 0x79, 0x7c, 0xfd, 0x4f, 0x86, 0xf2, 0x75, 0xcf, 0x3c,
+	0x10, 0xd6, 0x62, 0x7e, 0x08, 0x9b, 0x3c, 0x3e, 0x6c, 0x95, 0xf9, 0xfb, 0x2d, 0xfb, 0x03, 0xc8,
+	0x46, 0xc6, 0x60, 0xa8, 0x06, 0x23, 0xbe, 0xfa, 0x56, 0x06, 0x2e, 0x0d, 0x36, 0x70, 0x20, 0x16,
+	0x37, 0x72, 0x28, 0x5e, 0xfa, 0xb7, 0x06, 0x10, 0x8b, 0x91, 0x6f, 0xa7, 0x8f, 0xa1, 0x1a, 0xa4,
+	0x55, 0x72, 0x4e, 0x3e, 0x6f, 0x72, 0x56, 0x00, 0x31, 0xa3, 0xfe, 0x22, 0x01, 0x27, 0x37, 0x83,
+	0xe8, 0xfd, 0xf6, 0xdb, 0x60, 0x13, 0x86, 0x89, 0xeb, 0x7b, 0xb6, 0x30, 0x02, 0x3f, 0xf3, 0x77,
+	0x06, 0x9d, 0xf9, 0x01, 0x4a, 0x2d, 0xbb, 0xbe, 0xd7, 0x8b, 0x7b, 0x40, 0x80, 0x15, 0xb3, 0xc7,
+	0x67, 0x49, 0x28, 0x0e, 0x12, 0x45, 0x6f, 0x42, 0xde, 0xf4, 0x88, 0x98, 0x08, 0xea, 0x8e, 0x26,
+	0x12, 0xe6, 0x58, 0x30, 0xad, 0xca, 0x8e, 0x0e, 0xfc, 0x66, 0xc9, 0x9d, 0x8b, 0xb3, 0x3e, 0xdf,
+	0x55, 0x72, 0x2c, 0x42, 0x10, 0x85, 0x67, 0x03, 0xf2, 0xb6, 0x6b, 0xfb, 0xfc, 0x86, 0xd4, 0xc0,
+	0x0e, 0x76, 0xcd, 0xe0, 0xca, 0x7d, 0xac, 0x9a, 0x3f, 0xa6, 0x30, 0x2a, 0x12, 0x02, 0x2d, 0xc3,
+	0x70, 0x80, 0x96, 0x3a, 0x3e, 0x5a, 0x20, 0x8b, 0x5e, 0x87, 0xd1, 0x78, 0x61, 0x10, 0xb7, 0x91,
+	0x54, 0x25, 0x51, 0xd4, 0xf4, 0x6c, 0xac, 0x36, 0x1c, 0x56, 0x7d, 0xd2, 0xcf, 0xac, 0x3e, 0xea,
+	0xd2, 0xf7, 0x59, 0x12, 0xc6, 0x75, 0x62, 0xfd, 0xf7, 0x1f, 0xcd, 0x3a, 0x80, 0x0c, 0x57, 0x9e,
+	0x4d, 0x8b, 0xa9, 0xe7, 0x8d, 0xf9, 0x8c, 0x04, 0xa9, 0x32, 0x1f, 0x9d, 0x3f, 0xe8, 0x94, 0x5e,
+	0xfe, 0x09, 0xfd, 0x39, 0x01, 0xa3, 0xf1, 0x13, 0xfa, 0x9f, 0x2c, 0x5c, 0x68, 0x35, 0x4a, 0x55,
+	0x29, 0x91, 0xaa, 0xde, 0x1a, 0x94, 0xaa, 0xf6, 0x79, 0xf3, 0x21, 0x39, 0xea, 0xcb, 0x13, 0x90,
+	0x5e, 0xc7, 0x1e, 0x6e, 0x33, 0xb4, 0xb6, 0xef, 0x7e, 0x1b, 0xb4, 0x32, 0xf6, 0x3a, 0x73, 0x55,
+	0xf5, 0x4b, 0xa4, 0x2f, 0x7f, 0x3a, 0xe8, 0x7a, 0xfb, 0x3a, 0x8c, 0xf1, 0x87, 0x7d, 0xa8, 0x90,
+	0x34, 0x6e, 0x4e, 0xbc, 0xcf, 0x43, 0xed, 0x19, 0x9a, 0x86, 0x2c, 0x67, 0x8b, 0x72, 0x31, 0xe7,
+	0x81, 0x36, 0xbe, 0xb3, 0x2c, 0x67, 0xd0, 0x3c, 0xa0, 0xed, 0xb0, 0xdb, 0x62, 0x44, 0x86, 0xd0,
+	0x66, 0x73, 0x22, 0x2d, 0x8c, 0x47, 0xd4, 0x40, 0xe4, 0x1c, 0x00, 0xdf, 0x89, 0x61, 0x11, 0x97,
+	0xb6, 0xd5, 0x0b, 0x35, 0xc3, 0x67, 0xaa, 0x7c, 0x02, 0xfd, 0x4c, 0x93, 0x57, 0xe5, 0x3d, 0x2d,
+	0x00, 0xf5, 0x52, 0xd9, 0x38, 0x42, 0x60, 0xfc, 0xeb, 0xf1, 0xf4, 0x64, 0x0f, 0xb7, 0x9d, 0x85,
+	0xd2, 0x01, 0x38, 0xa5, 0x83, 0xba, 0x12, 0xfc, 0x02, 0xdd, 0xdf, 0x42, 0x40, 0x35, 0x28, 0xec,
+	0x90, 0x9e, 0xe1, 0x51, 0x5f, 0x26, 0x9b, 0x26, 0x21, 0xc5, 0xe1, 0xb0, 0x7d, 0x24, 0xc4, 0x79,
+	0x3f, 0x2c, 0xf6, 0x04, 0xb0, 0xdd, 0x4a, 0x8a, 0xef, 0x4e, 0x1f, 0xdb, 0x21, 0x3d, 0x5d, 0xc9,
+	0xad, 0x10, 0x82, 0x6e, 0xc1, 0x44, 0xcb, 0xa1, 0x0d, 0xec, 0x18, 0x8e, 0x7d, 0xab, 0x6b, 0x5b,
+	0x86, 0x72, 0x0c, 0xc3, 0xc4, 0x9d, 0xe2, 0xc8, 0x0b, 0xb5, 0x53, 0x4e, 0x4b, 0xe0, 0x1b, 0x02,
+	0xb7, 0x2e, 0x61, 0x97, 0x70, 0x07, 0x7d, 0x14, 0xf7, 0x75, 0x61, 0xed, 0x26, 0x36, 0x7d, 0xea,
+	0x15, 0x33, 0x2f, 0xb4, 0x5c, 0x14, 0x00, 0xfc, 0x8d, 0xba, 0x22, 0x20, 0x17, 0x2e, 0xf0, 0x64,
+	0x70, 0xf7, 0xeb, 0xcf, 0x2f, 0x9e, 0x8d, 0xde, 0x25, 0x73, 0x77, 0xc2, 0xde, 0xa5, 0xf4, 0x60,
+	0x7e, 0xb7, 0x47, 0x51, 0x9d, 0xd5, 0x09, 0xeb, 0x50, 0x97, 0x89, 0x67, 0x56, 0xec, 0x39, 0xa4,
+	0x3d, 0xfb, 0x99, 0x15, 0xc9, 0xf7, 0x3d, 0xb3, 0x62, 0x19, 0xe8, 0xbd, 0xa8, 0xcc, 0x25, 0x0e,
+	0x3b, 0xac, 0x78, 0xf0, 0x29, 0x21, 0x91, 0xd8, 0x86, 0x4a, 0x7f, 0xd0, 0x60, 0x62, 0x5f, 0xb0,
+	0x86, 0x5b, 0x36, 0x01, 0x79, 0x31, 0xa2, 0x70, 0xfa, 0x9e, 0xda, 0xfa, 0xf3, 0xc5, 0xfe, 0xb8,
+	0xb7, 0x97, 0xfa, 0x92, 0xea, 0xb5, 0x4a, 0xd4, 0xbf, 0xd7, 0xe0, 0x54, 0x7c, 0x03, 0xa1, 0x2a,
+	0x75, 0x18, 0x8d, 0x2f, 0xad, 0x94, 0xb8, 0x70, 0x14, 0x25, 0xe2, 0xfb, 0xef, 0x03, 0x41, 0x5b,
+	0x51, 0x42, 0x94, 0x1d, 0xd3, 0xf9, 0x23, 0x1b, 0x25, 0xd8, 0xd8, 0x81, 0x89, 0x51, 0x9e, 0xcd,
+	0xdf, 0x35, 0x48, 0xad, 0x53, 0xea, 0xa0, 0x5b, 0x30, 0xee, 0x52, 0x5f, 0x38, 0x37, 0xb1, 0x0c,
+	0xd5, 0x1d, 0x91, 0xc5, 0x66, 0xf9, 0x99, 0xb6, 0xfa, 0xdb, 0xe3, 0xe9, 0xfd, 0x92, 0xfd, 0x06,
+	0x54, 0x5d, 0x43, 0x97, 0xfa, 0x15, 0xc1, 0xb4, 0x21, 0x78, 0x50, 0x13, 0x72, 0xfd, 0xcb, 0xc9,
+	0x82, 0xb4, 0x78, 0xd8, 0x72, 0xb9, 0x43, 0x97, 0x1a, 0x6d, 0xc4, 0xd6, 0x59, 0x18, 0xe1, 0xa7,
+	0xf6, 0x0f, 0x7e, 0x72, 0x1f, 0x42, 0x21, 0xcc, 0xc6, 0x9b, 0xa2, 0xe5, 0xc8, 0xd0, 0x0a, 0x0c,
+	0xcb, 0xee, 0x63, 0xf0, 0x1e, 0x3a, 0x1f, 0xf5, 0xb3, 0x79, 0x77, 0x9f, 0xb7, 0xb3, 0xf7, 0x08,
+	0xf5, 0xd9, 0x53, 0x09, 0x8b, 0x96, 0xf4, 0xc3, 0x04, 0x4c, 0x2c, 0x51, 0x97, 0xa9, 0x5e, 0x96,
+	0x4a, 0x5a, 0xb2, 0x7f, 0xde, 0xe3, 0x0d, 0x98, 0x03, 0x3b, 0x6d, 0xa3, 0xfb, 0xfb, 0x69, 0x5b,
+	0x90, 0xe7, 0x37, 0x08, 0x93, 0xba, 0x2f, 0xd8, 0x4e, 0xcb, 0x51, 0xc7, 0x52, 0x3b, 0xe2, 0xcd,
+	0xb4, 0x2d, 0xc8, 0xbb, 0xe4, 0x76, 0x1f, 0x6e, 0xf2, 0xf9, 0x70, 0x5d, 0x72, 0x3b, 0x86, 0x7b,
+	0x9a, 0xff, 0x27, 0x20, 0xae, 0x8f, 0x29, 0x71, 0x39, 0x52, 0x23, 0x74, 0x05, 0x92, 0x3c, 0xd3,
+	0x9f, 0x38, 0x46, 0xf2, 0xe0, 0x02, 0xb1, 0xaa, 0x5d, 0x87, 0x09, 0xd5, 0x0c, 0x61, 0x6b, 0x4d,
+	0x61, 0x51, 0x22, 0x14, 0xfa, 0x80, 0xf4, 0x0e, 0xe8, 0x8c, 0x8c, 0x1e, 0xad, 0x33, 0xf2, 0x47,
+	0x0d, 0x4e, 0x0a, 0xe7, 0xb0, 0x7f, 0x4a, 0x44, 0xbf, 0x4d, 0x27, 0x26, 0xf5, 0x2c, 0x34, 0x06,
+	0x09, 0xdb, 0x12, 0x67, 0x92, 0xd2, 0x13, 0xb6, 0x85, 0xca, 0x70, 0x82, 0xde, 0x76, 0x89, 0xa7,
+	0x3c, 0x73, 0x30, 0xb6, 0x64, 0x43, 0x57, 0x61, 0xac, 0x4d, 0xad, 0xae, 0x43, 0x0c, 0x6c, 0xca,
+	0x9b, 0x5f, 0xf2, 0x10, 0xc1, 0x9c, 0xe4, 0x5f, 0x94, 0xec, 0xe8, 0x2a, 0x64, 0xc2, 0x9a, 0x50,
+	0x4c, 0x1d, 0xf5, 0x22, 0x15, 0xc9, 0x5c, 0xfc, 0xad, 0x06, 0x10, 0x35, 0x3c, 0xd1, 0xdb, 0x70,
+	0xa6, 0xb2, 0xb6, 0x5a, 0x35, 0xea, 0x1b, 0x8b, 0x1b, 0x9b, 0x75, 0x63, 0x73, 0xb5, 0xbe, 0xbe,
+	0xbc, 0x54, 0x5b, 0xa9, 0x2d, 0x57, 0x0b, 0x43, 0x93, 0xf9, 0xbb, 0xf7, 0x66, 0xb2, 0x9b, 0x2e,
+	0xeb, 0x10, 0xd3, 0x6e, 0xda, 0xc4, 0x42, 0x6f, 0xc0, 0xa9, 0x7e, 0x6e, 0x3e, 0x5a, 0xae, 0x16,
+	0xb4, 0xc9, 0xd1, 0xbb, 0xf7, 0x66, 0x46, 0xe4, 0x03, 0x8f, 0x58, 0x68, 0x16, 0x5e, 0xd9, 0xcf,
+	0x57, 0x5b, 0xbd, 0x56, 0x48, 0x4c, 0xe6, 0xee, 0xde, 0x9b, 0xc9, 0x84, 0x2f, 0x41, 0x54, 0x02,
+	0x14, 0xe7, 0x54, 0x78, 0xc9, 0x49, 0xb8, 0x7b, 0x6f, 0x26, 0x2d, 0x93, 0xc1, 0x64, 0xea, 0xe3,
+	0x5f, 0x4d, 0x0d, 0x5d, 0xfc, 0x09, 0x40, 0xcd, 0x6d, 0x7a, 0xd8, 0x14, 0x49, 0x6f, 0x12, 0x4e,
+	0xd7, 0x56, 0x57, 0xf4, 0xc5, 0xa5, 0x8d, 0xda, 0xda, 0x6a, 0xff, 0xb6, 0xf7, 0xd0, 0xaa, 0x6b,
+	0x9b, 0x95, 0x1b, 0xcb, 0x46, 0xbd, 0x76, 0x6d, 0xb5, 0xa0, 0xa1, 0x33, 0x70, 0xb2, 0x8f, 0xf6,
+	0xfd, 0xd5, 0x8d, 0xda, 0xcd, 0xe5, 0x42, 0xa2, 0x72, 0xe5, 0x8b, 0x27, 0x53, 0xda, 0xc3, 0x27,
+	0x53, 0xda, 0x5f, 0x9f, 0x4c, 0x69, 0x9f, 0x3c, 0x9d, 0x1a, 0x7a, 0xf8, 0x74, 0x6a, 0xe8, 0x4f,
+	0x4f, 0xa7, 0x86, 0x7e, 0xf4, 0x6a, 0x5f, 0x9a, 0x89, 0x0a, 0xad, 0xf8, 0x2f, 0xab, 0x91, 0x16,
+	0xf1, 0xf0, 0xff, 0xff, 0x19, 0x00, 0x4f, 0xd1, 0xd0, 0x84, 0x43, 0x1c, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1594,750 +1685,820 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11885 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x94, 0x1c, 0x57,
-		0x5a, 0x98, 0xaa, 0xbb, 0xa7, 0xa7, 0xfb, 0xeb, 0x57, 0xcd, 0x9d, 0x91, 0x34, 0x1a, 0xd9, 0x9a,
-		0x51, 0xc9, 0xb6, 0x64, 0xd9, 0x1e, 0x59, 0xb2, 0x2d, 0xdb, 0xed, 0x17, 0xdd, 0x33, 0xad, 0x51,
-		0xcb, 0xf3, 0xda, 0xea, 0x1e, 0xad, 0x6d, 0x1e, 0x45, 0x4d, 0xf5, 0x9d, 0x99, 0xb2, 0xba, 0xab,
-		0x7a, 0xab, 0xaa, 0x25, 0x8d, 0x73, 0x4e, 0xce, 0x12, 0x58, 0xb2, 0x88, 0x47, 0x96, 0x40, 0x60,
-		0xd9, 0x5d, 0x2d, 0x06, 0x02, 0xbb, 0x0b, 0x81, 0x40, 0x76, 0x21, 0x6c, 0x38, 0x79, 0x90, 0x93,
-		0x10, 0x20, 0x27, 0x64, 0xc3, 0x8f, 0xc0, 0xe1, 0x1c, 0x1c, 0xd8, 0xe5, 0x1c, 0x36, 0xec, 0x42,
-		0x80, 0x2c, 0x1c, 0x4e, 0xf6, 0x24, 0x27, 0xe7, 0xbe, 0xea, 0xd1, 0x5d, 0x3d, 0xdd, 0x23, 0xdb,
-		0x40, 0x20, 0x7f, 0xa4, 0xa9, 0x7b, 0xbf, 0xef, 0xbb, 0xf7, 0x7e, 0xf7, 0xbb, 0xdf, 0xeb, 0x3e,
-		0x1a, 0x7e, 0xf7, 0x0a, 0x2c, 0xec, 0xda, 0xf6, 0x6e, 0x1b, 0x5f, 0xe8, 0x3a, 0xb6, 0x67, 0x6f,
-		0xf7, 0x76, 0x2e, 0xb4, 0xb0, 0x6b, 0x38, 0x66, 0xd7, 0xb3, 0x9d, 0x45, 0x5a, 0x86, 0x4a, 0x0c,
-		0x62, 0x51, 0x40, 0x28, 0x6b, 0x30, 0x75, 0xc5, 0x6c, 0xe3, 0x65, 0x1f, 0xb0, 0x81, 0x3d, 0xf4,
-		0x0c, 0xa4, 0x76, 0xcc, 0x36, 0x9e, 0x95, 0x16, 0x92, 0xe7, 0x72, 0x97, 0x1e, 0x58, 0xec, 0x43,
-		0x5a, 0x8c, 0x62, 0x6c, 0x92, 0x62, 0x95, 0x62, 0x28, 0xff, 0x27, 0x05, 0xd3, 0x31, 0xb5, 0x08,
-		0x41, 0xca, 0xd2, 0x3b, 0x84, 0xa2, 0x74, 0x2e, 0xab, 0xd2, 0xbf, 0xd1, 0x2c, 0x4c, 0x76, 0x75,
-		0xe3, 0x86, 0xbe, 0x8b, 0x67, 0x13, 0xb4, 0x58, 0x7c, 0xa2, 0x53, 0x00, 0x2d, 0xdc, 0xc5, 0x56,
-		0x0b, 0x5b, 0xc6, 0xfe, 0x6c, 0x72, 0x21, 0x79, 0x2e, 0xab, 0x86, 0x4a, 0xd0, 0x23, 0x30, 0xd5,
-		0xed, 0x6d, 0xb7, 0x4d, 0x43, 0x0b, 0x81, 0xc1, 0x42, 0xf2, 0xdc, 0x84, 0x2a, 0xb3, 0x8a, 0xe5,
-		0x00, 0xf8, 0x2c, 0x94, 0x6e, 0x61, 0xfd, 0x46, 0x18, 0x34, 0x47, 0x41, 0x8b, 0xa4, 0x38, 0x04,
-		0xb8, 0x04, 0xf9, 0x0e, 0x76, 0x5d, 0x7d, 0x17, 0x6b, 0xde, 0x7e, 0x17, 0xcf, 0xa6, 0xe8, 0xe8,
-		0x17, 0x06, 0x46, 0xdf, 0x3f, 0xf2, 0x1c, 0xc7, 0x6a, 0xee, 0x77, 0x31, 0xaa, 0x40, 0x16, 0x5b,
-		0xbd, 0x0e, 0xa3, 0x30, 0x31, 0x84, 0x7f, 0x35, 0xab, 0xd7, 0xe9, 0xa7, 0x92, 0x21, 0x68, 0x9c,
-		0xc4, 0xa4, 0x8b, 0x9d, 0x9b, 0xa6, 0x81, 0x67, 0xd3, 0x94, 0xc0, 0xd9, 0x01, 0x02, 0x0d, 0x56,
-		0xdf, 0x4f, 0x43, 0xe0, 0xa1, 0x25, 0xc8, 0xe2, 0xdb, 0x1e, 0xb6, 0x5c, 0xd3, 0xb6, 0x66, 0x27,
-		0x29, 0x91, 0x07, 0x63, 0x66, 0x11, 0xb7, 0x5b, 0xfd, 0x24, 0x02, 0x3c, 0x74, 0x19, 0x26, 0xed,
-		0xae, 0x67, 0xda, 0x96, 0x3b, 0x9b, 0x59, 0x90, 0xce, 0xe5, 0x2e, 0xdd, 0x17, 0x2b, 0x08, 0x1b,
-		0x0c, 0x46, 0x15, 0xc0, 0xa8, 0x0e, 0xb2, 0x6b, 0xf7, 0x1c, 0x03, 0x6b, 0x86, 0xdd, 0xc2, 0x9a,
-		0x69, 0xed, 0xd8, 0xb3, 0x59, 0x4a, 0x60, 0x7e, 0x70, 0x20, 0x14, 0x70, 0xc9, 0x6e, 0xe1, 0xba,
-		0xb5, 0x63, 0xab, 0x45, 0x37, 0xf2, 0x8d, 0x8e, 0x41, 0xda, 0xdd, 0xb7, 0x3c, 0xfd, 0xf6, 0x6c,
-		0x9e, 0x4a, 0x08, 0xff, 0x22, 0xa2, 0x83, 0x5b, 0x26, 0x69, 0x6e, 0xb6, 0xc0, 0x44, 0x87, 0x7f,
-		0x2a, 0x9f, 0x4d, 0x43, 0x69, 0x1c, 0xe1, 0x7b, 0x0e, 0x26, 0x76, 0xc8, 0xf8, 0x67, 0x13, 0x87,
-		0xe1, 0x0e, 0xc3, 0x89, 0xb2, 0x37, 0x7d, 0x8f, 0xec, 0xad, 0x40, 0xce, 0xc2, 0xae, 0x87, 0x5b,
-		0x4c, 0x56, 0x92, 0x63, 0x4a, 0x1b, 0x30, 0xa4, 0x41, 0x61, 0x4b, 0xdd, 0x93, 0xb0, 0xbd, 0x02,
-		0x25, 0xbf, 0x4b, 0x9a, 0xa3, 0x5b, 0xbb, 0x42, 0x6a, 0x2f, 0x8c, 0xea, 0xc9, 0x62, 0x4d, 0xe0,
-		0xa9, 0x04, 0x4d, 0x2d, 0xe2, 0xc8, 0x37, 0x5a, 0x06, 0xb0, 0x2d, 0x6c, 0xef, 0x68, 0x2d, 0x6c,
-		0xb4, 0x67, 0x33, 0x43, 0xb8, 0xb4, 0x41, 0x40, 0x06, 0xb8, 0x64, 0xb3, 0x52, 0xa3, 0x8d, 0x9e,
-		0x0d, 0x84, 0x70, 0x72, 0x88, 0x0c, 0xad, 0xb1, 0xe5, 0x37, 0x20, 0x87, 0x5b, 0x50, 0x74, 0x30,
-		0x59, 0x11, 0xb8, 0xc5, 0x47, 0x96, 0xa5, 0x9d, 0x58, 0x1c, 0x39, 0x32, 0x95, 0xa3, 0xb1, 0x81,
-		0x15, 0x9c, 0xf0, 0x27, 0x3a, 0x03, 0x7e, 0x81, 0x46, 0xc5, 0x0a, 0xa8, 0x7e, 0xca, 0x8b, 0xc2,
-		0x75, 0xbd, 0x83, 0xe7, 0xde, 0x80, 0x62, 0x94, 0x3d, 0x68, 0x06, 0x26, 0x5c, 0x4f, 0x77, 0x3c,
-		0x2a, 0x85, 0x13, 0x2a, 0xfb, 0x40, 0x32, 0x24, 0xb1, 0xd5, 0xa2, 0xfa, 0x6f, 0x42, 0x25, 0x7f,
-		0xa2, 0xaf, 0x09, 0x06, 0x9c, 0xa4, 0x03, 0x7e, 0x68, 0x70, 0x46, 0x23, 0x94, 0xfb, 0xc7, 0x3d,
-		0xf7, 0x34, 0x14, 0x22, 0x03, 0x18, 0xb7, 0x69, 0xe5, 0x27, 0x53, 0x70, 0x34, 0x96, 0x36, 0x7a,
-		0x05, 0x66, 0x7a, 0x96, 0x69, 0x79, 0xd8, 0xe9, 0x3a, 0x98, 0x88, 0x2c, 0x6b, 0x6b, 0xf6, 0xf7,
-		0x27, 0x87, 0x08, 0xdd, 0x56, 0x18, 0x9a, 0x51, 0x51, 0xa7, 0x7b, 0x83, 0x85, 0xe8, 0x55, 0xc8,
-		0x11, 0xf9, 0xd0, 0x1d, 0x9d, 0x12, 0x64, 0xab, 0xf1, 0xd2, 0x78, 0x43, 0x5e, 0x5c, 0x0e, 0x30,
-		0xab, 0xc9, 0x0f, 0x4a, 0x09, 0x35, 0x4c, 0x0b, 0xed, 0x41, 0xfe, 0x26, 0x76, 0xcc, 0x1d, 0xd3,
-		0x60, 0xb4, 0x09, 0x3b, 0x8b, 0x97, 0x9e, 0x19, 0x93, 0xf6, 0xf5, 0x10, 0x6a, 0xc3, 0xd3, 0x3d,
-		0x5c, 0x86, 0xad, 0xf5, 0xeb, 0x35, 0xb5, 0x7e, 0xa5, 0x5e, 0x5b, 0x56, 0x23, 0x94, 0xe7, 0x3e,
-		0x2d, 0x41, 0x2e, 0xd4, 0x17, 0xa2, 0xb6, 0xac, 0x5e, 0x67, 0x1b, 0x3b, 0x9c, 0xe3, 0xfc, 0x0b,
-		0x9d, 0x84, 0xec, 0x4e, 0xaf, 0xdd, 0x66, 0x62, 0xc3, 0x6c, 0x5e, 0x86, 0x14, 0x10, 0x91, 0x21,
-		0x5a, 0x8a, 0x2b, 0x02, 0xaa, 0xa5, 0xc8, 0xdf, 0xe8, 0x0c, 0xe4, 0x4c, 0x57, 0x73, 0x70, 0x17,
-		0xeb, 0x1e, 0x6e, 0xcd, 0xa6, 0x16, 0xa4, 0x73, 0x99, 0x6a, 0x62, 0x56, 0x52, 0xc1, 0x74, 0x55,
-		0x5e, 0x8a, 0xe6, 0x20, 0x23, 0x64, 0x6f, 0x76, 0x82, 0x40, 0xa8, 0xfe, 0x37, 0xab, 0xe3, 0xd8,
-		0x69, 0x51, 0xc7, 0xbe, 0x95, 0x27, 0x61, 0x6a, 0x60, 0x90, 0xa8, 0x04, 0xb9, 0xe5, 0xda, 0xd2,
-		0x6a, 0x45, 0xad, 0x34, 0xeb, 0x1b, 0xeb, 0xf2, 0x11, 0x54, 0x84, 0xd0, 0xb8, 0x65, 0xe9, 0x7c,
-		0x36, 0xf3, 0xc5, 0x49, 0xf9, 0xfd, 0xef, 0x7f, 0xff, 0xfb, 0x13, 0xca, 0x2f, 0xa6, 0x61, 0x26,
-		0x4e, 0xcb, 0xc5, 0x2a, 0xdc, 0x80, 0x27, 0xc9, 0x08, 0x4f, 0x2a, 0x30, 0xd1, 0xd6, 0xb7, 0x71,
-		0x9b, 0x0e, 0xae, 0x78, 0xe9, 0x91, 0xb1, 0xf4, 0xe8, 0xe2, 0x2a, 0x41, 0x51, 0x19, 0x26, 0x7a,
-		0x91, 0x73, 0x6e, 0x82, 0x52, 0x38, 0x3f, 0x1e, 0x05, 0xa2, 0xfd, 0x38, 0x97, 0x4f, 0x42, 0x96,
-		0xfc, 0xcf, 0xa6, 0x25, 0xcd, 0xa6, 0x85, 0x14, 0xd0, 0x69, 0x99, 0x83, 0x0c, 0x55, 0x6c, 0x2d,
-		0xec, 0x4f, 0x99, 0xf8, 0x26, 0xaa, 0xa0, 0x85, 0x77, 0xf4, 0x5e, 0xdb, 0xd3, 0x6e, 0xea, 0xed,
-		0x1e, 0xa6, 0x2a, 0x2a, 0xab, 0xe6, 0x79, 0xe1, 0x75, 0x52, 0x86, 0xe6, 0x21, 0xc7, 0xf4, 0xa0,
-		0x69, 0xb5, 0xf0, 0x6d, 0x6a, 0x09, 0x27, 0x54, 0xa6, 0x1a, 0xeb, 0xa4, 0x84, 0x34, 0xff, 0xba,
-		0x6b, 0x5b, 0x42, 0x99, 0xd0, 0x26, 0x48, 0x01, 0x6d, 0xfe, 0xe9, 0x7e, 0x23, 0x7c, 0x7f, 0xfc,
-		0xf0, 0x06, 0xb4, 0xdf, 0x59, 0x28, 0x51, 0x88, 0x27, 0xf8, 0x5a, 0xd5, 0xdb, 0xb3, 0x53, 0x54,
-		0x00, 0x8a, 0xac, 0x78, 0x83, 0x97, 0x2a, 0x3f, 0x97, 0x80, 0x14, 0x35, 0x05, 0x25, 0xc8, 0x35,
-		0x5f, 0xdd, 0xac, 0x69, 0xcb, 0x1b, 0x5b, 0xd5, 0xd5, 0x9a, 0x2c, 0x91, 0xa9, 0xa7, 0x05, 0x57,
-		0x56, 0x37, 0x2a, 0x4d, 0x39, 0xe1, 0x7f, 0xd7, 0xd7, 0x9b, 0x97, 0x9f, 0x94, 0x93, 0x3e, 0xc2,
-		0x16, 0x2b, 0x48, 0x85, 0x01, 0x9e, 0xb8, 0x24, 0x4f, 0x20, 0x19, 0xf2, 0x8c, 0x40, 0xfd, 0x95,
-		0xda, 0xf2, 0xe5, 0x27, 0xe5, 0x74, 0xb4, 0xe4, 0x89, 0x4b, 0xf2, 0x24, 0x2a, 0x40, 0x96, 0x96,
-		0x54, 0x37, 0x36, 0x56, 0xe5, 0x8c, 0x4f, 0xb3, 0xd1, 0x54, 0xeb, 0xeb, 0x2b, 0x72, 0xd6, 0xa7,
-		0xb9, 0xa2, 0x6e, 0x6c, 0x6d, 0xca, 0xe0, 0x53, 0x58, 0xab, 0x35, 0x1a, 0x95, 0x95, 0x9a, 0x9c,
-		0xf3, 0x21, 0xaa, 0xaf, 0x36, 0x6b, 0x0d, 0x39, 0x1f, 0xe9, 0xd6, 0x13, 0x97, 0xe4, 0x82, 0xdf,
-		0x44, 0x6d, 0x7d, 0x6b, 0x4d, 0x2e, 0xa2, 0x29, 0x28, 0xb0, 0x26, 0x44, 0x27, 0x4a, 0x7d, 0x45,
-		0x97, 0x9f, 0x94, 0xe5, 0xa0, 0x23, 0x8c, 0xca, 0x54, 0xa4, 0xe0, 0xf2, 0x93, 0x32, 0x52, 0x96,
-		0x60, 0x82, 0x8a, 0x21, 0x42, 0x50, 0x5c, 0xad, 0x54, 0x6b, 0xab, 0xda, 0xc6, 0x26, 0x59, 0x34,
-		0x95, 0x55, 0x59, 0x0a, 0xca, 0xd4, 0xda, 0x7b, 0xb6, 0xea, 0x6a, 0x6d, 0x59, 0x4e, 0x84, 0xcb,
-		0x36, 0x6b, 0x95, 0x66, 0x6d, 0x59, 0x4e, 0x2a, 0x06, 0xcc, 0xc4, 0x99, 0xc0, 0xd8, 0x25, 0x14,
-		0x92, 0x85, 0xc4, 0x10, 0x59, 0xa0, 0xb4, 0xfa, 0x65, 0x41, 0xf9, 0x42, 0x02, 0xa6, 0x63, 0xdc,
-		0x80, 0xd8, 0x46, 0x5e, 0x82, 0x09, 0x26, 0xcb, 0x4c, 0x15, 0x3f, 0x1c, 0xeb, 0x4f, 0x50, 0xc9,
-		0x1e, 0x70, 0x8e, 0x28, 0x5e, 0xd8, 0x6d, 0x4c, 0x0e, 0x71, 0x1b, 0x09, 0x89, 0x01, 0x81, 0xfd,
-		0xfa, 0x01, 0x73, 0xcd, 0x3c, 0x9a, 0xcb, 0xe3, 0x78, 0x34, 0xb4, 0xec, 0x70, 0x66, 0x7b, 0x22,
-		0xc6, 0x6c, 0x3f, 0x07, 0x53, 0x03, 0x84, 0xc6, 0x36, 0x9f, 0xdf, 0x2c, 0xc1, 0xec, 0x30, 0xe6,
-		0x8c, 0x50, 0x89, 0x89, 0x88, 0x4a, 0x7c, 0xae, 0x9f, 0x83, 0xa7, 0x87, 0x4f, 0xc2, 0xc0, 0x5c,
-		0x7f, 0x42, 0x82, 0x63, 0xf1, 0xe1, 0x41, 0x6c, 0x1f, 0x5e, 0x84, 0x74, 0x07, 0x7b, 0x7b, 0xb6,
-		0x70, 0x84, 0x1f, 0x8a, 0x71, 0xaf, 0x48, 0x75, 0xff, 0x64, 0x73, 0xac, 0xb0, 0x7f, 0x96, 0x1c,
-		0xe6, 0xe3, 0xb3, 0xde, 0x0c, 0xf4, 0xf4, 0xdb, 0x12, 0x70, 0x34, 0x96, 0x78, 0x6c, 0x47, 0xef,
-		0x07, 0x30, 0xad, 0x6e, 0xcf, 0x63, 0xce, 0x2e, 0xd3, 0xc4, 0x59, 0x5a, 0x42, 0x95, 0x17, 0xd1,
-		0xb2, 0x3d, 0xcf, 0xaf, 0x67, 0x46, 0x14, 0x58, 0x11, 0x05, 0x78, 0x26, 0xe8, 0x68, 0x8a, 0x76,
-		0xf4, 0xd4, 0x90, 0x91, 0x0e, 0x08, 0xe6, 0xe3, 0x20, 0x1b, 0x6d, 0x13, 0x5b, 0x9e, 0xe6, 0x7a,
-		0x0e, 0xd6, 0x3b, 0xa6, 0xb5, 0xcb, 0xec, 0x6c, 0x79, 0x62, 0x47, 0x6f, 0xbb, 0x58, 0x2d, 0xb1,
-		0xea, 0x86, 0xa8, 0x25, 0x18, 0x54, 0x80, 0x9c, 0x10, 0x46, 0x3a, 0x82, 0xc1, 0xaa, 0x7d, 0x0c,
-		0xe5, 0xbb, 0xb3, 0x90, 0x0b, 0x05, 0x53, 0xe8, 0x34, 0xe4, 0x5f, 0xd7, 0x6f, 0xea, 0x9a, 0x08,
-		0x90, 0x19, 0x27, 0x72, 0xa4, 0x6c, 0x93, 0x07, 0xc9, 0x8f, 0xc3, 0x0c, 0x05, 0xb1, 0x7b, 0x1e,
-		0x76, 0x34, 0xa3, 0xad, 0xbb, 0x2e, 0x65, 0x5a, 0x86, 0x82, 0x22, 0x52, 0xb7, 0x41, 0xaa, 0x96,
-		0x44, 0x0d, 0x7a, 0x0a, 0xa6, 0x29, 0x46, 0xa7, 0xd7, 0xf6, 0xcc, 0x6e, 0x1b, 0x6b, 0x24, 0x64,
-		0x77, 0xa9, 0xc9, 0xf1, 0x7b, 0x36, 0x45, 0x20, 0xd6, 0x38, 0x00, 0xe9, 0x91, 0x8b, 0x96, 0xe1,
-		0x7e, 0x8a, 0xb6, 0x8b, 0x2d, 0xec, 0xe8, 0x1e, 0xd6, 0xf0, 0xfb, 0x7a, 0x7a, 0xdb, 0xd5, 0x74,
-		0xab, 0xa5, 0xed, 0xe9, 0xee, 0xde, 0xec, 0x8c, 0xef, 0x96, 0x9c, 0x20, 0x80, 0x2b, 0x1c, 0xae,
-		0x46, 0xc1, 0x2a, 0x56, 0xeb, 0xaa, 0xee, 0xee, 0xa1, 0x32, 0x1c, 0xa3, 0x54, 0x5c, 0xcf, 0x31,
-		0xad, 0x5d, 0xcd, 0xd8, 0xc3, 0xc6, 0x0d, 0xad, 0xe7, 0xed, 0x3c, 0x33, 0x7b, 0x32, 0xdc, 0x3e,
-		0xed, 0x61, 0x83, 0xc2, 0x2c, 0x11, 0x90, 0x2d, 0x6f, 0xe7, 0x19, 0xd4, 0x80, 0x3c, 0x99, 0x8c,
-		0x8e, 0xf9, 0x06, 0xd6, 0x76, 0x6c, 0x87, 0xda, 0xd0, 0x62, 0x8c, 0x6a, 0x0a, 0x71, 0x70, 0x71,
-		0x83, 0x23, 0xac, 0xd9, 0x2d, 0x5c, 0x9e, 0x68, 0x6c, 0xd6, 0x6a, 0xcb, 0x6a, 0x4e, 0x50, 0xb9,
-		0x62, 0x3b, 0x44, 0xa0, 0x76, 0x6d, 0x9f, 0xc1, 0x39, 0x26, 0x50, 0xbb, 0xb6, 0x60, 0xef, 0x53,
-		0x30, 0x6d, 0x18, 0x6c, 0xcc, 0xa6, 0xa1, 0xf1, 0xc0, 0xda, 0x9d, 0x95, 0x23, 0xcc, 0x32, 0x8c,
-		0x15, 0x06, 0xc0, 0x65, 0xdc, 0x45, 0xcf, 0xc2, 0xd1, 0x80, 0x59, 0x61, 0xc4, 0xa9, 0x81, 0x51,
-		0xf6, 0xa3, 0x3e, 0x05, 0xd3, 0xdd, 0xfd, 0x41, 0x44, 0x14, 0x69, 0xb1, 0xbb, 0xdf, 0x8f, 0xf6,
-		0x34, 0xcc, 0x74, 0xf7, 0xba, 0x83, 0x78, 0xe7, 0xc3, 0x78, 0xa8, 0xbb, 0xd7, 0xed, 0x47, 0x7c,
-		0x90, 0x66, 0x59, 0x1c, 0x6c, 0x50, 0xef, 0xf0, 0x78, 0x18, 0x3c, 0x54, 0x81, 0x16, 0x41, 0x36,
-		0x0c, 0x0d, 0x5b, 0xfa, 0x76, 0x1b, 0x6b, 0xba, 0x83, 0x2d, 0xdd, 0x9d, 0x9d, 0xa7, 0xc0, 0x29,
-		0xcf, 0xe9, 0x61, 0xb5, 0x68, 0x18, 0x35, 0x5a, 0x59, 0xa1, 0x75, 0xe8, 0x3c, 0x4c, 0xd9, 0xdb,
-		0xaf, 0x1b, 0x4c, 0x22, 0xb5, 0xae, 0x83, 0x77, 0xcc, 0xdb, 0xb3, 0x0f, 0x50, 0xf6, 0x96, 0x48,
-		0x05, 0x95, 0xc7, 0x4d, 0x5a, 0x8c, 0x1e, 0x06, 0xd9, 0x70, 0xf7, 0x74, 0xa7, 0x4b, 0x55, 0xb2,
-		0xdb, 0xd5, 0x0d, 0x3c, 0xfb, 0x20, 0x03, 0x65, 0xe5, 0xeb, 0xa2, 0x98, 0xac, 0x08, 0xf7, 0x96,
-		0xb9, 0xe3, 0x09, 0x8a, 0x67, 0xd9, 0x8a, 0xa0, 0x65, 0x9c, 0xda, 0x39, 0x90, 0x09, 0x27, 0x22,
-		0x0d, 0x9f, 0xa3, 0x60, 0xc5, 0xee, 0x5e, 0x37, 0xdc, 0xee, 0x19, 0x28, 0x10, 0xc8, 0xa0, 0xd1,
-		0x87, 0x99, 0xe3, 0xd6, 0xdd, 0x0b, 0xb5, 0xf8, 0x24, 0x1c, 0x23, 0x40, 0x1d, 0xec, 0xe9, 0x2d,
-		0xdd, 0xd3, 0x43, 0xd0, 0x8f, 0x52, 0x68, 0xc2, 0xf6, 0x35, 0x5e, 0x19, 0xe9, 0xa7, 0xd3, 0xdb,
-		0xde, 0xf7, 0x05, 0xeb, 0x31, 0xd6, 0x4f, 0x52, 0x26, 0x44, 0xeb, 0x5d, 0x8b, 0xa6, 0x94, 0x32,
-		0xe4, 0xc3, 0x72, 0x8f, 0xb2, 0xc0, 0x24, 0x5f, 0x96, 0x88, 0x13, 0xb4, 0xb4, 0xb1, 0x4c, 0xdc,
-		0x97, 0xd7, 0x6a, 0x72, 0x82, 0xb8, 0x51, 0xab, 0xf5, 0x66, 0x4d, 0x53, 0xb7, 0xd6, 0x9b, 0xf5,
-		0xb5, 0x9a, 0x9c, 0x0c, 0x39, 0xf6, 0xd7, 0x52, 0x99, 0x87, 0xe4, 0xb3, 0xca, 0x2f, 0x24, 0xa1,
-		0x18, 0x8d, 0xad, 0xd1, 0xf3, 0x70, 0x5c, 0xa4, 0xc8, 0x5c, 0xec, 0x69, 0xb7, 0x4c, 0x87, 0x2e,
-		0xc8, 0x8e, 0xce, 0x8c, 0xa3, 0x2f, 0x3f, 0x33, 0x1c, 0xaa, 0x81, 0xbd, 0xf7, 0x9a, 0x0e, 0x59,
-		0x6e, 0x1d, 0xdd, 0x43, 0xab, 0x30, 0x6f, 0xd9, 0x9a, 0xeb, 0xe9, 0x56, 0x4b, 0x77, 0x5a, 0x5a,
-		0x90, 0x9c, 0xd4, 0x74, 0xc3, 0xc0, 0xae, 0x6b, 0x33, 0x43, 0xe8, 0x53, 0xb9, 0xcf, 0xb2, 0x1b,
-		0x1c, 0x38, 0xb0, 0x10, 0x15, 0x0e, 0xda, 0x27, 0xbe, 0xc9, 0x61, 0xe2, 0x7b, 0x12, 0xb2, 0x1d,
-		0xbd, 0xab, 0x61, 0xcb, 0x73, 0xf6, 0xa9, 0x7f, 0x9e, 0x51, 0x33, 0x1d, 0xbd, 0x5b, 0x23, 0xdf,
-		0xe8, 0x3a, 0x3c, 0x14, 0x80, 0x6a, 0x6d, 0xbc, 0xab, 0x1b, 0xfb, 0x1a, 0x75, 0xc6, 0x69, 0xa2,
-		0x47, 0x33, 0x6c, 0x6b, 0xa7, 0x6d, 0x1a, 0x9e, 0x4b, 0xf5, 0x03, 0xd3, 0x71, 0x4a, 0x80, 0xb1,
-		0x4a, 0x11, 0xae, 0xb9, 0xb6, 0x45, 0x7d, 0xf0, 0x25, 0x01, 0xfd, 0xee, 0xcd, 0x70, 0x74, 0x96,
-		0x52, 0xf2, 0xc4, 0xb5, 0x54, 0x66, 0x42, 0x4e, 0x5f, 0x4b, 0x65, 0xd2, 0xf2, 0xe4, 0xb5, 0x54,
-		0x26, 0x23, 0x67, 0xaf, 0xa5, 0x32, 0x59, 0x19, 0x94, 0x0f, 0x64, 0x21, 0x1f, 0x8e, 0x0c, 0x48,
-		0xa0, 0x65, 0x50, 0xdb, 0x28, 0x51, 0xed, 0x79, 0xe6, 0xc0, 0x38, 0x62, 0x71, 0x89, 0x18, 0xcd,
-		0x72, 0x9a, 0xb9, 0xe1, 0x2a, 0xc3, 0x24, 0x0e, 0x0b, 0x11, 0x6b, 0xcc, 0xdc, 0x9e, 0x8c, 0xca,
-		0xbf, 0xd0, 0x0a, 0xa4, 0x5f, 0x77, 0x29, 0xed, 0x34, 0xa5, 0xfd, 0xc0, 0xc1, 0xb4, 0xaf, 0x35,
-		0x28, 0xf1, 0xec, 0xb5, 0x86, 0xb6, 0xbe, 0xa1, 0xae, 0x55, 0x56, 0x55, 0x8e, 0x8e, 0x4e, 0x40,
-		0xaa, 0xad, 0xbf, 0xb1, 0x1f, 0x35, 0xaf, 0xb4, 0x08, 0x2d, 0x42, 0xa9, 0x67, 0xb1, 0xa8, 0x9b,
-		0x4c, 0x15, 0x81, 0x2a, 0x85, 0xa1, 0x8a, 0x41, 0xed, 0x2a, 0x81, 0x1f, 0x53, 0x3c, 0x4e, 0x40,
-		0xea, 0x16, 0xd6, 0x6f, 0x44, 0x8d, 0x20, 0x2d, 0x42, 0xe7, 0x20, 0xdf, 0xc2, 0xdb, 0xbd, 0x5d,
-		0xcd, 0xc1, 0x2d, 0xdd, 0xf0, 0xa2, 0xaa, 0x3f, 0x47, 0xab, 0x54, 0x5a, 0x83, 0x5e, 0x86, 0x2c,
-		0x99, 0x23, 0x8b, 0xce, 0xf1, 0x14, 0x65, 0xc1, 0x63, 0x07, 0xb3, 0x80, 0x4f, 0xb1, 0x40, 0x52,
-		0x03, 0x7c, 0x74, 0x0d, 0xd2, 0x9e, 0xee, 0xec, 0x62, 0x8f, 0x6a, 0xfe, 0x62, 0x4c, 0xba, 0x2a,
-		0x86, 0x52, 0x93, 0x62, 0x10, 0xb6, 0x52, 0x19, 0xe5, 0x14, 0xd0, 0x55, 0x98, 0x64, 0x7f, 0xb9,
-		0xb3, 0xd3, 0x0b, 0xc9, 0xc3, 0x13, 0x53, 0x05, 0xfa, 0xbb, 0xa8, 0xb3, 0x2e, 0xc0, 0x04, 0x15,
-		0x36, 0x04, 0xc0, 0xc5, 0x4d, 0x3e, 0x82, 0x32, 0x90, 0x5a, 0xda, 0x50, 0x89, 0xde, 0x92, 0x21,
-		0xcf, 0x4a, 0xb5, 0xcd, 0x7a, 0x6d, 0xa9, 0x26, 0x27, 0x94, 0xa7, 0x20, 0xcd, 0x24, 0x88, 0xe8,
-		0x34, 0x5f, 0x86, 0xe4, 0x23, 0xfc, 0x93, 0xd3, 0x90, 0x44, 0xed, 0xd6, 0x5a, 0xb5, 0xa6, 0xca,
-		0x09, 0x65, 0x0b, 0x4a, 0x7d, 0x5c, 0x47, 0x47, 0x61, 0x4a, 0xad, 0x35, 0x6b, 0xeb, 0x24, 0x6a,
-		0xd3, 0xb6, 0xd6, 0x5f, 0x5e, 0xdf, 0x78, 0xef, 0xba, 0x7c, 0x24, 0x5a, 0x2c, 0x14, 0xa4, 0x84,
-		0x66, 0x40, 0x0e, 0x8a, 0x1b, 0x1b, 0x5b, 0x2a, 0xed, 0xcd, 0x77, 0x24, 0x40, 0xee, 0x67, 0x1b,
-		0x3a, 0x0e, 0xd3, 0xcd, 0x8a, 0xba, 0x52, 0x6b, 0x6a, 0x2c, 0x12, 0xf5, 0x49, 0xcf, 0x80, 0x1c,
-		0xae, 0xb8, 0x52, 0xa7, 0x81, 0xf6, 0x3c, 0x9c, 0x0c, 0x97, 0xd6, 0x5e, 0x69, 0xd6, 0xd6, 0x1b,
-		0xb4, 0xf1, 0xca, 0xfa, 0x0a, 0xd1, 0xd6, 0x7d, 0xf4, 0x44, 0xec, 0x9b, 0x24, 0x5d, 0x8d, 0xd2,
-		0xab, 0xad, 0x2e, 0xcb, 0xa9, 0xfe, 0xe2, 0x8d, 0xf5, 0xda, 0xc6, 0x15, 0x79, 0xa2, 0xbf, 0x75,
-		0x1a, 0x0f, 0xa7, 0xd1, 0x1c, 0x1c, 0xeb, 0x2f, 0xd5, 0x6a, 0xeb, 0x4d, 0xf5, 0x55, 0x79, 0xb2,
-		0xbf, 0xe1, 0x46, 0x4d, 0xbd, 0x5e, 0x5f, 0xaa, 0xc9, 0x19, 0x74, 0x0c, 0x50, 0xb4, 0x47, 0xcd,
-		0xab, 0x1b, 0xcb, 0x72, 0x76, 0x40, 0x3f, 0x29, 0x2e, 0xe4, 0xc3, 0x41, 0xe9, 0x5f, 0x8a, 0x6a,
-		0x54, 0x3e, 0x9c, 0x80, 0x5c, 0x28, 0xc8, 0x24, 0xd1, 0x81, 0xde, 0x6e, 0xdb, 0xb7, 0x34, 0xbd,
-		0x6d, 0xea, 0x2e, 0xd7, 0x5e, 0x40, 0x8b, 0x2a, 0xa4, 0x64, 0x5c, 0x6d, 0x31, 0xbe, 0xbd, 0x48,
-		0xff, 0x75, 0xb4, 0x17, 0x13, 0x72, 0x5a, 0xf9, 0xb8, 0x04, 0x72, 0x7f, 0xf4, 0xd8, 0x37, 0x7c,
-		0x69, 0xd8, 0xf0, 0xff, 0x52, 0xe6, 0xee, 0x63, 0x12, 0x14, 0xa3, 0x21, 0x63, 0x5f, 0xf7, 0x4e,
-		0xff, 0x95, 0x76, 0xef, 0x77, 0x12, 0x50, 0x88, 0x04, 0x8a, 0xe3, 0xf6, 0xee, 0x7d, 0x30, 0x65,
-		0xb6, 0x70, 0xa7, 0x6b, 0x7b, 0xd8, 0x32, 0xf6, 0xb5, 0x36, 0xbe, 0x89, 0xdb, 0xb3, 0x0a, 0x55,
-		0xf1, 0x17, 0x0e, 0x0e, 0x45, 0x17, 0xeb, 0x01, 0xde, 0x2a, 0x41, 0x2b, 0x4f, 0xd7, 0x97, 0x6b,
-		0x6b, 0x9b, 0x1b, 0xcd, 0xda, 0xfa, 0xd2, 0xab, 0x42, 0xbb, 0xa8, 0xb2, 0xd9, 0x07, 0xf6, 0x2e,
-		0x2a, 0xed, 0x4d, 0x90, 0xfb, 0x3b, 0x45, 0x74, 0x45, 0x4c, 0xb7, 0xe4, 0x23, 0x68, 0x1a, 0x4a,
-		0xeb, 0x1b, 0x5a, 0xa3, 0xbe, 0x5c, 0xd3, 0x6a, 0x57, 0xae, 0xd4, 0x96, 0x9a, 0x0d, 0x96, 0x5c,
-		0xf4, 0xa1, 0x9b, 0x72, 0x22, 0xcc, 0xe2, 0x8f, 0x24, 0x61, 0x3a, 0xa6, 0x27, 0xa8, 0xc2, 0xd3,
-		0x02, 0x2c, 0x53, 0xf1, 0xd8, 0x38, 0xbd, 0x5f, 0x24, 0x8e, 0xf9, 0xa6, 0xee, 0x78, 0x3c, 0x8b,
-		0xf0, 0x30, 0x10, 0x2e, 0x59, 0x1e, 0xf1, 0x13, 0x1c, 0x9e, 0xb4, 0x65, 0xb9, 0x82, 0x52, 0x50,
-		0xce, 0xf2, 0xb6, 0x8f, 0x02, 0xea, 0xda, 0xae, 0xe9, 0x99, 0x37, 0xb1, 0x66, 0x5a, 0x22, 0xc3,
-		0x9b, 0x5a, 0x90, 0xce, 0xa5, 0x54, 0x59, 0xd4, 0xd4, 0x2d, 0xcf, 0x87, 0xb6, 0xf0, 0xae, 0xde,
-		0x07, 0x4d, 0xfc, 0x98, 0xa4, 0x2a, 0x8b, 0x1a, 0x1f, 0xfa, 0x34, 0xe4, 0x5b, 0x76, 0x8f, 0x04,
-		0x54, 0x0c, 0x8e, 0x68, 0x0b, 0x49, 0xcd, 0xb1, 0x32, 0x1f, 0x84, 0x87, 0xca, 0x41, 0x6a, 0x39,
-		0xaf, 0xe6, 0x58, 0x19, 0x03, 0x39, 0x0b, 0x25, 0x7d, 0x77, 0xd7, 0x21, 0xc4, 0x05, 0x21, 0x16,
-		0xfc, 0x17, 0xfd, 0x62, 0x0a, 0x38, 0x77, 0x0d, 0x32, 0x82, 0x0f, 0xc4, 0x1f, 0x26, 0x9c, 0xd0,
-		0xba, 0x2c, 0xa3, 0x95, 0x38, 0x97, 0x55, 0x33, 0x96, 0xa8, 0x3c, 0x0d, 0x79, 0xd3, 0xd5, 0x82,
-		0xbd, 0xcd, 0xc4, 0x42, 0xe2, 0x5c, 0x46, 0xcd, 0x99, 0xae, 0xbf, 0x47, 0xa2, 0x7c, 0x22, 0x01,
-		0xc5, 0xe8, 0xae, 0x2d, 0x5a, 0x86, 0x4c, 0xdb, 0xe6, 0x9b, 0x2c, 0xec, 0xc8, 0xc0, 0xb9, 0x11,
-		0x1b, 0xbd, 0x8b, 0xab, 0x1c, 0x5e, 0xf5, 0x31, 0xe7, 0x7e, 0x4d, 0x82, 0x8c, 0x28, 0x46, 0xc7,
-		0x20, 0xd5, 0xd5, 0xbd, 0x3d, 0x4a, 0x6e, 0xa2, 0x9a, 0x90, 0x25, 0x95, 0x7e, 0x93, 0x72, 0xb7,
-		0xab, 0xb3, 0x7d, 0x22, 0x5e, 0x4e, 0xbe, 0xc9, 0xbc, 0xb6, 0xb1, 0xde, 0xa2, 0x99, 0x05, 0xbb,
-		0xd3, 0xc1, 0x96, 0xe7, 0x8a, 0x79, 0xe5, 0xe5, 0x4b, 0xbc, 0x18, 0x3d, 0x02, 0x53, 0x9e, 0xa3,
-		0x9b, 0xed, 0x08, 0x6c, 0x8a, 0xc2, 0xca, 0xa2, 0xc2, 0x07, 0x2e, 0xc3, 0x09, 0x41, 0xb7, 0x85,
-		0x3d, 0xdd, 0xd8, 0xc3, 0xad, 0x00, 0x29, 0x4d, 0x33, 0x88, 0xc7, 0x39, 0xc0, 0x32, 0xaf, 0x17,
-		0xb8, 0xca, 0xe7, 0x12, 0x30, 0x25, 0x72, 0x21, 0x2d, 0x9f, 0x59, 0x6b, 0x00, 0xba, 0x65, 0xd9,
-		0x5e, 0x98, 0x5d, 0x83, 0xa2, 0x3c, 0x80, 0xb7, 0x58, 0xf1, 0x91, 0xd4, 0x10, 0x81, 0xb9, 0x2f,
-		0x49, 0x00, 0x41, 0xd5, 0x50, 0xbe, 0xcd, 0x43, 0x8e, 0xef, 0xc9, 0xd3, 0x83, 0x1d, 0x2c, 0x7d,
-		0x06, 0xac, 0xe8, 0x8a, 0xd9, 0xa6, 0x49, 0xce, 0x6d, 0xbc, 0x6b, 0x5a, 0x7c, 0x77, 0x86, 0x7d,
-		0x88, 0x24, 0x67, 0x2a, 0xd8, 0x9e, 0x54, 0x21, 0xe3, 0xe2, 0x8e, 0x6e, 0x79, 0xa6, 0xc1, 0xf7,
-		0x5b, 0x2e, 0x1f, 0xaa, 0xf3, 0x8b, 0x0d, 0x8e, 0xad, 0xfa, 0x74, 0x94, 0x73, 0x90, 0x11, 0xa5,
-		0xc4, 0xf1, 0x5b, 0xdf, 0x58, 0xaf, 0xc9, 0x47, 0xd0, 0x24, 0x24, 0x1b, 0xb5, 0xa6, 0x2c, 0x91,
-		0x20, 0xb6, 0xb2, 0x5a, 0xaf, 0x34, 0xe4, 0x44, 0xf5, 0xef, 0xc2, 0xb4, 0x61, 0x77, 0xfa, 0x1b,
-		0xac, 0xca, 0x7d, 0x09, 0x44, 0xf7, 0xaa, 0xf4, 0xda, 0x63, 0x1c, 0x68, 0xd7, 0x6e, 0xeb, 0xd6,
-		0xee, 0xa2, 0xed, 0xec, 0x06, 0xc7, 0x62, 0x48, 0xac, 0xe1, 0x86, 0x0e, 0xc7, 0x74, 0xb7, 0xff,
-		0x42, 0x92, 0x7e, 0x38, 0x91, 0x5c, 0xd9, 0xac, 0xfe, 0x78, 0x62, 0x6e, 0x85, 0x21, 0x6e, 0x8a,
-		0xe1, 0xa8, 0x78, 0xa7, 0x8d, 0x0d, 0xd2, 0x79, 0xf8, 0x68, 0x0a, 0xa6, 0xf4, 0x8e, 0x69, 0xd9,
-		0x17, 0xe8, 0xbf, 0xfc, 0x50, 0xcd, 0x04, 0xfd, 0x98, 0x1b, 0x79, 0xfa, 0xa6, 0x7c, 0x99, 0x29,
-		0x30, 0x34, 0x6a, 0x0f, 0x7b, 0xf6, 0x4f, 0xbe, 0xe3, 0xc7, 0x26, 0x82, 0xdc, 0x67, 0x79, 0x0d,
-		0x64, 0x11, 0x76, 0x63, 0xcb, 0xb0, 0x89, 0xb4, 0x8d, 0xa6, 0xf1, 0xa7, 0x82, 0x46, 0x89, 0xe3,
-		0xd6, 0x38, 0x6a, 0xf9, 0x79, 0xc8, 0xf8, 0x64, 0x0e, 0xde, 0x4e, 0x9a, 0xfd, 0x9f, 0x82, 0x88,
-		0x8f, 0x51, 0x7e, 0x09, 0x80, 0x39, 0x3b, 0x2c, 0x2d, 0x7b, 0x30, 0xfe, 0x57, 0x04, 0x7e, 0x96,
-		0xe2, 0x10, 0x2d, 0x54, 0x5e, 0x81, 0x62, 0xcb, 0xb6, 0x3c, 0xcd, 0xee, 0x98, 0x1e, 0xee, 0x74,
-		0xbd, 0xfd, 0x51, 0x44, 0xfe, 0x8c, 0x11, 0xc9, 0xa8, 0x05, 0x82, 0xb7, 0x21, 0xd0, 0x48, 0x4f,
-		0xd8, 0xce, 0xda, 0x38, 0x3d, 0xf9, 0x73, 0xbf, 0x27, 0x14, 0x87, 0xf4, 0xa4, 0x5a, 0xfb, 0xe5,
-		0xcf, 0x9f, 0x92, 0x3e, 0xf7, 0xf9, 0x53, 0xd2, 0xef, 0x7c, 0xfe, 0x94, 0xf4, 0xa1, 0x2f, 0x9c,
-		0x3a, 0xf2, 0xb9, 0x2f, 0x9c, 0x3a, 0xf2, 0x9b, 0x5f, 0x38, 0x75, 0xe4, 0xb5, 0x47, 0x76, 0x4d,
-		0x6f, 0xaf, 0xb7, 0xbd, 0x68, 0xd8, 0x9d, 0x0b, 0x86, 0xed, 0x76, 0x6c, 0x97, 0xff, 0xf7, 0x98,
-		0xdb, 0xba, 0xc1, 0xe5, 0xc7, 0xbb, 0xcd, 0xa4, 0x60, 0x3b, 0xcd, 0x76, 0xd4, 0xe0, 0x0f, 0x1f,
-		0x81, 0x99, 0x5d, 0x7b, 0xd7, 0xa6, 0x9f, 0x17, 0xc8, 0x5f, 0x5c, 0x40, 0xb2, 0x7e, 0xe9, 0x18,
-		0x42, 0xb2, 0x0e, 0xd3, 0x1c, 0x58, 0xa3, 0x87, 0x3b, 0x58, 0x22, 0x0b, 0x1d, 0xb8, 0x8b, 0x32,
-		0xfb, 0x33, 0xbf, 0x47, 0x7d, 0x56, 0x75, 0x8a, 0xa3, 0x92, 0x3a, 0x96, 0xeb, 0x2a, 0xab, 0x70,
-		0x34, 0x42, 0x8f, 0x59, 0x10, 0xec, 0x8c, 0xa0, 0xf8, 0xef, 0x39, 0xc5, 0xe9, 0x10, 0xc5, 0x06,
-		0x47, 0x2d, 0x2f, 0x41, 0xe1, 0x30, 0xb4, 0x7e, 0x89, 0xd3, 0xca, 0xe3, 0x30, 0x91, 0x15, 0x28,
-		0x51, 0x22, 0x46, 0xcf, 0xf5, 0xec, 0x0e, 0x9d, 0xc3, 0x83, 0xc9, 0xfc, 0x87, 0xdf, 0x63, 0x2a,
-		0xbd, 0x48, 0xd0, 0x96, 0x7c, 0xac, 0x72, 0x19, 0xe8, 0x79, 0x96, 0x16, 0x36, 0xda, 0x23, 0x28,
-		0xfc, 0x32, 0xef, 0x88, 0x0f, 0x5f, 0xbe, 0x0e, 0x33, 0xe4, 0x6f, 0x6a, 0x3d, 0xc3, 0x3d, 0x19,
-		0xbd, 0xe5, 0x32, 0xfb, 0x5f, 0xbe, 0x99, 0x59, 0x8d, 0x69, 0x9f, 0x40, 0xa8, 0x4f, 0xa1, 0x59,
-		0xdc, 0xc5, 0x9e, 0x87, 0x1d, 0x57, 0xd3, 0xdb, 0x71, 0xdd, 0x0b, 0xe5, 0xac, 0x67, 0x7f, 0xe0,
-		0xcb, 0xd1, 0x59, 0x5c, 0x61, 0x98, 0x95, 0x76, 0xbb, 0xbc, 0x05, 0xc7, 0x63, 0xa4, 0x62, 0x0c,
-		0x9a, 0x1f, 0xe1, 0x34, 0x67, 0x06, 0x24, 0x83, 0x90, 0xdd, 0x04, 0x51, 0xee, 0xcf, 0xe5, 0x18,
-		0x34, 0x3f, 0xca, 0x69, 0x22, 0x8e, 0x2b, 0xa6, 0x94, 0x50, 0xbc, 0x06, 0x53, 0x37, 0xb1, 0xb3,
-		0x6d, 0xbb, 0x7c, 0x9f, 0x60, 0x0c, 0x72, 0x1f, 0xe3, 0xe4, 0x4a, 0x1c, 0x91, 0x6e, 0x1c, 0x10,
-		0x5a, 0xcf, 0x42, 0x66, 0x47, 0x37, 0xf0, 0x18, 0x24, 0xee, 0x72, 0x12, 0x93, 0x04, 0x9e, 0xa0,
-		0x56, 0x20, 0xbf, 0x6b, 0x73, 0x07, 0x6a, 0x34, 0xfa, 0xc7, 0x39, 0x7a, 0x4e, 0xe0, 0x70, 0x12,
-		0x5d, 0xbb, 0xdb, 0x6b, 0x13, 0xef, 0x6a, 0x34, 0x89, 0x1f, 0x14, 0x24, 0x04, 0x0e, 0x27, 0x71,
-		0x08, 0xb6, 0xbe, 0x29, 0x48, 0xb8, 0x21, 0x7e, 0xbe, 0x04, 0x39, 0xdb, 0x6a, 0xef, 0xdb, 0xd6,
-		0x38, 0x9d, 0xf8, 0x21, 0x4e, 0x01, 0x38, 0x0a, 0x21, 0xf0, 0x1c, 0x64, 0xc7, 0x9d, 0x88, 0x1f,
-		0xfd, 0xb2, 0x58, 0x1e, 0x62, 0x06, 0x56, 0xa0, 0x24, 0x14, 0x94, 0x69, 0x5b, 0x63, 0x90, 0xf8,
-		0x31, 0x4e, 0xa2, 0x18, 0x42, 0xe3, 0xc3, 0xf0, 0xb0, 0xeb, 0xed, 0xe2, 0x71, 0x88, 0x7c, 0x42,
-		0x0c, 0x83, 0xa3, 0x70, 0x56, 0x6e, 0x63, 0xcb, 0xd8, 0x1b, 0x8f, 0xc2, 0x27, 0x05, 0x2b, 0x05,
-		0x0e, 0x21, 0xb1, 0x04, 0x85, 0x8e, 0xee, 0xb8, 0x7b, 0x7a, 0x7b, 0xac, 0xe9, 0xf8, 0x14, 0xa7,
-		0x91, 0xf7, 0x91, 0x38, 0x47, 0x7a, 0xd6, 0x61, 0xc8, 0xfc, 0xb8, 0xe0, 0x48, 0x08, 0x8d, 0x2f,
-		0x3d, 0xd7, 0xa3, 0x9b, 0x2a, 0x87, 0xa1, 0xf6, 0x13, 0x62, 0xe9, 0x31, 0xdc, 0xb5, 0x30, 0xc5,
-		0xe7, 0x20, 0xeb, 0x9a, 0x6f, 0x8c, 0x45, 0xe6, 0x9f, 0x88, 0x99, 0xa6, 0x08, 0x04, 0xf9, 0x55,
-		0x38, 0x11, 0x6b, 0x26, 0xc6, 0x20, 0xf6, 0x93, 0x9c, 0xd8, 0xb1, 0x18, 0x53, 0xc1, 0x55, 0xc2,
-		0x61, 0x49, 0xfe, 0x94, 0x50, 0x09, 0xb8, 0x8f, 0xd6, 0x26, 0x09, 0x69, 0x5d, 0x7d, 0xe7, 0x70,
-		0x5c, 0xfb, 0xa7, 0x82, 0x6b, 0x0c, 0x37, 0xc2, 0xb5, 0x26, 0x1c, 0xe3, 0x14, 0x0f, 0x37, 0xaf,
-		0x3f, 0x2d, 0x14, 0x2b, 0xc3, 0xde, 0x8a, 0xce, 0xee, 0xd7, 0xc2, 0x9c, 0xcf, 0x4e, 0x11, 0x3b,
-		0xb9, 0x5a, 0x47, 0xef, 0x8e, 0x41, 0xf9, 0x67, 0x38, 0x65, 0xa1, 0xf1, 0xfd, 0xe0, 0xcb, 0x5d,
-		0xd3, 0xbb, 0x84, 0xf8, 0x2b, 0x30, 0x2b, 0x88, 0xf7, 0x2c, 0x07, 0x1b, 0xf6, 0xae, 0x65, 0xbe,
-		0x81, 0x5b, 0x63, 0x90, 0xfe, 0x67, 0x7d, 0x53, 0xb5, 0x15, 0x42, 0x27, 0x94, 0xeb, 0x20, 0xfb,
-		0xbe, 0x8a, 0x66, 0x76, 0xba, 0xb6, 0xe3, 0x8d, 0xa0, 0xf8, 0x69, 0x31, 0x53, 0x3e, 0x5e, 0x9d,
-		0xa2, 0x95, 0x6b, 0xc0, 0x4e, 0x1a, 0x8d, 0x2b, 0x92, 0x9f, 0xe1, 0x84, 0x0a, 0x01, 0x16, 0x57,
-		0x1c, 0x86, 0xdd, 0xe9, 0xea, 0xce, 0x38, 0xfa, 0xef, 0x67, 0x85, 0xe2, 0xe0, 0x28, 0x5c, 0x71,
-		0x10, 0x7f, 0x8d, 0x58, 0xfb, 0x31, 0x28, 0xfc, 0x9c, 0x50, 0x1c, 0x02, 0x87, 0x93, 0x10, 0x0e,
-		0xc3, 0x18, 0x24, 0xfe, 0xb9, 0x20, 0x21, 0x70, 0x08, 0x89, 0xf7, 0x04, 0x86, 0xd6, 0xc1, 0xbb,
-		0xa6, 0xeb, 0xf1, 0xa3, 0x82, 0x07, 0x93, 0xfa, 0xf9, 0x2f, 0x47, 0x9d, 0x30, 0x35, 0x84, 0x4a,
-		0x34, 0x11, 0xf7, 0xec, 0x69, 0x40, 0x3f, 0xba, 0x63, 0x9f, 0x15, 0x9a, 0x28, 0x84, 0x46, 0xfa,
-		0x16, 0xf2, 0x10, 0x09, 0xdb, 0x0d, 0x12, 0xc6, 0x8e, 0x41, 0xee, 0x5f, 0xf4, 0x75, 0xae, 0x21,
-		0x70, 0x09, 0xcd, 0x90, 0xff, 0xd3, 0xb3, 0x6e, 0xe0, 0xfd, 0xb1, 0xa4, 0xf3, 0x17, 0xfa, 0xfc,
-		0x9f, 0x2d, 0x86, 0xc9, 0x74, 0x48, 0xa9, 0xcf, 0x9f, 0x1a, 0x1d, 0x01, 0x7d, 0xd3, 0x57, 0xf8,
-		0x78, 0xa3, 0xee, 0x54, 0x79, 0x95, 0x08, 0x79, 0xd4, 0xe9, 0x19, 0x4d, 0xec, 0x9b, 0xbf, 0xe2,
-		0xcb, 0x79, 0xc4, 0xe7, 0x29, 0x5f, 0x81, 0x42, 0xc4, 0xe1, 0x19, 0x4d, 0xea, 0x5b, 0x38, 0xa9,
-		0x7c, 0xd8, 0xdf, 0x29, 0x3f, 0x05, 0x29, 0xe2, 0xbc, 0x8c, 0x46, 0xff, 0x00, 0x47, 0xa7, 0xe0,
-		0xe5, 0x17, 0x20, 0x23, 0x9c, 0x96, 0xd1, 0xa8, 0xdf, 0xca, 0x51, 0x7d, 0x14, 0x82, 0x2e, 0x1c,
-		0x96, 0xd1, 0xe8, 0x7f, 0x5f, 0xa0, 0x0b, 0x14, 0x82, 0x3e, 0x3e, 0x0b, 0xff, 0xcd, 0xb7, 0xa7,
-		0xb8, 0xd1, 0x11, 0xbc, 0x7b, 0x0e, 0x26, 0xb9, 0xa7, 0x32, 0x1a, 0xfb, 0xdb, 0x78, 0xe3, 0x02,
-		0xa3, 0xfc, 0x34, 0x4c, 0x8c, 0xc9, 0xf0, 0xef, 0xe4, 0xa8, 0x0c, 0xbe, 0xbc, 0x04, 0xb9, 0x90,
-		0x77, 0x32, 0x1a, 0xfd, 0xbb, 0x38, 0x7a, 0x18, 0x8b, 0x74, 0x9d, 0x7b, 0x27, 0xa3, 0x09, 0xfc,
-		0x03, 0xd1, 0x75, 0x8e, 0x41, 0xd8, 0x26, 0x1c, 0x93, 0xd1, 0xd8, 0x1f, 0x12, 0x5c, 0x17, 0x28,
-		0xe5, 0x97, 0x20, 0xeb, 0x1b, 0x9b, 0xd1, 0xf8, 0xdf, 0xcd, 0xf1, 0x03, 0x1c, 0xc2, 0x81, 0x90,
-		0xb1, 0x1b, 0x4d, 0xe2, 0x1f, 0x0a, 0x0e, 0x84, 0xb0, 0xc8, 0x32, 0xea, 0x77, 0x60, 0x46, 0x53,
-		0xfa, 0x1e, 0xb1, 0x8c, 0xfa, 0xfc, 0x17, 0x32, 0x9b, 0x54, 0xe7, 0x8f, 0x26, 0xf1, 0xbd, 0x62,
-		0x36, 0x29, 0x3c, 0xe9, 0x46, 0xbf, 0x47, 0x30, 0x9a, 0xc6, 0xf7, 0x8b, 0x6e, 0xf4, 0x39, 0x04,
-		0xe5, 0x4d, 0x40, 0x83, 0xde, 0xc0, 0x68, 0x7a, 0x1f, 0xe6, 0xf4, 0xa6, 0x06, 0x9c, 0x81, 0xf2,
-		0x7b, 0xe1, 0x58, 0xbc, 0x27, 0x30, 0x9a, 0xea, 0x0f, 0x7c, 0xa5, 0x2f, 0x76, 0x0b, 0x3b, 0x02,
-		0xe5, 0x66, 0x60, 0x52, 0xc2, 0x5e, 0xc0, 0x68, 0xb2, 0x1f, 0xf9, 0x4a, 0x54, 0x71, 0x87, 0x9d,
-		0x80, 0x72, 0x05, 0x20, 0x30, 0xc0, 0xa3, 0x69, 0x7d, 0x8c, 0xd3, 0x0a, 0x21, 0x91, 0xa5, 0xc1,
-		0xed, 0xef, 0x68, 0xfc, 0xbb, 0x62, 0x69, 0x70, 0x0c, 0xb2, 0x34, 0x84, 0xe9, 0x1d, 0x8d, 0xfd,
-		0x71, 0xb1, 0x34, 0x04, 0x0a, 0x91, 0xec, 0x90, 0x75, 0x1b, 0x4d, 0xe1, 0x87, 0x84, 0x64, 0x87,
-		0xb0, 0xca, 0xeb, 0x30, 0x35, 0x60, 0x10, 0x47, 0x93, 0xfa, 0x61, 0x4e, 0x4a, 0xee, 0xb7, 0x87,
-		0x61, 0xe3, 0xc5, 0x8d, 0xe1, 0x68, 0x6a, 0x3f, 0xd2, 0x67, 0xbc, 0xb8, 0x2d, 0x2c, 0x3f, 0x07,
-		0x19, 0xab, 0xd7, 0x6e, 0x93, 0xc5, 0x33, 0x2a, 0xe5, 0xf5, 0xdf, 0xbf, 0xca, 0xb9, 0x23, 0x10,
-		0xca, 0x4f, 0xc1, 0x04, 0xee, 0x6c, 0xe3, 0xd6, 0x28, 0xcc, 0x3f, 0xf8, 0xaa, 0x50, 0x98, 0x04,
-		0xba, 0xfc, 0x12, 0x00, 0x4b, 0x8d, 0xd0, 0x43, 0x1a, 0x23, 0x70, 0xbf, 0xf4, 0x55, 0x7e, 0xf8,
-		0x32, 0x40, 0x09, 0x08, 0x8c, 0x93, 0xa9, 0xfb, 0x72, 0x94, 0x00, 0x9d, 0x91, 0x67, 0x61, 0xf2,
-		0x75, 0xd7, 0xb6, 0x3c, 0x7d, 0x64, 0xc6, 0xf2, 0x0f, 0x39, 0xb6, 0x80, 0x27, 0x0c, 0xeb, 0xd8,
-		0x0e, 0xf6, 0xf4, 0x5d, 0x77, 0x14, 0xee, 0x1f, 0x71, 0x5c, 0x1f, 0x81, 0x20, 0x1b, 0xba, 0xeb,
-		0x8d, 0x33, 0xee, 0xff, 0x21, 0x90, 0x05, 0x02, 0xe9, 0x34, 0xf9, 0xfb, 0x06, 0x1e, 0x99, 0xe1,
-		0xfc, 0x63, 0xd1, 0x69, 0x0e, 0x5f, 0x7e, 0x01, 0xb2, 0xe4, 0x4f, 0x76, 0xa2, 0x7a, 0x04, 0xf2,
-		0x9f, 0x70, 0xe4, 0x00, 0x83, 0xb4, 0xec, 0x7a, 0x2d, 0xcf, 0x1c, 0xcd, 0xec, 0x3f, 0xe5, 0x33,
-		0x2d, 0xe0, 0xcb, 0x15, 0xc8, 0xb9, 0x5e, 0xab, 0xd5, 0xe3, 0xfe, 0xe9, 0xa8, 0xfc, 0xf0, 0x57,
-		0xfd, 0x94, 0x85, 0x8f, 0x43, 0x66, 0xfb, 0xd6, 0x0d, 0xaf, 0x6b, 0xd3, 0xdd, 0xb8, 0x91, 0x19,
-		0x62, 0x4e, 0x21, 0x84, 0x52, 0x5e, 0x82, 0x3c, 0x19, 0x8b, 0xb8, 0xa9, 0x32, 0x32, 0x3f, 0xcc,
-		0x19, 0x10, 0x41, 0xaa, 0x7e, 0xe3, 0xb0, 0xe4, 0x6e, 0xfc, 0x1e, 0x02, 0xac, 0xd8, 0x2b, 0x36,
-		0xdb, 0x3d, 0x78, 0xed, 0xc1, 0xc1, 0xec, 0x6f, 0x34, 0xaf, 0x4b, 0xff, 0x82, 0xff, 0x25, 0xc1,
-		0xfd, 0x86, 0xdd, 0xc1, 0xde, 0xf6, 0x8e, 0x77, 0xc1, 0x70, 0xf6, 0xbb, 0x9e, 0x7d, 0xe1, 0xe6,
-		0xc5, 0x0b, 0x37, 0xf0, 0xbe, 0xcb, 0x13, 0xbf, 0x48, 0x54, 0x2f, 0xb2, 0xea, 0xc5, 0x9b, 0x17,
-		0xe7, 0x62, 0x53, 0xc4, 0xca, 0x2b, 0x90, 0xdd, 0xa4, 0x37, 0x57, 0x5f, 0xc6, 0xfb, 0x68, 0x0e,
-		0x26, 0x71, 0xeb, 0xd2, 0x53, 0x4f, 0x5d, 0x7c, 0x96, 0xee, 0xc5, 0xe7, 0xaf, 0x1e, 0x51, 0x45,
-		0x01, 0x3a, 0x05, 0x59, 0x17, 0x1b, 0xdd, 0x4b, 0x4f, 0x5d, 0xbe, 0x71, 0x91, 0xee, 0xe3, 0x90,
-		0xda, 0xa0, 0xa8, 0x9c, 0xf9, 0xe2, 0x9b, 0xf3, 0xd2, 0x17, 0x7f, 0x68, 0x5e, 0xaa, 0x4e, 0x40,
-		0xd2, 0xed, 0x75, 0xaa, 0x6b, 0x43, 0x93, 0xdc, 0x4f, 0x44, 0x86, 0x29, 0xc6, 0x21, 0xfe, 0xd0,
-		0xbb, 0xe6, 0x85, 0xc1, 0xd1, 0xf9, 0xc9, 0xee, 0x4f, 0xa5, 0xe0, 0x54, 0xcc, 0xe0, 0xbb, 0x8e,
-		0x6d, 0xef, 0x1c, 0x7a, 0xf4, 0x3b, 0x30, 0xb1, 0x49, 0x10, 0xd1, 0x0c, 0x4c, 0x78, 0xb6, 0xa7,
-		0xb7, 0xe9, 0xb8, 0x93, 0x2a, 0xfb, 0x20, 0xa5, 0xec, 0xf2, 0x4c, 0x82, 0x95, 0x9a, 0xe2, 0xde,
-		0x4c, 0x1b, 0xeb, 0x3b, 0xec, 0x0c, 0x72, 0x92, 0x6e, 0x8f, 0x66, 0x48, 0x01, 0x3d, 0x6e, 0x3c,
-		0x03, 0x13, 0x7a, 0x8f, 0xed, 0xec, 0x25, 0xcf, 0xe5, 0x55, 0xf6, 0xa1, 0xac, 0xc2, 0x24, 0x4f,
-		0xe1, 0x22, 0x19, 0x92, 0x37, 0xf0, 0x3e, 0xe3, 0xaf, 0x4a, 0xfe, 0x44, 0x17, 0x60, 0x82, 0xf6,
-		0x9e, 0x5f, 0xae, 0x38, 0xb1, 0x38, 0xd8, 0xfd, 0x45, 0xda, 0x4b, 0x95, 0xc1, 0x29, 0xd7, 0x20,
-		0xb3, 0x6c, 0x77, 0x4c, 0xcb, 0x8e, 0x92, 0xcb, 0x32, 0x72, 0xb4, 0xd3, 0xdd, 0x9e, 0xc7, 0x37,
-		0xdb, 0xd8, 0x07, 0x3a, 0x06, 0x69, 0x76, 0x28, 0x9d, 0x6f, 0x4f, 0xf2, 0x2f, 0x65, 0x09, 0x26,
-		0x29, 0xed, 0x8d, 0xae, 0x7f, 0x11, 0x4c, 0x0a, 0x5d, 0x04, 0xe3, 0xe4, 0x13, 0x41, 0x6f, 0x11,
-		0xa4, 0x5a, 0xba, 0xa7, 0xf3, 0x81, 0xd3, 0xbf, 0x95, 0x97, 0x20, 0xc3, 0x89, 0xb8, 0xe8, 0x09,
-		0x48, 0xda, 0x5d, 0x97, 0x6f, 0x30, 0x9e, 0x1c, 0x3a, 0x96, 0x8d, 0x6e, 0x35, 0xf5, 0xcb, 0x6f,
-		0xcd, 0x1f, 0x51, 0x09, 0xf4, 0xbb, 0x25, 0x2b, 0xdf, 0x93, 0x80, 0x53, 0x03, 0xfb, 0x1e, 0x5c,
-		0x5b, 0x0c, 0xbb, 0x98, 0x5e, 0x86, 0xcc, 0xb2, 0x50, 0x42, 0xb3, 0x30, 0xe9, 0x62, 0xc3, 0xb6,
-		0x5a, 0x2e, 0x97, 0x0b, 0xf1, 0x49, 0x98, 0x6c, 0xe9, 0x96, 0xed, 0xf2, 0x1b, 0x14, 0xec, 0xa3,
-		0xfa, 0x51, 0xe9, 0x70, 0x6b, 0xbf, 0x20, 0x5a, 0xa2, 0xeb, 0x7f, 0x53, 0x7a, 0xed, 0xe2, 0xc8,
-		0xcd, 0xc3, 0x1b, 0x96, 0x7d, 0xcb, 0xf2, 0x07, 0x11, 0xd9, 0x40, 0x3c, 0xd5, 0xbf, 0x81, 0xf8,
-		0x5e, 0xdc, 0x6e, 0xbf, 0x4c, 0xe0, 0x9b, 0x04, 0xd5, 0xe7, 0xca, 0xc7, 0x53, 0x83, 0x5c, 0xb9,
-		0xe5, 0xe8, 0xdd, 0x2e, 0x76, 0xdc, 0x61, 0x5c, 0x39, 0x03, 0xb9, 0xe5, 0xd0, 0x21, 0x81, 0x19,
-		0x71, 0x59, 0x47, 0xa2, 0x07, 0x08, 0xd8, 0x87, 0xa2, 0x00, 0x5c, 0x69, 0xdb, 0xba, 0x17, 0x03,
-		0x93, 0x08, 0xc1, 0xd4, 0x2d, 0xef, 0xf2, 0x93, 0x31, 0x30, 0x49, 0x01, 0x73, 0x06, 0x72, 0x5b,
-		0xc3, 0x80, 0x52, 0x51, 0x42, 0x4f, 0x5c, 0x8a, 0x81, 0x99, 0xe8, 0x23, 0x14, 0x0b, 0x54, 0x10,
-		0x40, 0xa7, 0x21, 0x5b, 0xb5, 0xed, 0x76, 0x0c, 0x48, 0x26, 0x44, 0xa7, 0x11, 0x3a, 0xff, 0x10,
-		0x01, 0xca, 0x86, 0x3a, 0x54, 0xdd, 0xf7, 0xb0, 0x1b, 0x03, 0x93, 0xe7, 0x30, 0x87, 0x17, 0x90,
-		0xf7, 0xf2, 0x79, 0x39, 0xac, 0x80, 0x88, 0xf9, 0xbc, 0x27, 0x01, 0xf9, 0xfe, 0x7c, 0x48, 0xc5,
-		0x32, 0xaa, 0x44, 0xc3, 0xea, 0x8e, 0xde, 0x11, 0x02, 0x32, 0xe5, 0xaf, 0x6b, 0x5a, 0x3f, 0x54,
-		0xc3, 0xce, 0x8d, 0x58, 0x7f, 0x73, 0x23, 0x24, 0x51, 0xf9, 0x6c, 0x12, 0x4a, 0x4b, 0xb6, 0xe5,
-		0x62, 0xcb, 0xed, 0xb9, 0x9b, 0xb4, 0x0b, 0xe8, 0x49, 0x98, 0xd8, 0x6e, 0xdb, 0xc6, 0x0d, 0xca,
-		0xdb, 0xdc, 0xa5, 0x53, 0x8b, 0x03, 0x9d, 0x59, 0xac, 0x92, 0x7a, 0x06, 0xae, 0x32, 0x60, 0xf4,
-		0x02, 0x64, 0xf0, 0x4d, 0xb3, 0x85, 0x2d, 0x03, 0x73, 0x4d, 0x7b, 0x3a, 0x06, 0xb1, 0xc6, 0x41,
-		0x38, 0xae, 0x8f, 0x82, 0xbe, 0x06, 0xb2, 0x37, 0xf5, 0xb6, 0xd9, 0xd2, 0x3d, 0xdb, 0xe1, 0x57,
-		0x8e, 0x94, 0x18, 0xfc, 0xeb, 0x02, 0x86, 0x13, 0x08, 0x90, 0x50, 0x19, 0x26, 0x6f, 0x62, 0x87,
-		0x9e, 0x6f, 0x61, 0x37, 0x81, 0x16, 0xe2, 0xf0, 0x19, 0x04, 0xc7, 0x16, 0x08, 0xe8, 0x29, 0x48,
-		0xe9, 0xdb, 0x86, 0x49, 0x8f, 0x3e, 0xe4, 0x2e, 0xdd, 0x1f, 0x83, 0x58, 0xa9, 0x2e, 0xd5, 0x19,
-		0x16, 0x3d, 0xfd, 0x47, 0xc1, 0x49, 0xa7, 0xdd, 0x7d, 0xcb, 0xd8, 0x73, 0x6c, 0x6b, 0x9f, 0x1e,
-		0xf6, 0x89, 0xef, 0x74, 0x43, 0xc0, 0x88, 0x4e, 0xfb, 0x48, 0xa4, 0xd3, 0x3b, 0x58, 0xf7, 0x7a,
-		0x0e, 0xe6, 0xf7, 0xe0, 0xe3, 0x3a, 0x7d, 0x85, 0x41, 0x88, 0x4e, 0x73, 0x04, 0xa5, 0x0e, 0xb9,
-		0xd0, 0x3c, 0xb0, 0x13, 0xf1, 0xb7, 0xb5, 0x6d, 0xb2, 0x48, 0xf8, 0x82, 0xcf, 0x74, 0xf4, 0xdb,
-		0x74, 0xd1, 0xa0, 0xe3, 0x30, 0x49, 0x2a, 0x77, 0xf9, 0x29, 0xc9, 0xa4, 0x9a, 0xee, 0xe8, 0xb7,
-		0x57, 0x74, 0xf7, 0x5a, 0x2a, 0x93, 0x94, 0x53, 0xca, 0xa7, 0x24, 0x28, 0x46, 0xa7, 0x06, 0x3d,
-		0x02, 0x88, 0x60, 0xe8, 0xbb, 0x58, 0xb3, 0x7a, 0x1d, 0x8d, 0x4e, 0xb2, 0xa0, 0x5b, 0xea, 0xe8,
-		0xb7, 0x2b, 0xbb, 0x78, 0xbd, 0xd7, 0xa1, 0x1d, 0x70, 0xd1, 0x1a, 0xc8, 0x02, 0x58, 0x08, 0xa0,
-		0x6f, 0x6e, 0x07, 0x6e, 0xe5, 0x73, 0x80, 0x6a, 0x86, 0x18, 0xa8, 0x0f, 0xff, 0xb7, 0x79, 0x49,
-		0x2d, 0x32, 0x7a, 0xbe, 0x61, 0x88, 0x0c, 0x25, 0x19, 0x1d, 0x8a, 0xf2, 0x12, 0x94, 0xfa, 0xa4,
-		0x00, 0x29, 0x50, 0xe8, 0xf6, 0xb6, 0xb5, 0x1b, 0x78, 0x9f, 0x5e, 0x13, 0x63, 0xe6, 0x31, 0xab,
-		0xe6, 0xba, 0xbd, 0xed, 0x97, 0xf1, 0x3e, 0x5d, 0x7d, 0xe5, 0xcc, 0xcf, 0x13, 0x07, 0xea, 0xcd,
-		0x79, 0x49, 0x79, 0x04, 0x0a, 0x11, 0x31, 0x20, 0x56, 0x58, 0xef, 0x76, 0xb9, 0xfe, 0x23, 0x7f,
-		0x86, 0x80, 0x5f, 0x83, 0x3c, 0x71, 0x3c, 0x70, 0x8b, 0xc3, 0x3e, 0x04, 0x25, 0xca, 0x0a, 0xad,
-		0x9f, 0xd7, 0x05, 0x5a, 0xbc, 0x26, 0x18, 0xae, 0x40, 0x21, 0x80, 0x0b, 0xd8, 0x9e, 0x13, 0x50,
-		0x2b, 0xba, 0xab, 0x7c, 0x9f, 0x04, 0xa5, 0x3e, 0xd9, 0x40, 0x2f, 0x40, 0xb6, 0xeb, 0x60, 0xc3,
-		0x74, 0xd9, 0x31, 0xa2, 0x11, 0x2c, 0x4c, 0x51, 0xf6, 0x05, 0x18, 0x68, 0x19, 0x0a, 0xe2, 0x48,
-		0x49, 0x0b, 0xb7, 0xf5, 0xfd, 0xd1, 0xb3, 0xc0, 0x48, 0x88, 0x27, 0x52, 0x96, 0x09, 0x92, 0xf2,
-		0x4b, 0x12, 0x14, 0x22, 0x42, 0x87, 0x5a, 0x70, 0xff, 0x4d, 0xdb, 0xc3, 0xe1, 0x54, 0x07, 0xbf,
-		0x3a, 0xb4, 0x87, 0xcd, 0xdd, 0x3d, 0x8f, 0x77, 0xf5, 0xe4, 0x40, 0x3b, 0x81, 0xa1, 0xa1, 0x0e,
-		0x89, 0xa4, 0xce, 0x11, 0x3a, 0x41, 0xc6, 0x83, 0xdd, 0x31, 0xba, 0x4a, 0x89, 0xa0, 0x0d, 0x40,
-		0xdd, 0x6d, 0xaf, 0x9f, 0x74, 0x62, 0x5c, 0xd2, 0x32, 0x41, 0x0e, 0x13, 0x54, 0x1a, 0x00, 0xc1,
-		0xc2, 0x45, 0x95, 0x71, 0x06, 0x91, 0x3c, 0xa8, 0x87, 0xe5, 0xc4, 0xac, 0x54, 0xdd, 0xfc, 0xe4,
-		0xe7, 0x4f, 0x0d, 0x35, 0x34, 0xaf, 0x5d, 0x1a, 0xdf, 0xa3, 0x12, 0xba, 0xdf, 0xb7, 0x0c, 0x7f,
-		0x9e, 0x86, 0xd3, 0x83, 0x96, 0xc1, 0x57, 0x71, 0x87, 0x35, 0x0e, 0x07, 0x47, 0x31, 0xca, 0x67,
-		0x24, 0xc8, 0xfb, 0x2b, 0xa9, 0x81, 0x3d, 0xf4, 0x3c, 0x80, 0xdf, 0x96, 0x70, 0x31, 0xef, 0x3b,
-		0x48, 0x09, 0xab, 0x21, 0x78, 0xf4, 0x0c, 0x64, 0xba, 0x8e, 0xdd, 0xb5, 0x5d, 0x7e, 0xf1, 0x75,
-		0x14, 0xae, 0x0f, 0x8d, 0x1e, 0x05, 0x44, 0x03, 0x02, 0xed, 0xa6, 0xed, 0x99, 0xd6, 0xae, 0xd6,
-		0xb5, 0x6f, 0xf1, 0xf7, 0x04, 0x92, 0xaa, 0x4c, 0x6b, 0xae, 0xd3, 0x8a, 0x4d, 0x52, 0xae, 0x7c,
-		0x5a, 0x82, 0xac, 0x4f, 0x85, 0xf8, 0x90, 0x7a, 0xab, 0xe5, 0x60, 0xd7, 0xe5, 0xae, 0x80, 0xf8,
-		0x44, 0xcf, 0xc3, 0x24, 0x57, 0x0a, 0xfe, 0xb5, 0xea, 0x38, 0x6f, 0x59, 0x44, 0x67, 0xdc, 0x5f,
-		0x4e, 0x33, 0x9d, 0x81, 0x4e, 0x43, 0x3e, 0xa6, 0x37, 0xb9, 0x9b, 0x41, 0x47, 0xe8, 0x73, 0x45,
-		0x7c, 0x08, 0x5a, 0xd7, 0x31, 0x6d, 0xc7, 0xf4, 0xf6, 0xa9, 0xe9, 0x49, 0xaa, 0xb2, 0xa8, 0xd8,
-		0xe4, 0xe5, 0x4a, 0x1b, 0x4a, 0x0d, 0xb3, 0xd3, 0xa5, 0x1e, 0x1e, 0xef, 0xfa, 0xe5, 0xa0, 0x83,
-		0xd2, 0x18, 0x1d, 0x1c, 0xda, 0xb5, 0xc4, 0x40, 0xd7, 0xce, 0xff, 0x86, 0xc4, 0x6d, 0x43, 0x7d,
-		0xf9, 0x4a, 0x5b, 0xdf, 0x45, 0x17, 0xe1, 0x68, 0x75, 0x75, 0x63, 0xe9, 0x65, 0xad, 0xbe, 0xac,
-		0x5d, 0x59, 0xad, 0xac, 0x04, 0xa7, 0x78, 0xe7, 0x8e, 0xdd, 0xb9, 0xbb, 0x80, 0x42, 0xb0, 0x5b,
-		0x16, 0x75, 0x71, 0xd0, 0x05, 0x98, 0x89, 0xa2, 0x54, 0xaa, 0x8d, 0xda, 0x7a, 0x53, 0x96, 0xe6,
-		0x8e, 0xde, 0xb9, 0xbb, 0x30, 0x15, 0xc2, 0xa8, 0x6c, 0xbb, 0xd8, 0xf2, 0x06, 0x11, 0x96, 0x36,
-		0xd6, 0xd6, 0xea, 0x4d, 0x39, 0x31, 0x80, 0xb0, 0x64, 0x77, 0x3a, 0xa6, 0x87, 0x1e, 0x86, 0xa9,
-		0x28, 0xc2, 0x7a, 0x7d, 0x55, 0x4e, 0xce, 0xa1, 0x3b, 0x77, 0x17, 0x8a, 0x21, 0xe8, 0x75, 0xb3,
-		0x3d, 0x97, 0xf9, 0xe0, 0x8f, 0x9c, 0x3a, 0xf2, 0xc9, 0x7f, 0x7c, 0x4a, 0xaa, 0xae, 0xbe, 0x2b,
-		0x0b, 0xef, 0xfb, 0x13, 0x30, 0xdf, 0xef, 0x29, 0x79, 0x66, 0x07, 0xbb, 0x9e, 0xde, 0xe9, 0x0e,
-		0x73, 0xda, 0x9f, 0x83, 0x6c, 0x53, 0xc0, 0x1c, 0x3a, 0x96, 0xb9, 0x7b, 0x48, 0x57, 0xb5, 0xe8,
-		0x37, 0x25, 0x7c, 0xd5, 0x4b, 0x63, 0xfa, 0xaa, 0xfe, 0x38, 0xee, 0xc9, 0x59, 0xfd, 0xed, 0x06,
-		0xdc, 0x17, 0x30, 0x71, 0xdb, 0x30, 0x89, 0x12, 0x61, 0xab, 0x99, 0xb1, 0x45, 0xf6, 0x65, 0x96,
-		0xd4, 0x12, 0x65, 0x74, 0xb0, 0xda, 0x99, 0x1b, 0x91, 0x5e, 0x98, 0x1b, 0xe1, 0x1b, 0xcf, 0x8d,
-		0xd6, 0x90, 0x43, 0xd4, 0xe1, 0xa8, 0x19, 0x56, 0xfe, 0x73, 0x16, 0x26, 0x55, 0xfc, 0xbe, 0x1e,
-		0x76, 0x3d, 0xf4, 0x04, 0xa4, 0xb0, 0xb1, 0x67, 0x0f, 0xae, 0x4c, 0x3e, 0xca, 0xc5, 0x9a, 0xb1,
-		0x67, 0x73, 0xe0, 0xab, 0x47, 0x54, 0x0a, 0x8c, 0x2e, 0xc3, 0xc4, 0x4e, 0xbb, 0xe7, 0xee, 0x71,
-		0x85, 0x73, 0x6a, 0x10, 0xeb, 0x0a, 0xa9, 0x0e, 0xd0, 0x18, 0x38, 0x69, 0x8c, 0x3e, 0xa7, 0x95,
-		0x1c, 0xd6, 0x18, 0x7d, 0x45, 0x2b, 0x68, 0x8c, 0x00, 0xa3, 0x25, 0x00, 0xd3, 0x32, 0x3d, 0xcd,
-		0xd8, 0xd3, 0x4d, 0x8b, 0x7b, 0xae, 0x4a, 0x1c, 0xaa, 0xe9, 0x2d, 0x11, 0x90, 0x00, 0x3f, 0x6b,
-		0x8a, 0x32, 0xd2, 0xe3, 0xf7, 0xf5, 0xb0, 0x23, 0xbc, 0xd7, 0x98, 0x1e, 0xbf, 0x87, 0x54, 0x87,
-		0x7a, 0x4c, 0xc1, 0x89, 0xb7, 0xcf, 0xae, 0x7a, 0x7b, 0xb7, 0xf9, 0x03, 0x26, 0x0b, 0x83, 0xa8,
-		0xf4, 0xa6, 0x77, 0xf3, 0x76, 0x80, 0x3c, 0x69, 0xb0, 0x12, 0xf4, 0x2c, 0xa4, 0x0d, 0xaa, 0x04,
-		0xe8, 0x05, 0xcc, 0xdc, 0xa5, 0xf9, 0x18, 0x64, 0x5a, 0x1f, 0xe0, 0x72, 0x04, 0xb4, 0x01, 0xc5,
-		0xb6, 0xe9, 0x7a, 0x9a, 0x6b, 0xe9, 0x5d, 0x77, 0xcf, 0xf6, 0x5c, 0xfa, 0x86, 0x58, 0xee, 0xd2,
-		0x43, 0x83, 0x24, 0x56, 0x4d, 0xd7, 0x6b, 0x08, 0xb0, 0x80, 0x52, 0xa1, 0x1d, 0x2e, 0x27, 0x04,
-		0xed, 0x9d, 0x1d, 0xec, 0xf8, 0x14, 0xe9, 0xdb, 0x63, 0xb1, 0x04, 0x37, 0x08, 0x9c, 0xc0, 0x0c,
-		0x11, 0xb4, 0xc3, 0xe5, 0xe8, 0xeb, 0x60, 0xba, 0x6d, 0xeb, 0x2d, 0x9f, 0x9e, 0x66, 0xec, 0xf5,
-		0xac, 0x1b, 0xb3, 0x45, 0x4a, 0xf5, 0x7c, 0x4c, 0x37, 0x6d, 0xbd, 0x25, 0x90, 0x97, 0x08, 0x68,
-		0x40, 0x79, 0xaa, 0xdd, 0x5f, 0x87, 0x34, 0x98, 0xd1, 0xbb, 0xdd, 0xf6, 0x7e, 0x3f, 0xf9, 0x12,
-		0x25, 0xff, 0xc8, 0x20, 0xf9, 0x0a, 0x81, 0x1e, 0x42, 0x1f, 0xe9, 0x03, 0x95, 0x68, 0x0b, 0xe4,
-		0xae, 0x83, 0xe9, 0xc9, 0x0c, 0x66, 0xc5, 0xf4, 0x36, 0xbd, 0x23, 0x99, 0xbb, 0x74, 0x6e, 0x90,
-		0xf8, 0x26, 0x83, 0xdc, 0xe4, 0x80, 0x01, 0xe5, 0x52, 0x37, 0x5a, 0xc3, 0xc8, 0xda, 0x06, 0xa6,
-		0x77, 0xb8, 0x39, 0xd9, 0xa9, 0xe1, 0x64, 0x29, 0x64, 0x2c, 0xd9, 0x48, 0x0d, 0xba, 0x02, 0x39,
-		0xf6, 0x6e, 0x0f, 0x71, 0x1e, 0x30, 0xbd, 0x5b, 0x99, 0xbb, 0x74, 0x26, 0x66, 0xb9, 0x52, 0xa0,
-		0xeb, 0xb6, 0x87, 0x03, 0x62, 0x80, 0xfd, 0x42, 0xb4, 0x0d, 0x47, 0xe9, 0x3d, 0xd3, 0x7d, 0x2d,
-		0xea, 0x22, 0xce, 0x4e, 0x53, 0x8a, 0x8f, 0x0e, 0x52, 0xa4, 0x8f, 0x2c, 0xed, 0x5f, 0x0f, 0xfb,
-		0x8a, 0x01, 0xe9, 0xe9, 0x9b, 0x83, 0xb5, 0x44, 0xd2, 0x76, 0x4c, 0x4b, 0x6f, 0x9b, 0x6f, 0x60,
-		0x16, 0x4f, 0xd1, 0x27, 0x16, 0x62, 0x25, 0xed, 0x0a, 0x87, 0xa3, 0x76, 0x30, 0x24, 0x69, 0x3b,
-		0xe1, 0xf2, 0xea, 0x24, 0xcf, 0x82, 0xf8, 0x77, 0x86, 0x27, 0xe5, 0x0c, 0xbb, 0x27, 0x7c, 0x2d,
-		0x95, 0x01, 0x39, 0xa7, 0x9c, 0x85, 0x5c, 0x48, 0x4f, 0x11, 0x23, 0xc5, 0xfd, 0x7c, 0x9e, 0x5b,
-		0x11, 0x9f, 0x4a, 0x11, 0xf2, 0x61, 0xd5, 0xa4, 0x7c, 0x48, 0x82, 0x5c, 0x48, 0xe9, 0x10, 0x4c,
-		0x11, 0x5c, 0x73, 0x4c, 0x11, 0x3a, 0x9f, 0x11, 0x81, 0x8e, 0xa8, 0x4f, 0xd0, 0x30, 0x2a, 0x4f,
-		0x0b, 0x79, 0x9c, 0x85, 0xe6, 0x21, 0xd7, 0xbd, 0xd4, 0xf5, 0x41, 0x92, 0x14, 0x04, 0xba, 0x97,
-		0xba, 0x02, 0xe0, 0x34, 0xe4, 0xc9, 0xd0, 0xb5, 0x70, 0x04, 0x9f, 0x55, 0x73, 0xa4, 0x8c, 0x83,
-		0x28, 0xbf, 0x96, 0x00, 0xb9, 0x5f, 0x99, 0xa1, 0x67, 0x20, 0x45, 0xb4, 0x38, 0x57, 0xd3, 0x73,
-		0x03, 0x31, 0x82, 0x6f, 0x35, 0x59, 0xb4, 0xf9, 0x21, 0x12, 0xeb, 0x50, 0x0c, 0x74, 0x82, 0x68,
-		0x30, 0xdd, 0xb4, 0x34, 0xb3, 0x25, 0xde, 0xa9, 0xa4, 0xdf, 0xf5, 0x16, 0x89, 0x66, 0x0d, 0x91,
-		0x13, 0xd1, 0x98, 0xed, 0x39, 0x20, 0x25, 0xd1, 0x97, 0x3e, 0x51, 0x4b, 0x46, 0x5f, 0x3e, 0x65,
-		0x25, 0xe2, 0x56, 0xb3, 0xd7, 0x6f, 0x4e, 0xc7, 0xc8, 0x93, 0x80, 0xd9, 0xea, 0xb6, 0x74, 0x0f,
-		0x73, 0x7f, 0x34, 0xec, 0x61, 0x3f, 0x04, 0x25, 0xbd, 0xdb, 0xd5, 0x5c, 0x4f, 0xf7, 0x30, 0x8f,
-		0x3d, 0x27, 0xa8, 0xcf, 0x5b, 0xd0, 0xbb, 0x5d, 0xfa, 0xce, 0x17, 0x8b, 0x3d, 0x1f, 0x84, 0x22,
-		0xd1, 0xf0, 0xa6, 0xde, 0x16, 0x81, 0x4d, 0x9a, 0x85, 0xa8, 0xbc, 0x94, 0x07, 0x47, 0x2d, 0xc8,
-		0x87, 0x95, 0xbb, 0x9f, 0x7a, 0x96, 0x82, 0xd4, 0x33, 0x29, 0xa3, 0x17, 0x4f, 0x18, 0x87, 0xc4,
-		0x65, 0x9d, 0x34, 0x27, 0xcb, 0x9c, 0x62, 0xfe, 0x45, 0x1c, 0x9d, 0xae, 0x63, 0xdf, 0x64, 0x97,
-		0xa9, 0x32, 0x2a, 0xfb, 0x50, 0x5e, 0x85, 0x62, 0xd4, 0x0e, 0xa0, 0x22, 0x24, 0xbc, 0xdb, 0xbc,
-		0x95, 0x84, 0x77, 0x1b, 0x5d, 0x0c, 0xbd, 0x90, 0x56, 0x8c, 0xb3, 0x7e, 0x1c, 0x3f, 0x78, 0xda,
-		0xeb, 0x5a, 0x2a, 0x93, 0x90, 0x93, 0x4a, 0x09, 0x0a, 0x11, 0x2b, 0xa1, 0x1c, 0x83, 0x99, 0x38,
-		0x9d, 0xaf, 0x98, 0x30, 0x13, 0xa7, 0xba, 0xd1, 0x65, 0xc8, 0xf8, 0x4a, 0x5f, 0x48, 0xd0, 0x40,
-		0xeb, 0x3e, 0x92, 0x0f, 0x4b, 0x64, 0x87, 0x4c, 0x04, 0xdd, 0xa1, 0x48, 0xf0, 0xa8, 0xa3, 0xdb,
-		0xbd, 0xaa, 0xbb, 0x7b, 0xca, 0x37, 0xc2, 0xec, 0x30, 0x7d, 0x1e, 0x62, 0x1c, 0x4b, 0x35, 0x08,
-		0xc6, 0x1d, 0x83, 0x34, 0x7f, 0x6d, 0x21, 0x41, 0x33, 0xa7, 0xfc, 0x8b, 0x30, 0x94, 0xe9, 0xf6,
-		0x24, 0x4b, 0xa8, 0xd2, 0x0f, 0x45, 0x83, 0x13, 0x43, 0x55, 0x7a, 0xb0, 0xa5, 0xc2, 0x73, 0xb0,
-		0x6c, 0x4b, 0xc5, 0x27, 0xc4, 0x3a, 0xcb, 0x3e, 0xe8, 0x2b, 0x9c, 0xd8, 0x6a, 0xf1, 0xe0, 0x26,
-		0xab, 0xf2, 0x2f, 0xe5, 0x23, 0x49, 0x38, 0x16, 0xaf, 0xd7, 0xd1, 0x02, 0xe4, 0x3b, 0xfa, 0x6d,
-		0xcd, 0x8b, 0xa6, 0x3e, 0xa0, 0xa3, 0xdf, 0x6e, 0xf2, 0xbc, 0x87, 0x0c, 0x49, 0xef, 0xb6, 0x4b,
-		0x2f, 0x72, 0xe5, 0x55, 0xf2, 0x27, 0xba, 0x0e, 0x53, 0x6d, 0xdb, 0xd0, 0xdb, 0x5a, 0x5b, 0x77,
-		0x3d, 0x8d, 0x9b, 0x7d, 0xb6, 0x9c, 0x1e, 0x18, 0xa6, 0xa7, 0xd9, 0x75, 0x2b, 0xd3, 0x23, 0x2a,
-		0x88, 0x2f, 0x84, 0x12, 0x25, 0xb2, 0xaa, 0xbb, 0x1e, 0x0f, 0x1f, 0x6a, 0x90, 0xeb, 0x98, 0xee,
-		0x36, 0xde, 0xd3, 0x6f, 0x9a, 0xb6, 0xc3, 0xd7, 0x55, 0x8c, 0xf4, 0xac, 0x05, 0x40, 0x9c, 0x54,
-		0x18, 0x2f, 0x34, 0x29, 0x13, 0x11, 0x69, 0x16, 0x9a, 0x25, 0x7d, 0x68, 0xcd, 0xf2, 0x38, 0xcc,
-		0x58, 0xf8, 0x36, 0xbd, 0x2b, 0xc8, 0x57, 0x2e, 0x93, 0x14, 0x76, 0xd5, 0x0f, 0x91, 0x3a, 0x7f,
-		0xad, 0xbb, 0x74, 0x57, 0xeb, 0x61, 0xf0, 0x03, 0x46, 0x4d, 0x44, 0xb3, 0x19, 0x0a, 0x5d, 0x12,
-		0xe5, 0x15, 0x56, 0xac, 0xdc, 0xa1, 0x93, 0x13, 0x67, 0x1d, 0x05, 0xeb, 0xa5, 0x80, 0xf5, 0x4d,
-		0x98, 0xe1, 0xf8, 0xad, 0x08, 0xf7, 0x07, 0xc2, 0xf3, 0xa8, 0xd3, 0x15, 0xe2, 0x3a, 0x12, 0xf8,
-		0xc3, 0x19, 0x9f, 0xbc, 0x47, 0xc6, 0x23, 0x48, 0x51, 0xb6, 0xa4, 0x98, 0xba, 0x21, 0x7f, 0xff,
-		0xbf, 0x36, 0x19, 0x1f, 0x48, 0xc2, 0xd4, 0x80, 0x63, 0xe1, 0x0f, 0x4c, 0x8a, 0x1d, 0x58, 0x22,
-		0x76, 0x60, 0xc9, 0x43, 0x0f, 0x8c, 0xcf, 0x76, 0x6a, 0xf4, 0x6c, 0x4f, 0xbc, 0x93, 0xb3, 0x9d,
-		0xbe, 0xc7, 0xd9, 0x7e, 0x57, 0xe7, 0xe1, 0x63, 0x12, 0xcc, 0x0d, 0x77, 0xc7, 0x62, 0x27, 0xe4,
-		0x11, 0x98, 0xf2, 0xbb, 0xe2, 0x93, 0x67, 0xea, 0x51, 0xf6, 0x2b, 0x38, 0xfd, 0xa1, 0x16, 0xef,
-		0x41, 0x28, 0xf6, 0x79, 0x8b, 0x4c, 0x98, 0x0b, 0x91, 0x0c, 0xa2, 0xf2, 0xad, 0x49, 0x98, 0x89,
-		0x73, 0xe8, 0x62, 0x56, 0xac, 0x0a, 0xd3, 0x2d, 0x6c, 0x98, 0xad, 0x7b, 0x5e, 0xb0, 0x53, 0x1c,
-		0xfd, 0xff, 0xaf, 0xd7, 0x18, 0x39, 0xf9, 0x51, 0x80, 0x8c, 0x8a, 0xdd, 0x2e, 0x71, 0xd0, 0xd8,
-		0x6b, 0xcf, 0x06, 0xee, 0x7a, 0x41, 0xa6, 0x3d, 0x36, 0x6e, 0xe0, 0x20, 0x02, 0x8f, 0xc4, 0xcf,
-		0x3e, 0x1e, 0x7a, 0x92, 0xa7, 0x09, 0x86, 0x06, 0xfc, 0xcc, 0xfd, 0xf6, 0x51, 0x59, 0x9e, 0xe0,
-		0x69, 0x91, 0x27, 0x48, 0x0e, 0x8b, 0x7e, 0xb9, 0x33, 0xee, 0xe3, 0xf1, 0x44, 0xc1, 0x93, 0x3c,
-		0x51, 0x90, 0x1a, 0xd6, 0x1c, 0xf3, 0xd9, 0x83, 0xe6, 0x4c, 0x76, 0x91, 0x3b, 0x9c, 0x29, 0x48,
-		0x0f, 0x1b, 0x6a, 0xc8, 0xb9, 0x0e, 0x86, 0x1a, 0xa4, 0x0a, 0x9e, 0x16, 0xa9, 0x82, 0xc9, 0x61,
-		0x9d, 0xe6, 0xde, 0x64, 0xd0, 0x69, 0x96, 0x2b, 0x78, 0x31, 0x94, 0x2b, 0xc8, 0xf6, 0xef, 0x0c,
-		0x0e, 0xe4, 0x0a, 0x7c, 0x6c, 0x3f, 0x59, 0x50, 0xf6, 0x93, 0x05, 0xf9, 0xa1, 0x99, 0x06, 0xee,
-		0x06, 0xfa, 0xc8, 0x22, 0x5b, 0xb0, 0x39, 0x90, 0x2d, 0x60, 0xc1, 0xfd, 0xd9, 0x91, 0xd9, 0x02,
-		0x9f, 0x54, 0x5f, 0xba, 0x60, 0x73, 0x20, 0x5d, 0x50, 0x1c, 0x46, 0xb1, 0xcf, 0xe7, 0x0c, 0x28,
-		0x46, 0xf3, 0x05, 0x5f, 0x1f, 0x9f, 0x2f, 0x18, 0x1a, 0xd0, 0xc7, 0xf8, 0x97, 0x3e, 0xe9, 0x98,
-		0x84, 0xc1, 0x37, 0x0e, 0x49, 0x18, 0xc8, 0xc3, 0x02, 0xdb, 0x38, 0xef, 0xd2, 0x6f, 0x20, 0x2e,
-		0x63, 0x70, 0x3d, 0x26, 0x63, 0xc0, 0x42, 0xfb, 0x87, 0xc7, 0xc8, 0x18, 0xf8, 0xa4, 0x07, 0x52,
-		0x06, 0xd7, 0x63, 0x52, 0x06, 0x68, 0x38, 0xdd, 0x3e, 0xa7, 0x28, 0x4c, 0x37, 0x9a, 0x33, 0x58,
-		0x89, 0xe6, 0x0c, 0xa6, 0x0f, 0xf6, 0x45, 0x99, 0x69, 0xf7, 0xa9, 0x85, 0x93, 0x06, 0xc6, 0xb0,
-		0xa4, 0x01, 0x8b, 0xeb, 0x1f, 0x1b, 0x33, 0x69, 0xe0, 0xd3, 0x8e, 0xcd, 0x1a, 0x6c, 0x0e, 0x64,
-		0x0d, 0x8e, 0x0e, 0x13, 0xb8, 0x3e, 0x23, 0x13, 0x08, 0xdc, 0xd0, 0xb4, 0x01, 0x7b, 0x64, 0x8c,
-		0x3d, 0x2f, 0x06, 0x72, 0xee, 0x5a, 0x2a, 0x93, 0x93, 0xf3, 0xca, 0xc3, 0xc4, 0xad, 0xe9, 0xd3,
-		0x7b, 0x24, 0x88, 0xc0, 0x8e, 0x63, 0x3b, 0xe2, 0x58, 0x06, 0xfd, 0x50, 0xce, 0x41, 0x3e, 0xac,
-		0xe2, 0x0e, 0x48, 0x31, 0x94, 0xa0, 0x10, 0xd1, 0x6a, 0xca, 0xcf, 0x4b, 0x90, 0x0f, 0xeb, 0xab,
-		0x48, 0x00, 0x9a, 0xe5, 0x01, 0x68, 0x28, 0xf1, 0x90, 0x88, 0x26, 0x1e, 0xe6, 0x21, 0x47, 0x82,
-		0xb0, 0xbe, 0x9c, 0x82, 0xde, 0xf5, 0x73, 0x0a, 0xe7, 0x61, 0x8a, 0xda, 0x50, 0x96, 0x9e, 0xe0,
-		0x76, 0x8a, 0xed, 0xcf, 0x94, 0x48, 0x05, 0x65, 0x06, 0xdf, 0x79, 0x7c, 0x0c, 0xa6, 0x43, 0xb0,
-		0x7e, 0x70, 0xc7, 0xc2, 0x6b, 0xd9, 0x87, 0xae, 0xf0, 0x28, 0xef, 0x97, 0x24, 0x98, 0x1a, 0x50,
-		0x97, 0xb1, 0x79, 0x03, 0xe9, 0x9d, 0xca, 0x1b, 0x24, 0xee, 0x3d, 0x6f, 0x10, 0x0e, 0x57, 0x93,
-		0xd1, 0x70, 0xf5, 0x2f, 0x24, 0x28, 0x44, 0xd4, 0x36, 0x99, 0x04, 0xc3, 0x6e, 0x89, 0x43, 0x3c,
-		0xf4, 0x6f, 0xe2, 0xa7, 0xb4, 0xed, 0x5d, 0x1e, 0x26, 0x92, 0x3f, 0x09, 0x94, 0x6f, 0x88, 0xb2,
-		0xdc, 0xcc, 0xf8, 0xb1, 0xe7, 0x44, 0xf8, 0x38, 0x1f, 0x3f, 0xe2, 0x96, 0x0e, 0x8e, 0xb8, 0xf9,
-		0x67, 0x77, 0x26, 0x43, 0x67, 0x77, 0xd0, 0xb3, 0x90, 0xa5, 0xbb, 0x00, 0x9a, 0xdd, 0x0d, 0x7e,
-		0x98, 0x62, 0xf8, 0xf1, 0x36, 0x97, 0xee, 0x1f, 0xb2, 0x33, 0x71, 0x81, 0x17, 0x92, 0x8d, 0x78,
-		0x21, 0xf7, 0x41, 0x96, 0x74, 0x9f, 0x3d, 0xee, 0x08, 0xfc, 0x30, 0xad, 0x28, 0x50, 0x7e, 0x22,
-		0x01, 0xa5, 0x3e, 0xab, 0x13, 0x3b, 0x78, 0x21, 0x95, 0x89, 0x50, 0x5a, 0x64, 0x3c, 0x86, 0x9c,
-		0x02, 0xd8, 0xd5, 0x5d, 0xed, 0x96, 0x6e, 0x79, 0xfc, 0x0d, 0xf7, 0xa4, 0x1a, 0x2a, 0x41, 0x73,
-		0x90, 0x21, 0x5f, 0x3d, 0x97, 0xbf, 0xe2, 0x9e, 0x54, 0xfd, 0x6f, 0x54, 0x87, 0x34, 0xbe, 0x49,
-		0x9f, 0x23, 0x61, 0x8f, 0xfa, 0x1c, 0x8f, 0x51, 0x4f, 0xa4, 0xbe, 0x3a, 0x4b, 0xa6, 0xfb, 0x0f,
-		0xde, 0x9a, 0x97, 0x19, 0xf8, 0xa3, 0xfe, 0xf3, 0x0b, 0x2a, 0x27, 0x10, 0x65, 0x43, 0xa6, 0x8f,
-		0x0d, 0x34, 0x5d, 0x98, 0x17, 0xb1, 0x3f, 0x61, 0x2a, 0xdb, 0xb0, 0x54, 0x0b, 0x1d, 0xdc, 0xe9,
-		0xda, 0x76, 0x5b, 0x63, 0xeb, 0xbc, 0x02, 0xc5, 0xa8, 0x91, 0x65, 0x2f, 0x2f, 0x7b, 0xba, 0x69,
-		0x69, 0x11, 0xdf, 0x38, 0xcf, 0x0a, 0xd9, 0xba, 0xba, 0x96, 0xca, 0x48, 0x72, 0x82, 0xa7, 0x6b,
-		0xde, 0x03, 0x47, 0x63, 0x6d, 0x2c, 0x7a, 0x06, 0xb2, 0x81, 0x7d, 0x66, 0xdb, 0xce, 0x07, 0xe5,
-		0x61, 0x02, 0x60, 0xe5, 0x3a, 0x1c, 0x8d, 0x35, 0xb2, 0xe8, 0x05, 0x48, 0x3b, 0xd8, 0xed, 0xb5,
-		0x3d, 0xfe, 0x2c, 0xe2, 0x83, 0xa3, 0xad, 0x73, 0xaf, 0xed, 0xa9, 0x1c, 0x49, 0xb9, 0x08, 0x27,
-		0x86, 0x5a, 0xd9, 0x20, 0x9b, 0x22, 0x85, 0xb2, 0x29, 0xca, 0x4f, 0x49, 0x30, 0x37, 0xdc, 0x72,
-		0xa2, 0x6a, 0x5f, 0x87, 0xce, 0x8f, 0x69, 0x77, 0x43, 0xbd, 0x22, 0xe1, 0x86, 0x83, 0x77, 0xb0,
-		0x67, 0xec, 0x31, 0x13, 0xce, 0x94, 0x42, 0x41, 0x2d, 0xf0, 0x52, 0x8a, 0xe3, 0x32, 0xb0, 0xd7,
-		0xb1, 0xe1, 0x69, 0x6c, 0x52, 0x5d, 0xfe, 0x53, 0x3b, 0x05, 0x56, 0xda, 0x60, 0x85, 0xca, 0x23,
-		0x70, 0x7c, 0x88, 0x2d, 0x1e, 0x8c, 0x4b, 0x94, 0xd7, 0x08, 0x70, 0xac, 0x81, 0x45, 0x2f, 0x41,
-		0xda, 0xf5, 0x74, 0xaf, 0xe7, 0xf2, 0x91, 0x9d, 0x1d, 0x69, 0x9b, 0x1b, 0x14, 0x5c, 0xe5, 0x68,
-		0xca, 0x73, 0x80, 0x06, 0x2d, 0x6d, 0x4c, 0x6c, 0x25, 0xc5, 0xc5, 0x56, 0xdb, 0x70, 0xf2, 0x00,
-		0x9b, 0x8a, 0x96, 0xfa, 0x3a, 0xf7, 0xc8, 0x58, 0x26, 0xb9, 0xaf, 0x83, 0x7f, 0x94, 0x80, 0xa3,
-		0xb1, 0xa6, 0x35, 0xb4, 0x4a, 0xa5, 0xb7, 0xbb, 0x4a, 0x5f, 0x00, 0xf0, 0x6e, 0x6b, 0x6c, 0xa6,
-		0x85, 0xb6, 0x8f, 0x8b, 0x27, 0x6e, 0x63, 0x83, 0x2a, 0x2c, 0x22, 0x18, 0x59, 0x8f, 0xff, 0x45,
-		0x82, 0xff, 0x50, 0x3c, 0xdb, 0xa3, 0x96, 0xc0, 0xe5, 0xa1, 0xde, 0xd8, 0x36, 0x23, 0x08, 0x7c,
-		0x59, 0xb1, 0x8b, 0x5e, 0x83, 0xe3, 0x7d, 0x16, 0xcd, 0xa7, 0x9d, 0x1a, 0xdb, 0xb0, 0x1d, 0x8d,
-		0x1a, 0x36, 0x41, 0x3b, 0x6c, 0x95, 0x26, 0xa2, 0x56, 0xe9, 0x35, 0x80, 0x20, 0xb0, 0x25, 0xeb,
-		0xcd, 0xb1, 0x7b, 0x56, 0x4b, 0x1c, 0x3e, 0xa5, 0x1f, 0xe8, 0x32, 0x4c, 0x10, 0x49, 0x10, 0xac,
-		0x8a, 0x51, 0x18, 0x64, 0x4a, 0x43, 0x91, 0x31, 0x03, 0x57, 0x5e, 0x17, 0xd2, 0x16, 0xce, 0x31,
-		0x0e, 0x69, 0xe3, 0xc5, 0x68, 0x1b, 0xca, 0xf0, 0x74, 0x65, 0x7c, 0x5b, 0x7f, 0x07, 0x26, 0xe8,
-		0xf4, 0xc7, 0x9e, 0xfd, 0xfe, 0x06, 0x00, 0xdd, 0xf3, 0x1c, 0x73, 0xbb, 0x17, 0xb4, 0xb0, 0x30,
-		0x44, 0x7e, 0x2a, 0x02, 0xb0, 0x7a, 0x1f, 0x17, 0xa4, 0x99, 0x00, 0x37, 0x24, 0x4c, 0x21, 0x8a,
-		0xca, 0x3a, 0x14, 0xa3, 0xb8, 0xf1, 0x87, 0xd9, 0xc5, 0xaf, 0x02, 0x04, 0x47, 0x6d, 0x03, 0x43,
-		0xce, 0x6f, 0x0b, 0xd1, 0x0f, 0xe5, 0x9b, 0x12, 0x90, 0x0f, 0x4b, 0xdf, 0xdf, 0x42, 0x63, 0xa9,
-		0x7c, 0xab, 0x04, 0x19, 0x7f, 0xfc, 0xd1, 0x74, 0x7e, 0x64, 0x1f, 0x24, 0xb8, 0xd6, 0xe0, 0xe7,
-		0xe0, 0xd9, 0xae, 0x47, 0xd2, 0xdf, 0xf5, 0x78, 0xde, 0x37, 0x08, 0x43, 0x83, 0xf9, 0x30, 0xb7,
-		0xc5, 0xf1, 0x24, 0x6e, 0xa0, 0x9e, 0x1b, 0xef, 0x0c, 0xd4, 0x0c, 0x4c, 0x84, 0x8f, 0x2f, 0xb1,
-		0x0f, 0x05, 0x87, 0x4e, 0x50, 0xb2, 0xd5, 0x18, 0x3e, 0x2c, 0x25, 0x1d, 0xfe, 0xb0, 0x94, 0xdf,
-		0x4c, 0x22, 0xdc, 0xcc, 0x3f, 0x92, 0x20, 0x23, 0xd6, 0x05, 0x7a, 0x29, 0x7c, 0xbe, 0x57, 0x1c,
-		0x16, 0x1c, 0xae, 0x97, 0x78, 0x03, 0xa1, 0xe3, 0xbd, 0x55, 0xb1, 0xcf, 0x68, 0xb6, 0xb4, 0x9d,
-		0xb6, 0xbe, 0xcb, 0xb7, 0x8b, 0x86, 0x9e, 0x4e, 0x66, 0x87, 0x87, 0xf8, 0x81, 0xcb, 0x7a, 0x8b,
-		0x7c, 0x70, 0x3f, 0xe4, 0xcf, 0x24, 0x90, 0xfb, 0xd7, 0xed, 0xdb, 0xef, 0xdf, 0xa0, 0xbd, 0x4a,
-		0xc6, 0xd8, 0x2b, 0x74, 0x01, 0xa6, 0x83, 0x1f, 0xe6, 0x72, 0xcd, 0x5d, 0x8b, 0x1d, 0xfe, 0x65,
-		0x49, 0x35, 0xe4, 0x57, 0x35, 0x44, 0xcd, 0xe0, 0xb8, 0x27, 0xee, 0x75, 0xdc, 0x1f, 0x48, 0x40,
-		0x2e, 0x94, 0xe3, 0x43, 0x4f, 0x85, 0x94, 0x52, 0x31, 0xce, 0x4a, 0x84, 0x80, 0x43, 0x3f, 0xab,
-		0x13, 0xe1, 0x54, 0xe2, 0x1e, 0x38, 0x35, 0x2c, 0x9b, 0x2a, 0x92, 0x86, 0xa9, 0x43, 0x27, 0x0d,
-		0xe3, 0x0f, 0x10, 0x4e, 0x0c, 0x39, 0x40, 0xf8, 0xf7, 0x24, 0xc8, 0xf8, 0xc9, 0x97, 0xc3, 0xee,
-		0xc9, 0x1d, 0x83, 0x34, 0xf7, 0xbd, 0xd8, 0xa6, 0x1c, 0xff, 0x8a, 0xcd, 0x8e, 0xce, 0x41, 0x46,
-		0xbc, 0x32, 0xcf, 0x2d, 0x9c, 0xff, 0x7d, 0x7e, 0x1b, 0x72, 0xa1, 0x6d, 0x4d, 0x74, 0x02, 0x8e,
-		0x2e, 0x5d, 0xad, 0x2d, 0xbd, 0xac, 0x35, 0x5f, 0xe9, 0x7f, 0x5b, 0x78, 0xa0, 0x4a, 0xad, 0xd1,
-		0x6f, 0x59, 0x42, 0xc7, 0x61, 0x3a, 0x5a, 0xc5, 0x2a, 0x12, 0x73, 0xa9, 0x0f, 0xfe, 0xc8, 0xa9,
-		0x23, 0xe7, 0xff, 0x4c, 0x82, 0xe9, 0x18, 0x2f, 0x17, 0x9d, 0x86, 0xfb, 0x37, 0xae, 0x5c, 0xa9,
-		0xa9, 0x5a, 0x63, 0xbd, 0xb2, 0xd9, 0xb8, 0xba, 0xd1, 0xd4, 0xd4, 0x5a, 0x63, 0x6b, 0xb5, 0x19,
-		0x6a, 0x74, 0x01, 0xee, 0x8b, 0x07, 0xa9, 0x2c, 0x2d, 0xd5, 0x36, 0x9b, 0xec, 0x71, 0xe3, 0x21,
-		0x10, 0xd5, 0x0d, 0xb5, 0x29, 0x27, 0x86, 0x93, 0x50, 0x6b, 0xd7, 0x6a, 0x4b, 0x4d, 0x39, 0x89,
-		0xce, 0xc2, 0x99, 0x83, 0x20, 0xb4, 0x2b, 0x1b, 0xea, 0x5a, 0xa5, 0x29, 0xa7, 0x46, 0x02, 0x36,
-		0x6a, 0xeb, 0xcb, 0x35, 0x55, 0x9e, 0xe0, 0xe3, 0x7e, 0x33, 0x01, 0xb3, 0xc3, 0x9c, 0x69, 0x42,
-		0xab, 0xb2, 0xb9, 0xb9, 0xfa, 0x6a, 0x40, 0x6b, 0xe9, 0xea, 0xd6, 0xfa, 0xcb, 0x83, 0x2c, 0x78,
-		0x08, 0x94, 0x83, 0x00, 0x7d, 0x46, 0x3c, 0x08, 0xa7, 0x0f, 0x84, 0xe3, 0xec, 0x18, 0x01, 0xa6,
-		0xd6, 0x9a, 0xea, 0xab, 0x72, 0x12, 0x2d, 0xc2, 0xf9, 0x91, 0x60, 0x7e, 0x9d, 0x9c, 0x42, 0x17,
-		0xe0, 0x91, 0x83, 0xe1, 0x19, 0x83, 0x04, 0x82, 0x60, 0xd1, 0x1d, 0x09, 0x8e, 0xc6, 0x7a, 0xe5,
-		0xe8, 0x0c, 0xcc, 0x6f, 0xaa, 0x1b, 0x4b, 0xb5, 0x46, 0x43, 0xdb, 0x54, 0x37, 0x36, 0x37, 0x1a,
-		0x95, 0x55, 0xad, 0xd1, 0xac, 0x34, 0xb7, 0x1a, 0x21, 0xde, 0x28, 0x70, 0x6a, 0x18, 0x90, 0xcf,
-		0x97, 0x03, 0x60, 0xb8, 0x04, 0x08, 0x39, 0xbd, 0x2b, 0xc1, 0x89, 0xa1, 0x5e, 0x38, 0x3a, 0x07,
-		0x0f, 0xd0, 0xdf, 0x29, 0x7b, 0x55, 0xbb, 0xbe, 0xd1, 0x0c, 0xbf, 0xa2, 0x3d, 0xd0, 0xab, 0xb3,
-		0x70, 0xe6, 0x40, 0x48, 0xbf, 0x6b, 0xa3, 0x00, 0xfb, 0xfa, 0xf7, 0x2d, 0x12, 0x94, 0xfa, 0x74,
-		0x21, 0xba, 0x0f, 0x66, 0xd7, 0xea, 0x8d, 0x6a, 0xed, 0x6a, 0xe5, 0x7a, 0x7d, 0x43, 0xed, 0x5f,
-		0xb3, 0x67, 0x60, 0x7e, 0xa0, 0x76, 0x79, 0x6b, 0x73, 0xb5, 0xbe, 0x54, 0x69, 0xd6, 0x68, 0xa3,
-		0xb2, 0x44, 0x06, 0x36, 0x00, 0xb4, 0x5a, 0x5f, 0xb9, 0xda, 0xd4, 0x96, 0x56, 0xeb, 0xb5, 0xf5,
-		0xa6, 0x56, 0x69, 0x36, 0x2b, 0xc1, 0x72, 0xae, 0xbe, 0x3c, 0xf4, 0xe8, 0xeb, 0xc5, 0xf1, 0x8f,
-		0xbe, 0xf2, 0x23, 0x9c, 0xc1, 0x6d, 0xb5, 0x04, 0xcc, 0xfb, 0x95, 0x3c, 0x97, 0xd6, 0x7f, 0xc4,
-		0x73, 0xda, 0xd7, 0xee, 0x1c, 0x60, 0xf8, 0x8d, 0xcf, 0x17, 0x20, 0x59, 0xe9, 0x76, 0x89, 0xe6,
-		0xa3, 0xdf, 0x86, 0xdd, 0xe6, 0x7a, 0xd5, 0xff, 0x26, 0x75, 0xae, 0xbd, 0xe3, 0xdd, 0xd2, 0x1d,
-		0xff, 0x97, 0xd7, 0xc4, 0xb7, 0xf2, 0x2c, 0x64, 0xfd, 0xe8, 0x81, 0xbe, 0x5d, 0xea, 0xdf, 0x43,
-		0x4a, 0x89, 0x7b, 0x46, 0xfc, 0xb2, 0x46, 0x22, 0xb8, 0xac, 0x91, 0xfa, 0xe2, 0x9b, 0xf3, 0x52,
-		0x75, 0x7d, 0x28, 0x77, 0x9e, 0x1c, 0x9f, 0x3b, 0x01, 0x03, 0x7c, 0x06, 0x7d, 0xef, 0xfd, 0xa1,
-		0xdb, 0xc0, 0xfe, 0x89, 0xd3, 0x30, 0x7b, 0x62, 0xce, 0xe3, 0x8f, 0x3a, 0xe3, 0x3a, 0xc6, 0x19,
-		0xd6, 0x51, 0xb3, 0x72, 0xaf, 0x87, 0x5c, 0x9f, 0x85, 0xc2, 0xa6, 0xee, 0x78, 0x0d, 0xec, 0x5d,
-		0xc5, 0x7a, 0x0b, 0x3b, 0xd1, 0xbb, 0xb9, 0x05, 0x71, 0x37, 0x57, 0xd8, 0xb3, 0x44, 0x60, 0xcf,
-		0x14, 0x13, 0x52, 0xf4, 0x39, 0xe1, 0xa1, 0x87, 0x4c, 0xd8, 0xa1, 0x10, 0x7e, 0xc8, 0x84, 0x7e,
-		0xa0, 0xa7, 0xc4, 0xed, 0xdb, 0xe4, 0x88, 0xdb, 0xb7, 0x22, 0x72, 0x62, 0x77, 0x70, 0x3b, 0x30,
-		0xc9, 0xbd, 0x99, 0xd8, 0xdd, 0xdb, 0x75, 0x28, 0x75, 0x75, 0xc7, 0xa3, 0xbf, 0x56, 0xb2, 0x47,
-		0x87, 0xc1, 0x3d, 0x91, 0xb8, 0xeb, 0x53, 0x91, 0xe1, 0xf2, 0x66, 0x0a, 0xdd, 0x70, 0xa1, 0xf2,
-		0xc5, 0x14, 0xa4, 0x39, 0x3b, 0x5e, 0x8c, 0x9e, 0x74, 0x8b, 0x38, 0xe6, 0x81, 0xf8, 0x07, 0x41,
-		0x2e, 0x27, 0xe8, 0xa7, 0xa5, 0x1f, 0xea, 0x3f, 0x57, 0x56, 0xcd, 0x7d, 0xfe, 0xad, 0xf9, 0x49,
-		0x9a, 0x29, 0xae, 0x2f, 0x07, 0x87, 0xcc, 0xde, 0x79, 0x2f, 0x68, 0x19, 0x0a, 0xa1, 0x1c, 0xb6,
-		0xd9, 0xe2, 0x1b, 0xff, 0x73, 0xc3, 0x3d, 0x45, 0xb1, 0xcd, 0xeb, 0xe7, 0xb7, 0xeb, 0x2d, 0x74,
-		0x0e, 0xe4, 0xd0, 0xce, 0x33, 0x0b, 0xcf, 0x59, 0xf2, 0xb6, 0xd8, 0xf6, 0xf7, 0x94, 0xe9, 0xc6,
-		0xeb, 0x49, 0xc8, 0xd2, 0x1f, 0xd0, 0x09, 0xed, 0xcf, 0x66, 0x48, 0x01, 0xad, 0x3c, 0x0b, 0xa5,
-		0xfe, 0x2d, 0x5c, 0xb6, 0x29, 0x5b, 0xbc, 0x19, 0xdd, 0xbe, 0x1d, 0xb6, 0xe1, 0x9b, 0x1d, 0xba,
-		0xe1, 0xfb, 0x20, 0x14, 0x83, 0xa4, 0x04, 0x85, 0x05, 0xe6, 0x69, 0xfb, 0xa5, 0x14, 0x2c, 0x9c,
-		0x5f, 0xc8, 0x45, 0xf2, 0x0b, 0xfe, 0xce, 0x00, 0xcf, 0xb6, 0x30, 0x98, 0x3c, 0xdb, 0x33, 0x26,
-		0x15, 0x3c, 0xa9, 0x42, 0x61, 0xcf, 0x40, 0x41, 0x5c, 0x52, 0x64, 0x70, 0x05, 0x0a, 0x97, 0x17,
-		0x85, 0x43, 0xf7, 0xa0, 0x8b, 0xf1, 0x7b, 0xd0, 0xb3, 0x90, 0x5a, 0xe6, 0x51, 0x71, 0x5f, 0x8e,
-		0xed, 0xb3, 0x49, 0x48, 0xd1, 0x6d, 0xa5, 0x27, 0x23, 0x8e, 0x79, 0x9c, 0x48, 0x93, 0xf0, 0x00,
-		0xb7, 0xd6, 0xdc, 0xdd, 0x90, 0x5f, 0x3e, 0xec, 0x88, 0x89, 0x9f, 0xda, 0x48, 0x86, 0x53, 0x1b,
-		0x57, 0x20, 0xe3, 0xcb, 0x49, 0x6a, 0xa4, 0x9c, 0x94, 0x88, 0x9c, 0x10, 0x31, 0xe6, 0x05, 0xea,
-		0x24, 0x0f, 0x2f, 0x50, 0x15, 0xb2, 0xbe, 0x86, 0xf1, 0x05, 0x6e, 0x1c, 0x99, 0x0d, 0xd0, 0xe2,
-		0xcf, 0x62, 0xa4, 0x87, 0x9c, 0xc5, 0x08, 0x0b, 0x16, 0xff, 0xed, 0xcd, 0x49, 0x3a, 0xb0, 0x40,
-		0xb0, 0xd8, 0xef, 0x6f, 0xde, 0x07, 0xd9, 0x20, 0xbe, 0x62, 0xb2, 0x17, 0x14, 0x90, 0xda, 0x20,
-		0x52, 0x63, 0xb2, 0x16, 0xfa, 0x11, 0xe7, 0x21, 0x51, 0x1a, 0x0c, 0x8b, 0xd2, 0x94, 0x7f, 0x2b,
-		0x41, 0x9a, 0x1f, 0xb7, 0x38, 0x20, 0x2d, 0xc0, 0xe6, 0x21, 0x31, 0x6c, 0x1e, 0x92, 0x6f, 0x6b,
-		0x1e, 0xc0, 0xef, 0xa7, 0x38, 0x64, 0x7a, 0x5f, 0x6c, 0x72, 0x8e, 0x74, 0xb2, 0x61, 0xee, 0x8a,
-		0x7d, 0xa2, 0x00, 0x4b, 0x79, 0x4b, 0x22, 0xe6, 0x97, 0xd7, 0x0f, 0x06, 0x9e, 0xd2, 0xa1, 0x03,
-		0xcf, 0xc3, 0x9d, 0xb2, 0x89, 0x88, 0x52, 0xf2, 0xde, 0x44, 0x29, 0x32, 0xe9, 0xa9, 0xbe, 0x49,
-		0x57, 0xbe, 0x20, 0xf1, 0xdf, 0x6f, 0xf6, 0x93, 0x7f, 0x7f, 0x45, 0xb3, 0xf5, 0xb5, 0x5c, 0xbe,
-		0x5a, 0xb8, 0xa5, 0x0d, 0x4c, 0xdb, 0x03, 0x71, 0xf7, 0xa6, 0x23, 0xbd, 0x0e, 0xa6, 0x0f, 0x09,
-		0x32, 0x8d, 0x60, 0x1a, 0x7f, 0x36, 0x21, 0x4e, 0xa5, 0x85, 0xe0, 0xff, 0x06, 0x4e, 0x67, 0x74,
-		0x0d, 0x4f, 0x8c, 0xb9, 0x86, 0xd3, 0x43, 0xd7, 0xf0, 0xcf, 0x26, 0xe8, 0x3b, 0x1b, 0xec, 0x8c,
-		0xc0, 0x5f, 0x86, 0x0e, 0x3e, 0x09, 0xd9, 0xae, 0xdd, 0xd6, 0x58, 0x0d, 0x7b, 0x8c, 0x3f, 0xd3,
-		0xb5, 0xdb, 0xea, 0x80, 0xa8, 0x4d, 0xbc, 0x53, 0x0a, 0x3a, 0xfd, 0x0e, 0x4c, 0xc3, 0x64, 0xff,
-		0xaa, 0xf2, 0x20, 0xcf, 0x78, 0xc1, 0x3d, 0xa8, 0x8b, 0x84, 0x09, 0xd4, 0x27, 0x93, 0xfa, 0x7d,
-		0x3e, 0xbf, 0xdf, 0x0c, 0x54, 0xe5, 0x80, 0x04, 0x25, 0x72, 0xd2, 0xed, 0xc4, 0x50, 0xcd, 0x25,
-		0x4e, 0xf6, 0x28, 0x1f, 0x96, 0x00, 0x56, 0x09, 0x73, 0xe9, 0x88, 0x89, 0xf3, 0xe3, 0xd2, 0x4e,
-		0x68, 0x91, 0xb6, 0xe7, 0x87, 0x4e, 0x1c, 0xef, 0x41, 0xde, 0x0d, 0x77, 0x7d, 0x19, 0x0a, 0x81,
-		0x80, 0xbb, 0x58, 0x74, 0x67, 0xfe, 0xa0, 0x8b, 0xac, 0x0d, 0xec, 0xa9, 0xf9, 0x9b, 0xa1, 0x2f,
-		0xe5, 0xdf, 0x49, 0x90, 0xa5, 0xbd, 0x5a, 0xc3, 0x9e, 0x1e, 0x99, 0x48, 0xe9, 0x6d, 0x4c, 0xe4,
-		0xfd, 0x00, 0x8c, 0x8e, 0x6b, 0xbe, 0x81, 0xb9, 0x7c, 0x65, 0x69, 0x49, 0xc3, 0x7c, 0x03, 0xa3,
-		0xa7, 0x7d, 0xae, 0x27, 0x47, 0x70, 0x5d, 0x24, 0x6f, 0x39, 0xef, 0x8f, 0xc3, 0xa4, 0xd5, 0xeb,
-		0x68, 0xec, 0x30, 0x29, 0x15, 0x5a, 0xab, 0xd7, 0x69, 0xde, 0x76, 0x95, 0x1b, 0x30, 0xd9, 0xbc,
-		0xcd, 0xde, 0xef, 0x39, 0x09, 0x59, 0xc7, 0xb6, 0xb9, 0x37, 0xc8, 0x1c, 0xf1, 0x0c, 0x29, 0xa0,
-		0xce, 0x4f, 0x5c, 0xce, 0xff, 0xc2, 0xb8, 0x6e, 0x3f, 0x77, 0xf8, 0xcf, 0xff, 0x86, 0x04, 0x85,
-		0xc8, 0x8a, 0x42, 0x8f, 0xc2, 0xf1, 0x46, 0x7d, 0x65, 0xbd, 0xb6, 0xac, 0xad, 0x35, 0x56, 0xfa,
-		0x02, 0xec, 0xb9, 0xd2, 0x9d, 0xbb, 0x0b, 0x39, 0x7e, 0x55, 0x75, 0x18, 0xf4, 0xa6, 0x5a, 0x63,
-		0x91, 0x36, 0x83, 0xde, 0x74, 0xf0, 0x4d, 0xdb, 0xc3, 0x14, 0xfa, 0x71, 0x38, 0x11, 0x03, 0xed,
-		0x5f, 0x58, 0x9d, 0xba, 0x73, 0x77, 0xa1, 0xb0, 0xe9, 0x60, 0x26, 0x6a, 0x14, 0x63, 0x11, 0x66,
-		0x07, 0x31, 0x58, 0x56, 0x43, 0x5e, 0x98, 0x93, 0xef, 0xdc, 0x5d, 0xc8, 0x0b, 0xdd, 0x41, 0xe0,
-		0xdf, 0xf5, 0x1b, 0xab, 0x1f, 0xcd, 0xc2, 0x09, 0xf6, 0x86, 0x95, 0xc6, 0x62, 0x40, 0xf6, 0xc1,
-		0x43, 0xd2, 0x7c, 0xb8, 0x6a, 0xf4, 0x8f, 0x13, 0x28, 0x2f, 0xc3, 0x74, 0xdd, 0xf2, 0xb0, 0xb3,
-		0xa3, 0x87, 0x7f, 0x5e, 0x38, 0xf6, 0x07, 0x7b, 0x17, 0x22, 0xaf, 0x6c, 0xf2, 0x08, 0x3e, 0x5c,
-		0xa4, 0x7c, 0x93, 0x04, 0x72, 0xc3, 0xd0, 0xdb, 0xba, 0xf3, 0x76, 0x49, 0xa1, 0xa7, 0xc5, 0x8f,
-		0x52, 0xf0, 0x0b, 0x22, 0xc9, 0x73, 0xc5, 0x4b, 0xb3, 0x8b, 0xe1, 0xc1, 0x2d, 0xb2, 0x96, 0xa8,
-		0x0e, 0x66, 0x3f, 0x46, 0x41, 0xfe, 0x3c, 0xff, 0x0a, 0x40, 0x50, 0x81, 0x4e, 0xc2, 0xf1, 0xc6,
-		0x52, 0x65, 0xb5, 0xe2, 0xe7, 0x69, 0x1a, 0x9b, 0xb5, 0x25, 0xf6, 0xcb, 0xf7, 0x47, 0xd0, 0x31,
-		0x40, 0xe1, 0x4a, 0xff, 0x77, 0xe6, 0x8e, 0xc2, 0x54, 0xb8, 0x9c, 0xfd, 0x0c, 0x79, 0xa2, 0x7c,
-		0x15, 0x4a, 0xec, 0x37, 0x92, 0x89, 0x01, 0xc4, 0x2d, 0xcd, 0xb4, 0xd0, 0x88, 0x9f, 0x1c, 0x9e,
-		0xfd, 0x95, 0xff, 0xca, 0x7e, 0xa2, 0xa2, 0xc0, 0x10, 0x2b, 0x04, 0xaf, 0x6e, 0x95, 0x9b, 0x30,
-		0x43, 0x6f, 0x84, 0xd3, 0x9f, 0x95, 0xd1, 0x4c, 0xc1, 0xff, 0xd1, 0x6f, 0x08, 0x12, 0x7a, 0xc9,
-		0x73, 0x59, 0x75, 0x3a, 0x40, 0xf7, 0x67, 0xaf, 0xfc, 0x72, 0xf0, 0xa3, 0x22, 0x7e, 0x07, 0x47,
-		0x52, 0xfc, 0x55, 0xde, 0x43, 0xf1, 0x84, 0xb0, 0xe8, 0xe2, 0x2a, 0x4c, 0xe9, 0x86, 0x81, 0xbb,
-		0x91, 0xfe, 0x8d, 0x78, 0xb6, 0x4d, 0x8c, 0x56, 0xe6, 0x98, 0x41, 0xd7, 0x9e, 0x86, 0xb4, 0x4b,
-		0x27, 0x65, 0x14, 0x09, 0xd1, 0x1d, 0x0e, 0x5e, 0xae, 0x41, 0x91, 0x89, 0x81, 0x3f, 0xa2, 0x11,
-		0x04, 0xfe, 0x23, 0x27, 0x90, 0xa7, 0x68, 0x62, 0x34, 0x16, 0x4c, 0xb5, 0xb0, 0xd1, 0xd6, 0x1d,
-		0x1c, 0x1a, 0xcd, 0xc1, 0x4f, 0x17, 0xff, 0xcb, 0x4f, 0x3f, 0xee, 0xef, 0xa1, 0x87, 0x84, 0x2e,
-		0x66, 0xb1, 0xa8, 0x32, 0xa7, 0x1d, 0x8c, 0x17, 0x43, 0x51, 0xb4, 0xc7, 0xc7, 0x7d, 0x70, 0x63,
-		0xff, 0x8a, 0x37, 0x76, 0x2a, 0x4e, 0xc2, 0x43, 0x2d, 0x15, 0x38, 0x55, 0x56, 0x51, 0xae, 0x42,
-		0x61, 0xc7, 0x6c, 0x87, 0xa6, 0xfb, 0xe0, 0x56, 0xfe, 0xf5, 0xa7, 0x1f, 0x67, 0x0b, 0x8d, 0x20,
-		0x71, 0xd6, 0xdc, 0xd3, 0x4f, 0xa6, 0x50, 0xea, 0xcf, 0x85, 0xbb, 0xea, 0x6b, 0xa7, 0x4f, 0x24,
-		0xe1, 0x14, 0x07, 0xde, 0xd6, 0x5d, 0x4c, 0x14, 0x17, 0xf6, 0xf4, 0x8b, 0x17, 0x0c, 0xdb, 0xb4,
-		0x82, 0xa4, 0x22, 0x55, 0x58, 0xa4, 0x7e, 0x91, 0xd7, 0x0f, 0xc9, 0x69, 0x0d, 0x57, 0x74, 0x73,
-		0x83, 0x3f, 0xdb, 0xa3, 0xb4, 0x21, 0xb5, 0x64, 0x9b, 0x16, 0xf1, 0xb9, 0x5a, 0xd8, 0xb2, 0x3b,
-		0xe2, 0xbc, 0x22, 0xfd, 0x40, 0x57, 0x21, 0xad, 0x77, 0xec, 0x9e, 0xc5, 0xdf, 0x6f, 0xab, 0x3e,
-		0x4e, 0x6c, 0xe1, 0x6f, 0xbd, 0x35, 0x7f, 0x94, 0x91, 0x75, 0x5b, 0x37, 0x16, 0x4d, 0xfb, 0x42,
-		0x47, 0xf7, 0xf6, 0xc8, 0x24, 0xff, 0xfa, 0x67, 0x1e, 0x03, 0xde, 0x5e, 0xdd, 0xf2, 0x3e, 0xf9,
-		0xfb, 0x3f, 0x7d, 0x5e, 0x52, 0x39, 0x3e, 0x4b, 0x3b, 0x2a, 0x5d, 0x98, 0x5c, 0xc6, 0xc6, 0x01,
-		0x0d, 0xd6, 0xfb, 0x1a, 0xbc, 0xc8, 0x1b, 0x3c, 0x39, 0xd8, 0x20, 0xfb, 0x1d, 0xc1, 0x65, 0x6c,
-		0x84, 0x9a, 0x5d, 0xc6, 0x46, 0xb4, 0xc5, 0xea, 0xf2, 0x6f, 0xfe, 0xee, 0xa9, 0x23, 0xef, 0xff,
-		0xfc, 0xa9, 0x23, 0x43, 0xa7, 0x4c, 0x19, 0xfd, 0x2b, 0x37, 0xfe, 0x4c, 0xfd, 0x6f, 0x09, 0x4e,
-		0xf4, 0x9b, 0x07, 0xdd, 0xda, 0x1f, 0xf6, 0xe6, 0xc1, 0x65, 0x48, 0x56, 0xac, 0x7d, 0x74, 0x82,
-		0xbd, 0xe6, 0xaa, 0xf5, 0x9c, 0xb6, 0x38, 0xe6, 0x49, 0xbe, 0xb7, 0x9c, 0x76, 0xf4, 0x48, 0x81,
-		0xff, 0x32, 0xd7, 0x77, 0x1d, 0xf2, 0xb9, 0x83, 0x4c, 0xc5, 0xda, 0x17, 0x0f, 0x1d, 0x3c, 0x3a,
-		0xe6, 0x43, 0x07, 0xba, 0xb5, 0xdf, 0xdd, 0x3e, 0xec, 0xfb, 0x06, 0x77, 0x9e, 0x82, 0x07, 0x38,
-		0x8f, 0x5c, 0x4f, 0xbf, 0x61, 0x5a, 0xbb, 0xbe, 0xb0, 0xf2, 0x6f, 0xce, 0x8a, 0x63, 0x7c, 0x42,
-		0x44, 0xa9, 0x10, 0xd9, 0x41, 0x09, 0x9c, 0x3b, 0xf0, 0xc1, 0x84, 0xb9, 0x83, 0xb3, 0xc9, 0x73,
-		0x23, 0xd6, 0xcd, 0x41, 0x8b, 0x61, 0xc8, 0xea, 0x19, 0x3a, 0xbd, 0x23, 0x5f, 0x0f, 0x1b, 0x99,
-		0x4c, 0xfe, 0xb0, 0x04, 0xc5, 0xab, 0xa6, 0xeb, 0xd9, 0x8e, 0x69, 0xe8, 0x6d, 0xba, 0x91, 0xfe,
-		0xfc, 0xd8, 0xde, 0x7f, 0x35, 0x4b, 0x96, 0x02, 0x5f, 0x54, 0x7b, 0xc2, 0x01, 0x4f, 0xdf, 0xd4,
-		0xdb, 0xcc, 0xf3, 0x0e, 0xeb, 0xdd, 0x7e, 0xb6, 0x87, 0xf6, 0x97, 0xc3, 0x54, 0x18, 0x6e, 0x39,
-		0x31, 0x2b, 0x29, 0xdf, 0x97, 0x80, 0x12, 0x0d, 0x19, 0x5c, 0x7a, 0x20, 0x8c, 0x1e, 0x39, 0xba,
-		0x06, 0x29, 0x47, 0xf7, 0xb8, 0x13, 0x52, 0xbd, 0x7c, 0xe8, 0x95, 0xc8, 0x5a, 0xa1, 0x34, 0xd0,
-		0x7b, 0x20, 0xd3, 0xd1, 0x6f, 0x6b, 0x94, 0x5e, 0xe2, 0x6d, 0xd1, 0x9b, 0xec, 0xe8, 0xb7, 0x49,
-		0xff, 0xd0, 0x37, 0x40, 0x89, 0x90, 0x34, 0xf6, 0x74, 0x6b, 0x17, 0x33, 0xca, 0xc9, 0xb7, 0x45,
-		0xb9, 0xd0, 0xd1, 0x6f, 0x2f, 0x51, 0x6a, 0x84, 0x3e, 0xd7, 0x58, 0xbf, 0x28, 0xf1, 0xd3, 0x55,
-		0x94, 0x31, 0x48, 0x07, 0xd9, 0xf0, 0xbf, 0x68, 0xa3, 0xe2, 0xd0, 0xf2, 0xd9, 0x61, 0xbc, 0xef,
-		0x63, 0x6b, 0xb5, 0x40, 0xba, 0xf7, 0xb9, 0xb7, 0xe6, 0x25, 0xd6, 0x6a, 0xc9, 0x18, 0x60, 0x7b,
-		0x8e, 0x9d, 0x1a, 0xd3, 0x68, 0x66, 0x3b, 0x31, 0x32, 0x08, 0x2d, 0x88, 0x20, 0x94, 0x11, 0x04,
-		0x86, 0x4d, 0xea, 0xf9, 0x18, 0xfe, 0x44, 0x82, 0xdc, 0x72, 0xc8, 0x4f, 0x9c, 0x85, 0xc9, 0x8e,
-		0x6d, 0x99, 0x37, 0xb0, 0xe3, 0x9f, 0x3a, 0x67, 0x9f, 0x68, 0x0e, 0x32, 0xec, 0x17, 0x20, 0xbd,
-		0x7d, 0xb1, 0xdb, 0x24, 0xbe, 0x09, 0xd6, 0x2d, 0xbc, 0xed, 0x9a, 0x82, 0xcf, 0xaa, 0xf8, 0x44,
-		0x0f, 0x83, 0xec, 0x62, 0xa3, 0xe7, 0x98, 0xde, 0xbe, 0x66, 0xd8, 0x96, 0xa7, 0x1b, 0x1e, 0x3f,
-		0xac, 0x54, 0x12, 0xe5, 0x4b, 0xac, 0x98, 0x10, 0x69, 0x61, 0x4f, 0x37, 0xdb, 0xec, 0x32, 0x76,
-		0x56, 0x15, 0x9f, 0x68, 0x25, 0xb4, 0xfd, 0x9f, 0xf6, 0x77, 0x27, 0x62, 0x39, 0x2a, 0x7e, 0x6f,
-		0x9e, 0x0a, 0x33, 0x1d, 0xf5, 0x91, 0xe0, 0xac, 0x00, 0x1f, 0xf3, 0x0e, 0x64, 0x04, 0x18, 0x7a,
-		0x08, 0x4a, 0x5d, 0xc7, 0xa6, 0x56, 0xbf, 0x6b, 0x1a, 0x5a, 0xcf, 0x31, 0xf9, 0xb8, 0x0b, 0xbc,
-		0x78, 0xd3, 0x34, 0xb6, 0x1c, 0x13, 0x3d, 0x0a, 0xc8, 0xb5, 0x0d, 0x7a, 0x11, 0x5c, 0xb7, 0x5a,
-		0x6d, 0xa2, 0xb0, 0x4d, 0x76, 0xd6, 0x2c, 0xab, 0xca, 0xac, 0xe6, 0x2a, 0xad, 0xd8, 0x72, 0x4c,
-		0x97, 0xb7, 0x73, 0x77, 0x32, 0x7c, 0xb6, 0x68, 0x09, 0x64, 0xbb, 0x8b, 0x9d, 0x48, 0xc6, 0x87,
-		0x2d, 0x9f, 0xd9, 0x5f, 0xff, 0xcc, 0x63, 0x33, 0x7c, 0x3c, 0x3c, 0xe7, 0xc3, 0x5e, 0x71, 0x54,
-		0x4b, 0x02, 0x43, 0xa4, 0x82, 0x5e, 0x8d, 0x1c, 0x8c, 0xef, 0x6d, 0x07, 0x6f, 0x32, 0xcd, 0x0c,
-		0x48, 0x41, 0xc5, 0xda, 0xaf, 0xce, 0xfe, 0x6a, 0x40, 0x9a, 0xc7, 0x8b, 0x9b, 0xf4, 0xa4, 0x51,
-		0xf8, 0x90, 0x3c, 0x25, 0x83, 0x8e, 0x41, 0xfa, 0x75, 0xdd, 0x6c, 0x8b, 0x5f, 0xf7, 0x55, 0xf9,
-		0x17, 0x2a, 0xfb, 0x07, 0x3f, 0x53, 0x34, 0x83, 0xa3, 0x0c, 0x63, 0x7d, 0xd5, 0xb6, 0x5a, 0xd1,
-		0xf3, 0x9e, 0x68, 0x09, 0xd2, 0x9e, 0x7d, 0x03, 0x5b, 0x7c, 0x46, 0xab, 0x8f, 0x1c, 0xc2, 0x47,
-		0x50, 0x39, 0x2a, 0xfa, 0x3a, 0x90, 0x5b, 0xb8, 0x8d, 0x77, 0x59, 0x2a, 0x61, 0x4f, 0x77, 0x30,
-		0xcb, 0x69, 0xdf, 0x93, 0x07, 0x50, 0xf2, 0x49, 0x35, 0x28, 0x25, 0xb4, 0x19, 0x0d, 0x9d, 0x26,
-		0xfd, 0x3b, 0x5d, 0xb1, 0x63, 0x0c, 0x2d, 0x95, 0xb0, 0xba, 0x8c, 0x84, 0x5a, 0x0f, 0x83, 0xdc,
-		0xb3, 0xb6, 0x6d, 0x8b, 0xfe, 0x28, 0x26, 0x4f, 0x62, 0x65, 0xd8, 0x65, 0x09, 0xbf, 0x9c, 0x5f,
-		0x96, 0xd8, 0x84, 0x62, 0x00, 0x4a, 0x97, 0x74, 0xf6, 0xb0, 0x4b, 0xba, 0xe0, 0x13, 0x20, 0x20,
-		0x68, 0x0d, 0x20, 0x50, 0x1a, 0x34, 0xcd, 0x9e, 0x1b, 0x3e, 0x63, 0x81, 0xfa, 0x09, 0x0f, 0x26,
-		0x44, 0x00, 0x59, 0x30, 0xdd, 0x31, 0x2d, 0xcd, 0xc5, 0xed, 0x1d, 0x8d, 0x73, 0x8e, 0xd0, 0xcd,
-		0x51, 0xf6, 0xbf, 0x78, 0x88, 0xd9, 0xfc, 0xad, 0xcf, 0x3c, 0x56, 0x0a, 0x5c, 0xa7, 0x85, 0xc7,
-		0x17, 0x9f, 0x7c, 0x5a, 0x9d, 0xea, 0x98, 0x56, 0x03, 0xb7, 0x77, 0x96, 0x7d, 0xc2, 0xe8, 0x79,
-		0x38, 0x19, 0x30, 0xc4, 0xb6, 0xb4, 0x3d, 0xbb, 0xdd, 0xd2, 0x1c, 0xbc, 0xa3, 0x19, 0xd4, 0xf1,
-		0xcb, 0x53, 0x36, 0x1e, 0xf7, 0x41, 0x36, 0xac, 0xab, 0x76, 0xbb, 0xa5, 0xe2, 0x9d, 0x25, 0x52,
-		0x8d, 0xce, 0x40, 0xc0, 0x0d, 0xcd, 0x6c, 0xb9, 0xb3, 0x85, 0x85, 0xe4, 0xb9, 0x94, 0x9a, 0xf7,
-		0x0b, 0xeb, 0x2d, 0xb7, 0x9c, 0xf9, 0xe0, 0x9b, 0xf3, 0x47, 0xbe, 0xf8, 0xe6, 0xfc, 0x11, 0xe5,
-		0x0a, 0x7d, 0xb5, 0x8d, 0x2f, 0x2d, 0xec, 0xa2, 0xcb, 0x90, 0xd5, 0xc5, 0x07, 0x7b, 0xf8, 0xf0,
-		0x80, 0xa5, 0x19, 0x80, 0x2a, 0x9f, 0x92, 0x20, 0xbd, 0x7c, 0x7d, 0x53, 0x37, 0x1d, 0x54, 0x23,
-		0x81, 0x91, 0x90, 0xd5, 0x71, 0x57, 0x79, 0x20, 0xde, 0x62, 0x99, 0xaf, 0x0f, 0x4b, 0x0f, 0x67,
-		0xab, 0xa7, 0x7f, 0xfd, 0x33, 0x8f, 0xdd, 0xcf, 0xc9, 0x5c, 0xef, 0xcb, 0x14, 0x0b, 0x7a, 0xfd,
-		0x19, 0xe4, 0xd0, 0x98, 0xaf, 0xc1, 0x24, 0xeb, 0xaa, 0x8b, 0x5e, 0x82, 0x89, 0x2e, 0xf9, 0x83,
-		0x9f, 0xb8, 0x3e, 0x35, 0x54, 0xe6, 0x29, 0x7c, 0x58, 0x42, 0x18, 0x9e, 0xf2, 0xed, 0x09, 0x80,
-		0xe5, 0xeb, 0xd7, 0x9b, 0x8e, 0xd9, 0x6d, 0x63, 0xef, 0x9d, 0x1a, 0xfb, 0x16, 0x1c, 0x0d, 0x65,
-		0x0e, 0x1d, 0xe3, 0xf0, 0xe3, 0x9f, 0x0e, 0x72, 0x88, 0x8e, 0x11, 0x4b, 0xb6, 0xe5, 0x7a, 0x3e,
-		0xd9, 0xe4, 0xe1, 0xc9, 0x2e, 0xbb, 0xde, 0x20, 0x67, 0x5f, 0x81, 0x5c, 0xc0, 0x0c, 0x17, 0xd5,
-		0x21, 0xe3, 0xf1, 0xbf, 0x39, 0x83, 0x95, 0xe1, 0x0c, 0x16, 0x68, 0x61, 0x26, 0xfb, 0xe8, 0xca,
-		0x5f, 0x48, 0x00, 0xa1, 0x35, 0xf2, 0xd7, 0x53, 0xc6, 0x48, 0x78, 0xc6, 0x95, 0x73, 0xf2, 0x9e,
-		0xc3, 0x33, 0x46, 0x20, 0xc4, 0xd4, 0xef, 0x4c, 0xc0, 0xf4, 0x96, 0x58, 0xbd, 0x7f, 0xfd, 0x79,
-		0xb0, 0x05, 0x93, 0xd8, 0xf2, 0x1c, 0xd3, 0xbf, 0x31, 0xf0, 0xf8, 0xb0, 0x39, 0x8f, 0x19, 0x54,
-		0xcd, 0xf2, 0x9c, 0xfd, 0xb0, 0x04, 0x08, 0x5a, 0x21, 0x7e, 0xfc, 0x60, 0x12, 0x66, 0x87, 0xa1,
-		0xa2, 0xb3, 0x50, 0x32, 0x1c, 0x4c, 0x0b, 0xa2, 0xef, 0x70, 0x16, 0x45, 0x31, 0x37, 0x3b, 0x2a,
-		0x10, 0xcf, 0x92, 0x08, 0x17, 0x01, 0xbd, 0x37, 0x57, 0xb2, 0x18, 0x50, 0xa0, 0x86, 0xa7, 0x09,
-		0x25, 0xf1, 0x54, 0xce, 0xb6, 0xde, 0xd6, 0x2d, 0x43, 0xb8, 0xdc, 0x87, 0xb2, 0xf9, 0xe2, 0xb9,
-		0x9d, 0x2a, 0x23, 0x81, 0x6a, 0x30, 0x29, 0xa8, 0xa5, 0x0e, 0x4f, 0x4d, 0xe0, 0xa2, 0x07, 0x21,
-		0x1f, 0x36, 0x0c, 0xd4, 0x1b, 0x49, 0xd1, 0xe7, 0x87, 0x73, 0x21, 0xdb, 0x30, 0xca, 0xfa, 0xa4,
-		0x0f, 0xb4, 0x3e, 0xdc, 0xe9, 0xfb, 0xc1, 0x24, 0x4c, 0xa9, 0xb8, 0xf5, 0x37, 0x7f, 0x6a, 0x36,
-		0x01, 0xd8, 0x72, 0x25, 0xda, 0x94, 0xcf, 0xce, 0x3d, 0xac, 0xf9, 0x2c, 0x23, 0xb2, 0xec, 0xd2,
-		0x1f, 0x57, 0x1f, 0x9c, 0xa5, 0x77, 0x7e, 0x86, 0x7e, 0x3b, 0x01, 0xf9, 0xf0, 0x0c, 0xfd, 0xad,
-		0x34, 0x5c, 0x68, 0x3d, 0x50, 0x55, 0x6c, 0xb3, 0xfc, 0xe1, 0x61, 0xaa, 0x6a, 0x40, 0x9a, 0x47,
-		0xe8, 0xa8, 0x2f, 0x25, 0x21, 0xcd, 0x2f, 0xde, 0x6e, 0x0c, 0xf8, 0xb7, 0x23, 0x1f, 0x62, 0x2e,
-		0x88, 0xb7, 0xac, 0x63, 0xdd, 0xdb, 0x07, 0xa1, 0x48, 0x02, 0xfb, 0xc8, 0x6d, 0x5e, 0xe9, 0x5c,
-		0x81, 0xc6, 0xe7, 0xc1, 0xe1, 0x26, 0x34, 0x0f, 0x39, 0x02, 0x16, 0xe8, 0x62, 0x02, 0x03, 0x1d,
-		0xfd, 0x76, 0x8d, 0x95, 0xa0, 0x8b, 0x80, 0xf6, 0xfc, 0x6c, 0x8b, 0x16, 0x30, 0x42, 0x3a, 0x57,
-		0xa0, 0x6a, 0x61, 0x2a, 0xa8, 0x15, 0x28, 0xf7, 0x03, 0x90, 0x9e, 0x68, 0x2c, 0x33, 0xc9, 0x7f,
-		0x2a, 0x9b, 0x94, 0x2c, 0xd3, 0xec, 0xe4, 0xb7, 0x48, 0xcc, 0x55, 0xee, 0x4b, 0x01, 0xf0, 0x48,
-		0xa5, 0x39, 0xc6, 0xc2, 0xf8, 0xd3, 0xb7, 0xe6, 0xe7, 0xf6, 0xf5, 0x4e, 0xbb, 0xac, 0xc4, 0xd0,
-		0x51, 0xe2, 0xb2, 0x12, 0xc4, 0x81, 0x8e, 0xa6, 0x10, 0x50, 0x1d, 0xe4, 0x1b, 0x78, 0x5f, 0x73,
-		0xf8, 0xaf, 0xc9, 0x6b, 0x3b, 0x58, 0xbc, 0x87, 0x7e, 0x62, 0x31, 0x26, 0x4f, 0xbc, 0xb8, 0x64,
-		0x9b, 0x16, 0xdf, 0xc6, 0x2c, 0xde, 0xc0, 0xfb, 0x2a, 0xc7, 0xbb, 0x82, 0x71, 0xf9, 0x01, 0xb2,
-		0x5a, 0xee, 0xfc, 0xfe, 0x4f, 0x9f, 0x3f, 0x19, 0xca, 0x79, 0xde, 0xf6, 0x93, 0x7b, 0x6c, 0x8a,
-		0x89, 0xf3, 0x8b, 0x02, 0x43, 0x14, 0xba, 0xc1, 0x0d, 0xa1, 0x78, 0x41, 0x3a, 0x38, 0x0e, 0x09,
-		0xf0, 0x23, 0x71, 0x48, 0x68, 0x89, 0xbe, 0x18, 0xd8, 0x81, 0xc4, 0xa8, 0xd1, 0x84, 0xa5, 0x93,
-		0x23, 0xd1, 0x95, 0x7f, 0x44, 0xf9, 0x4f, 0x12, 0x9c, 0x18, 0x90, 0x66, 0xbf, 0xcb, 0x06, 0x20,
-		0x27, 0x54, 0x49, 0xa5, 0x42, 0x5c, 0xe2, 0xb9, 0xb7, 0xc5, 0x31, 0xe5, 0x0c, 0x18, 0x82, 0x77,
-		0xc6, 0xa0, 0x71, 0x4d, 0xf6, 0x2b, 0x12, 0xcc, 0x84, 0x3b, 0xe0, 0x0f, 0xa5, 0x01, 0xf9, 0x70,
-		0xd3, 0x7c, 0x10, 0x0f, 0x8c, 0x33, 0x88, 0x70, 0xff, 0x23, 0x44, 0xd0, 0xf5, 0x40, 0x63, 0xb0,
-		0x94, 0xe2, 0xc5, 0xb1, 0x99, 0x22, 0x3a, 0x16, 0xab, 0x39, 0xd8, 0xdc, 0x7c, 0x49, 0x82, 0xd4,
-		0xa6, 0x6d, 0xb7, 0xd1, 0xfb, 0x60, 0xca, 0xb2, 0x3d, 0x8d, 0xac, 0x2c, 0xdc, 0xd2, 0x78, 0xfa,
-		0x80, 0x69, 0xe3, 0xda, 0x81, 0xbc, 0xfa, 0x83, 0xb7, 0xe6, 0x07, 0x31, 0xe3, 0xf6, 0x1d, 0x4a,
-		0x96, 0xed, 0x55, 0x29, 0x50, 0x93, 0x65, 0x18, 0x76, 0xa0, 0x10, 0x6d, 0x8e, 0x69, 0xec, 0xca,
-		0xa8, 0xe6, 0x0a, 0x23, 0x9b, 0xca, 0x6f, 0x87, 0xda, 0x61, 0x3f, 0x3d, 0xf4, 0xc7, 0x64, 0xe6,
-		0xbe, 0x01, 0xe4, 0xeb, 0xfd, 0x57, 0x44, 0xaf, 0xc0, 0xa4, 0xb8, 0x12, 0x2a, 0x8d, 0x7b, 0xdd,
-		0x34, 0xcc, 0x4f, 0x8e, 0x4c, 0x73, 0xb6, 0x9f, 0x4b, 0xc0, 0x89, 0x25, 0xdb, 0x72, 0x79, 0xb2,
-		0x87, 0xaf, 0x6a, 0x96, 0x60, 0xde, 0x47, 0x0f, 0x0f, 0x49, 0x45, 0xe5, 0x07, 0x13, 0x4e, 0xd7,
-		0xa1, 0x44, 0x4c, 0xac, 0x61, 0x5b, 0x6f, 0x33, 0xdf, 0x54, 0xb0, 0xdb, 0x2d, 0xde, 0xa3, 0x1b,
-		0x78, 0x9f, 0xd0, 0xb5, 0xf0, 0xad, 0x08, 0xdd, 0xe4, 0xbd, 0xd1, 0xb5, 0xf0, 0xad, 0x10, 0xdd,
-		0xe0, 0xdc, 0x50, 0x2a, 0x72, 0x0b, 0xe9, 0x32, 0x24, 0x89, 0x2a, 0x9c, 0x38, 0x84, 0xf2, 0x20,
-		0x08, 0x21, 0xb3, 0xd6, 0x80, 0x13, 0x3c, 0x5b, 0xe0, 0x6e, 0xec, 0x50, 0x8e, 0x62, 0x3a, 0xa0,
-		0x97, 0xf1, 0x7e, 0x4c, 0xea, 0x20, 0x3f, 0x56, 0xea, 0xe0, 0xfc, 0xcf, 0x49, 0x00, 0x41, 0xde,
-		0x0c, 0x3d, 0x0a, 0xc7, 0xab, 0x1b, 0xeb, 0xcb, 0xc1, 0x85, 0x8c, 0xd0, 0xf6, 0xba, 0x38, 0xa9,
-		0xe1, 0x76, 0xb1, 0x61, 0xee, 0x98, 0xb8, 0x85, 0x1e, 0x82, 0x99, 0x28, 0x34, 0xf9, 0xaa, 0x2d,
-		0xcb, 0xd2, 0x5c, 0xfe, 0xce, 0xdd, 0x85, 0x0c, 0x8b, 0x13, 0x70, 0x0b, 0x9d, 0x83, 0xa3, 0x83,
-		0x70, 0xf5, 0xf5, 0x15, 0x39, 0x31, 0x57, 0xb8, 0x73, 0x77, 0x21, 0xeb, 0x07, 0x14, 0x48, 0x01,
-		0x14, 0x86, 0xe4, 0xf4, 0x92, 0x73, 0x70, 0xe7, 0xee, 0x42, 0x9a, 0x2d, 0x19, 0x7e, 0x93, 0xe3,
-		0xeb, 0x01, 0xea, 0xd6, 0x8e, 0xa3, 0x1b, 0x54, 0x35, 0xcc, 0xc1, 0xb1, 0xfa, 0xfa, 0x15, 0xb5,
-		0xb2, 0xd4, 0xac, 0x6f, 0xac, 0xf7, 0x9d, 0x0a, 0x88, 0xd6, 0x2d, 0x6f, 0x6c, 0x55, 0x57, 0x6b,
-		0x5a, 0xa3, 0xbe, 0xb2, 0xce, 0xae, 0x5d, 0x45, 0xea, 0xde, 0xbb, 0xde, 0xac, 0xaf, 0xd5, 0xe4,
-		0x44, 0xf5, 0xf2, 0xd0, 0x2d, 0xb9, 0xfb, 0x22, 0x8b, 0x31, 0x30, 0x47, 0x91, 0xcd, 0xb8, 0xff,
-		0x1b, 0x00, 0x00, 0xff, 0xff, 0x99, 0x81, 0xa8, 0x52, 0xec, 0xa7, 0x00, 0x00,
+		// 12996 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x69, 0x90, 0x1c, 0xc7,
+		0x75, 0x20, 0x8c, 0xea, 0x6b, 0xba, 0x5f, 0x5f, 0x35, 0x39, 0x03, 0xa0, 0x31, 0x20, 0x71, 0x34,
+		0x2f, 0x10, 0x14, 0x07, 0x04, 0x48, 0x82, 0xc4, 0x50, 0x22, 0xdd, 0x3d, 0xdd, 0x00, 0x1a, 0x1c,
+		0xcc, 0x8c, 0xaa, 0x7b, 0xc0, 0xc3, 0x47, 0xa9, 0xa6, 0xbb, 0x66, 0xa6, 0x88, 0xee, 0xaa, 0x66,
+		0x55, 0x35, 0x80, 0xd1, 0x17, 0xe1, 0x90, 0x6c, 0xd9, 0x9f, 0x4c, 0x59, 0xfe, 0xe4, 0xcf, 0xfe,
+		0x6c, 0xda, 0x32, 0x24, 0xd9, 0xfe, 0x6c, 0xc9, 0xe7, 0xfa, 0x90, 0xbc, 0x96, 0x37, 0xf6, 0xf0,
+		0x1e, 0x5e, 0x5b, 0xbb, 0xeb, 0x95, 0x1d, 0xb1, 0x6b, 0x87, 0x23, 0x96, 0xbb, 0x2b, 0x39, 0x96,
+		0xb4, 0x2d, 0x9f, 0x2b, 0xef, 0x2a, 0x42, 0xb1, 0xa1, 0x8d, 0x97, 0x47, 0x1d, 0xdd, 0xd5, 0xd3,
+		0x3d, 0x20, 0xe5, 0xe5, 0xda, 0xfb, 0x67, 0xa6, 0x33, 0xf3, 0xbd, 0x97, 0x99, 0x2f, 0x5f, 0xbe,
+		0x7c, 0xf9, 0xf2, 0x65, 0x16, 0x7c, 0xf0, 0x3d, 0x70, 0x62, 0xdb, 0xb2, 0xb6, 0xbb, 0xfa, 0x99,
+		0xbe, 0x6d, 0xb9, 0xd6, 0xe6, 0x60, 0xeb, 0x4c, 0x47, 0x77, 0xda, 0xb6, 0xd1, 0x77, 0x2d, 0x7b,
+		0x91, 0xe6, 0x91, 0x22, 0x83, 0x58, 0x14, 0x10, 0xe5, 0x6f, 0x84, 0xd9, 0x8b, 0x46, 0x57, 0xaf,
+		0x79, 0x80, 0x4d, 0xdd, 0x25, 0x4f, 0x42, 0x62, 0xcb, 0xe8, 0xea, 0x25, 0xe9, 0x44, 0xfc, 0x54,
+		0xf6, 0xdc, 0xbd, 0x8b, 0x43, 0x48, 0x8b, 0x61, 0x8c, 0x75, 0xcc, 0x56, 0x28, 0xc6, 0xe9, 0x5c,
+		0xfa, 0x7d, 0x5f, 0xfa, 0xdc, 0xd7, 0x24, 0xf9, 0xfd, 0xf8, 0xb7, 0xfc, 0x6a, 0x12, 0xe6, 0x22,
+		0x60, 0x09, 0x81, 0x84, 0xa9, 0xf5, 0x90, 0xbe, 0x74, 0x2a, 0xa3, 0xd0, 0xdf, 0xa4, 0x04, 0x33,
+		0x7d, 0xad, 0x7d, 0x5d, 0xdb, 0xd6, 0x4b, 0x31, 0x9a, 0x2d, 0x92, 0xe4, 0x18, 0x40, 0x47, 0xef,
+		0xeb, 0x66, 0x47, 0x37, 0xdb, 0xbb, 0xa5, 0xf8, 0x89, 0xf8, 0xa9, 0x8c, 0x12, 0xc8, 0x21, 0x0f,
+		0xc1, 0x6c, 0x7f, 0xb0, 0xd9, 0x35, 0xda, 0x6a, 0x00, 0x0c, 0x4e, 0xc4, 0x4f, 0x25, 0x15, 0x99,
+		0x15, 0xd4, 0x7c, 0xe0, 0x07, 0xa0, 0x78, 0x53, 0xd7, 0xae, 0x07, 0x41, 0xb3, 0x14, 0xb4, 0x80,
+		0xd9, 0x01, 0xc0, 0x65, 0xc8, 0xf5, 0x74, 0xc7, 0xd1, 0xb6, 0x75, 0xd5, 0xdd, 0xed, 0xeb, 0xa5,
+		0x04, 0xe5, 0xc5, 0x89, 0x11, 0x5e, 0x0c, 0xf3, 0x21, 0xcb, 0xb1, 0x5a, 0xbb, 0x7d, 0x9d, 0x54,
+		0x20, 0xa3, 0x9b, 0x83, 0x1e, 0xa3, 0x90, 0x1c, 0xc3, 0xcd, 0xba, 0x39, 0xe8, 0x0d, 0x53, 0x49,
+		0x23, 0x1a, 0x27, 0x31, 0xe3, 0xe8, 0xf6, 0x0d, 0xa3, 0xad, 0x97, 0x52, 0x94, 0xc0, 0x03, 0x23,
+		0x04, 0x9a, 0xac, 0x7c, 0x98, 0x86, 0xc0, 0x23, 0xcb, 0x90, 0xd1, 0x6f, 0xb9, 0xba, 0xe9, 0x18,
+		0x96, 0x59, 0x9a, 0xa1, 0x44, 0xee, 0x8b, 0x18, 0x53, 0xbd, 0xdb, 0x19, 0x26, 0xe1, 0xe3, 0x91,
+		0xf3, 0x30, 0x63, 0xf5, 0x5d, 0xc3, 0x32, 0x9d, 0x52, 0xfa, 0x84, 0x74, 0x2a, 0x7b, 0xee, 0xae,
+		0x48, 0xb1, 0x58, 0x63, 0x30, 0x8a, 0x00, 0x26, 0x0d, 0x90, 0x1d, 0x6b, 0x60, 0xb7, 0x75, 0xb5,
+		0x6d, 0x75, 0x74, 0xd5, 0x30, 0xb7, 0xac, 0x52, 0x86, 0x12, 0x38, 0x3e, 0xda, 0x11, 0x0a, 0xb8,
+		0x6c, 0x75, 0xf4, 0x86, 0xb9, 0x65, 0x29, 0x05, 0x27, 0x94, 0x26, 0x87, 0x20, 0xe5, 0xec, 0x9a,
+		0xae, 0x76, 0xab, 0x94, 0xa3, 0x12, 0xc2, 0x53, 0xe4, 0x1c, 0xcc, 0xe8, 0x1d, 0x03, 0xab, 0x2b,
+		0x15, 0x4e, 0x48, 0xa7, 0x0a, 0xe7, 0x4a, 0xa3, 0x3c, 0x66, 0xe5, 0x8a, 0x00, 0x2c, 0x7f, 0x36,
+		0x05, 0xc5, 0x69, 0xc4, 0xf2, 0x29, 0x48, 0x6e, 0x21, 0x67, 0x4a, 0xb1, 0xfd, 0xf0, 0x8d, 0xe1,
+		0x84, 0x19, 0x9f, 0xba, 0x43, 0xc6, 0x57, 0x20, 0x6b, 0xea, 0x8e, 0xab, 0x77, 0x98, 0x14, 0xc5,
+		0xa7, 0x94, 0x43, 0x60, 0x48, 0xa3, 0x62, 0x98, 0xb8, 0x23, 0x31, 0x7c, 0x1e, 0x8a, 0x5e, 0x93,
+		0x54, 0x5b, 0x33, 0xb7, 0x85, 0x3c, 0x9f, 0x99, 0xd4, 0x92, 0xc5, 0xba, 0xc0, 0x53, 0x10, 0x4d,
+		0x29, 0xe8, 0xa1, 0x34, 0xa9, 0x01, 0x58, 0xa6, 0x6e, 0x6d, 0xa9, 0x1d, 0xbd, 0xdd, 0x2d, 0xa5,
+		0xc7, 0x70, 0x69, 0x0d, 0x41, 0x46, 0xb8, 0x64, 0xb1, 0xdc, 0x76, 0x97, 0x5c, 0xf0, 0xc5, 0x73,
+		0x66, 0x8c, 0x74, 0x5d, 0x65, 0x13, 0x73, 0x44, 0x42, 0x37, 0xa0, 0x60, 0xeb, 0x38, 0x57, 0xf4,
+		0x0e, 0xef, 0x59, 0x86, 0x36, 0x62, 0x71, 0x62, 0xcf, 0x14, 0x8e, 0xc6, 0x3a, 0x96, 0xb7, 0x83,
+		0x49, 0x72, 0x0f, 0x78, 0x19, 0x2a, 0x15, 0x2b, 0xa0, 0x9a, 0x2b, 0x27, 0x32, 0x57, 0xb5, 0x9e,
+		0xbe, 0xf0, 0x5e, 0x28, 0x84, 0xd9, 0x43, 0xe6, 0x21, 0xe9, 0xb8, 0x9a, 0xed, 0x52, 0x29, 0x4c,
+		0x2a, 0x2c, 0x41, 0x64, 0x88, 0xeb, 0x66, 0x87, 0x6a, 0xc6, 0xa4, 0x82, 0x3f, 0xc9, 0x37, 0xf8,
+		0x1d, 0x8e, 0xd3, 0x0e, 0xdf, 0x3f, 0x3a, 0xa2, 0x21, 0xca, 0xc3, 0xfd, 0x5e, 0x78, 0x02, 0xf2,
+		0xa1, 0x0e, 0x4c, 0x5b, 0x75, 0xf9, 0x5f, 0x27, 0xe0, 0x60, 0x24, 0x6d, 0xf2, 0x3c, 0xcc, 0x0f,
+		0x4c, 0xc3, 0x74, 0x75, 0xbb, 0x6f, 0xeb, 0x28, 0xb2, 0xac, 0xae, 0xd2, 0xeb, 0x33, 0x63, 0x84,
+		0x6e, 0x23, 0x08, 0xcd, 0xa8, 0x28, 0x73, 0x83, 0xd1, 0x4c, 0xf2, 0x02, 0x64, 0x51, 0x3e, 0x34,
+		0x5b, 0xa3, 0x04, 0xd9, 0x6c, 0x3c, 0x37, 0x5d, 0x97, 0x17, 0x6b, 0x3e, 0x66, 0x35, 0xfe, 0x41,
+		0x29, 0xa6, 0x04, 0x69, 0x91, 0x27, 0x20, 0xbd, 0xa5, 0x6b, 0xee, 0xc0, 0xd6, 0x9d, 0xd2, 0x39,
+		0xca, 0xca, 0xa3, 0xa3, 0x93, 0x94, 0x01, 0x34, 0x75, 0x57, 0xf1, 0x80, 0x49, 0x0f, 0x72, 0x37,
+		0x74, 0xdb, 0xd8, 0x32, 0xda, 0xac, 0x51, 0x71, 0xaa, 0x7c, 0x9e, 0x9c, 0xb2, 0x51, 0xd7, 0x02,
+		0xa8, 0x4d, 0x57, 0x73, 0xf5, 0x25, 0xd8, 0x58, 0xbd, 0x56, 0x57, 0x1a, 0x17, 0x1b, 0xf5, 0x1a,
+		0x6b, 0x66, 0x88, 0xfc, 0xc2, 0xf7, 0x4b, 0x90, 0x0d, 0xf4, 0x04, 0xd5, 0xa1, 0x39, 0xe8, 0x6d,
+		0xea, 0x36, 0x1f, 0x2f, 0x9e, 0x22, 0x47, 0x21, 0xb3, 0x35, 0xe8, 0x76, 0x99, 0xd0, 0xb1, 0xb5,
+		0x34, 0x8d, 0x19, 0x28, 0x70, 0xa8, 0xe3, 0xb8, 0x1a, 0xa1, 0x3a, 0x0e, 0x7f, 0x93, 0x05, 0x48,
+		0x0b, 0xa1, 0x2c, 0x25, 0x4f, 0x48, 0xa7, 0xd2, 0x8a, 0x97, 0x66, 0x65, 0x7d, 0x5d, 0x73, 0xf5,
+		0x4e, 0x29, 0x25, 0xca, 0x58, 0xfa, 0x4a, 0x22, 0x9d, 0x90, 0x93, 0xe5, 0xc7, 0x60, 0x76, 0xa4,
+		0x2b, 0xa4, 0x08, 0xd9, 0x5a, 0x7d, 0x79, 0xa5, 0xa2, 0x54, 0x5a, 0x8d, 0xb5, 0x55, 0xf9, 0x00,
+		0x29, 0x40, 0xa0, 0x77, 0xb2, 0x74, 0x3a, 0x93, 0x7e, 0x63, 0x46, 0x7e, 0xdf, 0xfb, 0xde, 0xf7,
+		0xbe, 0x58, 0xf9, 0xd7, 0x53, 0x30, 0x1f, 0xa5, 0x04, 0x23, 0xf5, 0xb1, 0xdf, 0xe9, 0x78, 0xa8,
+		0xd3, 0x15, 0x48, 0x76, 0xb5, 0x4d, 0xbd, 0x5b, 0x4a, 0xd0, 0x41, 0x78, 0x68, 0x2a, 0x35, 0xbb,
+		0xb8, 0x82, 0x28, 0x0a, 0xc3, 0x24, 0x4f, 0x73, 0xd6, 0x24, 0x29, 0x85, 0xd3, 0xd3, 0x51, 0x40,
+		0xe5, 0xc8, 0xd9, 0x78, 0x14, 0x32, 0xf8, 0x9f, 0xf1, 0x3d, 0xc5, 0xf8, 0x8e, 0x19, 0x94, 0xef,
+		0x0b, 0x90, 0xa6, 0x7a, 0xaf, 0xa3, 0x7b, 0x63, 0x22, 0xd2, 0xa8, 0x29, 0x3a, 0xfa, 0x96, 0x36,
+		0xe8, 0xba, 0xea, 0x0d, 0xad, 0x3b, 0xd0, 0xa9, 0x06, 0xcb, 0x28, 0x39, 0x9e, 0x79, 0x0d, 0xf3,
+		0xc8, 0x71, 0xc8, 0x32, 0x35, 0x69, 0x98, 0x1d, 0xfd, 0x16, 0x5d, 0x42, 0x93, 0x0a, 0xd3, 0x9c,
+		0x0d, 0xcc, 0xc1, 0xea, 0x5f, 0x72, 0x2c, 0x53, 0xe8, 0x1a, 0x5a, 0x05, 0x66, 0xd0, 0xea, 0x9f,
+		0x18, 0x5e, 0xbd, 0xef, 0x8e, 0xee, 0xde, 0x88, 0x72, 0x7c, 0x00, 0x8a, 0x14, 0xe2, 0x51, 0x3e,
+		0x95, 0xb5, 0x6e, 0x69, 0x96, 0x8a, 0x41, 0x81, 0x65, 0xaf, 0xf1, 0xdc, 0xf2, 0xaf, 0xc4, 0x20,
+		0x41, 0x57, 0x8a, 0x22, 0x64, 0x5b, 0x2f, 0xac, 0xd7, 0xd5, 0xda, 0xda, 0x46, 0x75, 0xa5, 0x2e,
+		0x4b, 0x38, 0xf4, 0x34, 0xe3, 0xe2, 0xca, 0x5a, 0xa5, 0x25, 0xc7, 0xbc, 0x74, 0x63, 0xb5, 0x75,
+		0xfe, 0x31, 0x39, 0xee, 0x21, 0x6c, 0xb0, 0x8c, 0x44, 0x10, 0xe0, 0xd1, 0x73, 0x72, 0x92, 0xc8,
+		0x90, 0x63, 0x04, 0x1a, 0xcf, 0xd7, 0x6b, 0xe7, 0x1f, 0x93, 0x53, 0xe1, 0x9c, 0x47, 0xcf, 0xc9,
+		0x33, 0x24, 0x0f, 0x19, 0x9a, 0x53, 0x5d, 0x5b, 0x5b, 0x91, 0xd3, 0x1e, 0xcd, 0x66, 0x4b, 0x69,
+		0xac, 0x5e, 0x92, 0x33, 0x1e, 0xcd, 0x4b, 0xca, 0xda, 0xc6, 0xba, 0x0c, 0x1e, 0x85, 0xab, 0xf5,
+		0x66, 0xb3, 0x72, 0xa9, 0x2e, 0x67, 0x3d, 0x88, 0xea, 0x0b, 0xad, 0x7a, 0x53, 0xce, 0x85, 0x9a,
+		0xf5, 0xe8, 0x39, 0x39, 0xef, 0x55, 0x51, 0x5f, 0xdd, 0xb8, 0x2a, 0x17, 0xc8, 0x2c, 0xe4, 0x59,
+		0x15, 0xa2, 0x11, 0xc5, 0xa1, 0xac, 0xf3, 0x8f, 0xc9, 0xb2, 0xdf, 0x10, 0x46, 0x65, 0x36, 0x94,
+		0x71, 0xfe, 0x31, 0x99, 0x94, 0x97, 0x21, 0x49, 0xc5, 0x90, 0x10, 0x28, 0xac, 0x54, 0xaa, 0xf5,
+		0x15, 0x75, 0x6d, 0x1d, 0x27, 0x4d, 0x65, 0x45, 0x96, 0xfc, 0x3c, 0xa5, 0xbe, 0x5e, 0xaf, 0xb4,
+		0xea, 0x35, 0x39, 0x1e, 0xcc, 0x7b, 0xf7, 0x46, 0x43, 0xa9, 0xd7, 0xe4, 0x58, 0xb9, 0x0d, 0xf3,
+		0x51, 0x2b, 0x64, 0xe4, 0x14, 0x0a, 0xc8, 0x42, 0x6c, 0x8c, 0x2c, 0x50, 0x5a, 0xc3, 0xb2, 0x50,
+		0xfe, 0x62, 0x0c, 0xe6, 0x22, 0xac, 0x84, 0xc8, 0x4a, 0x9e, 0x81, 0x24, 0x93, 0x65, 0xa6, 0xa9,
+		0x1f, 0x8c, 0x34, 0x37, 0xa8, 0x64, 0x8f, 0xd8, 0x4e, 0x14, 0x2f, 0x68, 0x6f, 0xc6, 0xc7, 0xd8,
+		0x9b, 0x48, 0x62, 0x44, 0x60, 0xbf, 0x79, 0x64, 0x35, 0x67, 0x06, 0xcf, 0xf9, 0x69, 0x0c, 0x1e,
+		0x9a, 0xb7, 0xbf, 0x55, 0x3d, 0x19, 0xb1, 0xaa, 0x3f, 0x05, 0xb3, 0x23, 0x84, 0xa6, 0x5e, 0x5d,
+		0xbf, 0x5d, 0x82, 0xd2, 0x38, 0xe6, 0x4c, 0x50, 0x89, 0xb1, 0x90, 0x4a, 0x7c, 0x6a, 0x98, 0x83,
+		0x27, 0xc7, 0x0f, 0xc2, 0xc8, 0x58, 0x7f, 0x52, 0x82, 0x43, 0xd1, 0xfb, 0x8a, 0xc8, 0x36, 0x3c,
+		0x0d, 0xa9, 0x9e, 0xee, 0xee, 0x58, 0xc2, 0x4e, 0xbe, 0x3f, 0xc2, 0xfa, 0xc2, 0xe2, 0xe1, 0xc1,
+		0xe6, 0x58, 0xe4, 0xc2, 0x70, 0x5b, 0x8f, 0x8f, 0xdb, 0xe5, 0x8c, 0xb4, 0xf4, 0xbb, 0x62, 0x70,
+		0x30, 0x92, 0x78, 0x64, 0x43, 0xef, 0x06, 0x30, 0xcc, 0xfe, 0xc0, 0x65, 0xb6, 0x30, 0xd3, 0xc4,
+		0x19, 0x9a, 0x43, 0x95, 0x17, 0x6a, 0xd9, 0x81, 0xeb, 0x95, 0xb3, 0x55, 0x12, 0x58, 0x16, 0x05,
+		0x78, 0xd2, 0x6f, 0x68, 0x82, 0x36, 0xf4, 0xd8, 0x98, 0x9e, 0x8e, 0x08, 0xe6, 0x23, 0x20, 0xb7,
+		0xbb, 0x86, 0x6e, 0xba, 0xaa, 0xe3, 0xda, 0xba, 0xd6, 0x33, 0xcc, 0x6d, 0xb6, 0xda, 0x2e, 0x25,
+		0xb7, 0xb4, 0xae, 0xa3, 0x2b, 0x45, 0x56, 0xdc, 0x14, 0xa5, 0x88, 0x41, 0x05, 0xc8, 0x0e, 0x60,
+		0xa4, 0x42, 0x18, 0xac, 0xd8, 0xc3, 0x28, 0xff, 0x5c, 0x06, 0xb2, 0x81, 0x5d, 0x18, 0x39, 0x09,
+		0xb9, 0x97, 0xb4, 0x1b, 0x9a, 0x2a, 0x76, 0xd6, 0x8c, 0x13, 0x59, 0xcc, 0x5b, 0x67, 0x59, 0xe4,
+		0x11, 0x98, 0xa7, 0x20, 0xd6, 0xc0, 0xd5, 0x6d, 0xb5, 0xdd, 0xd5, 0x1c, 0x87, 0x32, 0x2d, 0x4d,
+		0x41, 0x09, 0x96, 0xad, 0x61, 0xd1, 0xb2, 0x28, 0x21, 0x8f, 0xc3, 0x1c, 0xc5, 0xe8, 0x0d, 0xba,
+		0xae, 0xd1, 0xef, 0xea, 0x2a, 0xee, 0xfc, 0x9d, 0x12, 0x04, 0x5b, 0x36, 0x8b, 0x10, 0x57, 0x39,
+		0x00, 0xb6, 0xc8, 0x21, 0x35, 0xb8, 0x9b, 0xa2, 0x6d, 0xeb, 0xa6, 0x6e, 0x6b, 0xae, 0xae, 0xea,
+		0x2f, 0x0f, 0xb4, 0xae, 0xa3, 0x6a, 0x66, 0x47, 0xdd, 0xd1, 0x9c, 0x9d, 0xd2, 0x3c, 0x12, 0xa8,
+		0xc6, 0x4a, 0x92, 0x72, 0x04, 0x01, 0x2f, 0x71, 0xb8, 0x3a, 0x05, 0xab, 0x98, 0x9d, 0xcb, 0x9a,
+		0xb3, 0x43, 0x96, 0xe0, 0x10, 0xa5, 0xe2, 0xb8, 0xb6, 0x61, 0x6e, 0xab, 0xed, 0x1d, 0xbd, 0x7d,
+		0x5d, 0x1d, 0xb8, 0x5b, 0x4f, 0x96, 0x8e, 0x06, 0xeb, 0xa7, 0x2d, 0x6c, 0x52, 0x98, 0x65, 0x04,
+		0xd9, 0x70, 0xb7, 0x9e, 0x24, 0x4d, 0xc8, 0xe1, 0x60, 0xf4, 0x8c, 0xf7, 0xea, 0xea, 0x96, 0x65,
+		0xd3, 0x35, 0xb4, 0x10, 0xa1, 0x9a, 0x02, 0x1c, 0x5c, 0x5c, 0xe3, 0x08, 0x57, 0xad, 0x8e, 0xbe,
+		0x94, 0x6c, 0xae, 0xd7, 0xeb, 0x35, 0x25, 0x2b, 0xa8, 0x5c, 0xb4, 0x6c, 0x14, 0xa8, 0x6d, 0xcb,
+		0x63, 0x70, 0x96, 0x09, 0xd4, 0xb6, 0x25, 0xd8, 0xfb, 0x38, 0xcc, 0xb5, 0xdb, 0xac, 0xcf, 0x46,
+		0x5b, 0xe5, 0x3b, 0x72, 0xa7, 0x24, 0x87, 0x98, 0xd5, 0x6e, 0x5f, 0x62, 0x00, 0x5c, 0xc6, 0x1d,
+		0x72, 0x01, 0x0e, 0xfa, 0xcc, 0x0a, 0x22, 0xce, 0x8e, 0xf4, 0x72, 0x18, 0xf5, 0x71, 0x98, 0xeb,
+		0xef, 0x8e, 0x22, 0x92, 0x50, 0x8d, 0xfd, 0xdd, 0x61, 0xb4, 0xfb, 0xa8, 0x97, 0xc5, 0xd6, 0xdb,
+		0xd4, 0xd4, 0x3b, 0x1c, 0x84, 0x0e, 0x14, 0x90, 0x45, 0x90, 0xdb, 0x6d, 0x55, 0x37, 0xb5, 0xcd,
+		0xae, 0xae, 0x6a, 0xb6, 0x6e, 0x6a, 0x4e, 0xe9, 0x38, 0x05, 0x4e, 0xb8, 0xf6, 0x40, 0x57, 0x0a,
+		0xed, 0x76, 0x9d, 0x16, 0x56, 0x68, 0x19, 0x39, 0x0d, 0xb3, 0xd6, 0xe6, 0x4b, 0x6d, 0x26, 0x58,
+		0x6a, 0xdf, 0xd6, 0xb7, 0x8c, 0x5b, 0xa5, 0x7b, 0x29, 0x97, 0x8a, 0x58, 0x40, 0xc5, 0x6a, 0x9d,
+		0x66, 0x93, 0x07, 0x41, 0x6e, 0x3b, 0x3b, 0x9a, 0xdd, 0xa7, 0x9a, 0xd5, 0xe9, 0x6b, 0x6d, 0xbd,
+		0x74, 0x1f, 0x03, 0x65, 0xf9, 0xab, 0x22, 0x1b, 0x05, 0xdb, 0xb9, 0x69, 0x6c, 0xb9, 0x82, 0xe2,
+		0x03, 0x4c, 0xb0, 0x69, 0x1e, 0xa7, 0x76, 0x0a, 0xe4, 0xfe, 0x4e, 0x3f, 0x5c, 0xf1, 0x29, 0x0a,
+		0x56, 0xe8, 0xef, 0xf4, 0x83, 0xf5, 0xde, 0x03, 0xf9, 0xfe, 0x4e, 0xb0, 0xd2, 0x07, 0x99, 0xfd,
+		0xd5, 0xdf, 0x09, 0xd4, 0xf8, 0x18, 0x1c, 0x42, 0xa0, 0x9e, 0xee, 0x6a, 0x1d, 0xcd, 0xd5, 0x02,
+		0xd0, 0xef, 0xa0, 0xd0, 0xf3, 0xfd, 0x9d, 0xfe, 0x55, 0x5e, 0x18, 0x6a, 0xa7, 0x3d, 0xd8, 0xdc,
+		0xf5, 0xe4, 0xe3, 0x61, 0xd6, 0x4e, 0xcc, 0x13, 0x12, 0x72, 0xc7, 0xdb, 0x8f, 0xaf, 0xdb, 0x66,
+		0xab, 0xbc, 0x04, 0xb9, 0xa0, 0xdc, 0x93, 0x0c, 0x30, 0xc9, 0x97, 0x25, 0x34, 0x82, 0x96, 0xd7,
+		0x6a, 0x68, 0xbe, 0xbc, 0x58, 0x97, 0x63, 0x68, 0x46, 0xad, 0x34, 0x5a, 0x75, 0x55, 0xd9, 0x58,
+		0x6d, 0x35, 0xae, 0xd6, 0xe5, 0x78, 0xc0, 0xb0, 0xbf, 0x92, 0x48, 0x9f, 0x96, 0x1f, 0xba, 0x92,
+		0x48, 0xdf, 0x2f, 0x3f, 0x40, 0xd9, 0x33, 0x22, 0x94, 0xe5, 0x2f, 0xc7, 0xa1, 0x10, 0xde, 0x96,
+		0x93, 0x77, 0xc2, 0x61, 0xe1, 0x77, 0x73, 0x74, 0x57, 0xbd, 0x69, 0xd8, 0x74, 0xb2, 0xf6, 0x34,
+		0xb6, 0x70, 0x7a, 0x42, 0x39, 0xcf, 0xa1, 0x9a, 0xba, 0xfb, 0x9c, 0x61, 0xe3, 0x54, 0xec, 0x69,
+		0x2e, 0x59, 0x81, 0xe3, 0xa6, 0xa5, 0x3a, 0xae, 0x66, 0x76, 0x34, 0xbb, 0xa3, 0xfa, 0xfe, 0x4f,
+		0x55, 0x6b, 0xb7, 0x75, 0xc7, 0xb1, 0xd8, 0x22, 0xe9, 0x51, 0xb9, 0xcb, 0xb4, 0x9a, 0x1c, 0xd8,
+		0x5f, 0x3d, 0x2a, 0x1c, 0x74, 0x68, 0x4e, 0xc4, 0xc7, 0xcd, 0x89, 0xa3, 0x90, 0xe9, 0x69, 0x7d,
+		0x55, 0x37, 0x5d, 0x7b, 0x97, 0xda, 0xee, 0x69, 0x25, 0xdd, 0xd3, 0xfa, 0x75, 0x4c, 0x93, 0x6b,
+		0x70, 0xbf, 0x0f, 0xaa, 0x76, 0xf5, 0x6d, 0xad, 0xbd, 0xab, 0x52, 0x43, 0x9d, 0xfa, 0x88, 0xd4,
+		0xb6, 0x65, 0x6e, 0x75, 0x8d, 0xb6, 0xeb, 0x94, 0xb2, 0x9e, 0xfe, 0x2b, 0xfb, 0x18, 0x2b, 0x14,
+		0xe1, 0x8a, 0x63, 0x99, 0xd4, 0x3e, 0x5f, 0x16, 0xd0, 0x21, 0xb1, 0xc9, 0xbd, 0x2d, 0xc4, 0x26,
+		0x3c, 0xf4, 0x09, 0x39, 0x79, 0x25, 0x91, 0x4e, 0xca, 0xa9, 0x2b, 0x89, 0x74, 0x4a, 0x9e, 0xb9,
+		0x92, 0x48, 0xa7, 0xe5, 0xcc, 0x95, 0x44, 0x3a, 0x23, 0x43, 0xf9, 0x76, 0x1e, 0x72, 0xc1, 0xed,
+		0x06, 0xee, 0xde, 0xda, 0x74, 0xc1, 0x95, 0xa8, 0x4a, 0xbe, 0x67, 0xcf, 0xcd, 0xc9, 0xe2, 0x32,
+		0xae, 0xc4, 0x4b, 0x29, 0x66, 0xdb, 0x2b, 0x0c, 0x13, 0xad, 0x20, 0x9c, 0x64, 0x3a, 0xb3, 0xa5,
+		0xd2, 0x0a, 0x4f, 0x91, 0x4b, 0x90, 0x7a, 0xc9, 0xa1, 0xb4, 0x53, 0x94, 0xf6, 0xbd, 0x7b, 0xd3,
+		0xbe, 0xd2, 0xa4, 0xc4, 0x33, 0x57, 0x9a, 0xea, 0xea, 0x9a, 0x72, 0xb5, 0xb2, 0xa2, 0x70, 0x74,
+		0x72, 0x04, 0x12, 0x5d, 0xed, 0xbd, 0xbb, 0xe1, 0x35, 0x9b, 0x66, 0x91, 0x45, 0x28, 0x0e, 0x4c,
+		0xb6, 0x57, 0xc7, 0x31, 0x46, 0xa8, 0x62, 0x10, 0xaa, 0xe0, 0x97, 0xae, 0x20, 0xfc, 0x94, 0x72,
+		0x75, 0x04, 0x12, 0xe8, 0x94, 0x0e, 0xaf, 0xac, 0x34, 0x8b, 0x9c, 0x82, 0x5c, 0x47, 0xdf, 0x1c,
+		0x6c, 0xab, 0xb6, 0xde, 0xd1, 0xda, 0x6e, 0x78, 0x3d, 0xc9, 0xd2, 0x22, 0x85, 0x96, 0x90, 0x67,
+		0x21, 0x83, 0x63, 0x64, 0xd2, 0x31, 0x9e, 0xa5, 0x2c, 0x78, 0x78, 0x6f, 0x16, 0xf0, 0x21, 0x16,
+		0x48, 0x8a, 0x8f, 0x4f, 0x2e, 0xc3, 0x8c, 0xab, 0xd9, 0xdb, 0xba, 0xeb, 0x94, 0xe6, 0x4e, 0xc4,
+		0x4f, 0x15, 0xce, 0x2d, 0x4e, 0x43, 0xaa, 0x45, 0x51, 0xe8, 0x4e, 0x59, 0xa0, 0x93, 0xe7, 0x40,
+		0xe6, 0xae, 0x58, 0x95, 0x6f, 0x73, 0x9d, 0xd2, 0x3c, 0x15, 0xc0, 0x77, 0xec, 0x4d, 0x92, 0x7b,
+		0x72, 0x6b, 0x0c, 0x49, 0x29, 0xea, 0xa1, 0x74, 0x78, 0x5e, 0x1c, 0xdc, 0xcf, 0xbc, 0xd8, 0x80,
+		0x22, 0xff, 0xad, 0x3a, 0x83, 0x7e, 0xdf, 0xb2, 0xdd, 0xd2, 0xa1, 0x13, 0xd2, 0xe4, 0x06, 0x09,
+		0x62, 0x0c, 0x47, 0x29, 0x6c, 0x85, 0xd2, 0x5f, 0xbf, 0xe9, 0xb6, 0xf0, 0x22, 0x14, 0xc2, 0xcc,
+		0x08, 0x3a, 0xc2, 0xe3, 0x53, 0x3a, 0xc2, 0x71, 0x5b, 0x22, 0x36, 0x6a, 0xb8, 0x34, 0xb1, 0xc4,
+		0xc2, 0x0f, 0xc4, 0xa0, 0x10, 0xee, 0x18, 0xb9, 0x04, 0x44, 0x8c, 0x98, 0x61, 0xba, 0xb6, 0xd5,
+		0x19, 0xb4, 0xf5, 0x4e, 0x49, 0x9a, 0x50, 0xcf, 0x2c, 0xc7, 0x69, 0x78, 0x28, 0x41, 0x42, 0x81,
+		0x59, 0x10, 0x9b, 0x92, 0x50, 0xcd, 0x9f, 0x1f, 0x67, 0x60, 0x4e, 0x10, 0x40, 0x62, 0x37, 0x35,
+		0xdb, 0x44, 0x13, 0x99, 0x19, 0xed, 0x24, 0x50, 0xf4, 0x1c, 0x2b, 0x21, 0x15, 0x10, 0xe2, 0xa2,
+		0xda, 0x7a, 0xcf, 0x42, 0x7f, 0x57, 0x62, 0x42, 0xb5, 0x05, 0x8e, 0xa0, 0x30, 0xf8, 0xf2, 0x19,
+		0x48, 0x52, 0xf5, 0x43, 0x00, 0xb8, 0x02, 0x92, 0x0f, 0x90, 0x34, 0x24, 0x96, 0xd7, 0x14, 0x5c,
+		0x1e, 0x65, 0xc8, 0xb1, 0x5c, 0x75, 0xbd, 0x51, 0x5f, 0xae, 0xcb, 0xb1, 0xf2, 0xe3, 0x90, 0x62,
+		0x3a, 0x05, 0x97, 0x4e, 0x4f, 0xab, 0xc8, 0x07, 0x78, 0x92, 0xd3, 0x90, 0x44, 0xe9, 0xc6, 0xd5,
+		0x6a, 0x5d, 0x91, 0x63, 0xe5, 0x0d, 0x28, 0x0e, 0xcd, 0x43, 0x72, 0x10, 0x66, 0x95, 0x7a, 0xab,
+		0xbe, 0x8a, 0xce, 0x01, 0x75, 0x63, 0xf5, 0xd9, 0xd5, 0xb5, 0xe7, 0xd0, 0xb3, 0x16, 0xca, 0x16,
+		0xeb, 0xb0, 0x44, 0xe6, 0x41, 0xf6, 0xb3, 0x9b, 0x6b, 0x1b, 0x0a, 0x6d, 0xcd, 0x77, 0xc7, 0x40,
+		0x1e, 0x9e, 0x94, 0xe4, 0x30, 0xcc, 0xb5, 0x2a, 0xca, 0xa5, 0x7a, 0x4b, 0x65, 0x0e, 0x0f, 0x8f,
+		0xf4, 0x3c, 0xc8, 0xc1, 0x82, 0x8b, 0x0d, 0xea, 0xcf, 0x39, 0x0e, 0x47, 0x83, 0xb9, 0xf5, 0xe7,
+		0x5b, 0xf5, 0xd5, 0x26, 0xad, 0xbc, 0xb2, 0x7a, 0x09, 0x8d, 0x82, 0x21, 0x7a, 0xc2, 0xc5, 0x12,
+		0xc7, 0xa6, 0x86, 0xe9, 0xd5, 0x57, 0x6a, 0x72, 0x62, 0x38, 0x7b, 0x6d, 0xb5, 0xbe, 0x76, 0x51,
+		0x4e, 0x0e, 0xd7, 0x4e, 0xdd, 0x2e, 0x29, 0xb2, 0x00, 0x87, 0x86, 0x73, 0xd5, 0xfa, 0x6a, 0x4b,
+		0x79, 0x41, 0x9e, 0x19, 0xae, 0xb8, 0x59, 0x57, 0xae, 0x35, 0x96, 0xeb, 0x72, 0x9a, 0x1c, 0x02,
+		0x12, 0x6e, 0x51, 0xeb, 0xf2, 0x5a, 0x4d, 0xce, 0x44, 0xad, 0x58, 0x44, 0x9e, 0x2b, 0xff, 0xac,
+		0x04, 0xb9, 0xa0, 0x0b, 0x24, 0xa4, 0x54, 0xa4, 0xb7, 0xdb, 0x62, 0x5b, 0xfe, 0x9d, 0x18, 0x64,
+		0x03, 0xbe, 0x10, 0xdc, 0xc4, 0x6a, 0xdd, 0xae, 0x75, 0x53, 0xd5, 0xba, 0x86, 0xe6, 0xf0, 0xf5,
+		0x10, 0x68, 0x56, 0x05, 0x73, 0xa6, 0x5d, 0x7f, 0xa6, 0x37, 0x5d, 0x52, 0x77, 0x6c, 0xba, 0xcc,
+		0xbc, 0x0d, 0x4d, 0x97, 0xa4, 0x9c, 0x2a, 0xff, 0x7e, 0x0c, 0xe4, 0x61, 0xef, 0xc8, 0x10, 0xdf,
+		0xa4, 0x71, 0x7c, 0x0b, 0xf6, 0x2f, 0xb6, 0x9f, 0xfe, 0x0d, 0xaf, 0xea, 0xf1, 0xb1, 0xab, 0x7a,
+		0xc4, 0x62, 0x95, 0x78, 0x3b, 0x2f, 0x56, 0x41, 0x71, 0xfd, 0x77, 0x12, 0x14, 0xc2, 0xce, 0x9c,
+		0x10, 0xc7, 0xca, 0xfb, 0xe1, 0x58, 0x78, 0x44, 0x4e, 0x8e, 0x1b, 0x91, 0xbf, 0x91, 0x7e, 0xfd,
+		0x50, 0x1c, 0xf2, 0x21, 0xdf, 0xcf, 0xb4, 0xad, 0x7b, 0x19, 0x66, 0x8d, 0x8e, 0xde, 0xeb, 0x5b,
+		0x2e, 0x46, 0x1e, 0xa8, 0x5d, 0xfd, 0x86, 0xde, 0xa5, 0x6c, 0x28, 0x44, 0x9c, 0xae, 0x86, 0x6a,
+		0x58, 0x6c, 0xf8, 0x78, 0x2b, 0x88, 0xb6, 0x34, 0xd7, 0xa8, 0xd5, 0xaf, 0xae, 0xaf, 0xb5, 0xea,
+		0xab, 0xcb, 0x2f, 0x08, 0x4d, 0xae, 0xc8, 0xc6, 0x10, 0x58, 0x88, 0xe1, 0xf7, 0xbc, 0x3d, 0x36,
+		0x9d, 0xeb, 0x20, 0x0f, 0xf7, 0x06, 0x15, 0x7a, 0x44, 0x7f, 0xe4, 0x03, 0x64, 0x0e, 0x8a, 0xab,
+		0x6b, 0x6a, 0xb3, 0x51, 0xab, 0xab, 0xf5, 0x8b, 0x17, 0xeb, 0xcb, 0xad, 0x26, 0x3b, 0x68, 0xf0,
+		0xa0, 0x5b, 0x72, 0x2c, 0x38, 0x36, 0x3f, 0x1c, 0x87, 0xb9, 0x88, 0x96, 0x90, 0x0a, 0x77, 0x11,
+		0x32, 0xaf, 0xe5, 0xc3, 0xd3, 0xb4, 0x7e, 0x11, 0x77, 0xf7, 0xeb, 0x9a, 0xed, 0x72, 0x8f, 0xe2,
+		0x83, 0x80, 0xec, 0x35, 0x5d, 0x34, 0xef, 0x6d, 0x7e, 0x80, 0xc3, 0x4c, 0x90, 0xa2, 0x9f, 0xcf,
+		0xce, 0x70, 0xde, 0x01, 0xa4, 0x6f, 0x39, 0x86, 0x6b, 0xdc, 0xc0, 0x40, 0x08, 0x71, 0xda, 0x83,
+		0x13, 0x37, 0xa1, 0xc8, 0xa2, 0xa4, 0x61, 0xba, 0x1e, 0xb4, 0xa9, 0x6f, 0x6b, 0x43, 0xd0, 0xb8,
+		0xfd, 0x88, 0x2b, 0xb2, 0x28, 0xf1, 0xa0, 0x4f, 0x42, 0xae, 0x63, 0x0d, 0xd0, 0x2b, 0xc3, 0xe0,
+		0x50, 0x25, 0x4b, 0x4a, 0x96, 0xe5, 0x79, 0x20, 0xdc, 0x6d, 0xe6, 0x1f, 0x33, 0xe5, 0x94, 0x2c,
+		0xcb, 0x63, 0x20, 0x0f, 0x40, 0x51, 0xdb, 0xde, 0xb6, 0x91, 0xb8, 0x20, 0xc4, 0x1c, 0x81, 0x05,
+		0x2f, 0x9b, 0x02, 0x2e, 0x5c, 0x81, 0xb4, 0xe0, 0x03, 0xee, 0x7f, 0x91, 0x13, 0x6a, 0x9f, 0x79,
+		0xb7, 0x63, 0x78, 0xf2, 0x64, 0x8a, 0xc2, 0x93, 0x90, 0x33, 0x1c, 0xd5, 0x0f, 0x83, 0x88, 0x9d,
+		0x88, 0x9d, 0x4a, 0x2b, 0x59, 0xc3, 0xf1, 0x4e, 0x45, 0xcb, 0x9f, 0x04, 0x00, 0x5f, 0xd8, 0xc8,
+		0xf7, 0x4a, 0x50, 0x60, 0x0b, 0x4c, 0xdf, 0xd6, 0x1d, 0xdd, 0x6c, 0x8b, 0x6d, 0xe1, 0x83, 0x7b,
+		0x88, 0x28, 0x53, 0x73, 0xeb, 0x1c, 0xa1, 0xfa, 0xcc, 0x07, 0x25, 0xe9, 0x55, 0x29, 0xf1, 0xaa,
+		0x24, 0xfd, 0x98, 0x94, 0x27, 0xe9, 0xfa, 0xf3, 0xeb, 0x2b, 0x8d, 0xe5, 0x46, 0xab, 0xf4, 0x81,
+		0x19, 0x9a, 0x6e, 0x5c, 0xe5, 0xe9, 0xd7, 0x67, 0xc2, 0xe5, 0x6f, 0xcc, 0xfc, 0x92, 0x14, 0x4f,
+		0xbf, 0x31, 0xa3, 0xe4, 0xb7, 0x82, 0xf4, 0x48, 0x37, 0x18, 0x41, 0x11, 0x1b, 0xb7, 0x91, 0xf4,
+		0x5b, 0x53, 0xe7, 0x71, 0x13, 0xd5, 0x07, 0x69, 0x43, 0x52, 0xb4, 0x21, 0x59, 0x92, 0x5a, 0x5e,
+		0x59, 0x6b, 0xd6, 0x6b, 0xb4, 0x19, 0x19, 0x92, 0x58, 0x5b, 0xaf, 0xaf, 0x96, 0x5e, 0x17, 0x55,
+		0xfa, 0xc1, 0x16, 0xaf, 0x4a, 0x70, 0x58, 0x9c, 0xb2, 0xf2, 0xb5, 0x56, 0x37, 0xdb, 0x56, 0x47,
+		0x58, 0xb7, 0x85, 0x73, 0x67, 0xf7, 0xaa, 0x5c, 0xe1, 0xa8, 0x94, 0x25, 0x75, 0x8e, 0x58, 0x7d,
+		0x78, 0x84, 0x25, 0x95, 0xd5, 0x1a, 0x6f, 0x4b, 0x96, 0xa4, 0xd6, 0x2b, 0xcb, 0xcf, 0xd6, 0x6b,
+		0x7e, 0x6b, 0x0e, 0xda, 0x51, 0x54, 0xc8, 0xb7, 0x42, 0x11, 0xbd, 0xad, 0x28, 0x1b, 0x46, 0x87,
+		0x1d, 0x7b, 0x27, 0xc6, 0x9d, 0x97, 0xfa, 0x2d, 0x42, 0xf7, 0xeb, 0x35, 0x0f, 0xa3, 0xfa, 0x60,
+		0xa0, 0x29, 0x19, 0x92, 0x58, 0x5d, 0x5b, 0xad, 0x8b, 0x66, 0xd0, 0x23, 0xe2, 0x17, 0xfc, 0x66,
+		0x14, 0x06, 0x21, 0x54, 0xf2, 0xad, 0x20, 0x0b, 0xf7, 0x90, 0xc7, 0x92, 0xe4, 0xb8, 0x23, 0x5f,
+		0xbf, 0x01, 0xdc, 0xc9, 0xe4, 0x31, 0xe3, 0xfe, 0x40, 0x0b, 0xe6, 0x49, 0x71, 0xa5, 0xbe, 0x7a,
+		0xa9, 0x75, 0x59, 0x5d, 0x57, 0xea, 0xf4, 0xe4, 0xae, 0xf4, 0x01, 0x51, 0x7d, 0xb1, 0x17, 0x46,
+		0x24, 0xdf, 0x26, 0x41, 0x96, 0x99, 0x40, 0xcc, 0x27, 0xc5, 0x9c, 0x0a, 0xf7, 0xef, 0x55, 0x37,
+		0xb5, 0x80, 0x28, 0x74, 0xf5, 0x02, 0xad, 0x36, 0x2e, 0x04, 0xe2, 0x30, 0x21, 0x2b, 0xf5, 0x4b,
+		0x95, 0xe5, 0x17, 0xd4, 0x6a, 0xbd, 0xd9, 0x42, 0x4d, 0xb6, 0xa6, 0x30, 0x19, 0x05, 0x92, 0xac,
+		0xac, 0xac, 0xac, 0x3d, 0xe7, 0x33, 0x02, 0x5e, 0xf2, 0xc8, 0x94, 0xbf, 0x09, 0xf2, 0x21, 0x71,
+		0x47, 0xa3, 0x98, 0x1a, 0xd3, 0xd8, 0x83, 0x66, 0x7d, 0x75, 0x39, 0x68, 0xc4, 0xe7, 0xc0, 0x13,
+		0x6f, 0x59, 0xc2, 0x94, 0x10, 0x7e, 0x39, 0x86, 0x6a, 0x94, 0x37, 0xc0, 0x3b, 0x4b, 0x8c, 0x97,
+		0x9f, 0x80, 0xb4, 0x10, 0x5f, 0x34, 0xcd, 0xa9, 0x85, 0x3d, 0xb4, 0x31, 0x48, 0x03, 0x95, 0x5d,
+		0x59, 0xc2, 0x6d, 0x10, 0x93, 0x69, 0x39, 0x56, 0xbe, 0x06, 0x07, 0x23, 0x45, 0x8f, 0xdc, 0x03,
+		0xc7, 0xc5, 0xf9, 0x25, 0x33, 0xfa, 0xd5, 0xfa, 0xea, 0xf2, 0x5a, 0x0d, 0xb7, 0x49, 0x3e, 0x4d,
+		0x00, 0x2e, 0x83, 0xac, 0x95, 0x42, 0x3e, 0xe5, 0x58, 0xb9, 0x01, 0x85, 0xb0, 0x00, 0x91, 0xa3,
+		0x70, 0x78, 0xa3, 0x75, 0xf1, 0x49, 0xf5, 0x5a, 0x65, 0xa5, 0x51, 0xab, 0x0c, 0x6d, 0x88, 0x00,
+		0xb8, 0x14, 0xc9, 0x31, 0x6c, 0x28, 0x4a, 0x97, 0x1c, 0x2f, 0x27, 0xd2, 0x92, 0x2c, 0x95, 0x9b,
+		0x50, 0x1c, 0x12, 0x05, 0x72, 0x17, 0x94, 0xf8, 0x0e, 0x25, 0xaa, 0x55, 0x73, 0x30, 0x2c, 0x1c,
+		0x6c, 0xaf, 0x56, 0xab, 0xaf, 0x34, 0xae, 0x36, 0x5a, 0xb4, 0x7d, 0x97, 0x01, 0xfc, 0x31, 0xc6,
+		0x35, 0xeb, 0x4a, 0x73, 0x6d, 0x55, 0xbd, 0x88, 0x1b, 0xbd, 0x56, 0x80, 0x54, 0x06, 0xd8, 0x98,
+		0xca, 0x12, 0xee, 0x47, 0x46, 0x07, 0x5e, 0x8e, 0x9d, 0x4e, 0xe1, 0x8a, 0xf5, 0xa1, 0xd5, 0xd3,
+		0xa9, 0xf4, 0x87, 0x56, 0xe5, 0x8f, 0xe0, 0xff, 0x8f, 0xac, 0xca, 0xdf, 0xbb, 0x7a, 0x25, 0x95,
+		0x7e, 0x7d, 0x46, 0x7e, 0x63, 0xa6, 0xfc, 0xe7, 0x71, 0x20, 0xbe, 0x64, 0x79, 0x3e, 0x8f, 0xe7,
+		0x21, 0xed, 0x39, 0x51, 0x58, 0xcc, 0xe6, 0x3b, 0xf7, 0x10, 0x48, 0x81, 0x16, 0xc8, 0x1a, 0x72,
+		0xaa, 0x78, 0xd4, 0x70, 0xc7, 0xdc, 0x33, 0x4c, 0xa3, 0x37, 0xe8, 0xa9, 0xc2, 0xb3, 0x30, 0x71,
+		0xc7, 0xcc, 0x11, 0x78, 0x9a, 0x92, 0xd0, 0x6e, 0x85, 0x48, 0x24, 0x27, 0x92, 0x60, 0x08, 0x3c,
+		0xbd, 0xf0, 0x15, 0x09, 0x4a, 0xe3, 0x1a, 0x7b, 0x47, 0x4e, 0x8f, 0x55, 0x98, 0xb7, 0x6e, 0xe8,
+		0xb6, 0x6d, 0x74, 0xe8, 0x39, 0x86, 0x67, 0x0a, 0x25, 0x26, 0x9b, 0x42, 0x73, 0x01, 0x44, 0x9e,
+		0xed, 0x90, 0x2a, 0xae, 0x58, 0xb7, 0x50, 0x59, 0x0b, 0x4a, 0xc9, 0xc9, 0x94, 0xf2, 0x14, 0x45,
+		0xd0, 0xb8, 0x82, 0x02, 0x8a, 0xbb, 0x8f, 0x98, 0x1c, 0xf7, 0xed, 0xad, 0xf2, 0x67, 0x62, 0x50,
+		0x08, 0x87, 0x45, 0x92, 0x1a, 0xa4, 0xbb, 0x16, 0x0f, 0x39, 0x62, 0xa3, 0x7d, 0x6a, 0x42, 0x24,
+		0xe5, 0xe2, 0x0a, 0x87, 0x57, 0x3c, 0xcc, 0x85, 0xdf, 0x96, 0x20, 0x2d, 0xb2, 0xc9, 0x21, 0x48,
+		0xf4, 0x35, 0x77, 0x87, 0x92, 0x4b, 0x56, 0x63, 0xb2, 0xa4, 0xd0, 0x34, 0xe6, 0x3b, 0x7d, 0x8d,
+		0x85, 0x5b, 0xf1, 0x7c, 0x4c, 0xa3, 0xcd, 0xd3, 0xd5, 0xb5, 0x0e, 0x3d, 0x81, 0xb3, 0x7a, 0x3d,
+		0xdd, 0x74, 0x1d, 0x61, 0xf3, 0xf0, 0xfc, 0x65, 0x9e, 0x8d, 0xd1, 0xb9, 0xae, 0xad, 0x19, 0xdd,
+		0x10, 0x6c, 0x82, 0xc2, 0xca, 0xa2, 0xc0, 0x03, 0x5e, 0x82, 0x23, 0x82, 0x6e, 0x47, 0x77, 0xb5,
+		0xf6, 0x8e, 0xde, 0xf1, 0x91, 0x52, 0xf4, 0xa4, 0xfd, 0x30, 0x07, 0xa8, 0xf1, 0x72, 0x81, 0x3b,
+		0x14, 0x7a, 0xfc, 0xf9, 0x18, 0xcc, 0x8a, 0x13, 0xc4, 0x8e, 0xc7, 0xba, 0xab, 0x00, 0x9a, 0x69,
+		0x5a, 0x6e, 0x90, 0x79, 0xa3, 0x46, 0xdf, 0x08, 0xde, 0x62, 0xc5, 0x43, 0x52, 0x02, 0x04, 0x16,
+		0xfe, 0x44, 0x02, 0xf0, 0x8b, 0xc6, 0x72, 0xf1, 0x38, 0x64, 0x79, 0x08, 0x2c, 0x8d, 0xaa, 0x66,
+		0x8e, 0x36, 0x60, 0x59, 0x78, 0xd6, 0x88, 0x3e, 0xb8, 0x4d, 0x7d, 0xdb, 0x30, 0x79, 0x4c, 0x13,
+		0x4b, 0x88, 0xd0, 0x80, 0x84, 0x1f, 0xf3, 0xa7, 0x40, 0xda, 0xd1, 0x7b, 0x9a, 0xe9, 0x1a, 0x6d,
+		0x3e, 0x87, 0xce, 0xef, 0xab, 0xf1, 0x8b, 0x4d, 0x8e, 0xad, 0x78, 0x74, 0xca, 0xa7, 0x20, 0x2d,
+		0x72, 0x3d, 0x6d, 0x79, 0x80, 0xcc, 0x40, 0xbc, 0x59, 0xc7, 0xf5, 0x82, 0x2a, 0xad, 0x46, 0xa5,
+		0x29, 0xc7, 0x4e, 0x7f, 0x32, 0x06, 0x33, 0x62, 0x52, 0xcf, 0x41, 0xb1, 0x5e, 0x6b, 0x0c, 0x29,
+		0xde, 0x39, 0x28, 0x88, 0x4c, 0xa6, 0xdd, 0xe4, 0x0f, 0xcc, 0x04, 0x33, 0xd7, 0x95, 0xb5, 0xd6,
+		0xda, 0x39, 0xf9, 0xbf, 0x8c, 0x66, 0x3e, 0x2a, 0xbf, 0x3e, 0x43, 0x66, 0x21, 0x27, 0x32, 0xcf,
+		0x3d, 0x72, 0xee, 0x51, 0xf9, 0x8d, 0xe1, 0xac, 0xc7, 0xe4, 0x3f, 0xa2, 0x3e, 0x1e, 0x91, 0x75,
+		0x56, 0x6d, 0xa1, 0xf6, 0x5c, 0x5b, 0x5d, 0x79, 0x41, 0x96, 0x82, 0x05, 0xe7, 0x02, 0x05, 0x31,
+		0x72, 0x37, 0x1c, 0x16, 0x05, 0x17, 0x2e, 0x5c, 0xb8, 0xf0, 0x44, 0xa0, 0xf0, 0xf6, 0x87, 0x53,
+		0xc3, 0xc5, 0x4f, 0x06, 0x8a, 0x3f, 0x36, 0x5a, 0x7c, 0x21, 0x50, 0xfc, 0xf1, 0x0f, 0xa7, 0xc8,
+		0x1c, 0x64, 0x45, 0xf1, 0xd5, 0xca, 0xf3, 0xf2, 0xd7, 0xbe, 0xf6, 0xb5, 0xaf, 0xcd, 0x54, 0xbf,
+		0x15, 0xe6, 0xda, 0x56, 0x6f, 0x78, 0x68, 0xaa, 0xf2, 0x50, 0x80, 0x82, 0x73, 0x59, 0x7a, 0xf1,
+		0x61, 0x0e, 0xb4, 0x6d, 0x75, 0x35, 0x73, 0x7b, 0xd1, 0xb2, 0xb7, 0xfd, 0xe8, 0x7d, 0x34, 0x36,
+		0x9d, 0x40, 0x0c, 0x7f, 0x7f, 0xf3, 0x2b, 0x92, 0xf4, 0x63, 0xb1, 0xf8, 0xa5, 0xf5, 0xea, 0x4f,
+		0xc7, 0x16, 0x2e, 0x31, 0xc4, 0x75, 0x31, 0xf0, 0x8a, 0xbe, 0xd5, 0xd5, 0xdb, 0x38, 0x3a, 0xf0,
+		0xd1, 0x04, 0xcc, 0x62, 0x40, 0x80, 0x75, 0x86, 0xfe, 0x65, 0x2d, 0x20, 0x49, 0x9a, 0x58, 0x98,
+		0x78, 0x49, 0x60, 0xe9, 0x3c, 0xdb, 0x14, 0x91, 0x49, 0x21, 0xb4, 0xa5, 0xbf, 0xfc, 0xee, 0x9f,
+		0x4c, 0xfa, 0xb1, 0x15, 0x4b, 0x57, 0x47, 0x6d, 0xb3, 0xc9, 0x34, 0xfe, 0x4a, 0xd0, 0x18, 0x36,
+		0xb5, 0x96, 0xde, 0x09, 0x69, 0x8f, 0xcc, 0xde, 0xe1, 0x6a, 0xa5, 0xff, 0x2a, 0x88, 0x78, 0x18,
+		0x4b, 0xcf, 0x00, 0x30, 0xcb, 0x99, 0x85, 0x7d, 0xec, 0x8d, 0xff, 0x65, 0x81, 0x9f, 0xa1, 0x38,
+		0xb8, 0xb3, 0x59, 0xba, 0x04, 0x85, 0x8e, 0x65, 0xba, 0xaa, 0xd5, 0x33, 0x5c, 0xbd, 0xd7, 0x77,
+		0x77, 0x27, 0x11, 0xf9, 0x6b, 0x46, 0x24, 0xad, 0xe4, 0x11, 0x6f, 0x4d, 0xa0, 0x61, 0x4b, 0x58,
+		0xe4, 0xde, 0x34, 0x2d, 0xf9, 0x6f, 0x5e, 0x4b, 0x28, 0x0e, 0xb6, 0xa4, 0x5a, 0xff, 0xcd, 0x2f,
+		0x1c, 0x93, 0x3e, 0xff, 0x85, 0x63, 0xd2, 0x7f, 0xfa, 0xc2, 0x31, 0xe9, 0x23, 0x5f, 0x3c, 0x76,
+		0xe0, 0xf3, 0x5f, 0x3c, 0x76, 0xe0, 0xf7, 0xbf, 0x78, 0xec, 0xc0, 0x8b, 0x0f, 0x6d, 0x1b, 0xee,
+		0xce, 0x60, 0x73, 0xb1, 0x6d, 0xf5, 0xce, 0xb4, 0x2d, 0xa7, 0x67, 0x39, 0xfc, 0xdf, 0xc3, 0x4e,
+		0xe7, 0x3a, 0x97, 0x1f, 0xf7, 0x16, 0x93, 0x82, 0xcd, 0x14, 0x8b, 0xd8, 0x83, 0x3f, 0x7d, 0x08,
+		0xe6, 0xb7, 0xad, 0x6d, 0x8b, 0x26, 0xcf, 0xe0, 0x2f, 0x2e, 0x20, 0x19, 0x2f, 0x77, 0x0a, 0x21,
+		0x59, 0x85, 0x39, 0x0e, 0xac, 0xd2, 0x9d, 0x11, 0x3b, 0x61, 0x27, 0x7b, 0x46, 0x69, 0x95, 0x7e,
+		0xf1, 0x0f, 0xa9, 0x4b, 0x4b, 0x99, 0xe5, 0xa8, 0x58, 0xc6, 0x0e, 0xe1, 0x97, 0x14, 0x38, 0x18,
+		0xa2, 0xc7, 0x76, 0xa5, 0xba, 0x3d, 0x81, 0xe2, 0xbf, 0xe0, 0x14, 0xe7, 0x02, 0x14, 0x9b, 0x1c,
+		0x75, 0x69, 0x19, 0xf2, 0xfb, 0xa1, 0xf5, 0x1b, 0x9c, 0x56, 0x4e, 0x0f, 0x12, 0xb9, 0x04, 0x45,
+		0x4a, 0xa4, 0x3d, 0x70, 0x5c, 0xab, 0x47, 0xc7, 0x70, 0x6f, 0x32, 0xff, 0xf2, 0x0f, 0xd9, 0x52,
+		0x58, 0x40, 0xb4, 0x65, 0x0f, 0x6b, 0x69, 0x09, 0xe8, 0x0e, 0x0f, 0x43, 0x8f, 0x27, 0x50, 0xf8,
+		0x4d, 0xde, 0x10, 0x0f, 0x7e, 0xe9, 0x1a, 0xcc, 0xe3, 0x6f, 0xba, 0x23, 0x0f, 0xb6, 0x64, 0x72,
+		0x48, 0x57, 0xe9, 0x77, 0xbe, 0x9d, 0xad, 0xb6, 0x73, 0x1e, 0x81, 0x40, 0x9b, 0x02, 0xa3, 0xb8,
+		0xad, 0xbb, 0xae, 0x6e, 0x3b, 0xaa, 0xd6, 0x8d, 0x6a, 0x5e, 0x20, 0x26, 0xa6, 0xf4, 0x43, 0x5f,
+		0x0a, 0x8f, 0xe2, 0x25, 0x86, 0x59, 0xe9, 0x76, 0x97, 0x36, 0xe0, 0x70, 0x84, 0x54, 0x4c, 0x41,
+		0xf3, 0x87, 0x39, 0xcd, 0xf9, 0x11, 0xc9, 0x40, 0xb2, 0xeb, 0x20, 0xf2, 0xbd, 0xb1, 0x9c, 0x82,
+		0xe6, 0x47, 0x39, 0x4d, 0xc2, 0x71, 0xc5, 0x90, 0x22, 0xc5, 0x2b, 0x30, 0x7b, 0x43, 0xb7, 0x37,
+		0x2d, 0x87, 0xc7, 0x21, 0x4d, 0x41, 0xee, 0x47, 0x38, 0xb9, 0x22, 0x47, 0xa4, 0x81, 0x49, 0x48,
+		0xeb, 0x02, 0xa4, 0xb7, 0xb4, 0xb6, 0x3e, 0x05, 0x89, 0xdb, 0x9c, 0xc4, 0x0c, 0xc2, 0x23, 0x6a,
+		0x05, 0x72, 0xdb, 0x16, 0x77, 0xca, 0x4c, 0x46, 0xff, 0x18, 0x47, 0xcf, 0x0a, 0x1c, 0x4e, 0xa2,
+		0x6f, 0xf5, 0x07, 0x5d, 0xf4, 0xd8, 0x4c, 0x26, 0xf1, 0x71, 0x41, 0x42, 0xe0, 0x70, 0x12, 0xfb,
+		0x60, 0xeb, 0x27, 0x04, 0x09, 0x27, 0xc0, 0xcf, 0x67, 0x30, 0x3c, 0xb9, 0xbb, 0x6b, 0x99, 0xd3,
+		0x34, 0xe2, 0x47, 0x39, 0x05, 0xe0, 0x28, 0x48, 0xe0, 0x29, 0xc8, 0x4c, 0x3b, 0x10, 0x3f, 0xf1,
+		0x25, 0x31, 0x3d, 0xc4, 0x08, 0x5c, 0x82, 0xa2, 0x50, 0x50, 0x78, 0xb8, 0x37, 0x99, 0xc4, 0x4f,
+		0x72, 0x12, 0x85, 0x00, 0x1a, 0xef, 0x86, 0xab, 0x3b, 0xee, 0xb6, 0x3e, 0x0d, 0x91, 0x4f, 0x8a,
+		0x6e, 0x70, 0x14, 0xce, 0xca, 0x4d, 0xdd, 0x6c, 0xef, 0x4c, 0x47, 0xe1, 0x53, 0x82, 0x95, 0x02,
+		0x07, 0x49, 0x2c, 0x43, 0xbe, 0xa7, 0xd9, 0xce, 0x8e, 0xd6, 0x9d, 0x6a, 0x38, 0x7e, 0x8a, 0xd3,
+		0xc8, 0x79, 0x48, 0x9c, 0x23, 0x03, 0x73, 0x3f, 0x64, 0x7e, 0x5a, 0x70, 0x64, 0x60, 0x86, 0x08,
+		0xad, 0xc3, 0xbc, 0xe3, 0xd2, 0x5d, 0xd2, 0x7e, 0xa8, 0xfd, 0x8c, 0x98, 0x7a, 0x0c, 0xf7, 0x6a,
+		0x90, 0xe2, 0x53, 0x90, 0x71, 0x8c, 0xf7, 0x4e, 0x45, 0xe6, 0x67, 0xc5, 0x48, 0x53, 0x04, 0x44,
+		0x7e, 0x01, 0x8e, 0x44, 0x2e, 0x13, 0x53, 0x10, 0xfb, 0x39, 0x4e, 0xec, 0x50, 0xc4, 0x52, 0xc1,
+		0x55, 0xc2, 0x7e, 0x49, 0xfe, 0xbc, 0x50, 0x09, 0xfa, 0x10, 0xad, 0x75, 0x74, 0x93, 0x3b, 0xda,
+		0xd6, 0xfe, 0xb8, 0xf6, 0xf7, 0x04, 0xd7, 0x18, 0x6e, 0x88, 0x6b, 0x2d, 0x38, 0xc4, 0x29, 0xee,
+		0x6f, 0x5c, 0x7f, 0x41, 0x28, 0x56, 0x86, 0xbd, 0x11, 0x1e, 0xdd, 0x6f, 0x84, 0x05, 0x8f, 0x9d,
+		0xc2, 0x1f, 0xeb, 0xa8, 0x18, 0xcd, 0x34, 0x99, 0xf2, 0x2f, 0x72, 0xca, 0x42, 0xe3, 0x7b, 0x0e,
+		0x5d, 0xe7, 0xaa, 0xd6, 0x47, 0xe2, 0xcf, 0x43, 0x49, 0x10, 0x1f, 0x98, 0xb6, 0xde, 0xb6, 0xb6,
+		0x4d, 0xe3, 0xbd, 0x7a, 0x67, 0x0a, 0xd2, 0xbf, 0x34, 0x34, 0x54, 0x1b, 0x01, 0x74, 0xa4, 0xdc,
+		0x00, 0xd9, 0xb3, 0x55, 0x54, 0xa3, 0x47, 0xcf, 0xae, 0xf6, 0xa6, 0xf8, 0xcb, 0x62, 0xa4, 0x3c,
+		0xbc, 0x06, 0x45, 0x5b, 0xaa, 0x03, 0xbb, 0xc9, 0x30, 0xad, 0x48, 0x7e, 0x9a, 0x13, 0xca, 0xfb,
+		0x58, 0x5c, 0x71, 0xb4, 0xad, 0x5e, 0x5f, 0xb3, 0xa7, 0xd1, 0x7f, 0x9f, 0x11, 0x8a, 0x83, 0xa3,
+		0x70, 0xc5, 0x81, 0xf6, 0x1a, 0xae, 0xf6, 0x53, 0x50, 0xf8, 0x15, 0xa1, 0x38, 0x04, 0x0e, 0x27,
+		0x21, 0x0c, 0x86, 0x29, 0x48, 0xfc, 0x7d, 0x41, 0x42, 0xe0, 0x20, 0x89, 0x77, 0xfb, 0x0b, 0xad,
+		0xad, 0x6f, 0x1b, 0x8e, 0xcb, 0xef, 0x1a, 0xed, 0x4d, 0xea, 0x57, 0xbf, 0x14, 0x36, 0xc2, 0x94,
+		0x00, 0x2a, 0x6a, 0x22, 0x6e, 0xd9, 0xd3, 0x43, 0x82, 0xc9, 0x0d, 0xfb, 0xac, 0xd0, 0x44, 0x01,
+		0x34, 0x6c, 0x5b, 0xc0, 0x42, 0x44, 0xb6, 0xb7, 0x71, 0xfb, 0x3f, 0x05, 0xb9, 0x5f, 0x1b, 0x6a,
+		0x5c, 0x53, 0xe0, 0x22, 0xcd, 0x80, 0xfd, 0x33, 0x30, 0xaf, 0xeb, 0xbb, 0x53, 0x49, 0xe7, 0x3f,
+		0x18, 0xb2, 0x7f, 0x36, 0x18, 0x26, 0xd3, 0x21, 0xc5, 0x21, 0x7b, 0x6a, 0xf2, 0x0e, 0xe8, 0xfd,
+		0x5f, 0xe6, 0xfd, 0x0d, 0x9b, 0x53, 0x4b, 0x2b, 0x20, 0xf3, 0x1c, 0xdf, 0x80, 0x9d, 0x48, 0xec,
+		0xdb, 0xbf, 0xec, 0xc9, 0x79, 0xc8, 0xe6, 0x59, 0xba, 0x08, 0xf9, 0x90, 0xc1, 0x33, 0x99, 0xd4,
+		0x07, 0x38, 0xa9, 0x5c, 0xd0, 0xde, 0x59, 0x7a, 0x1c, 0x12, 0x68, 0xbc, 0x4c, 0x46, 0xff, 0x0e,
+		0x8e, 0x4e, 0xc1, 0x97, 0xde, 0x05, 0x69, 0x61, 0xb4, 0x4c, 0x46, 0xfd, 0x4e, 0x8e, 0xea, 0xa1,
+		0x20, 0xba, 0x30, 0x58, 0x26, 0xa3, 0xff, 0xdf, 0x02, 0x5d, 0xa0, 0x20, 0xfa, 0xf4, 0x2c, 0xfc,
+		0x27, 0x1f, 0x4a, 0x30, 0x74, 0x81, 0xb2, 0x84, 0x37, 0x29, 0x98, 0xa5, 0x32, 0x19, 0xfb, 0xbb,
+		0x78, 0xe5, 0x02, 0x63, 0xe9, 0x09, 0x48, 0x4e, 0xc9, 0xf0, 0x0f, 0x73, 0x54, 0x06, 0xbf, 0xb4,
+		0x0c, 0xd9, 0x80, 0x75, 0x32, 0x19, 0xfd, 0x7b, 0x38, 0x7a, 0x10, 0x0b, 0x9b, 0xce, 0xad, 0x93,
+		0xc9, 0x04, 0xfe, 0x1f, 0xd1, 0x74, 0x8e, 0x81, 0x6c, 0x13, 0x86, 0xc9, 0x64, 0xec, 0x8f, 0x08,
+		0xae, 0x0b, 0x94, 0xa5, 0x67, 0x20, 0xe3, 0x2d, 0x36, 0x93, 0xf1, 0xbf, 0x97, 0xe3, 0xfb, 0x38,
+		0xc8, 0x81, 0x81, 0xb9, 0x0f, 0x12, 0xff, 0xaf, 0xe0, 0x40, 0x00, 0x0b, 0xa7, 0xd1, 0xb0, 0x01,
+		0x33, 0x99, 0xd2, 0xf7, 0x89, 0x69, 0x34, 0x64, 0xbf, 0xe0, 0x68, 0x52, 0x9d, 0x3f, 0x99, 0xc4,
+		0xf7, 0x8b, 0xd1, 0xa4, 0xf0, 0xd8, 0x8c, 0x61, 0x8b, 0x60, 0x32, 0x8d, 0x1f, 0x14, 0xcd, 0x18,
+		0x32, 0x08, 0x96, 0xd6, 0x81, 0x8c, 0x5a, 0x03, 0x93, 0xe9, 0xbd, 0xca, 0xe9, 0xcd, 0x8e, 0x18,
+		0x03, 0x4b, 0xcf, 0xc1, 0xa1, 0x68, 0x4b, 0x60, 0x32, 0xd5, 0x1f, 0xfa, 0xf2, 0xd0, 0xde, 0x2d,
+		0x68, 0x08, 0x2c, 0xb5, 0x60, 0x3e, 0xca, 0x0a, 0x98, 0x4c, 0xf6, 0x87, 0xbf, 0x1c, 0x56, 0xdc,
+		0x41, 0x23, 0x60, 0xa9, 0x02, 0xe0, 0x2f, 0xc0, 0x93, 0x69, 0xfd, 0x08, 0xa7, 0x15, 0x40, 0xc2,
+		0xa9, 0xc1, 0xd7, 0xdf, 0xc9, 0xf8, 0xb7, 0xc5, 0xd4, 0xe0, 0x18, 0x38, 0x35, 0xc4, 0xd2, 0x3b,
+		0x19, 0xfb, 0x63, 0x62, 0x6a, 0x08, 0x14, 0x94, 0xec, 0xc0, 0xea, 0x36, 0x99, 0xc2, 0x8f, 0x0a,
+		0xc9, 0x0e, 0x60, 0x2d, 0xad, 0xc2, 0xec, 0xc8, 0x82, 0x38, 0x99, 0xd4, 0x8f, 0x71, 0x52, 0xf2,
+		0xf0, 0x7a, 0x18, 0x5c, 0xbc, 0xf8, 0x62, 0x38, 0x99, 0xda, 0x8f, 0x0f, 0x2d, 0x5e, 0x7c, 0x2d,
+		0x5c, 0x7a, 0x0a, 0xd2, 0xe6, 0xa0, 0xdb, 0xc5, 0xc9, 0x33, 0xc9, 0xe5, 0xf5, 0x47, 0x5f, 0xe5,
+		0xdc, 0x11, 0x08, 0x4b, 0x8f, 0x43, 0x52, 0xef, 0x6d, 0xea, 0x9d, 0x49, 0x98, 0x7f, 0xfc, 0x55,
+		0xa1, 0x30, 0x11, 0x1a, 0x1d, 0x6d, 0xcc, 0x35, 0x42, 0xe3, 0xb5, 0x27, 0xe0, 0xfe, 0xc9, 0x57,
+		0xf9, 0xe5, 0x2e, 0x1f, 0xc5, 0x27, 0x30, 0x8d, 0xa7, 0xee, 0x4b, 0x61, 0x02, 0x74, 0x44, 0x2e,
+		0xc0, 0x0c, 0x1e, 0xd3, 0xba, 0xda, 0x44, 0x8f, 0xe5, 0x9f, 0x72, 0x6c, 0x01, 0x8f, 0x0c, 0xeb,
+		0x59, 0xb6, 0xee, 0x6a, 0xdb, 0xce, 0x24, 0xdc, 0x3f, 0xe3, 0xb8, 0x1e, 0x02, 0x22, 0xb7, 0x35,
+		0xc7, 0x9d, 0xa6, 0xdf, 0x7f, 0x2e, 0x90, 0x05, 0x02, 0x36, 0x1a, 0x7f, 0x5f, 0xd7, 0x27, 0x7a,
+		0x38, 0xff, 0x42, 0x34, 0x9a, 0xc3, 0x2f, 0xbd, 0x0b, 0x32, 0xf8, 0x93, 0xdd, 0xd8, 0x9c, 0x80,
+		0xfc, 0x97, 0x1c, 0xd9, 0xc7, 0xc0, 0x9a, 0x1d, 0xb7, 0xe3, 0x1a, 0x93, 0x99, 0xfd, 0x57, 0x7c,
+		0xa4, 0x05, 0xfc, 0x52, 0x05, 0xb2, 0x8e, 0xdb, 0xe9, 0x0c, 0xb8, 0x7d, 0x3a, 0xc9, 0x3f, 0xfc,
+		0x55, 0xcf, 0x65, 0xe1, 0xe1, 0xe0, 0x68, 0xdf, 0xbc, 0xee, 0xf6, 0x2d, 0x1a, 0xe1, 0x33, 0xd1,
+		0x43, 0xcc, 0x29, 0x04, 0x50, 0x96, 0x96, 0x21, 0x87, 0x7d, 0x11, 0x81, 0x12, 0x13, 0xfd, 0xc3,
+		0x9c, 0x01, 0x21, 0xa4, 0xea, 0x7b, 0xc6, 0x39, 0x77, 0xa3, 0xcf, 0x10, 0xe0, 0x92, 0x75, 0xc9,
+		0x62, 0xa7, 0x07, 0x2f, 0xde, 0x37, 0xea, 0xfd, 0x0d, 0xfb, 0x75, 0xe9, 0x2f, 0xf8, 0xa9, 0x04,
+		0x1c, 0x6b, 0x5b, 0x3d, 0xdd, 0xdd, 0xdc, 0x72, 0xcf, 0xb4, 0xed, 0xdd, 0xbe, 0x6b, 0x9d, 0xb9,
+		0x71, 0x16, 0x5d, 0xbb, 0xd6, 0x16, 0x23, 0x4c, 0x88, 0x28, 0x5f, 0x64, 0xe5, 0x8b, 0x37, 0xce,
+		0x2e, 0x44, 0xfa, 0x88, 0xcb, 0x5b, 0x90, 0x5c, 0x47, 0x44, 0x3c, 0xb3, 0x72, 0x2d, 0x57, 0xeb,
+		0xd2, 0xd8, 0x9c, 0xb8, 0xc2, 0x12, 0x98, 0xcb, 0xee, 0xa7, 0xc7, 0x58, 0xae, 0x21, 0xae, 0xa6,
+		0x77, 0x75, 0x6d, 0x8b, 0x5d, 0xf3, 0x8b, 0xd3, 0xa8, 0xa3, 0x34, 0x66, 0xd0, 0x1b, 0x7d, 0xf3,
+		0x90, 0xd4, 0x06, 0xec, 0x50, 0x30, 0x7e, 0x2a, 0xa7, 0xb0, 0x44, 0x79, 0x05, 0x66, 0xb8, 0x17,
+		0x13, 0xcf, 0xc1, 0xae, 0xeb, 0xbb, 0xb4, 0x9e, 0x9c, 0x82, 0x3f, 0xc9, 0x19, 0x48, 0xd2, 0xd6,
+		0xf3, 0xe8, 0xca, 0x23, 0x8b, 0xa3, 0xcd, 0x5f, 0xa4, 0xad, 0x54, 0x18, 0x5c, 0xf9, 0x0a, 0xa4,
+		0x6b, 0x16, 0x3a, 0xc1, 0xc3, 0xe4, 0x32, 0x8c, 0x1c, 0x6d, 0x74, 0x7f, 0xe0, 0x8a, 0x10, 0x78,
+		0x9a, 0xc0, 0x0b, 0x25, 0xec, 0xde, 0x27, 0x3f, 0xd9, 0xe4, 0xa9, 0xf2, 0x32, 0xcc, 0x50, 0xda,
+		0x6b, 0x7d, 0xef, 0x31, 0x05, 0x29, 0xf0, 0x98, 0x02, 0x27, 0x1f, 0xf3, 0x5b, 0x4b, 0x20, 0x81,
+		0x97, 0xc2, 0x78, 0xc7, 0xe9, 0xef, 0xf2, 0x33, 0x90, 0xe6, 0x44, 0x1c, 0xf2, 0x28, 0xc4, 0xad,
+		0xbe, 0x38, 0xb8, 0x3f, 0x3a, 0xb6, 0x2f, 0x6b, 0xfd, 0x6a, 0xe2, 0x37, 0x5f, 0x3b, 0x7e, 0x40,
+		0x41, 0xe8, 0xea, 0xd5, 0xb1, 0x67, 0x02, 0x8f, 0x86, 0xa4, 0x42, 0x0c, 0xbb, 0xf8, 0xa1, 0xf5,
+		0x8d, 0x33, 0xa3, 0xc2, 0xe0, 0x9d, 0x0d, 0x7c, 0x5f, 0x0c, 0x8e, 0x8d, 0xb8, 0xfe, 0xf9, 0x84,
+		0x19, 0xf7, 0x84, 0xd4, 0x12, 0xa4, 0x6b, 0x62, 0x1e, 0x96, 0xf0, 0xb5, 0xa2, 0xb6, 0x65, 0x76,
+		0x1c, 0x2e, 0x17, 0x22, 0x89, 0x4c, 0x36, 0x35, 0xd3, 0x72, 0xf8, 0x25, 0x65, 0x96, 0xa8, 0x7e,
+		0x54, 0xda, 0x9f, 0xf8, 0xe7, 0x45, 0x4d, 0x74, 0x0a, 0xac, 0x4b, 0x2f, 0x9e, 0x9d, 0x78, 0x7e,
+		0x76, 0xdd, 0xb4, 0x6e, 0x9a, 0x5e, 0x27, 0x42, 0x67, 0x68, 0xc7, 0x86, 0xcf, 0xd0, 0x9e, 0xd3,
+		0xbb, 0xdd, 0x67, 0x11, 0x1e, 0x83, 0x5f, 0x1c, 0x8f, 0x2b, 0x1f, 0x4b, 0x8c, 0x72, 0xe5, 0xa6,
+		0xad, 0xf5, 0xfb, 0xba, 0xed, 0x8c, 0xe3, 0xca, 0x3d, 0x90, 0xad, 0x05, 0x62, 0xef, 0xbc, 0x6b,
+		0x16, 0x12, 0x8d, 0xcb, 0x63, 0x89, 0x72, 0x19, 0xe0, 0x62, 0xd7, 0xd2, 0xdc, 0x08, 0x98, 0x58,
+		0x00, 0xa6, 0x61, 0xba, 0xe7, 0x1f, 0x8b, 0x80, 0x89, 0x0b, 0x98, 0x7b, 0x20, 0xbb, 0x31, 0x0e,
+		0x28, 0x11, 0x26, 0xf4, 0xe8, 0xb9, 0x08, 0x98, 0xe4, 0x10, 0xa1, 0x48, 0xa0, 0xbc, 0x00, 0x3a,
+		0x09, 0x99, 0xaa, 0x65, 0x75, 0x23, 0x40, 0xd2, 0x01, 0x3a, 0xcd, 0x40, 0x58, 0x61, 0x08, 0x28,
+		0x13, 0x68, 0x50, 0x75, 0xd7, 0xd5, 0x9d, 0x08, 0x98, 0x1c, 0x87, 0xd9, 0xbf, 0x80, 0x3c, 0xc7,
+		0xc7, 0x65, 0xbf, 0x02, 0x22, 0xc6, 0xf3, 0x8e, 0x04, 0xe4, 0x07, 0x73, 0x01, 0x15, 0xcb, 0xa8,
+		0xa2, 0x86, 0xd5, 0x6c, 0xad, 0x27, 0x04, 0x64, 0xd6, 0x9b, 0xd7, 0xb4, 0x7c, 0xac, 0x86, 0x5d,
+		0x98, 0x30, 0xff, 0x16, 0x26, 0x48, 0x62, 0xf9, 0xb3, 0x71, 0x28, 0x2e, 0x5b, 0xa6, 0xa3, 0x9b,
+		0xce, 0xc0, 0x59, 0xa7, 0x4d, 0x20, 0x8f, 0x41, 0x72, 0xb3, 0x6b, 0xb5, 0xaf, 0xf3, 0xcb, 0x0b,
+		0xc7, 0x16, 0x47, 0x1a, 0xb3, 0x58, 0xc5, 0x72, 0x06, 0xae, 0x30, 0x60, 0xf2, 0x2e, 0x48, 0xeb,
+		0x37, 0x30, 0x86, 0xb5, 0xad, 0x73, 0x4d, 0x7b, 0x32, 0x02, 0xb1, 0xce, 0x41, 0x38, 0xae, 0x87,
+		0x42, 0xbe, 0x01, 0x32, 0x3c, 0x4a, 0xd0, 0xb2, 0xf9, 0xad, 0xfe, 0x72, 0x04, 0xfe, 0x35, 0x01,
+		0xc3, 0x09, 0xf8, 0x48, 0x64, 0x09, 0x66, 0x6e, 0xe8, 0xb6, 0x23, 0xa2, 0x8e, 0xf0, 0xd9, 0xab,
+		0x08, 0x7c, 0x06, 0xc1, 0xb1, 0x05, 0x02, 0x79, 0x1c, 0x12, 0xda, 0x66, 0xdb, 0xe0, 0x81, 0x38,
+		0x77, 0x47, 0x20, 0x56, 0xaa, 0xcb, 0x0d, 0x86, 0x45, 0x6f, 0x2e, 0x50, 0x70, 0x6c, 0xb4, 0xb3,
+		0x6b, 0xb6, 0x77, 0x6c, 0xcb, 0xdc, 0x2d, 0xa5, 0xc6, 0x36, 0xba, 0x29, 0x60, 0x44, 0xa3, 0x3d,
+		0x24, 0x6c, 0x34, 0x8f, 0xde, 0x29, 0xcd, 0x8c, 0x6d, 0x34, 0x8f, 0xfa, 0x11, 0x8d, 0xe6, 0x08,
+		0xe5, 0x06, 0x64, 0x03, 0xe3, 0xc0, 0x2e, 0x96, 0xde, 0x52, 0x37, 0x71, 0x92, 0xf0, 0x09, 0x9f,
+		0xee, 0x69, 0xb7, 0xe8, 0xa4, 0x21, 0x87, 0x61, 0x06, 0x0b, 0xb7, 0xf9, 0x0d, 0x8f, 0xb8, 0x92,
+		0xea, 0x69, 0xb7, 0x2e, 0x69, 0x18, 0x48, 0x14, 0x97, 0x13, 0xe5, 0x9f, 0x92, 0xa0, 0x10, 0x1e,
+		0x1a, 0xf2, 0x10, 0x10, 0xc4, 0xc0, 0xf3, 0x79, 0xf4, 0x73, 0xd3, 0x41, 0x16, 0x74, 0x31, 0x46,
+		0xab, 0xb2, 0xad, 0xaf, 0x0e, 0x7a, 0xb4, 0x01, 0x0e, 0xc1, 0xc3, 0x7c, 0x0e, 0x2c, 0x04, 0xd0,
+		0x5b, 0x6e, 0x47, 0xde, 0xc5, 0xe2, 0x00, 0xd5, 0x34, 0x2e, 0x50, 0xaf, 0xfe, 0xc7, 0xe3, 0x12,
+		0x0d, 0xe1, 0xaa, 0x6c, 0xeb, 0xa2, 0x24, 0xdc, 0x95, 0x78, 0xb8, 0x2b, 0xe5, 0x67, 0xa0, 0x38,
+		0x24, 0x05, 0xa4, 0x0c, 0xf9, 0xfe, 0x60, 0x53, 0xbd, 0xae, 0xef, 0xd2, 0x98, 0x5b, 0xb6, 0x3c,
+		0x66, 0x94, 0x6c, 0x7f, 0xb0, 0xf9, 0xac, 0xbe, 0x4b, 0x67, 0xdf, 0x52, 0xfa, 0x57, 0x3f, 0x71,
+		0x5c, 0x7a, 0xe3, 0x13, 0xc7, 0xa5, 0xf2, 0x43, 0x90, 0x0f, 0x89, 0x01, 0xae, 0xc2, 0x5a, 0xbf,
+		0xcf, 0xf5, 0x1f, 0xfe, 0x0c, 0x00, 0xbf, 0x08, 0x39, 0x34, 0x3c, 0xf4, 0x0e, 0x87, 0xbd, 0x1f,
+		0x8a, 0x94, 0x15, 0xea, 0x30, 0xaf, 0xf3, 0x34, 0xfb, 0xaa, 0x60, 0x78, 0x19, 0xf2, 0x3e, 0x9c,
+		0xcf, 0xf6, 0xac, 0x80, 0xba, 0xa4, 0x39, 0xe5, 0x1f, 0x90, 0xa0, 0x38, 0x24, 0x1b, 0xe4, 0x5d,
+		0x90, 0xc1, 0x50, 0x7f, 0xc3, 0x61, 0x31, 0x47, 0x13, 0x58, 0x98, 0xa0, 0xec, 0xf3, 0x31, 0x48,
+		0x0d, 0xf2, 0x22, 0xaa, 0xa2, 0xa3, 0x77, 0xb5, 0xdd, 0x52, 0x6c, 0x3a, 0x12, 0xe2, 0xf9, 0xc2,
+		0x1a, 0x22, 0x95, 0x7f, 0x43, 0x82, 0x7c, 0x48, 0xe8, 0x48, 0x07, 0xee, 0xbe, 0x61, 0xb9, 0x7a,
+		0x70, 0xb7, 0xcf, 0xaf, 0xf5, 0xef, 0xe8, 0xc6, 0xf6, 0x8e, 0x3b, 0xf6, 0xa2, 0x93, 0xbf, 0xd0,
+		0x50, 0x83, 0x44, 0x52, 0x16, 0x90, 0x8e, 0xbf, 0xe9, 0x67, 0xf7, 0xff, 0x2f, 0x53, 0x22, 0x64,
+		0x0d, 0x48, 0x7f, 0xd3, 0x1d, 0x26, 0x1d, 0x9b, 0x96, 0xb4, 0x8c, 0xc8, 0x41, 0x82, 0xe5, 0x26,
+		0x80, 0x3f, 0x71, 0x49, 0x65, 0x9a, 0x4e, 0xc4, 0xf7, 0x6a, 0xe1, 0x52, 0xac, 0x24, 0x55, 0xd7,
+		0x3f, 0xf5, 0x85, 0x63, 0x63, 0x17, 0x9a, 0x17, 0xcf, 0x4d, 0x6f, 0x51, 0x09, 0xdd, 0xef, 0xad,
+		0x0c, 0xaf, 0xc6, 0xe0, 0xee, 0x08, 0xe3, 0xfb, 0xba, 0xbe, 0xeb, 0xec, 0xdb, 0xf6, 0xbe, 0x01,
+		0x99, 0x75, 0xfa, 0x9e, 0xe5, 0xb3, 0xfa, 0x2e, 0x59, 0xc0, 0xb0, 0xc7, 0x73, 0x8f, 0x3f, 0x7e,
+		0xf6, 0x02, 0x5b, 0x30, 0x2f, 0x1f, 0x50, 0x44, 0x06, 0x39, 0x06, 0x19, 0x47, 0x6f, 0xf7, 0xcf,
+		0x3d, 0x7e, 0xfe, 0xfa, 0x59, 0x66, 0x89, 0x5e, 0x3e, 0xa0, 0xf8, 0x59, 0xe4, 0x2e, 0x48, 0x6f,
+		0x76, 0x9d, 0xb3, 0xe7, 0x1e, 0x7d, 0xf2, 0x2c, 0xb3, 0x4a, 0x2f, 0x1f, 0x50, 0xbc, 0x9c, 0xa5,
+		0x34, 0xce, 0x92, 0x37, 0x7e, 0xf4, 0xb8, 0x54, 0x4d, 0x42, 0xdc, 0x19, 0xf4, 0xbe, 0x5e, 0xb6,
+		0xe6, 0xaf, 0xcd, 0xc0, 0xc9, 0xd1, 0x45, 0xd3, 0xd3, 0xfe, 0xe3, 0xd7, 0xcd, 0xbd, 0x19, 0x3a,
+		0x86, 0x79, 0x9f, 0x96, 0x20, 0xe7, 0x29, 0x19, 0xbc, 0x66, 0xf0, 0x4e, 0x00, 0xaf, 0x2e, 0x61,
+		0x7d, 0xdf, 0xb5, 0xd7, 0xfa, 0xa4, 0x04, 0xe0, 0xc9, 0x93, 0x90, 0xee, 0xdb, 0x56, 0xdf, 0x72,
+		0xf8, 0xb3, 0x3b, 0x93, 0x70, 0x3d, 0x68, 0xbc, 0xd6, 0x41, 0xf7, 0x4a, 0xea, 0x0d, 0xcb, 0xc5,
+		0x63, 0xff, 0xbe, 0x75, 0x93, 0xbf, 0x66, 0x16, 0x57, 0x64, 0x5a, 0x72, 0x8d, 0x16, 0xac, 0x63,
+		0x7e, 0xf9, 0xbf, 0x4b, 0x90, 0xf1, 0xa8, 0xa0, 0x79, 0xad, 0x75, 0x3a, 0xb6, 0xee, 0x38, 0xdc,
+		0x4a, 0x12, 0x49, 0x5c, 0x75, 0xb8, 0xbe, 0xf4, 0x1e, 0x75, 0x8a, 0xda, 0x48, 0x08, 0xf1, 0xa1,
+		0x2b, 0x5e, 0x8a, 0x29, 0x53, 0xbc, 0xa2, 0x11, 0xd1, 0x96, 0xec, 0x0d, 0xbf, 0x19, 0xf4, 0x8d,
+		0x55, 0xde, 0x01, 0xb5, 0x6f, 0x1b, 0x96, 0x6d, 0xb8, 0xbb, 0x74, 0x4d, 0x8e, 0x2b, 0xb2, 0x28,
+		0x58, 0xe7, 0xf9, 0x41, 0xdd, 0xcd, 0xd4, 0x69, 0x92, 0x5d, 0x34, 0x61, 0xd5, 0x31, 0x65, 0x7a,
+		0x02, 0x72, 0x41, 0xfd, 0xce, 0xdf, 0x4b, 0x03, 0x5f, 0xbd, 0x97, 0xbb, 0x50, 0x6c, 0x1a, 0xbd,
+		0x7e, 0x57, 0xf7, 0xbb, 0x7f, 0xde, 0xef, 0xa4, 0x34, 0x45, 0x27, 0xc7, 0x76, 0x30, 0x36, 0xd2,
+		0xc1, 0xd3, 0xbf, 0x27, 0xf1, 0xa5, 0xb7, 0x51, 0xbb, 0xd8, 0xd5, 0xb6, 0xc9, 0x59, 0x38, 0x58,
+		0x5d, 0x59, 0x5b, 0x7e, 0x56, 0x6d, 0xd4, 0xd4, 0x8b, 0x2b, 0x95, 0x40, 0x48, 0xf8, 0xc2, 0xa1,
+		0x57, 0x6e, 0x9f, 0x20, 0x01, 0xd8, 0x0d, 0x93, 0x5a, 0x90, 0xe4, 0x0c, 0xcc, 0x87, 0x51, 0x2a,
+		0xd5, 0x26, 0x5e, 0x44, 0x92, 0x16, 0x0e, 0xbe, 0x72, 0xfb, 0xc4, 0x6c, 0x00, 0xa3, 0xb2, 0xe9,
+		0xe8, 0xa6, 0x3b, 0x8a, 0xb0, 0xbc, 0x76, 0xf5, 0x2a, 0xc6, 0xe5, 0x8f, 0x20, 0x60, 0x90, 0xab,
+		0xe1, 0x92, 0x07, 0x61, 0x36, 0x8c, 0xb0, 0xda, 0x58, 0x91, 0xe3, 0x0b, 0xe4, 0x95, 0xdb, 0x27,
+		0x0a, 0x01, 0xe8, 0x55, 0xa3, 0xbb, 0x90, 0xfe, 0xe0, 0x8f, 0x1f, 0x3b, 0xf0, 0xa9, 0xff, 0xff,
+		0x98, 0x54, 0x5d, 0xf9, 0xba, 0xe8, 0xb5, 0x1f, 0x8c, 0xc1, 0xf1, 0x61, 0x43, 0x14, 0x1d, 0x33,
+		0x8e, 0xab, 0xf5, 0xfa, 0xe3, 0xf6, 0x44, 0x4f, 0x41, 0xa6, 0x25, 0x60, 0xf6, 0xbd, 0x55, 0xbc,
+		0xbd, 0xcf, 0x9d, 0x40, 0xc1, 0xab, 0x4a, 0x6c, 0x05, 0xce, 0x4d, 0xb9, 0x15, 0xf0, 0xfa, 0x71,
+		0x47, 0x7b, 0x81, 0xaf, 0x34, 0xe1, 0x2e, 0x9f, 0x89, 0x9b, 0x6d, 0x03, 0xd5, 0x13, 0xd3, 0x08,
+		0x8c, 0x2d, 0xb2, 0x28, 0x5d, 0xc4, 0x52, 0x54, 0x68, 0x13, 0xdc, 0x33, 0x0b, 0x13, 0xf6, 0x16,
+		0x0b, 0x93, 0xd5, 0xe8, 0x98, 0xbd, 0xc6, 0xa4, 0x21, 0x2c, 0xff, 0xdb, 0x0c, 0xcc, 0x28, 0xfa,
+		0xcb, 0x03, 0xdd, 0x71, 0xc9, 0xa3, 0x90, 0xd0, 0xdb, 0x3b, 0xd6, 0xe8, 0xd4, 0xe3, 0xdd, 0x58,
+		0xac, 0xb7, 0x77, 0x2c, 0x0e, 0x7c, 0xf9, 0x80, 0x42, 0x81, 0xc9, 0x79, 0x48, 0x6e, 0x75, 0x07,
+		0xce, 0x4e, 0x29, 0x36, 0xbc, 0xf3, 0x10, 0x58, 0x17, 0xb1, 0xd8, 0x47, 0x63, 0xe0, 0x58, 0x19,
+		0x7d, 0x2a, 0x38, 0x3e, 0xae, 0x32, 0xfa, 0x42, 0xb0, 0x5f, 0x19, 0x02, 0x93, 0x65, 0x00, 0xc3,
+		0x34, 0x5c, 0xb5, 0xbd, 0xa3, 0x19, 0x66, 0x29, 0x39, 0x6c, 0xbd, 0xfb, 0xa8, 0x86, 0xbb, 0x8c,
+		0x20, 0x3e, 0x7e, 0xc6, 0x10, 0x79, 0xd8, 0xe2, 0x97, 0x07, 0xba, 0x2d, 0xac, 0xff, 0x88, 0x16,
+		0xbf, 0x1b, 0x8b, 0x03, 0x2d, 0xa6, 0xe0, 0xb8, 0x5b, 0x62, 0xaf, 0x51, 0xb9, 0xb7, 0x4a, 0xe9,
+		0x61, 0xc3, 0x5f, 0xa0, 0xd2, 0xc7, 0xa8, 0x5a, 0xb7, 0x7c, 0xe4, 0x99, 0x36, 0xcb, 0x21, 0x17,
+		0x20, 0xd5, 0xa6, 0xb3, 0xbc, 0x94, 0xe5, 0x0f, 0xa0, 0x8d, 0x22, 0xd3, 0x72, 0x1f, 0x97, 0x23,
+		0x90, 0x35, 0x28, 0x74, 0x0d, 0xc7, 0x55, 0x1d, 0x53, 0xeb, 0x3b, 0x3b, 0x96, 0x2b, 0x1e, 0x84,
+		0xb9, 0x7f, 0x94, 0xc4, 0x8a, 0xe1, 0xb8, 0x4d, 0x01, 0xe6, 0x53, 0xca, 0x77, 0x83, 0xf9, 0x48,
+		0xd0, 0xda, 0xda, 0xd2, 0x6d, 0x8f, 0x62, 0x29, 0x3f, 0x8e, 0xe0, 0x1a, 0xc2, 0x09, 0xcc, 0x00,
+		0x41, 0x2b, 0x98, 0x4f, 0xbe, 0x09, 0xe6, 0xba, 0x96, 0xd6, 0xf1, 0xe8, 0xa9, 0xed, 0x9d, 0x81,
+		0x79, 0x9d, 0xbe, 0xd6, 0x9c, 0x3d, 0x77, 0x3a, 0xa2, 0x99, 0x96, 0xd6, 0x11, 0xc8, 0xcb, 0x08,
+		0xea, 0x53, 0x9e, 0xed, 0x0e, 0x97, 0x11, 0x15, 0xe6, 0xb5, 0x7e, 0xbf, 0xbb, 0x3b, 0x4c, 0xbe,
+		0x48, 0xc9, 0x3f, 0x34, 0x4a, 0xbe, 0x82, 0xd0, 0x63, 0xe8, 0x13, 0x6d, 0xa4, 0x90, 0x6c, 0x80,
+		0xdc, 0xb7, 0x75, 0x1a, 0xdc, 0xc1, 0x16, 0x3b, 0xad, 0x4b, 0x5f, 0x5c, 0xc1, 0x9b, 0x17, 0x23,
+		0xc4, 0xd7, 0x19, 0xe4, 0x3a, 0x07, 0xf4, 0x29, 0x17, 0xfb, 0xe1, 0x12, 0x46, 0xd6, 0x6a, 0xeb,
+		0xf4, 0x7d, 0x2a, 0x4e, 0x76, 0x76, 0x3c, 0x59, 0x0a, 0x19, 0x49, 0x36, 0x54, 0x42, 0x2e, 0x42,
+		0x96, 0x3d, 0x2d, 0x8a, 0x16, 0x86, 0x4e, 0x1f, 0xfe, 0xca, 0x9e, 0xbb, 0x27, 0x62, 0xba, 0x52,
+		0xa0, 0x6b, 0x96, 0xab, 0xfb, 0xc4, 0x40, 0xf7, 0x32, 0xc9, 0x26, 0x1c, 0xa4, 0xaf, 0xd6, 0xec,
+		0xaa, 0x61, 0x13, 0xbb, 0x34, 0xc7, 0x6f, 0x9a, 0x8f, 0x50, 0xa4, 0xef, 0xc0, 0xee, 0x5e, 0x0b,
+		0xda, 0xda, 0x3e, 0xe9, 0xb9, 0x1b, 0xa3, 0xa5, 0x28, 0x69, 0x5b, 0x86, 0xa9, 0x75, 0xf1, 0x49,
+		0x36, 0xe6, 0xa1, 0x98, 0x1f, 0x27, 0x69, 0x17, 0x39, 0x1c, 0x5d, 0xe8, 0x02, 0x92, 0xb6, 0x15,
+		0xcc, 0xaf, 0xce, 0x70, 0x2f, 0x92, 0xf7, 0x9e, 0xc3, 0x8c, 0x9c, 0x66, 0xaf, 0x0e, 0x5d, 0x49,
+		0xa4, 0x41, 0xce, 0x96, 0x1f, 0x80, 0x6c, 0x40, 0x4f, 0xe1, 0x2a, 0xc4, 0xf7, 0x49, 0xdc, 0x37,
+		0x25, 0x92, 0xe5, 0x02, 0xe4, 0x82, 0xaa, 0xa9, 0xfc, 0x11, 0x09, 0xb2, 0x01, 0xa5, 0x83, 0x98,
+		0xc2, 0x39, 0xc1, 0x31, 0x79, 0x12, 0xdf, 0x13, 0x63, 0x1b, 0x45, 0x51, 0x1e, 0xa3, 0xdb, 0xd0,
+		0x1c, 0xcd, 0xe4, 0xfb, 0x54, 0xbc, 0x14, 0xd2, 0x3f, 0xd7, 0xf7, 0x40, 0xe2, 0x14, 0x04, 0xfa,
+		0xe7, 0xfa, 0x02, 0xe0, 0x24, 0xe4, 0xb0, 0xeb, 0x6a, 0xd0, 0x03, 0x92, 0x51, 0xb2, 0x98, 0xc7,
+		0x41, 0xca, 0xbf, 0x1d, 0x03, 0x79, 0x58, 0x99, 0xe1, 0xe3, 0xfd, 0xa8, 0xc5, 0xb9, 0x9a, 0x5e,
+		0x18, 0xd9, 0x63, 0x79, 0xcb, 0x22, 0xdb, 0xad, 0x7f, 0x04, 0xf7, 0x8a, 0x14, 0x83, 0x1c, 0x41,
+		0x0d, 0xa6, 0x19, 0xa6, 0x6a, 0x74, 0xc4, 0x1b, 0xfc, 0x34, 0xdd, 0xe8, 0xa0, 0x37, 0xa0, 0x2d,
+		0x7c, 0x4a, 0x2a, 0x5b, 0x7b, 0xf6, 0x70, 0xe9, 0x0c, 0xb9, 0x9f, 0x94, 0x62, 0x3b, 0x9c, 0x41,
+		0x2e, 0x85, 0x6c, 0x6f, 0xf6, 0x40, 0xe7, 0xc9, 0x08, 0x79, 0x12, 0x30, 0x1b, 0xfd, 0x8e, 0xe6,
+		0xea, 0xdc, 0xff, 0x1d, 0x40, 0xc5, 0xbd, 0xbb, 0xd6, 0xef, 0xab, 0x8e, 0xab, 0xb9, 0x7a, 0xc8,
+		0xd8, 0xcc, 0x6b, 0xfd, 0x3e, 0x7d, 0x8a, 0x98, 0x99, 0x9b, 0xf7, 0x41, 0x01, 0x35, 0xbc, 0xa1,
+		0x75, 0xc5, 0xc6, 0x30, 0xc5, 0xb6, 0xf8, 0x3c, 0x97, 0x6f, 0x2e, 0x3b, 0x90, 0x0b, 0x2a, 0x77,
+		0xcf, 0x75, 0x2f, 0xf9, 0xae, 0x7b, 0xcc, 0xa3, 0xb7, 0x7c, 0x18, 0x87, 0xc4, 0x3d, 0xa9, 0x14,
+		0x27, 0xcb, 0x6c, 0x67, 0x9e, 0x42, 0x4b, 0xa6, 0x6f, 0x5b, 0x37, 0xd8, 0x1d, 0xef, 0xb4, 0xc2,
+		0x12, 0xe5, 0x17, 0xa0, 0x10, 0x5e, 0x07, 0x48, 0x01, 0x62, 0xee, 0x2d, 0x5e, 0x4b, 0xcc, 0xbd,
+		0x45, 0xce, 0x06, 0x5e, 0x69, 0x2e, 0x44, 0xad, 0x7e, 0x1c, 0xdf, 0x7f, 0x7d, 0x98, 0x5d, 0x1c,
+		0x2b, 0x17, 0x21, 0x1f, 0x5a, 0x25, 0xca, 0x87, 0x60, 0x3e, 0x4a, 0xe7, 0x97, 0x0d, 0x98, 0x8f,
+		0x52, 0xdd, 0xe4, 0x3c, 0xa4, 0x3d, 0xa5, 0x2f, 0x24, 0x68, 0xa4, 0x76, 0x0f, 0xc9, 0x83, 0x45,
+		0xd9, 0xc1, 0x81, 0xa0, 0x27, 0x3c, 0x31, 0xbe, 0x35, 0xe9, 0xf7, 0xd1, 0xcf, 0x52, 0x7e, 0x0f,
+		0x94, 0xc6, 0xe9, 0xf3, 0x00, 0xe3, 0x98, 0xab, 0x46, 0x30, 0xee, 0x10, 0xa4, 0xf8, 0x05, 0xdb,
+		0x18, 0xf5, 0x3c, 0xf3, 0x14, 0x32, 0x94, 0xe9, 0xf6, 0x38, 0xcd, 0x66, 0x89, 0xb2, 0x0a, 0x47,
+		0xc6, 0xaa, 0x74, 0xff, 0x48, 0x8a, 0xfb, 0xb0, 0x69, 0xc2, 0x27, 0xc4, 0x1a, 0xcb, 0x12, 0x58,
+		0xad, 0xa3, 0x9b, 0x1d, 0xbe, 0x07, 0xca, 0x28, 0x3c, 0x85, 0xcf, 0x07, 0x1c, 0x8a, 0xd6, 0xeb,
+		0xb8, 0x91, 0x41, 0x7f, 0x90, 0x1b, 0x76, 0x1d, 0x41, 0x4f, 0xbb, 0xd5, 0xe2, 0x7e, 0x23, 0x19,
+		0xe2, 0xee, 0x2d, 0x87, 0xde, 0xa1, 0xcb, 0x29, 0xf8, 0x93, 0x5c, 0x83, 0x59, 0xbc, 0x87, 0xd7,
+		0x55, 0xbb, 0x9a, 0xe3, 0xaa, 0x7c, 0xd9, 0x67, 0xd3, 0xe9, 0xde, 0x71, 0x7a, 0x9a, 0xdd, 0x74,
+		0x33, 0x5c, 0x54, 0x41, 0x7c, 0x22, 0x14, 0x29, 0x91, 0x15, 0xcd, 0x71, 0x59, 0x11, 0xa9, 0x43,
+		0xb6, 0x67, 0x38, 0x9b, 0xfa, 0x8e, 0x76, 0xc3, 0xb0, 0x6c, 0x3e, 0xaf, 0x22, 0xa4, 0xe7, 0xaa,
+		0x0f, 0xc4, 0x49, 0x05, 0xf1, 0x02, 0x83, 0x92, 0x0c, 0x49, 0xb3, 0xd0, 0x2c, 0xa9, 0x7d, 0x6b,
+		0x96, 0x47, 0x60, 0xde, 0xd4, 0x6f, 0xb9, 0xaa, 0x3f, 0x73, 0x99, 0xa4, 0xb0, 0x17, 0x08, 0x08,
+		0x96, 0x79, 0x73, 0xdd, 0xa1, 0xa7, 0x82, 0x0f, 0x82, 0xb7, 0xaf, 0x54, 0xc5, 0x96, 0x37, 0x4d,
+		0xa1, 0x8b, 0x22, 0xbf, 0xc2, 0xb2, 0xcb, 0xaf, 0xd0, 0xc1, 0x89, 0x5a, 0x1d, 0x05, 0xeb, 0x25,
+		0x9f, 0xf5, 0x2d, 0x98, 0xe7, 0xf8, 0x9d, 0x10, 0xf7, 0x47, 0xf6, 0xf0, 0x61, 0xa3, 0x2b, 0xc0,
+		0x75, 0x22, 0xf0, 0xc7, 0x33, 0x3e, 0x7e, 0x87, 0x8c, 0x27, 0x90, 0xa0, 0x6c, 0x49, 0x30, 0x75,
+		0x83, 0xbf, 0xff, 0x77, 0x1b, 0x8c, 0xef, 0x88, 0xc3, 0xec, 0x88, 0x61, 0xe1, 0x75, 0x4c, 0x8a,
+		0xec, 0x58, 0x2c, 0xb2, 0x63, 0xf1, 0x7d, 0x77, 0x8c, 0x8f, 0x76, 0x62, 0xf2, 0x68, 0x27, 0xdf,
+		0xca, 0xd1, 0x4e, 0xdd, 0xe1, 0x68, 0x7f, 0x5d, 0xc7, 0xe1, 0x47, 0x24, 0x58, 0x18, 0x6f, 0x8e,
+		0x45, 0x0e, 0xc8, 0x43, 0x30, 0xeb, 0x35, 0xc5, 0x23, 0xcf, 0xd4, 0xa3, 0xec, 0x15, 0x70, 0xfa,
+		0x63, 0x57, 0xbc, 0xfb, 0xa0, 0x30, 0x64, 0x2d, 0x32, 0x61, 0xce, 0x87, 0x3c, 0xb0, 0xe5, 0x5f,
+		0x8a, 0xe3, 0x9b, 0xff, 0xa3, 0x06, 0x5d, 0xc4, 0x8c, 0x55, 0xf0, 0x95, 0xb7, 0xb6, 0xd1, 0xb9,
+		0xe3, 0x09, 0x3b, 0xcb, 0xd1, 0xff, 0xcf, 0x7c, 0x1d, 0x95, 0x13, 0x7c, 0x9f, 0x17, 0x8f, 0xae,
+		0xd0, 0x37, 0xe6, 0x5a, 0xc2, 0x36, 0xca, 0xb0, 0x23, 0x21, 0x5e, 0xd0, 0xb2, 0xb8, 0x75, 0xf4,
+		0x13, 0x00, 0x69, 0x45, 0x77, 0xfa, 0x68, 0xcc, 0xb1, 0x6f, 0xdb, 0xb4, 0xf5, 0xbe, 0xeb, 0x9f,
+		0x6a, 0x44, 0xee, 0x31, 0x38, 0x88, 0xc0, 0xc3, 0xbd, 0xb6, 0x87, 0x47, 0x1e, 0xe3, 0x2e, 0x85,
+		0xb1, 0xce, 0x01, 0x66, 0xaa, 0x7b, 0xa8, 0x14, 0x9a, 0x3c, 0x21, 0x7c, 0x0a, 0xf1, 0x71, 0x3b,
+		0x65, 0x6e, 0xb8, 0x7b, 0x78, 0x0c, 0x1e, 0xab, 0xa3, 0x4e, 0x85, 0xc4, 0xb8, 0xea, 0x98, 0x7d,
+		0xef, 0x57, 0x67, 0xb0, 0xfb, 0xf6, 0x41, 0xaf, 0x42, 0x6a, 0x5c, 0x57, 0x03, 0x86, 0xb8, 0xdf,
+		0x55, 0xdf, 0xad, 0xf0, 0x84, 0x70, 0x2b, 0xcc, 0x8c, 0x6b, 0x34, 0xb7, 0x3c, 0xfd, 0x46, 0x53,
+		0x78, 0xf2, 0x74, 0xc0, 0xaf, 0x90, 0x19, 0x3e, 0x85, 0x1d, 0xf1, 0x2b, 0x78, 0xd8, 0x9e, 0x63,
+		0x61, 0xc9, 0x73, 0x2c, 0xe4, 0xc6, 0x7a, 0x25, 0xb8, 0xc9, 0xe8, 0x21, 0x73, 0x0c, 0xb2, 0x3e,
+		0xe2, 0x59, 0x60, 0x8e, 0x80, 0x07, 0x26, 0x7a, 0x16, 0x3c, 0x52, 0x43, 0xae, 0x85, 0xf5, 0x11,
+		0xd7, 0x42, 0x61, 0x1c, 0xc5, 0x21, 0xfb, 0xd4, 0xa7, 0x18, 0xf6, 0x2d, 0x7c, 0x73, 0xb4, 0x6f,
+		0x61, 0xec, 0xe6, 0x3f, 0xc2, 0x16, 0xf5, 0x48, 0x47, 0x38, 0x17, 0xde, 0x33, 0xc6, 0xb9, 0x20,
+		0x8f, 0xdb, 0x04, 0x47, 0x59, 0xa2, 0x5e, 0x05, 0x51, 0xde, 0x85, 0x6b, 0x11, 0xde, 0x05, 0xe6,
+		0x06, 0x78, 0x70, 0x0a, 0xef, 0x82, 0x47, 0x7a, 0xc4, 0xbd, 0x70, 0x2d, 0xc2, 0xbd, 0x40, 0xc6,
+		0xd3, 0x1d, 0x32, 0xa0, 0x82, 0x74, 0x43, 0x45, 0xe4, 0x52, 0xd8, 0xbf, 0x30, 0xb7, 0xb7, 0xdd,
+		0xca, 0xcc, 0x00, 0x8f, 0x5a, 0xd0, 0xc1, 0xd0, 0x1e, 0xe7, 0x60, 0x60, 0x3e, 0x80, 0x87, 0xa7,
+		0x74, 0x30, 0x78, 0xb4, 0x23, 0x3d, 0x0c, 0xeb, 0x23, 0x1e, 0x86, 0x83, 0xe3, 0x04, 0x6e, 0x68,
+		0x41, 0xf2, 0x05, 0x6e, 0xac, 0x8b, 0x81, 0x3d, 0x6f, 0xcc, 0x1e, 0x36, 0x06, 0x39, 0x7b, 0x25,
+		0x91, 0xce, 0xca, 0xb9, 0xf2, 0x83, 0x68, 0x02, 0x0d, 0xe9, 0x3d, 0xdc, 0x70, 0xe8, 0xb6, 0x6d,
+		0xd9, 0x22, 0x04, 0x86, 0x26, 0xca, 0xa7, 0x20, 0x17, 0x54, 0x71, 0x7b, 0xb8, 0x23, 0x8a, 0x90,
+		0x0f, 0x69, 0xb5, 0xf2, 0xaf, 0x4a, 0x90, 0x0b, 0xea, 0xab, 0xd0, 0x66, 0x35, 0xc3, 0x37, 0xab,
+		0x01, 0x27, 0x45, 0x2c, 0xec, 0xa4, 0xc0, 0x47, 0x22, 0xfb, 0x23, 0xfe, 0x07, 0xad, 0xef, 0xf9,
+		0x1f, 0x4e, 0xc3, 0x2c, 0x5d, 0x6f, 0x99, 0x2b, 0x83, 0xaf, 0x0c, 0xec, 0xc8, 0xa7, 0x88, 0x05,
+		0x94, 0x19, 0xfc, 0x94, 0xf7, 0x61, 0x98, 0x0b, 0xc0, 0x7a, 0x1b, 0x41, 0xb6, 0x15, 0x97, 0x3d,
+		0xe8, 0x0a, 0xdf, 0x11, 0xfe, 0x86, 0x04, 0xb3, 0x23, 0xea, 0x32, 0xd2, 0xc7, 0x20, 0xbd, 0x55,
+		0x3e, 0x86, 0xd8, 0x9d, 0xfb, 0x18, 0x82, 0x5b, 0xdb, 0x78, 0x78, 0x6b, 0xfb, 0x15, 0x09, 0xf2,
+		0x21, 0xb5, 0x8d, 0x83, 0xd0, 0xb6, 0x3a, 0x22, 0x60, 0x8a, 0xfe, 0x46, 0x9b, 0xa6, 0x6b, 0x89,
+		0x77, 0x69, 0xf1, 0x27, 0x42, 0x79, 0x0b, 0x51, 0x86, 0x2f, 0x33, 0xde, 0x3e, 0x35, 0x19, 0x0c,
+		0x9d, 0xe4, 0xe1, 0x84, 0x29, 0x3f, 0x9c, 0xd0, 0x8b, 0x93, 0x9a, 0x09, 0xc4, 0x49, 0x91, 0x0b,
+		0x18, 0x64, 0x60, 0x59, 0x5b, 0xaa, 0xd5, 0x67, 0x6b, 0x7d, 0xc8, 0x36, 0x1a, 0x09, 0x25, 0x74,
+		0xe8, 0x81, 0x24, 0xfd, 0x15, 0xb0, 0x58, 0x32, 0x21, 0x8b, 0xe5, 0x2e, 0xc8, 0x60, 0xf3, 0xd9,
+		0x23, 0xf7, 0xc0, 0x63, 0x77, 0x45, 0x46, 0xf9, 0x67, 0x62, 0x50, 0x1c, 0x5a, 0x75, 0x22, 0x3b,
+		0x2f, 0xa4, 0x32, 0x16, 0x70, 0xa1, 0x4c, 0xc7, 0x90, 0x63, 0x00, 0xdb, 0x9a, 0xa3, 0xde, 0xd4,
+		0x4c, 0x97, 0x7f, 0x98, 0x2a, 0xae, 0x04, 0x72, 0xf0, 0x93, 0x4a, 0x98, 0x1a, 0x38, 0xfc, 0xd3,
+		0x54, 0x71, 0xc5, 0x4b, 0x93, 0x06, 0xa4, 0xf4, 0x1b, 0xf4, 0xd5, 0x18, 0xf6, 0x2e, 0xe1, 0xe1,
+		0x08, 0xf5, 0x84, 0xe5, 0xd5, 0x12, 0x0e, 0xf7, 0x1f, 0xbf, 0x76, 0x5c, 0x66, 0xe0, 0xef, 0xf0,
+		0x5e, 0x7b, 0x50, 0x38, 0x81, 0x30, 0x1b, 0xd2, 0x43, 0x6c, 0xa0, 0xae, 0xc5, 0x9c, 0xf0, 0x13,
+		0x20, 0x53, 0xd9, 0x19, 0xa8, 0x92, 0xef, 0xe9, 0xbd, 0xbe, 0x65, 0x75, 0x55, 0x36, 0xcf, 0x2b,
+		0x50, 0x08, 0x2f, 0xb2, 0xec, 0x43, 0x32, 0x2e, 0x7a, 0xdb, 0x42, 0x76, 0x74, 0x8e, 0x65, 0xb2,
+		0x79, 0x15, 0x7c, 0x13, 0xa8, 0xfc, 0x6e, 0x38, 0x18, 0xb9, 0xc6, 0x92, 0x27, 0x21, 0xe3, 0xaf,
+		0xcf, 0xec, 0x1c, 0x7b, 0x2f, 0x9f, 0x8d, 0x0f, 0x8c, 0x0f, 0x76, 0x45, 0x2e, 0xb2, 0xe4, 0x5d,
+		0x90, 0xb2, 0x75, 0x67, 0xd0, 0x75, 0xf9, 0xcb, 0x7b, 0xf7, 0x4d, 0x5e, 0x9d, 0xf1, 0xdd, 0x28,
+		0x8e, 0x54, 0x3e, 0x0b, 0x47, 0xc6, 0xae, 0xb2, 0xbe, 0xe7, 0x45, 0x0a, 0x78, 0x5e, 0xca, 0x3f,
+		0x2f, 0xc1, 0xc2, 0xf8, 0x95, 0x93, 0x54, 0x87, 0x1a, 0x74, 0x7a, 0xca, 0x75, 0x37, 0xd0, 0x2a,
+		0xdc, 0x9a, 0xd8, 0xfa, 0x96, 0xee, 0xb6, 0x77, 0xd8, 0x12, 0xce, 0x94, 0x42, 0x5e, 0xc9, 0xf3,
+		0x5c, 0x8a, 0xe3, 0x30, 0xb0, 0x97, 0xf4, 0xb6, 0xab, 0xb2, 0x41, 0x75, 0xf8, 0x27, 0x47, 0xf3,
+		0x2c, 0xb7, 0xc9, 0x32, 0xcb, 0x0f, 0xc1, 0xe1, 0x31, 0x6b, 0xf1, 0xe8, 0x1e, 0xa6, 0xfc, 0x22,
+		0x02, 0x47, 0x2e, 0xb0, 0xe4, 0x19, 0x48, 0x39, 0xae, 0xe6, 0x0e, 0x1c, 0xde, 0xb3, 0x07, 0x26,
+		0xae, 0xcd, 0x4d, 0x0a, 0xae, 0x70, 0xb4, 0xf2, 0x53, 0x40, 0x46, 0x57, 0xda, 0x88, 0x7d, 0x98,
+		0x14, 0xb5, 0x0f, 0xdb, 0x84, 0xa3, 0x7b, 0xac, 0xa9, 0x64, 0x79, 0xa8, 0x71, 0x0f, 0x4d, 0xb5,
+		0x24, 0x0f, 0x35, 0xf0, 0xcf, 0x62, 0x70, 0x30, 0x72, 0x69, 0x0d, 0xcc, 0x52, 0xe9, 0xcd, 0xce,
+		0xd2, 0x77, 0x01, 0xb8, 0xb7, 0x54, 0x36, 0xd2, 0x42, 0xdb, 0x47, 0xed, 0x27, 0x6e, 0xe9, 0xed,
+		0xd6, 0x2d, 0x2e, 0x18, 0x19, 0x97, 0xff, 0x42, 0x47, 0x41, 0x60, 0xef, 0x3b, 0xa0, 0x2b, 0x81,
+		0x53, 0x8a, 0xef, 0x6f, 0xcd, 0x90, 0x6f, 0x84, 0xb3, 0x1d, 0xf2, 0x22, 0x1c, 0x1e, 0x5a, 0xd1,
+		0x3c, 0xda, 0x89, 0xa9, 0x17, 0xb6, 0x83, 0xe1, 0x85, 0x4d, 0xd0, 0x0e, 0xae, 0x4a, 0xc9, 0xf0,
+		0xaa, 0xf4, 0x22, 0x80, 0xbf, 0x09, 0xc6, 0xf9, 0x66, 0x5b, 0x03, 0xb3, 0x23, 0x02, 0x7d, 0x69,
+		0x02, 0x4f, 0x39, 0x51, 0x12, 0x04, 0xab, 0x22, 0x14, 0x06, 0x0e, 0x69, 0x60, 0x17, 0xcd, 0xc0,
+		0xcb, 0x2f, 0x01, 0x19, 0xf5, 0x47, 0x8e, 0xa9, 0xe3, 0xe9, 0x70, 0x1d, 0xe5, 0xf1, 0xae, 0xcd,
+		0xe8, 0xba, 0xfe, 0x2f, 0x48, 0xd2, 0xe1, 0x8f, 0x8c, 0xb3, 0xff, 0x16, 0x00, 0xcd, 0x75, 0x6d,
+		0x63, 0x73, 0xe0, 0xd7, 0x70, 0x62, 0x8c, 0xfc, 0x54, 0x04, 0x60, 0xf5, 0x2e, 0x2e, 0x48, 0xf3,
+		0x3e, 0x6e, 0x40, 0x98, 0x02, 0x14, 0xcb, 0xab, 0x50, 0x08, 0xe3, 0x46, 0x5f, 0x1c, 0x18, 0x7d,
+		0x3b, 0xdf, 0x5f, 0xc8, 0xf9, 0xe5, 0x24, 0x9a, 0x28, 0xbf, 0x3f, 0x06, 0xb9, 0xa0, 0xf4, 0xfd,
+		0x1d, 0x5c, 0x2c, 0xcb, 0xdf, 0x29, 0x41, 0xda, 0xeb, 0x7f, 0xd8, 0xf5, 0x1f, 0x3a, 0x33, 0xf1,
+		0xaf, 0x90, 0x78, 0xfe, 0x7a, 0x76, 0x42, 0x12, 0xf7, 0x4e, 0x48, 0xde, 0xe9, 0x2d, 0x08, 0x63,
+		0x37, 0xf3, 0x41, 0x6e, 0x73, 0xc1, 0x12, 0x0b, 0xd4, 0x53, 0xd3, 0x05, 0x55, 0xe1, 0xf1, 0x4d,
+		0x20, 0x22, 0x8a, 0x25, 0xca, 0x4e, 0x20, 0x5a, 0x95, 0xcd, 0x46, 0x1f, 0x30, 0x16, 0x00, 0x1c,
+		0x8d, 0x83, 0x8a, 0x4f, 0x8e, 0x83, 0x4a, 0x0c, 0xc7, 0x41, 0x31, 0x1b, 0xa0, 0xfc, 0xff, 0x49,
+		0x90, 0x16, 0xb3, 0x84, 0x3c, 0x13, 0x8c, 0xac, 0x16, 0x61, 0x9a, 0xe3, 0xb5, 0x14, 0xef, 0xbc,
+		0x8f, 0x43, 0xaa, 0xe2, 0x84, 0xd2, 0xe8, 0xa8, 0x5b, 0x5d, 0x4d, 0xbc, 0x2a, 0x3b, 0x36, 0x2e,
+		0x9c, 0xc5, 0x15, 0xf1, 0x50, 0xd7, 0x46, 0x07, 0x13, 0xdc, 0x2a, 0xf9, 0x6b, 0x09, 0xe4, 0xe1,
+		0x59, 0xfc, 0xe6, 0xdb, 0x37, 0xba, 0x7a, 0xc5, 0x23, 0x56, 0x2f, 0xfc, 0x00, 0x84, 0x07, 0xa1,
+		0x3a, 0xc6, 0xb6, 0xc9, 0xc2, 0xae, 0x99, 0x3b, 0x8e, 0x78, 0x45, 0x4d, 0x51, 0x32, 0xda, 0xef,
+		0xe4, 0x9d, 0xf6, 0xfb, 0x3b, 0x62, 0x90, 0x0d, 0x78, 0x07, 0x31, 0xdc, 0x3c, 0xf0, 0x01, 0x9b,
+		0x93, 0x7b, 0xba, 0x12, 0x03, 0xdf, 0x0c, 0x0d, 0x71, 0x2a, 0x76, 0x07, 0x9c, 0x1a, 0xe7, 0x87,
+		0x15, 0xee, 0xc6, 0xc4, 0xbe, 0xdd, 0x8d, 0xd1, 0xf1, 0x89, 0xc9, 0x31, 0xf1, 0x89, 0xdf, 0x26,
+		0x41, 0xda, 0x73, 0xc5, 0xec, 0xf7, 0x34, 0xef, 0x10, 0xa4, 0xb8, 0x25, 0xc6, 0x8e, 0xf3, 0x78,
+		0x2a, 0xd2, 0xaf, 0xba, 0x00, 0x69, 0xf1, 0xed, 0x2d, 0xbe, 0xde, 0x79, 0xe9, 0xd3, 0x9b, 0x90,
+		0x0d, 0x1c, 0x88, 0x92, 0x23, 0x70, 0x70, 0xf9, 0x72, 0x7d, 0xf9, 0x59, 0xb5, 0xf5, 0xfc, 0xf0,
+		0xc3, 0xb5, 0x23, 0x45, 0x4a, 0x9d, 0xa6, 0xd9, 0xfb, 0x81, 0xe1, 0x22, 0x56, 0x10, 0x5b, 0x48,
+		0x60, 0x28, 0xdd, 0xe9, 0xbf, 0x96, 0x60, 0x2e, 0xc2, 0xe6, 0x25, 0x27, 0xe1, 0xee, 0xb5, 0x8b,
+		0x17, 0xeb, 0x8a, 0xda, 0x5c, 0xad, 0xac, 0x37, 0x2f, 0xaf, 0xb5, 0x54, 0xa5, 0xde, 0xdc, 0x58,
+		0x09, 0x3e, 0xfc, 0x7a, 0x02, 0xee, 0x8a, 0x06, 0xa9, 0x2c, 0x2f, 0xd7, 0xd7, 0x5b, 0xec, 0x93,
+		0x1a, 0x63, 0x20, 0xaa, 0xf4, 0x61, 0xd8, 0xf1, 0x24, 0x94, 0xfa, 0x95, 0xfa, 0x72, 0x4b, 0x8e,
+		0x93, 0x07, 0xe0, 0x9e, 0xbd, 0x20, 0xf8, 0x73, 0xb4, 0x72, 0x62, 0x22, 0x60, 0xb3, 0xbe, 0x5a,
+		0xab, 0x2b, 0x72, 0x92, 0xf7, 0xfb, 0x13, 0x31, 0x28, 0x8d, 0x33, 0xad, 0x91, 0x56, 0x65, 0x7d,
+		0x7d, 0xe5, 0x05, 0x9f, 0xd6, 0xf2, 0xe5, 0x8d, 0xd5, 0x67, 0x47, 0x59, 0x70, 0x3f, 0x94, 0xf7,
+		0x02, 0xf4, 0x18, 0x71, 0x1f, 0x9c, 0xdc, 0x13, 0x8e, 0xb3, 0x63, 0x02, 0x98, 0x52, 0xc7, 0xef,
+		0x81, 0xc4, 0xc9, 0x22, 0x9c, 0x9e, 0x08, 0xe6, 0x95, 0xc9, 0x09, 0x72, 0x06, 0x1e, 0xda, 0x1b,
+		0x9e, 0x31, 0x48, 0x20, 0x08, 0x16, 0xbd, 0x22, 0xc1, 0xc1, 0x48, 0x1b, 0x1d, 0x1f, 0x3e, 0x5e,
+		0x57, 0xd6, 0x96, 0xeb, 0xcd, 0x26, 0xbe, 0x73, 0xb9, 0xbe, 0xd6, 0xac, 0xac, 0xa8, 0xcd, 0x56,
+		0xa5, 0xb5, 0xd1, 0x0c, 0xf0, 0xa6, 0x0c, 0xc7, 0xc6, 0x01, 0x79, 0x7c, 0xd9, 0x03, 0x86, 0x4b,
+		0x80, 0x90, 0xd3, 0xdb, 0x12, 0x1c, 0x19, 0x6b, 0x93, 0x93, 0x53, 0x70, 0x2f, 0x7b, 0x1b, 0x59,
+		0xbd, 0xb6, 0xd6, 0x0a, 0x7e, 0xbb, 0x65, 0xa4, 0x55, 0x0f, 0xc0, 0x3d, 0x7b, 0x42, 0x7a, 0x4d,
+		0x9b, 0x04, 0x38, 0xd4, 0xbe, 0x0f, 0x48, 0x50, 0x1c, 0xd2, 0x85, 0xf4, 0x09, 0xe6, 0x46, 0xb3,
+		0x5a, 0xbf, 0x5c, 0xb9, 0xd6, 0x58, 0x53, 0x86, 0xe7, 0xec, 0x3d, 0x70, 0x7c, 0xa4, 0xb4, 0xb6,
+		0x81, 0x6f, 0x58, 0x57, 0x5a, 0x75, 0x5a, 0xa9, 0x2c, 0x61, 0xc7, 0x46, 0x80, 0x56, 0x1a, 0x97,
+		0x2e, 0xb7, 0xd4, 0xe5, 0x95, 0x46, 0x7d, 0xb5, 0xa5, 0x56, 0x5a, 0xad, 0x8a, 0x3f, 0x9d, 0xab,
+		0xcf, 0x8e, 0x8d, 0x8a, 0x3d, 0x3b, 0x7d, 0x54, 0x2c, 0x8f, 0xee, 0xf4, 0xef, 0x09, 0xc6, 0xe0,
+		0xb8, 0x57, 0xc8, 0x3d, 0x6b, 0xc3, 0xd1, 0x9f, 0x73, 0x02, 0x60, 0x91, 0x03, 0x8c, 0x8f, 0xf7,
+		0x7f, 0x17, 0xc4, 0x2b, 0xfd, 0x3e, 0x6a, 0x3e, 0x9a, 0x6e, 0x5b, 0x5d, 0xae, 0x57, 0xbd, 0x34,
+		0x96, 0x39, 0xd6, 0x96, 0x7b, 0x53, 0xb3, 0xbd, 0xcf, 0x4a, 0x8b, 0x74, 0xf9, 0x02, 0x64, 0xbc,
+		0xbd, 0x04, 0x7d, 0x62, 0xd6, 0xbb, 0x01, 0x96, 0x10, 0x37, 0xbc, 0xf8, 0x35, 0x99, 0x98, 0x7f,
+		0x4d, 0x26, 0x81, 0xc1, 0xff, 0xd5, 0xd5, 0xb1, 0xdc, 0x79, 0x6c, 0x7a, 0xee, 0xf8, 0x0c, 0xf0,
+		0x18, 0xf4, 0xfd, 0x77, 0x07, 0x6e, 0x43, 0x78, 0xb1, 0xaa, 0x41, 0xf6, 0x44, 0x84, 0xfb, 0x4f,
+		0x8a, 0x8e, 0x9d, 0x22, 0xfa, 0x75, 0xd2, 0xa8, 0xdc, 0x69, 0x78, 0xec, 0x05, 0xc8, 0xe3, 0x47,
+		0x0e, 0x9a, 0xba, 0x7b, 0x59, 0xd7, 0x3a, 0xba, 0x1d, 0xbe, 0x15, 0x9d, 0x17, 0xb7, 0xa2, 0xc5,
+		0x7a, 0x16, 0xf3, 0xd7, 0xb3, 0xb2, 0x01, 0x09, 0x44, 0x1d, 0x1f, 0x9e, 0xc2, 0x4c, 0x46, 0x86,
+		0xc2, 0x12, 0xe4, 0x71, 0x71, 0xef, 0x39, 0x3e, 0xe1, 0xde, 0xb3, 0xd8, 0x47, 0x51, 0xe8, 0x72,
+		0x0f, 0x66, 0xb8, 0x35, 0x13, 0x79, 0xee, 0xbb, 0x0a, 0xc5, 0xbe, 0x66, 0xbb, 0xf4, 0x73, 0x8b,
+		0x3b, 0xb4, 0x1b, 0xdc, 0x12, 0x89, 0xba, 0xb8, 0x16, 0xea, 0x2e, 0xaf, 0x26, 0xdf, 0x0f, 0x66,
+		0x96, 0xdf, 0x48, 0x40, 0x8a, 0xb3, 0xe3, 0xe9, 0x70, 0x8c, 0x5c, 0xc8, 0x4c, 0xf7, 0xc5, 0xdf,
+		0xdf, 0xf2, 0x72, 0x82, 0x02, 0x89, 0xdc, 0x3f, 0x1c, 0x91, 0x56, 0xcd, 0x7e, 0xe1, 0xb5, 0xe3,
+		0x33, 0xd4, 0x6f, 0xdc, 0xa8, 0xf9, 0xe1, 0x69, 0x6f, 0xbd, 0x15, 0x54, 0x83, 0x7c, 0xc0, 0xa3,
+		0x6d, 0x74, 0x78, 0xc8, 0xc0, 0xc2, 0x78, 0x4b, 0x51, 0x1c, 0x10, 0x7b, 0xde, 0xee, 0x46, 0x07,
+		0xbf, 0x41, 0x1a, 0x38, 0xb3, 0x66, 0x9b, 0x75, 0xe6, 0xca, 0x2d, 0x74, 0xbd, 0xd3, 0x68, 0x7a,
+		0x64, 0x7b, 0x14, 0x32, 0xf4, 0xb3, 0xa2, 0x81, 0x93, 0xdd, 0x34, 0x66, 0xd0, 0xc2, 0x07, 0xa0,
+		0x38, 0x7c, 0xf8, 0xcb, 0x8e, 0x73, 0x0b, 0x37, 0xc2, 0x07, 0xbf, 0xe3, 0x8e, 0x8a, 0x33, 0x63,
+		0x8f, 0x8a, 0xef, 0x83, 0x82, 0xef, 0xa2, 0xa0, 0xb0, 0xc0, 0x2c, 0x6d, 0x2f, 0x97, 0x82, 0x05,
+		0xbd, 0x0d, 0xd9, 0x90, 0xb7, 0xc1, 0x3b, 0x27, 0xe0, 0xbe, 0x17, 0x06, 0x93, 0x63, 0xa7, 0xcd,
+		0x58, 0xc0, 0x5d, 0x2c, 0x14, 0xf6, 0x1e, 0xc8, 0x8b, 0xeb, 0xa1, 0x0c, 0x2e, 0x4f, 0xe1, 0x72,
+		0x22, 0x73, 0xec, 0xe9, 0x75, 0x21, 0x3a, 0xca, 0xa1, 0x04, 0x89, 0x1a, 0xdf, 0x23, 0x0f, 0x79,
+		0xdc, 0x3e, 0x1b, 0x87, 0x04, 0x3d, 0x64, 0x7a, 0x2c, 0x64, 0x98, 0x47, 0x89, 0x34, 0x6e, 0x0f,
+		0xf4, 0xce, 0x55, 0x67, 0x3b, 0x60, 0x97, 0x8f, 0x0b, 0x4e, 0xf1, 0x1c, 0x1d, 0xf1, 0xa0, 0xa3,
+		0xe3, 0x22, 0xa4, 0x3d, 0x39, 0x49, 0x4c, 0x94, 0x93, 0x22, 0xca, 0x09, 0x8a, 0x31, 0xcf, 0x50,
+		0x66, 0xf8, 0xf6, 0x82, 0x54, 0x21, 0xe3, 0x69, 0x98, 0x52, 0x72, 0x1f, 0x32, 0xeb, 0xa3, 0x45,
+		0x47, 0x71, 0xa4, 0xc6, 0x44, 0x71, 0x04, 0x05, 0x4b, 0x65, 0x6a, 0x68, 0x86, 0x76, 0xcc, 0x17,
+		0xac, 0x06, 0xe6, 0xe2, 0xbe, 0xde, 0xdf, 0x5f, 0x31, 0xd9, 0xf3, 0x33, 0xb0, 0xd4, 0xdf, 0xa9,
+		0x31, 0x59, 0xcb, 0xe8, 0x93, 0x76, 0x69, 0x30, 0x6e, 0x97, 0x56, 0xfe, 0xa7, 0x12, 0xa4, 0x78,
+		0xa0, 0xc6, 0x1e, 0x4e, 0x02, 0x36, 0x0e, 0xb1, 0x71, 0xe3, 0x10, 0x7f, 0x53, 0xe3, 0x00, 0x5e,
+		0x3b, 0x45, 0x78, 0xea, 0x5d, 0x91, 0xae, 0x3a, 0x6c, 0x64, 0xd3, 0xd8, 0x16, 0xa7, 0x46, 0x3e,
+		0x56, 0xf9, 0x35, 0x09, 0x32, 0x5e, 0xf9, 0xe8, 0xc6, 0x53, 0xda, 0xf7, 0xc6, 0x73, 0x7f, 0xf1,
+		0x39, 0x21, 0x51, 0x8a, 0xdf, 0x99, 0x28, 0x85, 0x06, 0x3d, 0x31, 0x34, 0xe8, 0xe5, 0x2f, 0xe2,
+		0x05, 0xe3, 0x90, 0x2b, 0xf0, 0x7f, 0xd1, 0x68, 0x7d, 0x23, 0x97, 0x2f, 0x8c, 0x10, 0x1a, 0x19,
+		0xb6, 0x7b, 0x23, 0x48, 0x86, 0x5b, 0xed, 0x0f, 0x1f, 0x11, 0x64, 0x9a, 0xfe, 0x30, 0x7e, 0x26,
+		0x06, 0xb3, 0x23, 0xf0, 0x7f, 0x0b, 0x87, 0x33, 0x3c, 0x87, 0x93, 0x53, 0xce, 0xe1, 0xd4, 0xd8,
+		0x39, 0xfc, 0x99, 0x18, 0xa4, 0xc5, 0x66, 0xe8, 0x6f, 0x44, 0x07, 0x1f, 0x85, 0x4c, 0xdf, 0xea,
+		0xaa, 0xac, 0x84, 0x7d, 0x33, 0x21, 0xdd, 0xb7, 0xba, 0xca, 0x88, 0xa8, 0x25, 0xdf, 0x2a, 0x05,
+		0x9d, 0x7a, 0x0b, 0x86, 0x61, 0x66, 0x78, 0x56, 0xb9, 0x90, 0x63, 0xbc, 0xe0, 0x16, 0xd4, 0x59,
+		0x64, 0x02, 0xfe, 0xf2, 0x6e, 0x8e, 0x8f, 0xb6, 0x9b, 0x81, 0x2a, 0xa9, 0x1d, 0x0f, 0x25, 0x14,
+		0x23, 0x77, 0x64, 0xac, 0xe6, 0x12, 0x71, 0x3e, 0xe5, 0x57, 0x25, 0x80, 0x15, 0x64, 0x2e, 0xed,
+		0x31, 0x1a, 0x3f, 0x0e, 0x6d, 0x84, 0x1a, 0xaa, 0xfb, 0xf8, 0xd8, 0x81, 0xe3, 0x2d, 0xc8, 0x39,
+		0xc1, 0xa6, 0xd7, 0x20, 0xef, 0x0b, 0xb8, 0xa3, 0x8b, 0xe6, 0x1c, 0xdf, 0xeb, 0x9e, 0x2c, 0x7e,
+		0x17, 0x25, 0x77, 0x23, 0x90, 0x2a, 0xff, 0x73, 0x09, 0x32, 0xb4, 0x55, 0xf8, 0x71, 0xf5, 0xd0,
+		0x40, 0x4a, 0x6f, 0x62, 0x20, 0xef, 0x06, 0x60, 0x74, 0xf0, 0xe1, 0x34, 0x2e, 0x5f, 0x19, 0x9a,
+		0x83, 0xcf, 0xa1, 0x91, 0x27, 0x3c, 0xae, 0xc7, 0x27, 0x70, 0x5d, 0x38, 0x96, 0x39, 0xef, 0x0f,
+		0xc3, 0x0c, 0xfd, 0x4c, 0xd8, 0x2d, 0x87, 0x87, 0x4a, 0xa4, 0xf0, 0x4b, 0x4a, 0xb7, 0x9c, 0xf2,
+		0x75, 0x98, 0x69, 0xdd, 0x62, 0x2f, 0x27, 0x1d, 0x85, 0x8c, 0x6d, 0x59, 0xdc, 0x1a, 0x64, 0x86,
+		0x78, 0x1a, 0x33, 0xa8, 0xf1, 0x13, 0x75, 0x02, 0x70, 0x66, 0x5a, 0xb3, 0x9f, 0x1b, 0xfc, 0x78,
+		0x99, 0x35, 0x1f, 0x9a, 0x51, 0xe4, 0x1d, 0x70, 0xb8, 0xd9, 0xb8, 0xb4, 0x5a, 0xaf, 0xa9, 0x57,
+		0x9b, 0x97, 0x86, 0x36, 0xd8, 0x0b, 0xc5, 0x57, 0x6e, 0x9f, 0xc8, 0xf2, 0x5b, 0xac, 0xe3, 0xa0,
+		0xd7, 0x95, 0x3a, 0xdb, 0x69, 0x33, 0xe8, 0x75, 0x5b, 0x47, 0x57, 0x2c, 0x85, 0x7e, 0x04, 0x8e,
+		0x44, 0x40, 0x7b, 0x77, 0x59, 0x67, 0x5f, 0xb9, 0x7d, 0x22, 0xbf, 0x6e, 0xeb, 0x4c, 0xd4, 0x28,
+		0xc6, 0x22, 0x94, 0x46, 0x31, 0x98, 0x57, 0x43, 0x3e, 0xb1, 0x20, 0xbf, 0x72, 0xfb, 0x44, 0x4e,
+		0xe8, 0x0e, 0x84, 0xff, 0xba, 0x5f, 0x66, 0xfd, 0x68, 0x06, 0x8e, 0xb0, 0x07, 0xb4, 0x54, 0xb6,
+		0x07, 0x64, 0x09, 0xbe, 0x25, 0xcd, 0x05, 0x8b, 0x26, 0x7f, 0x19, 0xa1, 0xfc, 0x2c, 0xcc, 0x35,
+		0x4c, 0x57, 0xb7, 0xf1, 0xdd, 0x53, 0xff, 0x8b, 0x1f, 0x38, 0xb2, 0xf4, 0x53, 0x83, 0x92, 0xff,
+		0xc5, 0x0c, 0x72, 0x22, 0xf4, 0xc4, 0x27, 0xdf, 0xc1, 0x07, 0xb3, 0xca, 0xef, 0x97, 0x40, 0x6e,
+		0xb6, 0xb5, 0xae, 0x66, 0xbf, 0x59, 0x52, 0xe4, 0x09, 0xf1, 0x45, 0x0c, 0x7e, 0xb5, 0x24, 0x4e,
+		0xbf, 0x95, 0x14, 0xec, 0xdc, 0x22, 0xab, 0x89, 0xea, 0x60, 0xf6, 0x25, 0x0c, 0xfc, 0x79, 0xfa,
+		0x79, 0x00, 0xbf, 0x00, 0xbf, 0xbd, 0xd5, 0x5c, 0xae, 0xac, 0x54, 0x3c, 0x3f, 0x4d, 0x73, 0xbd,
+		0xbe, 0xdc, 0xb8, 0xd8, 0xa8, 0xd7, 0xe4, 0x03, 0xf8, 0x61, 0xab, 0x60, 0xa1, 0xf7, 0x75, 0xe3,
+		0x83, 0x30, 0x1b, 0xcc, 0xaf, 0xbe, 0xd0, 0xaa, 0x37, 0xe5, 0xd8, 0xd2, 0x65, 0x7c, 0x1b, 0x18,
+		0xbf, 0x67, 0x89, 0x0b, 0xa0, 0xde, 0x51, 0x0d, 0x93, 0x1c, 0xdb, 0xfb, 0x8b, 0x97, 0xa5, 0xdf,
+		0xfa, 0xf7, 0xec, 0xfb, 0x18, 0x79, 0x86, 0x58, 0x41, 0xbc, 0x86, 0x89, 0xaf, 0x4c, 0xd2, 0xcb,
+		0xe2, 0xf4, 0x5b, 0x40, 0xaa, 0x21, 0xf8, 0x3f, 0xf9, 0x01, 0x43, 0xa4, 0x87, 0xc7, 0xfb, 0x73,
+		0x3e, 0xba, 0x37, 0x7a, 0x4b, 0xcf, 0xfa, 0x5f, 0x34, 0xf1, 0x1a, 0x38, 0x91, 0xe2, 0xe7, 0x78,
+		0x0b, 0xc5, 0xfb, 0xc5, 0xa2, 0x89, 0x2b, 0x30, 0xab, 0xb5, 0xdb, 0x7a, 0x3f, 0xd4, 0xbe, 0x09,
+		0x6f, 0xc6, 0x89, 0xde, 0xca, 0x1c, 0xd3, 0x6f, 0xda, 0x13, 0x90, 0x72, 0xe8, 0xa0, 0x4c, 0x22,
+		0x21, 0x9a, 0xc3, 0xc1, 0xf1, 0xc1, 0x6b, 0x26, 0x06, 0x5e, 0x8f, 0x26, 0x10, 0xf8, 0x57, 0x9c,
+		0x40, 0x8e, 0xa2, 0x89, 0xde, 0x98, 0x80, 0x71, 0xd5, 0x5d, 0x8c, 0x5a, 0xf4, 0x7b, 0xb3, 0xf7,
+		0xbb, 0xc9, 0xff, 0xf0, 0x97, 0x1f, 0xf1, 0x4e, 0xd4, 0x03, 0x42, 0x17, 0x31, 0x59, 0x14, 0x99,
+		0xd3, 0xf6, 0xfb, 0xab, 0x43, 0x41, 0xd4, 0xc7, 0xfb, 0xbd, 0x77, 0x65, 0xff, 0x88, 0x57, 0x76,
+		0x2c, 0x4a, 0xc2, 0x03, 0x35, 0xe5, 0x39, 0x55, 0x56, 0xb0, 0x54, 0x85, 0xfc, 0x96, 0xd1, 0x0d,
+		0x0c, 0xf7, 0xde, 0xb5, 0xfc, 0xe3, 0x5f, 0x7e, 0x84, 0x4d, 0x34, 0x44, 0xe2, 0xac, 0xb9, 0xa3,
+		0xef, 0xb5, 0x50, 0xea, 0x4f, 0x05, 0x9b, 0xea, 0x69, 0xa7, 0x4f, 0xc6, 0xe1, 0x18, 0x2b, 0x38,
+		0xb3, 0xa9, 0x39, 0x3a, 0x2a, 0x2e, 0xdd, 0xd5, 0xce, 0x9e, 0x69, 0x5b, 0x86, 0xe9, 0x3b, 0x15,
+		0xb1, 0x7c, 0x11, 0xcb, 0x17, 0x79, 0xf9, 0x18, 0x9f, 0xd6, 0x78, 0x45, 0xb7, 0x30, 0xfa, 0xcd,
+		0xa0, 0x72, 0x17, 0x12, 0xcb, 0x96, 0x41, 0xbf, 0x12, 0xdf, 0xd1, 0x4d, 0xab, 0x27, 0xa2, 0x17,
+		0x69, 0x82, 0x5c, 0x86, 0x94, 0xd6, 0xb3, 0x06, 0x26, 0x7f, 0x39, 0xaf, 0xfa, 0x08, 0xae, 0x85,
+		0x7f, 0xf0, 0xda, 0xf1, 0x83, 0x8c, 0xac, 0xd3, 0xb9, 0xbe, 0x68, 0x58, 0x67, 0x7a, 0x9a, 0xbb,
+		0x83, 0x83, 0xfc, 0xbb, 0x9f, 0x7e, 0x18, 0x78, 0x7d, 0x0d, 0xd3, 0xfd, 0xd4, 0xeb, 0xbf, 0x70,
+		0x5a, 0x52, 0x38, 0x3e, 0x73, 0x3b, 0x96, 0xfb, 0x30, 0x53, 0xd3, 0xdb, 0x7b, 0x54, 0xd8, 0x18,
+		0xaa, 0xf0, 0x2c, 0xaf, 0xf0, 0xe8, 0x68, 0x85, 0xec, 0xeb, 0xd3, 0x35, 0xbd, 0x1d, 0xa8, 0xb6,
+		0xa6, 0xb7, 0xc3, 0x35, 0x56, 0x6b, 0xbf, 0xff, 0x9f, 0x8f, 0x1d, 0x78, 0xdf, 0x17, 0x8e, 0x1d,
+		0x18, 0x3b, 0x64, 0xe5, 0xc9, 0x9f, 0xd8, 0xf1, 0x46, 0xea, 0x7f, 0x48, 0x70, 0x84, 0x49, 0x8b,
+		0xbf, 0x3c, 0x68, 0xe6, 0xee, 0xb8, 0xe7, 0x10, 0xce, 0x43, 0xbc, 0x62, 0xee, 0xa2, 0x6f, 0x05,
+		0xc9, 0xa8, 0x03, 0xbb, 0x2b, 0x82, 0x3e, 0x31, 0xbd, 0x61, 0x77, 0xc3, 0x01, 0x06, 0xde, 0x9b,
+		0x68, 0xdf, 0xb3, 0xcf, 0x97, 0x10, 0xd2, 0x15, 0x73, 0x57, 0xbc, 0x81, 0xf0, 0x8e, 0x29, 0xdf,
+		0x40, 0xd0, 0xcc, 0xdd, 0xfe, 0xe6, 0x7e, 0x9f, 0x3e, 0xf8, 0x67, 0x4f, 0xc2, 0xbd, 0x9c, 0x47,
+		0x8e, 0xab, 0x5d, 0x37, 0xcc, 0x6d, 0x4f, 0x58, 0x79, 0x9a, 0xb3, 0xe2, 0x10, 0x1f, 0x10, 0x91,
+		0x2b, 0x44, 0x76, 0x54, 0x02, 0x17, 0xf6, 0x7c, 0x4b, 0x61, 0x61, 0x6f, 0x6f, 0xf2, 0xc2, 0x84,
+		0x79, 0xb3, 0xd7, 0x64, 0x18, 0x33, 0x7b, 0xc6, 0x0e, 0xef, 0xc4, 0x77, 0xdb, 0x26, 0x3a, 0x93,
+		0x5f, 0x95, 0xa0, 0x70, 0xd9, 0x70, 0x5c, 0xcb, 0x36, 0xda, 0x5a, 0x97, 0x1e, 0xa4, 0xbf, 0x73,
+		0x6a, 0xeb, 0xbf, 0x9a, 0xc1, 0xa9, 0xc0, 0x27, 0xd5, 0x8e, 0x30, 0xc0, 0x53, 0x37, 0xb4, 0x2e,
+		0xb3, 0xbc, 0x83, 0x7a, 0x77, 0x98, 0xed, 0x81, 0xf3, 0xe5, 0x20, 0x15, 0x86, 0x8b, 0xef, 0x23,
+		0x95, 0x7f, 0x00, 0x83, 0x3d, 0xd1, 0x7e, 0x73, 0x68, 0x78, 0x18, 0x0d, 0x40, 0xba, 0x02, 0x09,
+		0x5b, 0x73, 0xb9, 0x11, 0x52, 0x3d, 0xbf, 0xef, 0x99, 0xc8, 0x6a, 0xa1, 0x34, 0xc8, 0xbb, 0x01,
+		0x1f, 0x03, 0x53, 0x29, 0xbd, 0xd8, 0x9b, 0xa2, 0x87, 0x6f, 0xa2, 0x61, 0xfb, 0xc8, 0xb7, 0xd0,
+		0xcf, 0x4e, 0xe2, 0xa5, 0x0d, 0x73, 0x5b, 0x67, 0x94, 0xe3, 0x6f, 0x8a, 0x72, 0xbe, 0xa7, 0xdd,
+		0x5a, 0xa6, 0xd4, 0x90, 0x3e, 0xd7, 0x58, 0xbf, 0x2e, 0x01, 0xf8, 0x8c, 0x21, 0x1a, 0x86, 0x30,
+		0x8b, 0x14, 0xad, 0x54, 0x84, 0x30, 0x3f, 0x30, 0x8e, 0xf7, 0x43, 0x6c, 0xad, 0xe6, 0xb1, 0x79,
+		0x9f, 0x7f, 0xed, 0xb8, 0xc4, 0x6a, 0x2d, 0xb6, 0x47, 0xd8, 0x9e, 0x65, 0x31, 0x64, 0x2a, 0xf5,
+		0x6c, 0xc7, 0x26, 0x6e, 0x42, 0xf3, 0x62, 0x13, 0xca, 0x08, 0x02, 0xc3, 0xc6, 0x72, 0xde, 0x87,
+		0xbf, 0x94, 0x20, 0x5b, 0x0b, 0xd8, 0x89, 0x18, 0x83, 0x6e, 0x99, 0xc6, 0x75, 0xdd, 0xf6, 0x62,
+		0xd0, 0x59, 0x12, 0x4f, 0x9b, 0xd8, 0x27, 0xad, 0xdd, 0x5d, 0x71, 0xda, 0x24, 0xd2, 0x88, 0x75,
+		0x53, 0xdf, 0x74, 0x0c, 0xc1, 0x67, 0x45, 0x24, 0xd1, 0x9f, 0xeb, 0xe8, 0xed, 0x01, 0x86, 0xc5,
+		0xaa, 0x6d, 0xcb, 0x74, 0xf1, 0xcb, 0xf6, 0x2c, 0xcc, 0xa5, 0x28, 0xf2, 0x97, 0x59, 0x36, 0x12,
+		0xe9, 0xe8, 0xae, 0x66, 0x74, 0xd9, 0x35, 0xee, 0x8c, 0x22, 0x92, 0xe4, 0x52, 0xe0, 0xf8, 0x3f,
+		0xe5, 0x9d, 0x4e, 0x44, 0x72, 0xf4, 0x2a, 0x87, 0xa3, 0xc2, 0x4c, 0x7b, 0x7d, 0xc0, 0x8f, 0x15,
+		0xe0, 0x7d, 0xde, 0x82, 0xb4, 0x00, 0xc3, 0x3b, 0xe4, 0x7d, 0xdb, 0xa2, 0xab, 0x7e, 0xdf, 0x68,
+		0xab, 0x03, 0xdb, 0xe0, 0xfd, 0xce, 0xf3, 0xec, 0x75, 0xa3, 0xbd, 0x61, 0x1b, 0x18, 0x18, 0xe1,
+		0x58, 0x6d, 0x7a, 0x85, 0x5c, 0x33, 0x3b, 0x5d, 0x54, 0xd8, 0x06, 0x8b, 0x3c, 0xcb, 0x28, 0x32,
+		0x2b, 0xb9, 0x4c, 0x0b, 0x36, 0x6c, 0xc3, 0xe1, 0xf5, 0xdc, 0x9e, 0x09, 0x46, 0x1a, 0x2d, 0x83,
+		0x6c, 0xf5, 0x75, 0x3b, 0xe4, 0xf1, 0x61, 0xd3, 0xa7, 0xf4, 0xbb, 0x9f, 0x7e, 0x78, 0x9e, 0xf7,
+		0x87, 0xfb, 0x7c, 0xd8, 0xfb, 0x99, 0x4a, 0x51, 0x60, 0xf0, 0x6c, 0xf2, 0x42, 0x28, 0x4c, 0x7e,
+		0xb0, 0xe9, 0x3f, 0xf9, 0x34, 0x3f, 0x22, 0x05, 0x15, 0x73, 0xb7, 0x5a, 0xfa, 0x9c, 0x4f, 0x9a,
+		0xef, 0x17, 0xd7, 0x69, 0x74, 0x51, 0x30, 0x64, 0x9e, 0x92, 0x41, 0x8f, 0xcb, 0x4b, 0x9a, 0xd1,
+		0xd5, 0x3b, 0x3c, 0x74, 0x8d, 0xa7, 0xf0, 0x0a, 0x11, 0x0f, 0x03, 0x65, 0x1f, 0x7f, 0x2d, 0x8f,
+		0x63, 0x7d, 0xd5, 0x32, 0x3b, 0xe1, 0xe8, 0x4f, 0x0c, 0x21, 0x75, 0xad, 0xeb, 0xba, 0xc9, 0x47,
+		0xb4, 0xfa, 0xd0, 0x3e, 0x6c, 0x04, 0x85, 0xa3, 0x92, 0x6f, 0x02, 0xb9, 0xa3, 0x77, 0xf5, 0x6d,
+		0xe6, 0x4a, 0xd8, 0xd1, 0xd0, 0xbf, 0x97, 0xba, 0x53, 0x0b, 0xa0, 0xe8, 0x91, 0x6a, 0x52, 0x4a,
+		0x64, 0x3d, 0xbc, 0x75, 0x9a, 0xf1, 0x6e, 0x78, 0x45, 0xf6, 0x31, 0x30, 0x55, 0x82, 0xea, 0x32,
+		0xb4, 0xd5, 0x7a, 0x10, 0x1f, 0xfb, 0xdf, 0xb4, 0x4c, 0xfa, 0x25, 0x53, 0xee, 0xc4, 0x4a, 0xb3,
+		0xab, 0x13, 0x5e, 0x3e, 0xbf, 0x3a, 0xb1, 0x0e, 0x05, 0x1f, 0x94, 0x4e, 0xe9, 0xcc, 0x7e, 0xa7,
+		0x74, 0xde, 0x23, 0x80, 0x20, 0xf8, 0x91, 0x53, 0x5f, 0x69, 0x50, 0x37, 0x7b, 0xf6, 0x5c, 0x79,
+		0xb2, 0xfa, 0x09, 0x76, 0x26, 0x40, 0x80, 0x98, 0x30, 0xd7, 0x33, 0x4c, 0xd5, 0xd1, 0xbb, 0x5b,
+		0x2a, 0xe7, 0x1c, 0xd2, 0xcd, 0x52, 0xf6, 0x3f, 0xbd, 0x8f, 0xd1, 0xfc, 0x83, 0x4f, 0x3f, 0x5c,
+		0xf4, 0x4d, 0xa7, 0x13, 0x8f, 0x2c, 0x3e, 0xf6, 0x84, 0x32, 0xdb, 0x33, 0xcc, 0xa6, 0xde, 0xdd,
+		0xaa, 0x79, 0x84, 0xc9, 0x3b, 0xe1, 0xa8, 0xcf, 0x10, 0xcb, 0x54, 0x77, 0xac, 0x6e, 0x47, 0xb5,
+		0xf5, 0x2d, 0xb5, 0x4d, 0x0d, 0xbf, 0x1c, 0x65, 0xe3, 0x61, 0x0f, 0x64, 0xcd, 0xbc, 0x6c, 0x75,
+		0x3b, 0x8a, 0xbe, 0xb5, 0x8c, 0xc5, 0x78, 0xc2, 0xe4, 0x63, 0x1b, 0x1d, 0xbc, 0xb0, 0x16, 0xc7,
+		0xb7, 0x37, 0xbc, 0xcc, 0x46, 0xc7, 0x59, 0x4a, 0x7f, 0xf0, 0x13, 0xc7, 0x0f, 0xbc, 0xf1, 0x89,
+		0xe3, 0x07, 0xca, 0x17, 0xe9, 0xa3, 0x70, 0x7c, 0x6a, 0xe9, 0x0e, 0x39, 0x0f, 0x19, 0x4d, 0x24,
+		0xd8, 0x93, 0x93, 0x7b, 0x4c, 0x4d, 0x1f, 0x14, 0x5f, 0xdb, 0x4c, 0xd5, 0xae, 0xad, 0x6b, 0x86,
+		0x4d, 0xea, 0xb8, 0x31, 0x12, 0xb2, 0x3a, 0xed, 0x2c, 0xf7, 0xc5, 0x5b, 0x4c, 0xf3, 0xd5, 0x71,
+		0xee, 0xe1, 0x4c, 0xf5, 0xe4, 0xef, 0x7e, 0xfa, 0xe1, 0xbb, 0x39, 0x99, 0x6b, 0x43, 0x9e, 0x62,
+		0x41, 0x6f, 0xd8, 0x83, 0x1c, 0xe8, 0xf3, 0x15, 0x98, 0x61, 0x4d, 0x75, 0xc8, 0x33, 0x90, 0xec,
+		0xe3, 0x8f, 0x92, 0x14, 0xda, 0x2b, 0x8d, 0xca, 0x3c, 0x85, 0x0f, 0x4a, 0x08, 0xc3, 0x2b, 0x7f,
+		0x28, 0x06, 0x50, 0xbb, 0x76, 0xad, 0x65, 0x1b, 0xfd, 0xae, 0xee, 0xbe, 0x55, 0x7d, 0xdf, 0x80,
+		0x83, 0x7e, 0xdf, 0x1d, 0xbb, 0xbd, 0xff, 0xfe, 0xcf, 0xf9, 0x3e, 0x44, 0xbb, 0x1d, 0x49, 0xb6,
+		0xe3, 0xb8, 0x1e, 0xd9, 0xf8, 0xfe, 0xc9, 0xd6, 0x1c, 0x77, 0x94, 0xb3, 0xcf, 0x43, 0xd6, 0x67,
+		0x86, 0x43, 0x1a, 0x90, 0x76, 0xf9, 0x6f, 0xce, 0xe0, 0xf2, 0x78, 0x06, 0x0b, 0xb4, 0x20, 0x93,
+		0x3d, 0x74, 0xbc, 0x68, 0x04, 0x81, 0x39, 0xf2, 0xf6, 0x94, 0x31, 0xdc, 0x9e, 0x71, 0xe5, 0x1c,
+		0xbf, 0xe3, 0xed, 0x19, 0x23, 0x10, 0x60, 0xea, 0x87, 0x63, 0x30, 0xb7, 0x21, 0x66, 0xef, 0xdb,
+		0x9f, 0x07, 0x1b, 0x30, 0xa3, 0x9b, 0xae, 0x6d, 0x78, 0xf7, 0x07, 0x1e, 0x19, 0x37, 0xe6, 0x11,
+		0x9d, 0xaa, 0x9b, 0xae, 0xbd, 0x1b, 0x94, 0x00, 0x41, 0x2b, 0xc0, 0x8f, 0x8f, 0xc7, 0xa1, 0x34,
+		0x0e, 0x15, 0x0f, 0x74, 0xdb, 0xb6, 0x4e, 0x33, 0xc2, 0x2f, 0xa0, 0x16, 0x44, 0x36, 0x5f, 0x76,
+		0x14, 0x40, 0xcb, 0x12, 0x85, 0x0b, 0x41, 0xef, 0xcc, 0x94, 0x2c, 0xf8, 0x14, 0xe8, 0xc2, 0xd3,
+		0x82, 0xa2, 0x78, 0x64, 0x67, 0x53, 0xeb, 0x6a, 0xf8, 0x6c, 0x74, 0x7c, 0xff, 0x6b, 0xbe, 0x78,
+		0xa8, 0xa7, 0xca, 0x48, 0x90, 0x3a, 0xcc, 0x08, 0x6a, 0x89, 0xfd, 0x53, 0x13, 0xb8, 0xe4, 0x3e,
+		0xc8, 0x05, 0x17, 0x06, 0x6a, 0x8d, 0x24, 0xe8, 0x33, 0x98, 0xd9, 0xc0, 0xda, 0x30, 0x69, 0xf5,
+		0x49, 0xed, 0xb9, 0xfa, 0x70, 0xa3, 0xef, 0xe3, 0x71, 0x98, 0x55, 0xf4, 0xce, 0xdf, 0xfe, 0xa1,
+		0x59, 0x07, 0x60, 0xd3, 0x15, 0xb5, 0x69, 0x29, 0x71, 0xa7, 0x73, 0x3e, 0xc3, 0x88, 0xd4, 0x1c,
+		0x8c, 0xa6, 0x8d, 0x18, 0xa5, 0xb7, 0x7e, 0x84, 0xfe, 0x43, 0x0c, 0x72, 0xc1, 0x11, 0xfa, 0x3b,
+		0xb9, 0x70, 0x91, 0x55, 0x5f, 0x55, 0xb1, 0xc3, 0xf2, 0x07, 0xc7, 0xa9, 0xaa, 0x11, 0x69, 0x9e,
+		0xa0, 0xa3, 0x3e, 0x97, 0x84, 0x14, 0xbf, 0x86, 0xbb, 0x36, 0x62, 0xdf, 0x4e, 0x7c, 0x02, 0x3b,
+		0x2f, 0x5e, 0x11, 0x8f, 0x34, 0x6f, 0xef, 0x03, 0x7c, 0x5b, 0x5c, 0x0d, 0xdd, 0xed, 0xc5, 0xc8,
+		0x39, 0xdc, 0x9f, 0x7b, 0xbd, 0x77, 0xf0, 0x7e, 0x33, 0x82, 0xf9, 0xba, 0x18, 0x61, 0xf0, 0x59,
+		0xa6, 0x3a, 0xcb, 0x21, 0x67, 0x81, 0xec, 0x78, 0xde, 0x16, 0xd5, 0x67, 0x84, 0x74, 0x2a, 0x4f,
+		0xd5, 0xc2, 0xac, 0x5f, 0x2a, 0x50, 0xf0, 0xd4, 0xd0, 0x32, 0x3b, 0x2a, 0xf3, 0x4c, 0xf2, 0xef,
+		0x74, 0x63, 0x4e, 0x0d, 0x33, 0xc8, 0x07, 0x24, 0x66, 0x2a, 0x0f, 0xb9, 0x00, 0xf8, 0x4e, 0xa5,
+		0x35, 0xc5, 0xc4, 0xf8, 0xab, 0xd7, 0x8e, 0x2f, 0xec, 0x6a, 0xbd, 0xee, 0x52, 0x39, 0x82, 0x4e,
+		0x39, 0xca, 0x2b, 0x81, 0x06, 0x74, 0xd8, 0x85, 0x40, 0x1a, 0x20, 0xe3, 0x75, 0x12, 0x9b, 0x7f,
+		0xf4, 0x5f, 0xdd, 0xd2, 0xc5, 0x4b, 0xf4, 0x47, 0x16, 0x23, 0xfc, 0xc4, 0x8b, 0xe8, 0x6f, 0xe5,
+		0xc7, 0x98, 0x85, 0xeb, 0xfa, 0xae, 0xc2, 0xf1, 0x2e, 0xea, 0x3a, 0x79, 0x19, 0x8e, 0x6c, 0x77,
+		0xad, 0x4d, 0x7c, 0xa9, 0xca, 0x78, 0x79, 0x60, 0x74, 0x54, 0x2e, 0x18, 0x6a, 0x5b, 0xeb, 0x97,
+		0xd2, 0x6f, 0xca, 0x9d, 0x72, 0x88, 0x11, 0x5e, 0xa1, 0x74, 0x9b, 0x8c, 0xec, 0xb2, 0xd6, 0x27,
+		0x2f, 0x05, 0x65, 0x9d, 0x72, 0x7b, 0x4b, 0x6b, 0xbb, 0x96, 0x5d, 0xca, 0xbc, 0xa9, 0xea, 0xfc,
+		0x09, 0x80, 0x7b, 0xd4, 0x8b, 0x94, 0xe4, 0xd2, 0xbd, 0xa8, 0x0c, 0x5e, 0x79, 0xfd, 0x17, 0x4e,
+		0x1f, 0x0d, 0xb8, 0x74, 0x6f, 0x79, 0xbe, 0x4b, 0x26, 0xc1, 0x68, 0xdb, 0x13, 0x7f, 0x9d, 0x0d,
+		0x5c, 0x57, 0x87, 0xc0, 0x76, 0x48, 0xda, 0x7b, 0x9b, 0xe5, 0xe3, 0x87, 0xb6, 0x59, 0x01, 0x0d,
+		0xf4, 0xb4, 0xbf, 0xcc, 0xc5, 0x26, 0x0d, 0x56, 0x70, 0xf2, 0x71, 0x24, 0xaa, 0xd8, 0x0e, 0x94,
+		0xff, 0x8d, 0x04, 0x47, 0x46, 0x26, 0xab, 0xd7, 0xe4, 0x36, 0x10, 0x3b, 0x50, 0x48, 0x85, 0x5e,
+		0x3c, 0xa5, 0x7c, 0x67, 0x73, 0x7f, 0xd6, 0x1e, 0x2e, 0x7d, 0x8b, 0xd6, 0x6b, 0xae, 0xa8, 0x7f,
+		0x4b, 0x82, 0xf9, 0x60, 0x03, 0xbc, 0xae, 0x34, 0x21, 0x17, 0xac, 0x9a, 0x77, 0xe2, 0xde, 0x69,
+		0x3a, 0x11, 0x6c, 0x7f, 0x88, 0x08, 0xb9, 0xe6, 0x2b, 0x44, 0xe6, 0x31, 0x3d, 0x3b, 0x35, 0x53,
+		0x44, 0xc3, 0x22, 0x15, 0x23, 0x1b, 0x9b, 0x3f, 0x91, 0x20, 0xb1, 0x6e, 0x59, 0x5d, 0xf2, 0x32,
+		0xcc, 0x9a, 0x96, 0x4b, 0x85, 0x5b, 0xef, 0xa8, 0xdc, 0x3b, 0xc2, 0x16, 0x9b, 0xfa, 0x9e, 0xbc,
+		0xfa, 0xe3, 0xd7, 0x8e, 0x8f, 0x62, 0x46, 0x1d, 0xab, 0x14, 0x4d, 0xcb, 0xad, 0x52, 0xa0, 0x16,
+		0x85, 0x21, 0x5b, 0x90, 0x0f, 0x57, 0xc7, 0x16, 0xa4, 0xca, 0xa4, 0xea, 0xf2, 0x13, 0xab, 0xca,
+		0x6d, 0x06, 0xea, 0x61, 0x6f, 0xc7, 0xff, 0x05, 0x8e, 0xdc, 0xb7, 0x80, 0x7c, 0x6d, 0xf8, 0x3e,
+		0xec, 0x45, 0x98, 0x11, 0xf7, 0x5f, 0xa5, 0x69, 0xef, 0xd6, 0x06, 0xf9, 0xc9, 0x91, 0xa9, 0x4b,
+		0xfa, 0xf3, 0x31, 0x38, 0x82, 0x71, 0xc3, 0xdc, 0x97, 0xc5, 0x95, 0x16, 0xf3, 0x9f, 0xef, 0xa2,
+		0x03, 0x26, 0xd2, 0xd3, 0x96, 0x1b, 0xf5, 0xa7, 0x5d, 0x83, 0x22, 0x5a, 0x10, 0xe8, 0x0b, 0x7b,
+		0x73, 0xee, 0xb4, 0xbc, 0xd5, 0xed, 0xf0, 0x16, 0xa1, 0x33, 0xed, 0x1a, 0x14, 0x4d, 0xfd, 0x66,
+		0x88, 0x6e, 0xfc, 0xce, 0xe8, 0x9a, 0xfa, 0xcd, 0x00, 0x5d, 0x3f, 0x2c, 0x2a, 0x11, 0xba, 0x64,
+		0x75, 0x1e, 0xe2, 0xa8, 0xe9, 0x93, 0xfb, 0x50, 0x1e, 0x88, 0x10, 0x58, 0xb5, 0x9b, 0x70, 0x84,
+		0x3b, 0x43, 0x9c, 0xb5, 0x2d, 0xca, 0x51, 0x9d, 0x76, 0xe8, 0x59, 0x7d, 0x37, 0xc2, 0x33, 0x92,
+		0x9b, 0xce, 0x33, 0xf2, 0x7b, 0x12, 0xcc, 0x51, 0xe1, 0x30, 0xde, 0xab, 0x53, 0x7f, 0x9b, 0xa2,
+		0xb7, 0x2d, 0xbb, 0x83, 0x17, 0x3a, 0x79, 0x0c, 0x4f, 0x42, 0x89, 0x19, 0x1d, 0xb2, 0x08, 0x49,
+		0xeb, 0xa6, 0xc9, 0x63, 0xcf, 0xf7, 0xb2, 0xba, 0x18, 0x18, 0x79, 0x06, 0x0a, 0x3d, 0xab, 0x33,
+		0xc0, 0xa3, 0xda, 0x36, 0xb3, 0xfc, 0xe2, 0x13, 0x10, 0xf3, 0x0c, 0xbe, 0xc2, 0xc0, 0xc3, 0x57,
+		0xef, 0x12, 0xd3, 0x1a, 0x52, 0x3e, 0xce, 0xe9, 0x5f, 0x91, 0x00, 0x7c, 0x87, 0x27, 0x06, 0xcd,
+		0x54, 0xd7, 0x56, 0x6b, 0xfe, 0x4d, 0x9a, 0x40, 0x5c, 0x84, 0x08, 0xb1, 0x71, 0xfa, 0x7a, 0xdb,
+		0xd8, 0x32, 0xf4, 0x0e, 0xb9, 0x1f, 0xe6, 0xc3, 0xd0, 0x98, 0xaa, 0xd7, 0x64, 0x69, 0x21, 0xf7,
+		0xca, 0xed, 0x13, 0x69, 0xb6, 0xc1, 0xd3, 0x31, 0x82, 0xfc, 0xe0, 0x28, 0x1c, 0xc6, 0x54, 0xc4,
+		0x16, 0xf2, 0xaf, 0xdc, 0x3e, 0x91, 0xf1, 0x76, 0x82, 0xa4, 0x0c, 0x24, 0x08, 0xc9, 0xe9, 0xc5,
+		0x17, 0xe0, 0x95, 0xdb, 0x27, 0x52, 0x4c, 0x19, 0xf0, 0x2b, 0x38, 0xdf, 0x8c, 0x1f, 0x82, 0xda,
+		0xb2, 0xb5, 0x36, 0x55, 0x7a, 0x0b, 0x70, 0xa8, 0xb1, 0x7a, 0x51, 0xa9, 0x2c, 0xb7, 0xf0, 0xd2,
+		0x4e, 0xa8, 0xd9, 0x43, 0x65, 0xb5, 0xb5, 0x8d, 0xea, 0x4a, 0x5d, 0xc5, 0xd0, 0x1d, 0x76, 0x5f,
+		0x2e, 0x54, 0xf6, 0xdc, 0x6a, 0xab, 0x71, 0xb5, 0x2e, 0xc7, 0xaa, 0xe7, 0xc7, 0x9e, 0xa5, 0xde,
+		0x15, 0x52, 0x33, 0xfe, 0x42, 0x1b, 0x3a, 0x45, 0xfd, 0x9f, 0x03, 0x00, 0x1d, 0x7b, 0x6b, 0xd6,
+		0xc9, 0xb6, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2599,6 +2760,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -3543,6 +3710,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3910,6 +4097,55 @@ func (m *ValAddrsOfRotatedConsKeys) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -4291,6 +4527,10 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.KeyRotationFee.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -4406,6 +4646,30 @@ func (m *ValAddrsOfRotatedConsKeys) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7249,6 +7513,74 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -8112,6 +8444,171 @@ func (m *ValAddrsOfRotatedConsKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
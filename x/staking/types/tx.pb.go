@@ -716,6 +716,192 @@ func (m *MsgRotateConsPubKeyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRotateConsPubKeyResponse proto.InternalMessageInfo
 
+// MsgTokenizeShares defines a SDK message for tokenizing a delegation into a
+// share denom of the validator.
+type MsgTokenizeShares struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount is the amount of bonded tokens of the delegation to tokenize.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// tokenized_share_owner is the owner of the created tokenize share record.
+	TokenizedShareOwner string `protobuf:"bytes,4,opt,name=tokenized_share_owner,json=tokenizedShareOwner,proto3" json:"tokenized_share_owner,omitempty"`
+}
+
+func (m *MsgTokenizeShares) Reset()         { *m = MsgTokenizeShares{} }
+func (m *MsgTokenizeShares) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenizeShares) ProtoMessage()    {}
+func (*MsgTokenizeShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{16}
+}
+func (m *MsgTokenizeShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenizeShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenizeShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenizeShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeShares.Merge(m, src)
+}
+func (m *MsgTokenizeShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenizeShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenizeShares proto.InternalMessageInfo
+
+// MsgTokenizeSharesResponse defines the Msg/TokenizeShares response type.
+type MsgTokenizeSharesResponse struct {
+	// amount is the amount of share tokens minted to the delegator.
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// record_id is the id of the created tokenize share record.
+	RecordId uint64 `protobuf:"varint,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+}
+
+func (m *MsgTokenizeSharesResponse) Reset()         { *m = MsgTokenizeSharesResponse{} }
+func (m *MsgTokenizeSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenizeSharesResponse) ProtoMessage()    {}
+func (*MsgTokenizeSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{17}
+}
+func (m *MsgTokenizeSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenizeSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenizeSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenizeSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeSharesResponse.Merge(m, src)
+}
+func (m *MsgTokenizeSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenizeSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenizeSharesResponse proto.InternalMessageInfo
+
+func (m *MsgTokenizeSharesResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgTokenizeSharesResponse) GetRecordId() uint64 {
+	if m != nil {
+		return m.RecordId
+	}
+	return 0
+}
+
+// MsgRedeemTokensForShares defines a SDK message for redeeming share tokens
+// into a delegation to the validator they were tokenized from.
+type MsgRedeemTokensForShares struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// amount is the amount of share tokens to redeem.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgRedeemTokensForShares) Reset()         { *m = MsgRedeemTokensForShares{} }
+func (m *MsgRedeemTokensForShares) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemTokensForShares) ProtoMessage()    {}
+func (*MsgRedeemTokensForShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{18}
+}
+func (m *MsgRedeemTokensForShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemTokensForShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemTokensForShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemTokensForShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemTokensForShares.Merge(m, src)
+}
+func (m *MsgRedeemTokensForShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemTokensForShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemTokensForShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemTokensForShares proto.InternalMessageInfo
+
+// MsgRedeemTokensForSharesResponse defines the Msg/RedeemTokensForShares response type.
+type MsgRedeemTokensForSharesResponse struct {
+	// amount is the amount of bonded tokens delegated to the delegator.
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgRedeemTokensForSharesResponse) Reset()         { *m = MsgRedeemTokensForSharesResponse{} }
+func (m *MsgRedeemTokensForSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemTokensForSharesResponse) ProtoMessage()    {}
+func (*MsgRedeemTokensForSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{19}
+}
+func (m *MsgRedeemTokensForSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemTokensForSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemTokensForSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemTokensForSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemTokensForSharesResponse.Merge(m, src)
+}
+func (m *MsgRedeemTokensForSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemTokensForSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemTokensForSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemTokensForSharesResponse proto.InternalMessageInfo
+
+func (m *MsgRedeemTokensForSharesResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorResponse")
@@ -733,92 +919,108 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.staking.v1beta1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgRotateConsPubKey)(nil), "cosmos.staking.v1beta1.MsgRotateConsPubKey")
 	proto.RegisterType((*MsgRotateConsPubKeyResponse)(nil), "cosmos.staking.v1beta1.MsgRotateConsPubKeyResponse")
+	proto.RegisterType((*MsgTokenizeShares)(nil), "cosmos.staking.v1beta1.MsgTokenizeShares")
+	proto.RegisterType((*MsgTokenizeSharesResponse)(nil), "cosmos.staking.v1beta1.MsgTokenizeSharesResponse")
+	proto.RegisterType((*MsgRedeemTokensForShares)(nil), "cosmos.staking.v1beta1.MsgRedeemTokensForShares")
+	proto.RegisterType((*MsgRedeemTokensForSharesResponse)(nil), "cosmos.staking.v1beta1.MsgRedeemTokensForSharesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 1277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x41, 0x4f, 0x1b, 0x47,
-	0x14, 0xf6, 0xda, 0x09, 0x29, 0x43, 0xc1, 0xb0, 0x40, 0x62, 0x16, 0x6a, 0xd3, 0x0d, 0x15, 0x94,
-	0xca, 0x6b, 0x20, 0x01, 0x54, 0x27, 0xaa, 0x82, 0x21, 0x6d, 0xd3, 0x96, 0x16, 0x2d, 0x21, 0x95,
-	0xaa, 0xb6, 0xee, 0x78, 0x77, 0x58, 0x56, 0x78, 0x77, 0x9c, 0x9d, 0x31, 0x89, 0x0f, 0x95, 0xaa,
-	0x9e, 0x9a, 0x9e, 0x72, 0xaf, 0x2a, 0xa5, 0x52, 0x2b, 0xf5, 0xc8, 0x81, 0x63, 0x7f, 0x40, 0x94,
-	0x53, 0x44, 0x2f, 0x51, 0x0e, 0xb4, 0x82, 0x03, 0xfd, 0x0f, 0xb9, 0x54, 0xbb, 0x3b, 0x5e, 0x7b,
-	0xd7, 0xde, 0xc5, 0xd0, 0xe6, 0x92, 0x4b, 0x62, 0xde, 0x7c, 0xf3, 0xcd, 0xcc, 0xf7, 0xbe, 0x79,
-	0xf3, 0x16, 0x64, 0x14, 0x4c, 0x0c, 0x4c, 0x72, 0x84, 0xc2, 0x6d, 0xdd, 0xd4, 0x72, 0x3b, 0xb3,
-	0x25, 0x44, 0xe1, 0x6c, 0x8e, 0xde, 0x97, 0x2a, 0x16, 0xa6, 0x98, 0xbf, 0xe8, 0x02, 0x24, 0x06,
-	0x90, 0x18, 0x40, 0x18, 0xd1, 0x30, 0xd6, 0xca, 0x28, 0xe7, 0xa0, 0x4a, 0xd5, 0xcd, 0x1c, 0x34,
-	0x6b, 0xee, 0x14, 0x21, 0x13, 0x1c, 0xa2, 0xba, 0x81, 0x08, 0x85, 0x46, 0x85, 0x01, 0x86, 0x34,
-	0xac, 0x61, 0xe7, 0x67, 0xce, 0xfe, 0xc5, 0xa2, 0x23, 0xee, 0x4a, 0x45, 0x77, 0x80, 0x2d, 0xeb,
-	0x0e, 0xa5, 0xd9, 0x2e, 0x4b, 0x90, 0x20, 0x6f, 0x8b, 0x0a, 0xd6, 0x4d, 0x36, 0x3e, 0x11, 0x72,
-	0x8a, 0xfa, 0xa6, 0x5d, 0xd4, 0x25, 0x86, 0x32, 0x88, 0x8d, 0xb0, 0xff, 0x63, 0x03, 0x03, 0xd0,
-	0xd0, 0x4d, 0x9c, 0x73, 0xfe, 0x75, 0x43, 0xe2, 0x8b, 0x73, 0x80, 0x5f, 0x25, 0xda, 0xb2, 0x85,
-	0x20, 0x45, 0x77, 0x60, 0x59, 0x57, 0x21, 0xc5, 0x16, 0xbf, 0x06, 0x7a, 0x54, 0x44, 0x14, 0x4b,
-	0xaf, 0x50, 0x1d, 0x9b, 0x29, 0x6e, 0x9c, 0x9b, 0xea, 0x99, 0xbb, 0x2c, 0xb5, 0xd7, 0x48, 0x5a,
-	0x69, 0x40, 0x0b, 0xdd, 0x8f, 0x0f, 0x32, 0xb1, 0xdf, 0x8f, 0x77, 0xa7, 0x39, 0xb9, 0x99, 0x82,
-	0x97, 0x01, 0x50, 0xb0, 0x61, 0xe8, 0x84, 0xd8, 0x84, 0x71, 0x87, 0x70, 0x32, 0x8c, 0x70, 0xd9,
-	0x43, 0xca, 0x90, 0x22, 0xd2, 0x4c, 0xda, 0xc4, 0xc2, 0x7f, 0x03, 0x06, 0x0d, 0xdd, 0x2c, 0x12,
-	0x54, 0xde, 0x2c, 0xaa, 0xa8, 0x8c, 0x34, 0xe8, 0xec, 0x36, 0x31, 0xce, 0x4d, 0x75, 0x17, 0x66,
-	0xec, 0x39, 0xcf, 0x0f, 0x32, 0xc3, 0xee, 0x1a, 0x44, 0xdd, 0x96, 0x74, 0x9c, 0x33, 0x20, 0xdd,
-	0x92, 0x6e, 0x99, 0x74, 0x7f, 0x2f, 0x0b, 0xd8, 0xe2, 0xb7, 0x4c, 0xea, 0x52, 0x0f, 0x18, 0xba,
-	0xb9, 0x8e, 0xca, 0x9b, 0x2b, 0x1e, 0x15, 0xff, 0x01, 0x18, 0x60, 0xc4, 0xd8, 0x2a, 0x42, 0x55,
-	0xb5, 0x10, 0x21, 0xa9, 0x73, 0x0e, 0xbf, 0xb0, 0xbf, 0x97, 0x1d, 0x62, 0x14, 0x4b, 0xee, 0xc8,
-	0x3a, 0xb5, 0x74, 0x53, 0x4b, 0x71, 0x72, 0xbf, 0x37, 0x89, 0x8d, 0xf0, 0x9f, 0x82, 0x81, 0x9d,
-	0xba, 0xba, 0x1e, 0xd1, 0x79, 0x87, 0xe8, 0xcd, 0xfd, 0xbd, 0xec, 0x1b, 0x8c, 0xc8, 0xcb, 0x80,
-	0x8f, 0x51, 0xee, 0xdf, 0x09, 0xc4, 0xf9, 0xf7, 0x41, 0x57, 0xa5, 0x5a, 0xda, 0x46, 0xb5, 0x54,
-	0x97, 0x23, 0xe5, 0x90, 0xe4, 0x9a, 0x51, 0xaa, 0x9b, 0x51, 0x5a, 0x32, 0x6b, 0x85, 0xd4, 0x93,
-	0xc6, 0x1e, 0x15, 0xab, 0x56, 0xa1, 0x58, 0x5a, 0xab, 0x96, 0x3e, 0x46, 0x35, 0x99, 0xcd, 0xe6,
-	0xf3, 0xe0, 0xfc, 0x0e, 0x2c, 0x57, 0x51, 0xea, 0x82, 0x43, 0x33, 0x52, 0xcf, 0x88, 0xed, 0xc0,
-	0xa6, 0x74, 0xe8, 0xbe, 0xc4, 0xba, 0x53, 0xf2, 0x37, 0x7e, 0x78, 0x94, 0x89, 0xfd, 0xf3, 0x28,
-	0x13, 0xfb, 0xfe, 0x78, 0x77, 0xba, 0xf5, 0x78, 0x3f, 0x1e, 0xef, 0x4e, 0xb3, 0x73, 0x65, 0x89,
-	0xba, 0x9d, 0x6b, 0xb5, 0x99, 0x38, 0x06, 0x84, 0xd6, 0xa8, 0x8c, 0x48, 0x05, 0x9b, 0x04, 0x89,
-	0xbf, 0x25, 0x40, 0xff, 0x2a, 0xd1, 0x6e, 0xaa, 0x3a, 0x7d, 0x99, 0xce, 0x6c, 0x9b, 0x9a, 0xf8,
-	0xd9, 0x53, 0x73, 0x07, 0x24, 0x1b, 0x1e, 0x2d, 0x5a, 0x90, 0x22, 0xe6, 0xc8, 0xec, 0xf3, 0x83,
-	0xcc, 0x68, 0xab, 0x1b, 0x3f, 0x41, 0x1a, 0x54, 0x6a, 0x2b, 0x48, 0x69, 0xf2, 0xe4, 0x0a, 0x52,
-	0xe4, 0x3e, 0xc5, 0x77, 0x0b, 0xf8, 0xcf, 0xdb, 0xbb, 0xdd, 0x75, 0xe3, 0x64, 0x87, 0x4e, 0x6f,
-	0x63, 0xf2, 0xfc, 0x7b, 0x27, 0xe7, 0x71, 0xd4, 0x9f, 0x47, 0x5f, 0x4a, 0x44, 0x01, 0xa4, 0x82,
-	0x31, 0x2f, 0x87, 0x3f, 0xc7, 0x41, 0xcf, 0x2a, 0xd1, 0xd8, 0x6a, 0x88, 0xbf, 0xd9, 0xee, 0x42,
-	0x71, 0xce, 0x11, 0x52, 0x61, 0x17, 0xaa, 0xd3, 0xeb, 0xf4, 0x1f, 0x72, 0x76, 0x1d, 0x74, 0x41,
-	0x03, 0x57, 0x4d, 0xea, 0xa4, 0xaa, 0xd3, 0x7b, 0xc0, 0xe6, 0xe4, 0xdf, 0xf5, 0x09, 0xd8, 0x72,
-	0x3e, 0x5b, 0xc0, 0x8b, 0x7e, 0x01, 0xeb, 0x7a, 0x88, 0xc3, 0x60, 0xb0, 0xe9, 0x4f, 0x4f, 0xb6,
-	0x07, 0x09, 0xa7, 0x2c, 0x17, 0x90, 0xa6, 0x9b, 0x32, 0x52, 0xff, 0x67, 0xf5, 0x36, 0xc0, 0x70,
-	0x43, 0x3d, 0x62, 0x29, 0xa7, 0x57, 0x70, 0xd0, 0x9b, 0xbf, 0x6e, 0x29, 0x6d, 0x69, 0x55, 0x42,
-	0x3d, 0xda, 0xc4, 0xe9, 0x69, 0x57, 0x08, 0x6d, 0xcd, 0xcd, 0xb9, 0x33, 0xe4, 0xe6, 0xc6, 0xc9,
-	0xb9, 0x09, 0x14, 0xa9, 0x80, 0xe8, 0x62, 0xc5, 0x29, 0x52, 0x81, 0x68, 0x3d, 0x53, 0xbc, 0xec,
-	0xdc, 0xf6, 0x4a, 0x19, 0xd9, 0x57, 0xa9, 0x68, 0x77, 0x00, 0xac, 0x26, 0x09, 0x2d, 0x15, 0xf9,
-	0x76, 0xbd, 0x3d, 0x28, 0xf4, 0xda, 0xfb, 0x7c, 0xf8, 0x57, 0x86, 0x73, 0xf7, 0xda, 0xd7, 0x60,
-	0xb0, 0x31, 0xe2, 0x2f, 0x71, 0xd0, 0xbb, 0x4a, 0xb4, 0x0d, 0x53, 0x7d, 0xa5, 0xaf, 0xcd, 0xb5,
-	0x93, 0x53, 0x93, 0xf2, 0xa7, 0xa6, 0xa1, 0x88, 0xf8, 0x07, 0x07, 0x86, 0x7d, 0x91, 0x97, 0x99,
-	0x11, 0xfe, 0x33, 0xef, 0xa0, 0xf1, 0x93, 0x0e, 0x3a, 0xe6, 0xf4, 0x1d, 0x7b, 0xd9, 0x64, 0x63,
-	0xeb, 0xe3, 0x33, 0xd2, 0xfc, 0x8c, 0xef, 0xec, 0xe2, 0x8b, 0x38, 0x18, 0xb3, 0x9f, 0x3e, 0x68,
-	0x2a, 0xa8, 0xbc, 0x61, 0x96, 0xb0, 0xa9, 0xea, 0xa6, 0xd6, 0xd4, 0x79, 0xbc, 0x8a, 0x19, 0xe7,
-	0x27, 0x41, 0x52, 0xb1, 0x1f, 0x7b, 0x3b, 0x31, 0x5b, 0x48, 0xd7, 0xb6, 0xdc, 0x3b, 0x9d, 0x90,
-	0xfb, 0xea, 0xe1, 0x0f, 0x9d, 0x68, 0xfe, 0xab, 0xba, 0x35, 0xf6, 0x83, 0x42, 0x5e, 0x5d, 0x08,
-	0x77, 0xcb, 0x64, 0xa0, 0xdb, 0x08, 0x13, 0x57, 0xbc, 0x06, 0x26, 0xa2, 0xc6, 0xeb, 0x56, 0xca,
-	0x0f, 0xb6, 0x59, 0x5e, 0x7c, 0xc6, 0x81, 0xa4, 0xed, 0xbc, 0x8a, 0x0a, 0x29, 0x5a, 0x83, 0x16,
-	0x34, 0x08, 0xbf, 0x00, 0xba, 0x61, 0x95, 0x6e, 0x61, 0x4b, 0xa7, 0xb5, 0x13, 0xb3, 0xd4, 0x80,
-	0xf2, 0x4b, 0xa0, 0xab, 0xe2, 0x30, 0x30, 0x5f, 0xa5, 0xc3, 0x1a, 0x19, 0x77, 0x1d, 0x9f, 0xa6,
-	0xee, 0xc4, 0xfc, 0x47, 0xad, 0x7b, 0x5c, 0xb4, 0x25, 0x6a, 0xac, 0x62, 0x4b, 0x33, 0xd1, 0x24,
-	0xcd, 0x7d, 0xef, 0xfb, 0x21, 0x70, 0x0c, 0x51, 0x02, 0x97, 0x02, 0xa1, 0x28, 0x29, 0x16, 0xc5,
-	0x9f, 0xe2, 0xce, 0xf3, 0x25, 0x63, 0x0a, 0x29, 0x5a, 0xc6, 0x26, 0x71, 0xbb, 0xcb, 0xf6, 0xae,
-	0xe3, 0xce, 0xee, 0xba, 0xaf, 0x01, 0x30, 0xd1, 0xbd, 0x22, 0xeb, 0x78, 0xe3, 0x11, 0x1d, 0xef,
-	0xdb, 0x61, 0x1d, 0xef, 0xfe, 0x5e, 0xb6, 0x97, 0xc5, 0x59, 0x0b, 0xdc, 0x6d, 0xa2, 0x7b, 0x6b,
-	0x0e, 0x63, 0xfe, 0x76, 0xa8, 0xdd, 0xe6, 0x67, 0xc3, 0x9b, 0xa2, 0xb4, 0xdf, 0x6e, 0x41, 0x15,
-	0xc4, 0x39, 0x30, 0xda, 0x26, 0x1c, 0xa1, 0xe8, 0xfc, 0xec, 0xdc, 0x9f, 0x17, 0x40, 0x62, 0x95,
-	0x68, 0xfc, 0x5d, 0x90, 0x0c, 0x7e, 0x93, 0x4d, 0x87, 0x79, 0xa3, 0xb5, 0x85, 0x16, 0xe6, 0x3a,
-	0xc7, 0x7a, 0x75, 0x73, 0x1b, 0xf4, 0xfa, 0x5b, 0xed, 0xa9, 0x08, 0x12, 0x1f, 0x52, 0x98, 0xe9,
-	0x14, 0xe9, 0x2d, 0xf6, 0x25, 0x78, 0xcd, 0xeb, 0x09, 0x2f, 0x47, 0xcc, 0xae, 0x83, 0x84, 0x77,
-	0x3a, 0x00, 0x79, 0xec, 0x77, 0x41, 0x32, 0xd8, 0x3a, 0x45, 0xa9, 0x17, 0xc0, 0x46, 0xaa, 0x17,
-	0xd6, 0x07, 0x94, 0x00, 0x68, 0x7a, 0xaf, 0xdf, 0x8a, 0x60, 0x68, 0xc0, 0x84, 0x6c, 0x47, 0x30,
-	0x6f, 0x8d, 0x5f, 0x39, 0x30, 0x12, 0xfe, 0x62, 0x5c, 0x8d, 0xca, 0x79, 0xd8, 0x2c, 0xe1, 0xfa,
-	0x59, 0x66, 0x79, 0x7d, 0xea, 0xe0, 0x93, 0xd6, 0x02, 0xc9, 0x7f, 0x0b, 0x5e, 0xf7, 0x15, 0xc7,
-	0xc9, 0xa8, 0x53, 0x36, 0x01, 0x85, 0x5c, 0x87, 0xc0, 0xa8, 0xe5, 0x17, 0xf9, 0x07, 0x1c, 0xe8,
-	0x6f, 0xa9, 0x48, 0x51, 0xf6, 0x09, 0x82, 0x85, 0x2b, 0xa7, 0x00, 0x47, 0xec, 0x65, 0x7e, 0x56,
-	0x38, 0xff, 0x9d, 0x5d, 0xab, 0x0b, 0x0b, 0x8f, 0x0f, 0xd3, 0xdc, 0xd3, 0xc3, 0x34, 0xf7, 0xf7,
-	0x61, 0x9a, 0x7b, 0x78, 0x94, 0x8e, 0x3d, 0x3d, 0x4a, 0xc7, 0x9e, 0x1d, 0xa5, 0x63, 0x5f, 0x8c,
-	0xf9, 0xbe, 0xd9, 0x1a, 0x95, 0x99, 0xd6, 0x2a, 0x88, 0x94, 0xba, 0x9c, 0xda, 0x76, 0xe5, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x0b, 0xcb, 0xe0, 0xf9, 0xbe, 0x12, 0x00, 0x00,
+	// 1459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4d, 0x6f, 0xd4, 0x56,
+	0x17, 0x8e, 0x67, 0x42, 0x5e, 0x72, 0x78, 0xf3, 0xe5, 0x10, 0x98, 0x38, 0xe9, 0x4c, 0x6a, 0xa8,
+	0x02, 0xa9, 0xc6, 0x93, 0x0c, 0x04, 0xda, 0x01, 0x55, 0x10, 0x02, 0x2d, 0x2d, 0x29, 0x91, 0x03,
+	0x54, 0xad, 0xda, 0x4e, 0x3d, 0xf6, 0xc5, 0xb1, 0x32, 0xf6, 0x1d, 0x7c, 0xef, 0x04, 0xa6, 0x52,
+	0xa5, 0xaa, 0xdd, 0x14, 0xba, 0x61, 0x59, 0xa9, 0xaa, 0x44, 0xa5, 0x56, 0xea, 0x92, 0x45, 0x96,
+	0xfd, 0x01, 0x88, 0x15, 0xca, 0x0a, 0xb1, 0xa0, 0x15, 0x2c, 0xe8, 0x0f, 0xe8, 0xaa, 0x6c, 0x2a,
+	0x7f, 0x8c, 0x3d, 0xfe, 0xcc, 0x24, 0x85, 0x0d, 0x1b, 0x48, 0xce, 0x7d, 0xce, 0xb9, 0xe7, 0x3c,
+	0xcf, 0xb9, 0xf7, 0x1e, 0x07, 0x0a, 0x32, 0x26, 0x3a, 0x26, 0x25, 0x42, 0xa5, 0x35, 0xcd, 0x50,
+	0x4b, 0xeb, 0x73, 0x35, 0x44, 0xa5, 0xb9, 0x12, 0xbd, 0x21, 0x34, 0x4c, 0x4c, 0x31, 0xbb, 0xcf,
+	0x01, 0x08, 0x2e, 0x40, 0x70, 0x01, 0xdc, 0xb8, 0x8a, 0xb1, 0x5a, 0x47, 0x25, 0x1b, 0x55, 0x6b,
+	0x5e, 0x2d, 0x49, 0x46, 0xcb, 0x71, 0xe1, 0x0a, 0xe1, 0x25, 0xaa, 0xe9, 0x88, 0x50, 0x49, 0x6f,
+	0xb8, 0x80, 0xbd, 0x2a, 0x56, 0xb1, 0xfd, 0x63, 0xc9, 0xfa, 0xc9, 0xb5, 0x8e, 0x3b, 0x3b, 0x55,
+	0x9d, 0x05, 0x77, 0x5b, 0x67, 0x29, 0xef, 0x66, 0x59, 0x93, 0x08, 0xf2, 0x52, 0x94, 0xb1, 0x66,
+	0xb8, 0xeb, 0x07, 0x13, 0xaa, 0x68, 0x27, 0xed, 0xa0, 0xf6, 0xbb, 0x28, 0x9d, 0x58, 0x08, 0xeb,
+	0x3f, 0x77, 0x61, 0x44, 0xd2, 0x35, 0x03, 0x97, 0xec, 0x7f, 0x1d, 0x13, 0xff, 0xbc, 0x17, 0xd8,
+	0x25, 0xa2, 0x9e, 0x31, 0x91, 0x44, 0xd1, 0x15, 0xa9, 0xae, 0x29, 0x12, 0xc5, 0x26, 0xbb, 0x0c,
+	0x7b, 0x14, 0x44, 0x64, 0x53, 0x6b, 0x50, 0x0d, 0x1b, 0x39, 0x66, 0x8a, 0x39, 0xb4, 0xa7, 0x7c,
+	0x40, 0x88, 0xe7, 0x48, 0x58, 0xf4, 0xa1, 0x0b, 0xfd, 0xf7, 0x1e, 0x17, 0x7a, 0x7e, 0x7b, 0x76,
+	0x77, 0x86, 0x11, 0x3b, 0x43, 0xb0, 0x22, 0x80, 0x8c, 0x75, 0x5d, 0x23, 0xc4, 0x0a, 0x98, 0xb1,
+	0x03, 0x4e, 0x27, 0x05, 0x3c, 0xe3, 0x21, 0x45, 0x89, 0x22, 0xd2, 0x19, 0xb4, 0x23, 0x0a, 0xfb,
+	0x05, 0x8c, 0xea, 0x9a, 0x51, 0x25, 0xa8, 0x7e, 0xb5, 0xaa, 0xa0, 0x3a, 0x52, 0x25, 0x3b, 0xdb,
+	0xec, 0x14, 0x73, 0xa8, 0x7f, 0x61, 0xd6, 0xf2, 0x79, 0xf4, 0xb8, 0x30, 0xe6, 0xec, 0x41, 0x94,
+	0x35, 0x41, 0xc3, 0x25, 0x5d, 0xa2, 0xab, 0xc2, 0x79, 0x83, 0x6e, 0x6e, 0x14, 0xc1, 0xdd, 0xfc,
+	0xbc, 0x41, 0x9d, 0xd0, 0x23, 0xba, 0x66, 0xac, 0xa0, 0xfa, 0xd5, 0x45, 0x2f, 0x14, 0xfb, 0x2e,
+	0x8c, 0xb8, 0x81, 0xb1, 0x59, 0x95, 0x14, 0xc5, 0x44, 0x84, 0xe4, 0x7a, 0xed, 0xf8, 0xdc, 0xe6,
+	0x46, 0x71, 0xaf, 0x1b, 0xe2, 0xb4, 0xb3, 0xb2, 0x42, 0x4d, 0xcd, 0x50, 0x73, 0x8c, 0x38, 0xec,
+	0x39, 0xb9, 0x2b, 0xec, 0x87, 0x30, 0xb2, 0xde, 0x66, 0xd7, 0x0b, 0xb4, 0xcb, 0x0e, 0xf4, 0xfa,
+	0xe6, 0x46, 0xf1, 0x35, 0x37, 0x90, 0xa7, 0x40, 0x20, 0xa2, 0x38, 0xbc, 0x1e, 0xb2, 0xb3, 0xe7,
+	0xa0, 0xaf, 0xd1, 0xac, 0xad, 0xa1, 0x56, 0xae, 0xcf, 0xa6, 0x72, 0xaf, 0xe0, 0x34, 0xa3, 0xd0,
+	0x6e, 0x46, 0xe1, 0xb4, 0xd1, 0x5a, 0xc8, 0xdd, 0xf7, 0x73, 0x94, 0xcd, 0x56, 0x83, 0x62, 0x61,
+	0xb9, 0x59, 0xfb, 0x00, 0xb5, 0x44, 0xd7, 0x9b, 0xad, 0xc0, 0xae, 0x75, 0xa9, 0xde, 0x44, 0xb9,
+	0xff, 0xd9, 0x61, 0xc6, 0xdb, 0x8a, 0x58, 0x1d, 0xd8, 0x21, 0x87, 0x16, 0x10, 0xd6, 0x71, 0xa9,
+	0x9c, 0xfa, 0xee, 0x4e, 0xa1, 0xe7, 0xaf, 0x3b, 0x85, 0x9e, 0x6f, 0x9e, 0xdd, 0x9d, 0x89, 0x96,
+	0x77, 0xeb, 0xd9, 0xdd, 0x19, 0xb7, 0xae, 0x22, 0x51, 0xd6, 0x4a, 0xd1, 0x36, 0xe3, 0x27, 0x81,
+	0x8b, 0x5a, 0x45, 0x44, 0x1a, 0xd8, 0x20, 0x88, 0xff, 0x35, 0x0b, 0xc3, 0x4b, 0x44, 0x3d, 0xab,
+	0x68, 0xf4, 0x65, 0x76, 0x66, 0xac, 0x34, 0x99, 0x9d, 0x4b, 0x73, 0x05, 0x86, 0xfc, 0x1e, 0xad,
+	0x9a, 0x12, 0x45, 0x6e, 0x47, 0x16, 0x1f, 0x3d, 0x2e, 0x4c, 0x44, 0xbb, 0xf1, 0x02, 0x52, 0x25,
+	0xb9, 0xb5, 0x88, 0xe4, 0x8e, 0x9e, 0x5c, 0x44, 0xb2, 0x38, 0x28, 0x07, 0x4e, 0x01, 0xfb, 0x51,
+	0x7c, 0xb7, 0x3b, 0xdd, 0x38, 0xdd, 0x65, 0xa7, 0xc7, 0x34, 0x79, 0xe5, 0x9d, 0xad, 0x75, 0x9c,
+	0x08, 0xea, 0x18, 0x90, 0x84, 0xe7, 0x20, 0x17, 0xb6, 0x79, 0x1a, 0xfe, 0x94, 0x81, 0x3d, 0x4b,
+	0x44, 0x75, 0x77, 0x43, 0xec, 0xd9, 0xb8, 0x03, 0xc5, 0xd8, 0x25, 0xe4, 0x92, 0x0e, 0x54, 0xb7,
+	0xc7, 0xe9, 0x3f, 0x68, 0x76, 0x12, 0xfa, 0x24, 0x1d, 0x37, 0x0d, 0x9a, 0xcb, 0x6e, 0xe3, 0x1c,
+	0xb8, 0x3e, 0x95, 0xb7, 0x03, 0x04, 0x46, 0xea, 0xb3, 0x08, 0xdc, 0x17, 0x24, 0xb0, 0xcd, 0x07,
+	0x3f, 0x06, 0xa3, 0x1d, 0xbf, 0x7a, 0xb4, 0xdd, 0xcc, 0xda, 0xd7, 0xf2, 0x02, 0x52, 0x35, 0x43,
+	0x44, 0xca, 0x0b, 0x66, 0xef, 0x32, 0x8c, 0xf9, 0xec, 0x11, 0x53, 0xde, 0x3e, 0x83, 0xa3, 0x9e,
+	0xff, 0x8a, 0x29, 0xc7, 0x86, 0x55, 0x08, 0xf5, 0xc2, 0x66, 0xb7, 0x1f, 0x76, 0x91, 0xd0, 0xa8,
+	0x36, 0xbd, 0x3b, 0xd0, 0xe6, 0xd4, 0xd6, 0xda, 0x84, 0x2e, 0xa9, 0x10, 0xe9, 0x7c, 0x03, 0xb8,
+	0xa8, 0xb5, 0xad, 0x14, 0x2b, 0xda, 0xa7, 0xbd, 0x51, 0x47, 0xd6, 0x51, 0xaa, 0x5a, 0x13, 0x80,
+	0x7b, 0x27, 0x71, 0x91, 0x1b, 0xf9, 0x52, 0x7b, 0x3c, 0x58, 0x18, 0xb0, 0xf2, 0xbc, 0xfd, 0x47,
+	0x81, 0x71, 0x72, 0x1d, 0xf4, 0x23, 0x58, 0x18, 0xfe, 0xe7, 0x0c, 0x0c, 0x2c, 0x11, 0xf5, 0xb2,
+	0xa1, 0xbc, 0xd2, 0xc7, 0xe6, 0xc4, 0xd6, 0xd2, 0xe4, 0x82, 0xd2, 0xf8, 0x8c, 0xf0, 0xbf, 0x33,
+	0x30, 0x16, 0xb0, 0xbc, 0x4c, 0x45, 0xd8, 0x8b, 0x5e, 0xa1, 0x99, 0xad, 0x0a, 0x9d, 0xb4, 0xe7,
+	0x8e, 0x8d, 0xe2, 0x90, 0x9f, 0xfa, 0xd4, 0xac, 0x30, 0x3f, 0x1b, 0xa8, 0x9d, 0x7f, 0x9e, 0x81,
+	0x49, 0xeb, 0xe9, 0x93, 0x0c, 0x19, 0xd5, 0x2f, 0x1b, 0x35, 0x6c, 0x28, 0x9a, 0xa1, 0x76, 0x4c,
+	0x1e, 0xaf, 0xa2, 0xe2, 0xec, 0x34, 0x0c, 0xc9, 0xd6, 0x63, 0x6f, 0x09, 0xb3, 0x8a, 0x34, 0x75,
+	0xd5, 0x39, 0xd3, 0x59, 0x71, 0xb0, 0x6d, 0x7e, 0xcf, 0xb6, 0x56, 0x3e, 0x6b, 0xb7, 0xc6, 0x66,
+	0x98, 0xc8, 0xa3, 0xc7, 0x92, 0xbb, 0x65, 0x3a, 0x34, 0x6d, 0x24, 0x91, 0xcb, 0x9f, 0x80, 0x83,
+	0x69, 0xeb, 0xed, 0x56, 0xaa, 0x8c, 0xc6, 0x6c, 0xcf, 0x3f, 0x64, 0x60, 0xc8, 0xea, 0xbc, 0x86,
+	0x22, 0x51, 0xb4, 0x2c, 0x99, 0x92, 0x4e, 0xd8, 0x63, 0xd0, 0x2f, 0x35, 0xe9, 0x2a, 0x36, 0x35,
+	0xda, 0xda, 0x52, 0x25, 0x1f, 0xca, 0x9e, 0x86, 0xbe, 0x86, 0x1d, 0xc1, 0xed, 0xab, 0x7c, 0xd2,
+	0x20, 0xe3, 0xec, 0x13, 0xe0, 0xd4, 0x71, 0xac, 0xbc, 0x1f, 0xcd, 0xf1, 0xb8, 0x45, 0x91, 0xbf,
+	0x8b, 0x45, 0xcd, 0xc1, 0x0e, 0x6a, 0x6e, 0x78, 0xdf, 0x0f, 0xa1, 0x32, 0x78, 0x01, 0xf6, 0x87,
+	0x4c, 0x69, 0x54, 0x1c, 0xe7, 0x7f, 0xcc, 0xd8, 0xcf, 0x97, 0x88, 0xa9, 0x44, 0xd1, 0x19, 0x6c,
+	0x10, 0x67, 0xba, 0x8c, 0xef, 0x3a, 0x66, 0xe7, 0x5d, 0xf7, 0x39, 0x80, 0x81, 0xae, 0x57, 0xdd,
+	0x89, 0x37, 0x93, 0x32, 0xf1, 0x1e, 0x4e, 0x9a, 0x78, 0x37, 0x37, 0x8a, 0x03, 0xae, 0xdd, 0x31,
+	0x88, 0xfd, 0x06, 0xba, 0xbe, 0x6c, 0x47, 0xac, 0x5c, 0x4a, 0x6c, 0xb7, 0xf9, 0xb9, 0xe4, 0xa1,
+	0x28, 0x1f, 0x6c, 0xb7, 0x30, 0x0b, 0x7c, 0x19, 0x26, 0x62, 0xcc, 0x29, 0x8c, 0xce, 0xcf, 0xf1,
+	0xb7, 0xb2, 0x30, 0xb2, 0x44, 0xd4, 0x4b, 0x78, 0x0d, 0x19, 0xda, 0x97, 0x68, 0x65, 0x55, 0x32,
+	0x11, 0x79, 0x35, 0x2f, 0x83, 0x0b, 0x30, 0x46, 0xdd, 0x32, 0x95, 0x2a, 0xb1, 0x0a, 0xad, 0xe2,
+	0xeb, 0x06, 0x32, 0x73, 0xbd, 0x5b, 0x14, 0x36, 0xea, 0xb9, 0xd9, 0xf4, 0x5c, 0xb4, 0x9c, 0x2a,
+	0x62, 0xb2, 0x84, 0xe5, 0xe4, 0x1b, 0x63, 0x32, 0x28, 0x61, 0x90, 0x76, 0xfe, 0x7b, 0x06, 0xc6,
+	0x23, 0x56, 0xef, 0x9d, 0xf1, 0xab, 0x67, 0x76, 0x50, 0xfd, 0x04, 0xf4, 0x9b, 0x48, 0xc6, 0xa6,
+	0x52, 0xd5, 0x14, 0x5b, 0x83, 0x5e, 0x71, 0xb7, 0x63, 0x38, 0xaf, 0xc4, 0xb5, 0x46, 0x99, 0xff,
+	0x87, 0xb1, 0xe7, 0x6c, 0x6b, 0x06, 0x41, 0xba, 0x9d, 0x13, 0x39, 0x87, 0xcd, 0x17, 0xdb, 0x21,
+	0x27, 0xbb, 0x7f, 0xe7, 0x62, 0x1e, 0xf4, 0x8f, 0x77, 0xa4, 0xc1, 0x81, 0xd0, 0x31, 0x8a, 0xab,
+	0x8f, 0x6f, 0xc2, 0x54, 0xd2, 0xda, 0x8b, 0x11, 0x24, 0x96, 0xf3, 0xf2, 0xdf, 0xfd, 0x90, 0x5d,
+	0x22, 0x2a, 0x7b, 0x0d, 0x86, 0xc2, 0x7f, 0x22, 0x99, 0x49, 0xba, 0xaa, 0xa3, 0x5f, 0xb4, 0x5c,
+	0xb9, 0x7b, 0xac, 0x57, 0xcd, 0x1a, 0x0c, 0x04, 0xbf, 0x7c, 0x0f, 0xa5, 0x04, 0x09, 0x20, 0xb9,
+	0xd9, 0x6e, 0x91, 0xde, 0x66, 0x9f, 0xc2, 0x6e, 0xef, 0x13, 0xed, 0x40, 0x8a, 0x77, 0x1b, 0xc4,
+	0xbd, 0xd9, 0x05, 0xc8, 0x8b, 0x7e, 0x0d, 0x86, 0xc2, 0x5f, 0x32, 0x69, 0xec, 0x85, 0xb0, 0x5c,
+	0xb9, 0x7b, 0xac, 0xb7, 0x65, 0x0d, 0xa0, 0x63, 0x7c, 0x7e, 0x23, 0x25, 0x82, 0x0f, 0xe3, 0x8a,
+	0x5d, 0xc1, 0xbc, 0x3d, 0x7e, 0x61, 0x60, 0x3c, 0x79, 0x80, 0x3b, 0x9a, 0xa6, 0x79, 0x92, 0x17,
+	0x77, 0x72, 0x27, 0x5e, 0xde, 0x67, 0xe3, 0xe8, 0xfd, 0xe8, 0xbc, 0xc2, 0x7e, 0x05, 0xff, 0x0f,
+	0xcc, 0x2a, 0xd3, 0x69, 0x55, 0x76, 0x00, 0xb9, 0x52, 0x97, 0xc0, 0xb4, 0xed, 0x8f, 0xb3, 0x37,
+	0x19, 0x18, 0x8e, 0x0c, 0x08, 0x69, 0xed, 0x13, 0x06, 0x73, 0x47, 0xb6, 0x01, 0x4e, 0xc9, 0x65,
+	0x7e, 0x8e, 0xfd, 0x96, 0x81, 0xc1, 0xd0, 0xd3, 0x7a, 0x38, 0x25, 0x78, 0x10, 0xca, 0xcd, 0x75,
+	0x0d, 0x4d, 0xcb, 0xa2, 0xcc, 0xfe, 0xc0, 0xc0, 0x58, 0xfc, 0x2d, 0x9e, 0x76, 0x70, 0x63, 0x3d,
+	0xb8, 0xb7, 0xb6, 0xeb, 0x91, 0x9a, 0x1a, 0xb7, 0xeb, 0x6b, 0xeb, 0x4e, 0x5c, 0x38, 0x76, 0xef,
+	0x49, 0x9e, 0x79, 0xf0, 0x24, 0xcf, 0xfc, 0xf9, 0x24, 0xcf, 0xdc, 0x7e, 0x9a, 0xef, 0x79, 0xf0,
+	0x34, 0xdf, 0xf3, 0xf0, 0x69, 0xbe, 0xe7, 0x93, 0xc9, 0xc0, 0xdf, 0x98, 0xfc, 0x49, 0x92, 0xb6,
+	0x1a, 0x88, 0xd4, 0xfa, 0xec, 0x59, 0xec, 0xc8, 0xbf, 0x03, 0x00, 0x8c, 0x73, 0xcd, 0x63, 0x6e,
+	0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -855,6 +1057,12 @@ type MsgClient interface {
 	// RotateConsPubKey defines an operation for rotating the consensus keys
 	// of a validator.
 	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
+	// TokenizeShares defines a method for converting a delegation into a
+	// transferable share denom.
+	TokenizeShares(ctx context.Context, in *MsgTokenizeShares, opts ...grpc.CallOption) (*MsgTokenizeSharesResponse, error)
+	// RedeemTokensForShares defines a method for converting share tokens back
+	// into a delegation.
+	RedeemTokensForShares(ctx context.Context, in *MsgRedeemTokensForShares, opts ...grpc.CallOption) (*MsgRedeemTokensForSharesResponse, error)
 }
 
 type msgClient struct {
@@ -937,6 +1145,24 @@ func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKe
 	return out, nil
 }
 
+func (c *msgClient) TokenizeShares(ctx context.Context, in *MsgTokenizeShares, opts ...grpc.CallOption) (*MsgTokenizeSharesResponse, error) {
+	out := new(MsgTokenizeSharesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/TokenizeShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedeemTokensForShares(ctx context.Context, in *MsgRedeemTokensForShares, opts ...grpc.CallOption) (*MsgRedeemTokensForSharesResponse, error) {
+	out := new(MsgRedeemTokensForSharesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/RedeemTokensForShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -961,6 +1187,12 @@ type MsgServer interface {
 	// RotateConsPubKey defines an operation for rotating the consensus keys
 	// of a validator.
 	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
+	// TokenizeShares defines a method for converting a delegation into a
+	// transferable share denom.
+	TokenizeShares(context.Context, *MsgTokenizeShares) (*MsgTokenizeSharesResponse, error)
+	// RedeemTokensForShares defines a method for converting share tokens back
+	// into a delegation.
+	RedeemTokensForShares(context.Context, *MsgRedeemTokensForShares) (*MsgRedeemTokensForSharesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -991,6 +1223,12 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) RotateConsPubKey(ctx context.Context, req *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
 }
+func (*UnimplementedMsgServer) TokenizeShares(ctx context.Context, req *MsgTokenizeShares) (*MsgTokenizeSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShares not implemented")
+}
+func (*UnimplementedMsgServer) RedeemTokensForShares(ctx context.Context, req *MsgRedeemTokensForShares) (*MsgRedeemTokensForSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemTokensForShares not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1140,6 +1378,42 @@ func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TokenizeShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenizeShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TokenizeShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/TokenizeShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TokenizeShares(ctx, req.(*MsgTokenizeShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemTokensForShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemTokensForShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemTokensForShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/RedeemTokensForShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemTokensForShares(ctx, req.(*MsgRedeemTokensForShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Msg",
@@ -1177,6 +1451,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RotateConsPubKey",
 			Handler:    _Msg_RotateConsPubKey_Handler,
 		},
+		{
+			MethodName: "TokenizeShares",
+			Handler:    _Msg_TokenizeShares_Handler,
+		},
+		{
+			MethodName: "RedeemTokensForShares",
+			Handler:    _Msg_RedeemTokensForShares_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -1827,70 +2109,235 @@ func (m *MsgRotateConsPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgTokenizeShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgTokenizeShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenizeShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Description.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Commission.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinSelfDelegation.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenizedShareOwner) > 0 {
+		i -= len(m.TokenizedShareOwner)
+		copy(dAtA[i:], m.TokenizedShareOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenizedShareOwner)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.Pubkey != nil {
-		l = m.Pubkey.Size()
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Value.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTokenizeSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgEditValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgTokenizeSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenizeSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Description.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.RecordId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.CommissionRate != nil {
-		l = m.CommissionRate.Size()
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemTokensForShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemTokensForShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemTokensForShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemTokensForSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemTokensForSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemTokensForSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pubkey != nil {
+		l = m.Pubkey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEditValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CommissionRate != nil {
+		l = m.CommissionRate.Size()
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.MinSelfDelegation != nil {
 		l = m.MinSelfDelegation.Size()
@@ -2083,6 +2530,69 @@ func (m *MsgRotateConsPubKeyResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgTokenizeShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TokenizedShareOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTokenizeSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.RecordId != 0 {
+		n += 1 + sovTx(uint64(m.RecordId))
+	}
+	return n
+}
+
+func (m *MsgRedeemTokensForShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemTokensForSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3930,6 +4440,485 @@ func (m *MsgRotateConsPubKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTokenizeShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenizeShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenizeShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizedShareOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizedShareOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTokenizeSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTokenizeSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTokenizeSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemTokensForShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemTokensForShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemTokensForShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemTokensForSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemTokensForSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemTokensForSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
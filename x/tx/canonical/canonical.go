@@ -0,0 +1,387 @@
+// Package canonical implements deterministic binary and JSON encodings of
+// protobuf messages.
+//
+// The binary encoding follows the serialization rules of ADR-027: fields are
+// serialized once in ascending field number order, default values are omitted,
+// repeated scalar numeric fields are packed and varints are as short as possible.
+// In addition, an embedded message which is not a oneof member is omitted when
+// its own encoding is empty, the values of google.protobuf.Any are encoded
+// canonically too, and messages with unknown fields or non-empty map fields are
+// rejected.
+//
+// The JSON encoding is the protobuf JSON mapping of the canonical binary
+// encoding, without insignificant whitespace and with object keys sorted as
+// specified by RFC 8785.
+//
+// Both encodings work on any protoreflect message, including dynamicpb messages,
+// and on gogoproto messages resolved through their registered descriptors.
+package canonical
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const anyFullName = "google.protobuf.Any"
+
+// recursionLimit is the maximum depth of nested messages, with the same default
+// as protowire.
+const recursionLimit = 10_000
+
+var (
+	// ErrUnknownField is returned when a message has unknown fields.
+	ErrUnknownField = errors.New("unknown field")
+	// ErrMapField is returned when a message has a non-empty map field.
+	ErrMapField = errors.New("map fields are not supported")
+	// ErrNonCanonical is returned when bytes are not the canonical encoding of
+	// their message.
+	ErrNonCanonical = errors.New("non-canonical encoding")
+)
+
+// MarshalOptions are the options of the canonical encoders.
+type MarshalOptions struct {
+	// FileResolver resolves the descriptors of the messages packed in
+	// google.protobuf.Any and of gogoproto messages. It defaults to
+	// gogoproto.HybridResolver.
+	FileResolver protodesc.Resolver
+}
+
+// Marshal returns the canonical binary encoding of msg with the default options.
+func Marshal(msg proto.Message) ([]byte, error) {
+	return MarshalOptions{}.Marshal(msg)
+}
+
+// MarshalJSON returns the canonical JSON encoding of msg with the default options.
+func MarshalJSON(msg proto.Message) ([]byte, error) {
+	return MarshalOptions{}.MarshalToJSON(msg)
+}
+
+// Check returns an error if bz is not the canonical binary encoding of a message
+// of type desc, using the default options.
+func Check(bz []byte, desc protoreflect.MessageDescriptor) error {
+	return MarshalOptions{}.Check(bz, desc)
+}
+
+func (o MarshalOptions) fileResolver() protodesc.Resolver {
+	if o.FileResolver == nil {
+		return gogoproto.HybridResolver
+	}
+	return o.FileResolver
+}
+
+// Marshal returns the canonical binary encoding of msg.
+func (o MarshalOptions) Marshal(msg proto.Message) ([]byte, error) {
+	if msg == nil {
+		return nil, errors.New("cannot canonically encode nil message")
+	}
+	return o.appendMessage(nil, msg.ProtoReflect(), 0)
+}
+
+// MarshalGogo returns the canonical binary encoding of a gogoproto message. The
+// message is converted to a dynamic message with its registered descriptor.
+func (o MarshalOptions) MarshalGogo(msg gogoproto.Message) ([]byte, error) {
+	dynMsg, err := o.FromGogo(msg)
+	if err != nil {
+		return nil, err
+	}
+	return o.Marshal(dynMsg)
+}
+
+// MarshalGogoJSON returns the canonical JSON encoding of a gogoproto message.
+func (o MarshalOptions) MarshalGogoJSON(msg gogoproto.Message) ([]byte, error) {
+	dynMsg, err := o.FromGogo(msg)
+	if err != nil {
+		return nil, err
+	}
+	return o.MarshalToJSON(dynMsg)
+}
+
+// FromGogo converts a gogoproto message to a dynamic message of its registered
+// descriptor.
+func (o MarshalOptions) FromGogo(msg gogoproto.Message) (*dynamicpb.Message, error) {
+	if msg == nil {
+		return nil, errors.New("cannot canonically encode nil message")
+	}
+
+	bz, err := gogoproto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	desc, err := o.findMessage(protoreflect.FullName(gogoproto.MessageName(msg)))
+	if err != nil {
+		return nil, err
+	}
+
+	dynMsg := dynamicpb.NewMessage(desc)
+	if err := proto.Unmarshal(bz, dynMsg); err != nil {
+		return nil, err
+	}
+	return dynMsg, nil
+}
+
+// Check returns an error wrapping ErrNonCanonical if bz is not the canonical
+// binary encoding of a message of type desc, that is if it decodes to a message
+// whose canonical encoding differs from bz.
+func (o MarshalOptions) Check(bz []byte, desc protoreflect.MessageDescriptor) error {
+	msg := dynamicpb.NewMessage(desc)
+	if err := proto.Unmarshal(bz, msg); err != nil {
+		return err
+	}
+
+	canonical, err := o.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if i := firstDiff(bz, canonical); i >= 0 {
+		return fmt.Errorf("%w: %s differs from its canonical encoding at byte %d", ErrNonCanonical, desc.FullName(), i)
+	}
+
+	return nil
+}
+
+// firstDiff returns the index of the first byte which differs between a and b, or
+// -1 if they are equal.
+func firstDiff(a, b []byte) int {
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	if len(a) != len(b) {
+		return n
+	}
+	return -1
+}
+
+func (o MarshalOptions) findMessage(name protoreflect.FullName) (protoreflect.MessageDescriptor, error) {
+	desc, err := o.fileResolver().FindDescriptorByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("can't resolve message %s: %w", name, err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+	return msgDesc, nil
+}
+
+// sortedFields returns the fields of desc in ascending field number order.
+func sortedFields(desc protoreflect.MessageDescriptor) []protoreflect.FieldDescriptor {
+	fields := desc.Fields()
+	sorted := make([]protoreflect.FieldDescriptor, fields.Len())
+	for i := range sorted {
+		sorted[i] = fields.Get(i)
+	}
+	slices.SortFunc(sorted, func(a, b protoreflect.FieldDescriptor) int {
+		return int(a.Number()) - int(b.Number())
+	})
+	return sorted
+}
+
+func (o MarshalOptions) appendMessage(b []byte, msg protoreflect.Message, depth int) ([]byte, error) {
+	if depth > recursionLimit {
+		return nil, errors.New("recursion limit reached")
+	}
+
+	desc := msg.Descriptor()
+	if unknown := msg.GetUnknown(); len(unknown) > 0 {
+		num, _, _ := protowire.ConsumeTag(unknown)
+		return nil, fmt.Errorf("%w: %s: {TagNum: %d}", ErrUnknownField, desc.FullName(), num)
+	}
+	if desc.FullName() == anyFullName {
+		return o.appendAny(b, msg, depth)
+	}
+
+	var err error
+	for _, fd := range sortedFields(desc) {
+		// proto3 scalars without presence are only set when they differ from
+		// their default value, and lists when they are not empty
+		if !msg.Has(fd) {
+			continue
+		}
+		if fd.IsMap() {
+			return nil, fmt.Errorf("%w: %s", ErrMapField, fd.FullName())
+		}
+
+		value := msg.Get(fd)
+		if fd.IsList() {
+			b, err = o.appendList(b, fd, value.List(), depth)
+		} else {
+			b, err = o.appendField(b, fd, value, depth)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+// appendAny appends a google.protobuf.Any, whose value is re-encoded canonically.
+func (o MarshalOptions) appendAny(b []byte, msg protoreflect.Message, depth int) ([]byte, error) {
+	fields := msg.Descriptor().Fields()
+	typeURLField, valueField := fields.ByNumber(1), fields.ByNumber(2)
+	typeURL := msg.Get(typeURLField).String()
+	value := msg.Get(valueField).Bytes()
+
+	if typeURL == "" {
+		if len(value) > 0 {
+			return nil, errors.New("google.protobuf.Any has a value but no type URL")
+		}
+		return b, nil
+	}
+
+	name := typeURL
+	if i := strings.LastIndexByte(typeURL, '/'); i >= 0 {
+		name = typeURL[i+1:]
+	}
+	desc, err := o.findMessage(protoreflect.FullName(name))
+	if err != nil {
+		return nil, err
+	}
+	packed := dynamicpb.NewMessage(desc)
+	if err := proto.Unmarshal(value, packed); err != nil {
+		return nil, fmt.Errorf("can't decode google.protobuf.Any value of type %s: %w", typeURL, err)
+	}
+	value, err = o.appendMessage(nil, packed, depth+1)
+	if err != nil {
+		return nil, err
+	}
+
+	b = protowire.AppendTag(b, typeURLField.Number(), protowire.BytesType)
+	b = protowire.AppendString(b, typeURL)
+	if len(value) > 0 {
+		b = protowire.AppendTag(b, valueField.Number(), protowire.BytesType)
+		b = protowire.AppendBytes(b, value)
+	}
+	return b, nil
+}
+
+func (o MarshalOptions) appendList(b []byte, fd protoreflect.FieldDescriptor, list protoreflect.List, depth int) ([]byte, error) {
+	if isPackable(fd.Kind()) {
+		var packed []byte
+		for i := 0; i < list.Len(); i++ {
+			packed = appendScalar(packed, fd.Kind(), list.Get(i))
+		}
+		b = protowire.AppendTag(b, fd.Number(), protowire.BytesType)
+		return protowire.AppendBytes(b, packed), nil
+	}
+
+	var err error
+	for i := 0; i < list.Len(); i++ {
+		if fd.Message() != nil {
+			// empty messages of a list are kept, as they are elements of the list
+			b, err = o.appendMessageField(b, fd, list.Get(i).Message(), depth, true)
+		} else {
+			b, err = o.appendField(b, fd, list.Get(i), depth)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+func (o MarshalOptions) appendField(b []byte, fd protoreflect.FieldDescriptor, value protoreflect.Value, depth int) ([]byte, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		// oneof members are kept even when empty, as they select the oneof case
+		return o.appendMessageField(b, fd, value.Message(), depth, fd.ContainingOneof() != nil)
+	case protoreflect.GroupKind:
+		return nil, fmt.Errorf("groups are not supported: %s", fd.FullName())
+	case protoreflect.StringKind:
+		if !utf8.ValidString(value.String()) {
+			return nil, fmt.Errorf("invalid UTF-8 in string field %s", fd.FullName())
+		}
+	}
+
+	b = protowire.AppendTag(b, fd.Number(), wireType(fd.Kind()))
+	return appendScalar(b, fd.Kind(), value), nil
+}
+
+func (o MarshalOptions) appendMessageField(b []byte, fd protoreflect.FieldDescriptor, msg protoreflect.Message, depth int, keepEmpty bool) ([]byte, error) {
+	bz, err := o.appendMessage(nil, msg, depth+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) == 0 && !keepEmpty {
+		return b, nil
+	}
+
+	b = protowire.AppendTag(b, fd.Number(), protowire.BytesType)
+	return protowire.AppendBytes(b, bz), nil
+}
+
+// isPackable returns true for the scalar numeric kinds, which use the packed
+// encoding in repeated fields.
+func isPackable(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.MessageKind, protoreflect.GroupKind:
+		return false
+	default:
+		return true
+	}
+}
+
+func wireType(kind protoreflect.Kind) protowire.Type {
+	switch kind {
+	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind, protoreflect.FloatKind:
+		return protowire.Fixed32Type
+	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind, protoreflect.DoubleKind:
+		return protowire.Fixed64Type
+	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.MessageKind:
+		return protowire.BytesType
+	default:
+		return protowire.VarintType
+	}
+}
+
+// appendScalar appends the value of a scalar field without its tag. Varints are
+// always as short as possible, and negative int32 and enum values are sign
+// extended to 10 bytes.
+func appendScalar(b []byte, kind protoreflect.Kind, value protoreflect.Value) []byte {
+	switch kind {
+	case protoreflect.BoolKind:
+		return protowire.AppendVarint(b, protowire.EncodeBool(value.Bool()))
+	case protoreflect.EnumKind:
+		return protowire.AppendVarint(b, uint64(value.Enum()))
+	case protoreflect.Int32Kind, protoreflect.Int64Kind:
+		return protowire.AppendVarint(b, uint64(value.Int()))
+	case protoreflect.Sint32Kind:
+		return protowire.AppendVarint(b, protowire.EncodeZigZag(int64(int32(value.Int()))))
+	case protoreflect.Sint64Kind:
+		return protowire.AppendVarint(b, protowire.EncodeZigZag(value.Int()))
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		return protowire.AppendVarint(b, value.Uint())
+	case protoreflect.Fixed32Kind:
+		return protowire.AppendFixed32(b, uint32(value.Uint()))
+	case protoreflect.Sfixed32Kind:
+		return protowire.AppendFixed32(b, uint32(value.Int()))
+	case protoreflect.FloatKind:
+		return protowire.AppendFixed32(b, math.Float32bits(float32(value.Float())))
+	case protoreflect.Fixed64Kind:
+		return protowire.AppendFixed64(b, value.Uint())
+	case protoreflect.Sfixed64Kind:
+		return protowire.AppendFixed64(b, uint64(value.Int()))
+	case protoreflect.DoubleKind:
+		return protowire.AppendFixed64(b, math.Float64bits(value.Float()))
+	case protoreflect.StringKind:
+		return protowire.AppendString(b, value.String())
+	case protoreflect.BytesKind:
+		return protowire.AppendBytes(b, value.Bytes())
+	default:
+		panic(fmt.Sprintf("unsupported scalar kind %s", kind))
+	}
+}
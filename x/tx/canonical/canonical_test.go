@@ -0,0 +1,222 @@
+package canonical_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/x/tx/canonical"
+	"cosmossdk.io/x/tx/internal/testpb"
+)
+
+func TestMarshal(t *testing.T) {
+	// without empty embedded messages, maps and Any values the canonical
+	// encoding is the deterministic encoding of the generated types
+	msg := &testpb.A{
+		UINT32:   1,
+		UINT64:   math.MaxUint64,
+		INT32:    -1,
+		INT64:    math.MinInt64,
+		SDKINT:   "100",
+		COIN:     &basev1beta1.Coin{Denom: "stake", Amount: "10"},
+		COINS:    []*basev1beta1.Coin{{Denom: "a", Amount: "1"}, {Denom: "b", Amount: "2"}},
+		BYTES:    []byte{0, 1},
+		DURATION: durationpb.New(5),
+		ENUM:     testpb.ExternalEnum_EXTERNAL_ENUM_THREE,
+		SINT32:   -2,
+		SINT64:   -3,
+		SFIXED32: -4,
+		FIXED32:  5,
+		FLOAT:    1.5,
+		SFIXED64: -6,
+		FIXED64:  7,
+		DOUBLE:   -8.5,
+	}
+	expected, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	require.NoError(t, err)
+
+	bz, err := canonical.Marshal(msg)
+	require.NoError(t, err)
+	require.Equal(t, expected, bz)
+	require.NoError(t, canonical.Check(bz, msg.ProtoReflect().Descriptor()))
+}
+
+func TestMarshalNegativeInt32(t *testing.T) {
+	bz, err := canonical.Marshal(&testpb.A{INT32: -1})
+	require.NoError(t, err)
+	// the tag and a sign extended 10 bytes varint
+	require.Len(t, bz, 11)
+}
+
+func TestMarshalEmptyMessages(t *testing.T) {
+	// empty embedded messages are omitted
+	bz, err := canonical.Marshal(&testpb.A{COIN: &basev1beta1.Coin{}, DURATION: &durationpb.Duration{}})
+	require.NoError(t, err)
+	require.Empty(t, bz)
+
+	// except in lists
+	bz, err = canonical.Marshal(&testpb.A{COINS: []*basev1beta1.Coin{{}}})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x42, 0x00}, bz)
+
+	// and in oneofs, where they select the oneof case
+	bz, err = canonical.Marshal(&txv1beta1.ModeInfo{Sum: &txv1beta1.ModeInfo_Single_{Single: &txv1beta1.ModeInfo_Single{}}})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x0a, 0x00}, bz)
+}
+
+func TestMarshalPacked(t *testing.T) {
+	desc := newPackedDescriptor(t)
+	msg := dynamicpb.NewMessage(desc)
+	list := msg.Mutable(desc.Fields().ByNumber(1)).List()
+	list.Append(protoreflect.ValueOfUint64(1))
+	list.Append(protoreflect.ValueOfUint64(300))
+
+	bz, err := canonical.Marshal(msg)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x0a, 0x03, 0x01, 0xac, 0x02}, bz)
+
+	// unpacked elements are not canonical
+	var unpacked []byte
+	unpacked = protowire.AppendTag(unpacked, 1, protowire.VarintType)
+	unpacked = protowire.AppendVarint(unpacked, 1)
+	unpacked = protowire.AppendTag(unpacked, 1, protowire.VarintType)
+	unpacked = protowire.AppendVarint(unpacked, 300)
+	require.ErrorIs(t, canonical.Check(unpacked, desc), canonical.ErrNonCanonical)
+}
+
+// newPackedDescriptor returns the descriptor of a message with a repeated uint64
+// field, which the test protos don't have.
+func newPackedDescriptor(t *testing.T) protoreflect.MessageDescriptor {
+	t.Helper()
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("canonical_test.proto"),
+		Package: proto.String("canonical.test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Packed"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("values"),
+				Number:   proto.Int32(1),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_UINT64.Enum(),
+				JsonName: proto.String("values"),
+			}},
+		}},
+	}, nil)
+	require.NoError(t, err)
+	return fd.Messages().Get(0)
+}
+
+func TestMarshalRejects(t *testing.T) {
+	_, err := canonical.Marshal(&testpb.A{MAP: map[string]*testpb.A{"a": {}}})
+	require.ErrorIs(t, err, canonical.ErrMapField)
+
+	msg := &testpb.Foo{FullName: "foo"}
+	msg.ProtoReflect().SetUnknown(protowire.AppendVarint(protowire.AppendTag(nil, 20, protowire.VarintType), 1))
+	_, err = canonical.Marshal(msg)
+	require.ErrorIs(t, err, canonical.ErrUnknownField)
+
+	_, err = canonical.Marshal(&testpb.A{SDKINT: "\xff"})
+	require.ErrorContains(t, err, "invalid UTF-8")
+}
+
+func TestMarshalAny(t *testing.T) {
+	coin := &basev1beta1.Coin{Denom: "stake", Amount: "10"}
+	canonicalAny, err := anyutil.New(coin)
+	require.NoError(t, err)
+
+	// the amount is encoded before the denom
+	amount, err := proto.Marshal(&basev1beta1.Coin{Amount: "10"})
+	require.NoError(t, err)
+	denom, err := proto.Marshal(&basev1beta1.Coin{Denom: "stake"})
+	require.NoError(t, err)
+	unorderedAny := &anypb.Any{TypeUrl: canonicalAny.TypeUrl, Value: append(amount, denom...)}
+
+	expected, err := canonical.Marshal(&testpb.Bar{Payload: canonicalAny})
+	require.NoError(t, err)
+	bz, err := canonical.Marshal(&testpb.Bar{Payload: unorderedAny})
+	require.NoError(t, err)
+	require.Equal(t, expected, bz)
+
+	_, err = canonical.Marshal(&testpb.Bar{Payload: &anypb.Any{TypeUrl: "/unknown.Message"}})
+	require.ErrorContains(t, err, "can't resolve message unknown.Message")
+}
+
+func TestCheck(t *testing.T) {
+	desc := (&testpb.A{}).ProtoReflect().Descriptor()
+
+	var nonMinimal []byte
+	nonMinimal = protowire.AppendTag(nonMinimal, 1, protowire.VarintType)
+	nonMinimal = append(nonMinimal, 0x81, 0x00)
+
+	var unordered []byte
+	unordered = protowire.AppendVarint(protowire.AppendTag(unordered, 2, protowire.VarintType), 1)
+	unordered = protowire.AppendVarint(protowire.AppendTag(unordered, 1, protowire.VarintType), 1)
+
+	var duplicated []byte
+	duplicated = protowire.AppendVarint(protowire.AppendTag(duplicated, 1, protowire.VarintType), 1)
+	duplicated = protowire.AppendVarint(protowire.AppendTag(duplicated, 1, protowire.VarintType), 1)
+
+	var defaultValue []byte
+	defaultValue = protowire.AppendVarint(protowire.AppendTag(defaultValue, 1, protowire.VarintType), 0)
+
+	for name, bz := range map[string][]byte{
+		"non-minimal varint": nonMinimal,
+		"unordered fields":   unordered,
+		"duplicated field":   duplicated,
+		"default value":      defaultValue,
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, canonical.Check(bz, desc), canonical.ErrNonCanonical)
+		})
+	}
+
+	require.NoError(t, canonical.Check(nil, desc))
+}
+
+func TestCheckTag(t *testing.T) {
+	require.NoError(t, canonical.CheckTag(1, 0, 1, false))
+	require.NoError(t, canonical.CheckTag(2, 2, 1, true))
+	require.NoError(t, canonical.CheckTag(16, 2, 2, false))
+
+	require.ErrorIs(t, canonical.CheckTag(1, 2, 1, false), canonical.ErrNonCanonical)
+	require.ErrorIs(t, canonical.CheckTag(2, 2, 1, false), canonical.ErrNonCanonical)
+	require.ErrorIs(t, canonical.CheckTag(1, 0, 2, false), canonical.ErrNonCanonical)
+
+	require.NoError(t, canonical.CheckVarint(1, 1))
+	require.NoError(t, canonical.CheckVarint(math.MaxUint64, 10))
+	require.ErrorIs(t, canonical.CheckVarint(1, 2), canonical.ErrNonCanonical)
+}
+
+func TestMarshalJSON(t *testing.T) {
+	bz, err := canonical.MarshalJSON(&testpb.A{
+		UINT64: 10,
+		INT32:  -1,
+		SDKINT: "<100>",
+		COIN:   &basev1beta1.Coin{Denom: "stake", Amount: "10"},
+		// empty messages are omitted as in the binary encoding
+		DURATION: &durationpb.Duration{},
+		ENUM:     testpb.ExternalEnum_Two,
+	})
+	require.NoError(t, err)
+	require.Equal(t, `{"COIN":{"amount":"10","denom":"stake"},"ENUM":"Two","INT32":-1,"SDKINT":"<100>","UINT64":"10"}`, string(bz))
+
+	coin, err := anyutil.New(&basev1beta1.Coin{Denom: "stake", Amount: "10"})
+	require.NoError(t, err)
+	bz, err = canonical.MarshalJSON(&testpb.Bar{BarId: "bar", Payload: coin})
+	require.NoError(t, err)
+	require.Equal(t, `{"barId":"bar","payload":{"@type":"/cosmos.base.v1beta1.Coin","amount":"10","denom":"stake"}}`, string(bz))
+}
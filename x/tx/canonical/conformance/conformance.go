@@ -0,0 +1,239 @@
+// Package conformance generates and reads the golden vectors of the canonical
+// encodings. A vector holds the canonical binary and JSON encodings of a message
+// populated deterministically from its descriptor, so that other implementations
+// can check their output against it.
+package conformance
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"cosmossdk.io/x/tx/canonical"
+)
+
+// maxDepth is the depth from which the message fields of a populated message are
+// left unset.
+const maxDepth = 4
+
+// anyValueName is the message packed in the google.protobuf.Any fields of
+// populated messages.
+const anyValueName = "cosmos.base.v1beta1.Coin"
+
+// Vector is a golden vector of the canonical encodings of a message.
+type Vector struct {
+	// TypeURL is the type URL of the message.
+	TypeURL string `json:"type_url"`
+	// Binary is the hex encoded canonical binary encoding of the message.
+	Binary string `json:"binary"`
+	// JSON is the canonical JSON encoding of the message.
+	JSON string `json:"json"`
+}
+
+// Populate returns a message of type desc with every field set to a value
+// derived from its field number. Repeated fields have two elements, the first
+// field of each oneof is set, map fields are left empty and google.protobuf.Any
+// fields contain a cosmos.base.v1beta1.Coin. Strings and bytes only contain
+// digits so that they are valid integers and decimals.
+func Populate(desc protoreflect.MessageDescriptor, opts canonical.MarshalOptions) (*dynamicpb.Message, error) {
+	msg := dynamicpb.NewMessage(desc)
+	if err := populate(msg, opts, 0); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func populate(msg protoreflect.Message, opts canonical.MarshalOptions, depth int) error {
+	desc := msg.Descriptor()
+	if desc.FullName() == "google.protobuf.Any" {
+		return populateAny(msg, opts, depth)
+	}
+
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsMap() {
+			continue
+		}
+		if oneof := fd.ContainingOneof(); oneof != nil && oneof.Fields().Get(0) != fd {
+			continue
+		}
+
+		switch {
+		case fd.IsList():
+			list := msg.Mutable(fd).List()
+			for j := 0; j < 2; j++ {
+				value, ok, err := newValue(fd, list.NewElement, opts, depth, j)
+				if err != nil {
+					return err
+				}
+				if ok {
+					list.Append(value)
+				}
+			}
+		default:
+			value, ok, err := newValue(fd, func() protoreflect.Value { return msg.NewField(fd) }, opts, depth, 0)
+			if err != nil {
+				return err
+			}
+			if ok {
+				msg.Set(fd, value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// newValue returns the value of the index-th element of a field, or false if the
+// field must be left unset.
+func newValue(fd protoreflect.FieldDescriptor, newMessage func() protoreflect.Value, opts canonical.MarshalOptions, depth, index int) (protoreflect.Value, bool, error) {
+	n := int64(fd.Number()) + int64(index)
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(true), true, nil
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		return protoreflect.ValueOfEnum(values.Get(values.Len() - 1).Number()), true, nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(n)), true, nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(n), true, nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(n)), true, nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(uint64(n)), true, nil
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(float32(n) + 0.5), true, nil
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(float64(n) + 0.5), true, nil
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(digits(n)), true, nil
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(digits(n))), true, nil
+	case protoreflect.MessageKind:
+		if depth >= maxDepth {
+			return protoreflect.Value{}, false, nil
+		}
+		value := newMessage()
+		if err := populate(value.Message(), opts, depth+1); err != nil {
+			return protoreflect.Value{}, false, err
+		}
+		return value, true, nil
+	default:
+		return protoreflect.Value{}, false, fmt.Errorf("unsupported field kind %s of %s", fd.Kind(), fd.FullName())
+	}
+}
+
+// digits returns a string of digits derived from n, without leading zeros.
+func digits(n int64) string {
+	return strconv.FormatInt(1000+n, 10)
+}
+
+func populateAny(msg protoreflect.Message, opts canonical.MarshalOptions, depth int) error {
+	resolver := opts.FileResolver
+	if resolver == nil {
+		resolver = gogoproto.HybridResolver
+	}
+	desc, err := resolver.FindDescriptorByName(anyValueName)
+	if err != nil {
+		return err
+	}
+	value := dynamicpb.NewMessage(desc.(protoreflect.MessageDescriptor))
+	if err := populate(value, opts, depth+1); err != nil {
+		return err
+	}
+	bz, err := opts.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	fields := msg.Descriptor().Fields()
+	msg.Set(fields.ByNumber(1), protoreflect.ValueOfString("/"+anyValueName))
+	msg.Set(fields.ByNumber(2), protoreflect.ValueOfBytes(bz))
+	return nil
+}
+
+// Generate returns the vectors of the messages of descs, sorted by type URL.
+func Generate(descs []protoreflect.MessageDescriptor, opts canonical.MarshalOptions) ([]Vector, error) {
+	vectors := make([]Vector, 0, len(descs))
+	for _, desc := range descs {
+		msg, err := Populate(desc, opts)
+		if err != nil {
+			return nil, fmt.Errorf("can't populate %s: %w", desc.FullName(), err)
+		}
+		vector, err := NewVector(msg, opts)
+		if err != nil {
+			return nil, err
+		}
+		vectors = append(vectors, vector)
+	}
+
+	slices.SortFunc(vectors, func(a, b Vector) int {
+		return strings.Compare(a.TypeURL, b.TypeURL)
+	})
+	return vectors, nil
+}
+
+// NewVector returns the vector of the canonical encodings of msg.
+func NewVector(msg protoreflect.ProtoMessage, opts canonical.MarshalOptions) (Vector, error) {
+	name := msg.ProtoReflect().Descriptor().FullName()
+	bz, err := opts.Marshal(msg)
+	if err != nil {
+		return Vector{}, fmt.Errorf("can't encode %s: %w", name, err)
+	}
+	jsonBz, err := opts.MarshalToJSON(msg)
+	if err != nil {
+		return Vector{}, fmt.Errorf("can't encode %s to JSON: %w", name, err)
+	}
+
+	return Vector{
+		TypeURL: "/" + string(name),
+		Binary:  hex.EncodeToString(bz),
+		JSON:    string(jsonBz),
+	}, nil
+}
+
+// Read reads the vectors of a file written by Write.
+func Read(path string) ([]Vector, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var vectors []Vector
+	if err := json.Unmarshal(bz, &vectors); err != nil {
+		return nil, err
+	}
+	return vectors, nil
+}
+
+// Write writes vectors to a file, one vector per line.
+func Write(path string, vectors []Vector) error {
+	var buf bytes.Buffer
+	buf.WriteString("[\n")
+	for i, vector := range vectors {
+		var line bytes.Buffer
+		enc := json.NewEncoder(&line)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(vector); err != nil {
+			return err
+		}
+		buf.Write(bytes.TrimSuffix(line.Bytes(), []byte("\n")))
+		if i < len(vectors)-1 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('\n')
+	}
+	buf.WriteString("]\n")
+
+	return os.WriteFile(path, buf.Bytes(), 0o600)
+}
@@ -0,0 +1,59 @@
+package conformance_test
+
+import (
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/x/tx/canonical"
+	"cosmossdk.io/x/tx/canonical/conformance"
+	"cosmossdk.io/x/tx/internal/testpb"
+)
+
+func TestGenerate(t *testing.T) {
+	descs := []protoreflect.MessageDescriptor{
+		(&testpb.Qux{}).ProtoReflect().Descriptor(),
+		(&testpb.A{}).ProtoReflect().Descriptor(),
+		(&txv1beta1.ModeInfo{}).ProtoReflect().Descriptor(),
+	}
+	opts := canonical.MarshalOptions{}
+
+	vectors, err := conformance.Generate(descs, opts)
+	require.NoError(t, err)
+	require.Len(t, vectors, 3)
+	require.Equal(t, "/A", vectors[0].TypeURL)
+	require.Equal(t, "/Qux", vectors[1].TypeURL)
+	require.Equal(t, "/cosmos.tx.v1beta1.ModeInfo", vectors[2].TypeURL)
+
+	// the vectors are deterministic
+	again, err := conformance.Generate(descs, opts)
+	require.NoError(t, err)
+	require.Equal(t, vectors, again)
+
+	// and canonical
+	for i, vector := range vectors {
+		desc := descs[[]int{1, 0, 2}[i]]
+		bz, err := hex.DecodeString(vector.Binary)
+		require.NoError(t, err)
+		require.NotEmpty(t, bz)
+		require.NoError(t, canonical.Check(bz, desc))
+
+		msg := dynamicpb.NewMessage(desc)
+		require.NoError(t, proto.Unmarshal(bz, msg))
+		jsonBz, err := canonical.MarshalJSON(msg)
+		require.NoError(t, err)
+		require.Equal(t, vector.JSON, string(jsonBz))
+	}
+
+	path := filepath.Join(t.TempDir(), "vectors.json")
+	require.NoError(t, conformance.Write(path, vectors))
+	read, err := conformance.Read(path)
+	require.NoError(t, err)
+	require.Equal(t, vectors, read)
+}
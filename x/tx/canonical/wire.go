@@ -0,0 +1,34 @@
+package canonical
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// CheckTag returns an error wrapping ErrNonCanonical if the tag of a field num,
+// encoded in n bytes and following a field prev, breaks the ADR-027 rules the
+// binary encoding follows: fields are in ascending field number order, only the
+// values of a repeated field share a field number, and the tag varint is as
+// short as possible.
+//
+// Unlike Check, it only looks at the wire format, so it can be applied while
+// walking encoded bytes, including fields unknown to the message descriptor.
+func CheckTag(num, prev protowire.Number, n int, repeated bool) error {
+	if num < prev || (num == prev && !repeated) {
+		return fmt.Errorf("%w: field %d after field %d", ErrNonCanonical, num, prev)
+	}
+	if size := protowire.SizeTag(num); n != size {
+		return fmt.Errorf("%w: tag of field %d encoded in %d bytes, only need %d", ErrNonCanonical, num, n, size)
+	}
+	return nil
+}
+
+// CheckVarint returns an error wrapping ErrNonCanonical if the varint v, encoded
+// in n bytes, is not as short as possible.
+func CheckVarint(v uint64, n int) error {
+	if size := protowire.SizeVarint(v); n != size {
+		return fmt.Errorf("%w: varint %d encoded in %d bytes, only need %d", ErrNonCanonical, v, n, size)
+	}
+	return nil
+}
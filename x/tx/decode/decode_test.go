@@ -17,6 +17,7 @@ import (
 	"cosmossdk.io/api/cosmos/crypto/secp256k1"
 	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/x/tx/canonical"
 	"cosmossdk.io/x/tx/decode"
 	"cosmossdk.io/x/tx/internal/testpb"
 	"cosmossdk.io/x/tx/signing"
@@ -179,3 +180,83 @@ func TestDecodeTxBodyPanic(t *testing.T) {
 		t.Fatalf("error mismatch\n%s\nodes not contain\n\t%q", g, w)
 	}
 }
+
+func TestDecodeRejectNonCanonical(t *testing.T) {
+	signingCtx, err := signing.NewContext(signing.Options{
+		AddressCodec:          dummyAddressCodec{},
+		ValidatorAddressCodec: dummyAddressCodec{},
+	})
+	require.NoError(t, err)
+	gogoproto.RegisterType(&bankv1beta1.MsgSend{}, string((&bankv1beta1.MsgSend{}).ProtoReflect().Descriptor().FullName()))
+
+	anyMsg, err := anyutil.New(&bankv1beta1.MsgSend{FromAddress: "636f736d6f73"})
+	require.NoError(t, err)
+	authInfoBytes, err := canonical.Marshal(&txv1beta1.AuthInfo{
+		Fee: &txv1beta1.Fee{
+			Amount:   []*basev1beta1.Coin{{Amount: "100", Denom: "denom"}},
+			GasLimit: 100,
+		},
+	})
+	require.NoError(t, err)
+	bodyBytes, err := canonical.Marshal(&txv1beta1.TxBody{Messages: []*anypb.Any{anyMsg}, Memo: "memo"})
+	require.NoError(t, err)
+
+	// the memo is encoded before the messages
+	memoBytes, err := proto.Marshal(&txv1beta1.TxBody{Memo: "memo"})
+	require.NoError(t, err)
+	messagesBytes, err := proto.Marshal(&txv1beta1.TxBody{Messages: []*anypb.Any{anyMsg}})
+	require.NoError(t, err)
+	unorderedBodyBytes := append(memoBytes, messagesBytes...)
+
+	// the memo is encoded twice
+	duplicateBodyBytes := append(append([]byte{}, bodyBytes...), memoBytes...)
+
+	// the timeout height is encoded in a two bytes varint
+	longVarintBodyBytes := append(append([]byte{}, bodyBytes...), 0x18, 0x81, 0x00)
+
+	testCases := []struct {
+		name      string
+		bodyBytes []byte
+		error     string
+	}{
+		{
+			name:      "canonical",
+			bodyBytes: bodyBytes,
+		},
+		{
+			name:      "unordered fields",
+			bodyBytes: unorderedBodyBytes,
+			error:     "cosmos.tx.v1beta1.TxBody: non-canonical encoding: field 1 after field 2: tx parse error",
+		},
+		{
+			name:      "duplicate field",
+			bodyBytes: duplicateBodyBytes,
+			error:     "cosmos.tx.v1beta1.TxBody: non-canonical encoding: field 2 after field 2: tx parse error",
+		},
+		{
+			name:      "varint not as short as possible",
+			bodyBytes: longVarintBodyBytes,
+			error:     "cosmos.tx.v1beta1.TxBody: non-canonical encoding: varint 1 encoded in 2 bytes, only need 1: tx parse error",
+		},
+	}
+
+	decoder, err := decode.NewDecoder(decode.Options{
+		SigningContext: signingCtx,
+		ProtoCodec:     mockCodec{},
+	})
+	require.NoError(t, err)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBytes, err := proto.Marshal(&txv1beta1.TxRaw{BodyBytes: tc.bodyBytes, AuthInfoBytes: authInfoBytes})
+			require.NoError(t, err)
+
+			_, err = decoder.Decode(txBytes)
+			if tc.error != "" {
+				require.EqualError(t, err, tc.error)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"google.golang.org/protobuf/reflect/protodesc"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/anypb"
+
+	"cosmossdk.io/x/tx/canonical"
 )
 
 const bit11NonCritical = 1 << 10
@@ -32,6 +34,8 @@ func RejectUnknownFieldsStrict(bz []byte, msg protoreflect.MessageDescriptor, re
 // used to treat a message with non-critical field different in different security contexts (such as transaction signing).
 // This function traverses inside of messages nested via google.protobuf.Any. It does not do any deserialization of the proto.Message.
 // An AnyResolver must be provided for traversing inside google.protobuf.Any's.
+// The bytes must also follow the ADR-027 wire rules of the canonical encoding, see canonical.CheckTag and
+// canonical.CheckVarint: fields in ascending order and varints as short as possible.
 func RejectUnknownFields(bz []byte, desc protoreflect.MessageDescriptor, allowUnknownNonCriticals bool, resolver protodesc.Resolver) (hasUnknownNonCriticals bool, err error) {
 	// recursion limit with same default as https://github.com/protocolbuffers/protobuf-go/blob/v1.35.2/encoding/protowire/wire.go#L28
 	return doRejectUnknownFields(bz, desc, allowUnknownNonCriticals, resolver, 10_000)
@@ -52,6 +56,7 @@ func doRejectUnknownFields(
 	}
 
 	fields := desc.Fields()
+	prevTagNum := protowire.Number(0)
 
 	for len(bz) > 0 {
 		tagNum, wireType, m := protowire.ConsumeTag(bz)
@@ -60,6 +65,12 @@ func doRejectUnknownFields(
 		}
 
 		fieldDesc := fields.ByNumber(tagNum)
+		// unknown fields may be repeated, their descriptor is not known
+		repeated := fieldDesc == nil || fieldDesc.Cardinality() == protoreflect.Repeated
+		if err := canonical.CheckTag(tagNum, prevTagNum, m, repeated); err != nil {
+			return hasUnknownNonCriticals, fmt.Errorf("%s: %w", desc.FullName(), err)
+		}
+		prevTagNum = tagNum
 		if fieldDesc == nil {
 			isCriticalField := tagNum&bit11NonCritical == 0
 
@@ -86,6 +97,17 @@ func doRejectUnknownFields(
 		fieldBytes := bz[:n]
 		bz = bz[n:]
 
+		// varint values and length prefixes must be as short as possible
+		if wireType == protowire.VarintType || wireType == protowire.BytesType {
+			v, o := protowire.ConsumeVarint(fieldBytes)
+			if o < 0 {
+				return hasUnknownNonCriticals, fmt.Errorf("could not consume varint for tagNum: %d; %w", tagNum, protowire.ParseError(o))
+			}
+			if err := canonical.CheckVarint(v, o); err != nil {
+				return hasUnknownNonCriticals, fmt.Errorf("%s: %w", desc.FullName(), err)
+			}
+		}
+
 		// An unknown but non-critical field
 		if fieldDesc == nil {
 			continue
@@ -65,11 +65,16 @@ func TestE2EJSONTestcases(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, tc.Screens, screens)
 
-			// Make sure CBOR match.
-			signDoc, err := tr.GetSignBytes(ctx, signerData, signing.TxData{
+			txData := signing.TxData{
 				BodyBytes:     bodyBz,
 				AuthInfoBytes: authInfoBz,
-			})
+			}
+			handlerScreens, err := tr.GetScreens(ctx, signerData, txData)
+			require.NoError(t, err)
+			require.Equal(t, tc.Screens, handlerScreens)
+
+			// Make sure CBOR match.
+			signDoc, err := tr.GetSignBytes(ctx, signerData, txData)
 			require.NoError(t, err)
 			decodeWant, err := hex.DecodeString(tc.Cbor)
 			require.NoError(t, err)